@@ -3,11 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
 	"github.com/nekomeowww/insights-bot/ent/chathistories"
 )
@@ -31,6 +33,8 @@ type ChatHistories struct {
 	Username string `json:"username,omitempty"`
 	// FullName holds the value of the "full_name" field.
 	FullName string `json:"full_name,omitempty"`
+	// IsBot holds the value of the "is_bot" field.
+	IsBot bool `json:"is_bot,omitempty"`
 	// Text holds the value of the "text" field.
 	Text string `json:"text,omitempty"`
 	// RepliedToMessageID holds the value of the "replied_to_message_id" field.
@@ -51,6 +55,18 @@ type ChatHistories struct {
 	Embedded bool `json:"embedded,omitempty"`
 	// FromPlatform holds the value of the "from_platform" field.
 	FromPlatform int `json:"from_platform,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID string `json:"poll_id,omitempty"`
+	// PollQuestion holds the value of the "poll_question" field.
+	PollQuestion string `json:"poll_question,omitempty"`
+	// PollOptions holds the value of the "poll_options" field.
+	PollOptions []tgbotapi.PollOption `json:"poll_options,omitempty"`
+	// PollTotalVoterCount holds the value of the "poll_total_voter_count" field.
+	PollTotalVoterCount int `json:"poll_total_voter_count,omitempty"`
+	// PollIsClosed holds the value of the "poll_is_closed" field.
+	PollIsClosed bool `json:"poll_is_closed,omitempty"`
+	// MessageTypes holds the value of the "message_types" field.
+	MessageTypes int `json:"message_types,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -63,11 +79,13 @@ func (*ChatHistories) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case chathistories.FieldEmbedded:
+		case chathistories.FieldPollOptions:
+			values[i] = new([]byte)
+		case chathistories.FieldIsBot, chathistories.FieldEmbedded, chathistories.FieldPollIsClosed:
 			values[i] = new(sql.NullBool)
-		case chathistories.FieldChatID, chathistories.FieldMessageID, chathistories.FieldUserID, chathistories.FieldRepliedToMessageID, chathistories.FieldRepliedToUserID, chathistories.FieldChattedAt, chathistories.FieldFromPlatform, chathistories.FieldCreatedAt, chathistories.FieldUpdatedAt:
+		case chathistories.FieldChatID, chathistories.FieldMessageID, chathistories.FieldUserID, chathistories.FieldRepliedToMessageID, chathistories.FieldRepliedToUserID, chathistories.FieldChattedAt, chathistories.FieldFromPlatform, chathistories.FieldPollTotalVoterCount, chathistories.FieldMessageTypes, chathistories.FieldCreatedAt, chathistories.FieldUpdatedAt:
 			values[i] = new(sql.NullInt64)
-		case chathistories.FieldChatTitle, chathistories.FieldChatType, chathistories.FieldUsername, chathistories.FieldFullName, chathistories.FieldText, chathistories.FieldRepliedToFullName, chathistories.FieldRepliedToUsername, chathistories.FieldRepliedToText, chathistories.FieldRepliedToChatType:
+		case chathistories.FieldChatTitle, chathistories.FieldChatType, chathistories.FieldUsername, chathistories.FieldFullName, chathistories.FieldText, chathistories.FieldRepliedToFullName, chathistories.FieldRepliedToUsername, chathistories.FieldRepliedToText, chathistories.FieldRepliedToChatType, chathistories.FieldPollID, chathistories.FieldPollQuestion:
 			values[i] = new(sql.NullString)
 		case chathistories.FieldID:
 			values[i] = new(uuid.UUID)
@@ -134,6 +152,12 @@ func (_m *ChatHistories) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.FullName = value.String
 			}
+		case chathistories.FieldIsBot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_bot", values[i])
+			} else if value.Valid {
+				_m.IsBot = value.Bool
+			}
 		case chathistories.FieldText:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field text", values[i])
@@ -194,6 +218,44 @@ func (_m *ChatHistories) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.FromPlatform = int(value.Int64)
 			}
+		case chathistories.FieldPollID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value.Valid {
+				_m.PollID = value.String
+			}
+		case chathistories.FieldPollQuestion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_question", values[i])
+			} else if value.Valid {
+				_m.PollQuestion = value.String
+			}
+		case chathistories.FieldPollOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PollOptions); err != nil {
+					return fmt.Errorf("unmarshal field poll_options: %w", err)
+				}
+			}
+		case chathistories.FieldPollTotalVoterCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_total_voter_count", values[i])
+			} else if value.Valid {
+				_m.PollTotalVoterCount = int(value.Int64)
+			}
+		case chathistories.FieldPollIsClosed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_is_closed", values[i])
+			} else if value.Valid {
+				_m.PollIsClosed = value.Bool
+			}
+		case chathistories.FieldMessageTypes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_types", values[i])
+			} else if value.Valid {
+				_m.MessageTypes = int(value.Int64)
+			}
 		case chathistories.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -263,6 +325,9 @@ func (_m *ChatHistories) String() string {
 	builder.WriteString("full_name=")
 	builder.WriteString(_m.FullName)
 	builder.WriteString(", ")
+	builder.WriteString("is_bot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsBot))
+	builder.WriteString(", ")
 	builder.WriteString("text=")
 	builder.WriteString(_m.Text)
 	builder.WriteString(", ")
@@ -293,6 +358,24 @@ func (_m *ChatHistories) String() string {
 	builder.WriteString("from_platform=")
 	builder.WriteString(fmt.Sprintf("%v", _m.FromPlatform))
 	builder.WriteString(", ")
+	builder.WriteString("poll_id=")
+	builder.WriteString(_m.PollID)
+	builder.WriteString(", ")
+	builder.WriteString("poll_question=")
+	builder.WriteString(_m.PollQuestion)
+	builder.WriteString(", ")
+	builder.WriteString("poll_options=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollOptions))
+	builder.WriteString(", ")
+	builder.WriteString("poll_total_voter_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollTotalVoterCount))
+	builder.WriteString(", ")
+	builder.WriteString("poll_is_closed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollIsClosed))
+	builder.WriteString(", ")
+	builder.WriteString("message_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageTypes))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
 	builder.WriteString(", ")
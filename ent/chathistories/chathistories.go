@@ -26,6 +26,8 @@ const (
 	FieldUsername = "username"
 	// FieldFullName holds the string denoting the full_name field in the database.
 	FieldFullName = "full_name"
+	// FieldIsBot holds the string denoting the is_bot field in the database.
+	FieldIsBot = "is_bot"
 	// FieldText holds the string denoting the text field in the database.
 	FieldText = "text"
 	// FieldRepliedToMessageID holds the string denoting the replied_to_message_id field in the database.
@@ -46,6 +48,18 @@ const (
 	FieldEmbedded = "embedded"
 	// FieldFromPlatform holds the string denoting the from_platform field in the database.
 	FieldFromPlatform = "from_platform"
+	// FieldPollID holds the string denoting the poll_id field in the database.
+	FieldPollID = "poll_id"
+	// FieldPollQuestion holds the string denoting the poll_question field in the database.
+	FieldPollQuestion = "poll_question"
+	// FieldPollOptions holds the string denoting the poll_options field in the database.
+	FieldPollOptions = "poll_options"
+	// FieldPollTotalVoterCount holds the string denoting the poll_total_voter_count field in the database.
+	FieldPollTotalVoterCount = "poll_total_voter_count"
+	// FieldPollIsClosed holds the string denoting the poll_is_closed field in the database.
+	FieldPollIsClosed = "poll_is_closed"
+	// FieldMessageTypes holds the string denoting the message_types field in the database.
+	FieldMessageTypes = "message_types"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -64,6 +78,7 @@ var Columns = []string{
 	FieldUserID,
 	FieldUsername,
 	FieldFullName,
+	FieldIsBot,
 	FieldText,
 	FieldRepliedToMessageID,
 	FieldRepliedToUserID,
@@ -74,6 +89,12 @@ var Columns = []string{
 	FieldChattedAt,
 	FieldEmbedded,
 	FieldFromPlatform,
+	FieldPollID,
+	FieldPollQuestion,
+	FieldPollOptions,
+	FieldPollTotalVoterCount,
+	FieldPollIsClosed,
+	FieldMessageTypes,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -103,6 +124,8 @@ var (
 	DefaultUsername string
 	// DefaultFullName holds the default value on creation for the "full_name" field.
 	DefaultFullName string
+	// DefaultIsBot holds the default value on creation for the "is_bot" field.
+	DefaultIsBot bool
 	// DefaultText holds the default value on creation for the "text" field.
 	DefaultText string
 	// DefaultRepliedToMessageID holds the default value on creation for the "replied_to_message_id" field.
@@ -123,6 +146,16 @@ var (
 	DefaultEmbedded bool
 	// DefaultFromPlatform holds the default value on creation for the "from_platform" field.
 	DefaultFromPlatform int
+	// DefaultPollID holds the default value on creation for the "poll_id" field.
+	DefaultPollID string
+	// DefaultPollQuestion holds the default value on creation for the "poll_question" field.
+	DefaultPollQuestion string
+	// DefaultPollTotalVoterCount holds the default value on creation for the "poll_total_voter_count" field.
+	DefaultPollTotalVoterCount int
+	// DefaultPollIsClosed holds the default value on creation for the "poll_is_closed" field.
+	DefaultPollIsClosed bool
+	// DefaultMessageTypes holds the default value on creation for the "message_types" field.
+	DefaultMessageTypes int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() int64
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -174,6 +207,11 @@ func ByFullName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFullName, opts...).ToFunc()
 }
 
+// ByIsBot orders the results by the is_bot field.
+func ByIsBot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsBot, opts...).ToFunc()
+}
+
 // ByText orders the results by the text field.
 func ByText(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldText, opts...).ToFunc()
@@ -224,6 +262,31 @@ func ByFromPlatform(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFromPlatform, opts...).ToFunc()
 }
 
+// ByPollID orders the results by the poll_id field.
+func ByPollID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollID, opts...).ToFunc()
+}
+
+// ByPollQuestion orders the results by the poll_question field.
+func ByPollQuestion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollQuestion, opts...).ToFunc()
+}
+
+// ByPollTotalVoterCount orders the results by the poll_total_voter_count field.
+func ByPollTotalVoterCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollTotalVoterCount, opts...).ToFunc()
+}
+
+// ByPollIsClosed orders the results by the poll_is_closed field.
+func ByPollIsClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollIsClosed, opts...).ToFunc()
+}
+
+// ByMessageTypes orders the results by the message_types field.
+func ByMessageTypes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageTypes, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -88,6 +88,11 @@ func FullName(v string) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldEQ(FieldFullName, v))
 }
 
+// IsBot applies equality check predicate on the "is_bot" field. It's identical to IsBotEQ.
+func IsBot(v bool) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldIsBot, v))
+}
+
 // Text applies equality check predicate on the "text" field. It's identical to TextEQ.
 func Text(v string) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldEQ(FieldText, v))
@@ -138,6 +143,31 @@ func FromPlatform(v int) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldEQ(FieldFromPlatform, v))
 }
 
+// PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
+func PollID(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollQuestion applies equality check predicate on the "poll_question" field. It's identical to PollQuestionEQ.
+func PollQuestion(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollQuestion, v))
+}
+
+// PollTotalVoterCount applies equality check predicate on the "poll_total_voter_count" field. It's identical to PollTotalVoterCountEQ.
+func PollTotalVoterCount(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollTotalVoterCount, v))
+}
+
+// PollIsClosed applies equality check predicate on the "poll_is_closed" field. It's identical to PollIsClosedEQ.
+func PollIsClosed(v bool) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollIsClosed, v))
+}
+
+// MessageTypes applies equality check predicate on the "message_types" field. It's identical to MessageTypesEQ.
+func MessageTypes(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldMessageTypes, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldEQ(FieldCreatedAt, v))
@@ -528,6 +558,16 @@ func FullNameContainsFold(v string) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldContainsFold(FieldFullName, v))
 }
 
+// IsBotEQ applies the EQ predicate on the "is_bot" field.
+func IsBotEQ(v bool) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldIsBot, v))
+}
+
+// IsBotNEQ applies the NEQ predicate on the "is_bot" field.
+func IsBotNEQ(v bool) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNEQ(FieldIsBot, v))
+}
+
 // TextEQ applies the EQ predicate on the "text" field.
 func TextEQ(v string) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldEQ(FieldText, v))
@@ -1023,6 +1063,236 @@ func FromPlatformLTE(v int) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldLTE(FieldFromPlatform, v))
 }
 
+// PollIDEQ applies the EQ predicate on the "poll_id" field.
+func PollIDEQ(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollIDNEQ applies the NEQ predicate on the "poll_id" field.
+func PollIDNEQ(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNEQ(FieldPollID, v))
+}
+
+// PollIDIn applies the In predicate on the "poll_id" field.
+func PollIDIn(vs ...string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldIn(FieldPollID, vs...))
+}
+
+// PollIDNotIn applies the NotIn predicate on the "poll_id" field.
+func PollIDNotIn(vs ...string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNotIn(FieldPollID, vs...))
+}
+
+// PollIDGT applies the GT predicate on the "poll_id" field.
+func PollIDGT(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGT(FieldPollID, v))
+}
+
+// PollIDGTE applies the GTE predicate on the "poll_id" field.
+func PollIDGTE(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGTE(FieldPollID, v))
+}
+
+// PollIDLT applies the LT predicate on the "poll_id" field.
+func PollIDLT(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLT(FieldPollID, v))
+}
+
+// PollIDLTE applies the LTE predicate on the "poll_id" field.
+func PollIDLTE(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLTE(FieldPollID, v))
+}
+
+// PollIDContains applies the Contains predicate on the "poll_id" field.
+func PollIDContains(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldContains(FieldPollID, v))
+}
+
+// PollIDHasPrefix applies the HasPrefix predicate on the "poll_id" field.
+func PollIDHasPrefix(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldHasPrefix(FieldPollID, v))
+}
+
+// PollIDHasSuffix applies the HasSuffix predicate on the "poll_id" field.
+func PollIDHasSuffix(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldHasSuffix(FieldPollID, v))
+}
+
+// PollIDEqualFold applies the EqualFold predicate on the "poll_id" field.
+func PollIDEqualFold(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEqualFold(FieldPollID, v))
+}
+
+// PollIDContainsFold applies the ContainsFold predicate on the "poll_id" field.
+func PollIDContainsFold(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldContainsFold(FieldPollID, v))
+}
+
+// PollQuestionEQ applies the EQ predicate on the "poll_question" field.
+func PollQuestionEQ(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollQuestion, v))
+}
+
+// PollQuestionNEQ applies the NEQ predicate on the "poll_question" field.
+func PollQuestionNEQ(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNEQ(FieldPollQuestion, v))
+}
+
+// PollQuestionIn applies the In predicate on the "poll_question" field.
+func PollQuestionIn(vs ...string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldIn(FieldPollQuestion, vs...))
+}
+
+// PollQuestionNotIn applies the NotIn predicate on the "poll_question" field.
+func PollQuestionNotIn(vs ...string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNotIn(FieldPollQuestion, vs...))
+}
+
+// PollQuestionGT applies the GT predicate on the "poll_question" field.
+func PollQuestionGT(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGT(FieldPollQuestion, v))
+}
+
+// PollQuestionGTE applies the GTE predicate on the "poll_question" field.
+func PollQuestionGTE(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGTE(FieldPollQuestion, v))
+}
+
+// PollQuestionLT applies the LT predicate on the "poll_question" field.
+func PollQuestionLT(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLT(FieldPollQuestion, v))
+}
+
+// PollQuestionLTE applies the LTE predicate on the "poll_question" field.
+func PollQuestionLTE(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLTE(FieldPollQuestion, v))
+}
+
+// PollQuestionContains applies the Contains predicate on the "poll_question" field.
+func PollQuestionContains(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldContains(FieldPollQuestion, v))
+}
+
+// PollQuestionHasPrefix applies the HasPrefix predicate on the "poll_question" field.
+func PollQuestionHasPrefix(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldHasPrefix(FieldPollQuestion, v))
+}
+
+// PollQuestionHasSuffix applies the HasSuffix predicate on the "poll_question" field.
+func PollQuestionHasSuffix(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldHasSuffix(FieldPollQuestion, v))
+}
+
+// PollQuestionEqualFold applies the EqualFold predicate on the "poll_question" field.
+func PollQuestionEqualFold(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEqualFold(FieldPollQuestion, v))
+}
+
+// PollQuestionContainsFold applies the ContainsFold predicate on the "poll_question" field.
+func PollQuestionContainsFold(v string) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldContainsFold(FieldPollQuestion, v))
+}
+
+// PollOptionsIsNil applies the IsNil predicate on the "poll_options" field.
+func PollOptionsIsNil() predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldIsNull(FieldPollOptions))
+}
+
+// PollOptionsNotNil applies the NotNil predicate on the "poll_options" field.
+func PollOptionsNotNil() predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNotNull(FieldPollOptions))
+}
+
+// PollTotalVoterCountEQ applies the EQ predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountEQ(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollTotalVoterCount, v))
+}
+
+// PollTotalVoterCountNEQ applies the NEQ predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountNEQ(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNEQ(FieldPollTotalVoterCount, v))
+}
+
+// PollTotalVoterCountIn applies the In predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountIn(vs ...int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldIn(FieldPollTotalVoterCount, vs...))
+}
+
+// PollTotalVoterCountNotIn applies the NotIn predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountNotIn(vs ...int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNotIn(FieldPollTotalVoterCount, vs...))
+}
+
+// PollTotalVoterCountGT applies the GT predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountGT(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGT(FieldPollTotalVoterCount, v))
+}
+
+// PollTotalVoterCountGTE applies the GTE predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountGTE(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGTE(FieldPollTotalVoterCount, v))
+}
+
+// PollTotalVoterCountLT applies the LT predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountLT(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLT(FieldPollTotalVoterCount, v))
+}
+
+// PollTotalVoterCountLTE applies the LTE predicate on the "poll_total_voter_count" field.
+func PollTotalVoterCountLTE(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLTE(FieldPollTotalVoterCount, v))
+}
+
+// PollIsClosedEQ applies the EQ predicate on the "poll_is_closed" field.
+func PollIsClosedEQ(v bool) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldPollIsClosed, v))
+}
+
+// PollIsClosedNEQ applies the NEQ predicate on the "poll_is_closed" field.
+func PollIsClosedNEQ(v bool) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNEQ(FieldPollIsClosed, v))
+}
+
+// MessageTypesEQ applies the EQ predicate on the "message_types" field.
+func MessageTypesEQ(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldEQ(FieldMessageTypes, v))
+}
+
+// MessageTypesNEQ applies the NEQ predicate on the "message_types" field.
+func MessageTypesNEQ(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNEQ(FieldMessageTypes, v))
+}
+
+// MessageTypesIn applies the In predicate on the "message_types" field.
+func MessageTypesIn(vs ...int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldIn(FieldMessageTypes, vs...))
+}
+
+// MessageTypesNotIn applies the NotIn predicate on the "message_types" field.
+func MessageTypesNotIn(vs ...int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldNotIn(FieldMessageTypes, vs...))
+}
+
+// MessageTypesGT applies the GT predicate on the "message_types" field.
+func MessageTypesGT(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGT(FieldMessageTypes, v))
+}
+
+// MessageTypesGTE applies the GTE predicate on the "message_types" field.
+func MessageTypesGTE(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldGTE(FieldMessageTypes, v))
+}
+
+// MessageTypesLT applies the LT predicate on the "message_types" field.
+func MessageTypesLT(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLT(FieldMessageTypes, v))
+}
+
+// MessageTypesLTE applies the LTE predicate on the "message_types" field.
+func MessageTypesLTE(v int) predicate.ChatHistories {
+	return predicate.ChatHistories(sql.FieldLTE(FieldMessageTypes, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v int64) predicate.ChatHistories {
 	return predicate.ChatHistories(sql.FieldEQ(FieldCreatedAt, v))
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
 	"github.com/nekomeowww/insights-bot/ent/chathistories"
 )
@@ -118,6 +119,20 @@ func (_c *ChatHistoriesCreate) SetNillableFullName(v *string) *ChatHistoriesCrea
 	return _c
 }
 
+// SetIsBot sets the "is_bot" field.
+func (_c *ChatHistoriesCreate) SetIsBot(v bool) *ChatHistoriesCreate {
+	_c.mutation.SetIsBot(v)
+	return _c
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (_c *ChatHistoriesCreate) SetNillableIsBot(v *bool) *ChatHistoriesCreate {
+	if v != nil {
+		_c.SetIsBot(*v)
+	}
+	return _c
+}
+
 // SetText sets the "text" field.
 func (_c *ChatHistoriesCreate) SetText(v string) *ChatHistoriesCreate {
 	_c.mutation.SetText(v)
@@ -258,6 +273,82 @@ func (_c *ChatHistoriesCreate) SetNillableFromPlatform(v *int) *ChatHistoriesCre
 	return _c
 }
 
+// SetPollID sets the "poll_id" field.
+func (_c *ChatHistoriesCreate) SetPollID(v string) *ChatHistoriesCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_c *ChatHistoriesCreate) SetNillablePollID(v *string) *ChatHistoriesCreate {
+	if v != nil {
+		_c.SetPollID(*v)
+	}
+	return _c
+}
+
+// SetPollQuestion sets the "poll_question" field.
+func (_c *ChatHistoriesCreate) SetPollQuestion(v string) *ChatHistoriesCreate {
+	_c.mutation.SetPollQuestion(v)
+	return _c
+}
+
+// SetNillablePollQuestion sets the "poll_question" field if the given value is not nil.
+func (_c *ChatHistoriesCreate) SetNillablePollQuestion(v *string) *ChatHistoriesCreate {
+	if v != nil {
+		_c.SetPollQuestion(*v)
+	}
+	return _c
+}
+
+// SetPollOptions sets the "poll_options" field.
+func (_c *ChatHistoriesCreate) SetPollOptions(v []tgbotapi.PollOption) *ChatHistoriesCreate {
+	_c.mutation.SetPollOptions(v)
+	return _c
+}
+
+// SetPollTotalVoterCount sets the "poll_total_voter_count" field.
+func (_c *ChatHistoriesCreate) SetPollTotalVoterCount(v int) *ChatHistoriesCreate {
+	_c.mutation.SetPollTotalVoterCount(v)
+	return _c
+}
+
+// SetNillablePollTotalVoterCount sets the "poll_total_voter_count" field if the given value is not nil.
+func (_c *ChatHistoriesCreate) SetNillablePollTotalVoterCount(v *int) *ChatHistoriesCreate {
+	if v != nil {
+		_c.SetPollTotalVoterCount(*v)
+	}
+	return _c
+}
+
+// SetPollIsClosed sets the "poll_is_closed" field.
+func (_c *ChatHistoriesCreate) SetPollIsClosed(v bool) *ChatHistoriesCreate {
+	_c.mutation.SetPollIsClosed(v)
+	return _c
+}
+
+// SetNillablePollIsClosed sets the "poll_is_closed" field if the given value is not nil.
+func (_c *ChatHistoriesCreate) SetNillablePollIsClosed(v *bool) *ChatHistoriesCreate {
+	if v != nil {
+		_c.SetPollIsClosed(*v)
+	}
+	return _c
+}
+
+// SetMessageTypes sets the "message_types" field.
+func (_c *ChatHistoriesCreate) SetMessageTypes(v int) *ChatHistoriesCreate {
+	_c.mutation.SetMessageTypes(v)
+	return _c
+}
+
+// SetNillableMessageTypes sets the "message_types" field if the given value is not nil.
+func (_c *ChatHistoriesCreate) SetNillableMessageTypes(v *int) *ChatHistoriesCreate {
+	if v != nil {
+		_c.SetMessageTypes(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ChatHistoriesCreate) SetCreatedAt(v int64) *ChatHistoriesCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -363,6 +454,10 @@ func (_c *ChatHistoriesCreate) defaults() {
 		v := chathistories.DefaultFullName
 		_c.mutation.SetFullName(v)
 	}
+	if _, ok := _c.mutation.IsBot(); !ok {
+		v := chathistories.DefaultIsBot
+		_c.mutation.SetIsBot(v)
+	}
 	if _, ok := _c.mutation.Text(); !ok {
 		v := chathistories.DefaultText
 		_c.mutation.SetText(v)
@@ -403,6 +498,26 @@ func (_c *ChatHistoriesCreate) defaults() {
 		v := chathistories.DefaultFromPlatform
 		_c.mutation.SetFromPlatform(v)
 	}
+	if _, ok := _c.mutation.PollID(); !ok {
+		v := chathistories.DefaultPollID
+		_c.mutation.SetPollID(v)
+	}
+	if _, ok := _c.mutation.PollQuestion(); !ok {
+		v := chathistories.DefaultPollQuestion
+		_c.mutation.SetPollQuestion(v)
+	}
+	if _, ok := _c.mutation.PollTotalVoterCount(); !ok {
+		v := chathistories.DefaultPollTotalVoterCount
+		_c.mutation.SetPollTotalVoterCount(v)
+	}
+	if _, ok := _c.mutation.PollIsClosed(); !ok {
+		v := chathistories.DefaultPollIsClosed
+		_c.mutation.SetPollIsClosed(v)
+	}
+	if _, ok := _c.mutation.MessageTypes(); !ok {
+		v := chathistories.DefaultMessageTypes
+		_c.mutation.SetMessageTypes(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := chathistories.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -440,6 +555,9 @@ func (_c *ChatHistoriesCreate) check() error {
 	if _, ok := _c.mutation.FullName(); !ok {
 		return &ValidationError{Name: "full_name", err: errors.New(`ent: missing required field "ChatHistories.full_name"`)}
 	}
+	if _, ok := _c.mutation.IsBot(); !ok {
+		return &ValidationError{Name: "is_bot", err: errors.New(`ent: missing required field "ChatHistories.is_bot"`)}
+	}
 	if _, ok := _c.mutation.Text(); !ok {
 		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "ChatHistories.text"`)}
 	}
@@ -470,6 +588,21 @@ func (_c *ChatHistoriesCreate) check() error {
 	if _, ok := _c.mutation.FromPlatform(); !ok {
 		return &ValidationError{Name: "from_platform", err: errors.New(`ent: missing required field "ChatHistories.from_platform"`)}
 	}
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "ChatHistories.poll_id"`)}
+	}
+	if _, ok := _c.mutation.PollQuestion(); !ok {
+		return &ValidationError{Name: "poll_question", err: errors.New(`ent: missing required field "ChatHistories.poll_question"`)}
+	}
+	if _, ok := _c.mutation.PollTotalVoterCount(); !ok {
+		return &ValidationError{Name: "poll_total_voter_count", err: errors.New(`ent: missing required field "ChatHistories.poll_total_voter_count"`)}
+	}
+	if _, ok := _c.mutation.PollIsClosed(); !ok {
+		return &ValidationError{Name: "poll_is_closed", err: errors.New(`ent: missing required field "ChatHistories.poll_is_closed"`)}
+	}
+	if _, ok := _c.mutation.MessageTypes(); !ok {
+		return &ValidationError{Name: "message_types", err: errors.New(`ent: missing required field "ChatHistories.message_types"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatHistories.created_at"`)}
 	}
@@ -540,6 +673,10 @@ func (_c *ChatHistoriesCreate) createSpec() (*ChatHistories, *sqlgraph.CreateSpe
 		_spec.SetField(chathistories.FieldFullName, field.TypeString, value)
 		_node.FullName = value
 	}
+	if value, ok := _c.mutation.IsBot(); ok {
+		_spec.SetField(chathistories.FieldIsBot, field.TypeBool, value)
+		_node.IsBot = value
+	}
 	if value, ok := _c.mutation.Text(); ok {
 		_spec.SetField(chathistories.FieldText, field.TypeString, value)
 		_node.Text = value
@@ -580,6 +717,30 @@ func (_c *ChatHistoriesCreate) createSpec() (*ChatHistories, *sqlgraph.CreateSpe
 		_spec.SetField(chathistories.FieldFromPlatform, field.TypeInt, value)
 		_node.FromPlatform = value
 	}
+	if value, ok := _c.mutation.PollID(); ok {
+		_spec.SetField(chathistories.FieldPollID, field.TypeString, value)
+		_node.PollID = value
+	}
+	if value, ok := _c.mutation.PollQuestion(); ok {
+		_spec.SetField(chathistories.FieldPollQuestion, field.TypeString, value)
+		_node.PollQuestion = value
+	}
+	if value, ok := _c.mutation.PollOptions(); ok {
+		_spec.SetField(chathistories.FieldPollOptions, field.TypeJSON, value)
+		_node.PollOptions = value
+	}
+	if value, ok := _c.mutation.PollTotalVoterCount(); ok {
+		_spec.SetField(chathistories.FieldPollTotalVoterCount, field.TypeInt, value)
+		_node.PollTotalVoterCount = value
+	}
+	if value, ok := _c.mutation.PollIsClosed(); ok {
+		_spec.SetField(chathistories.FieldPollIsClosed, field.TypeBool, value)
+		_node.PollIsClosed = value
+	}
+	if value, ok := _c.mutation.MessageTypes(); ok {
+		_spec.SetField(chathistories.FieldMessageTypes, field.TypeInt, value)
+		_node.MessageTypes = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(chathistories.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/nekomeowww/insights-bot/ent/chathistories"
 	"github.com/nekomeowww/insights-bot/ent/internal"
 	"github.com/nekomeowww/insights-bot/ent/predicate"
@@ -147,6 +149,20 @@ func (_u *ChatHistoriesUpdate) SetNillableFullName(v *string) *ChatHistoriesUpda
 	return _u
 }
 
+// SetIsBot sets the "is_bot" field.
+func (_u *ChatHistoriesUpdate) SetIsBot(v bool) *ChatHistoriesUpdate {
+	_u.mutation.SetIsBot(v)
+	return _u
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (_u *ChatHistoriesUpdate) SetNillableIsBot(v *bool) *ChatHistoriesUpdate {
+	if v != nil {
+		_u.SetIsBot(*v)
+	}
+	return _u
+}
+
 // SetText sets the "text" field.
 func (_u *ChatHistoriesUpdate) SetText(v string) *ChatHistoriesUpdate {
 	_u.mutation.SetText(v)
@@ -315,6 +331,108 @@ func (_u *ChatHistoriesUpdate) AddFromPlatform(v int) *ChatHistoriesUpdate {
 	return _u
 }
 
+// SetPollID sets the "poll_id" field.
+func (_u *ChatHistoriesUpdate) SetPollID(v string) *ChatHistoriesUpdate {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *ChatHistoriesUpdate) SetNillablePollID(v *string) *ChatHistoriesUpdate {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetPollQuestion sets the "poll_question" field.
+func (_u *ChatHistoriesUpdate) SetPollQuestion(v string) *ChatHistoriesUpdate {
+	_u.mutation.SetPollQuestion(v)
+	return _u
+}
+
+// SetNillablePollQuestion sets the "poll_question" field if the given value is not nil.
+func (_u *ChatHistoriesUpdate) SetNillablePollQuestion(v *string) *ChatHistoriesUpdate {
+	if v != nil {
+		_u.SetPollQuestion(*v)
+	}
+	return _u
+}
+
+// SetPollOptions sets the "poll_options" field.
+func (_u *ChatHistoriesUpdate) SetPollOptions(v []tgbotapi.PollOption) *ChatHistoriesUpdate {
+	_u.mutation.SetPollOptions(v)
+	return _u
+}
+
+// AppendPollOptions appends value to the "poll_options" field.
+func (_u *ChatHistoriesUpdate) AppendPollOptions(v []tgbotapi.PollOption) *ChatHistoriesUpdate {
+	_u.mutation.AppendPollOptions(v)
+	return _u
+}
+
+// ClearPollOptions clears the value of the "poll_options" field.
+func (_u *ChatHistoriesUpdate) ClearPollOptions() *ChatHistoriesUpdate {
+	_u.mutation.ClearPollOptions()
+	return _u
+}
+
+// SetPollTotalVoterCount sets the "poll_total_voter_count" field.
+func (_u *ChatHistoriesUpdate) SetPollTotalVoterCount(v int) *ChatHistoriesUpdate {
+	_u.mutation.ResetPollTotalVoterCount()
+	_u.mutation.SetPollTotalVoterCount(v)
+	return _u
+}
+
+// SetNillablePollTotalVoterCount sets the "poll_total_voter_count" field if the given value is not nil.
+func (_u *ChatHistoriesUpdate) SetNillablePollTotalVoterCount(v *int) *ChatHistoriesUpdate {
+	if v != nil {
+		_u.SetPollTotalVoterCount(*v)
+	}
+	return _u
+}
+
+// AddPollTotalVoterCount adds value to the "poll_total_voter_count" field.
+func (_u *ChatHistoriesUpdate) AddPollTotalVoterCount(v int) *ChatHistoriesUpdate {
+	_u.mutation.AddPollTotalVoterCount(v)
+	return _u
+}
+
+// SetPollIsClosed sets the "poll_is_closed" field.
+func (_u *ChatHistoriesUpdate) SetPollIsClosed(v bool) *ChatHistoriesUpdate {
+	_u.mutation.SetPollIsClosed(v)
+	return _u
+}
+
+// SetNillablePollIsClosed sets the "poll_is_closed" field if the given value is not nil.
+func (_u *ChatHistoriesUpdate) SetNillablePollIsClosed(v *bool) *ChatHistoriesUpdate {
+	if v != nil {
+		_u.SetPollIsClosed(*v)
+	}
+	return _u
+}
+
+// SetMessageTypes sets the "message_types" field.
+func (_u *ChatHistoriesUpdate) SetMessageTypes(v int) *ChatHistoriesUpdate {
+	_u.mutation.ResetMessageTypes()
+	_u.mutation.SetMessageTypes(v)
+	return _u
+}
+
+// SetNillableMessageTypes sets the "message_types" field if the given value is not nil.
+func (_u *ChatHistoriesUpdate) SetNillableMessageTypes(v *int) *ChatHistoriesUpdate {
+	if v != nil {
+		_u.SetMessageTypes(*v)
+	}
+	return _u
+}
+
+// AddMessageTypes adds value to the "message_types" field.
+func (_u *ChatHistoriesUpdate) AddMessageTypes(v int) *ChatHistoriesUpdate {
+	_u.mutation.AddMessageTypes(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ChatHistoriesUpdate) SetCreatedAt(v int64) *ChatHistoriesUpdate {
 	_u.mutation.ResetCreatedAt()
@@ -428,6 +546,9 @@ func (_u *ChatHistoriesUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.FullName(); ok {
 		_spec.SetField(chathistories.FieldFullName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.IsBot(); ok {
+		_spec.SetField(chathistories.FieldIsBot, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(chathistories.FieldText, field.TypeString, value)
 	}
@@ -470,6 +591,38 @@ func (_u *ChatHistoriesUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AddedFromPlatform(); ok {
 		_spec.AddField(chathistories.FieldFromPlatform, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.PollID(); ok {
+		_spec.SetField(chathistories.FieldPollID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PollQuestion(); ok {
+		_spec.SetField(chathistories.FieldPollQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PollOptions(); ok {
+		_spec.SetField(chathistories.FieldPollOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPollOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chathistories.FieldPollOptions, value)
+		})
+	}
+	if _u.mutation.PollOptionsCleared() {
+		_spec.ClearField(chathistories.FieldPollOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PollTotalVoterCount(); ok {
+		_spec.SetField(chathistories.FieldPollTotalVoterCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPollTotalVoterCount(); ok {
+		_spec.AddField(chathistories.FieldPollTotalVoterCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PollIsClosed(); ok {
+		_spec.SetField(chathistories.FieldPollIsClosed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MessageTypes(); ok {
+		_spec.SetField(chathistories.FieldMessageTypes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageTypes(); ok {
+		_spec.AddField(chathistories.FieldMessageTypes, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(chathistories.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -623,6 +776,20 @@ func (_u *ChatHistoriesUpdateOne) SetNillableFullName(v *string) *ChatHistoriesU
 	return _u
 }
 
+// SetIsBot sets the "is_bot" field.
+func (_u *ChatHistoriesUpdateOne) SetIsBot(v bool) *ChatHistoriesUpdateOne {
+	_u.mutation.SetIsBot(v)
+	return _u
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (_u *ChatHistoriesUpdateOne) SetNillableIsBot(v *bool) *ChatHistoriesUpdateOne {
+	if v != nil {
+		_u.SetIsBot(*v)
+	}
+	return _u
+}
+
 // SetText sets the "text" field.
 func (_u *ChatHistoriesUpdateOne) SetText(v string) *ChatHistoriesUpdateOne {
 	_u.mutation.SetText(v)
@@ -791,6 +958,108 @@ func (_u *ChatHistoriesUpdateOne) AddFromPlatform(v int) *ChatHistoriesUpdateOne
 	return _u
 }
 
+// SetPollID sets the "poll_id" field.
+func (_u *ChatHistoriesUpdateOne) SetPollID(v string) *ChatHistoriesUpdateOne {
+	_u.mutation.SetPollID(v)
+	return _u
+}
+
+// SetNillablePollID sets the "poll_id" field if the given value is not nil.
+func (_u *ChatHistoriesUpdateOne) SetNillablePollID(v *string) *ChatHistoriesUpdateOne {
+	if v != nil {
+		_u.SetPollID(*v)
+	}
+	return _u
+}
+
+// SetPollQuestion sets the "poll_question" field.
+func (_u *ChatHistoriesUpdateOne) SetPollQuestion(v string) *ChatHistoriesUpdateOne {
+	_u.mutation.SetPollQuestion(v)
+	return _u
+}
+
+// SetNillablePollQuestion sets the "poll_question" field if the given value is not nil.
+func (_u *ChatHistoriesUpdateOne) SetNillablePollQuestion(v *string) *ChatHistoriesUpdateOne {
+	if v != nil {
+		_u.SetPollQuestion(*v)
+	}
+	return _u
+}
+
+// SetPollOptions sets the "poll_options" field.
+func (_u *ChatHistoriesUpdateOne) SetPollOptions(v []tgbotapi.PollOption) *ChatHistoriesUpdateOne {
+	_u.mutation.SetPollOptions(v)
+	return _u
+}
+
+// AppendPollOptions appends value to the "poll_options" field.
+func (_u *ChatHistoriesUpdateOne) AppendPollOptions(v []tgbotapi.PollOption) *ChatHistoriesUpdateOne {
+	_u.mutation.AppendPollOptions(v)
+	return _u
+}
+
+// ClearPollOptions clears the value of the "poll_options" field.
+func (_u *ChatHistoriesUpdateOne) ClearPollOptions() *ChatHistoriesUpdateOne {
+	_u.mutation.ClearPollOptions()
+	return _u
+}
+
+// SetPollTotalVoterCount sets the "poll_total_voter_count" field.
+func (_u *ChatHistoriesUpdateOne) SetPollTotalVoterCount(v int) *ChatHistoriesUpdateOne {
+	_u.mutation.ResetPollTotalVoterCount()
+	_u.mutation.SetPollTotalVoterCount(v)
+	return _u
+}
+
+// SetNillablePollTotalVoterCount sets the "poll_total_voter_count" field if the given value is not nil.
+func (_u *ChatHistoriesUpdateOne) SetNillablePollTotalVoterCount(v *int) *ChatHistoriesUpdateOne {
+	if v != nil {
+		_u.SetPollTotalVoterCount(*v)
+	}
+	return _u
+}
+
+// AddPollTotalVoterCount adds value to the "poll_total_voter_count" field.
+func (_u *ChatHistoriesUpdateOne) AddPollTotalVoterCount(v int) *ChatHistoriesUpdateOne {
+	_u.mutation.AddPollTotalVoterCount(v)
+	return _u
+}
+
+// SetPollIsClosed sets the "poll_is_closed" field.
+func (_u *ChatHistoriesUpdateOne) SetPollIsClosed(v bool) *ChatHistoriesUpdateOne {
+	_u.mutation.SetPollIsClosed(v)
+	return _u
+}
+
+// SetNillablePollIsClosed sets the "poll_is_closed" field if the given value is not nil.
+func (_u *ChatHistoriesUpdateOne) SetNillablePollIsClosed(v *bool) *ChatHistoriesUpdateOne {
+	if v != nil {
+		_u.SetPollIsClosed(*v)
+	}
+	return _u
+}
+
+// SetMessageTypes sets the "message_types" field.
+func (_u *ChatHistoriesUpdateOne) SetMessageTypes(v int) *ChatHistoriesUpdateOne {
+	_u.mutation.ResetMessageTypes()
+	_u.mutation.SetMessageTypes(v)
+	return _u
+}
+
+// SetNillableMessageTypes sets the "message_types" field if the given value is not nil.
+func (_u *ChatHistoriesUpdateOne) SetNillableMessageTypes(v *int) *ChatHistoriesUpdateOne {
+	if v != nil {
+		_u.SetMessageTypes(*v)
+	}
+	return _u
+}
+
+// AddMessageTypes adds value to the "message_types" field.
+func (_u *ChatHistoriesUpdateOne) AddMessageTypes(v int) *ChatHistoriesUpdateOne {
+	_u.mutation.AddMessageTypes(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ChatHistoriesUpdateOne) SetCreatedAt(v int64) *ChatHistoriesUpdateOne {
 	_u.mutation.ResetCreatedAt()
@@ -934,6 +1203,9 @@ func (_u *ChatHistoriesUpdateOne) sqlSave(ctx context.Context) (_node *ChatHisto
 	if value, ok := _u.mutation.FullName(); ok {
 		_spec.SetField(chathistories.FieldFullName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.IsBot(); ok {
+		_spec.SetField(chathistories.FieldIsBot, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(chathistories.FieldText, field.TypeString, value)
 	}
@@ -976,6 +1248,38 @@ func (_u *ChatHistoriesUpdateOne) sqlSave(ctx context.Context) (_node *ChatHisto
 	if value, ok := _u.mutation.AddedFromPlatform(); ok {
 		_spec.AddField(chathistories.FieldFromPlatform, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.PollID(); ok {
+		_spec.SetField(chathistories.FieldPollID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PollQuestion(); ok {
+		_spec.SetField(chathistories.FieldPollQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PollOptions(); ok {
+		_spec.SetField(chathistories.FieldPollOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPollOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chathistories.FieldPollOptions, value)
+		})
+	}
+	if _u.mutation.PollOptionsCleared() {
+		_spec.ClearField(chathistories.FieldPollOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PollTotalVoterCount(); ok {
+		_spec.SetField(chathistories.FieldPollTotalVoterCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPollTotalVoterCount(); ok {
+		_spec.AddField(chathistories.FieldPollTotalVoterCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PollIsClosed(); ok {
+		_spec.SetField(chathistories.FieldPollIsClosed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MessageTypes(); ok {
+		_spec.SetField(chathistories.FieldMessageTypes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageTypes(); ok {
+		_spec.AddField(chathistories.FieldMessageTypes, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(chathistories.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -19,13 +19,16 @@ import (
 	"github.com/nekomeowww/insights-bot/ent/feedbackchathistoriesrecapsreactions"
 	"github.com/nekomeowww/insights-bot/ent/feedbacksummarizationsreactions"
 	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
 	"github.com/nekomeowww/insights-bot/ent/logsummarizations"
 	"github.com/nekomeowww/insights-bot/ent/metricopenaichatcompletiontokenusage"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
 	"github.com/nekomeowww/insights-bot/ent/sentmessages"
 	"github.com/nekomeowww/insights-bot/ent/slackoauthcredentials"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatautorecapssubscribers"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatfeatureflags"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatrecapsoptions"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
 
 	"github.com/nekomeowww/insights-bot/ent/internal"
 )
@@ -43,10 +46,14 @@ type Client struct {
 	FeedbackSummarizationsReactions *FeedbackSummarizationsReactionsClient
 	// LogChatHistoriesRecap is the client for interacting with the LogChatHistoriesRecap builders.
 	LogChatHistoriesRecap *LogChatHistoriesRecapClient
+	// LogChatHistoriesRecapFailures is the client for interacting with the LogChatHistoriesRecapFailures builders.
+	LogChatHistoriesRecapFailures *LogChatHistoriesRecapFailuresClient
 	// LogSummarizations is the client for interacting with the LogSummarizations builders.
 	LogSummarizations *LogSummarizationsClient
 	// MetricOpenAIChatCompletionTokenUsage is the client for interacting with the MetricOpenAIChatCompletionTokenUsage builders.
 	MetricOpenAIChatCompletionTokenUsage *MetricOpenAIChatCompletionTokenUsageClient
+	// RecapFeedback is the client for interacting with the RecapFeedback builders.
+	RecapFeedback *RecapFeedbackClient
 	// SentMessages is the client for interacting with the SentMessages builders.
 	SentMessages *SentMessagesClient
 	// SlackOAuthCredentials is the client for interacting with the SlackOAuthCredentials builders.
@@ -57,6 +64,8 @@ type Client struct {
 	TelegramChatFeatureFlags *TelegramChatFeatureFlagsClient
 	// TelegramChatRecapsOptions is the client for interacting with the TelegramChatRecapsOptions builders.
 	TelegramChatRecapsOptions *TelegramChatRecapsOptionsClient
+	// TelegramUserPreferences is the client for interacting with the TelegramUserPreferences builders.
+	TelegramUserPreferences *TelegramUserPreferencesClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -72,13 +81,16 @@ func (c *Client) init() {
 	c.FeedbackChatHistoriesRecapsReactions = NewFeedbackChatHistoriesRecapsReactionsClient(c.config)
 	c.FeedbackSummarizationsReactions = NewFeedbackSummarizationsReactionsClient(c.config)
 	c.LogChatHistoriesRecap = NewLogChatHistoriesRecapClient(c.config)
+	c.LogChatHistoriesRecapFailures = NewLogChatHistoriesRecapFailuresClient(c.config)
 	c.LogSummarizations = NewLogSummarizationsClient(c.config)
 	c.MetricOpenAIChatCompletionTokenUsage = NewMetricOpenAIChatCompletionTokenUsageClient(c.config)
+	c.RecapFeedback = NewRecapFeedbackClient(c.config)
 	c.SentMessages = NewSentMessagesClient(c.config)
 	c.SlackOAuthCredentials = NewSlackOAuthCredentialsClient(c.config)
 	c.TelegramChatAutoRecapsSubscribers = NewTelegramChatAutoRecapsSubscribersClient(c.config)
 	c.TelegramChatFeatureFlags = NewTelegramChatFeatureFlagsClient(c.config)
 	c.TelegramChatRecapsOptions = NewTelegramChatRecapsOptionsClient(c.config)
+	c.TelegramUserPreferences = NewTelegramUserPreferencesClient(c.config)
 }
 
 type (
@@ -177,13 +189,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		FeedbackChatHistoriesRecapsReactions: NewFeedbackChatHistoriesRecapsReactionsClient(cfg),
 		FeedbackSummarizationsReactions:      NewFeedbackSummarizationsReactionsClient(cfg),
 		LogChatHistoriesRecap:                NewLogChatHistoriesRecapClient(cfg),
+		LogChatHistoriesRecapFailures:        NewLogChatHistoriesRecapFailuresClient(cfg),
 		LogSummarizations:                    NewLogSummarizationsClient(cfg),
 		MetricOpenAIChatCompletionTokenUsage: NewMetricOpenAIChatCompletionTokenUsageClient(cfg),
+		RecapFeedback:                        NewRecapFeedbackClient(cfg),
 		SentMessages:                         NewSentMessagesClient(cfg),
 		SlackOAuthCredentials:                NewSlackOAuthCredentialsClient(cfg),
 		TelegramChatAutoRecapsSubscribers:    NewTelegramChatAutoRecapsSubscribersClient(cfg),
 		TelegramChatFeatureFlags:             NewTelegramChatFeatureFlagsClient(cfg),
 		TelegramChatRecapsOptions:            NewTelegramChatRecapsOptionsClient(cfg),
+		TelegramUserPreferences:              NewTelegramUserPreferencesClient(cfg),
 	}, nil
 }
 
@@ -207,13 +222,16 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		FeedbackChatHistoriesRecapsReactions: NewFeedbackChatHistoriesRecapsReactionsClient(cfg),
 		FeedbackSummarizationsReactions:      NewFeedbackSummarizationsReactionsClient(cfg),
 		LogChatHistoriesRecap:                NewLogChatHistoriesRecapClient(cfg),
+		LogChatHistoriesRecapFailures:        NewLogChatHistoriesRecapFailuresClient(cfg),
 		LogSummarizations:                    NewLogSummarizationsClient(cfg),
 		MetricOpenAIChatCompletionTokenUsage: NewMetricOpenAIChatCompletionTokenUsageClient(cfg),
+		RecapFeedback:                        NewRecapFeedbackClient(cfg),
 		SentMessages:                         NewSentMessagesClient(cfg),
 		SlackOAuthCredentials:                NewSlackOAuthCredentialsClient(cfg),
 		TelegramChatAutoRecapsSubscribers:    NewTelegramChatAutoRecapsSubscribersClient(cfg),
 		TelegramChatFeatureFlags:             NewTelegramChatFeatureFlagsClient(cfg),
 		TelegramChatRecapsOptions:            NewTelegramChatRecapsOptionsClient(cfg),
+		TelegramUserPreferences:              NewTelegramUserPreferencesClient(cfg),
 	}, nil
 }
 
@@ -245,9 +263,11 @@ func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.ChatHistories, c.FeedbackChatHistoriesRecapsReactions,
 		c.FeedbackSummarizationsReactions, c.LogChatHistoriesRecap,
-		c.LogSummarizations, c.MetricOpenAIChatCompletionTokenUsage, c.SentMessages,
+		c.LogChatHistoriesRecapFailures, c.LogSummarizations,
+		c.MetricOpenAIChatCompletionTokenUsage, c.RecapFeedback, c.SentMessages,
 		c.SlackOAuthCredentials, c.TelegramChatAutoRecapsSubscribers,
 		c.TelegramChatFeatureFlags, c.TelegramChatRecapsOptions,
+		c.TelegramUserPreferences,
 	} {
 		n.Use(hooks...)
 	}
@@ -259,9 +279,11 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.ChatHistories, c.FeedbackChatHistoriesRecapsReactions,
 		c.FeedbackSummarizationsReactions, c.LogChatHistoriesRecap,
-		c.LogSummarizations, c.MetricOpenAIChatCompletionTokenUsage, c.SentMessages,
+		c.LogChatHistoriesRecapFailures, c.LogSummarizations,
+		c.MetricOpenAIChatCompletionTokenUsage, c.RecapFeedback, c.SentMessages,
 		c.SlackOAuthCredentials, c.TelegramChatAutoRecapsSubscribers,
 		c.TelegramChatFeatureFlags, c.TelegramChatRecapsOptions,
+		c.TelegramUserPreferences,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -278,10 +300,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FeedbackSummarizationsReactions.mutate(ctx, m)
 	case *LogChatHistoriesRecapMutation:
 		return c.LogChatHistoriesRecap.mutate(ctx, m)
+	case *LogChatHistoriesRecapFailuresMutation:
+		return c.LogChatHistoriesRecapFailures.mutate(ctx, m)
 	case *LogSummarizationsMutation:
 		return c.LogSummarizations.mutate(ctx, m)
 	case *MetricOpenAIChatCompletionTokenUsageMutation:
 		return c.MetricOpenAIChatCompletionTokenUsage.mutate(ctx, m)
+	case *RecapFeedbackMutation:
+		return c.RecapFeedback.mutate(ctx, m)
 	case *SentMessagesMutation:
 		return c.SentMessages.mutate(ctx, m)
 	case *SlackOAuthCredentialsMutation:
@@ -292,6 +318,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.TelegramChatFeatureFlags.mutate(ctx, m)
 	case *TelegramChatRecapsOptionsMutation:
 		return c.TelegramChatRecapsOptions.mutate(ctx, m)
+	case *TelegramUserPreferencesMutation:
+		return c.TelegramUserPreferences.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -829,6 +857,139 @@ func (c *LogChatHistoriesRecapClient) mutate(ctx context.Context, m *LogChatHist
 	}
 }
 
+// LogChatHistoriesRecapFailuresClient is a client for the LogChatHistoriesRecapFailures schema.
+type LogChatHistoriesRecapFailuresClient struct {
+	config
+}
+
+// NewLogChatHistoriesRecapFailuresClient returns a client for the LogChatHistoriesRecapFailures from the given config.
+func NewLogChatHistoriesRecapFailuresClient(c config) *LogChatHistoriesRecapFailuresClient {
+	return &LogChatHistoriesRecapFailuresClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `logchathistoriesrecapfailures.Hooks(f(g(h())))`.
+func (c *LogChatHistoriesRecapFailuresClient) Use(hooks ...Hook) {
+	c.hooks.LogChatHistoriesRecapFailures = append(c.hooks.LogChatHistoriesRecapFailures, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `logchathistoriesrecapfailures.Intercept(f(g(h())))`.
+func (c *LogChatHistoriesRecapFailuresClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LogChatHistoriesRecapFailures = append(c.inters.LogChatHistoriesRecapFailures, interceptors...)
+}
+
+// Create returns a builder for creating a LogChatHistoriesRecapFailures entity.
+func (c *LogChatHistoriesRecapFailuresClient) Create() *LogChatHistoriesRecapFailuresCreate {
+	mutation := newLogChatHistoriesRecapFailuresMutation(c.config, OpCreate)
+	return &LogChatHistoriesRecapFailuresCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LogChatHistoriesRecapFailures entities.
+func (c *LogChatHistoriesRecapFailuresClient) CreateBulk(builders ...*LogChatHistoriesRecapFailuresCreate) *LogChatHistoriesRecapFailuresCreateBulk {
+	return &LogChatHistoriesRecapFailuresCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LogChatHistoriesRecapFailuresClient) MapCreateBulk(slice any, setFunc func(*LogChatHistoriesRecapFailuresCreate, int)) *LogChatHistoriesRecapFailuresCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LogChatHistoriesRecapFailuresCreateBulk{err: fmt.Errorf("calling to LogChatHistoriesRecapFailuresClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LogChatHistoriesRecapFailuresCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LogChatHistoriesRecapFailuresCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LogChatHistoriesRecapFailures.
+func (c *LogChatHistoriesRecapFailuresClient) Update() *LogChatHistoriesRecapFailuresUpdate {
+	mutation := newLogChatHistoriesRecapFailuresMutation(c.config, OpUpdate)
+	return &LogChatHistoriesRecapFailuresUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LogChatHistoriesRecapFailuresClient) UpdateOne(_m *LogChatHistoriesRecapFailures) *LogChatHistoriesRecapFailuresUpdateOne {
+	mutation := newLogChatHistoriesRecapFailuresMutation(c.config, OpUpdateOne, withLogChatHistoriesRecapFailures(_m))
+	return &LogChatHistoriesRecapFailuresUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LogChatHistoriesRecapFailuresClient) UpdateOneID(id uuid.UUID) *LogChatHistoriesRecapFailuresUpdateOne {
+	mutation := newLogChatHistoriesRecapFailuresMutation(c.config, OpUpdateOne, withLogChatHistoriesRecapFailuresID(id))
+	return &LogChatHistoriesRecapFailuresUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LogChatHistoriesRecapFailures.
+func (c *LogChatHistoriesRecapFailuresClient) Delete() *LogChatHistoriesRecapFailuresDelete {
+	mutation := newLogChatHistoriesRecapFailuresMutation(c.config, OpDelete)
+	return &LogChatHistoriesRecapFailuresDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LogChatHistoriesRecapFailuresClient) DeleteOne(_m *LogChatHistoriesRecapFailures) *LogChatHistoriesRecapFailuresDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LogChatHistoriesRecapFailuresClient) DeleteOneID(id uuid.UUID) *LogChatHistoriesRecapFailuresDeleteOne {
+	builder := c.Delete().Where(logchathistoriesrecapfailures.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LogChatHistoriesRecapFailuresDeleteOne{builder}
+}
+
+// Query returns a query builder for LogChatHistoriesRecapFailures.
+func (c *LogChatHistoriesRecapFailuresClient) Query() *LogChatHistoriesRecapFailuresQuery {
+	return &LogChatHistoriesRecapFailuresQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLogChatHistoriesRecapFailures},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LogChatHistoriesRecapFailures entity by its id.
+func (c *LogChatHistoriesRecapFailuresClient) Get(ctx context.Context, id uuid.UUID) (*LogChatHistoriesRecapFailures, error) {
+	return c.Query().Where(logchathistoriesrecapfailures.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LogChatHistoriesRecapFailuresClient) GetX(ctx context.Context, id uuid.UUID) *LogChatHistoriesRecapFailures {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LogChatHistoriesRecapFailuresClient) Hooks() []Hook {
+	return c.hooks.LogChatHistoriesRecapFailures
+}
+
+// Interceptors returns the client interceptors.
+func (c *LogChatHistoriesRecapFailuresClient) Interceptors() []Interceptor {
+	return c.inters.LogChatHistoriesRecapFailures
+}
+
+func (c *LogChatHistoriesRecapFailuresClient) mutate(ctx context.Context, m *LogChatHistoriesRecapFailuresMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LogChatHistoriesRecapFailuresCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LogChatHistoriesRecapFailuresUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LogChatHistoriesRecapFailuresUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LogChatHistoriesRecapFailuresDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LogChatHistoriesRecapFailures mutation op: %q", m.Op())
+	}
+}
+
 // LogSummarizationsClient is a client for the LogSummarizations schema.
 type LogSummarizationsClient struct {
 	config
@@ -1095,6 +1256,139 @@ func (c *MetricOpenAIChatCompletionTokenUsageClient) mutate(ctx context.Context,
 	}
 }
 
+// RecapFeedbackClient is a client for the RecapFeedback schema.
+type RecapFeedbackClient struct {
+	config
+}
+
+// NewRecapFeedbackClient returns a client for the RecapFeedback from the given config.
+func NewRecapFeedbackClient(c config) *RecapFeedbackClient {
+	return &RecapFeedbackClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recapfeedback.Hooks(f(g(h())))`.
+func (c *RecapFeedbackClient) Use(hooks ...Hook) {
+	c.hooks.RecapFeedback = append(c.hooks.RecapFeedback, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recapfeedback.Intercept(f(g(h())))`.
+func (c *RecapFeedbackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecapFeedback = append(c.inters.RecapFeedback, interceptors...)
+}
+
+// Create returns a builder for creating a RecapFeedback entity.
+func (c *RecapFeedbackClient) Create() *RecapFeedbackCreate {
+	mutation := newRecapFeedbackMutation(c.config, OpCreate)
+	return &RecapFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecapFeedback entities.
+func (c *RecapFeedbackClient) CreateBulk(builders ...*RecapFeedbackCreate) *RecapFeedbackCreateBulk {
+	return &RecapFeedbackCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecapFeedbackClient) MapCreateBulk(slice any, setFunc func(*RecapFeedbackCreate, int)) *RecapFeedbackCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecapFeedbackCreateBulk{err: fmt.Errorf("calling to RecapFeedbackClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecapFeedbackCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecapFeedbackCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecapFeedback.
+func (c *RecapFeedbackClient) Update() *RecapFeedbackUpdate {
+	mutation := newRecapFeedbackMutation(c.config, OpUpdate)
+	return &RecapFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecapFeedbackClient) UpdateOne(_m *RecapFeedback) *RecapFeedbackUpdateOne {
+	mutation := newRecapFeedbackMutation(c.config, OpUpdateOne, withRecapFeedback(_m))
+	return &RecapFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecapFeedbackClient) UpdateOneID(id uuid.UUID) *RecapFeedbackUpdateOne {
+	mutation := newRecapFeedbackMutation(c.config, OpUpdateOne, withRecapFeedbackID(id))
+	return &RecapFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecapFeedback.
+func (c *RecapFeedbackClient) Delete() *RecapFeedbackDelete {
+	mutation := newRecapFeedbackMutation(c.config, OpDelete)
+	return &RecapFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecapFeedbackClient) DeleteOne(_m *RecapFeedback) *RecapFeedbackDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecapFeedbackClient) DeleteOneID(id uuid.UUID) *RecapFeedbackDeleteOne {
+	builder := c.Delete().Where(recapfeedback.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecapFeedbackDeleteOne{builder}
+}
+
+// Query returns a query builder for RecapFeedback.
+func (c *RecapFeedbackClient) Query() *RecapFeedbackQuery {
+	return &RecapFeedbackQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecapFeedback},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecapFeedback entity by its id.
+func (c *RecapFeedbackClient) Get(ctx context.Context, id uuid.UUID) (*RecapFeedback, error) {
+	return c.Query().Where(recapfeedback.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecapFeedbackClient) GetX(ctx context.Context, id uuid.UUID) *RecapFeedback {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RecapFeedbackClient) Hooks() []Hook {
+	return c.hooks.RecapFeedback
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecapFeedbackClient) Interceptors() []Interceptor {
+	return c.inters.RecapFeedback
+}
+
+func (c *RecapFeedbackClient) mutate(ctx context.Context, m *RecapFeedbackMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecapFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecapFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecapFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecapFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecapFeedback mutation op: %q", m.Op())
+	}
+}
+
 // SentMessagesClient is a client for the SentMessages schema.
 type SentMessagesClient struct {
 	config
@@ -1760,21 +2054,158 @@ func (c *TelegramChatRecapsOptionsClient) mutate(ctx context.Context, m *Telegra
 	}
 }
 
+// TelegramUserPreferencesClient is a client for the TelegramUserPreferences schema.
+type TelegramUserPreferencesClient struct {
+	config
+}
+
+// NewTelegramUserPreferencesClient returns a client for the TelegramUserPreferences from the given config.
+func NewTelegramUserPreferencesClient(c config) *TelegramUserPreferencesClient {
+	return &TelegramUserPreferencesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `telegramuserpreferences.Hooks(f(g(h())))`.
+func (c *TelegramUserPreferencesClient) Use(hooks ...Hook) {
+	c.hooks.TelegramUserPreferences = append(c.hooks.TelegramUserPreferences, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `telegramuserpreferences.Intercept(f(g(h())))`.
+func (c *TelegramUserPreferencesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TelegramUserPreferences = append(c.inters.TelegramUserPreferences, interceptors...)
+}
+
+// Create returns a builder for creating a TelegramUserPreferences entity.
+func (c *TelegramUserPreferencesClient) Create() *TelegramUserPreferencesCreate {
+	mutation := newTelegramUserPreferencesMutation(c.config, OpCreate)
+	return &TelegramUserPreferencesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TelegramUserPreferences entities.
+func (c *TelegramUserPreferencesClient) CreateBulk(builders ...*TelegramUserPreferencesCreate) *TelegramUserPreferencesCreateBulk {
+	return &TelegramUserPreferencesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TelegramUserPreferencesClient) MapCreateBulk(slice any, setFunc func(*TelegramUserPreferencesCreate, int)) *TelegramUserPreferencesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TelegramUserPreferencesCreateBulk{err: fmt.Errorf("calling to TelegramUserPreferencesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TelegramUserPreferencesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TelegramUserPreferencesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TelegramUserPreferences.
+func (c *TelegramUserPreferencesClient) Update() *TelegramUserPreferencesUpdate {
+	mutation := newTelegramUserPreferencesMutation(c.config, OpUpdate)
+	return &TelegramUserPreferencesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TelegramUserPreferencesClient) UpdateOne(_m *TelegramUserPreferences) *TelegramUserPreferencesUpdateOne {
+	mutation := newTelegramUserPreferencesMutation(c.config, OpUpdateOne, withTelegramUserPreferences(_m))
+	return &TelegramUserPreferencesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TelegramUserPreferencesClient) UpdateOneID(id uuid.UUID) *TelegramUserPreferencesUpdateOne {
+	mutation := newTelegramUserPreferencesMutation(c.config, OpUpdateOne, withTelegramUserPreferencesID(id))
+	return &TelegramUserPreferencesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TelegramUserPreferences.
+func (c *TelegramUserPreferencesClient) Delete() *TelegramUserPreferencesDelete {
+	mutation := newTelegramUserPreferencesMutation(c.config, OpDelete)
+	return &TelegramUserPreferencesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TelegramUserPreferencesClient) DeleteOne(_m *TelegramUserPreferences) *TelegramUserPreferencesDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TelegramUserPreferencesClient) DeleteOneID(id uuid.UUID) *TelegramUserPreferencesDeleteOne {
+	builder := c.Delete().Where(telegramuserpreferences.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TelegramUserPreferencesDeleteOne{builder}
+}
+
+// Query returns a query builder for TelegramUserPreferences.
+func (c *TelegramUserPreferencesClient) Query() *TelegramUserPreferencesQuery {
+	return &TelegramUserPreferencesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTelegramUserPreferences},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TelegramUserPreferences entity by its id.
+func (c *TelegramUserPreferencesClient) Get(ctx context.Context, id uuid.UUID) (*TelegramUserPreferences, error) {
+	return c.Query().Where(telegramuserpreferences.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TelegramUserPreferencesClient) GetX(ctx context.Context, id uuid.UUID) *TelegramUserPreferences {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TelegramUserPreferencesClient) Hooks() []Hook {
+	return c.hooks.TelegramUserPreferences
+}
+
+// Interceptors returns the client interceptors.
+func (c *TelegramUserPreferencesClient) Interceptors() []Interceptor {
+	return c.inters.TelegramUserPreferences
+}
+
+func (c *TelegramUserPreferencesClient) mutate(ctx context.Context, m *TelegramUserPreferencesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TelegramUserPreferencesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TelegramUserPreferencesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TelegramUserPreferencesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TelegramUserPreferencesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TelegramUserPreferences mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
 		ChatHistories, FeedbackChatHistoriesRecapsReactions,
-		FeedbackSummarizationsReactions, LogChatHistoriesRecap, LogSummarizations,
-		MetricOpenAIChatCompletionTokenUsage, SentMessages, SlackOAuthCredentials,
-		TelegramChatAutoRecapsSubscribers, TelegramChatFeatureFlags,
-		TelegramChatRecapsOptions []ent.Hook
+		FeedbackSummarizationsReactions, LogChatHistoriesRecap,
+		LogChatHistoriesRecapFailures, LogSummarizations,
+		MetricOpenAIChatCompletionTokenUsage, RecapFeedback, SentMessages,
+		SlackOAuthCredentials, TelegramChatAutoRecapsSubscribers,
+		TelegramChatFeatureFlags, TelegramChatRecapsOptions,
+		TelegramUserPreferences []ent.Hook
 	}
 	inters struct {
 		ChatHistories, FeedbackChatHistoriesRecapsReactions,
-		FeedbackSummarizationsReactions, LogChatHistoriesRecap, LogSummarizations,
-		MetricOpenAIChatCompletionTokenUsage, SentMessages, SlackOAuthCredentials,
-		TelegramChatAutoRecapsSubscribers, TelegramChatFeatureFlags,
-		TelegramChatRecapsOptions []ent.Interceptor
+		FeedbackSummarizationsReactions, LogChatHistoriesRecap,
+		LogChatHistoriesRecapFailures, LogSummarizations,
+		MetricOpenAIChatCompletionTokenUsage, RecapFeedback, SentMessages,
+		SlackOAuthCredentials, TelegramChatAutoRecapsSubscribers,
+		TelegramChatFeatureFlags, TelegramChatRecapsOptions,
+		TelegramUserPreferences []ent.Interceptor
 	}
 )
 
@@ -16,13 +16,16 @@ import (
 	"github.com/nekomeowww/insights-bot/ent/feedbackchathistoriesrecapsreactions"
 	"github.com/nekomeowww/insights-bot/ent/feedbacksummarizationsreactions"
 	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
 	"github.com/nekomeowww/insights-bot/ent/logsummarizations"
 	"github.com/nekomeowww/insights-bot/ent/metricopenaichatcompletiontokenusage"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
 	"github.com/nekomeowww/insights-bot/ent/sentmessages"
 	"github.com/nekomeowww/insights-bot/ent/slackoauthcredentials"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatautorecapssubscribers"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatfeatureflags"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatrecapsoptions"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -87,13 +90,16 @@ func checkColumn(t, c string) error {
 			feedbackchathistoriesrecapsreactions.Table: feedbackchathistoriesrecapsreactions.ValidColumn,
 			feedbacksummarizationsreactions.Table:      feedbacksummarizationsreactions.ValidColumn,
 			logchathistoriesrecap.Table:                logchathistoriesrecap.ValidColumn,
+			logchathistoriesrecapfailures.Table:        logchathistoriesrecapfailures.ValidColumn,
 			logsummarizations.Table:                    logsummarizations.ValidColumn,
 			metricopenaichatcompletiontokenusage.Table: metricopenaichatcompletiontokenusage.ValidColumn,
+			recapfeedback.Table:                        recapfeedback.ValidColumn,
 			sentmessages.Table:                         sentmessages.ValidColumn,
 			slackoauthcredentials.Table:                slackoauthcredentials.ValidColumn,
 			telegramchatautorecapssubscribers.Table:    telegramchatautorecapssubscribers.ValidColumn,
 			telegramchatfeatureflags.Table:             telegramchatfeatureflags.ValidColumn,
 			telegramchatrecapsoptions.Table:            telegramchatrecapsoptions.ValidColumn,
+			telegramuserpreferences.Table:              telegramuserpreferences.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
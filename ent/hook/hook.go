@@ -57,6 +57,18 @@ func (f LogChatHistoriesRecapFunc) Mutate(ctx context.Context, m ent.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LogChatHistoriesRecapMutation", m)
 }
 
+// The LogChatHistoriesRecapFailuresFunc type is an adapter to allow the use of ordinary
+// function as LogChatHistoriesRecapFailures mutator.
+type LogChatHistoriesRecapFailuresFunc func(context.Context, *ent.LogChatHistoriesRecapFailuresMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LogChatHistoriesRecapFailuresFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LogChatHistoriesRecapFailuresMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LogChatHistoriesRecapFailuresMutation", m)
+}
+
 // The LogSummarizationsFunc type is an adapter to allow the use of ordinary
 // function as LogSummarizations mutator.
 type LogSummarizationsFunc func(context.Context, *ent.LogSummarizationsMutation) (ent.Value, error)
@@ -81,6 +93,18 @@ func (f MetricOpenAIChatCompletionTokenUsageFunc) Mutate(ctx context.Context, m
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MetricOpenAIChatCompletionTokenUsageMutation", m)
 }
 
+// The RecapFeedbackFunc type is an adapter to allow the use of ordinary
+// function as RecapFeedback mutator.
+type RecapFeedbackFunc func(context.Context, *ent.RecapFeedbackMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecapFeedbackFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecapFeedbackMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecapFeedbackMutation", m)
+}
+
 // The SentMessagesFunc type is an adapter to allow the use of ordinary
 // function as SentMessages mutator.
 type SentMessagesFunc func(context.Context, *ent.SentMessagesMutation) (ent.Value, error)
@@ -141,6 +165,18 @@ func (f TelegramChatRecapsOptionsFunc) Mutate(ctx context.Context, m ent.Mutatio
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TelegramChatRecapsOptionsMutation", m)
 }
 
+// The TelegramUserPreferencesFunc type is an adapter to allow the use of ordinary
+// function as TelegramUserPreferences mutator.
+type TelegramUserPreferencesFunc func(context.Context, *ent.TelegramUserPreferencesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TelegramUserPreferencesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TelegramUserPreferencesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TelegramUserPreferencesMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
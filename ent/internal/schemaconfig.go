@@ -11,13 +11,16 @@ type SchemaConfig struct {
 	FeedbackChatHistoriesRecapsReactions string // FeedbackChatHistoriesRecapsReactions table.
 	FeedbackSummarizationsReactions      string // FeedbackSummarizationsReactions table.
 	LogChatHistoriesRecap                string // LogChatHistoriesRecap table.
+	LogChatHistoriesRecapFailures        string // LogChatHistoriesRecapFailures table.
 	LogSummarizations                    string // LogSummarizations table.
 	MetricOpenAIChatCompletionTokenUsage string // MetricOpenAIChatCompletionTokenUsage table.
+	RecapFeedback                        string // RecapFeedback table.
 	SentMessages                         string // SentMessages table.
 	SlackOAuthCredentials                string // SlackOAuthCredentials table.
 	TelegramChatAutoRecapsSubscribers    string // TelegramChatAutoRecapsSubscribers table.
 	TelegramChatFeatureFlags             string // TelegramChatFeatureFlags table.
 	TelegramChatRecapsOptions            string // TelegramChatRecapsOptions table.
+	TelegramUserPreferences              string // TelegramUserPreferences table.
 }
 
 type schemaCtxKey struct{}
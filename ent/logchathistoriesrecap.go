@@ -35,6 +35,10 @@ type LogChatHistoriesRecap struct {
 	RecapType int `json:"recap_type,omitempty"`
 	// ModelName holds the value of the "model_name" field.
 	ModelName string `json:"model_name,omitempty"`
+	// WindowHours holds the value of the "window_hours" field.
+	WindowHours int `json:"window_hours,omitempty"`
+	// IsAutoRecap holds the value of the "is_auto_recap" field.
+	IsAutoRecap bool `json:"is_auto_recap,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -47,7 +51,9 @@ func (*LogChatHistoriesRecap) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case logchathistoriesrecap.FieldChatID, logchathistoriesrecap.FieldFromPlatform, logchathistoriesrecap.FieldPromptTokenUsage, logchathistoriesrecap.FieldCompletionTokenUsage, logchathistoriesrecap.FieldTotalTokenUsage, logchathistoriesrecap.FieldRecapType, logchathistoriesrecap.FieldCreatedAt, logchathistoriesrecap.FieldUpdatedAt:
+		case logchathistoriesrecap.FieldIsAutoRecap:
+			values[i] = new(sql.NullBool)
+		case logchathistoriesrecap.FieldChatID, logchathistoriesrecap.FieldFromPlatform, logchathistoriesrecap.FieldPromptTokenUsage, logchathistoriesrecap.FieldCompletionTokenUsage, logchathistoriesrecap.FieldTotalTokenUsage, logchathistoriesrecap.FieldRecapType, logchathistoriesrecap.FieldWindowHours, logchathistoriesrecap.FieldCreatedAt, logchathistoriesrecap.FieldUpdatedAt:
 			values[i] = new(sql.NullInt64)
 		case logchathistoriesrecap.FieldRecapInputs, logchathistoriesrecap.FieldRecapOutputs, logchathistoriesrecap.FieldModelName:
 			values[i] = new(sql.NullString)
@@ -128,6 +134,18 @@ func (_m *LogChatHistoriesRecap) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.ModelName = value.String
 			}
+		case logchathistoriesrecap.FieldWindowHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field window_hours", values[i])
+			} else if value.Valid {
+				_m.WindowHours = int(value.Int64)
+			}
+		case logchathistoriesrecap.FieldIsAutoRecap:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_auto_recap", values[i])
+			} else if value.Valid {
+				_m.IsAutoRecap = value.Bool
+			}
 		case logchathistoriesrecap.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -203,6 +221,12 @@ func (_m *LogChatHistoriesRecap) String() string {
 	builder.WriteString("model_name=")
 	builder.WriteString(_m.ModelName)
 	builder.WriteString(", ")
+	builder.WriteString("window_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WindowHours))
+	builder.WriteString(", ")
+	builder.WriteString("is_auto_recap=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsAutoRecap))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
 	builder.WriteString(", ")
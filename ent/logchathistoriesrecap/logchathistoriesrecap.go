@@ -30,6 +30,10 @@ const (
 	FieldRecapType = "recap_type"
 	// FieldModelName holds the string denoting the model_name field in the database.
 	FieldModelName = "model_name"
+	// FieldWindowHours holds the string denoting the window_hours field in the database.
+	FieldWindowHours = "window_hours"
+	// FieldIsAutoRecap holds the string denoting the is_auto_recap field in the database.
+	FieldIsAutoRecap = "is_auto_recap"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -50,6 +54,8 @@ var Columns = []string{
 	FieldTotalTokenUsage,
 	FieldRecapType,
 	FieldModelName,
+	FieldWindowHours,
+	FieldIsAutoRecap,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -83,6 +89,10 @@ var (
 	DefaultRecapType int
 	// DefaultModelName holds the default value on creation for the "model_name" field.
 	DefaultModelName string
+	// DefaultWindowHours holds the default value on creation for the "window_hours" field.
+	DefaultWindowHours int
+	// DefaultIsAutoRecap holds the default value on creation for the "is_auto_recap" field.
+	DefaultIsAutoRecap bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() int64
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -144,6 +154,16 @@ func ByModelName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModelName, opts...).ToFunc()
 }
 
+// ByWindowHours orders the results by the window_hours field.
+func ByWindowHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowHours, opts...).ToFunc()
+}
+
+// ByIsAutoRecap orders the results by the is_auto_recap field.
+func ByIsAutoRecap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsAutoRecap, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
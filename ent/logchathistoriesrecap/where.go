@@ -98,6 +98,16 @@ func ModelName(v string) predicate.LogChatHistoriesRecap {
 	return predicate.LogChatHistoriesRecap(sql.FieldEQ(FieldModelName, v))
 }
 
+// WindowHours applies equality check predicate on the "window_hours" field. It's identical to WindowHoursEQ.
+func WindowHours(v int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldEQ(FieldWindowHours, v))
+}
+
+// IsAutoRecap applies equality check predicate on the "is_auto_recap" field. It's identical to IsAutoRecapEQ.
+func IsAutoRecap(v bool) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldEQ(FieldIsAutoRecap, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.LogChatHistoriesRecap {
 	return predicate.LogChatHistoriesRecap(sql.FieldEQ(FieldCreatedAt, v))
@@ -543,6 +553,56 @@ func ModelNameContainsFold(v string) predicate.LogChatHistoriesRecap {
 	return predicate.LogChatHistoriesRecap(sql.FieldContainsFold(FieldModelName, v))
 }
 
+// WindowHoursEQ applies the EQ predicate on the "window_hours" field.
+func WindowHoursEQ(v int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldEQ(FieldWindowHours, v))
+}
+
+// WindowHoursNEQ applies the NEQ predicate on the "window_hours" field.
+func WindowHoursNEQ(v int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldNEQ(FieldWindowHours, v))
+}
+
+// WindowHoursIn applies the In predicate on the "window_hours" field.
+func WindowHoursIn(vs ...int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldIn(FieldWindowHours, vs...))
+}
+
+// WindowHoursNotIn applies the NotIn predicate on the "window_hours" field.
+func WindowHoursNotIn(vs ...int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldNotIn(FieldWindowHours, vs...))
+}
+
+// WindowHoursGT applies the GT predicate on the "window_hours" field.
+func WindowHoursGT(v int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldGT(FieldWindowHours, v))
+}
+
+// WindowHoursGTE applies the GTE predicate on the "window_hours" field.
+func WindowHoursGTE(v int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldGTE(FieldWindowHours, v))
+}
+
+// WindowHoursLT applies the LT predicate on the "window_hours" field.
+func WindowHoursLT(v int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldLT(FieldWindowHours, v))
+}
+
+// WindowHoursLTE applies the LTE predicate on the "window_hours" field.
+func WindowHoursLTE(v int) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldLTE(FieldWindowHours, v))
+}
+
+// IsAutoRecapEQ applies the EQ predicate on the "is_auto_recap" field.
+func IsAutoRecapEQ(v bool) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldEQ(FieldIsAutoRecap, v))
+}
+
+// IsAutoRecapNEQ applies the NEQ predicate on the "is_auto_recap" field.
+func IsAutoRecapNEQ(v bool) predicate.LogChatHistoriesRecap {
+	return predicate.LogChatHistoriesRecap(sql.FieldNEQ(FieldIsAutoRecap, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v int64) predicate.LogChatHistoriesRecap {
 	return predicate.LogChatHistoriesRecap(sql.FieldEQ(FieldCreatedAt, v))
@@ -146,6 +146,34 @@ func (_c *LogChatHistoriesRecapCreate) SetNillableModelName(v *string) *LogChatH
 	return _c
 }
 
+// SetWindowHours sets the "window_hours" field.
+func (_c *LogChatHistoriesRecapCreate) SetWindowHours(v int) *LogChatHistoriesRecapCreate {
+	_c.mutation.SetWindowHours(v)
+	return _c
+}
+
+// SetNillableWindowHours sets the "window_hours" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapCreate) SetNillableWindowHours(v *int) *LogChatHistoriesRecapCreate {
+	if v != nil {
+		_c.SetWindowHours(*v)
+	}
+	return _c
+}
+
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (_c *LogChatHistoriesRecapCreate) SetIsAutoRecap(v bool) *LogChatHistoriesRecapCreate {
+	_c.mutation.SetIsAutoRecap(v)
+	return _c
+}
+
+// SetNillableIsAutoRecap sets the "is_auto_recap" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapCreate) SetNillableIsAutoRecap(v *bool) *LogChatHistoriesRecapCreate {
+	if v != nil {
+		_c.SetIsAutoRecap(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *LogChatHistoriesRecapCreate) SetCreatedAt(v int64) *LogChatHistoriesRecapCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -259,6 +287,14 @@ func (_c *LogChatHistoriesRecapCreate) defaults() {
 		v := logchathistoriesrecap.DefaultModelName
 		_c.mutation.SetModelName(v)
 	}
+	if _, ok := _c.mutation.WindowHours(); !ok {
+		v := logchathistoriesrecap.DefaultWindowHours
+		_c.mutation.SetWindowHours(v)
+	}
+	if _, ok := _c.mutation.IsAutoRecap(); !ok {
+		v := logchathistoriesrecap.DefaultIsAutoRecap
+		_c.mutation.SetIsAutoRecap(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := logchathistoriesrecap.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -302,6 +338,12 @@ func (_c *LogChatHistoriesRecapCreate) check() error {
 	if _, ok := _c.mutation.ModelName(); !ok {
 		return &ValidationError{Name: "model_name", err: errors.New(`ent: missing required field "LogChatHistoriesRecap.model_name"`)}
 	}
+	if _, ok := _c.mutation.WindowHours(); !ok {
+		return &ValidationError{Name: "window_hours", err: errors.New(`ent: missing required field "LogChatHistoriesRecap.window_hours"`)}
+	}
+	if _, ok := _c.mutation.IsAutoRecap(); !ok {
+		return &ValidationError{Name: "is_auto_recap", err: errors.New(`ent: missing required field "LogChatHistoriesRecap.is_auto_recap"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LogChatHistoriesRecap.created_at"`)}
 	}
@@ -380,6 +422,14 @@ func (_c *LogChatHistoriesRecapCreate) createSpec() (*LogChatHistoriesRecap, *sq
 		_spec.SetField(logchathistoriesrecap.FieldModelName, field.TypeString, value)
 		_node.ModelName = value
 	}
+	if value, ok := _c.mutation.WindowHours(); ok {
+		_spec.SetField(logchathistoriesrecap.FieldWindowHours, field.TypeInt, value)
+		_node.WindowHours = value
+	}
+	if value, ok := _c.mutation.IsAutoRecap(); ok {
+		_spec.SetField(logchathistoriesrecap.FieldIsAutoRecap, field.TypeBool, value)
+		_node.IsAutoRecap = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(logchathistoriesrecap.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
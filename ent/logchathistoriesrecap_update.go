@@ -196,6 +196,41 @@ func (_u *LogChatHistoriesRecapUpdate) SetNillableModelName(v *string) *LogChatH
 	return _u
 }
 
+// SetWindowHours sets the "window_hours" field.
+func (_u *LogChatHistoriesRecapUpdate) SetWindowHours(v int) *LogChatHistoriesRecapUpdate {
+	_u.mutation.ResetWindowHours()
+	_u.mutation.SetWindowHours(v)
+	return _u
+}
+
+// SetNillableWindowHours sets the "window_hours" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapUpdate) SetNillableWindowHours(v *int) *LogChatHistoriesRecapUpdate {
+	if v != nil {
+		_u.SetWindowHours(*v)
+	}
+	return _u
+}
+
+// AddWindowHours adds value to the "window_hours" field.
+func (_u *LogChatHistoriesRecapUpdate) AddWindowHours(v int) *LogChatHistoriesRecapUpdate {
+	_u.mutation.AddWindowHours(v)
+	return _u
+}
+
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (_u *LogChatHistoriesRecapUpdate) SetIsAutoRecap(v bool) *LogChatHistoriesRecapUpdate {
+	_u.mutation.SetIsAutoRecap(v)
+	return _u
+}
+
+// SetNillableIsAutoRecap sets the "is_auto_recap" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapUpdate) SetNillableIsAutoRecap(v *bool) *LogChatHistoriesRecapUpdate {
+	if v != nil {
+		_u.SetIsAutoRecap(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *LogChatHistoriesRecapUpdate) SetCreatedAt(v int64) *LogChatHistoriesRecapUpdate {
 	_u.mutation.ResetCreatedAt()
@@ -324,6 +359,15 @@ func (_u *LogChatHistoriesRecapUpdate) sqlSave(ctx context.Context) (_node int,
 	if value, ok := _u.mutation.ModelName(); ok {
 		_spec.SetField(logchathistoriesrecap.FieldModelName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.WindowHours(); ok {
+		_spec.SetField(logchathistoriesrecap.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWindowHours(); ok {
+		_spec.AddField(logchathistoriesrecap.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsAutoRecap(); ok {
+		_spec.SetField(logchathistoriesrecap.FieldIsAutoRecap, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(logchathistoriesrecap.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -526,6 +570,41 @@ func (_u *LogChatHistoriesRecapUpdateOne) SetNillableModelName(v *string) *LogCh
 	return _u
 }
 
+// SetWindowHours sets the "window_hours" field.
+func (_u *LogChatHistoriesRecapUpdateOne) SetWindowHours(v int) *LogChatHistoriesRecapUpdateOne {
+	_u.mutation.ResetWindowHours()
+	_u.mutation.SetWindowHours(v)
+	return _u
+}
+
+// SetNillableWindowHours sets the "window_hours" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapUpdateOne) SetNillableWindowHours(v *int) *LogChatHistoriesRecapUpdateOne {
+	if v != nil {
+		_u.SetWindowHours(*v)
+	}
+	return _u
+}
+
+// AddWindowHours adds value to the "window_hours" field.
+func (_u *LogChatHistoriesRecapUpdateOne) AddWindowHours(v int) *LogChatHistoriesRecapUpdateOne {
+	_u.mutation.AddWindowHours(v)
+	return _u
+}
+
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (_u *LogChatHistoriesRecapUpdateOne) SetIsAutoRecap(v bool) *LogChatHistoriesRecapUpdateOne {
+	_u.mutation.SetIsAutoRecap(v)
+	return _u
+}
+
+// SetNillableIsAutoRecap sets the "is_auto_recap" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapUpdateOne) SetNillableIsAutoRecap(v *bool) *LogChatHistoriesRecapUpdateOne {
+	if v != nil {
+		_u.SetIsAutoRecap(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *LogChatHistoriesRecapUpdateOne) SetCreatedAt(v int64) *LogChatHistoriesRecapUpdateOne {
 	_u.mutation.ResetCreatedAt()
@@ -684,6 +763,15 @@ func (_u *LogChatHistoriesRecapUpdateOne) sqlSave(ctx context.Context) (_node *L
 	if value, ok := _u.mutation.ModelName(); ok {
 		_spec.SetField(logchathistoriesrecap.FieldModelName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.WindowHours(); ok {
+		_spec.SetField(logchathistoriesrecap.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWindowHours(); ok {
+		_spec.AddField(logchathistoriesrecap.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsAutoRecap(); ok {
+		_spec.SetField(logchathistoriesrecap.FieldIsAutoRecap, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(logchathistoriesrecap.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
+)
+
+// LogChatHistoriesRecapFailures is the model entity for the LogChatHistoriesRecapFailures schema.
+type LogChatHistoriesRecapFailures struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID int64 `json:"chat_id,omitempty"`
+	// WindowHours holds the value of the "window_hours" field.
+	WindowHours int `json:"window_hours,omitempty"`
+	// WindowSince holds the value of the "window_since" field.
+	WindowSince int64 `json:"window_since,omitempty"`
+	// WindowUntil holds the value of the "window_until" field.
+	WindowUntil int64 `json:"window_until,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// IsAutoRecap holds the value of the "is_auto_recap" field.
+	IsAutoRecap bool `json:"is_auto_recap,omitempty"`
+	// Resolved holds the value of the "resolved" field.
+	Resolved bool `json:"resolved,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LogChatHistoriesRecapFailures) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case logchathistoriesrecapfailures.FieldIsAutoRecap, logchathistoriesrecapfailures.FieldResolved:
+			values[i] = new(sql.NullBool)
+		case logchathistoriesrecapfailures.FieldChatID, logchathistoriesrecapfailures.FieldWindowHours, logchathistoriesrecapfailures.FieldWindowSince, logchathistoriesrecapfailures.FieldWindowUntil, logchathistoriesrecapfailures.FieldCreatedAt, logchathistoriesrecapfailures.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case logchathistoriesrecapfailures.FieldError:
+			values[i] = new(sql.NullString)
+		case logchathistoriesrecapfailures.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LogChatHistoriesRecapFailures fields.
+func (_m *LogChatHistoriesRecapFailures) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case logchathistoriesrecapfailures.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case logchathistoriesrecapfailures.FieldChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.Int64
+			}
+		case logchathistoriesrecapfailures.FieldWindowHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field window_hours", values[i])
+			} else if value.Valid {
+				_m.WindowHours = int(value.Int64)
+			}
+		case logchathistoriesrecapfailures.FieldWindowSince:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field window_since", values[i])
+			} else if value.Valid {
+				_m.WindowSince = value.Int64
+			}
+		case logchathistoriesrecapfailures.FieldWindowUntil:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field window_until", values[i])
+			} else if value.Valid {
+				_m.WindowUntil = value.Int64
+			}
+		case logchathistoriesrecapfailures.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case logchathistoriesrecapfailures.FieldIsAutoRecap:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_auto_recap", values[i])
+			} else if value.Valid {
+				_m.IsAutoRecap = value.Bool
+			}
+		case logchathistoriesrecapfailures.FieldResolved:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved", values[i])
+			} else if value.Valid {
+				_m.Resolved = value.Bool
+			}
+		case logchathistoriesrecapfailures.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case logchathistoriesrecapfailures.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LogChatHistoriesRecapFailures.
+// This includes values selected through modifiers, order, etc.
+func (_m *LogChatHistoriesRecapFailures) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LogChatHistoriesRecapFailures.
+// Note that you need to call LogChatHistoriesRecapFailures.Unwrap() before calling this method if this LogChatHistoriesRecapFailures
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LogChatHistoriesRecapFailures) Update() *LogChatHistoriesRecapFailuresUpdateOne {
+	return NewLogChatHistoriesRecapFailuresClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LogChatHistoriesRecapFailures entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LogChatHistoriesRecapFailures) Unwrap() *LogChatHistoriesRecapFailures {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LogChatHistoriesRecapFailures is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LogChatHistoriesRecapFailures) String() string {
+	var builder strings.Builder
+	builder.WriteString("LogChatHistoriesRecapFailures(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("window_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WindowHours))
+	builder.WriteString(", ")
+	builder.WriteString("window_since=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WindowSince))
+	builder.WriteString(", ")
+	builder.WriteString("window_until=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WindowUntil))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("is_auto_recap=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsAutoRecap))
+	builder.WriteString(", ")
+	builder.WriteString("resolved=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Resolved))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LogChatHistoriesRecapFailuresSlice is a parsable slice of LogChatHistoriesRecapFailures.
+type LogChatHistoriesRecapFailuresSlice []*LogChatHistoriesRecapFailures
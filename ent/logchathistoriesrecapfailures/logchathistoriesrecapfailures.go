@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package logchathistoriesrecapfailures
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the logchathistoriesrecapfailures type in the database.
+	Label = "log_chat_histories_recap_failures"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldWindowHours holds the string denoting the window_hours field in the database.
+	FieldWindowHours = "window_hours"
+	// FieldWindowSince holds the string denoting the window_since field in the database.
+	FieldWindowSince = "window_since"
+	// FieldWindowUntil holds the string denoting the window_until field in the database.
+	FieldWindowUntil = "window_until"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldIsAutoRecap holds the string denoting the is_auto_recap field in the database.
+	FieldIsAutoRecap = "is_auto_recap"
+	// FieldResolved holds the string denoting the resolved field in the database.
+	FieldResolved = "resolved"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the logchathistoriesrecapfailures in the database.
+	Table = "log_chat_histories_recap_failures"
+)
+
+// Columns holds all SQL columns for logchathistoriesrecapfailures fields.
+var Columns = []string{
+	FieldID,
+	FieldChatID,
+	FieldWindowHours,
+	FieldWindowSince,
+	FieldWindowUntil,
+	FieldError,
+	FieldIsAutoRecap,
+	FieldResolved,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultChatID holds the default value on creation for the "chat_id" field.
+	DefaultChatID int64
+	// DefaultWindowHours holds the default value on creation for the "window_hours" field.
+	DefaultWindowHours int
+	// DefaultWindowSince holds the default value on creation for the "window_since" field.
+	DefaultWindowSince int64
+	// DefaultWindowUntil holds the default value on creation for the "window_until" field.
+	DefaultWindowUntil int64
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultIsAutoRecap holds the default value on creation for the "is_auto_recap" field.
+	DefaultIsAutoRecap bool
+	// DefaultResolved holds the default value on creation for the "resolved" field.
+	DefaultResolved bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LogChatHistoriesRecapFailures queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChatID orders the results by the chat_id field.
+func ByChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatID, opts...).ToFunc()
+}
+
+// ByWindowHours orders the results by the window_hours field.
+func ByWindowHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowHours, opts...).ToFunc()
+}
+
+// ByWindowSince orders the results by the window_since field.
+func ByWindowSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowSince, opts...).ToFunc()
+}
+
+// ByWindowUntil orders the results by the window_until field.
+func ByWindowUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowUntil, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByIsAutoRecap orders the results by the is_auto_recap field.
+func ByIsAutoRecap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsAutoRecap, opts...).ToFunc()
+}
+
+// ByResolved orders the results by the resolved field.
+func ByResolved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolved, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
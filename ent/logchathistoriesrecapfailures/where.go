@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package logchathistoriesrecapfailures
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldID, id))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldChatID, v))
+}
+
+// WindowHours applies equality check predicate on the "window_hours" field. It's identical to WindowHoursEQ.
+func WindowHours(v int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldWindowHours, v))
+}
+
+// WindowSince applies equality check predicate on the "window_since" field. It's identical to WindowSinceEQ.
+func WindowSince(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldWindowSince, v))
+}
+
+// WindowUntil applies equality check predicate on the "window_until" field. It's identical to WindowUntilEQ.
+func WindowUntil(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldWindowUntil, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldError, v))
+}
+
+// IsAutoRecap applies equality check predicate on the "is_auto_recap" field. It's identical to IsAutoRecapEQ.
+func IsAutoRecap(v bool) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldIsAutoRecap, v))
+}
+
+// Resolved applies equality check predicate on the "resolved" field. It's identical to ResolvedEQ.
+func Resolved(v bool) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldResolved, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldChatID, v))
+}
+
+// WindowHoursEQ applies the EQ predicate on the "window_hours" field.
+func WindowHoursEQ(v int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldWindowHours, v))
+}
+
+// WindowHoursNEQ applies the NEQ predicate on the "window_hours" field.
+func WindowHoursNEQ(v int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldWindowHours, v))
+}
+
+// WindowHoursIn applies the In predicate on the "window_hours" field.
+func WindowHoursIn(vs ...int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldWindowHours, vs...))
+}
+
+// WindowHoursNotIn applies the NotIn predicate on the "window_hours" field.
+func WindowHoursNotIn(vs ...int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldWindowHours, vs...))
+}
+
+// WindowHoursGT applies the GT predicate on the "window_hours" field.
+func WindowHoursGT(v int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldWindowHours, v))
+}
+
+// WindowHoursGTE applies the GTE predicate on the "window_hours" field.
+func WindowHoursGTE(v int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldWindowHours, v))
+}
+
+// WindowHoursLT applies the LT predicate on the "window_hours" field.
+func WindowHoursLT(v int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldWindowHours, v))
+}
+
+// WindowHoursLTE applies the LTE predicate on the "window_hours" field.
+func WindowHoursLTE(v int) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldWindowHours, v))
+}
+
+// WindowSinceEQ applies the EQ predicate on the "window_since" field.
+func WindowSinceEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldWindowSince, v))
+}
+
+// WindowSinceNEQ applies the NEQ predicate on the "window_since" field.
+func WindowSinceNEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldWindowSince, v))
+}
+
+// WindowSinceIn applies the In predicate on the "window_since" field.
+func WindowSinceIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldWindowSince, vs...))
+}
+
+// WindowSinceNotIn applies the NotIn predicate on the "window_since" field.
+func WindowSinceNotIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldWindowSince, vs...))
+}
+
+// WindowSinceGT applies the GT predicate on the "window_since" field.
+func WindowSinceGT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldWindowSince, v))
+}
+
+// WindowSinceGTE applies the GTE predicate on the "window_since" field.
+func WindowSinceGTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldWindowSince, v))
+}
+
+// WindowSinceLT applies the LT predicate on the "window_since" field.
+func WindowSinceLT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldWindowSince, v))
+}
+
+// WindowSinceLTE applies the LTE predicate on the "window_since" field.
+func WindowSinceLTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldWindowSince, v))
+}
+
+// WindowUntilEQ applies the EQ predicate on the "window_until" field.
+func WindowUntilEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldWindowUntil, v))
+}
+
+// WindowUntilNEQ applies the NEQ predicate on the "window_until" field.
+func WindowUntilNEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldWindowUntil, v))
+}
+
+// WindowUntilIn applies the In predicate on the "window_until" field.
+func WindowUntilIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldWindowUntil, vs...))
+}
+
+// WindowUntilNotIn applies the NotIn predicate on the "window_until" field.
+func WindowUntilNotIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldWindowUntil, vs...))
+}
+
+// WindowUntilGT applies the GT predicate on the "window_until" field.
+func WindowUntilGT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldWindowUntil, v))
+}
+
+// WindowUntilGTE applies the GTE predicate on the "window_until" field.
+func WindowUntilGTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldWindowUntil, v))
+}
+
+// WindowUntilLT applies the LT predicate on the "window_until" field.
+func WindowUntilLT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldWindowUntil, v))
+}
+
+// WindowUntilLTE applies the LTE predicate on the "window_until" field.
+func WindowUntilLTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldWindowUntil, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldContainsFold(FieldError, v))
+}
+
+// IsAutoRecapEQ applies the EQ predicate on the "is_auto_recap" field.
+func IsAutoRecapEQ(v bool) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldIsAutoRecap, v))
+}
+
+// IsAutoRecapNEQ applies the NEQ predicate on the "is_auto_recap" field.
+func IsAutoRecapNEQ(v bool) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldIsAutoRecap, v))
+}
+
+// ResolvedEQ applies the EQ predicate on the "resolved" field.
+func ResolvedEQ(v bool) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldResolved, v))
+}
+
+// ResolvedNEQ applies the NEQ predicate on the "resolved" field.
+func ResolvedNEQ(v bool) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldResolved, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LogChatHistoriesRecapFailures) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LogChatHistoriesRecapFailures) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LogChatHistoriesRecapFailures) predicate.LogChatHistoriesRecapFailures {
+	return predicate.LogChatHistoriesRecapFailures(sql.NotPredicates(p))
+}
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
+)
+
+// LogChatHistoriesRecapFailuresCreate is the builder for creating a LogChatHistoriesRecapFailures entity.
+type LogChatHistoriesRecapFailuresCreate struct {
+	config
+	mutation *LogChatHistoriesRecapFailuresMutation
+	hooks    []Hook
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetChatID(v int64) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableChatID(v *int64) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetChatID(*v)
+	}
+	return _c
+}
+
+// SetWindowHours sets the "window_hours" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetWindowHours(v int) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetWindowHours(v)
+	return _c
+}
+
+// SetNillableWindowHours sets the "window_hours" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableWindowHours(v *int) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetWindowHours(*v)
+	}
+	return _c
+}
+
+// SetWindowSince sets the "window_since" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetWindowSince(v int64) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetWindowSince(v)
+	return _c
+}
+
+// SetNillableWindowSince sets the "window_since" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableWindowSince(v *int64) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetWindowSince(*v)
+	}
+	return _c
+}
+
+// SetWindowUntil sets the "window_until" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetWindowUntil(v int64) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetWindowUntil(v)
+	return _c
+}
+
+// SetNillableWindowUntil sets the "window_until" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableWindowUntil(v *int64) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetWindowUntil(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetError(v string) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableError(v *string) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetIsAutoRecap(v bool) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetIsAutoRecap(v)
+	return _c
+}
+
+// SetNillableIsAutoRecap sets the "is_auto_recap" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableIsAutoRecap(v *bool) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetIsAutoRecap(*v)
+	}
+	return _c
+}
+
+// SetResolved sets the "resolved" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetResolved(v bool) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetResolved(v)
+	return _c
+}
+
+// SetNillableResolved sets the "resolved" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableResolved(v *bool) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetResolved(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetCreatedAt(v int64) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableCreatedAt(v *int64) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetUpdatedAt(v int64) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableUpdatedAt(v *int64) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetID(v uuid.UUID) *LogChatHistoriesRecapFailuresCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LogChatHistoriesRecapFailuresCreate) SetNillableID(v *uuid.UUID) *LogChatHistoriesRecapFailuresCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LogChatHistoriesRecapFailuresMutation object of the builder.
+func (_c *LogChatHistoriesRecapFailuresCreate) Mutation() *LogChatHistoriesRecapFailuresMutation {
+	return _c.mutation
+}
+
+// Save creates the LogChatHistoriesRecapFailures in the database.
+func (_c *LogChatHistoriesRecapFailuresCreate) Save(ctx context.Context) (*LogChatHistoriesRecapFailures, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LogChatHistoriesRecapFailuresCreate) SaveX(ctx context.Context) *LogChatHistoriesRecapFailures {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LogChatHistoriesRecapFailuresCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LogChatHistoriesRecapFailuresCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LogChatHistoriesRecapFailuresCreate) defaults() {
+	if _, ok := _c.mutation.ChatID(); !ok {
+		v := logchathistoriesrecapfailures.DefaultChatID
+		_c.mutation.SetChatID(v)
+	}
+	if _, ok := _c.mutation.WindowHours(); !ok {
+		v := logchathistoriesrecapfailures.DefaultWindowHours
+		_c.mutation.SetWindowHours(v)
+	}
+	if _, ok := _c.mutation.WindowSince(); !ok {
+		v := logchathistoriesrecapfailures.DefaultWindowSince
+		_c.mutation.SetWindowSince(v)
+	}
+	if _, ok := _c.mutation.WindowUntil(); !ok {
+		v := logchathistoriesrecapfailures.DefaultWindowUntil
+		_c.mutation.SetWindowUntil(v)
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		v := logchathistoriesrecapfailures.DefaultError
+		_c.mutation.SetError(v)
+	}
+	if _, ok := _c.mutation.IsAutoRecap(); !ok {
+		v := logchathistoriesrecapfailures.DefaultIsAutoRecap
+		_c.mutation.SetIsAutoRecap(v)
+	}
+	if _, ok := _c.mutation.Resolved(); !ok {
+		v := logchathistoriesrecapfailures.DefaultResolved
+		_c.mutation.SetResolved(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := logchathistoriesrecapfailures.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := logchathistoriesrecapfailures.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := logchathistoriesrecapfailures.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LogChatHistoriesRecapFailuresCreate) check() error {
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.chat_id"`)}
+	}
+	if _, ok := _c.mutation.WindowHours(); !ok {
+		return &ValidationError{Name: "window_hours", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.window_hours"`)}
+	}
+	if _, ok := _c.mutation.WindowSince(); !ok {
+		return &ValidationError{Name: "window_since", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.window_since"`)}
+	}
+	if _, ok := _c.mutation.WindowUntil(); !ok {
+		return &ValidationError{Name: "window_until", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.window_until"`)}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.error"`)}
+	}
+	if _, ok := _c.mutation.IsAutoRecap(); !ok {
+		return &ValidationError{Name: "is_auto_recap", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.is_auto_recap"`)}
+	}
+	if _, ok := _c.mutation.Resolved(); !ok {
+		return &ValidationError{Name: "resolved", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.resolved"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LogChatHistoriesRecapFailures.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *LogChatHistoriesRecapFailuresCreate) sqlSave(ctx context.Context) (*LogChatHistoriesRecapFailures, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LogChatHistoriesRecapFailuresCreate) createSpec() (*LogChatHistoriesRecapFailures, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LogChatHistoriesRecapFailures{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(logchathistoriesrecapfailures.Table, sqlgraph.NewFieldSpec(logchathistoriesrecapfailures.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.LogChatHistoriesRecapFailures
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.WindowHours(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowHours, field.TypeInt, value)
+		_node.WindowHours = value
+	}
+	if value, ok := _c.mutation.WindowSince(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowSince, field.TypeInt64, value)
+		_node.WindowSince = value
+	}
+	if value, ok := _c.mutation.WindowUntil(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowUntil, field.TypeInt64, value)
+		_node.WindowUntil = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.IsAutoRecap(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldIsAutoRecap, field.TypeBool, value)
+		_node.IsAutoRecap = value
+	}
+	if value, ok := _c.mutation.Resolved(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldResolved, field.TypeBool, value)
+		_node.Resolved = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// LogChatHistoriesRecapFailuresCreateBulk is the builder for creating many LogChatHistoriesRecapFailures entities in bulk.
+type LogChatHistoriesRecapFailuresCreateBulk struct {
+	config
+	err      error
+	builders []*LogChatHistoriesRecapFailuresCreate
+}
+
+// Save creates the LogChatHistoriesRecapFailures entities in the database.
+func (_c *LogChatHistoriesRecapFailuresCreateBulk) Save(ctx context.Context) ([]*LogChatHistoriesRecapFailures, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LogChatHistoriesRecapFailures, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LogChatHistoriesRecapFailuresMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LogChatHistoriesRecapFailuresCreateBulk) SaveX(ctx context.Context) []*LogChatHistoriesRecapFailures {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LogChatHistoriesRecapFailuresCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LogChatHistoriesRecapFailuresCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
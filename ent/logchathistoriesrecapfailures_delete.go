@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nekomeowww/insights-bot/ent/internal"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
+	"github.com/nekomeowww/insights-bot/ent/predicate"
+)
+
+// LogChatHistoriesRecapFailuresDelete is the builder for deleting a LogChatHistoriesRecapFailures entity.
+type LogChatHistoriesRecapFailuresDelete struct {
+	config
+	hooks    []Hook
+	mutation *LogChatHistoriesRecapFailuresMutation
+}
+
+// Where appends a list predicates to the LogChatHistoriesRecapFailuresDelete builder.
+func (_d *LogChatHistoriesRecapFailuresDelete) Where(ps ...predicate.LogChatHistoriesRecapFailures) *LogChatHistoriesRecapFailuresDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LogChatHistoriesRecapFailuresDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LogChatHistoriesRecapFailuresDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LogChatHistoriesRecapFailuresDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(logchathistoriesrecapfailures.Table, sqlgraph.NewFieldSpec(logchathistoriesrecapfailures.FieldID, field.TypeUUID))
+	_spec.Node.Schema = _d.schemaConfig.LogChatHistoriesRecapFailures
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LogChatHistoriesRecapFailuresDeleteOne is the builder for deleting a single LogChatHistoriesRecapFailures entity.
+type LogChatHistoriesRecapFailuresDeleteOne struct {
+	_d *LogChatHistoriesRecapFailuresDelete
+}
+
+// Where appends a list predicates to the LogChatHistoriesRecapFailuresDelete builder.
+func (_d *LogChatHistoriesRecapFailuresDeleteOne) Where(ps ...predicate.LogChatHistoriesRecapFailures) *LogChatHistoriesRecapFailuresDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LogChatHistoriesRecapFailuresDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{logchathistoriesrecapfailures.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LogChatHistoriesRecapFailuresDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
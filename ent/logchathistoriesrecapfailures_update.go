@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nekomeowww/insights-bot/ent/internal"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
+	"github.com/nekomeowww/insights-bot/ent/predicate"
+)
+
+// LogChatHistoriesRecapFailuresUpdate is the builder for updating LogChatHistoriesRecapFailures entities.
+type LogChatHistoriesRecapFailuresUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LogChatHistoriesRecapFailuresMutation
+}
+
+// Where appends a list predicates to the LogChatHistoriesRecapFailuresUpdate builder.
+func (_u *LogChatHistoriesRecapFailuresUpdate) Where(ps ...predicate.LogChatHistoriesRecapFailures) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetChatID(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableChatID(v *int64) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) AddChatID(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetWindowHours sets the "window_hours" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetWindowHours(v int) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.ResetWindowHours()
+	_u.mutation.SetWindowHours(v)
+	return _u
+}
+
+// SetNillableWindowHours sets the "window_hours" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableWindowHours(v *int) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetWindowHours(*v)
+	}
+	return _u
+}
+
+// AddWindowHours adds value to the "window_hours" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) AddWindowHours(v int) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.AddWindowHours(v)
+	return _u
+}
+
+// SetWindowSince sets the "window_since" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetWindowSince(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.ResetWindowSince()
+	_u.mutation.SetWindowSince(v)
+	return _u
+}
+
+// SetNillableWindowSince sets the "window_since" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableWindowSince(v *int64) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetWindowSince(*v)
+	}
+	return _u
+}
+
+// AddWindowSince adds value to the "window_since" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) AddWindowSince(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.AddWindowSince(v)
+	return _u
+}
+
+// SetWindowUntil sets the "window_until" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetWindowUntil(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.ResetWindowUntil()
+	_u.mutation.SetWindowUntil(v)
+	return _u
+}
+
+// SetNillableWindowUntil sets the "window_until" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableWindowUntil(v *int64) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetWindowUntil(*v)
+	}
+	return _u
+}
+
+// AddWindowUntil adds value to the "window_until" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) AddWindowUntil(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.AddWindowUntil(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetError(v string) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableError(v *string) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetIsAutoRecap(v bool) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.SetIsAutoRecap(v)
+	return _u
+}
+
+// SetNillableIsAutoRecap sets the "is_auto_recap" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableIsAutoRecap(v *bool) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetIsAutoRecap(*v)
+	}
+	return _u
+}
+
+// SetResolved sets the "resolved" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetResolved(v bool) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.SetResolved(v)
+	return _u
+}
+
+// SetNillableResolved sets the "resolved" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableResolved(v *bool) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetResolved(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetCreatedAt(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableCreatedAt(v *int64) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) AddCreatedAt(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetUpdatedAt(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SetNillableUpdatedAt(v *int64) *LogChatHistoriesRecapFailuresUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdate) AddUpdatedAt(v int64) *LogChatHistoriesRecapFailuresUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LogChatHistoriesRecapFailuresMutation object of the builder.
+func (_u *LogChatHistoriesRecapFailuresUpdate) Mutation() *LogChatHistoriesRecapFailuresMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LogChatHistoriesRecapFailuresUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LogChatHistoriesRecapFailuresUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LogChatHistoriesRecapFailuresUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LogChatHistoriesRecapFailuresUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LogChatHistoriesRecapFailuresUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(logchathistoriesrecapfailures.Table, logchathistoriesrecapfailures.Columns, sqlgraph.NewFieldSpec(logchathistoriesrecapfailures.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.WindowHours(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWindowHours(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WindowSince(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowSince, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWindowSince(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldWindowSince, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.WindowUntil(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWindowUntil(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldWindowUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsAutoRecap(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldIsAutoRecap, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Resolved(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldResolved, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LogChatHistoriesRecapFailures
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logchathistoriesrecapfailures.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LogChatHistoriesRecapFailuresUpdateOne is the builder for updating a single LogChatHistoriesRecapFailures entity.
+type LogChatHistoriesRecapFailuresUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LogChatHistoriesRecapFailuresMutation
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetChatID(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableChatID(v *int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) AddChatID(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetWindowHours sets the "window_hours" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetWindowHours(v int) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.ResetWindowHours()
+	_u.mutation.SetWindowHours(v)
+	return _u
+}
+
+// SetNillableWindowHours sets the "window_hours" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableWindowHours(v *int) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetWindowHours(*v)
+	}
+	return _u
+}
+
+// AddWindowHours adds value to the "window_hours" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) AddWindowHours(v int) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.AddWindowHours(v)
+	return _u
+}
+
+// SetWindowSince sets the "window_since" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetWindowSince(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.ResetWindowSince()
+	_u.mutation.SetWindowSince(v)
+	return _u
+}
+
+// SetNillableWindowSince sets the "window_since" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableWindowSince(v *int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetWindowSince(*v)
+	}
+	return _u
+}
+
+// AddWindowSince adds value to the "window_since" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) AddWindowSince(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.AddWindowSince(v)
+	return _u
+}
+
+// SetWindowUntil sets the "window_until" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetWindowUntil(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.ResetWindowUntil()
+	_u.mutation.SetWindowUntil(v)
+	return _u
+}
+
+// SetNillableWindowUntil sets the "window_until" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableWindowUntil(v *int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetWindowUntil(*v)
+	}
+	return _u
+}
+
+// AddWindowUntil adds value to the "window_until" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) AddWindowUntil(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.AddWindowUntil(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetError(v string) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableError(v *string) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetIsAutoRecap(v bool) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.SetIsAutoRecap(v)
+	return _u
+}
+
+// SetNillableIsAutoRecap sets the "is_auto_recap" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableIsAutoRecap(v *bool) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetIsAutoRecap(*v)
+	}
+	return _u
+}
+
+// SetResolved sets the "resolved" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetResolved(v bool) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.SetResolved(v)
+	return _u
+}
+
+// SetNillableResolved sets the "resolved" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableResolved(v *bool) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetResolved(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetCreatedAt(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableCreatedAt(v *int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) AddCreatedAt(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetUpdatedAt(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SetNillableUpdatedAt(v *int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) AddUpdatedAt(v int64) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LogChatHistoriesRecapFailuresMutation object of the builder.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) Mutation() *LogChatHistoriesRecapFailuresMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LogChatHistoriesRecapFailuresUpdate builder.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) Where(ps ...predicate.LogChatHistoriesRecapFailures) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) Select(field string, fields ...string) *LogChatHistoriesRecapFailuresUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LogChatHistoriesRecapFailures entity.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) Save(ctx context.Context) (*LogChatHistoriesRecapFailures, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) SaveX(ctx context.Context) *LogChatHistoriesRecapFailures {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LogChatHistoriesRecapFailuresUpdateOne) sqlSave(ctx context.Context) (_node *LogChatHistoriesRecapFailures, err error) {
+	_spec := sqlgraph.NewUpdateSpec(logchathistoriesrecapfailures.Table, logchathistoriesrecapfailures.Columns, sqlgraph.NewFieldSpec(logchathistoriesrecapfailures.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LogChatHistoriesRecapFailures.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, logchathistoriesrecapfailures.FieldID)
+		for _, f := range fields {
+			if !logchathistoriesrecapfailures.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != logchathistoriesrecapfailures.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.WindowHours(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWindowHours(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WindowSince(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowSince, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWindowSince(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldWindowSince, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.WindowUntil(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldWindowUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWindowUntil(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldWindowUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsAutoRecap(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldIsAutoRecap, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Resolved(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldResolved, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(logchathistoriesrecapfailures.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(logchathistoriesrecapfailures.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.LogChatHistoriesRecapFailures
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &LogChatHistoriesRecapFailures{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{logchathistoriesrecapfailures.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
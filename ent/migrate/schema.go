@@ -18,6 +18,7 @@ var (
 		{Name: "user_id", Type: field.TypeInt64, Default: 0},
 		{Name: "username", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "full_name", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "is_bot", Type: field.TypeBool, Default: false},
 		{Name: "text", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "replied_to_message_id", Type: field.TypeInt64, Default: 0},
 		{Name: "replied_to_user_id", Type: field.TypeInt64, Default: 0},
@@ -28,6 +29,12 @@ var (
 		{Name: "chatted_at", Type: field.TypeInt64},
 		{Name: "embedded", Type: field.TypeBool, Default: false},
 		{Name: "from_platform", Type: field.TypeInt, Default: 0},
+		{Name: "poll_id", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "poll_question", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "poll_options", Type: field.TypeJSON, Nullable: true},
+		{Name: "poll_total_voter_count", Type: field.TypeInt, Default: 0},
+		{Name: "poll_is_closed", Type: field.TypeBool, Default: false},
+		{Name: "message_types", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "updated_at", Type: field.TypeInt64},
 	}
@@ -81,6 +88,8 @@ var (
 		{Name: "total_token_usage", Type: field.TypeInt, Default: 0},
 		{Name: "recap_type", Type: field.TypeInt, Default: 0},
 		{Name: "model_name", Type: field.TypeString, Default: ""},
+		{Name: "window_hours", Type: field.TypeInt, Default: 0},
+		{Name: "is_auto_recap", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "updated_at", Type: field.TypeInt64},
 	}
@@ -90,6 +99,25 @@ var (
 		Columns:    LogChatHistoriesRecapsColumns,
 		PrimaryKey: []*schema.Column{LogChatHistoriesRecapsColumns[0]},
 	}
+	// LogChatHistoriesRecapFailuresColumns holds the columns for the "log_chat_histories_recap_failures" table.
+	LogChatHistoriesRecapFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "chat_id", Type: field.TypeInt64, Default: 0},
+		{Name: "window_hours", Type: field.TypeInt, Default: 0},
+		{Name: "window_since", Type: field.TypeInt64, Default: 0},
+		{Name: "window_until", Type: field.TypeInt64, Default: 0},
+		{Name: "error", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "is_auto_recap", Type: field.TypeBool, Default: false},
+		{Name: "resolved", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// LogChatHistoriesRecapFailuresTable holds the schema information for the "log_chat_histories_recap_failures" table.
+	LogChatHistoriesRecapFailuresTable = &schema.Table{
+		Name:       "log_chat_histories_recap_failures",
+		Columns:    LogChatHistoriesRecapFailuresColumns,
+		PrimaryKey: []*schema.Column{LogChatHistoriesRecapFailuresColumns[0]},
+	}
 	// LogSummarizationsColumns holds the columns for the "log_summarizations" table.
 	LogSummarizationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -130,6 +158,22 @@ var (
 		Columns:    MetricOpenAiChatCompletionTokenUsagesColumns,
 		PrimaryKey: []*schema.Column{MetricOpenAiChatCompletionTokenUsagesColumns[0]},
 	}
+	// RecapFeedbacksColumns holds the columns for the "recap_feedbacks" table.
+	RecapFeedbacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "chat_id", Type: field.TypeInt64, Default: 0},
+		{Name: "log_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeInt64, Default: 0},
+		{Name: "text", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// RecapFeedbacksTable holds the schema information for the "recap_feedbacks" table.
+	RecapFeedbacksTable = &schema.Table{
+		Name:       "recap_feedbacks",
+		Columns:    RecapFeedbacksColumns,
+		PrimaryKey: []*schema.Column{RecapFeedbacksColumns[0]},
+	}
 	// SentMessagesColumns holds the columns for the "sent_messages" table.
 	SentMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -202,6 +246,41 @@ var (
 		{Name: "manual_recap_rate_per_seconds", Type: field.TypeInt64, Default: 0},
 		{Name: "auto_recap_rates_per_day", Type: field.TypeInt, Default: 0},
 		{Name: "pin_auto_recap_message", Type: field.TypeBool, Default: false},
+		{Name: "pin_auto_recap_message_silently", Type: field.TypeBool, Default: true},
+		{Name: "recap_disclaimer", Type: field.TypeString, Default: ""},
+		{Name: "recap_target_chat_id", Type: field.TypeInt64, Default: 0},
+		{Name: "recap_persona", Type: field.TypeString, Default: ""},
+		{Name: "include_bot_messages", Type: field.TypeBool, Default: false},
+		{Name: "auto_recaps_snoozed_until", Type: field.TypeInt64, Default: 0},
+		{Name: "quiet_notice_enabled", Type: field.TypeBool, Default: false},
+		{Name: "last_quiet_notice_at", Type: field.TypeInt64, Default: 0},
+		{Name: "per_topic_messages", Type: field.TypeBool, Default: false},
+		{Name: "summary_temperature", Type: field.TypeFloat64, Default: -1},
+		{Name: "recap_output_format", Type: field.TypeInt, Default: 0},
+		{Name: "min_message_length_for_summary", Type: field.TypeInt, Default: 0},
+		{Name: "count_short_messages_for_activity", Type: field.TypeBool, Default: true},
+		{Name: "summary_languages", Type: field.TypeString, Default: ""},
+		{Name: "subscribe_min_membership_days", Type: field.TypeInt, Default: 0},
+		{Name: "subscribe_min_member_status", Type: field.TypeInt, Default: 0},
+		{Name: "top_keywords_count", Type: field.TypeInt, Default: 0},
+		{Name: "dedup_forwards", Type: field.TypeBool, Default: false},
+		{Name: "store_message_content", Type: field.TypeBool, Default: true},
+		{Name: "anonymize_participants", Type: field.TypeBool, Default: false},
+		{Name: "manual_recap_private", Type: field.TypeBool, Default: false},
+		{Name: "recap_in_progress_template", Type: field.TypeString, Default: ""},
+		{Name: "excluded_message_types", Type: field.TypeInt, Default: 1},
+		{Name: "recap_weekdays", Type: field.TypeJSON, Nullable: true},
+		{Name: "related_messages_count", Type: field.TypeInt, Default: 0},
+		{Name: "incremental_recap", Type: field.TypeBool, Default: false},
+		{Name: "recap_link_preview", Type: field.TypeBool, Default: true},
+		{Name: "auto_unpin_after_seconds", Type: field.TypeInt64, Default: 0},
+		{Name: "recap_thread_id", Type: field.TypeInt, Default: 0},
+		{Name: "manual_recap_rate_limit_exempt_admins", Type: field.TypeBool, Default: false},
+		{Name: "manual_recap_min_role", Type: field.TypeInt, Default: 0},
+		{Name: "approval_required", Type: field.TypeBool, Default: false},
+		{Name: "collect_only", Type: field.TypeBool, Default: false},
+		{Name: "recap_document_attachment", Type: field.TypeBool, Default: false},
+		{Name: "summary_max_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "updated_at", Type: field.TypeInt64},
 	}
@@ -211,19 +290,36 @@ var (
 		Columns:    TelegramChatRecapsOptionsColumns,
 		PrimaryKey: []*schema.Column{TelegramChatRecapsOptionsColumns[0]},
 	}
+	// TelegramUserPreferencesColumns holds the columns for the "telegram_user_preferences" table.
+	TelegramUserPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeInt64, Unique: true},
+		{Name: "batch_private_recaps", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// TelegramUserPreferencesTable holds the schema information for the "telegram_user_preferences" table.
+	TelegramUserPreferencesTable = &schema.Table{
+		Name:       "telegram_user_preferences",
+		Columns:    TelegramUserPreferencesColumns,
+		PrimaryKey: []*schema.Column{TelegramUserPreferencesColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ChatHistoriesTable,
 		FeedbackChatHistoriesRecapsReactionsTable,
 		FeedbackSummarizationsReactionsTable,
 		LogChatHistoriesRecapsTable,
+		LogChatHistoriesRecapFailuresTable,
 		LogSummarizationsTable,
 		MetricOpenAiChatCompletionTokenUsagesTable,
+		RecapFeedbacksTable,
 		SentMessagesTable,
 		SlackOauthCredentialsTable,
 		TelegramChatAutoRecapsSubscribersTable,
 		TelegramChatFeatureFlagsTable,
 		TelegramChatRecapsOptionsTable,
+		TelegramUserPreferencesTable,
 	}
 )
 
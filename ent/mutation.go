@@ -7,22 +7,27 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
 	"github.com/nekomeowww/insights-bot/ent/chathistories"
 	"github.com/nekomeowww/insights-bot/ent/feedbackchathistoriesrecapsreactions"
 	"github.com/nekomeowww/insights-bot/ent/feedbacksummarizationsreactions"
 	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
 	"github.com/nekomeowww/insights-bot/ent/logsummarizations"
 	"github.com/nekomeowww/insights-bot/ent/metricopenaichatcompletiontokenusage"
 	"github.com/nekomeowww/insights-bot/ent/predicate"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
 	"github.com/nekomeowww/insights-bot/ent/sentmessages"
 	"github.com/nekomeowww/insights-bot/ent/slackoauthcredentials"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatautorecapssubscribers"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatfeatureflags"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatrecapsoptions"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
 )
 
 const (
@@ -38,53 +43,66 @@ const (
 	TypeFeedbackChatHistoriesRecapsReactions = "FeedbackChatHistoriesRecapsReactions"
 	TypeFeedbackSummarizationsReactions      = "FeedbackSummarizationsReactions"
 	TypeLogChatHistoriesRecap                = "LogChatHistoriesRecap"
+	TypeLogChatHistoriesRecapFailures        = "LogChatHistoriesRecapFailures"
 	TypeLogSummarizations                    = "LogSummarizations"
 	TypeMetricOpenAIChatCompletionTokenUsage = "MetricOpenAIChatCompletionTokenUsage"
+	TypeRecapFeedback                        = "RecapFeedback"
 	TypeSentMessages                         = "SentMessages"
 	TypeSlackOAuthCredentials                = "SlackOAuthCredentials"
 	TypeTelegramChatAutoRecapsSubscribers    = "TelegramChatAutoRecapsSubscribers"
 	TypeTelegramChatFeatureFlags             = "TelegramChatFeatureFlags"
 	TypeTelegramChatRecapsOptions            = "TelegramChatRecapsOptions"
+	TypeTelegramUserPreferences              = "TelegramUserPreferences"
 )
 
 // ChatHistoriesMutation represents an operation that mutates the ChatHistories nodes in the graph.
 type ChatHistoriesMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	chat_id                  *int64
-	addchat_id               *int64
-	chat_title               *string
-	chat_type                *string
-	message_id               *int64
-	addmessage_id            *int64
-	user_id                  *int64
-	adduser_id               *int64
-	username                 *string
-	full_name                *string
-	text                     *string
-	replied_to_message_id    *int64
-	addreplied_to_message_id *int64
-	replied_to_user_id       *int64
-	addreplied_to_user_id    *int64
-	replied_to_full_name     *string
-	replied_to_username      *string
-	replied_to_text          *string
-	replied_to_chat_type     *string
-	chatted_at               *int64
-	addchatted_at            *int64
-	embedded                 *bool
-	from_platform            *int
-	addfrom_platform         *int
-	created_at               *int64
-	addcreated_at            *int64
-	updated_at               *int64
-	addupdated_at            *int64
-	clearedFields            map[string]struct{}
-	done                     bool
-	oldValue                 func(context.Context) (*ChatHistories, error)
-	predicates               []predicate.ChatHistories
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	chat_id                   *int64
+	addchat_id                *int64
+	chat_title                *string
+	chat_type                 *string
+	message_id                *int64
+	addmessage_id             *int64
+	user_id                   *int64
+	adduser_id                *int64
+	username                  *string
+	full_name                 *string
+	is_bot                    *bool
+	text                      *string
+	replied_to_message_id     *int64
+	addreplied_to_message_id  *int64
+	replied_to_user_id        *int64
+	addreplied_to_user_id     *int64
+	replied_to_full_name      *string
+	replied_to_username       *string
+	replied_to_text           *string
+	replied_to_chat_type      *string
+	chatted_at                *int64
+	addchatted_at             *int64
+	embedded                  *bool
+	from_platform             *int
+	addfrom_platform          *int
+	poll_id                   *string
+	poll_question             *string
+	poll_options              *[]tgbotapi.PollOption
+	appendpoll_options        []tgbotapi.PollOption
+	poll_total_voter_count    *int
+	addpoll_total_voter_count *int
+	poll_is_closed            *bool
+	message_types             *int
+	addmessage_types          *int
+	created_at                *int64
+	addcreated_at             *int64
+	updated_at                *int64
+	addupdated_at             *int64
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*ChatHistories, error)
+	predicates                []predicate.ChatHistories
 }
 
 var _ ent.Mutation = (*ChatHistoriesMutation)(nil)
@@ -503,6 +521,42 @@ func (m *ChatHistoriesMutation) ResetFullName() {
 	m.full_name = nil
 }
 
+// SetIsBot sets the "is_bot" field.
+func (m *ChatHistoriesMutation) SetIsBot(b bool) {
+	m.is_bot = &b
+}
+
+// IsBot returns the value of the "is_bot" field in the mutation.
+func (m *ChatHistoriesMutation) IsBot() (r bool, exists bool) {
+	v := m.is_bot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBot returns the old "is_bot" field's value of the ChatHistories entity.
+// If the ChatHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatHistoriesMutation) OldIsBot(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBot: %w", err)
+	}
+	return oldValue.IsBot, nil
+}
+
+// ResetIsBot resets all changes to the "is_bot" field.
+func (m *ChatHistoriesMutation) ResetIsBot() {
+	m.is_bot = nil
+}
+
 // SetText sets the "text" field.
 func (m *ChatHistoriesMutation) SetText(s string) {
 	m.text = &s
@@ -943,6 +997,291 @@ func (m *ChatHistoriesMutation) ResetFromPlatform() {
 	m.addfrom_platform = nil
 }
 
+// SetPollID sets the "poll_id" field.
+func (m *ChatHistoriesMutation) SetPollID(s string) {
+	m.poll_id = &s
+}
+
+// PollID returns the value of the "poll_id" field in the mutation.
+func (m *ChatHistoriesMutation) PollID() (r string, exists bool) {
+	v := m.poll_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollID returns the old "poll_id" field's value of the ChatHistories entity.
+// If the ChatHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatHistoriesMutation) OldPollID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollID: %w", err)
+	}
+	return oldValue.PollID, nil
+}
+
+// ResetPollID resets all changes to the "poll_id" field.
+func (m *ChatHistoriesMutation) ResetPollID() {
+	m.poll_id = nil
+}
+
+// SetPollQuestion sets the "poll_question" field.
+func (m *ChatHistoriesMutation) SetPollQuestion(s string) {
+	m.poll_question = &s
+}
+
+// PollQuestion returns the value of the "poll_question" field in the mutation.
+func (m *ChatHistoriesMutation) PollQuestion() (r string, exists bool) {
+	v := m.poll_question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollQuestion returns the old "poll_question" field's value of the ChatHistories entity.
+// If the ChatHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatHistoriesMutation) OldPollQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollQuestion: %w", err)
+	}
+	return oldValue.PollQuestion, nil
+}
+
+// ResetPollQuestion resets all changes to the "poll_question" field.
+func (m *ChatHistoriesMutation) ResetPollQuestion() {
+	m.poll_question = nil
+}
+
+// SetPollOptions sets the "poll_options" field.
+func (m *ChatHistoriesMutation) SetPollOptions(to []tgbotapi.PollOption) {
+	m.poll_options = &to
+	m.appendpoll_options = nil
+}
+
+// PollOptions returns the value of the "poll_options" field in the mutation.
+func (m *ChatHistoriesMutation) PollOptions() (r []tgbotapi.PollOption, exists bool) {
+	v := m.poll_options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollOptions returns the old "poll_options" field's value of the ChatHistories entity.
+// If the ChatHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatHistoriesMutation) OldPollOptions(ctx context.Context) (v []tgbotapi.PollOption, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollOptions: %w", err)
+	}
+	return oldValue.PollOptions, nil
+}
+
+// AppendPollOptions adds to to the "poll_options" field.
+func (m *ChatHistoriesMutation) AppendPollOptions(to []tgbotapi.PollOption) {
+	m.appendpoll_options = append(m.appendpoll_options, to...)
+}
+
+// AppendedPollOptions returns the list of values that were appended to the "poll_options" field in this mutation.
+func (m *ChatHistoriesMutation) AppendedPollOptions() ([]tgbotapi.PollOption, bool) {
+	if len(m.appendpoll_options) == 0 {
+		return nil, false
+	}
+	return m.appendpoll_options, true
+}
+
+// ClearPollOptions clears the value of the "poll_options" field.
+func (m *ChatHistoriesMutation) ClearPollOptions() {
+	m.poll_options = nil
+	m.appendpoll_options = nil
+	m.clearedFields[chathistories.FieldPollOptions] = struct{}{}
+}
+
+// PollOptionsCleared returns if the "poll_options" field was cleared in this mutation.
+func (m *ChatHistoriesMutation) PollOptionsCleared() bool {
+	_, ok := m.clearedFields[chathistories.FieldPollOptions]
+	return ok
+}
+
+// ResetPollOptions resets all changes to the "poll_options" field.
+func (m *ChatHistoriesMutation) ResetPollOptions() {
+	m.poll_options = nil
+	m.appendpoll_options = nil
+	delete(m.clearedFields, chathistories.FieldPollOptions)
+}
+
+// SetPollTotalVoterCount sets the "poll_total_voter_count" field.
+func (m *ChatHistoriesMutation) SetPollTotalVoterCount(i int) {
+	m.poll_total_voter_count = &i
+	m.addpoll_total_voter_count = nil
+}
+
+// PollTotalVoterCount returns the value of the "poll_total_voter_count" field in the mutation.
+func (m *ChatHistoriesMutation) PollTotalVoterCount() (r int, exists bool) {
+	v := m.poll_total_voter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollTotalVoterCount returns the old "poll_total_voter_count" field's value of the ChatHistories entity.
+// If the ChatHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatHistoriesMutation) OldPollTotalVoterCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollTotalVoterCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollTotalVoterCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollTotalVoterCount: %w", err)
+	}
+	return oldValue.PollTotalVoterCount, nil
+}
+
+// AddPollTotalVoterCount adds i to the "poll_total_voter_count" field.
+func (m *ChatHistoriesMutation) AddPollTotalVoterCount(i int) {
+	if m.addpoll_total_voter_count != nil {
+		*m.addpoll_total_voter_count += i
+	} else {
+		m.addpoll_total_voter_count = &i
+	}
+}
+
+// AddedPollTotalVoterCount returns the value that was added to the "poll_total_voter_count" field in this mutation.
+func (m *ChatHistoriesMutation) AddedPollTotalVoterCount() (r int, exists bool) {
+	v := m.addpoll_total_voter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPollTotalVoterCount resets all changes to the "poll_total_voter_count" field.
+func (m *ChatHistoriesMutation) ResetPollTotalVoterCount() {
+	m.poll_total_voter_count = nil
+	m.addpoll_total_voter_count = nil
+}
+
+// SetPollIsClosed sets the "poll_is_closed" field.
+func (m *ChatHistoriesMutation) SetPollIsClosed(b bool) {
+	m.poll_is_closed = &b
+}
+
+// PollIsClosed returns the value of the "poll_is_closed" field in the mutation.
+func (m *ChatHistoriesMutation) PollIsClosed() (r bool, exists bool) {
+	v := m.poll_is_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollIsClosed returns the old "poll_is_closed" field's value of the ChatHistories entity.
+// If the ChatHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatHistoriesMutation) OldPollIsClosed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollIsClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollIsClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollIsClosed: %w", err)
+	}
+	return oldValue.PollIsClosed, nil
+}
+
+// ResetPollIsClosed resets all changes to the "poll_is_closed" field.
+func (m *ChatHistoriesMutation) ResetPollIsClosed() {
+	m.poll_is_closed = nil
+}
+
+// SetMessageTypes sets the "message_types" field.
+func (m *ChatHistoriesMutation) SetMessageTypes(i int) {
+	m.message_types = &i
+	m.addmessage_types = nil
+}
+
+// MessageTypes returns the value of the "message_types" field in the mutation.
+func (m *ChatHistoriesMutation) MessageTypes() (r int, exists bool) {
+	v := m.message_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageTypes returns the old "message_types" field's value of the ChatHistories entity.
+// If the ChatHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatHistoriesMutation) OldMessageTypes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageTypes: %w", err)
+	}
+	return oldValue.MessageTypes, nil
+}
+
+// AddMessageTypes adds i to the "message_types" field.
+func (m *ChatHistoriesMutation) AddMessageTypes(i int) {
+	if m.addmessage_types != nil {
+		*m.addmessage_types += i
+	} else {
+		m.addmessage_types = &i
+	}
+}
+
+// AddedMessageTypes returns the value that was added to the "message_types" field in this mutation.
+func (m *ChatHistoriesMutation) AddedMessageTypes() (r int, exists bool) {
+	v := m.addmessage_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageTypes resets all changes to the "message_types" field.
+func (m *ChatHistoriesMutation) ResetMessageTypes() {
+	m.message_types = nil
+	m.addmessage_types = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *ChatHistoriesMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
@@ -1089,7 +1428,7 @@ func (m *ChatHistoriesMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChatHistoriesMutation) Fields() []string {
-	fields := make([]string, 0, 19)
+	fields := make([]string, 0, 26)
 	if m.chat_id != nil {
 		fields = append(fields, chathistories.FieldChatID)
 	}
@@ -1111,6 +1450,9 @@ func (m *ChatHistoriesMutation) Fields() []string {
 	if m.full_name != nil {
 		fields = append(fields, chathistories.FieldFullName)
 	}
+	if m.is_bot != nil {
+		fields = append(fields, chathistories.FieldIsBot)
+	}
 	if m.text != nil {
 		fields = append(fields, chathistories.FieldText)
 	}
@@ -1141,6 +1483,24 @@ func (m *ChatHistoriesMutation) Fields() []string {
 	if m.from_platform != nil {
 		fields = append(fields, chathistories.FieldFromPlatform)
 	}
+	if m.poll_id != nil {
+		fields = append(fields, chathistories.FieldPollID)
+	}
+	if m.poll_question != nil {
+		fields = append(fields, chathistories.FieldPollQuestion)
+	}
+	if m.poll_options != nil {
+		fields = append(fields, chathistories.FieldPollOptions)
+	}
+	if m.poll_total_voter_count != nil {
+		fields = append(fields, chathistories.FieldPollTotalVoterCount)
+	}
+	if m.poll_is_closed != nil {
+		fields = append(fields, chathistories.FieldPollIsClosed)
+	}
+	if m.message_types != nil {
+		fields = append(fields, chathistories.FieldMessageTypes)
+	}
 	if m.created_at != nil {
 		fields = append(fields, chathistories.FieldCreatedAt)
 	}
@@ -1169,6 +1529,8 @@ func (m *ChatHistoriesMutation) Field(name string) (ent.Value, bool) {
 		return m.Username()
 	case chathistories.FieldFullName:
 		return m.FullName()
+	case chathistories.FieldIsBot:
+		return m.IsBot()
 	case chathistories.FieldText:
 		return m.Text()
 	case chathistories.FieldRepliedToMessageID:
@@ -1189,6 +1551,18 @@ func (m *ChatHistoriesMutation) Field(name string) (ent.Value, bool) {
 		return m.Embedded()
 	case chathistories.FieldFromPlatform:
 		return m.FromPlatform()
+	case chathistories.FieldPollID:
+		return m.PollID()
+	case chathistories.FieldPollQuestion:
+		return m.PollQuestion()
+	case chathistories.FieldPollOptions:
+		return m.PollOptions()
+	case chathistories.FieldPollTotalVoterCount:
+		return m.PollTotalVoterCount()
+	case chathistories.FieldPollIsClosed:
+		return m.PollIsClosed()
+	case chathistories.FieldMessageTypes:
+		return m.MessageTypes()
 	case chathistories.FieldCreatedAt:
 		return m.CreatedAt()
 	case chathistories.FieldUpdatedAt:
@@ -1216,6 +1590,8 @@ func (m *ChatHistoriesMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldUsername(ctx)
 	case chathistories.FieldFullName:
 		return m.OldFullName(ctx)
+	case chathistories.FieldIsBot:
+		return m.OldIsBot(ctx)
 	case chathistories.FieldText:
 		return m.OldText(ctx)
 	case chathistories.FieldRepliedToMessageID:
@@ -1236,6 +1612,18 @@ func (m *ChatHistoriesMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldEmbedded(ctx)
 	case chathistories.FieldFromPlatform:
 		return m.OldFromPlatform(ctx)
+	case chathistories.FieldPollID:
+		return m.OldPollID(ctx)
+	case chathistories.FieldPollQuestion:
+		return m.OldPollQuestion(ctx)
+	case chathistories.FieldPollOptions:
+		return m.OldPollOptions(ctx)
+	case chathistories.FieldPollTotalVoterCount:
+		return m.OldPollTotalVoterCount(ctx)
+	case chathistories.FieldPollIsClosed:
+		return m.OldPollIsClosed(ctx)
+	case chathistories.FieldMessageTypes:
+		return m.OldMessageTypes(ctx)
 	case chathistories.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case chathistories.FieldUpdatedAt:
@@ -1298,6 +1686,13 @@ func (m *ChatHistoriesMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetFullName(v)
 		return nil
+	case chathistories.FieldIsBot:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBot(v)
+		return nil
 	case chathistories.FieldText:
 		v, ok := value.(string)
 		if !ok {
@@ -1368,6 +1763,48 @@ func (m *ChatHistoriesMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetFromPlatform(v)
 		return nil
+	case chathistories.FieldPollID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollID(v)
+		return nil
+	case chathistories.FieldPollQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollQuestion(v)
+		return nil
+	case chathistories.FieldPollOptions:
+		v, ok := value.([]tgbotapi.PollOption)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollOptions(v)
+		return nil
+	case chathistories.FieldPollTotalVoterCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollTotalVoterCount(v)
+		return nil
+	case chathistories.FieldPollIsClosed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollIsClosed(v)
+		return nil
+	case chathistories.FieldMessageTypes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageTypes(v)
+		return nil
 	case chathistories.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
@@ -1411,6 +1848,12 @@ func (m *ChatHistoriesMutation) AddedFields() []string {
 	if m.addfrom_platform != nil {
 		fields = append(fields, chathistories.FieldFromPlatform)
 	}
+	if m.addpoll_total_voter_count != nil {
+		fields = append(fields, chathistories.FieldPollTotalVoterCount)
+	}
+	if m.addmessage_types != nil {
+		fields = append(fields, chathistories.FieldMessageTypes)
+	}
 	if m.addcreated_at != nil {
 		fields = append(fields, chathistories.FieldCreatedAt)
 	}
@@ -1439,6 +1882,10 @@ func (m *ChatHistoriesMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedChattedAt()
 	case chathistories.FieldFromPlatform:
 		return m.AddedFromPlatform()
+	case chathistories.FieldPollTotalVoterCount:
+		return m.AddedPollTotalVoterCount()
+	case chathistories.FieldMessageTypes:
+		return m.AddedMessageTypes()
 	case chathistories.FieldCreatedAt:
 		return m.AddedCreatedAt()
 	case chathistories.FieldUpdatedAt:
@@ -1501,6 +1948,20 @@ func (m *ChatHistoriesMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddFromPlatform(v)
 		return nil
+	case chathistories.FieldPollTotalVoterCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPollTotalVoterCount(v)
+		return nil
+	case chathistories.FieldMessageTypes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageTypes(v)
+		return nil
 	case chathistories.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
@@ -1522,7 +1983,11 @@ func (m *ChatHistoriesMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *ChatHistoriesMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(chathistories.FieldPollOptions) {
+		fields = append(fields, chathistories.FieldPollOptions)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1535,6 +2000,11 @@ func (m *ChatHistoriesMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *ChatHistoriesMutation) ClearField(name string) error {
+	switch name {
+	case chathistories.FieldPollOptions:
+		m.ClearPollOptions()
+		return nil
+	}
 	return fmt.Errorf("unknown ChatHistories nullable field %s", name)
 }
 
@@ -1563,6 +2033,9 @@ func (m *ChatHistoriesMutation) ResetField(name string) error {
 	case chathistories.FieldFullName:
 		m.ResetFullName()
 		return nil
+	case chathistories.FieldIsBot:
+		m.ResetIsBot()
+		return nil
 	case chathistories.FieldText:
 		m.ResetText()
 		return nil
@@ -1593,13 +2066,31 @@ func (m *ChatHistoriesMutation) ResetField(name string) error {
 	case chathistories.FieldFromPlatform:
 		m.ResetFromPlatform()
 		return nil
-	case chathistories.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case chathistories.FieldPollID:
+		m.ResetPollID()
 		return nil
-	case chathistories.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case chathistories.FieldPollQuestion:
+		m.ResetPollQuestion()
 		return nil
-	}
+	case chathistories.FieldPollOptions:
+		m.ResetPollOptions()
+		return nil
+	case chathistories.FieldPollTotalVoterCount:
+		m.ResetPollTotalVoterCount()
+		return nil
+	case chathistories.FieldPollIsClosed:
+		m.ResetPollIsClosed()
+		return nil
+	case chathistories.FieldMessageTypes:
+		m.ResetMessageTypes()
+		return nil
+	case chathistories.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chathistories.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown ChatHistories field %s", name)
 }
 
@@ -3146,6 +3637,9 @@ type LogChatHistoriesRecapMutation struct {
 	recap_type                *int
 	addrecap_type             *int
 	model_name                *string
+	window_hours              *int
+	addwindow_hours           *int
+	is_auto_recap             *bool
 	created_at                *int64
 	addcreated_at             *int64
 	updated_at                *int64
@@ -3704,6 +4198,98 @@ func (m *LogChatHistoriesRecapMutation) ResetModelName() {
 	m.model_name = nil
 }
 
+// SetWindowHours sets the "window_hours" field.
+func (m *LogChatHistoriesRecapMutation) SetWindowHours(i int) {
+	m.window_hours = &i
+	m.addwindow_hours = nil
+}
+
+// WindowHours returns the value of the "window_hours" field in the mutation.
+func (m *LogChatHistoriesRecapMutation) WindowHours() (r int, exists bool) {
+	v := m.window_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWindowHours returns the old "window_hours" field's value of the LogChatHistoriesRecap entity.
+// If the LogChatHistoriesRecap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogChatHistoriesRecapMutation) OldWindowHours(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWindowHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWindowHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWindowHours: %w", err)
+	}
+	return oldValue.WindowHours, nil
+}
+
+// AddWindowHours adds i to the "window_hours" field.
+func (m *LogChatHistoriesRecapMutation) AddWindowHours(i int) {
+	if m.addwindow_hours != nil {
+		*m.addwindow_hours += i
+	} else {
+		m.addwindow_hours = &i
+	}
+}
+
+// AddedWindowHours returns the value that was added to the "window_hours" field in this mutation.
+func (m *LogChatHistoriesRecapMutation) AddedWindowHours() (r int, exists bool) {
+	v := m.addwindow_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWindowHours resets all changes to the "window_hours" field.
+func (m *LogChatHistoriesRecapMutation) ResetWindowHours() {
+	m.window_hours = nil
+	m.addwindow_hours = nil
+}
+
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (m *LogChatHistoriesRecapMutation) SetIsAutoRecap(b bool) {
+	m.is_auto_recap = &b
+}
+
+// IsAutoRecap returns the value of the "is_auto_recap" field in the mutation.
+func (m *LogChatHistoriesRecapMutation) IsAutoRecap() (r bool, exists bool) {
+	v := m.is_auto_recap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAutoRecap returns the old "is_auto_recap" field's value of the LogChatHistoriesRecap entity.
+// If the LogChatHistoriesRecap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogChatHistoriesRecapMutation) OldIsAutoRecap(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAutoRecap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAutoRecap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAutoRecap: %w", err)
+	}
+	return oldValue.IsAutoRecap, nil
+}
+
+// ResetIsAutoRecap resets all changes to the "is_auto_recap" field.
+func (m *LogChatHistoriesRecapMutation) ResetIsAutoRecap() {
+	m.is_auto_recap = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *LogChatHistoriesRecapMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
@@ -3850,7 +4436,7 @@ func (m *LogChatHistoriesRecapMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *LogChatHistoriesRecapMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 13)
 	if m.chat_id != nil {
 		fields = append(fields, logchathistoriesrecap.FieldChatID)
 	}
@@ -3878,6 +4464,12 @@ func (m *LogChatHistoriesRecapMutation) Fields() []string {
 	if m.model_name != nil {
 		fields = append(fields, logchathistoriesrecap.FieldModelName)
 	}
+	if m.window_hours != nil {
+		fields = append(fields, logchathistoriesrecap.FieldWindowHours)
+	}
+	if m.is_auto_recap != nil {
+		fields = append(fields, logchathistoriesrecap.FieldIsAutoRecap)
+	}
 	if m.created_at != nil {
 		fields = append(fields, logchathistoriesrecap.FieldCreatedAt)
 	}
@@ -3910,6 +4502,10 @@ func (m *LogChatHistoriesRecapMutation) Field(name string) (ent.Value, bool) {
 		return m.RecapType()
 	case logchathistoriesrecap.FieldModelName:
 		return m.ModelName()
+	case logchathistoriesrecap.FieldWindowHours:
+		return m.WindowHours()
+	case logchathistoriesrecap.FieldIsAutoRecap:
+		return m.IsAutoRecap()
 	case logchathistoriesrecap.FieldCreatedAt:
 		return m.CreatedAt()
 	case logchathistoriesrecap.FieldUpdatedAt:
@@ -3941,6 +4537,10 @@ func (m *LogChatHistoriesRecapMutation) OldField(ctx context.Context, name strin
 		return m.OldRecapType(ctx)
 	case logchathistoriesrecap.FieldModelName:
 		return m.OldModelName(ctx)
+	case logchathistoriesrecap.FieldWindowHours:
+		return m.OldWindowHours(ctx)
+	case logchathistoriesrecap.FieldIsAutoRecap:
+		return m.OldIsAutoRecap(ctx)
 	case logchathistoriesrecap.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case logchathistoriesrecap.FieldUpdatedAt:
@@ -4017,6 +4617,20 @@ func (m *LogChatHistoriesRecapMutation) SetField(name string, value ent.Value) e
 		}
 		m.SetModelName(v)
 		return nil
+	case logchathistoriesrecap.FieldWindowHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindowHours(v)
+		return nil
+	case logchathistoriesrecap.FieldIsAutoRecap:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAutoRecap(v)
+		return nil
 	case logchathistoriesrecap.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
@@ -4057,6 +4671,9 @@ func (m *LogChatHistoriesRecapMutation) AddedFields() []string {
 	if m.addrecap_type != nil {
 		fields = append(fields, logchathistoriesrecap.FieldRecapType)
 	}
+	if m.addwindow_hours != nil {
+		fields = append(fields, logchathistoriesrecap.FieldWindowHours)
+	}
 	if m.addcreated_at != nil {
 		fields = append(fields, logchathistoriesrecap.FieldCreatedAt)
 	}
@@ -4083,6 +4700,8 @@ func (m *LogChatHistoriesRecapMutation) AddedField(name string) (ent.Value, bool
 		return m.AddedTotalTokenUsage()
 	case logchathistoriesrecap.FieldRecapType:
 		return m.AddedRecapType()
+	case logchathistoriesrecap.FieldWindowHours:
+		return m.AddedWindowHours()
 	case logchathistoriesrecap.FieldCreatedAt:
 		return m.AddedCreatedAt()
 	case logchathistoriesrecap.FieldUpdatedAt:
@@ -4138,6 +4757,13 @@ func (m *LogChatHistoriesRecapMutation) AddField(name string, value ent.Value) e
 		}
 		m.AddRecapType(v)
 		return nil
+	case logchathistoriesrecap.FieldWindowHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWindowHours(v)
+		return nil
 	case logchathistoriesrecap.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
@@ -4206,6 +4832,12 @@ func (m *LogChatHistoriesRecapMutation) ResetField(name string) error {
 	case logchathistoriesrecap.FieldModelName:
 		m.ResetModelName()
 		return nil
+	case logchathistoriesrecap.FieldWindowHours:
+		m.ResetWindowHours()
+		return nil
+	case logchathistoriesrecap.FieldIsAutoRecap:
+		m.ResetIsAutoRecap()
+		return nil
 	case logchathistoriesrecap.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -4264,47 +4896,44 @@ func (m *LogChatHistoriesRecapMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown LogChatHistoriesRecap edge %s", name)
 }
 
-// LogSummarizationsMutation represents an operation that mutates the LogSummarizations nodes in the graph.
-type LogSummarizationsMutation struct {
+// LogChatHistoriesRecapFailuresMutation represents an operation that mutates the LogChatHistoriesRecapFailures nodes in the graph.
+type LogChatHistoriesRecapFailuresMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	content_url                *string
-	content_title              *string
-	content_author             *string
-	content_text               *string
-	content_summarized_outputs *string
-	from_platform              *int
-	addfrom_platform           *int
-	prompt_token_usage         *int
-	addprompt_token_usage      *int
-	completion_token_usage     *int
-	addcompletion_token_usage  *int
-	total_token_usage          *int
-	addtotal_token_usage       *int
-	model_name                 *string
-	created_at                 *int64
-	addcreated_at              *int64
-	updated_at                 *int64
-	addupdated_at              *int64
-	clearedFields              map[string]struct{}
-	done                       bool
-	oldValue                   func(context.Context) (*LogSummarizations, error)
-	predicates                 []predicate.LogSummarizations
-}
-
-var _ ent.Mutation = (*LogSummarizationsMutation)(nil)
-
-// logsummarizationsOption allows management of the mutation configuration using functional options.
-type logsummarizationsOption func(*LogSummarizationsMutation)
-
-// newLogSummarizationsMutation creates new mutation for the LogSummarizations entity.
-func newLogSummarizationsMutation(c config, op Op, opts ...logsummarizationsOption) *LogSummarizationsMutation {
-	m := &LogSummarizationsMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	chat_id         *int64
+	addchat_id      *int64
+	window_hours    *int
+	addwindow_hours *int
+	window_since    *int64
+	addwindow_since *int64
+	window_until    *int64
+	addwindow_until *int64
+	error           *string
+	is_auto_recap   *bool
+	resolved        *bool
+	created_at      *int64
+	addcreated_at   *int64
+	updated_at      *int64
+	addupdated_at   *int64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*LogChatHistoriesRecapFailures, error)
+	predicates      []predicate.LogChatHistoriesRecapFailures
+}
+
+var _ ent.Mutation = (*LogChatHistoriesRecapFailuresMutation)(nil)
+
+// logchathistoriesrecapfailuresOption allows management of the mutation configuration using functional options.
+type logchathistoriesrecapfailuresOption func(*LogChatHistoriesRecapFailuresMutation)
+
+// newLogChatHistoriesRecapFailuresMutation creates new mutation for the LogChatHistoriesRecapFailures entity.
+func newLogChatHistoriesRecapFailuresMutation(c config, op Op, opts ...logchathistoriesrecapfailuresOption) *LogChatHistoriesRecapFailuresMutation {
+	m := &LogChatHistoriesRecapFailuresMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLogSummarizations,
+		typ:           TypeLogChatHistoriesRecapFailures,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4313,20 +4942,20 @@ func newLogSummarizationsMutation(c config, op Op, opts ...logsummarizationsOpti
 	return m
 }
 
-// withLogSummarizationsID sets the ID field of the mutation.
-func withLogSummarizationsID(id uuid.UUID) logsummarizationsOption {
-	return func(m *LogSummarizationsMutation) {
+// withLogChatHistoriesRecapFailuresID sets the ID field of the mutation.
+func withLogChatHistoriesRecapFailuresID(id uuid.UUID) logchathistoriesrecapfailuresOption {
+	return func(m *LogChatHistoriesRecapFailuresMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LogSummarizations
+			value *LogChatHistoriesRecapFailures
 		)
-		m.oldValue = func(ctx context.Context) (*LogSummarizations, error) {
+		m.oldValue = func(ctx context.Context) (*LogChatHistoriesRecapFailures, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LogSummarizations.Get(ctx, id)
+					value, err = m.Client().LogChatHistoriesRecapFailures.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4335,10 +4964,10 @@ func withLogSummarizationsID(id uuid.UUID) logsummarizationsOption {
 	}
 }
 
-// withLogSummarizations sets the old LogSummarizations of the mutation.
-func withLogSummarizations(node *LogSummarizations) logsummarizationsOption {
-	return func(m *LogSummarizationsMutation) {
-		m.oldValue = func(context.Context) (*LogSummarizations, error) {
+// withLogChatHistoriesRecapFailures sets the old LogChatHistoriesRecapFailures of the mutation.
+func withLogChatHistoriesRecapFailures(node *LogChatHistoriesRecapFailures) logchathistoriesrecapfailuresOption {
+	return func(m *LogChatHistoriesRecapFailuresMutation) {
+		m.oldValue = func(context.Context) (*LogChatHistoriesRecapFailures, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4347,7 +4976,7 @@ func withLogSummarizations(node *LogSummarizations) logsummarizationsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LogSummarizationsMutation) Client() *Client {
+func (m LogChatHistoriesRecapFailuresMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4355,7 +4984,7 @@ func (m LogSummarizationsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LogSummarizationsMutation) Tx() (*Tx, error) {
+func (m LogChatHistoriesRecapFailuresMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4365,14 +4994,14 @@ func (m LogSummarizationsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of LogSummarizations entities.
-func (m *LogSummarizationsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of LogChatHistoriesRecapFailures entities.
+func (m *LogChatHistoriesRecapFailuresMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LogSummarizationsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *LogChatHistoriesRecapFailuresMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4383,7 +5012,7 @@ func (m *LogSummarizationsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LogSummarizationsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *LogChatHistoriesRecapFailuresMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4392,460 +5021,352 @@ func (m *LogSummarizationsMutation) IDs(ctx context.Context) ([]uuid.UUID, error
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LogSummarizations.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LogChatHistoriesRecapFailures.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetContentURL sets the "content_url" field.
-func (m *LogSummarizationsMutation) SetContentURL(s string) {
-	m.content_url = &s
+// SetChatID sets the "chat_id" field.
+func (m *LogChatHistoriesRecapFailuresMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
 }
 
-// ContentURL returns the value of the "content_url" field in the mutation.
-func (m *LogSummarizationsMutation) ContentURL() (r string, exists bool) {
-	v := m.content_url
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentURL returns the old "content_url" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldContentURL(ctx context.Context) (v string, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldChatID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentURL requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.ContentURL, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetContentURL resets all changes to the "content_url" field.
-func (m *LogSummarizationsMutation) ResetContentURL() {
-	m.content_url = nil
+// AddChatID adds i to the "chat_id" field.
+func (m *LogChatHistoriesRecapFailuresMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
 }
 
-// SetContentTitle sets the "content_title" field.
-func (m *LogSummarizationsMutation) SetContentTitle(s string) {
-	m.content_title = &s
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ContentTitle returns the value of the "content_title" field in the mutation.
-func (m *LogSummarizationsMutation) ContentTitle() (r string, exists bool) {
-	v := m.content_title
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *LogChatHistoriesRecapFailuresMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetWindowHours sets the "window_hours" field.
+func (m *LogChatHistoriesRecapFailuresMutation) SetWindowHours(i int) {
+	m.window_hours = &i
+	m.addwindow_hours = nil
+}
+
+// WindowHours returns the value of the "window_hours" field in the mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) WindowHours() (r int, exists bool) {
+	v := m.window_hours
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentTitle returns the old "content_title" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldWindowHours returns the old "window_hours" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldContentTitle(ctx context.Context) (v string, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldWindowHours(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldWindowHours is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentTitle requires an ID field in the mutation")
+		return v, errors.New("OldWindowHours requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldWindowHours: %w", err)
 	}
-	return oldValue.ContentTitle, nil
+	return oldValue.WindowHours, nil
 }
 
-// ResetContentTitle resets all changes to the "content_title" field.
-func (m *LogSummarizationsMutation) ResetContentTitle() {
-	m.content_title = nil
+// AddWindowHours adds i to the "window_hours" field.
+func (m *LogChatHistoriesRecapFailuresMutation) AddWindowHours(i int) {
+	if m.addwindow_hours != nil {
+		*m.addwindow_hours += i
+	} else {
+		m.addwindow_hours = &i
+	}
 }
 
-// SetContentAuthor sets the "content_author" field.
-func (m *LogSummarizationsMutation) SetContentAuthor(s string) {
-	m.content_author = &s
+// AddedWindowHours returns the value that was added to the "window_hours" field in this mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) AddedWindowHours() (r int, exists bool) {
+	v := m.addwindow_hours
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ContentAuthor returns the value of the "content_author" field in the mutation.
-func (m *LogSummarizationsMutation) ContentAuthor() (r string, exists bool) {
-	v := m.content_author
+// ResetWindowHours resets all changes to the "window_hours" field.
+func (m *LogChatHistoriesRecapFailuresMutation) ResetWindowHours() {
+	m.window_hours = nil
+	m.addwindow_hours = nil
+}
+
+// SetWindowSince sets the "window_since" field.
+func (m *LogChatHistoriesRecapFailuresMutation) SetWindowSince(i int64) {
+	m.window_since = &i
+	m.addwindow_since = nil
+}
+
+// WindowSince returns the value of the "window_since" field in the mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) WindowSince() (r int64, exists bool) {
+	v := m.window_since
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentAuthor returns the old "content_author" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldWindowSince returns the old "window_since" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldContentAuthor(ctx context.Context) (v string, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldWindowSince(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldWindowSince is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentAuthor requires an ID field in the mutation")
+		return v, errors.New("OldWindowSince requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldWindowSince: %w", err)
 	}
-	return oldValue.ContentAuthor, nil
+	return oldValue.WindowSince, nil
 }
 
-// ResetContentAuthor resets all changes to the "content_author" field.
-func (m *LogSummarizationsMutation) ResetContentAuthor() {
-	m.content_author = nil
+// AddWindowSince adds i to the "window_since" field.
+func (m *LogChatHistoriesRecapFailuresMutation) AddWindowSince(i int64) {
+	if m.addwindow_since != nil {
+		*m.addwindow_since += i
+	} else {
+		m.addwindow_since = &i
+	}
 }
 
-// SetContentText sets the "content_text" field.
-func (m *LogSummarizationsMutation) SetContentText(s string) {
-	m.content_text = &s
+// AddedWindowSince returns the value that was added to the "window_since" field in this mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) AddedWindowSince() (r int64, exists bool) {
+	v := m.addwindow_since
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ContentText returns the value of the "content_text" field in the mutation.
-func (m *LogSummarizationsMutation) ContentText() (r string, exists bool) {
-	v := m.content_text
+// ResetWindowSince resets all changes to the "window_since" field.
+func (m *LogChatHistoriesRecapFailuresMutation) ResetWindowSince() {
+	m.window_since = nil
+	m.addwindow_since = nil
+}
+
+// SetWindowUntil sets the "window_until" field.
+func (m *LogChatHistoriesRecapFailuresMutation) SetWindowUntil(i int64) {
+	m.window_until = &i
+	m.addwindow_until = nil
+}
+
+// WindowUntil returns the value of the "window_until" field in the mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) WindowUntil() (r int64, exists bool) {
+	v := m.window_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentText returns the old "content_text" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldWindowUntil returns the old "window_until" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldContentText(ctx context.Context) (v string, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldWindowUntil(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentText is only allowed on UpdateOne operations")
+		return v, errors.New("OldWindowUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentText requires an ID field in the mutation")
+		return v, errors.New("OldWindowUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentText: %w", err)
+		return v, fmt.Errorf("querying old value for OldWindowUntil: %w", err)
 	}
-	return oldValue.ContentText, nil
+	return oldValue.WindowUntil, nil
 }
 
-// ResetContentText resets all changes to the "content_text" field.
-func (m *LogSummarizationsMutation) ResetContentText() {
-	m.content_text = nil
+// AddWindowUntil adds i to the "window_until" field.
+func (m *LogChatHistoriesRecapFailuresMutation) AddWindowUntil(i int64) {
+	if m.addwindow_until != nil {
+		*m.addwindow_until += i
+	} else {
+		m.addwindow_until = &i
+	}
 }
 
-// SetContentSummarizedOutputs sets the "content_summarized_outputs" field.
-func (m *LogSummarizationsMutation) SetContentSummarizedOutputs(s string) {
-	m.content_summarized_outputs = &s
-}
-
-// ContentSummarizedOutputs returns the value of the "content_summarized_outputs" field in the mutation.
-func (m *LogSummarizationsMutation) ContentSummarizedOutputs() (r string, exists bool) {
-	v := m.content_summarized_outputs
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldContentSummarizedOutputs returns the old "content_summarized_outputs" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldContentSummarizedOutputs(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentSummarizedOutputs is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentSummarizedOutputs requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentSummarizedOutputs: %w", err)
-	}
-	return oldValue.ContentSummarizedOutputs, nil
-}
-
-// ResetContentSummarizedOutputs resets all changes to the "content_summarized_outputs" field.
-func (m *LogSummarizationsMutation) ResetContentSummarizedOutputs() {
-	m.content_summarized_outputs = nil
-}
-
-// SetFromPlatform sets the "from_platform" field.
-func (m *LogSummarizationsMutation) SetFromPlatform(i int) {
-	m.from_platform = &i
-	m.addfrom_platform = nil
-}
-
-// FromPlatform returns the value of the "from_platform" field in the mutation.
-func (m *LogSummarizationsMutation) FromPlatform() (r int, exists bool) {
-	v := m.from_platform
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFromPlatform returns the old "from_platform" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldFromPlatform(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromPlatform is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromPlatform requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromPlatform: %w", err)
-	}
-	return oldValue.FromPlatform, nil
-}
-
-// AddFromPlatform adds i to the "from_platform" field.
-func (m *LogSummarizationsMutation) AddFromPlatform(i int) {
-	if m.addfrom_platform != nil {
-		*m.addfrom_platform += i
-	} else {
-		m.addfrom_platform = &i
-	}
-}
-
-// AddedFromPlatform returns the value that was added to the "from_platform" field in this mutation.
-func (m *LogSummarizationsMutation) AddedFromPlatform() (r int, exists bool) {
-	v := m.addfrom_platform
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetFromPlatform resets all changes to the "from_platform" field.
-func (m *LogSummarizationsMutation) ResetFromPlatform() {
-	m.from_platform = nil
-	m.addfrom_platform = nil
-}
-
-// SetPromptTokenUsage sets the "prompt_token_usage" field.
-func (m *LogSummarizationsMutation) SetPromptTokenUsage(i int) {
-	m.prompt_token_usage = &i
-	m.addprompt_token_usage = nil
-}
-
-// PromptTokenUsage returns the value of the "prompt_token_usage" field in the mutation.
-func (m *LogSummarizationsMutation) PromptTokenUsage() (r int, exists bool) {
-	v := m.prompt_token_usage
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPromptTokenUsage returns the old "prompt_token_usage" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldPromptTokenUsage(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptTokenUsage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptTokenUsage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptTokenUsage: %w", err)
-	}
-	return oldValue.PromptTokenUsage, nil
-}
-
-// AddPromptTokenUsage adds i to the "prompt_token_usage" field.
-func (m *LogSummarizationsMutation) AddPromptTokenUsage(i int) {
-	if m.addprompt_token_usage != nil {
-		*m.addprompt_token_usage += i
-	} else {
-		m.addprompt_token_usage = &i
-	}
-}
-
-// AddedPromptTokenUsage returns the value that was added to the "prompt_token_usage" field in this mutation.
-func (m *LogSummarizationsMutation) AddedPromptTokenUsage() (r int, exists bool) {
-	v := m.addprompt_token_usage
+// AddedWindowUntil returns the value that was added to the "window_until" field in this mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) AddedWindowUntil() (r int64, exists bool) {
+	v := m.addwindow_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPromptTokenUsage resets all changes to the "prompt_token_usage" field.
-func (m *LogSummarizationsMutation) ResetPromptTokenUsage() {
-	m.prompt_token_usage = nil
-	m.addprompt_token_usage = nil
+// ResetWindowUntil resets all changes to the "window_until" field.
+func (m *LogChatHistoriesRecapFailuresMutation) ResetWindowUntil() {
+	m.window_until = nil
+	m.addwindow_until = nil
 }
 
-// SetCompletionTokenUsage sets the "completion_token_usage" field.
-func (m *LogSummarizationsMutation) SetCompletionTokenUsage(i int) {
-	m.completion_token_usage = &i
-	m.addcompletion_token_usage = nil
+// SetError sets the "error" field.
+func (m *LogChatHistoriesRecapFailuresMutation) SetError(s string) {
+	m.error = &s
 }
 
-// CompletionTokenUsage returns the value of the "completion_token_usage" field in the mutation.
-func (m *LogSummarizationsMutation) CompletionTokenUsage() (r int, exists bool) {
-	v := m.completion_token_usage
+// Error returns the value of the "error" field in the mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletionTokenUsage returns the old "completion_token_usage" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldCompletionTokenUsage(ctx context.Context) (v int, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionTokenUsage is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionTokenUsage requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionTokenUsage: %w", err)
-	}
-	return oldValue.CompletionTokenUsage, nil
-}
-
-// AddCompletionTokenUsage adds i to the "completion_token_usage" field.
-func (m *LogSummarizationsMutation) AddCompletionTokenUsage(i int) {
-	if m.addcompletion_token_usage != nil {
-		*m.addcompletion_token_usage += i
-	} else {
-		m.addcompletion_token_usage = &i
-	}
-}
-
-// AddedCompletionTokenUsage returns the value that was added to the "completion_token_usage" field in this mutation.
-func (m *LogSummarizationsMutation) AddedCompletionTokenUsage() (r int, exists bool) {
-	v := m.addcompletion_token_usage
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return *v, true
+	return oldValue.Error, nil
 }
 
-// ResetCompletionTokenUsage resets all changes to the "completion_token_usage" field.
-func (m *LogSummarizationsMutation) ResetCompletionTokenUsage() {
-	m.completion_token_usage = nil
-	m.addcompletion_token_usage = nil
+// ResetError resets all changes to the "error" field.
+func (m *LogChatHistoriesRecapFailuresMutation) ResetError() {
+	m.error = nil
 }
 
-// SetTotalTokenUsage sets the "total_token_usage" field.
-func (m *LogSummarizationsMutation) SetTotalTokenUsage(i int) {
-	m.total_token_usage = &i
-	m.addtotal_token_usage = nil
+// SetIsAutoRecap sets the "is_auto_recap" field.
+func (m *LogChatHistoriesRecapFailuresMutation) SetIsAutoRecap(b bool) {
+	m.is_auto_recap = &b
 }
 
-// TotalTokenUsage returns the value of the "total_token_usage" field in the mutation.
-func (m *LogSummarizationsMutation) TotalTokenUsage() (r int, exists bool) {
-	v := m.total_token_usage
+// IsAutoRecap returns the value of the "is_auto_recap" field in the mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) IsAutoRecap() (r bool, exists bool) {
+	v := m.is_auto_recap
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalTokenUsage returns the old "total_token_usage" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldIsAutoRecap returns the old "is_auto_recap" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldTotalTokenUsage(ctx context.Context) (v int, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldIsAutoRecap(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalTokenUsage is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsAutoRecap is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalTokenUsage requires an ID field in the mutation")
+		return v, errors.New("OldIsAutoRecap requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalTokenUsage: %w", err)
-	}
-	return oldValue.TotalTokenUsage, nil
-}
-
-// AddTotalTokenUsage adds i to the "total_token_usage" field.
-func (m *LogSummarizationsMutation) AddTotalTokenUsage(i int) {
-	if m.addtotal_token_usage != nil {
-		*m.addtotal_token_usage += i
-	} else {
-		m.addtotal_token_usage = &i
-	}
-}
-
-// AddedTotalTokenUsage returns the value that was added to the "total_token_usage" field in this mutation.
-func (m *LogSummarizationsMutation) AddedTotalTokenUsage() (r int, exists bool) {
-	v := m.addtotal_token_usage
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldIsAutoRecap: %w", err)
 	}
-	return *v, true
+	return oldValue.IsAutoRecap, nil
 }
 
-// ResetTotalTokenUsage resets all changes to the "total_token_usage" field.
-func (m *LogSummarizationsMutation) ResetTotalTokenUsage() {
-	m.total_token_usage = nil
-	m.addtotal_token_usage = nil
+// ResetIsAutoRecap resets all changes to the "is_auto_recap" field.
+func (m *LogChatHistoriesRecapFailuresMutation) ResetIsAutoRecap() {
+	m.is_auto_recap = nil
 }
 
-// SetModelName sets the "model_name" field.
-func (m *LogSummarizationsMutation) SetModelName(s string) {
-	m.model_name = &s
+// SetResolved sets the "resolved" field.
+func (m *LogChatHistoriesRecapFailuresMutation) SetResolved(b bool) {
+	m.resolved = &b
 }
 
-// ModelName returns the value of the "model_name" field in the mutation.
-func (m *LogSummarizationsMutation) ModelName() (r string, exists bool) {
-	v := m.model_name
+// Resolved returns the value of the "resolved" field in the mutation.
+func (m *LogChatHistoriesRecapFailuresMutation) Resolved() (r bool, exists bool) {
+	v := m.resolved
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelName returns the old "model_name" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldResolved returns the old "resolved" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldModelName(ctx context.Context) (v string, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldResolved(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelName is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolved is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelName requires an ID field in the mutation")
+		return v, errors.New("OldResolved requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelName: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolved: %w", err)
 	}
-	return oldValue.ModelName, nil
+	return oldValue.Resolved, nil
 }
 
-// ResetModelName resets all changes to the "model_name" field.
-func (m *LogSummarizationsMutation) ResetModelName() {
-	m.model_name = nil
+// ResetResolved resets all changes to the "resolved" field.
+func (m *LogChatHistoriesRecapFailuresMutation) ResetResolved() {
+	m.resolved = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LogSummarizationsMutation) SetCreatedAt(i int64) {
+func (m *LogChatHistoriesRecapFailuresMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LogSummarizationsMutation) CreatedAt() (r int64, exists bool) {
+func (m *LogChatHistoriesRecapFailuresMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4853,10 +5374,10 @@ func (m *LogSummarizationsMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4871,7 +5392,7 @@ func (m *LogSummarizationsMutation) OldCreatedAt(ctx context.Context) (v int64,
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *LogSummarizationsMutation) AddCreatedAt(i int64) {
+func (m *LogChatHistoriesRecapFailuresMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -4880,7 +5401,7 @@ func (m *LogSummarizationsMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *LogSummarizationsMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *LogChatHistoriesRecapFailuresMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -4889,19 +5410,19 @@ func (m *LogSummarizationsMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LogSummarizationsMutation) ResetCreatedAt() {
+func (m *LogChatHistoriesRecapFailuresMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *LogSummarizationsMutation) SetUpdatedAt(i int64) {
+func (m *LogChatHistoriesRecapFailuresMutation) SetUpdatedAt(i int64) {
 	m.updated_at = &i
 	m.addupdated_at = nil
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LogSummarizationsMutation) UpdatedAt() (r int64, exists bool) {
+func (m *LogChatHistoriesRecapFailuresMutation) UpdatedAt() (r int64, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4909,10 +5430,10 @@ func (m *LogSummarizationsMutation) UpdatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the LogSummarizations entity.
-// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the LogChatHistoriesRecapFailures entity.
+// If the LogChatHistoriesRecapFailures object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LogSummarizationsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4927,7 +5448,7 @@ func (m *LogSummarizationsMutation) OldUpdatedAt(ctx context.Context) (v int64,
 }
 
 // AddUpdatedAt adds i to the "updated_at" field.
-func (m *LogSummarizationsMutation) AddUpdatedAt(i int64) {
+func (m *LogChatHistoriesRecapFailuresMutation) AddUpdatedAt(i int64) {
 	if m.addupdated_at != nil {
 		*m.addupdated_at += i
 	} else {
@@ -4936,7 +5457,7 @@ func (m *LogSummarizationsMutation) AddUpdatedAt(i int64) {
 }
 
 // AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *LogSummarizationsMutation) AddedUpdatedAt() (r int64, exists bool) {
+func (m *LogChatHistoriesRecapFailuresMutation) AddedUpdatedAt() (r int64, exists bool) {
 	v := m.addupdated_at
 	if v == nil {
 		return
@@ -4945,20 +5466,20 @@ func (m *LogSummarizationsMutation) AddedUpdatedAt() (r int64, exists bool) {
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LogSummarizationsMutation) ResetUpdatedAt() {
+func (m *LogChatHistoriesRecapFailuresMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
-// Where appends a list predicates to the LogSummarizationsMutation builder.
-func (m *LogSummarizationsMutation) Where(ps ...predicate.LogSummarizations) {
+// Where appends a list predicates to the LogChatHistoriesRecapFailuresMutation builder.
+func (m *LogChatHistoriesRecapFailuresMutation) Where(ps ...predicate.LogChatHistoriesRecapFailures) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LogSummarizationsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LogChatHistoriesRecapFailuresMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LogSummarizationsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LogSummarizations, len(ps))
+func (m *LogChatHistoriesRecapFailuresMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LogChatHistoriesRecapFailures, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4966,60 +5487,51 @@ func (m *LogSummarizationsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LogSummarizationsMutation) Op() Op {
+func (m *LogChatHistoriesRecapFailuresMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LogSummarizationsMutation) SetOp(op Op) {
+func (m *LogChatHistoriesRecapFailuresMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LogSummarizations).
-func (m *LogSummarizationsMutation) Type() string {
+// Type returns the node type of this mutation (LogChatHistoriesRecapFailures).
+func (m *LogChatHistoriesRecapFailuresMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LogSummarizationsMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.content_url != nil {
-		fields = append(fields, logsummarizations.FieldContentURL)
+func (m *LogChatHistoriesRecapFailuresMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.chat_id != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldChatID)
 	}
-	if m.content_title != nil {
-		fields = append(fields, logsummarizations.FieldContentTitle)
+	if m.window_hours != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldWindowHours)
 	}
-	if m.content_author != nil {
-		fields = append(fields, logsummarizations.FieldContentAuthor)
-	}
-	if m.content_text != nil {
-		fields = append(fields, logsummarizations.FieldContentText)
-	}
-	if m.content_summarized_outputs != nil {
-		fields = append(fields, logsummarizations.FieldContentSummarizedOutputs)
+	if m.window_since != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldWindowSince)
 	}
-	if m.from_platform != nil {
-		fields = append(fields, logsummarizations.FieldFromPlatform)
-	}
-	if m.prompt_token_usage != nil {
-		fields = append(fields, logsummarizations.FieldPromptTokenUsage)
+	if m.window_until != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldWindowUntil)
 	}
-	if m.completion_token_usage != nil {
-		fields = append(fields, logsummarizations.FieldCompletionTokenUsage)
+	if m.error != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldError)
 	}
-	if m.total_token_usage != nil {
-		fields = append(fields, logsummarizations.FieldTotalTokenUsage)
+	if m.is_auto_recap != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldIsAutoRecap)
 	}
-	if m.model_name != nil {
-		fields = append(fields, logsummarizations.FieldModelName)
+	if m.resolved != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldResolved)
 	}
 	if m.created_at != nil {
-		fields = append(fields, logsummarizations.FieldCreatedAt)
+		fields = append(fields, logchathistoriesrecapfailures.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, logsummarizations.FieldUpdatedAt)
+		fields = append(fields, logchathistoriesrecapfailures.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5027,31 +5539,25 @@ func (m *LogSummarizationsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LogSummarizationsMutation) Field(name string) (ent.Value, bool) {
+func (m *LogChatHistoriesRecapFailuresMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case logsummarizations.FieldContentURL:
-		return m.ContentURL()
-	case logsummarizations.FieldContentTitle:
-		return m.ContentTitle()
-	case logsummarizations.FieldContentAuthor:
-		return m.ContentAuthor()
-	case logsummarizations.FieldContentText:
-		return m.ContentText()
-	case logsummarizations.FieldContentSummarizedOutputs:
-		return m.ContentSummarizedOutputs()
-	case logsummarizations.FieldFromPlatform:
-		return m.FromPlatform()
-	case logsummarizations.FieldPromptTokenUsage:
-		return m.PromptTokenUsage()
-	case logsummarizations.FieldCompletionTokenUsage:
-		return m.CompletionTokenUsage()
-	case logsummarizations.FieldTotalTokenUsage:
-		return m.TotalTokenUsage()
-	case logsummarizations.FieldModelName:
-		return m.ModelName()
-	case logsummarizations.FieldCreatedAt:
+	case logchathistoriesrecapfailures.FieldChatID:
+		return m.ChatID()
+	case logchathistoriesrecapfailures.FieldWindowHours:
+		return m.WindowHours()
+	case logchathistoriesrecapfailures.FieldWindowSince:
+		return m.WindowSince()
+	case logchathistoriesrecapfailures.FieldWindowUntil:
+		return m.WindowUntil()
+	case logchathistoriesrecapfailures.FieldError:
+		return m.Error()
+	case logchathistoriesrecapfailures.FieldIsAutoRecap:
+		return m.IsAutoRecap()
+	case logchathistoriesrecapfailures.FieldResolved:
+		return m.Resolved()
+	case logchathistoriesrecapfailures.FieldCreatedAt:
 		return m.CreatedAt()
-	case logsummarizations.FieldUpdatedAt:
+	case logchathistoriesrecapfailures.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5060,119 +5566,92 @@ func (m *LogSummarizationsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LogSummarizationsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LogChatHistoriesRecapFailuresMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case logsummarizations.FieldContentURL:
-		return m.OldContentURL(ctx)
-	case logsummarizations.FieldContentTitle:
-		return m.OldContentTitle(ctx)
-	case logsummarizations.FieldContentAuthor:
-		return m.OldContentAuthor(ctx)
-	case logsummarizations.FieldContentText:
-		return m.OldContentText(ctx)
-	case logsummarizations.FieldContentSummarizedOutputs:
-		return m.OldContentSummarizedOutputs(ctx)
-	case logsummarizations.FieldFromPlatform:
-		return m.OldFromPlatform(ctx)
-	case logsummarizations.FieldPromptTokenUsage:
-		return m.OldPromptTokenUsage(ctx)
-	case logsummarizations.FieldCompletionTokenUsage:
-		return m.OldCompletionTokenUsage(ctx)
-	case logsummarizations.FieldTotalTokenUsage:
-		return m.OldTotalTokenUsage(ctx)
-	case logsummarizations.FieldModelName:
-		return m.OldModelName(ctx)
-	case logsummarizations.FieldCreatedAt:
+	case logchathistoriesrecapfailures.FieldChatID:
+		return m.OldChatID(ctx)
+	case logchathistoriesrecapfailures.FieldWindowHours:
+		return m.OldWindowHours(ctx)
+	case logchathistoriesrecapfailures.FieldWindowSince:
+		return m.OldWindowSince(ctx)
+	case logchathistoriesrecapfailures.FieldWindowUntil:
+		return m.OldWindowUntil(ctx)
+	case logchathistoriesrecapfailures.FieldError:
+		return m.OldError(ctx)
+	case logchathistoriesrecapfailures.FieldIsAutoRecap:
+		return m.OldIsAutoRecap(ctx)
+	case logchathistoriesrecapfailures.FieldResolved:
+		return m.OldResolved(ctx)
+	case logchathistoriesrecapfailures.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case logsummarizations.FieldUpdatedAt:
+	case logchathistoriesrecapfailures.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown LogSummarizations field %s", name)
+	return nil, fmt.Errorf("unknown LogChatHistoriesRecapFailures field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LogSummarizationsMutation) SetField(name string, value ent.Value) error {
+func (m *LogChatHistoriesRecapFailuresMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case logsummarizations.FieldContentURL:
-		v, ok := value.(string)
+	case logchathistoriesrecapfailures.FieldChatID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentURL(v)
+		m.SetChatID(v)
 		return nil
-	case logsummarizations.FieldContentTitle:
-		v, ok := value.(string)
+	case logchathistoriesrecapfailures.FieldWindowHours:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentTitle(v)
+		m.SetWindowHours(v)
 		return nil
-	case logsummarizations.FieldContentAuthor:
-		v, ok := value.(string)
+	case logchathistoriesrecapfailures.FieldWindowSince:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentAuthor(v)
+		m.SetWindowSince(v)
 		return nil
-	case logsummarizations.FieldContentText:
-		v, ok := value.(string)
+	case logchathistoriesrecapfailures.FieldWindowUntil:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentText(v)
+		m.SetWindowUntil(v)
 		return nil
-	case logsummarizations.FieldContentSummarizedOutputs:
+	case logchathistoriesrecapfailures.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentSummarizedOutputs(v)
-		return nil
-	case logsummarizations.FieldFromPlatform:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFromPlatform(v)
-		return nil
-	case logsummarizations.FieldPromptTokenUsage:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptTokenUsage(v)
+		m.SetError(v)
 		return nil
-	case logsummarizations.FieldCompletionTokenUsage:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionTokenUsage(v)
-		return nil
-	case logsummarizations.FieldTotalTokenUsage:
-		v, ok := value.(int)
+	case logchathistoriesrecapfailures.FieldIsAutoRecap:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalTokenUsage(v)
+		m.SetIsAutoRecap(v)
 		return nil
-	case logsummarizations.FieldModelName:
-		v, ok := value.(string)
+	case logchathistoriesrecapfailures.FieldResolved:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelName(v)
+		m.SetResolved(v)
 		return nil
-	case logsummarizations.FieldCreatedAt:
+	case logchathistoriesrecapfailures.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case logsummarizations.FieldUpdatedAt:
+	case logchathistoriesrecapfailures.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5180,30 +5659,30 @@ func (m *LogSummarizationsMutation) SetField(name string, value ent.Value) error
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LogSummarizations field %s", name)
+	return fmt.Errorf("unknown LogChatHistoriesRecapFailures field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LogSummarizationsMutation) AddedFields() []string {
+func (m *LogChatHistoriesRecapFailuresMutation) AddedFields() []string {
 	var fields []string
-	if m.addfrom_platform != nil {
-		fields = append(fields, logsummarizations.FieldFromPlatform)
+	if m.addchat_id != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldChatID)
 	}
-	if m.addprompt_token_usage != nil {
-		fields = append(fields, logsummarizations.FieldPromptTokenUsage)
+	if m.addwindow_hours != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldWindowHours)
 	}
-	if m.addcompletion_token_usage != nil {
-		fields = append(fields, logsummarizations.FieldCompletionTokenUsage)
+	if m.addwindow_since != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldWindowSince)
 	}
-	if m.addtotal_token_usage != nil {
-		fields = append(fields, logsummarizations.FieldTotalTokenUsage)
+	if m.addwindow_until != nil {
+		fields = append(fields, logchathistoriesrecapfailures.FieldWindowUntil)
 	}
 	if m.addcreated_at != nil {
-		fields = append(fields, logsummarizations.FieldCreatedAt)
+		fields = append(fields, logchathistoriesrecapfailures.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, logsummarizations.FieldUpdatedAt)
+		fields = append(fields, logchathistoriesrecapfailures.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5211,19 +5690,19 @@ func (m *LogSummarizationsMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LogSummarizationsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LogChatHistoriesRecapFailuresMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case logsummarizations.FieldFromPlatform:
-		return m.AddedFromPlatform()
-	case logsummarizations.FieldPromptTokenUsage:
-		return m.AddedPromptTokenUsage()
-	case logsummarizations.FieldCompletionTokenUsage:
-		return m.AddedCompletionTokenUsage()
-	case logsummarizations.FieldTotalTokenUsage:
-		return m.AddedTotalTokenUsage()
-	case logsummarizations.FieldCreatedAt:
+	case logchathistoriesrecapfailures.FieldChatID:
+		return m.AddedChatID()
+	case logchathistoriesrecapfailures.FieldWindowHours:
+		return m.AddedWindowHours()
+	case logchathistoriesrecapfailures.FieldWindowSince:
+		return m.AddedWindowSince()
+	case logchathistoriesrecapfailures.FieldWindowUntil:
+		return m.AddedWindowUntil()
+	case logchathistoriesrecapfailures.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case logsummarizations.FieldUpdatedAt:
+	case logchathistoriesrecapfailures.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
 	}
 	return nil, false
@@ -5232,44 +5711,44 @@ func (m *LogSummarizationsMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LogSummarizationsMutation) AddField(name string, value ent.Value) error {
+func (m *LogChatHistoriesRecapFailuresMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case logsummarizations.FieldFromPlatform:
-		v, ok := value.(int)
+	case logchathistoriesrecapfailures.FieldChatID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFromPlatform(v)
+		m.AddChatID(v)
 		return nil
-	case logsummarizations.FieldPromptTokenUsage:
+	case logchathistoriesrecapfailures.FieldWindowHours:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPromptTokenUsage(v)
+		m.AddWindowHours(v)
 		return nil
-	case logsummarizations.FieldCompletionTokenUsage:
-		v, ok := value.(int)
+	case logchathistoriesrecapfailures.FieldWindowSince:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCompletionTokenUsage(v)
+		m.AddWindowSince(v)
 		return nil
-	case logsummarizations.FieldTotalTokenUsage:
-		v, ok := value.(int)
+	case logchathistoriesrecapfailures.FieldWindowUntil:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalTokenUsage(v)
+		m.AddWindowUntil(v)
 		return nil
-	case logsummarizations.FieldCreatedAt:
+	case logchathistoriesrecapfailures.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case logsummarizations.FieldUpdatedAt:
+	case logchathistoriesrecapfailures.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5277,179 +5756,174 @@ func (m *LogSummarizationsMutation) AddField(name string, value ent.Value) error
 		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LogSummarizations numeric field %s", name)
+	return fmt.Errorf("unknown LogChatHistoriesRecapFailures numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LogSummarizationsMutation) ClearedFields() []string {
+func (m *LogChatHistoriesRecapFailuresMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LogSummarizationsMutation) FieldCleared(name string) bool {
+func (m *LogChatHistoriesRecapFailuresMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LogSummarizationsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown LogSummarizations nullable field %s", name)
+func (m *LogChatHistoriesRecapFailuresMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LogChatHistoriesRecapFailures nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LogSummarizationsMutation) ResetField(name string) error {
+func (m *LogChatHistoriesRecapFailuresMutation) ResetField(name string) error {
 	switch name {
-	case logsummarizations.FieldContentURL:
-		m.ResetContentURL()
-		return nil
-	case logsummarizations.FieldContentTitle:
-		m.ResetContentTitle()
-		return nil
-	case logsummarizations.FieldContentAuthor:
-		m.ResetContentAuthor()
-		return nil
-	case logsummarizations.FieldContentText:
-		m.ResetContentText()
+	case logchathistoriesrecapfailures.FieldChatID:
+		m.ResetChatID()
 		return nil
-	case logsummarizations.FieldContentSummarizedOutputs:
-		m.ResetContentSummarizedOutputs()
+	case logchathistoriesrecapfailures.FieldWindowHours:
+		m.ResetWindowHours()
 		return nil
-	case logsummarizations.FieldFromPlatform:
-		m.ResetFromPlatform()
+	case logchathistoriesrecapfailures.FieldWindowSince:
+		m.ResetWindowSince()
 		return nil
-	case logsummarizations.FieldPromptTokenUsage:
-		m.ResetPromptTokenUsage()
+	case logchathistoriesrecapfailures.FieldWindowUntil:
+		m.ResetWindowUntil()
 		return nil
-	case logsummarizations.FieldCompletionTokenUsage:
-		m.ResetCompletionTokenUsage()
+	case logchathistoriesrecapfailures.FieldError:
+		m.ResetError()
 		return nil
-	case logsummarizations.FieldTotalTokenUsage:
-		m.ResetTotalTokenUsage()
+	case logchathistoriesrecapfailures.FieldIsAutoRecap:
+		m.ResetIsAutoRecap()
 		return nil
-	case logsummarizations.FieldModelName:
-		m.ResetModelName()
+	case logchathistoriesrecapfailures.FieldResolved:
+		m.ResetResolved()
 		return nil
-	case logsummarizations.FieldCreatedAt:
+	case logchathistoriesrecapfailures.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case logsummarizations.FieldUpdatedAt:
+	case logchathistoriesrecapfailures.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown LogSummarizations field %s", name)
+	return fmt.Errorf("unknown LogChatHistoriesRecapFailures field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LogSummarizationsMutation) AddedEdges() []string {
+func (m *LogChatHistoriesRecapFailuresMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LogSummarizationsMutation) AddedIDs(name string) []ent.Value {
+func (m *LogChatHistoriesRecapFailuresMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LogSummarizationsMutation) RemovedEdges() []string {
+func (m *LogChatHistoriesRecapFailuresMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LogSummarizationsMutation) RemovedIDs(name string) []ent.Value {
+func (m *LogChatHistoriesRecapFailuresMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LogSummarizationsMutation) ClearedEdges() []string {
+func (m *LogChatHistoriesRecapFailuresMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LogSummarizationsMutation) EdgeCleared(name string) bool {
+func (m *LogChatHistoriesRecapFailuresMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LogSummarizationsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown LogSummarizations unique edge %s", name)
+func (m *LogChatHistoriesRecapFailuresMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LogChatHistoriesRecapFailures unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LogSummarizationsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown LogSummarizations edge %s", name)
+func (m *LogChatHistoriesRecapFailuresMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LogChatHistoriesRecapFailures edge %s", name)
 }
 
-// MetricOpenAIChatCompletionTokenUsageMutation represents an operation that mutates the MetricOpenAIChatCompletionTokenUsage nodes in the graph.
-type MetricOpenAIChatCompletionTokenUsageMutation struct {
+// LogSummarizationsMutation represents an operation that mutates the LogSummarizations nodes in the graph.
+type LogSummarizationsMutation struct {
 	config
-	op                             Op
-	typ                            string
-	id                             *uuid.UUID
-	prompt_operation               *string
-	prompt_character_length        *int
-	addprompt_character_length     *int
-	prompt_token_usage             *int
-	addprompt_token_usage          *int
-	completion_character_length    *int
-	addcompletion_character_length *int
-	completion_token_usage         *int
-	addcompletion_token_usage      *int
-	total_token_usage              *int
-	addtotal_token_usage           *int
-	model_name                     *string
-	created_at                     *int64
-	addcreated_at                  *int64
-	clearedFields                  map[string]struct{}
-	done                           bool
-	oldValue                       func(context.Context) (*MetricOpenAIChatCompletionTokenUsage, error)
-	predicates                     []predicate.MetricOpenAIChatCompletionTokenUsage
-}
-
-var _ ent.Mutation = (*MetricOpenAIChatCompletionTokenUsageMutation)(nil)
-
-// metricopenaichatcompletiontokenusageOption allows management of the mutation configuration using functional options.
-type metricopenaichatcompletiontokenusageOption func(*MetricOpenAIChatCompletionTokenUsageMutation)
-
-// newMetricOpenAIChatCompletionTokenUsageMutation creates new mutation for the MetricOpenAIChatCompletionTokenUsage entity.
-func newMetricOpenAIChatCompletionTokenUsageMutation(c config, op Op, opts ...metricopenaichatcompletiontokenusageOption) *MetricOpenAIChatCompletionTokenUsageMutation {
-	m := &MetricOpenAIChatCompletionTokenUsageMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMetricOpenAIChatCompletionTokenUsage,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withMetricOpenAIChatCompletionTokenUsageID sets the ID field of the mutation.
-func withMetricOpenAIChatCompletionTokenUsageID(id uuid.UUID) metricopenaichatcompletiontokenusageOption {
-	return func(m *MetricOpenAIChatCompletionTokenUsageMutation) {
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	content_url                *string
+	content_title              *string
+	content_author             *string
+	content_text               *string
+	content_summarized_outputs *string
+	from_platform              *int
+	addfrom_platform           *int
+	prompt_token_usage         *int
+	addprompt_token_usage      *int
+	completion_token_usage     *int
+	addcompletion_token_usage  *int
+	total_token_usage          *int
+	addtotal_token_usage       *int
+	model_name                 *string
+	created_at                 *int64
+	addcreated_at              *int64
+	updated_at                 *int64
+	addupdated_at              *int64
+	clearedFields              map[string]struct{}
+	done                       bool
+	oldValue                   func(context.Context) (*LogSummarizations, error)
+	predicates                 []predicate.LogSummarizations
+}
+
+var _ ent.Mutation = (*LogSummarizationsMutation)(nil)
+
+// logsummarizationsOption allows management of the mutation configuration using functional options.
+type logsummarizationsOption func(*LogSummarizationsMutation)
+
+// newLogSummarizationsMutation creates new mutation for the LogSummarizations entity.
+func newLogSummarizationsMutation(c config, op Op, opts ...logsummarizationsOption) *LogSummarizationsMutation {
+	m := &LogSummarizationsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLogSummarizations,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLogSummarizationsID sets the ID field of the mutation.
+func withLogSummarizationsID(id uuid.UUID) logsummarizationsOption {
+	return func(m *LogSummarizationsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MetricOpenAIChatCompletionTokenUsage
+			value *LogSummarizations
 		)
-		m.oldValue = func(ctx context.Context) (*MetricOpenAIChatCompletionTokenUsage, error) {
+		m.oldValue = func(ctx context.Context) (*LogSummarizations, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MetricOpenAIChatCompletionTokenUsage.Get(ctx, id)
+					value, err = m.Client().LogSummarizations.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5458,10 +5932,10 @@ func withMetricOpenAIChatCompletionTokenUsageID(id uuid.UUID) metricopenaichatco
 	}
 }
 
-// withMetricOpenAIChatCompletionTokenUsage sets the old MetricOpenAIChatCompletionTokenUsage of the mutation.
-func withMetricOpenAIChatCompletionTokenUsage(node *MetricOpenAIChatCompletionTokenUsage) metricopenaichatcompletiontokenusageOption {
-	return func(m *MetricOpenAIChatCompletionTokenUsageMutation) {
-		m.oldValue = func(context.Context) (*MetricOpenAIChatCompletionTokenUsage, error) {
+// withLogSummarizations sets the old LogSummarizations of the mutation.
+func withLogSummarizations(node *LogSummarizations) logsummarizationsOption {
+	return func(m *LogSummarizationsMutation) {
+		m.oldValue = func(context.Context) (*LogSummarizations, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5470,7 +5944,7 @@ func withMetricOpenAIChatCompletionTokenUsage(node *MetricOpenAIChatCompletionTo
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MetricOpenAIChatCompletionTokenUsageMutation) Client() *Client {
+func (m LogSummarizationsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5478,7 +5952,7 @@ func (m MetricOpenAIChatCompletionTokenUsageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MetricOpenAIChatCompletionTokenUsageMutation) Tx() (*Tx, error) {
+func (m LogSummarizationsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5488,14 +5962,14 @@ func (m MetricOpenAIChatCompletionTokenUsageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MetricOpenAIChatCompletionTokenUsage entities.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of LogSummarizations entities.
+func (m *LogSummarizationsMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *LogSummarizationsMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5506,7 +5980,7 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) ID() (id uuid.UUID, exist
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *LogSummarizationsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5515,224 +5989,312 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) IDs(ctx context.Context)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MetricOpenAIChatCompletionTokenUsage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LogSummarizations.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPromptOperation sets the "prompt_operation" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetPromptOperation(s string) {
-	m.prompt_operation = &s
+// SetContentURL sets the "content_url" field.
+func (m *LogSummarizationsMutation) SetContentURL(s string) {
+	m.content_url = &s
 }
 
-// PromptOperation returns the value of the "prompt_operation" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) PromptOperation() (r string, exists bool) {
-	v := m.prompt_operation
+// ContentURL returns the value of the "content_url" field in the mutation.
+func (m *LogSummarizationsMutation) ContentURL() (r string, exists bool) {
+	v := m.content_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromptOperation returns the old "prompt_operation" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldContentURL returns the old "content_url" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldPromptOperation(ctx context.Context) (v string, err error) {
+func (m *LogSummarizationsMutation) OldContentURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptOperation is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptOperation requires an ID field in the mutation")
+		return v, errors.New("OldContentURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptOperation: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentURL: %w", err)
 	}
-	return oldValue.PromptOperation, nil
+	return oldValue.ContentURL, nil
 }
 
-// ResetPromptOperation resets all changes to the "prompt_operation" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetPromptOperation() {
-	m.prompt_operation = nil
+// ResetContentURL resets all changes to the "content_url" field.
+func (m *LogSummarizationsMutation) ResetContentURL() {
+	m.content_url = nil
 }
 
-// SetPromptCharacterLength sets the "prompt_character_length" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetPromptCharacterLength(i int) {
-	m.prompt_character_length = &i
-	m.addprompt_character_length = nil
+// SetContentTitle sets the "content_title" field.
+func (m *LogSummarizationsMutation) SetContentTitle(s string) {
+	m.content_title = &s
 }
 
-// PromptCharacterLength returns the value of the "prompt_character_length" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) PromptCharacterLength() (r int, exists bool) {
-	v := m.prompt_character_length
+// ContentTitle returns the value of the "content_title" field in the mutation.
+func (m *LogSummarizationsMutation) ContentTitle() (r string, exists bool) {
+	v := m.content_title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromptCharacterLength returns the old "prompt_character_length" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldContentTitle returns the old "content_title" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldPromptCharacterLength(ctx context.Context) (v int, err error) {
+func (m *LogSummarizationsMutation) OldContentTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptCharacterLength is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptCharacterLength requires an ID field in the mutation")
+		return v, errors.New("OldContentTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptCharacterLength: %w", err)
-	}
-	return oldValue.PromptCharacterLength, nil
-}
-
-// AddPromptCharacterLength adds i to the "prompt_character_length" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddPromptCharacterLength(i int) {
-	if m.addprompt_character_length != nil {
-		*m.addprompt_character_length += i
-	} else {
-		m.addprompt_character_length = &i
-	}
-}
-
-// AddedPromptCharacterLength returns the value that was added to the "prompt_character_length" field in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedPromptCharacterLength() (r int, exists bool) {
-	v := m.addprompt_character_length
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldContentTitle: %w", err)
 	}
-	return *v, true
+	return oldValue.ContentTitle, nil
 }
 
-// ResetPromptCharacterLength resets all changes to the "prompt_character_length" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetPromptCharacterLength() {
-	m.prompt_character_length = nil
-	m.addprompt_character_length = nil
+// ResetContentTitle resets all changes to the "content_title" field.
+func (m *LogSummarizationsMutation) ResetContentTitle() {
+	m.content_title = nil
 }
 
-// SetPromptTokenUsage sets the "prompt_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetPromptTokenUsage(i int) {
-	m.prompt_token_usage = &i
-	m.addprompt_token_usage = nil
+// SetContentAuthor sets the "content_author" field.
+func (m *LogSummarizationsMutation) SetContentAuthor(s string) {
+	m.content_author = &s
 }
 
-// PromptTokenUsage returns the value of the "prompt_token_usage" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) PromptTokenUsage() (r int, exists bool) {
-	v := m.prompt_token_usage
+// ContentAuthor returns the value of the "content_author" field in the mutation.
+func (m *LogSummarizationsMutation) ContentAuthor() (r string, exists bool) {
+	v := m.content_author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromptTokenUsage returns the old "prompt_token_usage" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldContentAuthor returns the old "content_author" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldPromptTokenUsage(ctx context.Context) (v int, err error) {
+func (m *LogSummarizationsMutation) OldContentAuthor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptTokenUsage is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentAuthor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptTokenUsage requires an ID field in the mutation")
+		return v, errors.New("OldContentAuthor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptTokenUsage: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentAuthor: %w", err)
 	}
-	return oldValue.PromptTokenUsage, nil
+	return oldValue.ContentAuthor, nil
 }
 
-// AddPromptTokenUsage adds i to the "prompt_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddPromptTokenUsage(i int) {
-	if m.addprompt_token_usage != nil {
-		*m.addprompt_token_usage += i
-	} else {
-		m.addprompt_token_usage = &i
-	}
+// ResetContentAuthor resets all changes to the "content_author" field.
+func (m *LogSummarizationsMutation) ResetContentAuthor() {
+	m.content_author = nil
 }
 
-// AddedPromptTokenUsage returns the value that was added to the "prompt_token_usage" field in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedPromptTokenUsage() (r int, exists bool) {
-	v := m.addprompt_token_usage
+// SetContentText sets the "content_text" field.
+func (m *LogSummarizationsMutation) SetContentText(s string) {
+	m.content_text = &s
+}
+
+// ContentText returns the value of the "content_text" field in the mutation.
+func (m *LogSummarizationsMutation) ContentText() (r string, exists bool) {
+	v := m.content_text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPromptTokenUsage resets all changes to the "prompt_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetPromptTokenUsage() {
-	m.prompt_token_usage = nil
-	m.addprompt_token_usage = nil
+// OldContentText returns the old "content_text" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogSummarizationsMutation) OldContentText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentText: %w", err)
+	}
+	return oldValue.ContentText, nil
 }
 
-// SetCompletionCharacterLength sets the "completion_character_length" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetCompletionCharacterLength(i int) {
-	m.completion_character_length = &i
-	m.addcompletion_character_length = nil
+// ResetContentText resets all changes to the "content_text" field.
+func (m *LogSummarizationsMutation) ResetContentText() {
+	m.content_text = nil
 }
 
-// CompletionCharacterLength returns the value of the "completion_character_length" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) CompletionCharacterLength() (r int, exists bool) {
-	v := m.completion_character_length
+// SetContentSummarizedOutputs sets the "content_summarized_outputs" field.
+func (m *LogSummarizationsMutation) SetContentSummarizedOutputs(s string) {
+	m.content_summarized_outputs = &s
+}
+
+// ContentSummarizedOutputs returns the value of the "content_summarized_outputs" field in the mutation.
+func (m *LogSummarizationsMutation) ContentSummarizedOutputs() (r string, exists bool) {
+	v := m.content_summarized_outputs
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletionCharacterLength returns the old "completion_character_length" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldContentSummarizedOutputs returns the old "content_summarized_outputs" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCompletionCharacterLength(ctx context.Context) (v int, err error) {
+func (m *LogSummarizationsMutation) OldContentSummarizedOutputs(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionCharacterLength is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentSummarizedOutputs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionCharacterLength requires an ID field in the mutation")
+		return v, errors.New("OldContentSummarizedOutputs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionCharacterLength: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentSummarizedOutputs: %w", err)
 	}
-	return oldValue.CompletionCharacterLength, nil
+	return oldValue.ContentSummarizedOutputs, nil
 }
 
-// AddCompletionCharacterLength adds i to the "completion_character_length" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCompletionCharacterLength(i int) {
-	if m.addcompletion_character_length != nil {
-		*m.addcompletion_character_length += i
+// ResetContentSummarizedOutputs resets all changes to the "content_summarized_outputs" field.
+func (m *LogSummarizationsMutation) ResetContentSummarizedOutputs() {
+	m.content_summarized_outputs = nil
+}
+
+// SetFromPlatform sets the "from_platform" field.
+func (m *LogSummarizationsMutation) SetFromPlatform(i int) {
+	m.from_platform = &i
+	m.addfrom_platform = nil
+}
+
+// FromPlatform returns the value of the "from_platform" field in the mutation.
+func (m *LogSummarizationsMutation) FromPlatform() (r int, exists bool) {
+	v := m.from_platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromPlatform returns the old "from_platform" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogSummarizationsMutation) OldFromPlatform(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromPlatform: %w", err)
+	}
+	return oldValue.FromPlatform, nil
+}
+
+// AddFromPlatform adds i to the "from_platform" field.
+func (m *LogSummarizationsMutation) AddFromPlatform(i int) {
+	if m.addfrom_platform != nil {
+		*m.addfrom_platform += i
 	} else {
-		m.addcompletion_character_length = &i
+		m.addfrom_platform = &i
 	}
 }
 
-// AddedCompletionCharacterLength returns the value that was added to the "completion_character_length" field in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCompletionCharacterLength() (r int, exists bool) {
-	v := m.addcompletion_character_length
+// AddedFromPlatform returns the value that was added to the "from_platform" field in this mutation.
+func (m *LogSummarizationsMutation) AddedFromPlatform() (r int, exists bool) {
+	v := m.addfrom_platform
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCompletionCharacterLength resets all changes to the "completion_character_length" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetCompletionCharacterLength() {
-	m.completion_character_length = nil
-	m.addcompletion_character_length = nil
+// ResetFromPlatform resets all changes to the "from_platform" field.
+func (m *LogSummarizationsMutation) ResetFromPlatform() {
+	m.from_platform = nil
+	m.addfrom_platform = nil
+}
+
+// SetPromptTokenUsage sets the "prompt_token_usage" field.
+func (m *LogSummarizationsMutation) SetPromptTokenUsage(i int) {
+	m.prompt_token_usage = &i
+	m.addprompt_token_usage = nil
+}
+
+// PromptTokenUsage returns the value of the "prompt_token_usage" field in the mutation.
+func (m *LogSummarizationsMutation) PromptTokenUsage() (r int, exists bool) {
+	v := m.prompt_token_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokenUsage returns the old "prompt_token_usage" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogSummarizationsMutation) OldPromptTokenUsage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokenUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokenUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokenUsage: %w", err)
+	}
+	return oldValue.PromptTokenUsage, nil
+}
+
+// AddPromptTokenUsage adds i to the "prompt_token_usage" field.
+func (m *LogSummarizationsMutation) AddPromptTokenUsage(i int) {
+	if m.addprompt_token_usage != nil {
+		*m.addprompt_token_usage += i
+	} else {
+		m.addprompt_token_usage = &i
+	}
+}
+
+// AddedPromptTokenUsage returns the value that was added to the "prompt_token_usage" field in this mutation.
+func (m *LogSummarizationsMutation) AddedPromptTokenUsage() (r int, exists bool) {
+	v := m.addprompt_token_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPromptTokenUsage resets all changes to the "prompt_token_usage" field.
+func (m *LogSummarizationsMutation) ResetPromptTokenUsage() {
+	m.prompt_token_usage = nil
+	m.addprompt_token_usage = nil
 }
 
 // SetCompletionTokenUsage sets the "completion_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetCompletionTokenUsage(i int) {
+func (m *LogSummarizationsMutation) SetCompletionTokenUsage(i int) {
 	m.completion_token_usage = &i
 	m.addcompletion_token_usage = nil
 }
 
 // CompletionTokenUsage returns the value of the "completion_token_usage" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) CompletionTokenUsage() (r int, exists bool) {
+func (m *LogSummarizationsMutation) CompletionTokenUsage() (r int, exists bool) {
 	v := m.completion_token_usage
 	if v == nil {
 		return
@@ -5740,10 +6302,10 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) CompletionTokenUsage() (r
 	return *v, true
 }
 
-// OldCompletionTokenUsage returns the old "completion_token_usage" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletionTokenUsage returns the old "completion_token_usage" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCompletionTokenUsage(ctx context.Context) (v int, err error) {
+func (m *LogSummarizationsMutation) OldCompletionTokenUsage(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCompletionTokenUsage is only allowed on UpdateOne operations")
 	}
@@ -5758,7 +6320,7 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCompletionTokenUsage(c
 }
 
 // AddCompletionTokenUsage adds i to the "completion_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCompletionTokenUsage(i int) {
+func (m *LogSummarizationsMutation) AddCompletionTokenUsage(i int) {
 	if m.addcompletion_token_usage != nil {
 		*m.addcompletion_token_usage += i
 	} else {
@@ -5767,7 +6329,7 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCompletionTokenUsage(i
 }
 
 // AddedCompletionTokenUsage returns the value that was added to the "completion_token_usage" field in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCompletionTokenUsage() (r int, exists bool) {
+func (m *LogSummarizationsMutation) AddedCompletionTokenUsage() (r int, exists bool) {
 	v := m.addcompletion_token_usage
 	if v == nil {
 		return
@@ -5776,19 +6338,19 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCompletionTokenUsage
 }
 
 // ResetCompletionTokenUsage resets all changes to the "completion_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetCompletionTokenUsage() {
+func (m *LogSummarizationsMutation) ResetCompletionTokenUsage() {
 	m.completion_token_usage = nil
 	m.addcompletion_token_usage = nil
 }
 
 // SetTotalTokenUsage sets the "total_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetTotalTokenUsage(i int) {
+func (m *LogSummarizationsMutation) SetTotalTokenUsage(i int) {
 	m.total_token_usage = &i
 	m.addtotal_token_usage = nil
 }
 
 // TotalTokenUsage returns the value of the "total_token_usage" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) TotalTokenUsage() (r int, exists bool) {
+func (m *LogSummarizationsMutation) TotalTokenUsage() (r int, exists bool) {
 	v := m.total_token_usage
 	if v == nil {
 		return
@@ -5796,10 +6358,10 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) TotalTokenUsage() (r int,
 	return *v, true
 }
 
-// OldTotalTokenUsage returns the old "total_token_usage" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalTokenUsage returns the old "total_token_usage" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldTotalTokenUsage(ctx context.Context) (v int, err error) {
+func (m *LogSummarizationsMutation) OldTotalTokenUsage(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTotalTokenUsage is only allowed on UpdateOne operations")
 	}
@@ -5814,7 +6376,7 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldTotalTokenUsage(ctx co
 }
 
 // AddTotalTokenUsage adds i to the "total_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddTotalTokenUsage(i int) {
+func (m *LogSummarizationsMutation) AddTotalTokenUsage(i int) {
 	if m.addtotal_token_usage != nil {
 		*m.addtotal_token_usage += i
 	} else {
@@ -5823,7 +6385,7 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddTotalTokenUsage(i int)
 }
 
 // AddedTotalTokenUsage returns the value that was added to the "total_token_usage" field in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedTotalTokenUsage() (r int, exists bool) {
+func (m *LogSummarizationsMutation) AddedTotalTokenUsage() (r int, exists bool) {
 	v := m.addtotal_token_usage
 	if v == nil {
 		return
@@ -5832,18 +6394,18 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedTotalTokenUsage() (r
 }
 
 // ResetTotalTokenUsage resets all changes to the "total_token_usage" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetTotalTokenUsage() {
+func (m *LogSummarizationsMutation) ResetTotalTokenUsage() {
 	m.total_token_usage = nil
 	m.addtotal_token_usage = nil
 }
 
 // SetModelName sets the "model_name" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetModelName(s string) {
+func (m *LogSummarizationsMutation) SetModelName(s string) {
 	m.model_name = &s
 }
 
 // ModelName returns the value of the "model_name" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ModelName() (r string, exists bool) {
+func (m *LogSummarizationsMutation) ModelName() (r string, exists bool) {
 	v := m.model_name
 	if v == nil {
 		return
@@ -5851,10 +6413,10 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) ModelName() (r string, ex
 	return *v, true
 }
 
-// OldModelName returns the old "model_name" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldModelName returns the old "model_name" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldModelName(ctx context.Context) (v string, err error) {
+func (m *LogSummarizationsMutation) OldModelName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldModelName is only allowed on UpdateOne operations")
 	}
@@ -5869,18 +6431,18 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldModelName(ctx context.
 }
 
 // ResetModelName resets all changes to the "model_name" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetModelName() {
+func (m *LogSummarizationsMutation) ResetModelName() {
 	m.model_name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetCreatedAt(i int64) {
+func (m *LogSummarizationsMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) CreatedAt() (r int64, exists bool) {
+func (m *LogSummarizationsMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5888,10 +6450,10 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) CreatedAt() (r int64, exi
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
-// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *LogSummarizationsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5906,7 +6468,7 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCreatedAt(ctx context.
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCreatedAt(i int64) {
+func (m *LogSummarizationsMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -5915,7 +6477,7 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *LogSummarizationsMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -5924,20 +6486,76 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCreatedAt() (r int64
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetCreatedAt() {
+func (m *LogSummarizationsMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// Where appends a list predicates to the MetricOpenAIChatCompletionTokenUsageMutation builder.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) Where(ps ...predicate.MetricOpenAIChatCompletionTokenUsage) {
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LogSummarizationsMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LogSummarizationsMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LogSummarizations entity.
+// If the LogSummarizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LogSummarizationsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *LogSummarizationsMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *LogSummarizationsMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LogSummarizationsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the LogSummarizationsMutation builder.
+func (m *LogSummarizationsMutation) Where(ps ...predicate.LogSummarizations) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MetricOpenAIChatCompletionTokenUsageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LogSummarizationsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MetricOpenAIChatCompletionTokenUsage, len(ps))
+func (m *LogSummarizationsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LogSummarizations, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5945,48 +6563,60 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) WhereP(ps ...func(*sql.Se
 }
 
 // Op returns the operation name.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) Op() Op {
+func (m *LogSummarizationsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetOp(op Op) {
+func (m *LogSummarizationsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MetricOpenAIChatCompletionTokenUsage).
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) Type() string {
+// Type returns the node type of this mutation (LogSummarizations).
+func (m *LogSummarizationsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.prompt_operation != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptOperation)
+func (m *LogSummarizationsMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.content_url != nil {
+		fields = append(fields, logsummarizations.FieldContentURL)
 	}
-	if m.prompt_character_length != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptCharacterLength)
+	if m.content_title != nil {
+		fields = append(fields, logsummarizations.FieldContentTitle)
 	}
-	if m.prompt_token_usage != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptTokenUsage)
+	if m.content_author != nil {
+		fields = append(fields, logsummarizations.FieldContentAuthor)
 	}
-	if m.completion_character_length != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength)
+	if m.content_text != nil {
+		fields = append(fields, logsummarizations.FieldContentText)
+	}
+	if m.content_summarized_outputs != nil {
+		fields = append(fields, logsummarizations.FieldContentSummarizedOutputs)
+	}
+	if m.from_platform != nil {
+		fields = append(fields, logsummarizations.FieldFromPlatform)
+	}
+	if m.prompt_token_usage != nil {
+		fields = append(fields, logsummarizations.FieldPromptTokenUsage)
 	}
 	if m.completion_token_usage != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage)
+		fields = append(fields, logsummarizations.FieldCompletionTokenUsage)
 	}
 	if m.total_token_usage != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldTotalTokenUsage)
+		fields = append(fields, logsummarizations.FieldTotalTokenUsage)
 	}
 	if m.model_name != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldModelName)
+		fields = append(fields, logsummarizations.FieldModelName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCreatedAt)
+		fields = append(fields, logsummarizations.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, logsummarizations.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5994,24 +6624,32 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) Field(name string) (ent.Value, bool) {
+func (m *LogSummarizationsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
-		return m.PromptOperation()
-	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
-		return m.PromptCharacterLength()
-	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+	case logsummarizations.FieldContentURL:
+		return m.ContentURL()
+	case logsummarizations.FieldContentTitle:
+		return m.ContentTitle()
+	case logsummarizations.FieldContentAuthor:
+		return m.ContentAuthor()
+	case logsummarizations.FieldContentText:
+		return m.ContentText()
+	case logsummarizations.FieldContentSummarizedOutputs:
+		return m.ContentSummarizedOutputs()
+	case logsummarizations.FieldFromPlatform:
+		return m.FromPlatform()
+	case logsummarizations.FieldPromptTokenUsage:
 		return m.PromptTokenUsage()
-	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
-		return m.CompletionCharacterLength()
-	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+	case logsummarizations.FieldCompletionTokenUsage:
 		return m.CompletionTokenUsage()
-	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+	case logsummarizations.FieldTotalTokenUsage:
 		return m.TotalTokenUsage()
-	case metricopenaichatcompletiontokenusage.FieldModelName:
+	case logsummarizations.FieldModelName:
 		return m.ModelName()
-	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
+	case logsummarizations.FieldCreatedAt:
 		return m.CreatedAt()
+	case logsummarizations.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6019,114 +6657,150 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) Field(name string) (ent.V
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LogSummarizationsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
-		return m.OldPromptOperation(ctx)
-	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
-		return m.OldPromptCharacterLength(ctx)
-	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+	case logsummarizations.FieldContentURL:
+		return m.OldContentURL(ctx)
+	case logsummarizations.FieldContentTitle:
+		return m.OldContentTitle(ctx)
+	case logsummarizations.FieldContentAuthor:
+		return m.OldContentAuthor(ctx)
+	case logsummarizations.FieldContentText:
+		return m.OldContentText(ctx)
+	case logsummarizations.FieldContentSummarizedOutputs:
+		return m.OldContentSummarizedOutputs(ctx)
+	case logsummarizations.FieldFromPlatform:
+		return m.OldFromPlatform(ctx)
+	case logsummarizations.FieldPromptTokenUsage:
 		return m.OldPromptTokenUsage(ctx)
-	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
-		return m.OldCompletionCharacterLength(ctx)
-	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+	case logsummarizations.FieldCompletionTokenUsage:
 		return m.OldCompletionTokenUsage(ctx)
-	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+	case logsummarizations.FieldTotalTokenUsage:
 		return m.OldTotalTokenUsage(ctx)
-	case metricopenaichatcompletiontokenusage.FieldModelName:
+	case logsummarizations.FieldModelName:
 		return m.OldModelName(ctx)
-	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
+	case logsummarizations.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case logsummarizations.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage field %s", name)
+	return nil, fmt.Errorf("unknown LogSummarizations field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetField(name string, value ent.Value) error {
+func (m *LogSummarizationsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
+	case logsummarizations.FieldContentURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromptOperation(v)
+		m.SetContentURL(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
-		v, ok := value.(int)
+	case logsummarizations.FieldContentTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromptCharacterLength(v)
+		m.SetContentTitle(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+	case logsummarizations.FieldContentAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentAuthor(v)
+		return nil
+	case logsummarizations.FieldContentText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentText(v)
+		return nil
+	case logsummarizations.FieldContentSummarizedOutputs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentSummarizedOutputs(v)
+		return nil
+	case logsummarizations.FieldFromPlatform:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromptTokenUsage(v)
+		m.SetFromPlatform(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
+	case logsummarizations.FieldPromptTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletionCharacterLength(v)
+		m.SetPromptTokenUsage(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+	case logsummarizations.FieldCompletionTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCompletionTokenUsage(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+	case logsummarizations.FieldTotalTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTotalTokenUsage(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldModelName:
+	case logsummarizations.FieldModelName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetModelName(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
+	case logsummarizations.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case logsummarizations.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage field %s", name)
+	return fmt.Errorf("unknown LogSummarizations field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedFields() []string {
+func (m *LogSummarizationsMutation) AddedFields() []string {
 	var fields []string
-	if m.addprompt_character_length != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptCharacterLength)
+	if m.addfrom_platform != nil {
+		fields = append(fields, logsummarizations.FieldFromPlatform)
 	}
 	if m.addprompt_token_usage != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptTokenUsage)
-	}
-	if m.addcompletion_character_length != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength)
+		fields = append(fields, logsummarizations.FieldPromptTokenUsage)
 	}
 	if m.addcompletion_token_usage != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage)
+		fields = append(fields, logsummarizations.FieldCompletionTokenUsage)
 	}
 	if m.addtotal_token_usage != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldTotalTokenUsage)
+		fields = append(fields, logsummarizations.FieldTotalTokenUsage)
 	}
 	if m.addcreated_at != nil {
-		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCreatedAt)
+		fields = append(fields, logsummarizations.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, logsummarizations.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6134,20 +6808,20 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LogSummarizationsMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
-		return m.AddedPromptCharacterLength()
-	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+	case logsummarizations.FieldFromPlatform:
+		return m.AddedFromPlatform()
+	case logsummarizations.FieldPromptTokenUsage:
 		return m.AddedPromptTokenUsage()
-	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
-		return m.AddedCompletionCharacterLength()
-	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+	case logsummarizations.FieldCompletionTokenUsage:
 		return m.AddedCompletionTokenUsage()
-	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+	case logsummarizations.FieldTotalTokenUsage:
 		return m.AddedTotalTokenUsage()
-	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
+	case logsummarizations.FieldCreatedAt:
 		return m.AddedCreatedAt()
+	case logsummarizations.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
 	}
 	return nil, false
 }
@@ -6155,190 +6829,202 @@ func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedField(name string) (
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddField(name string, value ent.Value) error {
+func (m *LogSummarizationsMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
+	case logsummarizations.FieldFromPlatform:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPromptCharacterLength(v)
+		m.AddFromPlatform(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+	case logsummarizations.FieldPromptTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddPromptTokenUsage(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
+	case logsummarizations.FieldCompletionTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCompletionCharacterLength(v)
+		m.AddCompletionTokenUsage(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+	case logsummarizations.FieldTotalTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCompletionTokenUsage(v)
+		m.AddTotalTokenUsage(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
-		v, ok := value.(int)
+	case logsummarizations.FieldCreatedAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalTokenUsage(v)
+		m.AddCreatedAt(v)
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
+	case logsummarizations.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreatedAt(v)
+		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage numeric field %s", name)
+	return fmt.Errorf("unknown LogSummarizations numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearedFields() []string {
+func (m *LogSummarizationsMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) FieldCleared(name string) bool {
+func (m *LogSummarizationsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage nullable field %s", name)
+func (m *LogSummarizationsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LogSummarizations nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetField(name string) error {
+func (m *LogSummarizationsMutation) ResetField(name string) error {
 	switch name {
-	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
-		m.ResetPromptOperation()
+	case logsummarizations.FieldContentURL:
+		m.ResetContentURL()
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
-		m.ResetPromptCharacterLength()
+	case logsummarizations.FieldContentTitle:
+		m.ResetContentTitle()
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
-		m.ResetPromptTokenUsage()
+	case logsummarizations.FieldContentAuthor:
+		m.ResetContentAuthor()
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
-		m.ResetCompletionCharacterLength()
+	case logsummarizations.FieldContentText:
+		m.ResetContentText()
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+	case logsummarizations.FieldContentSummarizedOutputs:
+		m.ResetContentSummarizedOutputs()
+		return nil
+	case logsummarizations.FieldFromPlatform:
+		m.ResetFromPlatform()
+		return nil
+	case logsummarizations.FieldPromptTokenUsage:
+		m.ResetPromptTokenUsage()
+		return nil
+	case logsummarizations.FieldCompletionTokenUsage:
 		m.ResetCompletionTokenUsage()
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+	case logsummarizations.FieldTotalTokenUsage:
 		m.ResetTotalTokenUsage()
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldModelName:
+	case logsummarizations.FieldModelName:
 		m.ResetModelName()
 		return nil
-	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
+	case logsummarizations.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case logsummarizations.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage field %s", name)
+	return fmt.Errorf("unknown LogSummarizations field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedEdges() []string {
+func (m *LogSummarizationsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedIDs(name string) []ent.Value {
+func (m *LogSummarizationsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) RemovedEdges() []string {
+func (m *LogSummarizationsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) RemovedIDs(name string) []ent.Value {
+func (m *LogSummarizationsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearedEdges() []string {
+func (m *LogSummarizationsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) EdgeCleared(name string) bool {
+func (m *LogSummarizationsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage unique edge %s", name)
+func (m *LogSummarizationsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LogSummarizations unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage edge %s", name)
+func (m *LogSummarizationsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LogSummarizations edge %s", name)
 }
 
-// SentMessagesMutation represents an operation that mutates the SentMessages nodes in the graph.
-type SentMessagesMutation struct {
+// MetricOpenAIChatCompletionTokenUsageMutation represents an operation that mutates the MetricOpenAIChatCompletionTokenUsage nodes in the graph.
+type MetricOpenAIChatCompletionTokenUsageMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	chat_id          *int64
-	addchat_id       *int64
-	message_id       *int
-	addmessage_id    *int
-	text             *string
-	is_pinned        *bool
-	from_platform    *int
-	addfrom_platform *int
-	message_type     *int
-	addmessage_type  *int
-	created_at       *int64
-	addcreated_at    *int64
-	updated_at       *int64
-	addupdated_at    *int64
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*SentMessages, error)
-	predicates       []predicate.SentMessages
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	prompt_operation               *string
+	prompt_character_length        *int
+	addprompt_character_length     *int
+	prompt_token_usage             *int
+	addprompt_token_usage          *int
+	completion_character_length    *int
+	addcompletion_character_length *int
+	completion_token_usage         *int
+	addcompletion_token_usage      *int
+	total_token_usage              *int
+	addtotal_token_usage           *int
+	model_name                     *string
+	created_at                     *int64
+	addcreated_at                  *int64
+	clearedFields                  map[string]struct{}
+	done                           bool
+	oldValue                       func(context.Context) (*MetricOpenAIChatCompletionTokenUsage, error)
+	predicates                     []predicate.MetricOpenAIChatCompletionTokenUsage
 }
 
-var _ ent.Mutation = (*SentMessagesMutation)(nil)
+var _ ent.Mutation = (*MetricOpenAIChatCompletionTokenUsageMutation)(nil)
 
-// sentmessagesOption allows management of the mutation configuration using functional options.
-type sentmessagesOption func(*SentMessagesMutation)
+// metricopenaichatcompletiontokenusageOption allows management of the mutation configuration using functional options.
+type metricopenaichatcompletiontokenusageOption func(*MetricOpenAIChatCompletionTokenUsageMutation)
 
-// newSentMessagesMutation creates new mutation for the SentMessages entity.
-func newSentMessagesMutation(c config, op Op, opts ...sentmessagesOption) *SentMessagesMutation {
-	m := &SentMessagesMutation{
+// newMetricOpenAIChatCompletionTokenUsageMutation creates new mutation for the MetricOpenAIChatCompletionTokenUsage entity.
+func newMetricOpenAIChatCompletionTokenUsageMutation(c config, op Op, opts ...metricopenaichatcompletiontokenusageOption) *MetricOpenAIChatCompletionTokenUsageMutation {
+	m := &MetricOpenAIChatCompletionTokenUsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSentMessages,
+		typ:           TypeMetricOpenAIChatCompletionTokenUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6347,20 +7033,20 @@ func newSentMessagesMutation(c config, op Op, opts ...sentmessagesOption) *SentM
 	return m
 }
 
-// withSentMessagesID sets the ID field of the mutation.
-func withSentMessagesID(id uuid.UUID) sentmessagesOption {
-	return func(m *SentMessagesMutation) {
+// withMetricOpenAIChatCompletionTokenUsageID sets the ID field of the mutation.
+func withMetricOpenAIChatCompletionTokenUsageID(id uuid.UUID) metricopenaichatcompletiontokenusageOption {
+	return func(m *MetricOpenAIChatCompletionTokenUsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SentMessages
+			value *MetricOpenAIChatCompletionTokenUsage
 		)
-		m.oldValue = func(ctx context.Context) (*SentMessages, error) {
+		m.oldValue = func(ctx context.Context) (*MetricOpenAIChatCompletionTokenUsage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SentMessages.Get(ctx, id)
+					value, err = m.Client().MetricOpenAIChatCompletionTokenUsage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6369,10 +7055,10 @@ func withSentMessagesID(id uuid.UUID) sentmessagesOption {
 	}
 }
 
-// withSentMessages sets the old SentMessages of the mutation.
-func withSentMessages(node *SentMessages) sentmessagesOption {
-	return func(m *SentMessagesMutation) {
-		m.oldValue = func(context.Context) (*SentMessages, error) {
+// withMetricOpenAIChatCompletionTokenUsage sets the old MetricOpenAIChatCompletionTokenUsage of the mutation.
+func withMetricOpenAIChatCompletionTokenUsage(node *MetricOpenAIChatCompletionTokenUsage) metricopenaichatcompletiontokenusageOption {
+	return func(m *MetricOpenAIChatCompletionTokenUsageMutation) {
+		m.oldValue = func(context.Context) (*MetricOpenAIChatCompletionTokenUsage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6381,7 +7067,7 @@ func withSentMessages(node *SentMessages) sentmessagesOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SentMessagesMutation) Client() *Client {
+func (m MetricOpenAIChatCompletionTokenUsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6389,7 +7075,7 @@ func (m SentMessagesMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SentMessagesMutation) Tx() (*Tx, error) {
+func (m MetricOpenAIChatCompletionTokenUsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6399,14 +7085,14 @@ func (m SentMessagesMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SentMessages entities.
-func (m *SentMessagesMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of MetricOpenAIChatCompletionTokenUsage entities.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SentMessagesMutation) ID() (id uuid.UUID, exists bool) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6417,7 +7103,7 @@ func (m *SentMessagesMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SentMessagesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6426,316 +7112,372 @@ func (m *SentMessagesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SentMessages.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MetricOpenAIChatCompletionTokenUsage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *SentMessagesMutation) SetChatID(i int64) {
-	m.chat_id = &i
-	m.addchat_id = nil
+// SetPromptOperation sets the "prompt_operation" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetPromptOperation(s string) {
+	m.prompt_operation = &s
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *SentMessagesMutation) ChatID() (r int64, exists bool) {
-	v := m.chat_id
+// PromptOperation returns the value of the "prompt_operation" field in the mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) PromptOperation() (r string, exists bool) {
+	v := m.prompt_operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// OldPromptOperation returns the old "prompt_operation" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldChatID(ctx context.Context) (v int64, err error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldPromptOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPromptOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldPromptOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
-	}
-	return oldValue.ChatID, nil
-}
-
-// AddChatID adds i to the "chat_id" field.
-func (m *SentMessagesMutation) AddChatID(i int64) {
-	if m.addchat_id != nil {
-		*m.addchat_id += i
-	} else {
-		m.addchat_id = &i
-	}
-}
-
-// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
-func (m *SentMessagesMutation) AddedChatID() (r int64, exists bool) {
-	v := m.addchat_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPromptOperation: %w", err)
 	}
-	return *v, true
+	return oldValue.PromptOperation, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *SentMessagesMutation) ResetChatID() {
-	m.chat_id = nil
-	m.addchat_id = nil
+// ResetPromptOperation resets all changes to the "prompt_operation" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetPromptOperation() {
+	m.prompt_operation = nil
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *SentMessagesMutation) SetMessageID(i int) {
-	m.message_id = &i
-	m.addmessage_id = nil
+// SetPromptCharacterLength sets the "prompt_character_length" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetPromptCharacterLength(i int) {
+	m.prompt_character_length = &i
+	m.addprompt_character_length = nil
 }
 
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *SentMessagesMutation) MessageID() (r int, exists bool) {
-	v := m.message_id
+// PromptCharacterLength returns the value of the "prompt_character_length" field in the mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) PromptCharacterLength() (r int, exists bool) {
+	v := m.prompt_character_length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessageID returns the old "message_id" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// OldPromptCharacterLength returns the old "prompt_character_length" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldMessageID(ctx context.Context) (v int, err error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldPromptCharacterLength(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPromptCharacterLength is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
+		return v, errors.New("OldPromptCharacterLength requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPromptCharacterLength: %w", err)
 	}
-	return oldValue.MessageID, nil
+	return oldValue.PromptCharacterLength, nil
 }
 
-// AddMessageID adds i to the "message_id" field.
-func (m *SentMessagesMutation) AddMessageID(i int) {
-	if m.addmessage_id != nil {
-		*m.addmessage_id += i
+// AddPromptCharacterLength adds i to the "prompt_character_length" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddPromptCharacterLength(i int) {
+	if m.addprompt_character_length != nil {
+		*m.addprompt_character_length += i
 	} else {
-		m.addmessage_id = &i
+		m.addprompt_character_length = &i
 	}
 }
 
-// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
-func (m *SentMessagesMutation) AddedMessageID() (r int, exists bool) {
-	v := m.addmessage_id
+// AddedPromptCharacterLength returns the value that was added to the "prompt_character_length" field in this mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedPromptCharacterLength() (r int, exists bool) {
+	v := m.addprompt_character_length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *SentMessagesMutation) ResetMessageID() {
-	m.message_id = nil
-	m.addmessage_id = nil
+// ResetPromptCharacterLength resets all changes to the "prompt_character_length" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetPromptCharacterLength() {
+	m.prompt_character_length = nil
+	m.addprompt_character_length = nil
 }
 
-// SetText sets the "text" field.
-func (m *SentMessagesMutation) SetText(s string) {
-	m.text = &s
+// SetPromptTokenUsage sets the "prompt_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetPromptTokenUsage(i int) {
+	m.prompt_token_usage = &i
+	m.addprompt_token_usage = nil
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *SentMessagesMutation) Text() (r string, exists bool) {
-	v := m.text
+// PromptTokenUsage returns the value of the "prompt_token_usage" field in the mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) PromptTokenUsage() (r int, exists bool) {
+	v := m.prompt_token_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// OldPromptTokenUsage returns the old "prompt_token_usage" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldPromptTokenUsage(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldPromptTokenUsage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldPromptTokenUsage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldPromptTokenUsage: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.PromptTokenUsage, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *SentMessagesMutation) ResetText() {
-	m.text = nil
+// AddPromptTokenUsage adds i to the "prompt_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddPromptTokenUsage(i int) {
+	if m.addprompt_token_usage != nil {
+		*m.addprompt_token_usage += i
+	} else {
+		m.addprompt_token_usage = &i
+	}
 }
 
-// SetIsPinned sets the "is_pinned" field.
-func (m *SentMessagesMutation) SetIsPinned(b bool) {
-	m.is_pinned = &b
+// AddedPromptTokenUsage returns the value that was added to the "prompt_token_usage" field in this mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedPromptTokenUsage() (r int, exists bool) {
+	v := m.addprompt_token_usage
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// IsPinned returns the value of the "is_pinned" field in the mutation.
-func (m *SentMessagesMutation) IsPinned() (r bool, exists bool) {
-	v := m.is_pinned
+// ResetPromptTokenUsage resets all changes to the "prompt_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetPromptTokenUsage() {
+	m.prompt_token_usage = nil
+	m.addprompt_token_usage = nil
+}
+
+// SetCompletionCharacterLength sets the "completion_character_length" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetCompletionCharacterLength(i int) {
+	m.completion_character_length = &i
+	m.addcompletion_character_length = nil
+}
+
+// CompletionCharacterLength returns the value of the "completion_character_length" field in the mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) CompletionCharacterLength() (r int, exists bool) {
+	v := m.completion_character_length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPinned returns the old "is_pinned" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletionCharacterLength returns the old "completion_character_length" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldIsPinned(ctx context.Context) (v bool, err error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCompletionCharacterLength(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPinned is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletionCharacterLength is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPinned requires an ID field in the mutation")
+		return v, errors.New("OldCompletionCharacterLength requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPinned: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletionCharacterLength: %w", err)
 	}
-	return oldValue.IsPinned, nil
+	return oldValue.CompletionCharacterLength, nil
 }
 
-// ResetIsPinned resets all changes to the "is_pinned" field.
-func (m *SentMessagesMutation) ResetIsPinned() {
-	m.is_pinned = nil
+// AddCompletionCharacterLength adds i to the "completion_character_length" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCompletionCharacterLength(i int) {
+	if m.addcompletion_character_length != nil {
+		*m.addcompletion_character_length += i
+	} else {
+		m.addcompletion_character_length = &i
+	}
 }
 
-// SetFromPlatform sets the "from_platform" field.
-func (m *SentMessagesMutation) SetFromPlatform(i int) {
-	m.from_platform = &i
-	m.addfrom_platform = nil
+// AddedCompletionCharacterLength returns the value that was added to the "completion_character_length" field in this mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCompletionCharacterLength() (r int, exists bool) {
+	v := m.addcompletion_character_length
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FromPlatform returns the value of the "from_platform" field in the mutation.
-func (m *SentMessagesMutation) FromPlatform() (r int, exists bool) {
-	v := m.from_platform
+// ResetCompletionCharacterLength resets all changes to the "completion_character_length" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetCompletionCharacterLength() {
+	m.completion_character_length = nil
+	m.addcompletion_character_length = nil
+}
+
+// SetCompletionTokenUsage sets the "completion_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetCompletionTokenUsage(i int) {
+	m.completion_token_usage = &i
+	m.addcompletion_token_usage = nil
+}
+
+// CompletionTokenUsage returns the value of the "completion_token_usage" field in the mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) CompletionTokenUsage() (r int, exists bool) {
+	v := m.completion_token_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromPlatform returns the old "from_platform" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletionTokenUsage returns the old "completion_token_usage" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldFromPlatform(ctx context.Context) (v int, err error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCompletionTokenUsage(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromPlatform is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletionTokenUsage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromPlatform requires an ID field in the mutation")
+		return v, errors.New("OldCompletionTokenUsage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromPlatform: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletionTokenUsage: %w", err)
 	}
-	return oldValue.FromPlatform, nil
+	return oldValue.CompletionTokenUsage, nil
 }
 
-// AddFromPlatform adds i to the "from_platform" field.
-func (m *SentMessagesMutation) AddFromPlatform(i int) {
-	if m.addfrom_platform != nil {
-		*m.addfrom_platform += i
+// AddCompletionTokenUsage adds i to the "completion_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCompletionTokenUsage(i int) {
+	if m.addcompletion_token_usage != nil {
+		*m.addcompletion_token_usage += i
 	} else {
-		m.addfrom_platform = &i
+		m.addcompletion_token_usage = &i
 	}
 }
 
-// AddedFromPlatform returns the value that was added to the "from_platform" field in this mutation.
-func (m *SentMessagesMutation) AddedFromPlatform() (r int, exists bool) {
-	v := m.addfrom_platform
+// AddedCompletionTokenUsage returns the value that was added to the "completion_token_usage" field in this mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCompletionTokenUsage() (r int, exists bool) {
+	v := m.addcompletion_token_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFromPlatform resets all changes to the "from_platform" field.
-func (m *SentMessagesMutation) ResetFromPlatform() {
-	m.from_platform = nil
-	m.addfrom_platform = nil
+// ResetCompletionTokenUsage resets all changes to the "completion_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetCompletionTokenUsage() {
+	m.completion_token_usage = nil
+	m.addcompletion_token_usage = nil
 }
 
-// SetMessageType sets the "message_type" field.
-func (m *SentMessagesMutation) SetMessageType(i int) {
-	m.message_type = &i
-	m.addmessage_type = nil
+// SetTotalTokenUsage sets the "total_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetTotalTokenUsage(i int) {
+	m.total_token_usage = &i
+	m.addtotal_token_usage = nil
 }
 
-// MessageType returns the value of the "message_type" field in the mutation.
-func (m *SentMessagesMutation) MessageType() (r int, exists bool) {
-	v := m.message_type
+// TotalTokenUsage returns the value of the "total_token_usage" field in the mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) TotalTokenUsage() (r int, exists bool) {
+	v := m.total_token_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessageType returns the old "message_type" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalTokenUsage returns the old "total_token_usage" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldMessageType(ctx context.Context) (v int, err error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldTotalTokenUsage(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalTokenUsage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageType requires an ID field in the mutation")
+		return v, errors.New("OldTotalTokenUsage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalTokenUsage: %w", err)
 	}
-	return oldValue.MessageType, nil
+	return oldValue.TotalTokenUsage, nil
 }
 
-// AddMessageType adds i to the "message_type" field.
-func (m *SentMessagesMutation) AddMessageType(i int) {
-	if m.addmessage_type != nil {
-		*m.addmessage_type += i
+// AddTotalTokenUsage adds i to the "total_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddTotalTokenUsage(i int) {
+	if m.addtotal_token_usage != nil {
+		*m.addtotal_token_usage += i
 	} else {
-		m.addmessage_type = &i
+		m.addtotal_token_usage = &i
 	}
 }
 
-// AddedMessageType returns the value that was added to the "message_type" field in this mutation.
-func (m *SentMessagesMutation) AddedMessageType() (r int, exists bool) {
-	v := m.addmessage_type
+// AddedTotalTokenUsage returns the value that was added to the "total_token_usage" field in this mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedTotalTokenUsage() (r int, exists bool) {
+	v := m.addtotal_token_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMessageType resets all changes to the "message_type" field.
-func (m *SentMessagesMutation) ResetMessageType() {
-	m.message_type = nil
-	m.addmessage_type = nil
+// ResetTotalTokenUsage resets all changes to the "total_token_usage" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetTotalTokenUsage() {
+	m.total_token_usage = nil
+	m.addtotal_token_usage = nil
+}
+
+// SetModelName sets the "model_name" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetModelName(s string) {
+	m.model_name = &s
+}
+
+// ModelName returns the value of the "model_name" field in the mutation.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ModelName() (r string, exists bool) {
+	v := m.model_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelName returns the old "model_name" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldModelName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelName: %w", err)
+	}
+	return oldValue.ModelName, nil
+}
+
+// ResetModelName resets all changes to the "model_name" field.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetModelName() {
+	m.model_name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SentMessagesMutation) SetCreatedAt(i int64) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SentMessagesMutation) CreatedAt() (r int64, exists bool) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6743,10 +7485,10 @@ func (m *SentMessagesMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the MetricOpenAIChatCompletionTokenUsage entity.
+// If the MetricOpenAIChatCompletionTokenUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6761,7 +7503,7 @@ func (m *SentMessagesMutation) OldCreatedAt(ctx context.Context) (v int64, err e
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *SentMessagesMutation) AddCreatedAt(i int64) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -6770,7 +7512,7 @@ func (m *SentMessagesMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *SentMessagesMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -6779,76 +7521,20 @@ func (m *SentMessagesMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SentMessagesMutation) ResetCreatedAt() {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SentMessagesMutation) SetUpdatedAt(i int64) {
-	m.updated_at = &i
-	m.addupdated_at = nil
+// Where appends a list predicates to the MetricOpenAIChatCompletionTokenUsageMutation builder.
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) Where(ps ...predicate.MetricOpenAIChatCompletionTokenUsage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SentMessagesMutation) UpdatedAt() (r int64, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the SentMessages entity.
-// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SentMessagesMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// AddUpdatedAt adds i to the "updated_at" field.
-func (m *SentMessagesMutation) AddUpdatedAt(i int64) {
-	if m.addupdated_at != nil {
-		*m.addupdated_at += i
-	} else {
-		m.addupdated_at = &i
-	}
-}
-
-// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *SentMessagesMutation) AddedUpdatedAt() (r int64, exists bool) {
-	v := m.addupdated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SentMessagesMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	m.addupdated_at = nil
-}
-
-// Where appends a list predicates to the SentMessagesMutation builder.
-func (m *SentMessagesMutation) Where(ps ...predicate.SentMessages) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the SentMessagesMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MetricOpenAIChatCompletionTokenUsageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SentMessagesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SentMessages, len(ps))
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MetricOpenAIChatCompletionTokenUsage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6856,48 +7542,48 @@ func (m *SentMessagesMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SentMessagesMutation) Op() Op {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SentMessagesMutation) SetOp(op Op) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SentMessages).
-func (m *SentMessagesMutation) Type() string {
+// Type returns the node type of this mutation (MetricOpenAIChatCompletionTokenUsage).
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SentMessagesMutation) Fields() []string {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) Fields() []string {
 	fields := make([]string, 0, 8)
-	if m.chat_id != nil {
-		fields = append(fields, sentmessages.FieldChatID)
+	if m.prompt_operation != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptOperation)
 	}
-	if m.message_id != nil {
-		fields = append(fields, sentmessages.FieldMessageID)
+	if m.prompt_character_length != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptCharacterLength)
 	}
-	if m.text != nil {
-		fields = append(fields, sentmessages.FieldText)
+	if m.prompt_token_usage != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptTokenUsage)
 	}
-	if m.is_pinned != nil {
-		fields = append(fields, sentmessages.FieldIsPinned)
+	if m.completion_character_length != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength)
 	}
-	if m.from_platform != nil {
-		fields = append(fields, sentmessages.FieldFromPlatform)
+	if m.completion_token_usage != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage)
 	}
-	if m.message_type != nil {
-		fields = append(fields, sentmessages.FieldMessageType)
+	if m.total_token_usage != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldTotalTokenUsage)
 	}
-	if m.created_at != nil {
-		fields = append(fields, sentmessages.FieldCreatedAt)
+	if m.model_name != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldModelName)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, sentmessages.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6905,24 +7591,24 @@ func (m *SentMessagesMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SentMessagesMutation) Field(name string) (ent.Value, bool) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sentmessages.FieldChatID:
-		return m.ChatID()
-	case sentmessages.FieldMessageID:
-		return m.MessageID()
-	case sentmessages.FieldText:
-		return m.Text()
-	case sentmessages.FieldIsPinned:
-		return m.IsPinned()
-	case sentmessages.FieldFromPlatform:
-		return m.FromPlatform()
-	case sentmessages.FieldMessageType:
-		return m.MessageType()
-	case sentmessages.FieldCreatedAt:
+	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
+		return m.PromptOperation()
+	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
+		return m.PromptCharacterLength()
+	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+		return m.PromptTokenUsage()
+	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
+		return m.CompletionCharacterLength()
+	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+		return m.CompletionTokenUsage()
+	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+		return m.TotalTokenUsage()
+	case metricopenaichatcompletiontokenusage.FieldModelName:
+		return m.ModelName()
+	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
 		return m.CreatedAt()
-	case sentmessages.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6930,114 +7616,114 @@ func (m *SentMessagesMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SentMessagesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sentmessages.FieldChatID:
-		return m.OldChatID(ctx)
-	case sentmessages.FieldMessageID:
-		return m.OldMessageID(ctx)
-	case sentmessages.FieldText:
-		return m.OldText(ctx)
-	case sentmessages.FieldIsPinned:
-		return m.OldIsPinned(ctx)
-	case sentmessages.FieldFromPlatform:
-		return m.OldFromPlatform(ctx)
-	case sentmessages.FieldMessageType:
-		return m.OldMessageType(ctx)
-	case sentmessages.FieldCreatedAt:
+	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
+		return m.OldPromptOperation(ctx)
+	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
+		return m.OldPromptCharacterLength(ctx)
+	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+		return m.OldPromptTokenUsage(ctx)
+	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
+		return m.OldCompletionCharacterLength(ctx)
+	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+		return m.OldCompletionTokenUsage(ctx)
+	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+		return m.OldTotalTokenUsage(ctx)
+	case metricopenaichatcompletiontokenusage.FieldModelName:
+		return m.OldModelName(ctx)
+	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case sentmessages.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SentMessages field %s", name)
+	return nil, fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SentMessagesMutation) SetField(name string, value ent.Value) error {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sentmessages.FieldChatID:
-		v, ok := value.(int64)
+	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatID(v)
+		m.SetPromptOperation(v)
 		return nil
-	case sentmessages.FieldMessageID:
+	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessageID(v)
+		m.SetPromptCharacterLength(v)
 		return nil
-	case sentmessages.FieldText:
-		v, ok := value.(string)
+	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetPromptTokenUsage(v)
 		return nil
-	case sentmessages.FieldIsPinned:
-		v, ok := value.(bool)
+	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPinned(v)
+		m.SetCompletionCharacterLength(v)
 		return nil
-	case sentmessages.FieldFromPlatform:
+	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFromPlatform(v)
+		m.SetCompletionTokenUsage(v)
 		return nil
-	case sentmessages.FieldMessageType:
+	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessageType(v)
+		m.SetTotalTokenUsage(v)
 		return nil
-	case sentmessages.FieldCreatedAt:
-		v, ok := value.(int64)
+	case metricopenaichatcompletiontokenusage.FieldModelName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetModelName(v)
 		return nil
-	case sentmessages.FieldUpdatedAt:
+	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SentMessages field %s", name)
+	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SentMessagesMutation) AddedFields() []string {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedFields() []string {
 	var fields []string
-	if m.addchat_id != nil {
-		fields = append(fields, sentmessages.FieldChatID)
+	if m.addprompt_character_length != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptCharacterLength)
 	}
-	if m.addmessage_id != nil {
-		fields = append(fields, sentmessages.FieldMessageID)
+	if m.addprompt_token_usage != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldPromptTokenUsage)
 	}
-	if m.addfrom_platform != nil {
-		fields = append(fields, sentmessages.FieldFromPlatform)
+	if m.addcompletion_character_length != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength)
 	}
-	if m.addmessage_type != nil {
-		fields = append(fields, sentmessages.FieldMessageType)
+	if m.addcompletion_token_usage != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage)
 	}
-	if m.addcreated_at != nil {
-		fields = append(fields, sentmessages.FieldCreatedAt)
+	if m.addtotal_token_usage != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldTotalTokenUsage)
 	}
-	if m.addupdated_at != nil {
-		fields = append(fields, sentmessages.FieldUpdatedAt)
+	if m.addcreated_at != nil {
+		fields = append(fields, metricopenaichatcompletiontokenusage.FieldCreatedAt)
 	}
 	return fields
 }
@@ -7045,20 +7731,20 @@ func (m *SentMessagesMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SentMessagesMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case sentmessages.FieldChatID:
-		return m.AddedChatID()
-	case sentmessages.FieldMessageID:
-		return m.AddedMessageID()
-	case sentmessages.FieldFromPlatform:
-		return m.AddedFromPlatform()
-	case sentmessages.FieldMessageType:
-		return m.AddedMessageType()
-	case sentmessages.FieldCreatedAt:
+	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
+		return m.AddedPromptCharacterLength()
+	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+		return m.AddedPromptTokenUsage()
+	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
+		return m.AddedCompletionCharacterLength()
+	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+		return m.AddedCompletionTokenUsage()
+	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+		return m.AddedTotalTokenUsage()
+	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case sentmessages.FieldUpdatedAt:
-		return m.AddedUpdatedAt()
 	}
 	return nil, false
 }
@@ -7066,183 +7752,186 @@ func (m *SentMessagesMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SentMessagesMutation) AddField(name string, value ent.Value) error {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sentmessages.FieldChatID:
-		v, ok := value.(int64)
+	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddChatID(v)
+		m.AddPromptCharacterLength(v)
 		return nil
-	case sentmessages.FieldMessageID:
+	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMessageID(v)
+		m.AddPromptTokenUsage(v)
 		return nil
-	case sentmessages.FieldFromPlatform:
+	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFromPlatform(v)
+		m.AddCompletionCharacterLength(v)
 		return nil
-	case sentmessages.FieldMessageType:
+	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMessageType(v)
+		m.AddCompletionTokenUsage(v)
 		return nil
-	case sentmessages.FieldCreatedAt:
-		v, ok := value.(int64)
+	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreatedAt(v)
+		m.AddTotalTokenUsage(v)
 		return nil
-	case sentmessages.FieldUpdatedAt:
+	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUpdatedAt(v)
+		m.AddCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SentMessages numeric field %s", name)
+	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SentMessagesMutation) ClearedFields() []string {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SentMessagesMutation) FieldCleared(name string) bool {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SentMessagesMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SentMessages nullable field %s", name)
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SentMessagesMutation) ResetField(name string) error {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetField(name string) error {
 	switch name {
-	case sentmessages.FieldChatID:
-		m.ResetChatID()
+	case metricopenaichatcompletiontokenusage.FieldPromptOperation:
+		m.ResetPromptOperation()
 		return nil
-	case sentmessages.FieldMessageID:
-		m.ResetMessageID()
+	case metricopenaichatcompletiontokenusage.FieldPromptCharacterLength:
+		m.ResetPromptCharacterLength()
 		return nil
-	case sentmessages.FieldText:
-		m.ResetText()
+	case metricopenaichatcompletiontokenusage.FieldPromptTokenUsage:
+		m.ResetPromptTokenUsage()
 		return nil
-	case sentmessages.FieldIsPinned:
-		m.ResetIsPinned()
+	case metricopenaichatcompletiontokenusage.FieldCompletionCharacterLength:
+		m.ResetCompletionCharacterLength()
 		return nil
-	case sentmessages.FieldFromPlatform:
-		m.ResetFromPlatform()
+	case metricopenaichatcompletiontokenusage.FieldCompletionTokenUsage:
+		m.ResetCompletionTokenUsage()
 		return nil
-	case sentmessages.FieldMessageType:
-		m.ResetMessageType()
+	case metricopenaichatcompletiontokenusage.FieldTotalTokenUsage:
+		m.ResetTotalTokenUsage()
 		return nil
-	case sentmessages.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case metricopenaichatcompletiontokenusage.FieldModelName:
+		m.ResetModelName()
 		return nil
-	case sentmessages.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case metricopenaichatcompletiontokenusage.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SentMessages field %s", name)
+	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SentMessagesMutation) AddedEdges() []string {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SentMessagesMutation) AddedIDs(name string) []ent.Value {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SentMessagesMutation) RemovedEdges() []string {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SentMessagesMutation) RemovedIDs(name string) []ent.Value {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SentMessagesMutation) ClearedEdges() []string {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SentMessagesMutation) EdgeCleared(name string) bool {
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SentMessagesMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SentMessages unique edge %s", name)
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SentMessagesMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SentMessages edge %s", name)
+func (m *MetricOpenAIChatCompletionTokenUsageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MetricOpenAIChatCompletionTokenUsage edge %s", name)
 }
 
-// SlackOAuthCredentialsMutation represents an operation that mutates the SlackOAuthCredentials nodes in the graph.
-type SlackOAuthCredentialsMutation struct {
+// RecapFeedbackMutation represents an operation that mutates the RecapFeedback nodes in the graph.
+type RecapFeedbackMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	team_id       *string
-	refresh_token *string
-	access_token  *string
+	chat_id       *int64
+	addchat_id    *int64
+	log_id        *uuid.UUID
+	user_id       *int64
+	adduser_id    *int64
+	text          *string
 	created_at    *int64
 	addcreated_at *int64
 	updated_at    *int64
 	addupdated_at *int64
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*SlackOAuthCredentials, error)
-	predicates    []predicate.SlackOAuthCredentials
+	oldValue      func(context.Context) (*RecapFeedback, error)
+	predicates    []predicate.RecapFeedback
 }
 
-var _ ent.Mutation = (*SlackOAuthCredentialsMutation)(nil)
+var _ ent.Mutation = (*RecapFeedbackMutation)(nil)
 
-// slackoauthcredentialsOption allows management of the mutation configuration using functional options.
-type slackoauthcredentialsOption func(*SlackOAuthCredentialsMutation)
+// recapfeedbackOption allows management of the mutation configuration using functional options.
+type recapfeedbackOption func(*RecapFeedbackMutation)
 
-// newSlackOAuthCredentialsMutation creates new mutation for the SlackOAuthCredentials entity.
-func newSlackOAuthCredentialsMutation(c config, op Op, opts ...slackoauthcredentialsOption) *SlackOAuthCredentialsMutation {
-	m := &SlackOAuthCredentialsMutation{
+// newRecapFeedbackMutation creates new mutation for the RecapFeedback entity.
+func newRecapFeedbackMutation(c config, op Op, opts ...recapfeedbackOption) *RecapFeedbackMutation {
+	m := &RecapFeedbackMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSlackOAuthCredentials,
+		typ:           TypeRecapFeedback,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7251,20 +7940,20 @@ func newSlackOAuthCredentialsMutation(c config, op Op, opts ...slackoauthcredent
 	return m
 }
 
-// withSlackOAuthCredentialsID sets the ID field of the mutation.
-func withSlackOAuthCredentialsID(id uuid.UUID) slackoauthcredentialsOption {
-	return func(m *SlackOAuthCredentialsMutation) {
+// withRecapFeedbackID sets the ID field of the mutation.
+func withRecapFeedbackID(id uuid.UUID) recapfeedbackOption {
+	return func(m *RecapFeedbackMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SlackOAuthCredentials
+			value *RecapFeedback
 		)
-		m.oldValue = func(ctx context.Context) (*SlackOAuthCredentials, error) {
+		m.oldValue = func(ctx context.Context) (*RecapFeedback, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SlackOAuthCredentials.Get(ctx, id)
+					value, err = m.Client().RecapFeedback.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7273,10 +7962,10 @@ func withSlackOAuthCredentialsID(id uuid.UUID) slackoauthcredentialsOption {
 	}
 }
 
-// withSlackOAuthCredentials sets the old SlackOAuthCredentials of the mutation.
-func withSlackOAuthCredentials(node *SlackOAuthCredentials) slackoauthcredentialsOption {
-	return func(m *SlackOAuthCredentialsMutation) {
-		m.oldValue = func(context.Context) (*SlackOAuthCredentials, error) {
+// withRecapFeedback sets the old RecapFeedback of the mutation.
+func withRecapFeedback(node *RecapFeedback) recapfeedbackOption {
+	return func(m *RecapFeedbackMutation) {
+		m.oldValue = func(context.Context) (*RecapFeedback, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7285,7 +7974,7 @@ func withSlackOAuthCredentials(node *SlackOAuthCredentials) slackoauthcredential
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SlackOAuthCredentialsMutation) Client() *Client {
+func (m RecapFeedbackMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7293,7 +7982,7 @@ func (m SlackOAuthCredentialsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SlackOAuthCredentialsMutation) Tx() (*Tx, error) {
+func (m RecapFeedbackMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7303,14 +7992,14 @@ func (m SlackOAuthCredentialsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SlackOAuthCredentials entities.
-func (m *SlackOAuthCredentialsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of RecapFeedback entities.
+func (m *RecapFeedbackMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SlackOAuthCredentialsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *RecapFeedbackMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7321,7 +8010,7 @@ func (m *SlackOAuthCredentialsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SlackOAuthCredentialsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *RecapFeedbackMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7330,163 +8019,239 @@ func (m *SlackOAuthCredentialsMutation) IDs(ctx context.Context) ([]uuid.UUID, e
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SlackOAuthCredentials.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RecapFeedback.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *SlackOAuthCredentialsMutation) SetTeamID(s string) {
-	m.team_id = &s
+// SetChatID sets the "chat_id" field.
+func (m *RecapFeedbackMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *SlackOAuthCredentialsMutation) TeamID() (r string, exists bool) {
-	v := m.team_id
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *RecapFeedbackMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the SlackOAuthCredentials entity.
-// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the RecapFeedback entity.
+// If the RecapFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlackOAuthCredentialsMutation) OldTeamID(ctx context.Context) (v string, err error) {
+func (m *RecapFeedbackMutation) OldChatID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.TeamID, nil
-}
-
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *SlackOAuthCredentialsMutation) ResetTeamID() {
-	m.team_id = nil
+	return oldValue.ChatID, nil
 }
 
-// SetRefreshToken sets the "refresh_token" field.
-func (m *SlackOAuthCredentialsMutation) SetRefreshToken(s string) {
-	m.refresh_token = &s
+// AddChatID adds i to the "chat_id" field.
+func (m *RecapFeedbackMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
 }
 
-// RefreshToken returns the value of the "refresh_token" field in the mutation.
-func (m *SlackOAuthCredentialsMutation) RefreshToken() (r string, exists bool) {
-	v := m.refresh_token
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *RecapFeedbackMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefreshToken returns the old "refresh_token" field's value of the SlackOAuthCredentials entity.
-// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlackOAuthCredentialsMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
-	}
-	return oldValue.RefreshToken, nil
-}
-
-// ResetRefreshToken resets all changes to the "refresh_token" field.
-func (m *SlackOAuthCredentialsMutation) ResetRefreshToken() {
-	m.refresh_token = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *RecapFeedbackMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
 }
 
-// SetAccessToken sets the "access_token" field.
-func (m *SlackOAuthCredentialsMutation) SetAccessToken(s string) {
-	m.access_token = &s
+// SetLogID sets the "log_id" field.
+func (m *RecapFeedbackMutation) SetLogID(u uuid.UUID) {
+	m.log_id = &u
 }
 
-// AccessToken returns the value of the "access_token" field in the mutation.
-func (m *SlackOAuthCredentialsMutation) AccessToken() (r string, exists bool) {
-	v := m.access_token
+// LogID returns the value of the "log_id" field in the mutation.
+func (m *RecapFeedbackMutation) LogID() (r uuid.UUID, exists bool) {
+	v := m.log_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessToken returns the old "access_token" field's value of the SlackOAuthCredentials entity.
-// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
+// OldLogID returns the old "log_id" field's value of the RecapFeedback entity.
+// If the RecapFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlackOAuthCredentialsMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+func (m *RecapFeedbackMutation) OldLogID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldLogID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+		return v, errors.New("OldLogID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldLogID: %w", err)
 	}
-	return oldValue.AccessToken, nil
+	return oldValue.LogID, nil
 }
 
-// ResetAccessToken resets all changes to the "access_token" field.
-func (m *SlackOAuthCredentialsMutation) ResetAccessToken() {
-	m.access_token = nil
+// ResetLogID resets all changes to the "log_id" field.
+func (m *RecapFeedbackMutation) ResetLogID() {
+	m.log_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SlackOAuthCredentialsMutation) SetCreatedAt(i int64) {
-	m.created_at = &i
-	m.addcreated_at = nil
+// SetUserID sets the "user_id" field.
+func (m *RecapFeedbackMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SlackOAuthCredentialsMutation) CreatedAt() (r int64, exists bool) {
-	v := m.created_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RecapFeedbackMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SlackOAuthCredentials entity.
-// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the RecapFeedback entity.
+// If the RecapFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlackOAuthCredentialsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *RecapFeedbackMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UserID, nil
 }
 
-// AddCreatedAt adds i to the "created_at" field.
-func (m *SlackOAuthCredentialsMutation) AddCreatedAt(i int64) {
-	if m.addcreated_at != nil {
-		*m.addcreated_at += i
+// AddUserID adds i to the "user_id" field.
+func (m *RecapFeedbackMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
 	} else {
-		m.addcreated_at = &i
+		m.adduser_id = &i
 	}
 }
 
-// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *SlackOAuthCredentialsMutation) AddedCreatedAt() (r int64, exists bool) {
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *RecapFeedbackMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RecapFeedbackMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetText sets the "text" field.
+func (m *RecapFeedbackMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *RecapFeedbackMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the RecapFeedback entity.
+// If the RecapFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecapFeedbackMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *RecapFeedbackMutation) ResetText() {
+	m.text = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecapFeedbackMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecapFeedbackMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecapFeedback entity.
+// If the RecapFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecapFeedbackMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *RecapFeedbackMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *RecapFeedbackMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -7495,19 +8260,19 @@ func (m *SlackOAuthCredentialsMutation) AddedCreatedAt() (r int64, exists bool)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SlackOAuthCredentialsMutation) ResetCreatedAt() {
+func (m *RecapFeedbackMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SlackOAuthCredentialsMutation) SetUpdatedAt(i int64) {
+func (m *RecapFeedbackMutation) SetUpdatedAt(i int64) {
 	m.updated_at = &i
 	m.addupdated_at = nil
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SlackOAuthCredentialsMutation) UpdatedAt() (r int64, exists bool) {
+func (m *RecapFeedbackMutation) UpdatedAt() (r int64, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7515,10 +8280,10 @@ func (m *SlackOAuthCredentialsMutation) UpdatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the SlackOAuthCredentials entity.
-// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the RecapFeedback entity.
+// If the RecapFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlackOAuthCredentialsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *RecapFeedbackMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7533,7 +8298,7 @@ func (m *SlackOAuthCredentialsMutation) OldUpdatedAt(ctx context.Context) (v int
 }
 
 // AddUpdatedAt adds i to the "updated_at" field.
-func (m *SlackOAuthCredentialsMutation) AddUpdatedAt(i int64) {
+func (m *RecapFeedbackMutation) AddUpdatedAt(i int64) {
 	if m.addupdated_at != nil {
 		*m.addupdated_at += i
 	} else {
@@ -7542,7 +8307,7 @@ func (m *SlackOAuthCredentialsMutation) AddUpdatedAt(i int64) {
 }
 
 // AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *SlackOAuthCredentialsMutation) AddedUpdatedAt() (r int64, exists bool) {
+func (m *RecapFeedbackMutation) AddedUpdatedAt() (r int64, exists bool) {
 	v := m.addupdated_at
 	if v == nil {
 		return
@@ -7551,20 +8316,20 @@ func (m *SlackOAuthCredentialsMutation) AddedUpdatedAt() (r int64, exists bool)
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SlackOAuthCredentialsMutation) ResetUpdatedAt() {
+func (m *RecapFeedbackMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
-// Where appends a list predicates to the SlackOAuthCredentialsMutation builder.
-func (m *SlackOAuthCredentialsMutation) Where(ps ...predicate.SlackOAuthCredentials) {
+// Where appends a list predicates to the RecapFeedbackMutation builder.
+func (m *RecapFeedbackMutation) Where(ps ...predicate.RecapFeedback) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SlackOAuthCredentialsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RecapFeedbackMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SlackOAuthCredentialsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SlackOAuthCredentials, len(ps))
+func (m *RecapFeedbackMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecapFeedback, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7572,39 +8337,42 @@ func (m *SlackOAuthCredentialsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SlackOAuthCredentialsMutation) Op() Op {
+func (m *RecapFeedbackMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SlackOAuthCredentialsMutation) SetOp(op Op) {
+func (m *RecapFeedbackMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SlackOAuthCredentials).
-func (m *SlackOAuthCredentialsMutation) Type() string {
+// Type returns the node type of this mutation (RecapFeedback).
+func (m *RecapFeedbackMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SlackOAuthCredentialsMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.team_id != nil {
-		fields = append(fields, slackoauthcredentials.FieldTeamID)
+func (m *RecapFeedbackMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.chat_id != nil {
+		fields = append(fields, recapfeedback.FieldChatID)
 	}
-	if m.refresh_token != nil {
-		fields = append(fields, slackoauthcredentials.FieldRefreshToken)
+	if m.log_id != nil {
+		fields = append(fields, recapfeedback.FieldLogID)
 	}
-	if m.access_token != nil {
-		fields = append(fields, slackoauthcredentials.FieldAccessToken)
+	if m.user_id != nil {
+		fields = append(fields, recapfeedback.FieldUserID)
+	}
+	if m.text != nil {
+		fields = append(fields, recapfeedback.FieldText)
 	}
 	if m.created_at != nil {
-		fields = append(fields, slackoauthcredentials.FieldCreatedAt)
+		fields = append(fields, recapfeedback.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, slackoauthcredentials.FieldUpdatedAt)
+		fields = append(fields, recapfeedback.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7612,17 +8380,19 @@ func (m *SlackOAuthCredentialsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SlackOAuthCredentialsMutation) Field(name string) (ent.Value, bool) {
+func (m *RecapFeedbackMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case slackoauthcredentials.FieldTeamID:
-		return m.TeamID()
-	case slackoauthcredentials.FieldRefreshToken:
-		return m.RefreshToken()
-	case slackoauthcredentials.FieldAccessToken:
-		return m.AccessToken()
-	case slackoauthcredentials.FieldCreatedAt:
+	case recapfeedback.FieldChatID:
+		return m.ChatID()
+	case recapfeedback.FieldLogID:
+		return m.LogID()
+	case recapfeedback.FieldUserID:
+		return m.UserID()
+	case recapfeedback.FieldText:
+		return m.Text()
+	case recapfeedback.FieldCreatedAt:
 		return m.CreatedAt()
-	case slackoauthcredentials.FieldUpdatedAt:
+	case recapfeedback.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -7631,56 +8401,65 @@ func (m *SlackOAuthCredentialsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SlackOAuthCredentialsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RecapFeedbackMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case slackoauthcredentials.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case slackoauthcredentials.FieldRefreshToken:
-		return m.OldRefreshToken(ctx)
-	case slackoauthcredentials.FieldAccessToken:
-		return m.OldAccessToken(ctx)
-	case slackoauthcredentials.FieldCreatedAt:
+	case recapfeedback.FieldChatID:
+		return m.OldChatID(ctx)
+	case recapfeedback.FieldLogID:
+		return m.OldLogID(ctx)
+	case recapfeedback.FieldUserID:
+		return m.OldUserID(ctx)
+	case recapfeedback.FieldText:
+		return m.OldText(ctx)
+	case recapfeedback.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case slackoauthcredentials.FieldUpdatedAt:
+	case recapfeedback.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SlackOAuthCredentials field %s", name)
+	return nil, fmt.Errorf("unknown RecapFeedback field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SlackOAuthCredentialsMutation) SetField(name string, value ent.Value) error {
+func (m *RecapFeedbackMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case slackoauthcredentials.FieldTeamID:
-		v, ok := value.(string)
+	case recapfeedback.FieldChatID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.SetChatID(v)
 		return nil
-	case slackoauthcredentials.FieldRefreshToken:
-		v, ok := value.(string)
+	case recapfeedback.FieldLogID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshToken(v)
+		m.SetLogID(v)
 		return nil
-	case slackoauthcredentials.FieldAccessToken:
+	case recapfeedback.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case recapfeedback.FieldText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessToken(v)
+		m.SetText(v)
 		return nil
-	case slackoauthcredentials.FieldCreatedAt:
+	case recapfeedback.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case slackoauthcredentials.FieldUpdatedAt:
+	case recapfeedback.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -7688,18 +8467,24 @@ func (m *SlackOAuthCredentialsMutation) SetField(name string, value ent.Value) e
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SlackOAuthCredentials field %s", name)
+	return fmt.Errorf("unknown RecapFeedback field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SlackOAuthCredentialsMutation) AddedFields() []string {
+func (m *RecapFeedbackMutation) AddedFields() []string {
 	var fields []string
+	if m.addchat_id != nil {
+		fields = append(fields, recapfeedback.FieldChatID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, recapfeedback.FieldUserID)
+	}
 	if m.addcreated_at != nil {
-		fields = append(fields, slackoauthcredentials.FieldCreatedAt)
+		fields = append(fields, recapfeedback.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, slackoauthcredentials.FieldUpdatedAt)
+		fields = append(fields, recapfeedback.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7707,11 +8492,15 @@ func (m *SlackOAuthCredentialsMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SlackOAuthCredentialsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RecapFeedbackMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case slackoauthcredentials.FieldCreatedAt:
+	case recapfeedback.FieldChatID:
+		return m.AddedChatID()
+	case recapfeedback.FieldUserID:
+		return m.AddedUserID()
+	case recapfeedback.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case slackoauthcredentials.FieldUpdatedAt:
+	case recapfeedback.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
 	}
 	return nil, false
@@ -7720,16 +8509,30 @@ func (m *SlackOAuthCredentialsMutation) AddedField(name string) (ent.Value, bool
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SlackOAuthCredentialsMutation) AddField(name string, value ent.Value) error {
+func (m *RecapFeedbackMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case slackoauthcredentials.FieldCreatedAt:
+	case recapfeedback.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case recapfeedback.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case recapfeedback.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case slackoauthcredentials.FieldUpdatedAt:
+	case recapfeedback.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -7737,152 +8540,161 @@ func (m *SlackOAuthCredentialsMutation) AddField(name string, value ent.Value) e
 		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SlackOAuthCredentials numeric field %s", name)
+	return fmt.Errorf("unknown RecapFeedback numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SlackOAuthCredentialsMutation) ClearedFields() []string {
+func (m *RecapFeedbackMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SlackOAuthCredentialsMutation) FieldCleared(name string) bool {
+func (m *RecapFeedbackMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SlackOAuthCredentialsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SlackOAuthCredentials nullable field %s", name)
+func (m *RecapFeedbackMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RecapFeedback nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SlackOAuthCredentialsMutation) ResetField(name string) error {
+func (m *RecapFeedbackMutation) ResetField(name string) error {
 	switch name {
-	case slackoauthcredentials.FieldTeamID:
-		m.ResetTeamID()
+	case recapfeedback.FieldChatID:
+		m.ResetChatID()
 		return nil
-	case slackoauthcredentials.FieldRefreshToken:
-		m.ResetRefreshToken()
+	case recapfeedback.FieldLogID:
+		m.ResetLogID()
 		return nil
-	case slackoauthcredentials.FieldAccessToken:
-		m.ResetAccessToken()
+	case recapfeedback.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case slackoauthcredentials.FieldCreatedAt:
+	case recapfeedback.FieldText:
+		m.ResetText()
+		return nil
+	case recapfeedback.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case slackoauthcredentials.FieldUpdatedAt:
+	case recapfeedback.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SlackOAuthCredentials field %s", name)
+	return fmt.Errorf("unknown RecapFeedback field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SlackOAuthCredentialsMutation) AddedEdges() []string {
+func (m *RecapFeedbackMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SlackOAuthCredentialsMutation) AddedIDs(name string) []ent.Value {
+func (m *RecapFeedbackMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SlackOAuthCredentialsMutation) RemovedEdges() []string {
+func (m *RecapFeedbackMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SlackOAuthCredentialsMutation) RemovedIDs(name string) []ent.Value {
+func (m *RecapFeedbackMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SlackOAuthCredentialsMutation) ClearedEdges() []string {
+func (m *RecapFeedbackMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SlackOAuthCredentialsMutation) EdgeCleared(name string) bool {
+func (m *RecapFeedbackMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SlackOAuthCredentialsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SlackOAuthCredentials unique edge %s", name)
+func (m *RecapFeedbackMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RecapFeedback unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SlackOAuthCredentialsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SlackOAuthCredentials edge %s", name)
+func (m *RecapFeedbackMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RecapFeedback edge %s", name)
 }
 
-// TelegramChatAutoRecapsSubscribersMutation represents an operation that mutates the TelegramChatAutoRecapsSubscribers nodes in the graph.
-type TelegramChatAutoRecapsSubscribersMutation struct {
+// SentMessagesMutation represents an operation that mutates the SentMessages nodes in the graph.
+type SentMessagesMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	chat_id       *int64
-	addchat_id    *int64
-	user_id       *int64
-	adduser_id    *int64
-	created_at    *int64
-	addcreated_at *int64
-	updated_at    *int64
-	addupdated_at *int64
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*TelegramChatAutoRecapsSubscribers, error)
-	predicates    []predicate.TelegramChatAutoRecapsSubscribers
-}
-
-var _ ent.Mutation = (*TelegramChatAutoRecapsSubscribersMutation)(nil)
-
-// telegramchatautorecapssubscribersOption allows management of the mutation configuration using functional options.
-type telegramchatautorecapssubscribersOption func(*TelegramChatAutoRecapsSubscribersMutation)
-
-// newTelegramChatAutoRecapsSubscribersMutation creates new mutation for the TelegramChatAutoRecapsSubscribers entity.
-func newTelegramChatAutoRecapsSubscribersMutation(c config, op Op, opts ...telegramchatautorecapssubscribersOption) *TelegramChatAutoRecapsSubscribersMutation {
-	m := &TelegramChatAutoRecapsSubscribersMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTelegramChatAutoRecapsSubscribers,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	chat_id          *int64
+	addchat_id       *int64
+	message_id       *int
+	addmessage_id    *int
+	text             *string
+	is_pinned        *bool
+	from_platform    *int
+	addfrom_platform *int
+	message_type     *int
+	addmessage_type  *int
+	created_at       *int64
+	addcreated_at    *int64
+	updated_at       *int64
+	addupdated_at    *int64
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*SentMessages, error)
+	predicates       []predicate.SentMessages
+}
+
+var _ ent.Mutation = (*SentMessagesMutation)(nil)
+
+// sentmessagesOption allows management of the mutation configuration using functional options.
+type sentmessagesOption func(*SentMessagesMutation)
+
+// newSentMessagesMutation creates new mutation for the SentMessages entity.
+func newSentMessagesMutation(c config, op Op, opts ...sentmessagesOption) *SentMessagesMutation {
+	m := &SentMessagesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSentMessages,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
 		opt(m)
 	}
 	return m
 }
 
-// withTelegramChatAutoRecapsSubscribersID sets the ID field of the mutation.
-func withTelegramChatAutoRecapsSubscribersID(id uuid.UUID) telegramchatautorecapssubscribersOption {
-	return func(m *TelegramChatAutoRecapsSubscribersMutation) {
+// withSentMessagesID sets the ID field of the mutation.
+func withSentMessagesID(id uuid.UUID) sentmessagesOption {
+	return func(m *SentMessagesMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramChatAutoRecapsSubscribers
+			value *SentMessages
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramChatAutoRecapsSubscribers, error) {
+		m.oldValue = func(ctx context.Context) (*SentMessages, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramChatAutoRecapsSubscribers.Get(ctx, id)
+					value, err = m.Client().SentMessages.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7891,10 +8703,10 @@ func withTelegramChatAutoRecapsSubscribersID(id uuid.UUID) telegramchatautorecap
 	}
 }
 
-// withTelegramChatAutoRecapsSubscribers sets the old TelegramChatAutoRecapsSubscribers of the mutation.
-func withTelegramChatAutoRecapsSubscribers(node *TelegramChatAutoRecapsSubscribers) telegramchatautorecapssubscribersOption {
-	return func(m *TelegramChatAutoRecapsSubscribersMutation) {
-		m.oldValue = func(context.Context) (*TelegramChatAutoRecapsSubscribers, error) {
+// withSentMessages sets the old SentMessages of the mutation.
+func withSentMessages(node *SentMessages) sentmessagesOption {
+	return func(m *SentMessagesMutation) {
+		m.oldValue = func(context.Context) (*SentMessages, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7903,7 +8715,7 @@ func withTelegramChatAutoRecapsSubscribers(node *TelegramChatAutoRecapsSubscribe
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramChatAutoRecapsSubscribersMutation) Client() *Client {
+func (m SentMessagesMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7911,7 +8723,7 @@ func (m TelegramChatAutoRecapsSubscribersMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramChatAutoRecapsSubscribersMutation) Tx() (*Tx, error) {
+func (m SentMessagesMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7921,14 +8733,14 @@ func (m TelegramChatAutoRecapsSubscribersMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TelegramChatAutoRecapsSubscribers entities.
-func (m *TelegramChatAutoRecapsSubscribersMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SentMessages entities.
+func (m *SentMessagesMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SentMessagesMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7939,7 +8751,7 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) ID() (id uuid.UUID, exists b
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SentMessagesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7948,20 +8760,20 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) IDs(ctx context.Context) ([]
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramChatAutoRecapsSubscribers.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SentMessages.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetChatID sets the "chat_id" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) SetChatID(i int64) {
+func (m *SentMessagesMutation) SetChatID(i int64) {
 	m.chat_id = &i
 	m.addchat_id = nil
 }
 
 // ChatID returns the value of the "chat_id" field in the mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ChatID() (r int64, exists bool) {
+func (m *SentMessagesMutation) ChatID() (r int64, exists bool) {
 	v := m.chat_id
 	if v == nil {
 		return
@@ -7969,10 +8781,10 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) ChatID() (r int64, exists bo
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the TelegramChatAutoRecapsSubscribers entity.
-// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatAutoRecapsSubscribersMutation) OldChatID(ctx context.Context) (v int64, err error) {
+func (m *SentMessagesMutation) OldChatID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
@@ -7987,7 +8799,7 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) OldChatID(ctx context.Contex
 }
 
 // AddChatID adds i to the "chat_id" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddChatID(i int64) {
+func (m *SentMessagesMutation) AddChatID(i int64) {
 	if m.addchat_id != nil {
 		*m.addchat_id += i
 	} else {
@@ -7996,7 +8808,7 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) AddChatID(i int64) {
 }
 
 // AddedChatID returns the value that was added to the "chat_id" field in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedChatID() (r int64, exists bool) {
+func (m *SentMessagesMutation) AddedChatID() (r int64, exists bool) {
 	v := m.addchat_id
 	if v == nil {
 		return
@@ -8005,188 +8817,372 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) AddedChatID() (r int64, exis
 }
 
 // ResetChatID resets all changes to the "chat_id" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ResetChatID() {
+func (m *SentMessagesMutation) ResetChatID() {
 	m.chat_id = nil
 	m.addchat_id = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) SetUserID(i int64) {
-	m.user_id = &i
-	m.adduser_id = nil
+// SetMessageID sets the "message_id" field.
+func (m *SentMessagesMutation) SetMessageID(i int) {
+	m.message_id = &i
+	m.addmessage_id = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) UserID() (r int64, exists bool) {
-	v := m.user_id
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *SentMessagesMutation) MessageID() (r int, exists bool) {
+	v := m.message_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the TelegramChatAutoRecapsSubscribers entity.
-// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatAutoRecapsSubscribersMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *SentMessagesMutation) OldMessageID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.MessageID, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddUserID(i int64) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
+// AddMessageID adds i to the "message_id" field.
+func (m *SentMessagesMutation) AddMessageID(i int) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
 	} else {
-		m.adduser_id = &i
+		m.addmessage_id = &i
 	}
 }
 
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedUserID() (r int64, exists bool) {
-	v := m.adduser_id
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *SentMessagesMutation) AddedMessageID() (r int, exists bool) {
+	v := m.addmessage_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *SentMessagesMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) SetCreatedAt(i int64) {
-	m.created_at = &i
-	m.addcreated_at = nil
+// SetText sets the "text" field.
+func (m *SentMessagesMutation) SetText(s string) {
+	m.text = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) CreatedAt() (r int64, exists bool) {
-	v := m.created_at
+// Text returns the value of the "text" field in the mutation.
+func (m *SentMessagesMutation) Text() (r string, exists bool) {
+	v := m.text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TelegramChatAutoRecapsSubscribers entity.
-// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// OldText returns the old "text" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatAutoRecapsSubscribersMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *SentMessagesMutation) OldText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Text, nil
 }
 
-// AddCreatedAt adds i to the "created_at" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddCreatedAt(i int64) {
-	if m.addcreated_at != nil {
-		*m.addcreated_at += i
-	} else {
-		m.addcreated_at = &i
-	}
+// ResetText resets all changes to the "text" field.
+func (m *SentMessagesMutation) ResetText() {
+	m.text = nil
 }
 
-// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedCreatedAt() (r int64, exists bool) {
-	v := m.addcreated_at
+// SetIsPinned sets the "is_pinned" field.
+func (m *SentMessagesMutation) SetIsPinned(b bool) {
+	m.is_pinned = &b
+}
+
+// IsPinned returns the value of the "is_pinned" field in the mutation.
+func (m *SentMessagesMutation) IsPinned() (r bool, exists bool) {
+	v := m.is_pinned
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ResetCreatedAt() {
-	m.created_at = nil
-	m.addcreated_at = nil
+// OldIsPinned returns the old "is_pinned" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentMessagesMutation) OldIsPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPinned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPinned: %w", err)
+	}
+	return oldValue.IsPinned, nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) SetUpdatedAt(i int64) {
-	m.updated_at = &i
-	m.addupdated_at = nil
+// ResetIsPinned resets all changes to the "is_pinned" field.
+func (m *SentMessagesMutation) ResetIsPinned() {
+	m.is_pinned = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) UpdatedAt() (r int64, exists bool) {
-	v := m.updated_at
+// SetFromPlatform sets the "from_platform" field.
+func (m *SentMessagesMutation) SetFromPlatform(i int) {
+	m.from_platform = &i
+	m.addfrom_platform = nil
+}
+
+// FromPlatform returns the value of the "from_platform" field in the mutation.
+func (m *SentMessagesMutation) FromPlatform() (r int, exists bool) {
+	v := m.from_platform
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TelegramChatAutoRecapsSubscribers entity.
-// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// OldFromPlatform returns the old "from_platform" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatAutoRecapsSubscribersMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *SentMessagesMutation) OldFromPlatform(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFromPlatform is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFromPlatform requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFromPlatform: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.FromPlatform, nil
 }
 
-// AddUpdatedAt adds i to the "updated_at" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddUpdatedAt(i int64) {
-	if m.addupdated_at != nil {
-		*m.addupdated_at += i
+// AddFromPlatform adds i to the "from_platform" field.
+func (m *SentMessagesMutation) AddFromPlatform(i int) {
+	if m.addfrom_platform != nil {
+		*m.addfrom_platform += i
 	} else {
-		m.addupdated_at = &i
+		m.addfrom_platform = &i
 	}
 }
 
-// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedUpdatedAt() (r int64, exists bool) {
-	v := m.addupdated_at
+// AddedFromPlatform returns the value that was added to the "from_platform" field in this mutation.
+func (m *SentMessagesMutation) AddedFromPlatform() (r int, exists bool) {
+	v := m.addfrom_platform
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ResetUpdatedAt() {
+// ResetFromPlatform resets all changes to the "from_platform" field.
+func (m *SentMessagesMutation) ResetFromPlatform() {
+	m.from_platform = nil
+	m.addfrom_platform = nil
+}
+
+// SetMessageType sets the "message_type" field.
+func (m *SentMessagesMutation) SetMessageType(i int) {
+	m.message_type = &i
+	m.addmessage_type = nil
+}
+
+// MessageType returns the value of the "message_type" field in the mutation.
+func (m *SentMessagesMutation) MessageType() (r int, exists bool) {
+	v := m.message_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageType returns the old "message_type" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentMessagesMutation) OldMessageType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageType: %w", err)
+	}
+	return oldValue.MessageType, nil
+}
+
+// AddMessageType adds i to the "message_type" field.
+func (m *SentMessagesMutation) AddMessageType(i int) {
+	if m.addmessage_type != nil {
+		*m.addmessage_type += i
+	} else {
+		m.addmessage_type = &i
+	}
+}
+
+// AddedMessageType returns the value that was added to the "message_type" field in this mutation.
+func (m *SentMessagesMutation) AddedMessageType() (r int, exists bool) {
+	v := m.addmessage_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageType resets all changes to the "message_type" field.
+func (m *SentMessagesMutation) ResetMessageType() {
+	m.message_type = nil
+	m.addmessage_type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SentMessagesMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SentMessagesMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentMessagesMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *SentMessagesMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *SentMessagesMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SentMessagesMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SentMessagesMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SentMessagesMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SentMessages entity.
+// If the SentMessages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentMessagesMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *SentMessagesMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *SentMessagesMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SentMessagesMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
-// Where appends a list predicates to the TelegramChatAutoRecapsSubscribersMutation builder.
-func (m *TelegramChatAutoRecapsSubscribersMutation) Where(ps ...predicate.TelegramChatAutoRecapsSubscribers) {
+// Where appends a list predicates to the SentMessagesMutation builder.
+func (m *SentMessagesMutation) Where(ps ...predicate.SentMessages) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramChatAutoRecapsSubscribersMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SentMessagesMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramChatAutoRecapsSubscribersMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramChatAutoRecapsSubscribers, len(ps))
+func (m *SentMessagesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SentMessages, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8194,36 +9190,48 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) WhereP(ps ...func(*sql.Selec
 }
 
 // Op returns the operation name.
-func (m *TelegramChatAutoRecapsSubscribersMutation) Op() Op {
+func (m *SentMessagesMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) SetOp(op Op) {
+func (m *SentMessagesMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramChatAutoRecapsSubscribers).
-func (m *TelegramChatAutoRecapsSubscribersMutation) Type() string {
+// Type returns the node type of this mutation (SentMessages).
+func (m *SentMessagesMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramChatAutoRecapsSubscribersMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *SentMessagesMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.chat_id != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldChatID)
+		fields = append(fields, sentmessages.FieldChatID)
 	}
-	if m.user_id != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldUserID)
+	if m.message_id != nil {
+		fields = append(fields, sentmessages.FieldMessageID)
+	}
+	if m.text != nil {
+		fields = append(fields, sentmessages.FieldText)
+	}
+	if m.is_pinned != nil {
+		fields = append(fields, sentmessages.FieldIsPinned)
+	}
+	if m.from_platform != nil {
+		fields = append(fields, sentmessages.FieldFromPlatform)
+	}
+	if m.message_type != nil {
+		fields = append(fields, sentmessages.FieldMessageType)
 	}
 	if m.created_at != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldCreatedAt)
+		fields = append(fields, sentmessages.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldUpdatedAt)
+		fields = append(fields, sentmessages.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8231,15 +9239,23 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramChatAutoRecapsSubscribersMutation) Field(name string) (ent.Value, bool) {
+func (m *SentMessagesMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchatautorecapssubscribers.FieldChatID:
+	case sentmessages.FieldChatID:
 		return m.ChatID()
-	case telegramchatautorecapssubscribers.FieldUserID:
-		return m.UserID()
-	case telegramchatautorecapssubscribers.FieldCreatedAt:
+	case sentmessages.FieldMessageID:
+		return m.MessageID()
+	case sentmessages.FieldText:
+		return m.Text()
+	case sentmessages.FieldIsPinned:
+		return m.IsPinned()
+	case sentmessages.FieldFromPlatform:
+		return m.FromPlatform()
+	case sentmessages.FieldMessageType:
+		return m.MessageType()
+	case sentmessages.FieldCreatedAt:
 		return m.CreatedAt()
-	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+	case sentmessages.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -8248,47 +9264,83 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) Field(name string) (ent.Valu
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramChatAutoRecapsSubscribersMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SentMessagesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramchatautorecapssubscribers.FieldChatID:
+	case sentmessages.FieldChatID:
 		return m.OldChatID(ctx)
-	case telegramchatautorecapssubscribers.FieldUserID:
-		return m.OldUserID(ctx)
-	case telegramchatautorecapssubscribers.FieldCreatedAt:
+	case sentmessages.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case sentmessages.FieldText:
+		return m.OldText(ctx)
+	case sentmessages.FieldIsPinned:
+		return m.OldIsPinned(ctx)
+	case sentmessages.FieldFromPlatform:
+		return m.OldFromPlatform(ctx)
+	case sentmessages.FieldMessageType:
+		return m.OldMessageType(ctx)
+	case sentmessages.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+	case sentmessages.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers field %s", name)
+	return nil, fmt.Errorf("unknown SentMessages field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChatAutoRecapsSubscribersMutation) SetField(name string, value ent.Value) error {
+func (m *SentMessagesMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramchatautorecapssubscribers.FieldChatID:
+	case sentmessages.FieldChatID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChatID(v)
 		return nil
-	case telegramchatautorecapssubscribers.FieldUserID:
-		v, ok := value.(int64)
+	case sentmessages.FieldMessageID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetMessageID(v)
 		return nil
-	case telegramchatautorecapssubscribers.FieldCreatedAt:
+	case sentmessages.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case sentmessages.FieldIsPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPinned(v)
+		return nil
+	case sentmessages.FieldFromPlatform:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromPlatform(v)
+		return nil
+	case sentmessages.FieldMessageType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageType(v)
+		return nil
+	case sentmessages.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+	case sentmessages.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -8296,24 +9348,30 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) SetField(name string, value
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers field %s", name)
+	return fmt.Errorf("unknown SentMessages field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedFields() []string {
+func (m *SentMessagesMutation) AddedFields() []string {
 	var fields []string
 	if m.addchat_id != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldChatID)
+		fields = append(fields, sentmessages.FieldChatID)
 	}
-	if m.adduser_id != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldUserID)
+	if m.addmessage_id != nil {
+		fields = append(fields, sentmessages.FieldMessageID)
+	}
+	if m.addfrom_platform != nil {
+		fields = append(fields, sentmessages.FieldFromPlatform)
+	}
+	if m.addmessage_type != nil {
+		fields = append(fields, sentmessages.FieldMessageType)
 	}
 	if m.addcreated_at != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldCreatedAt)
+		fields = append(fields, sentmessages.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, telegramchatautorecapssubscribers.FieldUpdatedAt)
+		fields = append(fields, sentmessages.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8321,15 +9379,19 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SentMessagesMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchatautorecapssubscribers.FieldChatID:
+	case sentmessages.FieldChatID:
 		return m.AddedChatID()
-	case telegramchatautorecapssubscribers.FieldUserID:
-		return m.AddedUserID()
-	case telegramchatautorecapssubscribers.FieldCreatedAt:
+	case sentmessages.FieldMessageID:
+		return m.AddedMessageID()
+	case sentmessages.FieldFromPlatform:
+		return m.AddedFromPlatform()
+	case sentmessages.FieldMessageType:
+		return m.AddedMessageType()
+	case sentmessages.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+	case sentmessages.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
 	}
 	return nil, false
@@ -8338,30 +9400,44 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) AddedField(name string) (ent
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddField(name string, value ent.Value) error {
+func (m *SentMessagesMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case telegramchatautorecapssubscribers.FieldChatID:
+	case sentmessages.FieldChatID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddChatID(v)
 		return nil
-	case telegramchatautorecapssubscribers.FieldUserID:
-		v, ok := value.(int64)
+	case sentmessages.FieldMessageID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserID(v)
+		m.AddMessageID(v)
 		return nil
-	case telegramchatautorecapssubscribers.FieldCreatedAt:
+	case sentmessages.FieldFromPlatform:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFromPlatform(v)
+		return nil
+	case sentmessages.FieldMessageType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageType(v)
+		return nil
+	case sentmessages.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+	case sentmessages.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -8369,129 +9445,138 @@ func (m *TelegramChatAutoRecapsSubscribersMutation) AddField(name string, value
 		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers numeric field %s", name)
+	return fmt.Errorf("unknown SentMessages numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ClearedFields() []string {
+func (m *SentMessagesMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) FieldCleared(name string) bool {
+func (m *SentMessagesMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers nullable field %s", name)
+func (m *SentMessagesMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SentMessages nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ResetField(name string) error {
+func (m *SentMessagesMutation) ResetField(name string) error {
 	switch name {
-	case telegramchatautorecapssubscribers.FieldChatID:
+	case sentmessages.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case telegramchatautorecapssubscribers.FieldUserID:
-		m.ResetUserID()
+	case sentmessages.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case telegramchatautorecapssubscribers.FieldCreatedAt:
+	case sentmessages.FieldText:
+		m.ResetText()
+		return nil
+	case sentmessages.FieldIsPinned:
+		m.ResetIsPinned()
+		return nil
+	case sentmessages.FieldFromPlatform:
+		m.ResetFromPlatform()
+		return nil
+	case sentmessages.FieldMessageType:
+		m.ResetMessageType()
+		return nil
+	case sentmessages.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+	case sentmessages.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers field %s", name)
+	return fmt.Errorf("unknown SentMessages field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedEdges() []string {
+func (m *SentMessagesMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) AddedIDs(name string) []ent.Value {
+func (m *SentMessagesMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) RemovedEdges() []string {
+func (m *SentMessagesMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) RemovedIDs(name string) []ent.Value {
+func (m *SentMessagesMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ClearedEdges() []string {
+func (m *SentMessagesMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramChatAutoRecapsSubscribersMutation) EdgeCleared(name string) bool {
+func (m *SentMessagesMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers unique edge %s", name)
+func (m *SentMessagesMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SentMessages unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramChatAutoRecapsSubscribersMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers edge %s", name)
+func (m *SentMessagesMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SentMessages edge %s", name)
 }
 
-// TelegramChatFeatureFlagsMutation represents an operation that mutates the TelegramChatFeatureFlags nodes in the graph.
-type TelegramChatFeatureFlagsMutation struct {
+// SlackOAuthCredentialsMutation represents an operation that mutates the SlackOAuthCredentials nodes in the graph.
+type SlackOAuthCredentialsMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uuid.UUID
-	chat_id                      *int64
-	addchat_id                   *int64
-	chat_type                    *string
-	chat_title                   *string
-	feature_chat_histories_recap *bool
-	feature_language             *string
-	created_at                   *int64
-	addcreated_at                *int64
-	updated_at                   *int64
-	addupdated_at                *int64
-	clearedFields                map[string]struct{}
-	done                         bool
-	oldValue                     func(context.Context) (*TelegramChatFeatureFlags, error)
-	predicates                   []predicate.TelegramChatFeatureFlags
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	team_id       *string
+	refresh_token *string
+	access_token  *string
+	created_at    *int64
+	addcreated_at *int64
+	updated_at    *int64
+	addupdated_at *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SlackOAuthCredentials, error)
+	predicates    []predicate.SlackOAuthCredentials
 }
 
-var _ ent.Mutation = (*TelegramChatFeatureFlagsMutation)(nil)
+var _ ent.Mutation = (*SlackOAuthCredentialsMutation)(nil)
 
-// telegramchatfeatureflagsOption allows management of the mutation configuration using functional options.
-type telegramchatfeatureflagsOption func(*TelegramChatFeatureFlagsMutation)
+// slackoauthcredentialsOption allows management of the mutation configuration using functional options.
+type slackoauthcredentialsOption func(*SlackOAuthCredentialsMutation)
 
-// newTelegramChatFeatureFlagsMutation creates new mutation for the TelegramChatFeatureFlags entity.
-func newTelegramChatFeatureFlagsMutation(c config, op Op, opts ...telegramchatfeatureflagsOption) *TelegramChatFeatureFlagsMutation {
-	m := &TelegramChatFeatureFlagsMutation{
+// newSlackOAuthCredentialsMutation creates new mutation for the SlackOAuthCredentials entity.
+func newSlackOAuthCredentialsMutation(c config, op Op, opts ...slackoauthcredentialsOption) *SlackOAuthCredentialsMutation {
+	m := &SlackOAuthCredentialsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTelegramChatFeatureFlags,
+		typ:           TypeSlackOAuthCredentials,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8500,20 +9585,20 @@ func newTelegramChatFeatureFlagsMutation(c config, op Op, opts ...telegramchatfe
 	return m
 }
 
-// withTelegramChatFeatureFlagsID sets the ID field of the mutation.
-func withTelegramChatFeatureFlagsID(id uuid.UUID) telegramchatfeatureflagsOption {
-	return func(m *TelegramChatFeatureFlagsMutation) {
+// withSlackOAuthCredentialsID sets the ID field of the mutation.
+func withSlackOAuthCredentialsID(id uuid.UUID) slackoauthcredentialsOption {
+	return func(m *SlackOAuthCredentialsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramChatFeatureFlags
+			value *SlackOAuthCredentials
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramChatFeatureFlags, error) {
+		m.oldValue = func(ctx context.Context) (*SlackOAuthCredentials, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramChatFeatureFlags.Get(ctx, id)
+					value, err = m.Client().SlackOAuthCredentials.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8522,10 +9607,10 @@ func withTelegramChatFeatureFlagsID(id uuid.UUID) telegramchatfeatureflagsOption
 	}
 }
 
-// withTelegramChatFeatureFlags sets the old TelegramChatFeatureFlags of the mutation.
-func withTelegramChatFeatureFlags(node *TelegramChatFeatureFlags) telegramchatfeatureflagsOption {
-	return func(m *TelegramChatFeatureFlagsMutation) {
-		m.oldValue = func(context.Context) (*TelegramChatFeatureFlags, error) {
+// withSlackOAuthCredentials sets the old SlackOAuthCredentials of the mutation.
+func withSlackOAuthCredentials(node *SlackOAuthCredentials) slackoauthcredentialsOption {
+	return func(m *SlackOAuthCredentialsMutation) {
+		m.oldValue = func(context.Context) (*SlackOAuthCredentials, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8534,7 +9619,7 @@ func withTelegramChatFeatureFlags(node *TelegramChatFeatureFlags) telegramchatfe
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramChatFeatureFlagsMutation) Client() *Client {
+func (m SlackOAuthCredentialsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8542,7 +9627,7 @@ func (m TelegramChatFeatureFlagsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramChatFeatureFlagsMutation) Tx() (*Tx, error) {
+func (m SlackOAuthCredentialsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8552,14 +9637,14 @@ func (m TelegramChatFeatureFlagsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TelegramChatFeatureFlags entities.
-func (m *TelegramChatFeatureFlagsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SlackOAuthCredentials entities.
+func (m *SlackOAuthCredentialsMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramChatFeatureFlagsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SlackOAuthCredentialsMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8570,7 +9655,7 @@ func (m *TelegramChatFeatureFlagsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramChatFeatureFlagsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SlackOAuthCredentialsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8579,220 +9664,128 @@ func (m *TelegramChatFeatureFlagsMutation) IDs(ctx context.Context) ([]uuid.UUID
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramChatFeatureFlags.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SlackOAuthCredentials.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *TelegramChatFeatureFlagsMutation) SetChatID(i int64) {
-	m.chat_id = &i
-	m.addchat_id = nil
+// SetTeamID sets the "team_id" field.
+func (m *SlackOAuthCredentialsMutation) SetTeamID(s string) {
+	m.team_id = &s
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *TelegramChatFeatureFlagsMutation) ChatID() (r int64, exists bool) {
-	v := m.chat_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChatID returns the old "chat_id" field's value of the TelegramChatFeatureFlags entity.
-// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatFeatureFlagsMutation) OldChatID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
-	}
-	return oldValue.ChatID, nil
-}
-
-// AddChatID adds i to the "chat_id" field.
-func (m *TelegramChatFeatureFlagsMutation) AddChatID(i int64) {
-	if m.addchat_id != nil {
-		*m.addchat_id += i
-	} else {
-		m.addchat_id = &i
-	}
-}
-
-// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) AddedChatID() (r int64, exists bool) {
-	v := m.addchat_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *TelegramChatFeatureFlagsMutation) ResetChatID() {
-	m.chat_id = nil
-	m.addchat_id = nil
-}
-
-// SetChatType sets the "chat_type" field.
-func (m *TelegramChatFeatureFlagsMutation) SetChatType(s string) {
-	m.chat_type = &s
-}
-
-// ChatType returns the value of the "chat_type" field in the mutation.
-func (m *TelegramChatFeatureFlagsMutation) ChatType() (r string, exists bool) {
-	v := m.chat_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChatType returns the old "chat_type" field's value of the TelegramChatFeatureFlags entity.
-// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatFeatureFlagsMutation) OldChatType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatType: %w", err)
-	}
-	return oldValue.ChatType, nil
-}
-
-// ResetChatType resets all changes to the "chat_type" field.
-func (m *TelegramChatFeatureFlagsMutation) ResetChatType() {
-	m.chat_type = nil
-}
-
-// SetChatTitle sets the "chat_title" field.
-func (m *TelegramChatFeatureFlagsMutation) SetChatTitle(s string) {
-	m.chat_title = &s
-}
-
-// ChatTitle returns the value of the "chat_title" field in the mutation.
-func (m *TelegramChatFeatureFlagsMutation) ChatTitle() (r string, exists bool) {
-	v := m.chat_title
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *SlackOAuthCredentialsMutation) TeamID() (r string, exists bool) {
+	v := m.team_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatTitle returns the old "chat_title" field's value of the TelegramChatFeatureFlags entity.
-// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the SlackOAuthCredentials entity.
+// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatFeatureFlagsMutation) OldChatTitle(ctx context.Context) (v string, err error) {
+func (m *SlackOAuthCredentialsMutation) OldTeamID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatTitle requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.ChatTitle, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetChatTitle resets all changes to the "chat_title" field.
-func (m *TelegramChatFeatureFlagsMutation) ResetChatTitle() {
-	m.chat_title = nil
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *SlackOAuthCredentialsMutation) ResetTeamID() {
+	m.team_id = nil
 }
 
-// SetFeatureChatHistoriesRecap sets the "feature_chat_histories_recap" field.
-func (m *TelegramChatFeatureFlagsMutation) SetFeatureChatHistoriesRecap(b bool) {
-	m.feature_chat_histories_recap = &b
+// SetRefreshToken sets the "refresh_token" field.
+func (m *SlackOAuthCredentialsMutation) SetRefreshToken(s string) {
+	m.refresh_token = &s
 }
 
-// FeatureChatHistoriesRecap returns the value of the "feature_chat_histories_recap" field in the mutation.
-func (m *TelegramChatFeatureFlagsMutation) FeatureChatHistoriesRecap() (r bool, exists bool) {
-	v := m.feature_chat_histories_recap
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *SlackOAuthCredentialsMutation) RefreshToken() (r string, exists bool) {
+	v := m.refresh_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFeatureChatHistoriesRecap returns the old "feature_chat_histories_recap" field's value of the TelegramChatFeatureFlags entity.
-// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// OldRefreshToken returns the old "refresh_token" field's value of the SlackOAuthCredentials entity.
+// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatFeatureFlagsMutation) OldFeatureChatHistoriesRecap(ctx context.Context) (v bool, err error) {
+func (m *SlackOAuthCredentialsMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFeatureChatHistoriesRecap is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFeatureChatHistoriesRecap requires an ID field in the mutation")
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFeatureChatHistoriesRecap: %w", err)
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
 	}
-	return oldValue.FeatureChatHistoriesRecap, nil
+	return oldValue.RefreshToken, nil
 }
 
-// ResetFeatureChatHistoriesRecap resets all changes to the "feature_chat_histories_recap" field.
-func (m *TelegramChatFeatureFlagsMutation) ResetFeatureChatHistoriesRecap() {
-	m.feature_chat_histories_recap = nil
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *SlackOAuthCredentialsMutation) ResetRefreshToken() {
+	m.refresh_token = nil
 }
 
-// SetFeatureLanguage sets the "feature_language" field.
-func (m *TelegramChatFeatureFlagsMutation) SetFeatureLanguage(s string) {
-	m.feature_language = &s
+// SetAccessToken sets the "access_token" field.
+func (m *SlackOAuthCredentialsMutation) SetAccessToken(s string) {
+	m.access_token = &s
 }
 
-// FeatureLanguage returns the value of the "feature_language" field in the mutation.
-func (m *TelegramChatFeatureFlagsMutation) FeatureLanguage() (r string, exists bool) {
-	v := m.feature_language
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *SlackOAuthCredentialsMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFeatureLanguage returns the old "feature_language" field's value of the TelegramChatFeatureFlags entity.
-// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessToken returns the old "access_token" field's value of the SlackOAuthCredentials entity.
+// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatFeatureFlagsMutation) OldFeatureLanguage(ctx context.Context) (v string, err error) {
+func (m *SlackOAuthCredentialsMutation) OldAccessToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFeatureLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFeatureLanguage requires an ID field in the mutation")
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFeatureLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
 	}
-	return oldValue.FeatureLanguage, nil
+	return oldValue.AccessToken, nil
 }
 
-// ResetFeatureLanguage resets all changes to the "feature_language" field.
-func (m *TelegramChatFeatureFlagsMutation) ResetFeatureLanguage() {
-	m.feature_language = nil
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *SlackOAuthCredentialsMutation) ResetAccessToken() {
+	m.access_token = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TelegramChatFeatureFlagsMutation) SetCreatedAt(i int64) {
+func (m *SlackOAuthCredentialsMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TelegramChatFeatureFlagsMutation) CreatedAt() (r int64, exists bool) {
+func (m *SlackOAuthCredentialsMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8800,10 +9793,10 @@ func (m *TelegramChatFeatureFlagsMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TelegramChatFeatureFlags entity.
-// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SlackOAuthCredentials entity.
+// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatFeatureFlagsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *SlackOAuthCredentialsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8818,7 +9811,7 @@ func (m *TelegramChatFeatureFlagsMutation) OldCreatedAt(ctx context.Context) (v
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *TelegramChatFeatureFlagsMutation) AddCreatedAt(i int64) {
+func (m *SlackOAuthCredentialsMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -8827,7 +9820,7 @@ func (m *TelegramChatFeatureFlagsMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *SlackOAuthCredentialsMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -8836,19 +9829,19 @@ func (m *TelegramChatFeatureFlagsMutation) AddedCreatedAt() (r int64, exists boo
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TelegramChatFeatureFlagsMutation) ResetCreatedAt() {
+func (m *SlackOAuthCredentialsMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TelegramChatFeatureFlagsMutation) SetUpdatedAt(i int64) {
+func (m *SlackOAuthCredentialsMutation) SetUpdatedAt(i int64) {
 	m.updated_at = &i
 	m.addupdated_at = nil
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TelegramChatFeatureFlagsMutation) UpdatedAt() (r int64, exists bool) {
+func (m *SlackOAuthCredentialsMutation) UpdatedAt() (r int64, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8856,10 +9849,10 @@ func (m *TelegramChatFeatureFlagsMutation) UpdatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TelegramChatFeatureFlags entity.
-// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SlackOAuthCredentials entity.
+// If the SlackOAuthCredentials object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatFeatureFlagsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *SlackOAuthCredentialsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8874,7 +9867,7 @@ func (m *TelegramChatFeatureFlagsMutation) OldUpdatedAt(ctx context.Context) (v
 }
 
 // AddUpdatedAt adds i to the "updated_at" field.
-func (m *TelegramChatFeatureFlagsMutation) AddUpdatedAt(i int64) {
+func (m *SlackOAuthCredentialsMutation) AddUpdatedAt(i int64) {
 	if m.addupdated_at != nil {
 		*m.addupdated_at += i
 	} else {
@@ -8883,7 +9876,7 @@ func (m *TelegramChatFeatureFlagsMutation) AddUpdatedAt(i int64) {
 }
 
 // AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) AddedUpdatedAt() (r int64, exists bool) {
+func (m *SlackOAuthCredentialsMutation) AddedUpdatedAt() (r int64, exists bool) {
 	v := m.addupdated_at
 	if v == nil {
 		return
@@ -8892,20 +9885,20 @@ func (m *TelegramChatFeatureFlagsMutation) AddedUpdatedAt() (r int64, exists boo
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TelegramChatFeatureFlagsMutation) ResetUpdatedAt() {
+func (m *SlackOAuthCredentialsMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
-// Where appends a list predicates to the TelegramChatFeatureFlagsMutation builder.
-func (m *TelegramChatFeatureFlagsMutation) Where(ps ...predicate.TelegramChatFeatureFlags) {
+// Where appends a list predicates to the SlackOAuthCredentialsMutation builder.
+func (m *SlackOAuthCredentialsMutation) Where(ps ...predicate.SlackOAuthCredentials) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramChatFeatureFlagsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SlackOAuthCredentialsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramChatFeatureFlagsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramChatFeatureFlags, len(ps))
+func (m *SlackOAuthCredentialsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SlackOAuthCredentials, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8913,45 +9906,39 @@ func (m *TelegramChatFeatureFlagsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TelegramChatFeatureFlagsMutation) Op() Op {
+func (m *SlackOAuthCredentialsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramChatFeatureFlagsMutation) SetOp(op Op) {
+func (m *SlackOAuthCredentialsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramChatFeatureFlags).
-func (m *TelegramChatFeatureFlagsMutation) Type() string {
+// Type returns the node type of this mutation (SlackOAuthCredentials).
+func (m *SlackOAuthCredentialsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramChatFeatureFlagsMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.chat_id != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldChatID)
-	}
-	if m.chat_type != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldChatType)
-	}
-	if m.chat_title != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldChatTitle)
+func (m *SlackOAuthCredentialsMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.team_id != nil {
+		fields = append(fields, slackoauthcredentials.FieldTeamID)
 	}
-	if m.feature_chat_histories_recap != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldFeatureChatHistoriesRecap)
+	if m.refresh_token != nil {
+		fields = append(fields, slackoauthcredentials.FieldRefreshToken)
 	}
-	if m.feature_language != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldFeatureLanguage)
+	if m.access_token != nil {
+		fields = append(fields, slackoauthcredentials.FieldAccessToken)
 	}
 	if m.created_at != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldCreatedAt)
+		fields = append(fields, slackoauthcredentials.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldUpdatedAt)
+		fields = append(fields, slackoauthcredentials.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8959,21 +9946,4004 @@ func (m *TelegramChatFeatureFlagsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramChatFeatureFlagsMutation) Field(name string) (ent.Value, bool) {
+func (m *SlackOAuthCredentialsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case slackoauthcredentials.FieldTeamID:
+		return m.TeamID()
+	case slackoauthcredentials.FieldRefreshToken:
+		return m.RefreshToken()
+	case slackoauthcredentials.FieldAccessToken:
+		return m.AccessToken()
+	case slackoauthcredentials.FieldCreatedAt:
+		return m.CreatedAt()
+	case slackoauthcredentials.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SlackOAuthCredentialsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case slackoauthcredentials.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case slackoauthcredentials.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	case slackoauthcredentials.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case slackoauthcredentials.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case slackoauthcredentials.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SlackOAuthCredentials field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlackOAuthCredentialsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case slackoauthcredentials.FieldTeamID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case slackoauthcredentials.FieldRefreshToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshToken(v)
+		return nil
+	case slackoauthcredentials.FieldAccessToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessToken(v)
+		return nil
+	case slackoauthcredentials.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case slackoauthcredentials.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SlackOAuthCredentials field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SlackOAuthCredentialsMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, slackoauthcredentials.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, slackoauthcredentials.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SlackOAuthCredentialsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case slackoauthcredentials.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case slackoauthcredentials.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlackOAuthCredentialsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case slackoauthcredentials.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case slackoauthcredentials.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SlackOAuthCredentials numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SlackOAuthCredentialsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SlackOAuthCredentialsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SlackOAuthCredentialsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SlackOAuthCredentials nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SlackOAuthCredentialsMutation) ResetField(name string) error {
+	switch name {
+	case slackoauthcredentials.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case slackoauthcredentials.FieldRefreshToken:
+		m.ResetRefreshToken()
+		return nil
+	case slackoauthcredentials.FieldAccessToken:
+		m.ResetAccessToken()
+		return nil
+	case slackoauthcredentials.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case slackoauthcredentials.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SlackOAuthCredentials field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SlackOAuthCredentialsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SlackOAuthCredentialsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SlackOAuthCredentialsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SlackOAuthCredentialsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SlackOAuthCredentialsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SlackOAuthCredentialsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SlackOAuthCredentialsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SlackOAuthCredentials unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SlackOAuthCredentialsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SlackOAuthCredentials edge %s", name)
+}
+
+// TelegramChatAutoRecapsSubscribersMutation represents an operation that mutates the TelegramChatAutoRecapsSubscribers nodes in the graph.
+type TelegramChatAutoRecapsSubscribersMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	chat_id       *int64
+	addchat_id    *int64
+	user_id       *int64
+	adduser_id    *int64
+	created_at    *int64
+	addcreated_at *int64
+	updated_at    *int64
+	addupdated_at *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TelegramChatAutoRecapsSubscribers, error)
+	predicates    []predicate.TelegramChatAutoRecapsSubscribers
+}
+
+var _ ent.Mutation = (*TelegramChatAutoRecapsSubscribersMutation)(nil)
+
+// telegramchatautorecapssubscribersOption allows management of the mutation configuration using functional options.
+type telegramchatautorecapssubscribersOption func(*TelegramChatAutoRecapsSubscribersMutation)
+
+// newTelegramChatAutoRecapsSubscribersMutation creates new mutation for the TelegramChatAutoRecapsSubscribers entity.
+func newTelegramChatAutoRecapsSubscribersMutation(c config, op Op, opts ...telegramchatautorecapssubscribersOption) *TelegramChatAutoRecapsSubscribersMutation {
+	m := &TelegramChatAutoRecapsSubscribersMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTelegramChatAutoRecapsSubscribers,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTelegramChatAutoRecapsSubscribersID sets the ID field of the mutation.
+func withTelegramChatAutoRecapsSubscribersID(id uuid.UUID) telegramchatautorecapssubscribersOption {
+	return func(m *TelegramChatAutoRecapsSubscribersMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TelegramChatAutoRecapsSubscribers
+		)
+		m.oldValue = func(ctx context.Context) (*TelegramChatAutoRecapsSubscribers, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TelegramChatAutoRecapsSubscribers.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTelegramChatAutoRecapsSubscribers sets the old TelegramChatAutoRecapsSubscribers of the mutation.
+func withTelegramChatAutoRecapsSubscribers(node *TelegramChatAutoRecapsSubscribers) telegramchatautorecapssubscribersOption {
+	return func(m *TelegramChatAutoRecapsSubscribersMutation) {
+		m.oldValue = func(context.Context) (*TelegramChatAutoRecapsSubscribers, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TelegramChatAutoRecapsSubscribersMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TelegramChatAutoRecapsSubscribersMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TelegramChatAutoRecapsSubscribers entities.
+func (m *TelegramChatAutoRecapsSubscribersMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramChatAutoRecapsSubscribers.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the TelegramChatAutoRecapsSubscribers entity.
+// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatAutoRecapsSubscribersMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the TelegramChatAutoRecapsSubscribers entity.
+// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatAutoRecapsSubscribersMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TelegramChatAutoRecapsSubscribers entity.
+// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatAutoRecapsSubscribersMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TelegramChatAutoRecapsSubscribers entity.
+// If the TelegramChatAutoRecapsSubscribers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatAutoRecapsSubscribersMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the TelegramChatAutoRecapsSubscribersMutation builder.
+func (m *TelegramChatAutoRecapsSubscribersMutation) Where(ps ...predicate.TelegramChatAutoRecapsSubscribers) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TelegramChatAutoRecapsSubscribersMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TelegramChatAutoRecapsSubscribersMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramChatAutoRecapsSubscribers, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TelegramChatAutoRecapsSubscribersMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TelegramChatAutoRecapsSubscribers).
+func (m *TelegramChatAutoRecapsSubscribersMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TelegramChatAutoRecapsSubscribersMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.chat_id != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldChatID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TelegramChatAutoRecapsSubscribersMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchatautorecapssubscribers.FieldChatID:
+		return m.ChatID()
+	case telegramchatautorecapssubscribers.FieldUserID:
+		return m.UserID()
+	case telegramchatautorecapssubscribers.FieldCreatedAt:
+		return m.CreatedAt()
+	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TelegramChatAutoRecapsSubscribersMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case telegramchatautorecapssubscribers.FieldChatID:
+		return m.OldChatID(ctx)
+	case telegramchatautorecapssubscribers.FieldUserID:
+		return m.OldUserID(ctx)
+	case telegramchatautorecapssubscribers.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramChatAutoRecapsSubscribersMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case telegramchatautorecapssubscribers.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case telegramchatautorecapssubscribers.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case telegramchatautorecapssubscribers.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedFields() []string {
+	var fields []string
+	if m.addchat_id != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldChatID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldUserID)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, telegramchatautorecapssubscribers.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchatautorecapssubscribers.FieldChatID:
+		return m.AddedChatID()
+	case telegramchatautorecapssubscribers.FieldUserID:
+		return m.AddedUserID()
+	case telegramchatautorecapssubscribers.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case telegramchatautorecapssubscribers.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case telegramchatautorecapssubscribers.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case telegramchatautorecapssubscribers.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ResetField(name string) error {
+	switch name {
+	case telegramchatautorecapssubscribers.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case telegramchatautorecapssubscribers.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case telegramchatautorecapssubscribers.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case telegramchatautorecapssubscribers.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TelegramChatAutoRecapsSubscribersMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TelegramChatAutoRecapsSubscribersMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChatAutoRecapsSubscribers edge %s", name)
+}
+
+// TelegramChatFeatureFlagsMutation represents an operation that mutates the TelegramChatFeatureFlags nodes in the graph.
+type TelegramChatFeatureFlagsMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	chat_id                      *int64
+	addchat_id                   *int64
+	chat_type                    *string
+	chat_title                   *string
+	feature_chat_histories_recap *bool
+	feature_language             *string
+	created_at                   *int64
+	addcreated_at                *int64
+	updated_at                   *int64
+	addupdated_at                *int64
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*TelegramChatFeatureFlags, error)
+	predicates                   []predicate.TelegramChatFeatureFlags
+}
+
+var _ ent.Mutation = (*TelegramChatFeatureFlagsMutation)(nil)
+
+// telegramchatfeatureflagsOption allows management of the mutation configuration using functional options.
+type telegramchatfeatureflagsOption func(*TelegramChatFeatureFlagsMutation)
+
+// newTelegramChatFeatureFlagsMutation creates new mutation for the TelegramChatFeatureFlags entity.
+func newTelegramChatFeatureFlagsMutation(c config, op Op, opts ...telegramchatfeatureflagsOption) *TelegramChatFeatureFlagsMutation {
+	m := &TelegramChatFeatureFlagsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTelegramChatFeatureFlags,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTelegramChatFeatureFlagsID sets the ID field of the mutation.
+func withTelegramChatFeatureFlagsID(id uuid.UUID) telegramchatfeatureflagsOption {
+	return func(m *TelegramChatFeatureFlagsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TelegramChatFeatureFlags
+		)
+		m.oldValue = func(ctx context.Context) (*TelegramChatFeatureFlags, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TelegramChatFeatureFlags.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTelegramChatFeatureFlags sets the old TelegramChatFeatureFlags of the mutation.
+func withTelegramChatFeatureFlags(node *TelegramChatFeatureFlags) telegramchatfeatureflagsOption {
+	return func(m *TelegramChatFeatureFlagsMutation) {
+		m.oldValue = func(context.Context) (*TelegramChatFeatureFlags, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TelegramChatFeatureFlagsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TelegramChatFeatureFlagsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TelegramChatFeatureFlags entities.
+func (m *TelegramChatFeatureFlagsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TelegramChatFeatureFlagsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TelegramChatFeatureFlagsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramChatFeatureFlags.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *TelegramChatFeatureFlagsMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *TelegramChatFeatureFlagsMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the TelegramChatFeatureFlags entity.
+// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatFeatureFlagsMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *TelegramChatFeatureFlagsMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *TelegramChatFeatureFlagsMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetChatType sets the "chat_type" field.
+func (m *TelegramChatFeatureFlagsMutation) SetChatType(s string) {
+	m.chat_type = &s
+}
+
+// ChatType returns the value of the "chat_type" field in the mutation.
+func (m *TelegramChatFeatureFlagsMutation) ChatType() (r string, exists bool) {
+	v := m.chat_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatType returns the old "chat_type" field's value of the TelegramChatFeatureFlags entity.
+// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatFeatureFlagsMutation) OldChatType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatType: %w", err)
+	}
+	return oldValue.ChatType, nil
+}
+
+// ResetChatType resets all changes to the "chat_type" field.
+func (m *TelegramChatFeatureFlagsMutation) ResetChatType() {
+	m.chat_type = nil
+}
+
+// SetChatTitle sets the "chat_title" field.
+func (m *TelegramChatFeatureFlagsMutation) SetChatTitle(s string) {
+	m.chat_title = &s
+}
+
+// ChatTitle returns the value of the "chat_title" field in the mutation.
+func (m *TelegramChatFeatureFlagsMutation) ChatTitle() (r string, exists bool) {
+	v := m.chat_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatTitle returns the old "chat_title" field's value of the TelegramChatFeatureFlags entity.
+// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatFeatureFlagsMutation) OldChatTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatTitle: %w", err)
+	}
+	return oldValue.ChatTitle, nil
+}
+
+// ResetChatTitle resets all changes to the "chat_title" field.
+func (m *TelegramChatFeatureFlagsMutation) ResetChatTitle() {
+	m.chat_title = nil
+}
+
+// SetFeatureChatHistoriesRecap sets the "feature_chat_histories_recap" field.
+func (m *TelegramChatFeatureFlagsMutation) SetFeatureChatHistoriesRecap(b bool) {
+	m.feature_chat_histories_recap = &b
+}
+
+// FeatureChatHistoriesRecap returns the value of the "feature_chat_histories_recap" field in the mutation.
+func (m *TelegramChatFeatureFlagsMutation) FeatureChatHistoriesRecap() (r bool, exists bool) {
+	v := m.feature_chat_histories_recap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureChatHistoriesRecap returns the old "feature_chat_histories_recap" field's value of the TelegramChatFeatureFlags entity.
+// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatFeatureFlagsMutation) OldFeatureChatHistoriesRecap(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureChatHistoriesRecap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureChatHistoriesRecap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureChatHistoriesRecap: %w", err)
+	}
+	return oldValue.FeatureChatHistoriesRecap, nil
+}
+
+// ResetFeatureChatHistoriesRecap resets all changes to the "feature_chat_histories_recap" field.
+func (m *TelegramChatFeatureFlagsMutation) ResetFeatureChatHistoriesRecap() {
+	m.feature_chat_histories_recap = nil
+}
+
+// SetFeatureLanguage sets the "feature_language" field.
+func (m *TelegramChatFeatureFlagsMutation) SetFeatureLanguage(s string) {
+	m.feature_language = &s
+}
+
+// FeatureLanguage returns the value of the "feature_language" field in the mutation.
+func (m *TelegramChatFeatureFlagsMutation) FeatureLanguage() (r string, exists bool) {
+	v := m.feature_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureLanguage returns the old "feature_language" field's value of the TelegramChatFeatureFlags entity.
+// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatFeatureFlagsMutation) OldFeatureLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureLanguage: %w", err)
+	}
+	return oldValue.FeatureLanguage, nil
+}
+
+// ResetFeatureLanguage resets all changes to the "feature_language" field.
+func (m *TelegramChatFeatureFlagsMutation) ResetFeatureLanguage() {
+	m.feature_language = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TelegramChatFeatureFlagsMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TelegramChatFeatureFlagsMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TelegramChatFeatureFlags entity.
+// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatFeatureFlagsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *TelegramChatFeatureFlagsMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TelegramChatFeatureFlagsMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TelegramChatFeatureFlagsMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TelegramChatFeatureFlagsMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TelegramChatFeatureFlags entity.
+// If the TelegramChatFeatureFlags object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatFeatureFlagsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *TelegramChatFeatureFlagsMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TelegramChatFeatureFlagsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the TelegramChatFeatureFlagsMutation builder.
+func (m *TelegramChatFeatureFlagsMutation) Where(ps ...predicate.TelegramChatFeatureFlags) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TelegramChatFeatureFlagsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TelegramChatFeatureFlagsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramChatFeatureFlags, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TelegramChatFeatureFlagsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TelegramChatFeatureFlagsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TelegramChatFeatureFlags).
+func (m *TelegramChatFeatureFlagsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TelegramChatFeatureFlagsMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.chat_id != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldChatID)
+	}
+	if m.chat_type != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldChatType)
+	}
+	if m.chat_title != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldChatTitle)
+	}
+	if m.feature_chat_histories_recap != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldFeatureChatHistoriesRecap)
+	}
+	if m.feature_language != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldFeatureLanguage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TelegramChatFeatureFlagsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchatfeatureflags.FieldChatID:
+		return m.ChatID()
+	case telegramchatfeatureflags.FieldChatType:
+		return m.ChatType()
+	case telegramchatfeatureflags.FieldChatTitle:
+		return m.ChatTitle()
+	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
+		return m.FeatureChatHistoriesRecap()
+	case telegramchatfeatureflags.FieldFeatureLanguage:
+		return m.FeatureLanguage()
+	case telegramchatfeatureflags.FieldCreatedAt:
+		return m.CreatedAt()
+	case telegramchatfeatureflags.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TelegramChatFeatureFlagsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case telegramchatfeatureflags.FieldChatID:
+		return m.OldChatID(ctx)
+	case telegramchatfeatureflags.FieldChatType:
+		return m.OldChatType(ctx)
+	case telegramchatfeatureflags.FieldChatTitle:
+		return m.OldChatTitle(ctx)
+	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
+		return m.OldFeatureChatHistoriesRecap(ctx)
+	case telegramchatfeatureflags.FieldFeatureLanguage:
+		return m.OldFeatureLanguage(ctx)
+	case telegramchatfeatureflags.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case telegramchatfeatureflags.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TelegramChatFeatureFlags field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramChatFeatureFlagsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case telegramchatfeatureflags.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case telegramchatfeatureflags.FieldChatType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatType(v)
+		return nil
+	case telegramchatfeatureflags.FieldChatTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatTitle(v)
+		return nil
+	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureChatHistoriesRecap(v)
+		return nil
+	case telegramchatfeatureflags.FieldFeatureLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureLanguage(v)
+		return nil
+	case telegramchatfeatureflags.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case telegramchatfeatureflags.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChatFeatureFlags field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TelegramChatFeatureFlagsMutation) AddedFields() []string {
+	var fields []string
+	if m.addchat_id != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldChatID)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, telegramchatfeatureflags.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TelegramChatFeatureFlagsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchatfeatureflags.FieldChatID:
+		return m.AddedChatID()
+	case telegramchatfeatureflags.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case telegramchatfeatureflags.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramChatFeatureFlagsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case telegramchatfeatureflags.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case telegramchatfeatureflags.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case telegramchatfeatureflags.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChatFeatureFlags numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TelegramChatFeatureFlagsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TelegramChatFeatureFlagsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramChatFeatureFlags nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TelegramChatFeatureFlagsMutation) ResetField(name string) error {
 	switch name {
 	case telegramchatfeatureflags.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case telegramchatfeatureflags.FieldChatType:
+		m.ResetChatType()
+		return nil
+	case telegramchatfeatureflags.FieldChatTitle:
+		m.ResetChatTitle()
+		return nil
+	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
+		m.ResetFeatureChatHistoriesRecap()
+		return nil
+	case telegramchatfeatureflags.FieldFeatureLanguage:
+		m.ResetFeatureLanguage()
+		return nil
+	case telegramchatfeatureflags.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case telegramchatfeatureflags.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChatFeatureFlags field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TelegramChatFeatureFlagsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TelegramChatFeatureFlagsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChatFeatureFlags unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TelegramChatFeatureFlagsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChatFeatureFlags edge %s", name)
+}
+
+// TelegramChatRecapsOptionsMutation represents an operation that mutates the TelegramChatRecapsOptions nodes in the graph.
+type TelegramChatRecapsOptionsMutation struct {
+	config
+	op                                    Op
+	typ                                   string
+	id                                    *uuid.UUID
+	chat_id                               *int64
+	addchat_id                            *int64
+	auto_recap_send_mode                  *int
+	addauto_recap_send_mode               *int
+	manual_recap_rate_per_seconds         *int64
+	addmanual_recap_rate_per_seconds      *int64
+	auto_recap_rates_per_day              *int
+	addauto_recap_rates_per_day           *int
+	pin_auto_recap_message                *bool
+	pin_auto_recap_message_silently       *bool
+	recap_disclaimer                      *string
+	recap_target_chat_id                  *int64
+	addrecap_target_chat_id               *int64
+	recap_persona                         *string
+	include_bot_messages                  *bool
+	auto_recaps_snoozed_until             *int64
+	addauto_recaps_snoozed_until          *int64
+	quiet_notice_enabled                  *bool
+	last_quiet_notice_at                  *int64
+	addlast_quiet_notice_at               *int64
+	per_topic_messages                    *bool
+	summary_temperature                   *float64
+	addsummary_temperature                *float64
+	recap_output_format                   *int
+	addrecap_output_format                *int
+	min_message_length_for_summary        *int
+	addmin_message_length_for_summary     *int
+	count_short_messages_for_activity     *bool
+	summary_languages                     *string
+	subscribe_min_membership_days         *int
+	addsubscribe_min_membership_days      *int
+	subscribe_min_member_status           *int
+	addsubscribe_min_member_status        *int
+	top_keywords_count                    *int
+	addtop_keywords_count                 *int
+	dedup_forwards                        *bool
+	store_message_content                 *bool
+	anonymize_participants                *bool
+	manual_recap_private                  *bool
+	recap_in_progress_template            *string
+	excluded_message_types                *int
+	addexcluded_message_types             *int
+	recap_weekdays                        *[]time.Weekday
+	appendrecap_weekdays                  []time.Weekday
+	related_messages_count                *int
+	addrelated_messages_count             *int
+	incremental_recap                     *bool
+	recap_link_preview                    *bool
+	auto_unpin_after_seconds              *int64
+	addauto_unpin_after_seconds           *int64
+	recap_thread_id                       *int
+	addrecap_thread_id                    *int
+	manual_recap_rate_limit_exempt_admins *bool
+	manual_recap_min_role                 *int
+	addmanual_recap_min_role              *int
+	approval_required                     *bool
+	collect_only                          *bool
+	recap_document_attachment             *bool
+	summary_max_tokens                    *int
+	addsummary_max_tokens                 *int
+	created_at                            *int64
+	addcreated_at                         *int64
+	updated_at                            *int64
+	addupdated_at                         *int64
+	clearedFields                         map[string]struct{}
+	done                                  bool
+	oldValue                              func(context.Context) (*TelegramChatRecapsOptions, error)
+	predicates                            []predicate.TelegramChatRecapsOptions
+}
+
+var _ ent.Mutation = (*TelegramChatRecapsOptionsMutation)(nil)
+
+// telegramchatrecapsoptionsOption allows management of the mutation configuration using functional options.
+type telegramchatrecapsoptionsOption func(*TelegramChatRecapsOptionsMutation)
+
+// newTelegramChatRecapsOptionsMutation creates new mutation for the TelegramChatRecapsOptions entity.
+func newTelegramChatRecapsOptionsMutation(c config, op Op, opts ...telegramchatrecapsoptionsOption) *TelegramChatRecapsOptionsMutation {
+	m := &TelegramChatRecapsOptionsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTelegramChatRecapsOptions,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTelegramChatRecapsOptionsID sets the ID field of the mutation.
+func withTelegramChatRecapsOptionsID(id uuid.UUID) telegramchatrecapsoptionsOption {
+	return func(m *TelegramChatRecapsOptionsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TelegramChatRecapsOptions
+		)
+		m.oldValue = func(ctx context.Context) (*TelegramChatRecapsOptions, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TelegramChatRecapsOptions.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTelegramChatRecapsOptions sets the old TelegramChatRecapsOptions of the mutation.
+func withTelegramChatRecapsOptions(node *TelegramChatRecapsOptions) telegramchatrecapsoptionsOption {
+	return func(m *TelegramChatRecapsOptionsMutation) {
+		m.oldValue = func(context.Context) (*TelegramChatRecapsOptions, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TelegramChatRecapsOptionsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TelegramChatRecapsOptionsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TelegramChatRecapsOptions entities.
+func (m *TelegramChatRecapsOptionsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TelegramChatRecapsOptionsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TelegramChatRecapsOptionsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramChatRecapsOptions.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *TelegramChatRecapsOptionsMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *TelegramChatRecapsOptionsMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetAutoRecapSendMode sets the "auto_recap_send_mode" field.
+func (m *TelegramChatRecapsOptionsMutation) SetAutoRecapSendMode(i int) {
+	m.auto_recap_send_mode = &i
+	m.addauto_recap_send_mode = nil
+}
+
+// AutoRecapSendMode returns the value of the "auto_recap_send_mode" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) AutoRecapSendMode() (r int, exists bool) {
+	v := m.auto_recap_send_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoRecapSendMode returns the old "auto_recap_send_mode" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldAutoRecapSendMode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoRecapSendMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoRecapSendMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoRecapSendMode: %w", err)
+	}
+	return oldValue.AutoRecapSendMode, nil
+}
+
+// AddAutoRecapSendMode adds i to the "auto_recap_send_mode" field.
+func (m *TelegramChatRecapsOptionsMutation) AddAutoRecapSendMode(i int) {
+	if m.addauto_recap_send_mode != nil {
+		*m.addauto_recap_send_mode += i
+	} else {
+		m.addauto_recap_send_mode = &i
+	}
+}
+
+// AddedAutoRecapSendMode returns the value that was added to the "auto_recap_send_mode" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedAutoRecapSendMode() (r int, exists bool) {
+	v := m.addauto_recap_send_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAutoRecapSendMode resets all changes to the "auto_recap_send_mode" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetAutoRecapSendMode() {
+	m.auto_recap_send_mode = nil
+	m.addauto_recap_send_mode = nil
+}
+
+// SetManualRecapRatePerSeconds sets the "manual_recap_rate_per_seconds" field.
+func (m *TelegramChatRecapsOptionsMutation) SetManualRecapRatePerSeconds(i int64) {
+	m.manual_recap_rate_per_seconds = &i
+	m.addmanual_recap_rate_per_seconds = nil
+}
+
+// ManualRecapRatePerSeconds returns the value of the "manual_recap_rate_per_seconds" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) ManualRecapRatePerSeconds() (r int64, exists bool) {
+	v := m.manual_recap_rate_per_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManualRecapRatePerSeconds returns the old "manual_recap_rate_per_seconds" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldManualRecapRatePerSeconds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManualRecapRatePerSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManualRecapRatePerSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManualRecapRatePerSeconds: %w", err)
+	}
+	return oldValue.ManualRecapRatePerSeconds, nil
+}
+
+// AddManualRecapRatePerSeconds adds i to the "manual_recap_rate_per_seconds" field.
+func (m *TelegramChatRecapsOptionsMutation) AddManualRecapRatePerSeconds(i int64) {
+	if m.addmanual_recap_rate_per_seconds != nil {
+		*m.addmanual_recap_rate_per_seconds += i
+	} else {
+		m.addmanual_recap_rate_per_seconds = &i
+	}
+}
+
+// AddedManualRecapRatePerSeconds returns the value that was added to the "manual_recap_rate_per_seconds" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedManualRecapRatePerSeconds() (r int64, exists bool) {
+	v := m.addmanual_recap_rate_per_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetManualRecapRatePerSeconds resets all changes to the "manual_recap_rate_per_seconds" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetManualRecapRatePerSeconds() {
+	m.manual_recap_rate_per_seconds = nil
+	m.addmanual_recap_rate_per_seconds = nil
+}
+
+// SetAutoRecapRatesPerDay sets the "auto_recap_rates_per_day" field.
+func (m *TelegramChatRecapsOptionsMutation) SetAutoRecapRatesPerDay(i int) {
+	m.auto_recap_rates_per_day = &i
+	m.addauto_recap_rates_per_day = nil
+}
+
+// AutoRecapRatesPerDay returns the value of the "auto_recap_rates_per_day" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) AutoRecapRatesPerDay() (r int, exists bool) {
+	v := m.auto_recap_rates_per_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoRecapRatesPerDay returns the old "auto_recap_rates_per_day" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldAutoRecapRatesPerDay(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoRecapRatesPerDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoRecapRatesPerDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoRecapRatesPerDay: %w", err)
+	}
+	return oldValue.AutoRecapRatesPerDay, nil
+}
+
+// AddAutoRecapRatesPerDay adds i to the "auto_recap_rates_per_day" field.
+func (m *TelegramChatRecapsOptionsMutation) AddAutoRecapRatesPerDay(i int) {
+	if m.addauto_recap_rates_per_day != nil {
+		*m.addauto_recap_rates_per_day += i
+	} else {
+		m.addauto_recap_rates_per_day = &i
+	}
+}
+
+// AddedAutoRecapRatesPerDay returns the value that was added to the "auto_recap_rates_per_day" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedAutoRecapRatesPerDay() (r int, exists bool) {
+	v := m.addauto_recap_rates_per_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAutoRecapRatesPerDay resets all changes to the "auto_recap_rates_per_day" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetAutoRecapRatesPerDay() {
+	m.auto_recap_rates_per_day = nil
+	m.addauto_recap_rates_per_day = nil
+}
+
+// SetPinAutoRecapMessage sets the "pin_auto_recap_message" field.
+func (m *TelegramChatRecapsOptionsMutation) SetPinAutoRecapMessage(b bool) {
+	m.pin_auto_recap_message = &b
+}
+
+// PinAutoRecapMessage returns the value of the "pin_auto_recap_message" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) PinAutoRecapMessage() (r bool, exists bool) {
+	v := m.pin_auto_recap_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinAutoRecapMessage returns the old "pin_auto_recap_message" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldPinAutoRecapMessage(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinAutoRecapMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinAutoRecapMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinAutoRecapMessage: %w", err)
+	}
+	return oldValue.PinAutoRecapMessage, nil
+}
+
+// ResetPinAutoRecapMessage resets all changes to the "pin_auto_recap_message" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetPinAutoRecapMessage() {
+	m.pin_auto_recap_message = nil
+}
+
+// SetPinAutoRecapMessageSilently sets the "pin_auto_recap_message_silently" field.
+func (m *TelegramChatRecapsOptionsMutation) SetPinAutoRecapMessageSilently(b bool) {
+	m.pin_auto_recap_message_silently = &b
+}
+
+// PinAutoRecapMessageSilently returns the value of the "pin_auto_recap_message_silently" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) PinAutoRecapMessageSilently() (r bool, exists bool) {
+	v := m.pin_auto_recap_message_silently
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinAutoRecapMessageSilently returns the old "pin_auto_recap_message_silently" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldPinAutoRecapMessageSilently(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinAutoRecapMessageSilently is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinAutoRecapMessageSilently requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinAutoRecapMessageSilently: %w", err)
+	}
+	return oldValue.PinAutoRecapMessageSilently, nil
+}
+
+// ResetPinAutoRecapMessageSilently resets all changes to the "pin_auto_recap_message_silently" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetPinAutoRecapMessageSilently() {
+	m.pin_auto_recap_message_silently = nil
+}
+
+// SetRecapDisclaimer sets the "recap_disclaimer" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapDisclaimer(s string) {
+	m.recap_disclaimer = &s
+}
+
+// RecapDisclaimer returns the value of the "recap_disclaimer" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapDisclaimer() (r string, exists bool) {
+	v := m.recap_disclaimer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapDisclaimer returns the old "recap_disclaimer" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapDisclaimer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapDisclaimer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapDisclaimer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapDisclaimer: %w", err)
+	}
+	return oldValue.RecapDisclaimer, nil
+}
+
+// ResetRecapDisclaimer resets all changes to the "recap_disclaimer" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapDisclaimer() {
+	m.recap_disclaimer = nil
+}
+
+// SetRecapTargetChatID sets the "recap_target_chat_id" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapTargetChatID(i int64) {
+	m.recap_target_chat_id = &i
+	m.addrecap_target_chat_id = nil
+}
+
+// RecapTargetChatID returns the value of the "recap_target_chat_id" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapTargetChatID() (r int64, exists bool) {
+	v := m.recap_target_chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapTargetChatID returns the old "recap_target_chat_id" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapTargetChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapTargetChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapTargetChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapTargetChatID: %w", err)
+	}
+	return oldValue.RecapTargetChatID, nil
+}
+
+// AddRecapTargetChatID adds i to the "recap_target_chat_id" field.
+func (m *TelegramChatRecapsOptionsMutation) AddRecapTargetChatID(i int64) {
+	if m.addrecap_target_chat_id != nil {
+		*m.addrecap_target_chat_id += i
+	} else {
+		m.addrecap_target_chat_id = &i
+	}
+}
+
+// AddedRecapTargetChatID returns the value that was added to the "recap_target_chat_id" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedRecapTargetChatID() (r int64, exists bool) {
+	v := m.addrecap_target_chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRecapTargetChatID resets all changes to the "recap_target_chat_id" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapTargetChatID() {
+	m.recap_target_chat_id = nil
+	m.addrecap_target_chat_id = nil
+}
+
+// SetRecapPersona sets the "recap_persona" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapPersona(s string) {
+	m.recap_persona = &s
+}
+
+// RecapPersona returns the value of the "recap_persona" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapPersona() (r string, exists bool) {
+	v := m.recap_persona
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapPersona returns the old "recap_persona" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapPersona(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapPersona is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapPersona requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapPersona: %w", err)
+	}
+	return oldValue.RecapPersona, nil
+}
+
+// ResetRecapPersona resets all changes to the "recap_persona" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapPersona() {
+	m.recap_persona = nil
+}
+
+// SetIncludeBotMessages sets the "include_bot_messages" field.
+func (m *TelegramChatRecapsOptionsMutation) SetIncludeBotMessages(b bool) {
+	m.include_bot_messages = &b
+}
+
+// IncludeBotMessages returns the value of the "include_bot_messages" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) IncludeBotMessages() (r bool, exists bool) {
+	v := m.include_bot_messages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncludeBotMessages returns the old "include_bot_messages" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldIncludeBotMessages(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncludeBotMessages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncludeBotMessages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncludeBotMessages: %w", err)
+	}
+	return oldValue.IncludeBotMessages, nil
+}
+
+// ResetIncludeBotMessages resets all changes to the "include_bot_messages" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetIncludeBotMessages() {
+	m.include_bot_messages = nil
+}
+
+// SetAutoRecapsSnoozedUntil sets the "auto_recaps_snoozed_until" field.
+func (m *TelegramChatRecapsOptionsMutation) SetAutoRecapsSnoozedUntil(i int64) {
+	m.auto_recaps_snoozed_until = &i
+	m.addauto_recaps_snoozed_until = nil
+}
+
+// AutoRecapsSnoozedUntil returns the value of the "auto_recaps_snoozed_until" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) AutoRecapsSnoozedUntil() (r int64, exists bool) {
+	v := m.auto_recaps_snoozed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoRecapsSnoozedUntil returns the old "auto_recaps_snoozed_until" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldAutoRecapsSnoozedUntil(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoRecapsSnoozedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoRecapsSnoozedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoRecapsSnoozedUntil: %w", err)
+	}
+	return oldValue.AutoRecapsSnoozedUntil, nil
+}
+
+// AddAutoRecapsSnoozedUntil adds i to the "auto_recaps_snoozed_until" field.
+func (m *TelegramChatRecapsOptionsMutation) AddAutoRecapsSnoozedUntil(i int64) {
+	if m.addauto_recaps_snoozed_until != nil {
+		*m.addauto_recaps_snoozed_until += i
+	} else {
+		m.addauto_recaps_snoozed_until = &i
+	}
+}
+
+// AddedAutoRecapsSnoozedUntil returns the value that was added to the "auto_recaps_snoozed_until" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedAutoRecapsSnoozedUntil() (r int64, exists bool) {
+	v := m.addauto_recaps_snoozed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAutoRecapsSnoozedUntil resets all changes to the "auto_recaps_snoozed_until" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetAutoRecapsSnoozedUntil() {
+	m.auto_recaps_snoozed_until = nil
+	m.addauto_recaps_snoozed_until = nil
+}
+
+// SetQuietNoticeEnabled sets the "quiet_notice_enabled" field.
+func (m *TelegramChatRecapsOptionsMutation) SetQuietNoticeEnabled(b bool) {
+	m.quiet_notice_enabled = &b
+}
+
+// QuietNoticeEnabled returns the value of the "quiet_notice_enabled" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) QuietNoticeEnabled() (r bool, exists bool) {
+	v := m.quiet_notice_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietNoticeEnabled returns the old "quiet_notice_enabled" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldQuietNoticeEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietNoticeEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietNoticeEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietNoticeEnabled: %w", err)
+	}
+	return oldValue.QuietNoticeEnabled, nil
+}
+
+// ResetQuietNoticeEnabled resets all changes to the "quiet_notice_enabled" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetQuietNoticeEnabled() {
+	m.quiet_notice_enabled = nil
+}
+
+// SetLastQuietNoticeAt sets the "last_quiet_notice_at" field.
+func (m *TelegramChatRecapsOptionsMutation) SetLastQuietNoticeAt(i int64) {
+	m.last_quiet_notice_at = &i
+	m.addlast_quiet_notice_at = nil
+}
+
+// LastQuietNoticeAt returns the value of the "last_quiet_notice_at" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) LastQuietNoticeAt() (r int64, exists bool) {
+	v := m.last_quiet_notice_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastQuietNoticeAt returns the old "last_quiet_notice_at" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldLastQuietNoticeAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastQuietNoticeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastQuietNoticeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastQuietNoticeAt: %w", err)
+	}
+	return oldValue.LastQuietNoticeAt, nil
+}
+
+// AddLastQuietNoticeAt adds i to the "last_quiet_notice_at" field.
+func (m *TelegramChatRecapsOptionsMutation) AddLastQuietNoticeAt(i int64) {
+	if m.addlast_quiet_notice_at != nil {
+		*m.addlast_quiet_notice_at += i
+	} else {
+		m.addlast_quiet_notice_at = &i
+	}
+}
+
+// AddedLastQuietNoticeAt returns the value that was added to the "last_quiet_notice_at" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedLastQuietNoticeAt() (r int64, exists bool) {
+	v := m.addlast_quiet_notice_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastQuietNoticeAt resets all changes to the "last_quiet_notice_at" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetLastQuietNoticeAt() {
+	m.last_quiet_notice_at = nil
+	m.addlast_quiet_notice_at = nil
+}
+
+// SetPerTopicMessages sets the "per_topic_messages" field.
+func (m *TelegramChatRecapsOptionsMutation) SetPerTopicMessages(b bool) {
+	m.per_topic_messages = &b
+}
+
+// PerTopicMessages returns the value of the "per_topic_messages" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) PerTopicMessages() (r bool, exists bool) {
+	v := m.per_topic_messages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPerTopicMessages returns the old "per_topic_messages" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldPerTopicMessages(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPerTopicMessages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPerTopicMessages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPerTopicMessages: %w", err)
+	}
+	return oldValue.PerTopicMessages, nil
+}
+
+// ResetPerTopicMessages resets all changes to the "per_topic_messages" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetPerTopicMessages() {
+	m.per_topic_messages = nil
+}
+
+// SetSummaryTemperature sets the "summary_temperature" field.
+func (m *TelegramChatRecapsOptionsMutation) SetSummaryTemperature(f float64) {
+	m.summary_temperature = &f
+	m.addsummary_temperature = nil
+}
+
+// SummaryTemperature returns the value of the "summary_temperature" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) SummaryTemperature() (r float64, exists bool) {
+	v := m.summary_temperature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryTemperature returns the old "summary_temperature" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldSummaryTemperature(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryTemperature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryTemperature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryTemperature: %w", err)
+	}
+	return oldValue.SummaryTemperature, nil
+}
+
+// AddSummaryTemperature adds f to the "summary_temperature" field.
+func (m *TelegramChatRecapsOptionsMutation) AddSummaryTemperature(f float64) {
+	if m.addsummary_temperature != nil {
+		*m.addsummary_temperature += f
+	} else {
+		m.addsummary_temperature = &f
+	}
+}
+
+// AddedSummaryTemperature returns the value that was added to the "summary_temperature" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedSummaryTemperature() (r float64, exists bool) {
+	v := m.addsummary_temperature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSummaryTemperature resets all changes to the "summary_temperature" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetSummaryTemperature() {
+	m.summary_temperature = nil
+	m.addsummary_temperature = nil
+}
+
+// SetRecapOutputFormat sets the "recap_output_format" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapOutputFormat(i int) {
+	m.recap_output_format = &i
+	m.addrecap_output_format = nil
+}
+
+// RecapOutputFormat returns the value of the "recap_output_format" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapOutputFormat() (r int, exists bool) {
+	v := m.recap_output_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapOutputFormat returns the old "recap_output_format" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapOutputFormat(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapOutputFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapOutputFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapOutputFormat: %w", err)
+	}
+	return oldValue.RecapOutputFormat, nil
+}
+
+// AddRecapOutputFormat adds i to the "recap_output_format" field.
+func (m *TelegramChatRecapsOptionsMutation) AddRecapOutputFormat(i int) {
+	if m.addrecap_output_format != nil {
+		*m.addrecap_output_format += i
+	} else {
+		m.addrecap_output_format = &i
+	}
+}
+
+// AddedRecapOutputFormat returns the value that was added to the "recap_output_format" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedRecapOutputFormat() (r int, exists bool) {
+	v := m.addrecap_output_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRecapOutputFormat resets all changes to the "recap_output_format" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapOutputFormat() {
+	m.recap_output_format = nil
+	m.addrecap_output_format = nil
+}
+
+// SetMinMessageLengthForSummary sets the "min_message_length_for_summary" field.
+func (m *TelegramChatRecapsOptionsMutation) SetMinMessageLengthForSummary(i int) {
+	m.min_message_length_for_summary = &i
+	m.addmin_message_length_for_summary = nil
+}
+
+// MinMessageLengthForSummary returns the value of the "min_message_length_for_summary" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) MinMessageLengthForSummary() (r int, exists bool) {
+	v := m.min_message_length_for_summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinMessageLengthForSummary returns the old "min_message_length_for_summary" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldMinMessageLengthForSummary(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinMessageLengthForSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinMessageLengthForSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinMessageLengthForSummary: %w", err)
+	}
+	return oldValue.MinMessageLengthForSummary, nil
+}
+
+// AddMinMessageLengthForSummary adds i to the "min_message_length_for_summary" field.
+func (m *TelegramChatRecapsOptionsMutation) AddMinMessageLengthForSummary(i int) {
+	if m.addmin_message_length_for_summary != nil {
+		*m.addmin_message_length_for_summary += i
+	} else {
+		m.addmin_message_length_for_summary = &i
+	}
+}
+
+// AddedMinMessageLengthForSummary returns the value that was added to the "min_message_length_for_summary" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedMinMessageLengthForSummary() (r int, exists bool) {
+	v := m.addmin_message_length_for_summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinMessageLengthForSummary resets all changes to the "min_message_length_for_summary" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetMinMessageLengthForSummary() {
+	m.min_message_length_for_summary = nil
+	m.addmin_message_length_for_summary = nil
+}
+
+// SetCountShortMessagesForActivity sets the "count_short_messages_for_activity" field.
+func (m *TelegramChatRecapsOptionsMutation) SetCountShortMessagesForActivity(b bool) {
+	m.count_short_messages_for_activity = &b
+}
+
+// CountShortMessagesForActivity returns the value of the "count_short_messages_for_activity" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) CountShortMessagesForActivity() (r bool, exists bool) {
+	v := m.count_short_messages_for_activity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountShortMessagesForActivity returns the old "count_short_messages_for_activity" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldCountShortMessagesForActivity(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountShortMessagesForActivity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountShortMessagesForActivity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountShortMessagesForActivity: %w", err)
+	}
+	return oldValue.CountShortMessagesForActivity, nil
+}
+
+// ResetCountShortMessagesForActivity resets all changes to the "count_short_messages_for_activity" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetCountShortMessagesForActivity() {
+	m.count_short_messages_for_activity = nil
+}
+
+// SetSummaryLanguages sets the "summary_languages" field.
+func (m *TelegramChatRecapsOptionsMutation) SetSummaryLanguages(s string) {
+	m.summary_languages = &s
+}
+
+// SummaryLanguages returns the value of the "summary_languages" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) SummaryLanguages() (r string, exists bool) {
+	v := m.summary_languages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryLanguages returns the old "summary_languages" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldSummaryLanguages(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryLanguages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryLanguages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryLanguages: %w", err)
+	}
+	return oldValue.SummaryLanguages, nil
+}
+
+// ResetSummaryLanguages resets all changes to the "summary_languages" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetSummaryLanguages() {
+	m.summary_languages = nil
+}
+
+// SetSubscribeMinMembershipDays sets the "subscribe_min_membership_days" field.
+func (m *TelegramChatRecapsOptionsMutation) SetSubscribeMinMembershipDays(i int) {
+	m.subscribe_min_membership_days = &i
+	m.addsubscribe_min_membership_days = nil
+}
+
+// SubscribeMinMembershipDays returns the value of the "subscribe_min_membership_days" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) SubscribeMinMembershipDays() (r int, exists bool) {
+	v := m.subscribe_min_membership_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscribeMinMembershipDays returns the old "subscribe_min_membership_days" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldSubscribeMinMembershipDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscribeMinMembershipDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscribeMinMembershipDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscribeMinMembershipDays: %w", err)
+	}
+	return oldValue.SubscribeMinMembershipDays, nil
+}
+
+// AddSubscribeMinMembershipDays adds i to the "subscribe_min_membership_days" field.
+func (m *TelegramChatRecapsOptionsMutation) AddSubscribeMinMembershipDays(i int) {
+	if m.addsubscribe_min_membership_days != nil {
+		*m.addsubscribe_min_membership_days += i
+	} else {
+		m.addsubscribe_min_membership_days = &i
+	}
+}
+
+// AddedSubscribeMinMembershipDays returns the value that was added to the "subscribe_min_membership_days" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedSubscribeMinMembershipDays() (r int, exists bool) {
+	v := m.addsubscribe_min_membership_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubscribeMinMembershipDays resets all changes to the "subscribe_min_membership_days" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetSubscribeMinMembershipDays() {
+	m.subscribe_min_membership_days = nil
+	m.addsubscribe_min_membership_days = nil
+}
+
+// SetSubscribeMinMemberStatus sets the "subscribe_min_member_status" field.
+func (m *TelegramChatRecapsOptionsMutation) SetSubscribeMinMemberStatus(i int) {
+	m.subscribe_min_member_status = &i
+	m.addsubscribe_min_member_status = nil
+}
+
+// SubscribeMinMemberStatus returns the value of the "subscribe_min_member_status" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) SubscribeMinMemberStatus() (r int, exists bool) {
+	v := m.subscribe_min_member_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscribeMinMemberStatus returns the old "subscribe_min_member_status" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldSubscribeMinMemberStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscribeMinMemberStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscribeMinMemberStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscribeMinMemberStatus: %w", err)
+	}
+	return oldValue.SubscribeMinMemberStatus, nil
+}
+
+// AddSubscribeMinMemberStatus adds i to the "subscribe_min_member_status" field.
+func (m *TelegramChatRecapsOptionsMutation) AddSubscribeMinMemberStatus(i int) {
+	if m.addsubscribe_min_member_status != nil {
+		*m.addsubscribe_min_member_status += i
+	} else {
+		m.addsubscribe_min_member_status = &i
+	}
+}
+
+// AddedSubscribeMinMemberStatus returns the value that was added to the "subscribe_min_member_status" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedSubscribeMinMemberStatus() (r int, exists bool) {
+	v := m.addsubscribe_min_member_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubscribeMinMemberStatus resets all changes to the "subscribe_min_member_status" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetSubscribeMinMemberStatus() {
+	m.subscribe_min_member_status = nil
+	m.addsubscribe_min_member_status = nil
+}
+
+// SetTopKeywordsCount sets the "top_keywords_count" field.
+func (m *TelegramChatRecapsOptionsMutation) SetTopKeywordsCount(i int) {
+	m.top_keywords_count = &i
+	m.addtop_keywords_count = nil
+}
+
+// TopKeywordsCount returns the value of the "top_keywords_count" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) TopKeywordsCount() (r int, exists bool) {
+	v := m.top_keywords_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopKeywordsCount returns the old "top_keywords_count" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldTopKeywordsCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopKeywordsCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopKeywordsCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopKeywordsCount: %w", err)
+	}
+	return oldValue.TopKeywordsCount, nil
+}
+
+// AddTopKeywordsCount adds i to the "top_keywords_count" field.
+func (m *TelegramChatRecapsOptionsMutation) AddTopKeywordsCount(i int) {
+	if m.addtop_keywords_count != nil {
+		*m.addtop_keywords_count += i
+	} else {
+		m.addtop_keywords_count = &i
+	}
+}
+
+// AddedTopKeywordsCount returns the value that was added to the "top_keywords_count" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedTopKeywordsCount() (r int, exists bool) {
+	v := m.addtop_keywords_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTopKeywordsCount resets all changes to the "top_keywords_count" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetTopKeywordsCount() {
+	m.top_keywords_count = nil
+	m.addtop_keywords_count = nil
+}
+
+// SetDedupForwards sets the "dedup_forwards" field.
+func (m *TelegramChatRecapsOptionsMutation) SetDedupForwards(b bool) {
+	m.dedup_forwards = &b
+}
+
+// DedupForwards returns the value of the "dedup_forwards" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) DedupForwards() (r bool, exists bool) {
+	v := m.dedup_forwards
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDedupForwards returns the old "dedup_forwards" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldDedupForwards(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDedupForwards is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDedupForwards requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDedupForwards: %w", err)
+	}
+	return oldValue.DedupForwards, nil
+}
+
+// ResetDedupForwards resets all changes to the "dedup_forwards" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetDedupForwards() {
+	m.dedup_forwards = nil
+}
+
+// SetStoreMessageContent sets the "store_message_content" field.
+func (m *TelegramChatRecapsOptionsMutation) SetStoreMessageContent(b bool) {
+	m.store_message_content = &b
+}
+
+// StoreMessageContent returns the value of the "store_message_content" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) StoreMessageContent() (r bool, exists bool) {
+	v := m.store_message_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoreMessageContent returns the old "store_message_content" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldStoreMessageContent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoreMessageContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoreMessageContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoreMessageContent: %w", err)
+	}
+	return oldValue.StoreMessageContent, nil
+}
+
+// ResetStoreMessageContent resets all changes to the "store_message_content" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetStoreMessageContent() {
+	m.store_message_content = nil
+}
+
+// SetAnonymizeParticipants sets the "anonymize_participants" field.
+func (m *TelegramChatRecapsOptionsMutation) SetAnonymizeParticipants(b bool) {
+	m.anonymize_participants = &b
+}
+
+// AnonymizeParticipants returns the value of the "anonymize_participants" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) AnonymizeParticipants() (r bool, exists bool) {
+	v := m.anonymize_participants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnonymizeParticipants returns the old "anonymize_participants" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldAnonymizeParticipants(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnonymizeParticipants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnonymizeParticipants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnonymizeParticipants: %w", err)
+	}
+	return oldValue.AnonymizeParticipants, nil
+}
+
+// ResetAnonymizeParticipants resets all changes to the "anonymize_participants" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetAnonymizeParticipants() {
+	m.anonymize_participants = nil
+}
+
+// SetManualRecapPrivate sets the "manual_recap_private" field.
+func (m *TelegramChatRecapsOptionsMutation) SetManualRecapPrivate(b bool) {
+	m.manual_recap_private = &b
+}
+
+// ManualRecapPrivate returns the value of the "manual_recap_private" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) ManualRecapPrivate() (r bool, exists bool) {
+	v := m.manual_recap_private
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManualRecapPrivate returns the old "manual_recap_private" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldManualRecapPrivate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManualRecapPrivate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManualRecapPrivate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManualRecapPrivate: %w", err)
+	}
+	return oldValue.ManualRecapPrivate, nil
+}
+
+// ResetManualRecapPrivate resets all changes to the "manual_recap_private" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetManualRecapPrivate() {
+	m.manual_recap_private = nil
+}
+
+// SetRecapInProgressTemplate sets the "recap_in_progress_template" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapInProgressTemplate(s string) {
+	m.recap_in_progress_template = &s
+}
+
+// RecapInProgressTemplate returns the value of the "recap_in_progress_template" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapInProgressTemplate() (r string, exists bool) {
+	v := m.recap_in_progress_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapInProgressTemplate returns the old "recap_in_progress_template" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapInProgressTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapInProgressTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapInProgressTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapInProgressTemplate: %w", err)
+	}
+	return oldValue.RecapInProgressTemplate, nil
+}
+
+// ResetRecapInProgressTemplate resets all changes to the "recap_in_progress_template" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapInProgressTemplate() {
+	m.recap_in_progress_template = nil
+}
+
+// SetExcludedMessageTypes sets the "excluded_message_types" field.
+func (m *TelegramChatRecapsOptionsMutation) SetExcludedMessageTypes(i int) {
+	m.excluded_message_types = &i
+	m.addexcluded_message_types = nil
+}
+
+// ExcludedMessageTypes returns the value of the "excluded_message_types" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) ExcludedMessageTypes() (r int, exists bool) {
+	v := m.excluded_message_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExcludedMessageTypes returns the old "excluded_message_types" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldExcludedMessageTypes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExcludedMessageTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExcludedMessageTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExcludedMessageTypes: %w", err)
+	}
+	return oldValue.ExcludedMessageTypes, nil
+}
+
+// AddExcludedMessageTypes adds i to the "excluded_message_types" field.
+func (m *TelegramChatRecapsOptionsMutation) AddExcludedMessageTypes(i int) {
+	if m.addexcluded_message_types != nil {
+		*m.addexcluded_message_types += i
+	} else {
+		m.addexcluded_message_types = &i
+	}
+}
+
+// AddedExcludedMessageTypes returns the value that was added to the "excluded_message_types" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedExcludedMessageTypes() (r int, exists bool) {
+	v := m.addexcluded_message_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExcludedMessageTypes resets all changes to the "excluded_message_types" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetExcludedMessageTypes() {
+	m.excluded_message_types = nil
+	m.addexcluded_message_types = nil
+}
+
+// SetRecapWeekdays sets the "recap_weekdays" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapWeekdays(t []time.Weekday) {
+	m.recap_weekdays = &t
+	m.appendrecap_weekdays = nil
+}
+
+// RecapWeekdays returns the value of the "recap_weekdays" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapWeekdays() (r []time.Weekday, exists bool) {
+	v := m.recap_weekdays
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapWeekdays returns the old "recap_weekdays" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapWeekdays(ctx context.Context) (v []time.Weekday, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapWeekdays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapWeekdays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapWeekdays: %w", err)
+	}
+	return oldValue.RecapWeekdays, nil
+}
+
+// AppendRecapWeekdays adds t to the "recap_weekdays" field.
+func (m *TelegramChatRecapsOptionsMutation) AppendRecapWeekdays(t []time.Weekday) {
+	m.appendrecap_weekdays = append(m.appendrecap_weekdays, t...)
+}
+
+// AppendedRecapWeekdays returns the list of values that were appended to the "recap_weekdays" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AppendedRecapWeekdays() ([]time.Weekday, bool) {
+	if len(m.appendrecap_weekdays) == 0 {
+		return nil, false
+	}
+	return m.appendrecap_weekdays, true
+}
+
+// ClearRecapWeekdays clears the value of the "recap_weekdays" field.
+func (m *TelegramChatRecapsOptionsMutation) ClearRecapWeekdays() {
+	m.recap_weekdays = nil
+	m.appendrecap_weekdays = nil
+	m.clearedFields[telegramchatrecapsoptions.FieldRecapWeekdays] = struct{}{}
+}
+
+// RecapWeekdaysCleared returns if the "recap_weekdays" field was cleared in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapWeekdaysCleared() bool {
+	_, ok := m.clearedFields[telegramchatrecapsoptions.FieldRecapWeekdays]
+	return ok
+}
+
+// ResetRecapWeekdays resets all changes to the "recap_weekdays" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapWeekdays() {
+	m.recap_weekdays = nil
+	m.appendrecap_weekdays = nil
+	delete(m.clearedFields, telegramchatrecapsoptions.FieldRecapWeekdays)
+}
+
+// SetRelatedMessagesCount sets the "related_messages_count" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRelatedMessagesCount(i int) {
+	m.related_messages_count = &i
+	m.addrelated_messages_count = nil
+}
+
+// RelatedMessagesCount returns the value of the "related_messages_count" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RelatedMessagesCount() (r int, exists bool) {
+	v := m.related_messages_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRelatedMessagesCount returns the old "related_messages_count" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRelatedMessagesCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelatedMessagesCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelatedMessagesCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelatedMessagesCount: %w", err)
+	}
+	return oldValue.RelatedMessagesCount, nil
+}
+
+// AddRelatedMessagesCount adds i to the "related_messages_count" field.
+func (m *TelegramChatRecapsOptionsMutation) AddRelatedMessagesCount(i int) {
+	if m.addrelated_messages_count != nil {
+		*m.addrelated_messages_count += i
+	} else {
+		m.addrelated_messages_count = &i
+	}
+}
+
+// AddedRelatedMessagesCount returns the value that was added to the "related_messages_count" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedRelatedMessagesCount() (r int, exists bool) {
+	v := m.addrelated_messages_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRelatedMessagesCount resets all changes to the "related_messages_count" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRelatedMessagesCount() {
+	m.related_messages_count = nil
+	m.addrelated_messages_count = nil
+}
+
+// SetIncrementalRecap sets the "incremental_recap" field.
+func (m *TelegramChatRecapsOptionsMutation) SetIncrementalRecap(b bool) {
+	m.incremental_recap = &b
+}
+
+// IncrementalRecap returns the value of the "incremental_recap" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) IncrementalRecap() (r bool, exists bool) {
+	v := m.incremental_recap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncrementalRecap returns the old "incremental_recap" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldIncrementalRecap(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncrementalRecap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncrementalRecap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncrementalRecap: %w", err)
+	}
+	return oldValue.IncrementalRecap, nil
+}
+
+// ResetIncrementalRecap resets all changes to the "incremental_recap" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetIncrementalRecap() {
+	m.incremental_recap = nil
+}
+
+// SetRecapLinkPreview sets the "recap_link_preview" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapLinkPreview(b bool) {
+	m.recap_link_preview = &b
+}
+
+// RecapLinkPreview returns the value of the "recap_link_preview" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapLinkPreview() (r bool, exists bool) {
+	v := m.recap_link_preview
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapLinkPreview returns the old "recap_link_preview" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapLinkPreview(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapLinkPreview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapLinkPreview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapLinkPreview: %w", err)
+	}
+	return oldValue.RecapLinkPreview, nil
+}
+
+// ResetRecapLinkPreview resets all changes to the "recap_link_preview" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapLinkPreview() {
+	m.recap_link_preview = nil
+}
+
+// SetAutoUnpinAfterSeconds sets the "auto_unpin_after_seconds" field.
+func (m *TelegramChatRecapsOptionsMutation) SetAutoUnpinAfterSeconds(i int64) {
+	m.auto_unpin_after_seconds = &i
+	m.addauto_unpin_after_seconds = nil
+}
+
+// AutoUnpinAfterSeconds returns the value of the "auto_unpin_after_seconds" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) AutoUnpinAfterSeconds() (r int64, exists bool) {
+	v := m.auto_unpin_after_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoUnpinAfterSeconds returns the old "auto_unpin_after_seconds" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldAutoUnpinAfterSeconds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoUnpinAfterSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoUnpinAfterSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoUnpinAfterSeconds: %w", err)
+	}
+	return oldValue.AutoUnpinAfterSeconds, nil
+}
+
+// AddAutoUnpinAfterSeconds adds i to the "auto_unpin_after_seconds" field.
+func (m *TelegramChatRecapsOptionsMutation) AddAutoUnpinAfterSeconds(i int64) {
+	if m.addauto_unpin_after_seconds != nil {
+		*m.addauto_unpin_after_seconds += i
+	} else {
+		m.addauto_unpin_after_seconds = &i
+	}
+}
+
+// AddedAutoUnpinAfterSeconds returns the value that was added to the "auto_unpin_after_seconds" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedAutoUnpinAfterSeconds() (r int64, exists bool) {
+	v := m.addauto_unpin_after_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAutoUnpinAfterSeconds resets all changes to the "auto_unpin_after_seconds" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetAutoUnpinAfterSeconds() {
+	m.auto_unpin_after_seconds = nil
+	m.addauto_unpin_after_seconds = nil
+}
+
+// SetRecapThreadID sets the "recap_thread_id" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapThreadID(i int) {
+	m.recap_thread_id = &i
+	m.addrecap_thread_id = nil
+}
+
+// RecapThreadID returns the value of the "recap_thread_id" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapThreadID() (r int, exists bool) {
+	v := m.recap_thread_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapThreadID returns the old "recap_thread_id" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapThreadID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapThreadID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapThreadID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapThreadID: %w", err)
+	}
+	return oldValue.RecapThreadID, nil
+}
+
+// AddRecapThreadID adds i to the "recap_thread_id" field.
+func (m *TelegramChatRecapsOptionsMutation) AddRecapThreadID(i int) {
+	if m.addrecap_thread_id != nil {
+		*m.addrecap_thread_id += i
+	} else {
+		m.addrecap_thread_id = &i
+	}
+}
+
+// AddedRecapThreadID returns the value that was added to the "recap_thread_id" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedRecapThreadID() (r int, exists bool) {
+	v := m.addrecap_thread_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRecapThreadID resets all changes to the "recap_thread_id" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapThreadID() {
+	m.recap_thread_id = nil
+	m.addrecap_thread_id = nil
+}
+
+// SetManualRecapRateLimitExemptAdmins sets the "manual_recap_rate_limit_exempt_admins" field.
+func (m *TelegramChatRecapsOptionsMutation) SetManualRecapRateLimitExemptAdmins(b bool) {
+	m.manual_recap_rate_limit_exempt_admins = &b
+}
+
+// ManualRecapRateLimitExemptAdmins returns the value of the "manual_recap_rate_limit_exempt_admins" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) ManualRecapRateLimitExemptAdmins() (r bool, exists bool) {
+	v := m.manual_recap_rate_limit_exempt_admins
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManualRecapRateLimitExemptAdmins returns the old "manual_recap_rate_limit_exempt_admins" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldManualRecapRateLimitExemptAdmins(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManualRecapRateLimitExemptAdmins is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManualRecapRateLimitExemptAdmins requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManualRecapRateLimitExemptAdmins: %w", err)
+	}
+	return oldValue.ManualRecapRateLimitExemptAdmins, nil
+}
+
+// ResetManualRecapRateLimitExemptAdmins resets all changes to the "manual_recap_rate_limit_exempt_admins" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetManualRecapRateLimitExemptAdmins() {
+	m.manual_recap_rate_limit_exempt_admins = nil
+}
+
+// SetManualRecapMinRole sets the "manual_recap_min_role" field.
+func (m *TelegramChatRecapsOptionsMutation) SetManualRecapMinRole(i int) {
+	m.manual_recap_min_role = &i
+	m.addmanual_recap_min_role = nil
+}
+
+// ManualRecapMinRole returns the value of the "manual_recap_min_role" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) ManualRecapMinRole() (r int, exists bool) {
+	v := m.manual_recap_min_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManualRecapMinRole returns the old "manual_recap_min_role" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldManualRecapMinRole(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManualRecapMinRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManualRecapMinRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManualRecapMinRole: %w", err)
+	}
+	return oldValue.ManualRecapMinRole, nil
+}
+
+// AddManualRecapMinRole adds i to the "manual_recap_min_role" field.
+func (m *TelegramChatRecapsOptionsMutation) AddManualRecapMinRole(i int) {
+	if m.addmanual_recap_min_role != nil {
+		*m.addmanual_recap_min_role += i
+	} else {
+		m.addmanual_recap_min_role = &i
+	}
+}
+
+// AddedManualRecapMinRole returns the value that was added to the "manual_recap_min_role" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedManualRecapMinRole() (r int, exists bool) {
+	v := m.addmanual_recap_min_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetManualRecapMinRole resets all changes to the "manual_recap_min_role" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetManualRecapMinRole() {
+	m.manual_recap_min_role = nil
+	m.addmanual_recap_min_role = nil
+}
+
+// SetApprovalRequired sets the "approval_required" field.
+func (m *TelegramChatRecapsOptionsMutation) SetApprovalRequired(b bool) {
+	m.approval_required = &b
+}
+
+// ApprovalRequired returns the value of the "approval_required" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) ApprovalRequired() (r bool, exists bool) {
+	v := m.approval_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovalRequired returns the old "approval_required" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldApprovalRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovalRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovalRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovalRequired: %w", err)
+	}
+	return oldValue.ApprovalRequired, nil
+}
+
+// ResetApprovalRequired resets all changes to the "approval_required" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetApprovalRequired() {
+	m.approval_required = nil
+}
+
+// SetCollectOnly sets the "collect_only" field.
+func (m *TelegramChatRecapsOptionsMutation) SetCollectOnly(b bool) {
+	m.collect_only = &b
+}
+
+// CollectOnly returns the value of the "collect_only" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) CollectOnly() (r bool, exists bool) {
+	v := m.collect_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectOnly returns the old "collect_only" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldCollectOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectOnly: %w", err)
+	}
+	return oldValue.CollectOnly, nil
+}
+
+// ResetCollectOnly resets all changes to the "collect_only" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetCollectOnly() {
+	m.collect_only = nil
+}
+
+// SetRecapDocumentAttachment sets the "recap_document_attachment" field.
+func (m *TelegramChatRecapsOptionsMutation) SetRecapDocumentAttachment(b bool) {
+	m.recap_document_attachment = &b
+}
+
+// RecapDocumentAttachment returns the value of the "recap_document_attachment" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) RecapDocumentAttachment() (r bool, exists bool) {
+	v := m.recap_document_attachment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecapDocumentAttachment returns the old "recap_document_attachment" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldRecapDocumentAttachment(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecapDocumentAttachment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecapDocumentAttachment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecapDocumentAttachment: %w", err)
+	}
+	return oldValue.RecapDocumentAttachment, nil
+}
+
+// ResetRecapDocumentAttachment resets all changes to the "recap_document_attachment" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetRecapDocumentAttachment() {
+	m.recap_document_attachment = nil
+}
+
+// SetSummaryMaxTokens sets the "summary_max_tokens" field.
+func (m *TelegramChatRecapsOptionsMutation) SetSummaryMaxTokens(i int) {
+	m.summary_max_tokens = &i
+	m.addsummary_max_tokens = nil
+}
+
+// SummaryMaxTokens returns the value of the "summary_max_tokens" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) SummaryMaxTokens() (r int, exists bool) {
+	v := m.summary_max_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryMaxTokens returns the old "summary_max_tokens" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldSummaryMaxTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryMaxTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryMaxTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryMaxTokens: %w", err)
+	}
+	return oldValue.SummaryMaxTokens, nil
+}
+
+// AddSummaryMaxTokens adds i to the "summary_max_tokens" field.
+func (m *TelegramChatRecapsOptionsMutation) AddSummaryMaxTokens(i int) {
+	if m.addsummary_max_tokens != nil {
+		*m.addsummary_max_tokens += i
+	} else {
+		m.addsummary_max_tokens = &i
+	}
+}
+
+// AddedSummaryMaxTokens returns the value that was added to the "summary_max_tokens" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedSummaryMaxTokens() (r int, exists bool) {
+	v := m.addsummary_max_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSummaryMaxTokens resets all changes to the "summary_max_tokens" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetSummaryMaxTokens() {
+	m.summary_max_tokens = nil
+	m.addsummary_max_tokens = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TelegramChatRecapsOptionsMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *TelegramChatRecapsOptionsMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TelegramChatRecapsOptionsMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TelegramChatRecapsOptionsMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TelegramChatRecapsOptions entity.
+// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChatRecapsOptionsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *TelegramChatRecapsOptionsMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *TelegramChatRecapsOptionsMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TelegramChatRecapsOptionsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// Where appends a list predicates to the TelegramChatRecapsOptionsMutation builder.
+func (m *TelegramChatRecapsOptionsMutation) Where(ps ...predicate.TelegramChatRecapsOptions) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TelegramChatRecapsOptionsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TelegramChatRecapsOptionsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramChatRecapsOptions, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TelegramChatRecapsOptionsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TelegramChatRecapsOptionsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TelegramChatRecapsOptions).
+func (m *TelegramChatRecapsOptionsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TelegramChatRecapsOptionsMutation) Fields() []string {
+	fields := make([]string, 0, 42)
+	if m.chat_id != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldChatID)
+	}
+	if m.auto_recap_send_mode != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapSendMode)
+	}
+	if m.manual_recap_rate_per_seconds != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapRatePerSeconds)
+	}
+	if m.auto_recap_rates_per_day != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapRatesPerDay)
+	}
+	if m.pin_auto_recap_message != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldPinAutoRecapMessage)
+	}
+	if m.pin_auto_recap_message_silently != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently)
+	}
+	if m.recap_disclaimer != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapDisclaimer)
+	}
+	if m.recap_target_chat_id != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapTargetChatID)
+	}
+	if m.recap_persona != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapPersona)
+	}
+	if m.include_bot_messages != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldIncludeBotMessages)
+	}
+	if m.auto_recaps_snoozed_until != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil)
+	}
+	if m.quiet_notice_enabled != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldQuietNoticeEnabled)
+	}
+	if m.last_quiet_notice_at != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldLastQuietNoticeAt)
+	}
+	if m.per_topic_messages != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldPerTopicMessages)
+	}
+	if m.summary_temperature != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSummaryTemperature)
+	}
+	if m.recap_output_format != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapOutputFormat)
+	}
+	if m.min_message_length_for_summary != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldMinMessageLengthForSummary)
+	}
+	if m.count_short_messages_for_activity != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldCountShortMessagesForActivity)
+	}
+	if m.summary_languages != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSummaryLanguages)
+	}
+	if m.subscribe_min_membership_days != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSubscribeMinMembershipDays)
+	}
+	if m.subscribe_min_member_status != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSubscribeMinMemberStatus)
+	}
+	if m.top_keywords_count != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldTopKeywordsCount)
+	}
+	if m.dedup_forwards != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldDedupForwards)
+	}
+	if m.store_message_content != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldStoreMessageContent)
+	}
+	if m.anonymize_participants != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAnonymizeParticipants)
+	}
+	if m.manual_recap_private != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapPrivate)
+	}
+	if m.recap_in_progress_template != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapInProgressTemplate)
+	}
+	if m.excluded_message_types != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldExcludedMessageTypes)
+	}
+	if m.recap_weekdays != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapWeekdays)
+	}
+	if m.related_messages_count != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRelatedMessagesCount)
+	}
+	if m.incremental_recap != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldIncrementalRecap)
+	}
+	if m.recap_link_preview != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapLinkPreview)
+	}
+	if m.auto_unpin_after_seconds != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds)
+	}
+	if m.recap_thread_id != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapThreadID)
+	}
+	if m.manual_recap_rate_limit_exempt_admins != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins)
+	}
+	if m.manual_recap_min_role != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapMinRole)
+	}
+	if m.approval_required != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldApprovalRequired)
+	}
+	if m.collect_only != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldCollectOnly)
+	}
+	if m.recap_document_attachment != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapDocumentAttachment)
+	}
+	if m.summary_max_tokens != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSummaryMaxTokens)
+	}
+	if m.created_at != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TelegramChatRecapsOptionsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchatrecapsoptions.FieldChatID:
 		return m.ChatID()
-	case telegramchatfeatureflags.FieldChatType:
-		return m.ChatType()
-	case telegramchatfeatureflags.FieldChatTitle:
-		return m.ChatTitle()
-	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
-		return m.FeatureChatHistoriesRecap()
-	case telegramchatfeatureflags.FieldFeatureLanguage:
-		return m.FeatureLanguage()
-	case telegramchatfeatureflags.FieldCreatedAt:
+	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
+		return m.AutoRecapSendMode()
+	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+		return m.ManualRecapRatePerSeconds()
+	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
+		return m.AutoRecapRatesPerDay()
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
+		return m.PinAutoRecapMessage()
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently:
+		return m.PinAutoRecapMessageSilently()
+	case telegramchatrecapsoptions.FieldRecapDisclaimer:
+		return m.RecapDisclaimer()
+	case telegramchatrecapsoptions.FieldRecapTargetChatID:
+		return m.RecapTargetChatID()
+	case telegramchatrecapsoptions.FieldRecapPersona:
+		return m.RecapPersona()
+	case telegramchatrecapsoptions.FieldIncludeBotMessages:
+		return m.IncludeBotMessages()
+	case telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil:
+		return m.AutoRecapsSnoozedUntil()
+	case telegramchatrecapsoptions.FieldQuietNoticeEnabled:
+		return m.QuietNoticeEnabled()
+	case telegramchatrecapsoptions.FieldLastQuietNoticeAt:
+		return m.LastQuietNoticeAt()
+	case telegramchatrecapsoptions.FieldPerTopicMessages:
+		return m.PerTopicMessages()
+	case telegramchatrecapsoptions.FieldSummaryTemperature:
+		return m.SummaryTemperature()
+	case telegramchatrecapsoptions.FieldRecapOutputFormat:
+		return m.RecapOutputFormat()
+	case telegramchatrecapsoptions.FieldMinMessageLengthForSummary:
+		return m.MinMessageLengthForSummary()
+	case telegramchatrecapsoptions.FieldCountShortMessagesForActivity:
+		return m.CountShortMessagesForActivity()
+	case telegramchatrecapsoptions.FieldSummaryLanguages:
+		return m.SummaryLanguages()
+	case telegramchatrecapsoptions.FieldSubscribeMinMembershipDays:
+		return m.SubscribeMinMembershipDays()
+	case telegramchatrecapsoptions.FieldSubscribeMinMemberStatus:
+		return m.SubscribeMinMemberStatus()
+	case telegramchatrecapsoptions.FieldTopKeywordsCount:
+		return m.TopKeywordsCount()
+	case telegramchatrecapsoptions.FieldDedupForwards:
+		return m.DedupForwards()
+	case telegramchatrecapsoptions.FieldStoreMessageContent:
+		return m.StoreMessageContent()
+	case telegramchatrecapsoptions.FieldAnonymizeParticipants:
+		return m.AnonymizeParticipants()
+	case telegramchatrecapsoptions.FieldManualRecapPrivate:
+		return m.ManualRecapPrivate()
+	case telegramchatrecapsoptions.FieldRecapInProgressTemplate:
+		return m.RecapInProgressTemplate()
+	case telegramchatrecapsoptions.FieldExcludedMessageTypes:
+		return m.ExcludedMessageTypes()
+	case telegramchatrecapsoptions.FieldRecapWeekdays:
+		return m.RecapWeekdays()
+	case telegramchatrecapsoptions.FieldRelatedMessagesCount:
+		return m.RelatedMessagesCount()
+	case telegramchatrecapsoptions.FieldIncrementalRecap:
+		return m.IncrementalRecap()
+	case telegramchatrecapsoptions.FieldRecapLinkPreview:
+		return m.RecapLinkPreview()
+	case telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds:
+		return m.AutoUnpinAfterSeconds()
+	case telegramchatrecapsoptions.FieldRecapThreadID:
+		return m.RecapThreadID()
+	case telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins:
+		return m.ManualRecapRateLimitExemptAdmins()
+	case telegramchatrecapsoptions.FieldManualRecapMinRole:
+		return m.ManualRecapMinRole()
+	case telegramchatrecapsoptions.FieldApprovalRequired:
+		return m.ApprovalRequired()
+	case telegramchatrecapsoptions.FieldCollectOnly:
+		return m.CollectOnly()
+	case telegramchatrecapsoptions.FieldRecapDocumentAttachment:
+		return m.RecapDocumentAttachment()
+	case telegramchatrecapsoptions.FieldSummaryMaxTokens:
+		return m.SummaryMaxTokens()
+	case telegramchatrecapsoptions.FieldCreatedAt:
 		return m.CreatedAt()
-	case telegramchatfeatureflags.FieldUpdatedAt:
+	case telegramchatrecapsoptions.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -8982,74 +13952,389 @@ func (m *TelegramChatFeatureFlagsMutation) Field(name string) (ent.Value, bool)
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramChatFeatureFlagsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TelegramChatRecapsOptionsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramchatfeatureflags.FieldChatID:
+	case telegramchatrecapsoptions.FieldChatID:
 		return m.OldChatID(ctx)
-	case telegramchatfeatureflags.FieldChatType:
-		return m.OldChatType(ctx)
-	case telegramchatfeatureflags.FieldChatTitle:
-		return m.OldChatTitle(ctx)
-	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
-		return m.OldFeatureChatHistoriesRecap(ctx)
-	case telegramchatfeatureflags.FieldFeatureLanguage:
-		return m.OldFeatureLanguage(ctx)
-	case telegramchatfeatureflags.FieldCreatedAt:
+	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
+		return m.OldAutoRecapSendMode(ctx)
+	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+		return m.OldManualRecapRatePerSeconds(ctx)
+	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
+		return m.OldAutoRecapRatesPerDay(ctx)
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
+		return m.OldPinAutoRecapMessage(ctx)
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently:
+		return m.OldPinAutoRecapMessageSilently(ctx)
+	case telegramchatrecapsoptions.FieldRecapDisclaimer:
+		return m.OldRecapDisclaimer(ctx)
+	case telegramchatrecapsoptions.FieldRecapTargetChatID:
+		return m.OldRecapTargetChatID(ctx)
+	case telegramchatrecapsoptions.FieldRecapPersona:
+		return m.OldRecapPersona(ctx)
+	case telegramchatrecapsoptions.FieldIncludeBotMessages:
+		return m.OldIncludeBotMessages(ctx)
+	case telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil:
+		return m.OldAutoRecapsSnoozedUntil(ctx)
+	case telegramchatrecapsoptions.FieldQuietNoticeEnabled:
+		return m.OldQuietNoticeEnabled(ctx)
+	case telegramchatrecapsoptions.FieldLastQuietNoticeAt:
+		return m.OldLastQuietNoticeAt(ctx)
+	case telegramchatrecapsoptions.FieldPerTopicMessages:
+		return m.OldPerTopicMessages(ctx)
+	case telegramchatrecapsoptions.FieldSummaryTemperature:
+		return m.OldSummaryTemperature(ctx)
+	case telegramchatrecapsoptions.FieldRecapOutputFormat:
+		return m.OldRecapOutputFormat(ctx)
+	case telegramchatrecapsoptions.FieldMinMessageLengthForSummary:
+		return m.OldMinMessageLengthForSummary(ctx)
+	case telegramchatrecapsoptions.FieldCountShortMessagesForActivity:
+		return m.OldCountShortMessagesForActivity(ctx)
+	case telegramchatrecapsoptions.FieldSummaryLanguages:
+		return m.OldSummaryLanguages(ctx)
+	case telegramchatrecapsoptions.FieldSubscribeMinMembershipDays:
+		return m.OldSubscribeMinMembershipDays(ctx)
+	case telegramchatrecapsoptions.FieldSubscribeMinMemberStatus:
+		return m.OldSubscribeMinMemberStatus(ctx)
+	case telegramchatrecapsoptions.FieldTopKeywordsCount:
+		return m.OldTopKeywordsCount(ctx)
+	case telegramchatrecapsoptions.FieldDedupForwards:
+		return m.OldDedupForwards(ctx)
+	case telegramchatrecapsoptions.FieldStoreMessageContent:
+		return m.OldStoreMessageContent(ctx)
+	case telegramchatrecapsoptions.FieldAnonymizeParticipants:
+		return m.OldAnonymizeParticipants(ctx)
+	case telegramchatrecapsoptions.FieldManualRecapPrivate:
+		return m.OldManualRecapPrivate(ctx)
+	case telegramchatrecapsoptions.FieldRecapInProgressTemplate:
+		return m.OldRecapInProgressTemplate(ctx)
+	case telegramchatrecapsoptions.FieldExcludedMessageTypes:
+		return m.OldExcludedMessageTypes(ctx)
+	case telegramchatrecapsoptions.FieldRecapWeekdays:
+		return m.OldRecapWeekdays(ctx)
+	case telegramchatrecapsoptions.FieldRelatedMessagesCount:
+		return m.OldRelatedMessagesCount(ctx)
+	case telegramchatrecapsoptions.FieldIncrementalRecap:
+		return m.OldIncrementalRecap(ctx)
+	case telegramchatrecapsoptions.FieldRecapLinkPreview:
+		return m.OldRecapLinkPreview(ctx)
+	case telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds:
+		return m.OldAutoUnpinAfterSeconds(ctx)
+	case telegramchatrecapsoptions.FieldRecapThreadID:
+		return m.OldRecapThreadID(ctx)
+	case telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins:
+		return m.OldManualRecapRateLimitExemptAdmins(ctx)
+	case telegramchatrecapsoptions.FieldManualRecapMinRole:
+		return m.OldManualRecapMinRole(ctx)
+	case telegramchatrecapsoptions.FieldApprovalRequired:
+		return m.OldApprovalRequired(ctx)
+	case telegramchatrecapsoptions.FieldCollectOnly:
+		return m.OldCollectOnly(ctx)
+	case telegramchatrecapsoptions.FieldRecapDocumentAttachment:
+		return m.OldRecapDocumentAttachment(ctx)
+	case telegramchatrecapsoptions.FieldSummaryMaxTokens:
+		return m.OldSummaryMaxTokens(ctx)
+	case telegramchatrecapsoptions.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case telegramchatfeatureflags.FieldUpdatedAt:
+	case telegramchatrecapsoptions.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramChatFeatureFlags field %s", name)
+	return nil, fmt.Errorf("unknown TelegramChatRecapsOptions field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChatFeatureFlagsMutation) SetField(name string, value ent.Value) error {
+func (m *TelegramChatRecapsOptionsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramchatfeatureflags.FieldChatID:
+	case telegramchatrecapsoptions.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoRecapSendMode(v)
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManualRecapRatePerSeconds(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoRecapRatesPerDay(v)
+		return nil
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinAutoRecapMessage(v)
+		return nil
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinAutoRecapMessageSilently(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapDisclaimer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapDisclaimer(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapTargetChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapTargetChatID(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapPersona:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapPersona(v)
+		return nil
+	case telegramchatrecapsoptions.FieldIncludeBotMessages:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncludeBotMessages(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoRecapsSnoozedUntil(v)
+		return nil
+	case telegramchatrecapsoptions.FieldQuietNoticeEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietNoticeEnabled(v)
+		return nil
+	case telegramchatrecapsoptions.FieldLastQuietNoticeAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastQuietNoticeAt(v)
+		return nil
+	case telegramchatrecapsoptions.FieldPerTopicMessages:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPerTopicMessages(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryTemperature:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryTemperature(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapOutputFormat:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapOutputFormat(v)
+		return nil
+	case telegramchatrecapsoptions.FieldMinMessageLengthForSummary:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinMessageLengthForSummary(v)
+		return nil
+	case telegramchatrecapsoptions.FieldCountShortMessagesForActivity:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountShortMessagesForActivity(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryLanguages:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryLanguages(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSubscribeMinMembershipDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscribeMinMembershipDays(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSubscribeMinMemberStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscribeMinMemberStatus(v)
+		return nil
+	case telegramchatrecapsoptions.FieldTopKeywordsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopKeywordsCount(v)
+		return nil
+	case telegramchatrecapsoptions.FieldDedupForwards:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDedupForwards(v)
+		return nil
+	case telegramchatrecapsoptions.FieldStoreMessageContent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoreMessageContent(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAnonymizeParticipants:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnonymizeParticipants(v)
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapPrivate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManualRecapPrivate(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapInProgressTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapInProgressTemplate(v)
+		return nil
+	case telegramchatrecapsoptions.FieldExcludedMessageTypes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcludedMessageTypes(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapWeekdays:
+		v, ok := value.([]time.Weekday)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapWeekdays(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRelatedMessagesCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelatedMessagesCount(v)
+		return nil
+	case telegramchatrecapsoptions.FieldIncrementalRecap:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncrementalRecap(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapLinkPreview:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapLinkPreview(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatID(v)
+		m.SetAutoUnpinAfterSeconds(v)
 		return nil
-	case telegramchatfeatureflags.FieldChatType:
-		v, ok := value.(string)
+	case telegramchatrecapsoptions.FieldRecapThreadID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatType(v)
+		m.SetRecapThreadID(v)
 		return nil
-	case telegramchatfeatureflags.FieldChatTitle:
-		v, ok := value.(string)
+	case telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatTitle(v)
+		m.SetManualRecapRateLimitExemptAdmins(v)
 		return nil
-	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
+	case telegramchatrecapsoptions.FieldManualRecapMinRole:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManualRecapMinRole(v)
+		return nil
+	case telegramchatrecapsoptions.FieldApprovalRequired:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFeatureChatHistoriesRecap(v)
+		m.SetApprovalRequired(v)
 		return nil
-	case telegramchatfeatureflags.FieldFeatureLanguage:
-		v, ok := value.(string)
+	case telegramchatrecapsoptions.FieldCollectOnly:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFeatureLanguage(v)
+		m.SetCollectOnly(v)
 		return nil
-	case telegramchatfeatureflags.FieldCreatedAt:
+	case telegramchatrecapsoptions.FieldRecapDocumentAttachment:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecapDocumentAttachment(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryMaxTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryMaxTokens(v)
+		return nil
+	case telegramchatrecapsoptions.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case telegramchatfeatureflags.FieldUpdatedAt:
+	case telegramchatrecapsoptions.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9057,21 +14342,75 @@ func (m *TelegramChatFeatureFlagsMutation) SetField(name string, value ent.Value
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatFeatureFlags field %s", name)
+	return fmt.Errorf("unknown TelegramChatRecapsOptions field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramChatFeatureFlagsMutation) AddedFields() []string {
+func (m *TelegramChatRecapsOptionsMutation) AddedFields() []string {
 	var fields []string
 	if m.addchat_id != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldChatID)
+		fields = append(fields, telegramchatrecapsoptions.FieldChatID)
+	}
+	if m.addauto_recap_send_mode != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapSendMode)
+	}
+	if m.addmanual_recap_rate_per_seconds != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapRatePerSeconds)
+	}
+	if m.addauto_recap_rates_per_day != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapRatesPerDay)
+	}
+	if m.addrecap_target_chat_id != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapTargetChatID)
+	}
+	if m.addauto_recaps_snoozed_until != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil)
+	}
+	if m.addlast_quiet_notice_at != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldLastQuietNoticeAt)
+	}
+	if m.addsummary_temperature != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSummaryTemperature)
+	}
+	if m.addrecap_output_format != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapOutputFormat)
+	}
+	if m.addmin_message_length_for_summary != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldMinMessageLengthForSummary)
+	}
+	if m.addsubscribe_min_membership_days != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSubscribeMinMembershipDays)
+	}
+	if m.addsubscribe_min_member_status != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSubscribeMinMemberStatus)
+	}
+	if m.addtop_keywords_count != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldTopKeywordsCount)
+	}
+	if m.addexcluded_message_types != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldExcludedMessageTypes)
+	}
+	if m.addrelated_messages_count != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRelatedMessagesCount)
+	}
+	if m.addauto_unpin_after_seconds != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds)
+	}
+	if m.addrecap_thread_id != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapThreadID)
+	}
+	if m.addmanual_recap_min_role != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapMinRole)
+	}
+	if m.addsummary_max_tokens != nil {
+		fields = append(fields, telegramchatrecapsoptions.FieldSummaryMaxTokens)
 	}
 	if m.addcreated_at != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldCreatedAt)
+		fields = append(fields, telegramchatrecapsoptions.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, telegramchatfeatureflags.FieldUpdatedAt)
+		fields = append(fields, telegramchatrecapsoptions.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9079,13 +14418,49 @@ func (m *TelegramChatFeatureFlagsMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramChatFeatureFlagsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TelegramChatRecapsOptionsMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchatfeatureflags.FieldChatID:
+	case telegramchatrecapsoptions.FieldChatID:
 		return m.AddedChatID()
-	case telegramchatfeatureflags.FieldCreatedAt:
+	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
+		return m.AddedAutoRecapSendMode()
+	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+		return m.AddedManualRecapRatePerSeconds()
+	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
+		return m.AddedAutoRecapRatesPerDay()
+	case telegramchatrecapsoptions.FieldRecapTargetChatID:
+		return m.AddedRecapTargetChatID()
+	case telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil:
+		return m.AddedAutoRecapsSnoozedUntil()
+	case telegramchatrecapsoptions.FieldLastQuietNoticeAt:
+		return m.AddedLastQuietNoticeAt()
+	case telegramchatrecapsoptions.FieldSummaryTemperature:
+		return m.AddedSummaryTemperature()
+	case telegramchatrecapsoptions.FieldRecapOutputFormat:
+		return m.AddedRecapOutputFormat()
+	case telegramchatrecapsoptions.FieldMinMessageLengthForSummary:
+		return m.AddedMinMessageLengthForSummary()
+	case telegramchatrecapsoptions.FieldSubscribeMinMembershipDays:
+		return m.AddedSubscribeMinMembershipDays()
+	case telegramchatrecapsoptions.FieldSubscribeMinMemberStatus:
+		return m.AddedSubscribeMinMemberStatus()
+	case telegramchatrecapsoptions.FieldTopKeywordsCount:
+		return m.AddedTopKeywordsCount()
+	case telegramchatrecapsoptions.FieldExcludedMessageTypes:
+		return m.AddedExcludedMessageTypes()
+	case telegramchatrecapsoptions.FieldRelatedMessagesCount:
+		return m.AddedRelatedMessagesCount()
+	case telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds:
+		return m.AddedAutoUnpinAfterSeconds()
+	case telegramchatrecapsoptions.FieldRecapThreadID:
+		return m.AddedRecapThreadID()
+	case telegramchatrecapsoptions.FieldManualRecapMinRole:
+		return m.AddedManualRecapMinRole()
+	case telegramchatrecapsoptions.FieldSummaryMaxTokens:
+		return m.AddedSummaryMaxTokens()
+	case telegramchatrecapsoptions.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case telegramchatfeatureflags.FieldUpdatedAt:
+	case telegramchatrecapsoptions.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
 	}
 	return nil, false
@@ -9094,23 +14469,149 @@ func (m *TelegramChatFeatureFlagsMutation) AddedField(name string) (ent.Value, b
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChatFeatureFlagsMutation) AddField(name string, value ent.Value) error {
+func (m *TelegramChatRecapsOptionsMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case telegramchatfeatureflags.FieldChatID:
+	case telegramchatrecapsoptions.FieldChatID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddChatID(v)
 		return nil
-	case telegramchatfeatureflags.FieldCreatedAt:
+	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAutoRecapSendMode(v)
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddManualRecapRatePerSeconds(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAutoRecapRatesPerDay(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapTargetChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecapTargetChatID(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAutoRecapsSnoozedUntil(v)
+		return nil
+	case telegramchatrecapsoptions.FieldLastQuietNoticeAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastQuietNoticeAt(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryTemperature:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSummaryTemperature(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapOutputFormat:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecapOutputFormat(v)
+		return nil
+	case telegramchatrecapsoptions.FieldMinMessageLengthForSummary:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinMessageLengthForSummary(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSubscribeMinMembershipDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubscribeMinMembershipDays(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSubscribeMinMemberStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubscribeMinMemberStatus(v)
+		return nil
+	case telegramchatrecapsoptions.FieldTopKeywordsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTopKeywordsCount(v)
+		return nil
+	case telegramchatrecapsoptions.FieldExcludedMessageTypes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExcludedMessageTypes(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRelatedMessagesCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRelatedMessagesCount(v)
+		return nil
+	case telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAutoUnpinAfterSeconds(v)
+		return nil
+	case telegramchatrecapsoptions.FieldRecapThreadID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecapThreadID(v)
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapMinRole:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddManualRecapMinRole(v)
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryMaxTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSummaryMaxTokens(v)
+		return nil
+	case telegramchatrecapsoptions.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case telegramchatfeatureflags.FieldUpdatedAt:
+	case telegramchatrecapsoptions.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9118,141 +14619,249 @@ func (m *TelegramChatFeatureFlagsMutation) AddField(name string, value ent.Value
 		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatFeatureFlags numeric field %s", name)
+	return fmt.Errorf("unknown TelegramChatRecapsOptions numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramChatFeatureFlagsMutation) ClearedFields() []string {
-	return nil
+func (m *TelegramChatRecapsOptionsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(telegramchatrecapsoptions.FieldRecapWeekdays) {
+		fields = append(fields, telegramchatrecapsoptions.FieldRecapWeekdays)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) FieldCleared(name string) bool {
+func (m *TelegramChatRecapsOptionsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramChatFeatureFlagsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TelegramChatFeatureFlags nullable field %s", name)
+func (m *TelegramChatRecapsOptionsMutation) ClearField(name string) error {
+	switch name {
+	case telegramchatrecapsoptions.FieldRecapWeekdays:
+		m.ClearRecapWeekdays()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChatRecapsOptions nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramChatFeatureFlagsMutation) ResetField(name string) error {
+func (m *TelegramChatRecapsOptionsMutation) ResetField(name string) error {
 	switch name {
-	case telegramchatfeatureflags.FieldChatID:
+	case telegramchatrecapsoptions.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case telegramchatfeatureflags.FieldChatType:
-		m.ResetChatType()
+	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
+		m.ResetAutoRecapSendMode()
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+		m.ResetManualRecapRatePerSeconds()
+		return nil
+	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
+		m.ResetAutoRecapRatesPerDay()
+		return nil
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
+		m.ResetPinAutoRecapMessage()
+		return nil
+	case telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently:
+		m.ResetPinAutoRecapMessageSilently()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapDisclaimer:
+		m.ResetRecapDisclaimer()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapTargetChatID:
+		m.ResetRecapTargetChatID()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapPersona:
+		m.ResetRecapPersona()
+		return nil
+	case telegramchatrecapsoptions.FieldIncludeBotMessages:
+		m.ResetIncludeBotMessages()
+		return nil
+	case telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil:
+		m.ResetAutoRecapsSnoozedUntil()
+		return nil
+	case telegramchatrecapsoptions.FieldQuietNoticeEnabled:
+		m.ResetQuietNoticeEnabled()
+		return nil
+	case telegramchatrecapsoptions.FieldLastQuietNoticeAt:
+		m.ResetLastQuietNoticeAt()
+		return nil
+	case telegramchatrecapsoptions.FieldPerTopicMessages:
+		m.ResetPerTopicMessages()
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryTemperature:
+		m.ResetSummaryTemperature()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapOutputFormat:
+		m.ResetRecapOutputFormat()
+		return nil
+	case telegramchatrecapsoptions.FieldMinMessageLengthForSummary:
+		m.ResetMinMessageLengthForSummary()
+		return nil
+	case telegramchatrecapsoptions.FieldCountShortMessagesForActivity:
+		m.ResetCountShortMessagesForActivity()
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryLanguages:
+		m.ResetSummaryLanguages()
 		return nil
-	case telegramchatfeatureflags.FieldChatTitle:
-		m.ResetChatTitle()
+	case telegramchatrecapsoptions.FieldSubscribeMinMembershipDays:
+		m.ResetSubscribeMinMembershipDays()
 		return nil
-	case telegramchatfeatureflags.FieldFeatureChatHistoriesRecap:
-		m.ResetFeatureChatHistoriesRecap()
+	case telegramchatrecapsoptions.FieldSubscribeMinMemberStatus:
+		m.ResetSubscribeMinMemberStatus()
 		return nil
-	case telegramchatfeatureflags.FieldFeatureLanguage:
-		m.ResetFeatureLanguage()
+	case telegramchatrecapsoptions.FieldTopKeywordsCount:
+		m.ResetTopKeywordsCount()
 		return nil
-	case telegramchatfeatureflags.FieldCreatedAt:
+	case telegramchatrecapsoptions.FieldDedupForwards:
+		m.ResetDedupForwards()
+		return nil
+	case telegramchatrecapsoptions.FieldStoreMessageContent:
+		m.ResetStoreMessageContent()
+		return nil
+	case telegramchatrecapsoptions.FieldAnonymizeParticipants:
+		m.ResetAnonymizeParticipants()
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapPrivate:
+		m.ResetManualRecapPrivate()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapInProgressTemplate:
+		m.ResetRecapInProgressTemplate()
+		return nil
+	case telegramchatrecapsoptions.FieldExcludedMessageTypes:
+		m.ResetExcludedMessageTypes()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapWeekdays:
+		m.ResetRecapWeekdays()
+		return nil
+	case telegramchatrecapsoptions.FieldRelatedMessagesCount:
+		m.ResetRelatedMessagesCount()
+		return nil
+	case telegramchatrecapsoptions.FieldIncrementalRecap:
+		m.ResetIncrementalRecap()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapLinkPreview:
+		m.ResetRecapLinkPreview()
+		return nil
+	case telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds:
+		m.ResetAutoUnpinAfterSeconds()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapThreadID:
+		m.ResetRecapThreadID()
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins:
+		m.ResetManualRecapRateLimitExemptAdmins()
+		return nil
+	case telegramchatrecapsoptions.FieldManualRecapMinRole:
+		m.ResetManualRecapMinRole()
+		return nil
+	case telegramchatrecapsoptions.FieldApprovalRequired:
+		m.ResetApprovalRequired()
+		return nil
+	case telegramchatrecapsoptions.FieldCollectOnly:
+		m.ResetCollectOnly()
+		return nil
+	case telegramchatrecapsoptions.FieldRecapDocumentAttachment:
+		m.ResetRecapDocumentAttachment()
+		return nil
+	case telegramchatrecapsoptions.FieldSummaryMaxTokens:
+		m.ResetSummaryMaxTokens()
+		return nil
+	case telegramchatrecapsoptions.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case telegramchatfeatureflags.FieldUpdatedAt:
+	case telegramchatrecapsoptions.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatFeatureFlags field %s", name)
+	return fmt.Errorf("unknown TelegramChatRecapsOptions field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) AddedEdges() []string {
+func (m *TelegramChatRecapsOptionsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) AddedIDs(name string) []ent.Value {
+func (m *TelegramChatRecapsOptionsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) RemovedEdges() []string {
+func (m *TelegramChatRecapsOptionsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) RemovedIDs(name string) []ent.Value {
+func (m *TelegramChatRecapsOptionsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) ClearedEdges() []string {
+func (m *TelegramChatRecapsOptionsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramChatFeatureFlagsMutation) EdgeCleared(name string) bool {
+func (m *TelegramChatRecapsOptionsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramChatFeatureFlagsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChatFeatureFlags unique edge %s", name)
+func (m *TelegramChatRecapsOptionsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChatRecapsOptions unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramChatFeatureFlagsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChatFeatureFlags edge %s", name)
+func (m *TelegramChatRecapsOptionsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChatRecapsOptions edge %s", name)
 }
 
-// TelegramChatRecapsOptionsMutation represents an operation that mutates the TelegramChatRecapsOptions nodes in the graph.
-type TelegramChatRecapsOptionsMutation struct {
+// TelegramUserPreferencesMutation represents an operation that mutates the TelegramUserPreferences nodes in the graph.
+type TelegramUserPreferencesMutation struct {
 	config
-	op                               Op
-	typ                              string
-	id                               *uuid.UUID
-	chat_id                          *int64
-	addchat_id                       *int64
-	auto_recap_send_mode             *int
-	addauto_recap_send_mode          *int
-	manual_recap_rate_per_seconds    *int64
-	addmanual_recap_rate_per_seconds *int64
-	auto_recap_rates_per_day         *int
-	addauto_recap_rates_per_day      *int
-	pin_auto_recap_message           *bool
-	created_at                       *int64
-	addcreated_at                    *int64
-	updated_at                       *int64
-	addupdated_at                    *int64
-	clearedFields                    map[string]struct{}
-	done                             bool
-	oldValue                         func(context.Context) (*TelegramChatRecapsOptions, error)
-	predicates                       []predicate.TelegramChatRecapsOptions
-}
-
-var _ ent.Mutation = (*TelegramChatRecapsOptionsMutation)(nil)
-
-// telegramchatrecapsoptionsOption allows management of the mutation configuration using functional options.
-type telegramchatrecapsoptionsOption func(*TelegramChatRecapsOptionsMutation)
-
-// newTelegramChatRecapsOptionsMutation creates new mutation for the TelegramChatRecapsOptions entity.
-func newTelegramChatRecapsOptionsMutation(c config, op Op, opts ...telegramchatrecapsoptionsOption) *TelegramChatRecapsOptionsMutation {
-	m := &TelegramChatRecapsOptionsMutation{
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	user_id              *int64
+	adduser_id           *int64
+	batch_private_recaps *bool
+	created_at           *int64
+	addcreated_at        *int64
+	updated_at           *int64
+	addupdated_at        *int64
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*TelegramUserPreferences, error)
+	predicates           []predicate.TelegramUserPreferences
+}
+
+var _ ent.Mutation = (*TelegramUserPreferencesMutation)(nil)
+
+// telegramuserpreferencesOption allows management of the mutation configuration using functional options.
+type telegramuserpreferencesOption func(*TelegramUserPreferencesMutation)
+
+// newTelegramUserPreferencesMutation creates new mutation for the TelegramUserPreferences entity.
+func newTelegramUserPreferencesMutation(c config, op Op, opts ...telegramuserpreferencesOption) *TelegramUserPreferencesMutation {
+	m := &TelegramUserPreferencesMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTelegramChatRecapsOptions,
+		typ:           TypeTelegramUserPreferences,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9261,20 +14870,20 @@ func newTelegramChatRecapsOptionsMutation(c config, op Op, opts ...telegramchatr
 	return m
 }
 
-// withTelegramChatRecapsOptionsID sets the ID field of the mutation.
-func withTelegramChatRecapsOptionsID(id uuid.UUID) telegramchatrecapsoptionsOption {
-	return func(m *TelegramChatRecapsOptionsMutation) {
+// withTelegramUserPreferencesID sets the ID field of the mutation.
+func withTelegramUserPreferencesID(id uuid.UUID) telegramuserpreferencesOption {
+	return func(m *TelegramUserPreferencesMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramChatRecapsOptions
+			value *TelegramUserPreferences
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramChatRecapsOptions, error) {
+		m.oldValue = func(ctx context.Context) (*TelegramUserPreferences, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramChatRecapsOptions.Get(ctx, id)
+					value, err = m.Client().TelegramUserPreferences.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9283,10 +14892,10 @@ func withTelegramChatRecapsOptionsID(id uuid.UUID) telegramchatrecapsoptionsOpti
 	}
 }
 
-// withTelegramChatRecapsOptions sets the old TelegramChatRecapsOptions of the mutation.
-func withTelegramChatRecapsOptions(node *TelegramChatRecapsOptions) telegramchatrecapsoptionsOption {
-	return func(m *TelegramChatRecapsOptionsMutation) {
-		m.oldValue = func(context.Context) (*TelegramChatRecapsOptions, error) {
+// withTelegramUserPreferences sets the old TelegramUserPreferences of the mutation.
+func withTelegramUserPreferences(node *TelegramUserPreferences) telegramuserpreferencesOption {
+	return func(m *TelegramUserPreferencesMutation) {
+		m.oldValue = func(context.Context) (*TelegramUserPreferences, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9295,7 +14904,7 @@ func withTelegramChatRecapsOptions(node *TelegramChatRecapsOptions) telegramchat
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramChatRecapsOptionsMutation) Client() *Client {
+func (m TelegramUserPreferencesMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9303,7 +14912,7 @@ func (m TelegramChatRecapsOptionsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramChatRecapsOptionsMutation) Tx() (*Tx, error) {
+func (m TelegramUserPreferencesMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9313,14 +14922,14 @@ func (m TelegramChatRecapsOptionsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TelegramChatRecapsOptions entities.
-func (m *TelegramChatRecapsOptionsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TelegramUserPreferences entities.
+func (m *TelegramUserPreferencesMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramChatRecapsOptionsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TelegramUserPreferencesMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9331,289 +14940,121 @@ func (m *TelegramChatRecapsOptionsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramChatRecapsOptionsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TelegramUserPreferencesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
 			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramChatRecapsOptions.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetChatID sets the "chat_id" field.
-func (m *TelegramChatRecapsOptionsMutation) SetChatID(i int64) {
-	m.chat_id = &i
-	m.addchat_id = nil
-}
-
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *TelegramChatRecapsOptionsMutation) ChatID() (r int64, exists bool) {
-	v := m.chat_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChatID returns the old "chat_id" field's value of the TelegramChatRecapsOptions entity.
-// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatRecapsOptionsMutation) OldChatID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
-	}
-	return oldValue.ChatID, nil
-}
-
-// AddChatID adds i to the "chat_id" field.
-func (m *TelegramChatRecapsOptionsMutation) AddChatID(i int64) {
-	if m.addchat_id != nil {
-		*m.addchat_id += i
-	} else {
-		m.addchat_id = &i
-	}
-}
-
-// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedChatID() (r int64, exists bool) {
-	v := m.addchat_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *TelegramChatRecapsOptionsMutation) ResetChatID() {
-	m.chat_id = nil
-	m.addchat_id = nil
-}
-
-// SetAutoRecapSendMode sets the "auto_recap_send_mode" field.
-func (m *TelegramChatRecapsOptionsMutation) SetAutoRecapSendMode(i int) {
-	m.auto_recap_send_mode = &i
-	m.addauto_recap_send_mode = nil
-}
-
-// AutoRecapSendMode returns the value of the "auto_recap_send_mode" field in the mutation.
-func (m *TelegramChatRecapsOptionsMutation) AutoRecapSendMode() (r int, exists bool) {
-	v := m.auto_recap_send_mode
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAutoRecapSendMode returns the old "auto_recap_send_mode" field's value of the TelegramChatRecapsOptions entity.
-// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatRecapsOptionsMutation) OldAutoRecapSendMode(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAutoRecapSendMode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAutoRecapSendMode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAutoRecapSendMode: %w", err)
-	}
-	return oldValue.AutoRecapSendMode, nil
-}
-
-// AddAutoRecapSendMode adds i to the "auto_recap_send_mode" field.
-func (m *TelegramChatRecapsOptionsMutation) AddAutoRecapSendMode(i int) {
-	if m.addauto_recap_send_mode != nil {
-		*m.addauto_recap_send_mode += i
-	} else {
-		m.addauto_recap_send_mode = &i
-	}
-}
-
-// AddedAutoRecapSendMode returns the value that was added to the "auto_recap_send_mode" field in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedAutoRecapSendMode() (r int, exists bool) {
-	v := m.addauto_recap_send_mode
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetAutoRecapSendMode resets all changes to the "auto_recap_send_mode" field.
-func (m *TelegramChatRecapsOptionsMutation) ResetAutoRecapSendMode() {
-	m.auto_recap_send_mode = nil
-	m.addauto_recap_send_mode = nil
-}
-
-// SetManualRecapRatePerSeconds sets the "manual_recap_rate_per_seconds" field.
-func (m *TelegramChatRecapsOptionsMutation) SetManualRecapRatePerSeconds(i int64) {
-	m.manual_recap_rate_per_seconds = &i
-	m.addmanual_recap_rate_per_seconds = nil
-}
-
-// ManualRecapRatePerSeconds returns the value of the "manual_recap_rate_per_seconds" field in the mutation.
-func (m *TelegramChatRecapsOptionsMutation) ManualRecapRatePerSeconds() (r int64, exists bool) {
-	v := m.manual_recap_rate_per_seconds
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldManualRecapRatePerSeconds returns the old "manual_recap_rate_per_seconds" field's value of the TelegramChatRecapsOptions entity.
-// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatRecapsOptionsMutation) OldManualRecapRatePerSeconds(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldManualRecapRatePerSeconds is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldManualRecapRatePerSeconds requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldManualRecapRatePerSeconds: %w", err)
-	}
-	return oldValue.ManualRecapRatePerSeconds, nil
-}
-
-// AddManualRecapRatePerSeconds adds i to the "manual_recap_rate_per_seconds" field.
-func (m *TelegramChatRecapsOptionsMutation) AddManualRecapRatePerSeconds(i int64) {
-	if m.addmanual_recap_rate_per_seconds != nil {
-		*m.addmanual_recap_rate_per_seconds += i
-	} else {
-		m.addmanual_recap_rate_per_seconds = &i
-	}
-}
-
-// AddedManualRecapRatePerSeconds returns the value that was added to the "manual_recap_rate_per_seconds" field in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedManualRecapRatePerSeconds() (r int64, exists bool) {
-	v := m.addmanual_recap_rate_per_seconds
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetManualRecapRatePerSeconds resets all changes to the "manual_recap_rate_per_seconds" field.
-func (m *TelegramChatRecapsOptionsMutation) ResetManualRecapRatePerSeconds() {
-	m.manual_recap_rate_per_seconds = nil
-	m.addmanual_recap_rate_per_seconds = nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramUserPreferences.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetAutoRecapRatesPerDay sets the "auto_recap_rates_per_day" field.
-func (m *TelegramChatRecapsOptionsMutation) SetAutoRecapRatesPerDay(i int) {
-	m.auto_recap_rates_per_day = &i
-	m.addauto_recap_rates_per_day = nil
+// SetUserID sets the "user_id" field.
+func (m *TelegramUserPreferencesMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// AutoRecapRatesPerDay returns the value of the "auto_recap_rates_per_day" field in the mutation.
-func (m *TelegramChatRecapsOptionsMutation) AutoRecapRatesPerDay() (r int, exists bool) {
-	v := m.auto_recap_rates_per_day
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TelegramUserPreferencesMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAutoRecapRatesPerDay returns the old "auto_recap_rates_per_day" field's value of the TelegramChatRecapsOptions entity.
-// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the TelegramUserPreferences entity.
+// If the TelegramUserPreferences object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatRecapsOptionsMutation) OldAutoRecapRatesPerDay(ctx context.Context) (v int, err error) {
+func (m *TelegramUserPreferencesMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAutoRecapRatesPerDay is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAutoRecapRatesPerDay requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAutoRecapRatesPerDay: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.AutoRecapRatesPerDay, nil
+	return oldValue.UserID, nil
 }
 
-// AddAutoRecapRatesPerDay adds i to the "auto_recap_rates_per_day" field.
-func (m *TelegramChatRecapsOptionsMutation) AddAutoRecapRatesPerDay(i int) {
-	if m.addauto_recap_rates_per_day != nil {
-		*m.addauto_recap_rates_per_day += i
+// AddUserID adds i to the "user_id" field.
+func (m *TelegramUserPreferencesMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
 	} else {
-		m.addauto_recap_rates_per_day = &i
+		m.adduser_id = &i
 	}
 }
 
-// AddedAutoRecapRatesPerDay returns the value that was added to the "auto_recap_rates_per_day" field in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedAutoRecapRatesPerDay() (r int, exists bool) {
-	v := m.addauto_recap_rates_per_day
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *TelegramUserPreferencesMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAutoRecapRatesPerDay resets all changes to the "auto_recap_rates_per_day" field.
-func (m *TelegramChatRecapsOptionsMutation) ResetAutoRecapRatesPerDay() {
-	m.auto_recap_rates_per_day = nil
-	m.addauto_recap_rates_per_day = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TelegramUserPreferencesMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetPinAutoRecapMessage sets the "pin_auto_recap_message" field.
-func (m *TelegramChatRecapsOptionsMutation) SetPinAutoRecapMessage(b bool) {
-	m.pin_auto_recap_message = &b
+// SetBatchPrivateRecaps sets the "batch_private_recaps" field.
+func (m *TelegramUserPreferencesMutation) SetBatchPrivateRecaps(b bool) {
+	m.batch_private_recaps = &b
 }
 
-// PinAutoRecapMessage returns the value of the "pin_auto_recap_message" field in the mutation.
-func (m *TelegramChatRecapsOptionsMutation) PinAutoRecapMessage() (r bool, exists bool) {
-	v := m.pin_auto_recap_message
+// BatchPrivateRecaps returns the value of the "batch_private_recaps" field in the mutation.
+func (m *TelegramUserPreferencesMutation) BatchPrivateRecaps() (r bool, exists bool) {
+	v := m.batch_private_recaps
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPinAutoRecapMessage returns the old "pin_auto_recap_message" field's value of the TelegramChatRecapsOptions entity.
-// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// OldBatchPrivateRecaps returns the old "batch_private_recaps" field's value of the TelegramUserPreferences entity.
+// If the TelegramUserPreferences object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatRecapsOptionsMutation) OldPinAutoRecapMessage(ctx context.Context) (v bool, err error) {
+func (m *TelegramUserPreferencesMutation) OldBatchPrivateRecaps(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPinAutoRecapMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldBatchPrivateRecaps is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPinAutoRecapMessage requires an ID field in the mutation")
+		return v, errors.New("OldBatchPrivateRecaps requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPinAutoRecapMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldBatchPrivateRecaps: %w", err)
 	}
-	return oldValue.PinAutoRecapMessage, nil
+	return oldValue.BatchPrivateRecaps, nil
 }
 
-// ResetPinAutoRecapMessage resets all changes to the "pin_auto_recap_message" field.
-func (m *TelegramChatRecapsOptionsMutation) ResetPinAutoRecapMessage() {
-	m.pin_auto_recap_message = nil
+// ResetBatchPrivateRecaps resets all changes to the "batch_private_recaps" field.
+func (m *TelegramUserPreferencesMutation) ResetBatchPrivateRecaps() {
+	m.batch_private_recaps = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TelegramChatRecapsOptionsMutation) SetCreatedAt(i int64) {
+func (m *TelegramUserPreferencesMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TelegramChatRecapsOptionsMutation) CreatedAt() (r int64, exists bool) {
+func (m *TelegramUserPreferencesMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -9621,10 +15062,10 @@ func (m *TelegramChatRecapsOptionsMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TelegramChatRecapsOptions entity.
-// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TelegramUserPreferences entity.
+// If the TelegramUserPreferences object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatRecapsOptionsMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *TelegramUserPreferencesMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -9639,7 +15080,7 @@ func (m *TelegramChatRecapsOptionsMutation) OldCreatedAt(ctx context.Context) (v
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *TelegramChatRecapsOptionsMutation) AddCreatedAt(i int64) {
+func (m *TelegramUserPreferencesMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -9648,7 +15089,7 @@ func (m *TelegramChatRecapsOptionsMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *TelegramUserPreferencesMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -9657,19 +15098,19 @@ func (m *TelegramChatRecapsOptionsMutation) AddedCreatedAt() (r int64, exists bo
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TelegramChatRecapsOptionsMutation) ResetCreatedAt() {
+func (m *TelegramUserPreferencesMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TelegramChatRecapsOptionsMutation) SetUpdatedAt(i int64) {
+func (m *TelegramUserPreferencesMutation) SetUpdatedAt(i int64) {
 	m.updated_at = &i
 	m.addupdated_at = nil
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TelegramChatRecapsOptionsMutation) UpdatedAt() (r int64, exists bool) {
+func (m *TelegramUserPreferencesMutation) UpdatedAt() (r int64, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -9677,10 +15118,10 @@ func (m *TelegramChatRecapsOptionsMutation) UpdatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TelegramChatRecapsOptions entity.
-// If the TelegramChatRecapsOptions object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TelegramUserPreferences entity.
+// If the TelegramUserPreferences object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChatRecapsOptionsMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *TelegramUserPreferencesMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -9695,7 +15136,7 @@ func (m *TelegramChatRecapsOptionsMutation) OldUpdatedAt(ctx context.Context) (v
 }
 
 // AddUpdatedAt adds i to the "updated_at" field.
-func (m *TelegramChatRecapsOptionsMutation) AddUpdatedAt(i int64) {
+func (m *TelegramUserPreferencesMutation) AddUpdatedAt(i int64) {
 	if m.addupdated_at != nil {
 		*m.addupdated_at += i
 	} else {
@@ -9704,7 +15145,7 @@ func (m *TelegramChatRecapsOptionsMutation) AddUpdatedAt(i int64) {
 }
 
 // AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedUpdatedAt() (r int64, exists bool) {
+func (m *TelegramUserPreferencesMutation) AddedUpdatedAt() (r int64, exists bool) {
 	v := m.addupdated_at
 	if v == nil {
 		return
@@ -9713,20 +15154,20 @@ func (m *TelegramChatRecapsOptionsMutation) AddedUpdatedAt() (r int64, exists bo
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TelegramChatRecapsOptionsMutation) ResetUpdatedAt() {
+func (m *TelegramUserPreferencesMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 	m.addupdated_at = nil
 }
 
-// Where appends a list predicates to the TelegramChatRecapsOptionsMutation builder.
-func (m *TelegramChatRecapsOptionsMutation) Where(ps ...predicate.TelegramChatRecapsOptions) {
+// Where appends a list predicates to the TelegramUserPreferencesMutation builder.
+func (m *TelegramUserPreferencesMutation) Where(ps ...predicate.TelegramUserPreferences) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramChatRecapsOptionsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TelegramUserPreferencesMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramChatRecapsOptionsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramChatRecapsOptions, len(ps))
+func (m *TelegramUserPreferencesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramUserPreferences, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9734,45 +15175,36 @@ func (m *TelegramChatRecapsOptionsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TelegramChatRecapsOptionsMutation) Op() Op {
+func (m *TelegramUserPreferencesMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramChatRecapsOptionsMutation) SetOp(op Op) {
+func (m *TelegramUserPreferencesMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramChatRecapsOptions).
-func (m *TelegramChatRecapsOptionsMutation) Type() string {
+// Type returns the node type of this mutation (TelegramUserPreferences).
+func (m *TelegramUserPreferencesMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramChatRecapsOptionsMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.chat_id != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldChatID)
-	}
-	if m.auto_recap_send_mode != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapSendMode)
-	}
-	if m.manual_recap_rate_per_seconds != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapRatePerSeconds)
-	}
-	if m.auto_recap_rates_per_day != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapRatesPerDay)
+func (m *TelegramUserPreferencesMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, telegramuserpreferences.FieldUserID)
 	}
-	if m.pin_auto_recap_message != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldPinAutoRecapMessage)
+	if m.batch_private_recaps != nil {
+		fields = append(fields, telegramuserpreferences.FieldBatchPrivateRecaps)
 	}
 	if m.created_at != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldCreatedAt)
+		fields = append(fields, telegramuserpreferences.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldUpdatedAt)
+		fields = append(fields, telegramuserpreferences.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9780,21 +15212,15 @@ func (m *TelegramChatRecapsOptionsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramChatRecapsOptionsMutation) Field(name string) (ent.Value, bool) {
+func (m *TelegramUserPreferencesMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchatrecapsoptions.FieldChatID:
-		return m.ChatID()
-	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
-		return m.AutoRecapSendMode()
-	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
-		return m.ManualRecapRatePerSeconds()
-	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
-		return m.AutoRecapRatesPerDay()
-	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
-		return m.PinAutoRecapMessage()
-	case telegramchatrecapsoptions.FieldCreatedAt:
+	case telegramuserpreferences.FieldUserID:
+		return m.UserID()
+	case telegramuserpreferences.FieldBatchPrivateRecaps:
+		return m.BatchPrivateRecaps()
+	case telegramuserpreferences.FieldCreatedAt:
 		return m.CreatedAt()
-	case telegramchatrecapsoptions.FieldUpdatedAt:
+	case telegramuserpreferences.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -9803,74 +15229,47 @@ func (m *TelegramChatRecapsOptionsMutation) Field(name string) (ent.Value, bool)
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramChatRecapsOptionsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TelegramUserPreferencesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramchatrecapsoptions.FieldChatID:
-		return m.OldChatID(ctx)
-	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
-		return m.OldAutoRecapSendMode(ctx)
-	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
-		return m.OldManualRecapRatePerSeconds(ctx)
-	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
-		return m.OldAutoRecapRatesPerDay(ctx)
-	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
-		return m.OldPinAutoRecapMessage(ctx)
-	case telegramchatrecapsoptions.FieldCreatedAt:
+	case telegramuserpreferences.FieldUserID:
+		return m.OldUserID(ctx)
+	case telegramuserpreferences.FieldBatchPrivateRecaps:
+		return m.OldBatchPrivateRecaps(ctx)
+	case telegramuserpreferences.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case telegramchatrecapsoptions.FieldUpdatedAt:
+	case telegramuserpreferences.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramChatRecapsOptions field %s", name)
+	return nil, fmt.Errorf("unknown TelegramUserPreferences field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChatRecapsOptionsMutation) SetField(name string, value ent.Value) error {
+func (m *TelegramUserPreferencesMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramchatrecapsoptions.FieldChatID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChatID(v)
-		return nil
-	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAutoRecapSendMode(v)
-		return nil
-	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+	case telegramuserpreferences.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetManualRecapRatePerSeconds(v)
-		return nil
-	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAutoRecapRatesPerDay(v)
+		m.SetUserID(v)
 		return nil
-	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
+	case telegramuserpreferences.FieldBatchPrivateRecaps:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPinAutoRecapMessage(v)
+		m.SetBatchPrivateRecaps(v)
 		return nil
-	case telegramchatrecapsoptions.FieldCreatedAt:
+	case telegramuserpreferences.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case telegramchatrecapsoptions.FieldUpdatedAt:
+	case telegramuserpreferences.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9878,30 +15277,21 @@ func (m *TelegramChatRecapsOptionsMutation) SetField(name string, value ent.Valu
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatRecapsOptions field %s", name)
+	return fmt.Errorf("unknown TelegramUserPreferences field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedFields() []string {
+func (m *TelegramUserPreferencesMutation) AddedFields() []string {
 	var fields []string
-	if m.addchat_id != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldChatID)
-	}
-	if m.addauto_recap_send_mode != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapSendMode)
-	}
-	if m.addmanual_recap_rate_per_seconds != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldManualRecapRatePerSeconds)
-	}
-	if m.addauto_recap_rates_per_day != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldAutoRecapRatesPerDay)
+	if m.adduser_id != nil {
+		fields = append(fields, telegramuserpreferences.FieldUserID)
 	}
 	if m.addcreated_at != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldCreatedAt)
+		fields = append(fields, telegramuserpreferences.FieldCreatedAt)
 	}
 	if m.addupdated_at != nil {
-		fields = append(fields, telegramchatrecapsoptions.FieldUpdatedAt)
+		fields = append(fields, telegramuserpreferences.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9909,19 +15299,13 @@ func (m *TelegramChatRecapsOptionsMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramChatRecapsOptionsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TelegramUserPreferencesMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchatrecapsoptions.FieldChatID:
-		return m.AddedChatID()
-	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
-		return m.AddedAutoRecapSendMode()
-	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
-		return m.AddedManualRecapRatePerSeconds()
-	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
-		return m.AddedAutoRecapRatesPerDay()
-	case telegramchatrecapsoptions.FieldCreatedAt:
+	case telegramuserpreferences.FieldUserID:
+		return m.AddedUserID()
+	case telegramuserpreferences.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case telegramchatrecapsoptions.FieldUpdatedAt:
+	case telegramuserpreferences.FieldUpdatedAt:
 		return m.AddedUpdatedAt()
 	}
 	return nil, false
@@ -9930,44 +15314,23 @@ func (m *TelegramChatRecapsOptionsMutation) AddedField(name string) (ent.Value,
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChatRecapsOptionsMutation) AddField(name string, value ent.Value) error {
+func (m *TelegramUserPreferencesMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case telegramchatrecapsoptions.FieldChatID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddChatID(v)
-		return nil
-	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAutoRecapSendMode(v)
-		return nil
-	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
+	case telegramuserpreferences.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddManualRecapRatePerSeconds(v)
-		return nil
-	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAutoRecapRatesPerDay(v)
+		m.AddUserID(v)
 		return nil
-	case telegramchatrecapsoptions.FieldCreatedAt:
+	case telegramuserpreferences.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case telegramchatrecapsoptions.FieldUpdatedAt:
+	case telegramuserpreferences.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9975,101 +15338,92 @@ func (m *TelegramChatRecapsOptionsMutation) AddField(name string, value ent.Valu
 		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatRecapsOptions numeric field %s", name)
+	return fmt.Errorf("unknown TelegramUserPreferences numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramChatRecapsOptionsMutation) ClearedFields() []string {
+func (m *TelegramUserPreferencesMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) FieldCleared(name string) bool {
+func (m *TelegramUserPreferencesMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramChatRecapsOptionsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TelegramChatRecapsOptions nullable field %s", name)
+func (m *TelegramUserPreferencesMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramUserPreferences nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramChatRecapsOptionsMutation) ResetField(name string) error {
+func (m *TelegramUserPreferencesMutation) ResetField(name string) error {
 	switch name {
-	case telegramchatrecapsoptions.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case telegramchatrecapsoptions.FieldAutoRecapSendMode:
-		m.ResetAutoRecapSendMode()
-		return nil
-	case telegramchatrecapsoptions.FieldManualRecapRatePerSeconds:
-		m.ResetManualRecapRatePerSeconds()
-		return nil
-	case telegramchatrecapsoptions.FieldAutoRecapRatesPerDay:
-		m.ResetAutoRecapRatesPerDay()
+	case telegramuserpreferences.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
-		m.ResetPinAutoRecapMessage()
+	case telegramuserpreferences.FieldBatchPrivateRecaps:
+		m.ResetBatchPrivateRecaps()
 		return nil
-	case telegramchatrecapsoptions.FieldCreatedAt:
+	case telegramuserpreferences.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case telegramchatrecapsoptions.FieldUpdatedAt:
+	case telegramuserpreferences.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChatRecapsOptions field %s", name)
+	return fmt.Errorf("unknown TelegramUserPreferences field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedEdges() []string {
+func (m *TelegramUserPreferencesMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) AddedIDs(name string) []ent.Value {
+func (m *TelegramUserPreferencesMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) RemovedEdges() []string {
+func (m *TelegramUserPreferencesMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) RemovedIDs(name string) []ent.Value {
+func (m *TelegramUserPreferencesMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) ClearedEdges() []string {
+func (m *TelegramUserPreferencesMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramChatRecapsOptionsMutation) EdgeCleared(name string) bool {
+func (m *TelegramUserPreferencesMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramChatRecapsOptionsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChatRecapsOptions unique edge %s", name)
+func (m *TelegramUserPreferencesMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramUserPreferences unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramChatRecapsOptionsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChatRecapsOptions edge %s", name)
+func (m *TelegramUserPreferencesMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramUserPreferences edge %s", name)
 }
@@ -18,12 +18,18 @@ type FeedbackSummarizationsReactions func(*sql.Selector)
 // LogChatHistoriesRecap is the predicate function for logchathistoriesrecap builders.
 type LogChatHistoriesRecap func(*sql.Selector)
 
+// LogChatHistoriesRecapFailures is the predicate function for logchathistoriesrecapfailures builders.
+type LogChatHistoriesRecapFailures func(*sql.Selector)
+
 // LogSummarizations is the predicate function for logsummarizations builders.
 type LogSummarizations func(*sql.Selector)
 
 // MetricOpenAIChatCompletionTokenUsage is the predicate function for metricopenaichatcompletiontokenusage builders.
 type MetricOpenAIChatCompletionTokenUsage func(*sql.Selector)
 
+// RecapFeedback is the predicate function for recapfeedback builders.
+type RecapFeedback func(*sql.Selector)
+
 // SentMessages is the predicate function for sentmessages builders.
 type SentMessages func(*sql.Selector)
 
@@ -38,3 +44,6 @@ type TelegramChatFeatureFlags func(*sql.Selector)
 
 // TelegramChatRecapsOptions is the predicate function for telegramchatrecapsoptions builders.
 type TelegramChatRecapsOptions func(*sql.Selector)
+
+// TelegramUserPreferences is the predicate function for telegramuserpreferences builders.
+type TelegramUserPreferences func(*sql.Selector)
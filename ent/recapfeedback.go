@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
+)
+
+// RecapFeedback is the model entity for the RecapFeedback schema.
+type RecapFeedback struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID int64 `json:"chat_id,omitempty"`
+	// LogID holds the value of the "log_id" field.
+	LogID uuid.UUID `json:"log_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecapFeedback) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recapfeedback.FieldChatID, recapfeedback.FieldUserID, recapfeedback.FieldCreatedAt, recapfeedback.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case recapfeedback.FieldText:
+			values[i] = new(sql.NullString)
+		case recapfeedback.FieldID, recapfeedback.FieldLogID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecapFeedback fields.
+func (_m *RecapFeedback) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recapfeedback.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case recapfeedback.FieldChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.Int64
+			}
+		case recapfeedback.FieldLogID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field log_id", values[i])
+			} else if value != nil {
+				_m.LogID = *value
+			}
+		case recapfeedback.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case recapfeedback.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case recapfeedback.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case recapfeedback.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecapFeedback.
+// This includes values selected through modifiers, order, etc.
+func (_m *RecapFeedback) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RecapFeedback.
+// Note that you need to call RecapFeedback.Unwrap() before calling this method if this RecapFeedback
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RecapFeedback) Update() *RecapFeedbackUpdateOne {
+	return NewRecapFeedbackClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RecapFeedback entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RecapFeedback) Unwrap() *RecapFeedback {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecapFeedback is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RecapFeedback) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecapFeedback(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("log_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecapFeedbacks is a parsable slice of RecapFeedback.
+type RecapFeedbacks []*RecapFeedback
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
+)
+
+// RecapFeedbackCreate is the builder for creating a RecapFeedback entity.
+type RecapFeedbackCreate struct {
+	config
+	mutation *RecapFeedbackMutation
+	hooks    []Hook
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *RecapFeedbackCreate) SetChatID(v int64) *RecapFeedbackCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_c *RecapFeedbackCreate) SetNillableChatID(v *int64) *RecapFeedbackCreate {
+	if v != nil {
+		_c.SetChatID(*v)
+	}
+	return _c
+}
+
+// SetLogID sets the "log_id" field.
+func (_c *RecapFeedbackCreate) SetLogID(v uuid.UUID) *RecapFeedbackCreate {
+	_c.mutation.SetLogID(v)
+	return _c
+}
+
+// SetNillableLogID sets the "log_id" field if the given value is not nil.
+func (_c *RecapFeedbackCreate) SetNillableLogID(v *uuid.UUID) *RecapFeedbackCreate {
+	if v != nil {
+		_c.SetLogID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RecapFeedbackCreate) SetUserID(v int64) *RecapFeedbackCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *RecapFeedbackCreate) SetNillableUserID(v *int64) *RecapFeedbackCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *RecapFeedbackCreate) SetText(v string) *RecapFeedbackCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_c *RecapFeedbackCreate) SetNillableText(v *string) *RecapFeedbackCreate {
+	if v != nil {
+		_c.SetText(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecapFeedbackCreate) SetCreatedAt(v int64) *RecapFeedbackCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecapFeedbackCreate) SetNillableCreatedAt(v *int64) *RecapFeedbackCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RecapFeedbackCreate) SetUpdatedAt(v int64) *RecapFeedbackCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RecapFeedbackCreate) SetNillableUpdatedAt(v *int64) *RecapFeedbackCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RecapFeedbackCreate) SetID(v uuid.UUID) *RecapFeedbackCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RecapFeedbackCreate) SetNillableID(v *uuid.UUID) *RecapFeedbackCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RecapFeedbackMutation object of the builder.
+func (_c *RecapFeedbackCreate) Mutation() *RecapFeedbackMutation {
+	return _c.mutation
+}
+
+// Save creates the RecapFeedback in the database.
+func (_c *RecapFeedbackCreate) Save(ctx context.Context) (*RecapFeedback, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecapFeedbackCreate) SaveX(ctx context.Context) *RecapFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecapFeedbackCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecapFeedbackCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecapFeedbackCreate) defaults() {
+	if _, ok := _c.mutation.ChatID(); !ok {
+		v := recapfeedback.DefaultChatID
+		_c.mutation.SetChatID(v)
+	}
+	if _, ok := _c.mutation.LogID(); !ok {
+		v := recapfeedback.DefaultLogID()
+		_c.mutation.SetLogID(v)
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		v := recapfeedback.DefaultUserID
+		_c.mutation.SetUserID(v)
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		v := recapfeedback.DefaultText
+		_c.mutation.SetText(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := recapfeedback.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := recapfeedback.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := recapfeedback.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecapFeedbackCreate) check() error {
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "RecapFeedback.chat_id"`)}
+	}
+	if _, ok := _c.mutation.LogID(); !ok {
+		return &ValidationError{Name: "log_id", err: errors.New(`ent: missing required field "RecapFeedback.log_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RecapFeedback.user_id"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "RecapFeedback.text"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecapFeedback.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RecapFeedback.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *RecapFeedbackCreate) sqlSave(ctx context.Context) (*RecapFeedback, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecapFeedbackCreate) createSpec() (*RecapFeedback, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecapFeedback{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recapfeedback.Table, sqlgraph.NewFieldSpec(recapfeedback.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.RecapFeedback
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(recapfeedback.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.LogID(); ok {
+		_spec.SetField(recapfeedback.FieldLogID, field.TypeUUID, value)
+		_node.LogID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(recapfeedback.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(recapfeedback.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recapfeedback.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(recapfeedback.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RecapFeedbackCreateBulk is the builder for creating many RecapFeedback entities in bulk.
+type RecapFeedbackCreateBulk struct {
+	config
+	err      error
+	builders []*RecapFeedbackCreate
+}
+
+// Save creates the RecapFeedback entities in the database.
+func (_c *RecapFeedbackCreateBulk) Save(ctx context.Context) ([]*RecapFeedback, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecapFeedback, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecapFeedbackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecapFeedbackCreateBulk) SaveX(ctx context.Context) []*RecapFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecapFeedbackCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecapFeedbackCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nekomeowww/insights-bot/ent/internal"
+	"github.com/nekomeowww/insights-bot/ent/predicate"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
+)
+
+// RecapFeedbackUpdate is the builder for updating RecapFeedback entities.
+type RecapFeedbackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecapFeedbackMutation
+}
+
+// Where appends a list predicates to the RecapFeedbackUpdate builder.
+func (_u *RecapFeedbackUpdate) Where(ps ...predicate.RecapFeedback) *RecapFeedbackUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *RecapFeedbackUpdate) SetChatID(v int64) *RecapFeedbackUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *RecapFeedbackUpdate) SetNillableChatID(v *int64) *RecapFeedbackUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *RecapFeedbackUpdate) AddChatID(v int64) *RecapFeedbackUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RecapFeedbackUpdate) SetUserID(v int64) *RecapFeedbackUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RecapFeedbackUpdate) SetNillableUserID(v *int64) *RecapFeedbackUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *RecapFeedbackUpdate) AddUserID(v int64) *RecapFeedbackUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *RecapFeedbackUpdate) SetText(v string) *RecapFeedbackUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *RecapFeedbackUpdate) SetNillableText(v *string) *RecapFeedbackUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RecapFeedbackUpdate) SetCreatedAt(v int64) *RecapFeedbackUpdate {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RecapFeedbackUpdate) SetNillableCreatedAt(v *int64) *RecapFeedbackUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *RecapFeedbackUpdate) AddCreatedAt(v int64) *RecapFeedbackUpdate {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecapFeedbackUpdate) SetUpdatedAt(v int64) *RecapFeedbackUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RecapFeedbackUpdate) SetNillableUpdatedAt(v *int64) *RecapFeedbackUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *RecapFeedbackUpdate) AddUpdatedAt(v int64) *RecapFeedbackUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RecapFeedbackMutation object of the builder.
+func (_u *RecapFeedbackUpdate) Mutation() *RecapFeedbackMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecapFeedbackUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecapFeedbackUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecapFeedbackUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecapFeedbackUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RecapFeedbackUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(recapfeedback.Table, recapfeedback.Columns, sqlgraph.NewFieldSpec(recapfeedback.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(recapfeedback.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(recapfeedback.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(recapfeedback.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(recapfeedback.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(recapfeedback.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(recapfeedback.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(recapfeedback.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recapfeedback.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(recapfeedback.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.RecapFeedback
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recapfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecapFeedbackUpdateOne is the builder for updating a single RecapFeedback entity.
+type RecapFeedbackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecapFeedbackMutation
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *RecapFeedbackUpdateOne) SetChatID(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *RecapFeedbackUpdateOne) SetNillableChatID(v *int64) *RecapFeedbackUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *RecapFeedbackUpdateOne) AddChatID(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RecapFeedbackUpdateOne) SetUserID(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RecapFeedbackUpdateOne) SetNillableUserID(v *int64) *RecapFeedbackUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *RecapFeedbackUpdateOne) AddUserID(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *RecapFeedbackUpdateOne) SetText(v string) *RecapFeedbackUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *RecapFeedbackUpdateOne) SetNillableText(v *string) *RecapFeedbackUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RecapFeedbackUpdateOne) SetCreatedAt(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RecapFeedbackUpdateOne) SetNillableCreatedAt(v *int64) *RecapFeedbackUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *RecapFeedbackUpdateOne) AddCreatedAt(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecapFeedbackUpdateOne) SetUpdatedAt(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RecapFeedbackUpdateOne) SetNillableUpdatedAt(v *int64) *RecapFeedbackUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *RecapFeedbackUpdateOne) AddUpdatedAt(v int64) *RecapFeedbackUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RecapFeedbackMutation object of the builder.
+func (_u *RecapFeedbackUpdateOne) Mutation() *RecapFeedbackMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RecapFeedbackUpdate builder.
+func (_u *RecapFeedbackUpdateOne) Where(ps ...predicate.RecapFeedback) *RecapFeedbackUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecapFeedbackUpdateOne) Select(field string, fields ...string) *RecapFeedbackUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecapFeedback entity.
+func (_u *RecapFeedbackUpdateOne) Save(ctx context.Context) (*RecapFeedback, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecapFeedbackUpdateOne) SaveX(ctx context.Context) *RecapFeedback {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecapFeedbackUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecapFeedbackUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RecapFeedbackUpdateOne) sqlSave(ctx context.Context) (_node *RecapFeedback, err error) {
+	_spec := sqlgraph.NewUpdateSpec(recapfeedback.Table, recapfeedback.Columns, sqlgraph.NewFieldSpec(recapfeedback.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecapFeedback.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recapfeedback.FieldID)
+		for _, f := range fields {
+			if !recapfeedback.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recapfeedback.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(recapfeedback.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(recapfeedback.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(recapfeedback.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(recapfeedback.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(recapfeedback.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(recapfeedback.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(recapfeedback.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recapfeedback.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(recapfeedback.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.RecapFeedback
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &RecapFeedback{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recapfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,14 +8,17 @@ import (
 	"github.com/nekomeowww/insights-bot/ent/feedbackchathistoriesrecapsreactions"
 	"github.com/nekomeowww/insights-bot/ent/feedbacksummarizationsreactions"
 	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
 	"github.com/nekomeowww/insights-bot/ent/logsummarizations"
 	"github.com/nekomeowww/insights-bot/ent/metricopenaichatcompletiontokenusage"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
 	"github.com/nekomeowww/insights-bot/ent/schema"
 	"github.com/nekomeowww/insights-bot/ent/sentmessages"
 	"github.com/nekomeowww/insights-bot/ent/slackoauthcredentials"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatautorecapssubscribers"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatfeatureflags"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatrecapsoptions"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -52,52 +55,76 @@ func init() {
 	chathistoriesDescFullName := chathistoriesFields[7].Descriptor()
 	// chathistories.DefaultFullName holds the default value on creation for the full_name field.
 	chathistories.DefaultFullName = chathistoriesDescFullName.Default.(string)
+	// chathistoriesDescIsBot is the schema descriptor for is_bot field.
+	chathistoriesDescIsBot := chathistoriesFields[8].Descriptor()
+	// chathistories.DefaultIsBot holds the default value on creation for the is_bot field.
+	chathistories.DefaultIsBot = chathistoriesDescIsBot.Default.(bool)
 	// chathistoriesDescText is the schema descriptor for text field.
-	chathistoriesDescText := chathistoriesFields[8].Descriptor()
+	chathistoriesDescText := chathistoriesFields[9].Descriptor()
 	// chathistories.DefaultText holds the default value on creation for the text field.
 	chathistories.DefaultText = chathistoriesDescText.Default.(string)
 	// chathistoriesDescRepliedToMessageID is the schema descriptor for replied_to_message_id field.
-	chathistoriesDescRepliedToMessageID := chathistoriesFields[9].Descriptor()
+	chathistoriesDescRepliedToMessageID := chathistoriesFields[10].Descriptor()
 	// chathistories.DefaultRepliedToMessageID holds the default value on creation for the replied_to_message_id field.
 	chathistories.DefaultRepliedToMessageID = chathistoriesDescRepliedToMessageID.Default.(int64)
 	// chathistoriesDescRepliedToUserID is the schema descriptor for replied_to_user_id field.
-	chathistoriesDescRepliedToUserID := chathistoriesFields[10].Descriptor()
+	chathistoriesDescRepliedToUserID := chathistoriesFields[11].Descriptor()
 	// chathistories.DefaultRepliedToUserID holds the default value on creation for the replied_to_user_id field.
 	chathistories.DefaultRepliedToUserID = chathistoriesDescRepliedToUserID.Default.(int64)
 	// chathistoriesDescRepliedToFullName is the schema descriptor for replied_to_full_name field.
-	chathistoriesDescRepliedToFullName := chathistoriesFields[11].Descriptor()
+	chathistoriesDescRepliedToFullName := chathistoriesFields[12].Descriptor()
 	// chathistories.DefaultRepliedToFullName holds the default value on creation for the replied_to_full_name field.
 	chathistories.DefaultRepliedToFullName = chathistoriesDescRepliedToFullName.Default.(string)
 	// chathistoriesDescRepliedToUsername is the schema descriptor for replied_to_username field.
-	chathistoriesDescRepliedToUsername := chathistoriesFields[12].Descriptor()
+	chathistoriesDescRepliedToUsername := chathistoriesFields[13].Descriptor()
 	// chathistories.DefaultRepliedToUsername holds the default value on creation for the replied_to_username field.
 	chathistories.DefaultRepliedToUsername = chathistoriesDescRepliedToUsername.Default.(string)
 	// chathistoriesDescRepliedToText is the schema descriptor for replied_to_text field.
-	chathistoriesDescRepliedToText := chathistoriesFields[13].Descriptor()
+	chathistoriesDescRepliedToText := chathistoriesFields[14].Descriptor()
 	// chathistories.DefaultRepliedToText holds the default value on creation for the replied_to_text field.
 	chathistories.DefaultRepliedToText = chathistoriesDescRepliedToText.Default.(string)
 	// chathistoriesDescRepliedToChatType is the schema descriptor for replied_to_chat_type field.
-	chathistoriesDescRepliedToChatType := chathistoriesFields[14].Descriptor()
+	chathistoriesDescRepliedToChatType := chathistoriesFields[15].Descriptor()
 	// chathistories.DefaultRepliedToChatType holds the default value on creation for the replied_to_chat_type field.
 	chathistories.DefaultRepliedToChatType = chathistoriesDescRepliedToChatType.Default.(string)
 	// chathistoriesDescChattedAt is the schema descriptor for chatted_at field.
-	chathistoriesDescChattedAt := chathistoriesFields[15].Descriptor()
+	chathistoriesDescChattedAt := chathistoriesFields[16].Descriptor()
 	// chathistories.DefaultChattedAt holds the default value on creation for the chatted_at field.
 	chathistories.DefaultChattedAt = chathistoriesDescChattedAt.Default.(func() int64)
 	// chathistoriesDescEmbedded is the schema descriptor for embedded field.
-	chathistoriesDescEmbedded := chathistoriesFields[16].Descriptor()
+	chathistoriesDescEmbedded := chathistoriesFields[17].Descriptor()
 	// chathistories.DefaultEmbedded holds the default value on creation for the embedded field.
 	chathistories.DefaultEmbedded = chathistoriesDescEmbedded.Default.(bool)
 	// chathistoriesDescFromPlatform is the schema descriptor for from_platform field.
-	chathistoriesDescFromPlatform := chathistoriesFields[17].Descriptor()
+	chathistoriesDescFromPlatform := chathistoriesFields[18].Descriptor()
 	// chathistories.DefaultFromPlatform holds the default value on creation for the from_platform field.
 	chathistories.DefaultFromPlatform = chathistoriesDescFromPlatform.Default.(int)
+	// chathistoriesDescPollID is the schema descriptor for poll_id field.
+	chathistoriesDescPollID := chathistoriesFields[19].Descriptor()
+	// chathistories.DefaultPollID holds the default value on creation for the poll_id field.
+	chathistories.DefaultPollID = chathistoriesDescPollID.Default.(string)
+	// chathistoriesDescPollQuestion is the schema descriptor for poll_question field.
+	chathistoriesDescPollQuestion := chathistoriesFields[20].Descriptor()
+	// chathistories.DefaultPollQuestion holds the default value on creation for the poll_question field.
+	chathistories.DefaultPollQuestion = chathistoriesDescPollQuestion.Default.(string)
+	// chathistoriesDescPollTotalVoterCount is the schema descriptor for poll_total_voter_count field.
+	chathistoriesDescPollTotalVoterCount := chathistoriesFields[22].Descriptor()
+	// chathistories.DefaultPollTotalVoterCount holds the default value on creation for the poll_total_voter_count field.
+	chathistories.DefaultPollTotalVoterCount = chathistoriesDescPollTotalVoterCount.Default.(int)
+	// chathistoriesDescPollIsClosed is the schema descriptor for poll_is_closed field.
+	chathistoriesDescPollIsClosed := chathistoriesFields[23].Descriptor()
+	// chathistories.DefaultPollIsClosed holds the default value on creation for the poll_is_closed field.
+	chathistories.DefaultPollIsClosed = chathistoriesDescPollIsClosed.Default.(bool)
+	// chathistoriesDescMessageTypes is the schema descriptor for message_types field.
+	chathistoriesDescMessageTypes := chathistoriesFields[24].Descriptor()
+	// chathistories.DefaultMessageTypes holds the default value on creation for the message_types field.
+	chathistories.DefaultMessageTypes = chathistoriesDescMessageTypes.Default.(int)
 	// chathistoriesDescCreatedAt is the schema descriptor for created_at field.
-	chathistoriesDescCreatedAt := chathistoriesFields[18].Descriptor()
+	chathistoriesDescCreatedAt := chathistoriesFields[25].Descriptor()
 	// chathistories.DefaultCreatedAt holds the default value on creation for the created_at field.
 	chathistories.DefaultCreatedAt = chathistoriesDescCreatedAt.Default.(func() int64)
 	// chathistoriesDescUpdatedAt is the schema descriptor for updated_at field.
-	chathistoriesDescUpdatedAt := chathistoriesFields[19].Descriptor()
+	chathistoriesDescUpdatedAt := chathistoriesFields[26].Descriptor()
 	// chathistories.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	chathistories.DefaultUpdatedAt = chathistoriesDescUpdatedAt.Default.(func() int64)
 	// chathistoriesDescID is the schema descriptor for id field.
@@ -194,18 +221,68 @@ func init() {
 	logchathistoriesrecapDescModelName := logchathistoriesrecapFields[9].Descriptor()
 	// logchathistoriesrecap.DefaultModelName holds the default value on creation for the model_name field.
 	logchathistoriesrecap.DefaultModelName = logchathistoriesrecapDescModelName.Default.(string)
+	// logchathistoriesrecapDescWindowHours is the schema descriptor for window_hours field.
+	logchathistoriesrecapDescWindowHours := logchathistoriesrecapFields[10].Descriptor()
+	// logchathistoriesrecap.DefaultWindowHours holds the default value on creation for the window_hours field.
+	logchathistoriesrecap.DefaultWindowHours = logchathistoriesrecapDescWindowHours.Default.(int)
+	// logchathistoriesrecapDescIsAutoRecap is the schema descriptor for is_auto_recap field.
+	logchathistoriesrecapDescIsAutoRecap := logchathistoriesrecapFields[11].Descriptor()
+	// logchathistoriesrecap.DefaultIsAutoRecap holds the default value on creation for the is_auto_recap field.
+	logchathistoriesrecap.DefaultIsAutoRecap = logchathistoriesrecapDescIsAutoRecap.Default.(bool)
 	// logchathistoriesrecapDescCreatedAt is the schema descriptor for created_at field.
-	logchathistoriesrecapDescCreatedAt := logchathistoriesrecapFields[10].Descriptor()
+	logchathistoriesrecapDescCreatedAt := logchathistoriesrecapFields[12].Descriptor()
 	// logchathistoriesrecap.DefaultCreatedAt holds the default value on creation for the created_at field.
 	logchathistoriesrecap.DefaultCreatedAt = logchathistoriesrecapDescCreatedAt.Default.(func() int64)
 	// logchathistoriesrecapDescUpdatedAt is the schema descriptor for updated_at field.
-	logchathistoriesrecapDescUpdatedAt := logchathistoriesrecapFields[11].Descriptor()
+	logchathistoriesrecapDescUpdatedAt := logchathistoriesrecapFields[13].Descriptor()
 	// logchathistoriesrecap.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	logchathistoriesrecap.DefaultUpdatedAt = logchathistoriesrecapDescUpdatedAt.Default.(func() int64)
 	// logchathistoriesrecapDescID is the schema descriptor for id field.
 	logchathistoriesrecapDescID := logchathistoriesrecapFields[0].Descriptor()
 	// logchathistoriesrecap.DefaultID holds the default value on creation for the id field.
 	logchathistoriesrecap.DefaultID = logchathistoriesrecapDescID.Default.(func() uuid.UUID)
+	logchathistoriesrecapfailuresFields := schema.LogChatHistoriesRecapFailures{}.Fields()
+	_ = logchathistoriesrecapfailuresFields
+	// logchathistoriesrecapfailuresDescChatID is the schema descriptor for chat_id field.
+	logchathistoriesrecapfailuresDescChatID := logchathistoriesrecapfailuresFields[1].Descriptor()
+	// logchathistoriesrecapfailures.DefaultChatID holds the default value on creation for the chat_id field.
+	logchathistoriesrecapfailures.DefaultChatID = logchathistoriesrecapfailuresDescChatID.Default.(int64)
+	// logchathistoriesrecapfailuresDescWindowHours is the schema descriptor for window_hours field.
+	logchathistoriesrecapfailuresDescWindowHours := logchathistoriesrecapfailuresFields[2].Descriptor()
+	// logchathistoriesrecapfailures.DefaultWindowHours holds the default value on creation for the window_hours field.
+	logchathistoriesrecapfailures.DefaultWindowHours = logchathistoriesrecapfailuresDescWindowHours.Default.(int)
+	// logchathistoriesrecapfailuresDescWindowSince is the schema descriptor for window_since field.
+	logchathistoriesrecapfailuresDescWindowSince := logchathistoriesrecapfailuresFields[3].Descriptor()
+	// logchathistoriesrecapfailures.DefaultWindowSince holds the default value on creation for the window_since field.
+	logchathistoriesrecapfailures.DefaultWindowSince = logchathistoriesrecapfailuresDescWindowSince.Default.(int64)
+	// logchathistoriesrecapfailuresDescWindowUntil is the schema descriptor for window_until field.
+	logchathistoriesrecapfailuresDescWindowUntil := logchathistoriesrecapfailuresFields[4].Descriptor()
+	// logchathistoriesrecapfailures.DefaultWindowUntil holds the default value on creation for the window_until field.
+	logchathistoriesrecapfailures.DefaultWindowUntil = logchathistoriesrecapfailuresDescWindowUntil.Default.(int64)
+	// logchathistoriesrecapfailuresDescError is the schema descriptor for error field.
+	logchathistoriesrecapfailuresDescError := logchathistoriesrecapfailuresFields[5].Descriptor()
+	// logchathistoriesrecapfailures.DefaultError holds the default value on creation for the error field.
+	logchathistoriesrecapfailures.DefaultError = logchathistoriesrecapfailuresDescError.Default.(string)
+	// logchathistoriesrecapfailuresDescIsAutoRecap is the schema descriptor for is_auto_recap field.
+	logchathistoriesrecapfailuresDescIsAutoRecap := logchathistoriesrecapfailuresFields[6].Descriptor()
+	// logchathistoriesrecapfailures.DefaultIsAutoRecap holds the default value on creation for the is_auto_recap field.
+	logchathistoriesrecapfailures.DefaultIsAutoRecap = logchathistoriesrecapfailuresDescIsAutoRecap.Default.(bool)
+	// logchathistoriesrecapfailuresDescResolved is the schema descriptor for resolved field.
+	logchathistoriesrecapfailuresDescResolved := logchathistoriesrecapfailuresFields[7].Descriptor()
+	// logchathistoriesrecapfailures.DefaultResolved holds the default value on creation for the resolved field.
+	logchathistoriesrecapfailures.DefaultResolved = logchathistoriesrecapfailuresDescResolved.Default.(bool)
+	// logchathistoriesrecapfailuresDescCreatedAt is the schema descriptor for created_at field.
+	logchathistoriesrecapfailuresDescCreatedAt := logchathistoriesrecapfailuresFields[8].Descriptor()
+	// logchathistoriesrecapfailures.DefaultCreatedAt holds the default value on creation for the created_at field.
+	logchathistoriesrecapfailures.DefaultCreatedAt = logchathistoriesrecapfailuresDescCreatedAt.Default.(func() int64)
+	// logchathistoriesrecapfailuresDescUpdatedAt is the schema descriptor for updated_at field.
+	logchathistoriesrecapfailuresDescUpdatedAt := logchathistoriesrecapfailuresFields[9].Descriptor()
+	// logchathistoriesrecapfailures.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	logchathistoriesrecapfailures.DefaultUpdatedAt = logchathistoriesrecapfailuresDescUpdatedAt.Default.(func() int64)
+	// logchathistoriesrecapfailuresDescID is the schema descriptor for id field.
+	logchathistoriesrecapfailuresDescID := logchathistoriesrecapfailuresFields[0].Descriptor()
+	// logchathistoriesrecapfailures.DefaultID holds the default value on creation for the id field.
+	logchathistoriesrecapfailures.DefaultID = logchathistoriesrecapfailuresDescID.Default.(func() uuid.UUID)
 	logsummarizationsFields := schema.LogSummarizations{}.Fields()
 	_ = logsummarizationsFields
 	// logsummarizationsDescContentURL is the schema descriptor for content_url field.
@@ -298,6 +375,36 @@ func init() {
 	metricopenaichatcompletiontokenusageDescID := metricopenaichatcompletiontokenusageFields[0].Descriptor()
 	// metricopenaichatcompletiontokenusage.DefaultID holds the default value on creation for the id field.
 	metricopenaichatcompletiontokenusage.DefaultID = metricopenaichatcompletiontokenusageDescID.Default.(func() uuid.UUID)
+	recapfeedbackFields := schema.RecapFeedback{}.Fields()
+	_ = recapfeedbackFields
+	// recapfeedbackDescChatID is the schema descriptor for chat_id field.
+	recapfeedbackDescChatID := recapfeedbackFields[1].Descriptor()
+	// recapfeedback.DefaultChatID holds the default value on creation for the chat_id field.
+	recapfeedback.DefaultChatID = recapfeedbackDescChatID.Default.(int64)
+	// recapfeedbackDescLogID is the schema descriptor for log_id field.
+	recapfeedbackDescLogID := recapfeedbackFields[2].Descriptor()
+	// recapfeedback.DefaultLogID holds the default value on creation for the log_id field.
+	recapfeedback.DefaultLogID = recapfeedbackDescLogID.Default.(func() uuid.UUID)
+	// recapfeedbackDescUserID is the schema descriptor for user_id field.
+	recapfeedbackDescUserID := recapfeedbackFields[3].Descriptor()
+	// recapfeedback.DefaultUserID holds the default value on creation for the user_id field.
+	recapfeedback.DefaultUserID = recapfeedbackDescUserID.Default.(int64)
+	// recapfeedbackDescText is the schema descriptor for text field.
+	recapfeedbackDescText := recapfeedbackFields[4].Descriptor()
+	// recapfeedback.DefaultText holds the default value on creation for the text field.
+	recapfeedback.DefaultText = recapfeedbackDescText.Default.(string)
+	// recapfeedbackDescCreatedAt is the schema descriptor for created_at field.
+	recapfeedbackDescCreatedAt := recapfeedbackFields[5].Descriptor()
+	// recapfeedback.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recapfeedback.DefaultCreatedAt = recapfeedbackDescCreatedAt.Default.(func() int64)
+	// recapfeedbackDescUpdatedAt is the schema descriptor for updated_at field.
+	recapfeedbackDescUpdatedAt := recapfeedbackFields[6].Descriptor()
+	// recapfeedback.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recapfeedback.DefaultUpdatedAt = recapfeedbackDescUpdatedAt.Default.(func() int64)
+	// recapfeedbackDescID is the schema descriptor for id field.
+	recapfeedbackDescID := recapfeedbackFields[0].Descriptor()
+	// recapfeedback.DefaultID holds the default value on creation for the id field.
+	recapfeedback.DefaultID = recapfeedbackDescID.Default.(func() uuid.UUID)
 	sentmessagesFields := schema.SentMessages{}.Fields()
 	_ = sentmessagesFields
 	// sentmessagesDescChatID is the schema descriptor for chat_id field.
@@ -428,16 +535,170 @@ func init() {
 	telegramchatrecapsoptionsDescPinAutoRecapMessage := telegramchatrecapsoptionsFields[5].Descriptor()
 	// telegramchatrecapsoptions.DefaultPinAutoRecapMessage holds the default value on creation for the pin_auto_recap_message field.
 	telegramchatrecapsoptions.DefaultPinAutoRecapMessage = telegramchatrecapsoptionsDescPinAutoRecapMessage.Default.(bool)
+	// telegramchatrecapsoptionsDescPinAutoRecapMessageSilently is the schema descriptor for pin_auto_recap_message_silently field.
+	telegramchatrecapsoptionsDescPinAutoRecapMessageSilently := telegramchatrecapsoptionsFields[6].Descriptor()
+	// telegramchatrecapsoptions.DefaultPinAutoRecapMessageSilently holds the default value on creation for the pin_auto_recap_message_silently field.
+	telegramchatrecapsoptions.DefaultPinAutoRecapMessageSilently = telegramchatrecapsoptionsDescPinAutoRecapMessageSilently.Default.(bool)
+	// telegramchatrecapsoptionsDescRecapDisclaimer is the schema descriptor for recap_disclaimer field.
+	telegramchatrecapsoptionsDescRecapDisclaimer := telegramchatrecapsoptionsFields[7].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapDisclaimer holds the default value on creation for the recap_disclaimer field.
+	telegramchatrecapsoptions.DefaultRecapDisclaimer = telegramchatrecapsoptionsDescRecapDisclaimer.Default.(string)
+	// telegramchatrecapsoptionsDescRecapTargetChatID is the schema descriptor for recap_target_chat_id field.
+	telegramchatrecapsoptionsDescRecapTargetChatID := telegramchatrecapsoptionsFields[8].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapTargetChatID holds the default value on creation for the recap_target_chat_id field.
+	telegramchatrecapsoptions.DefaultRecapTargetChatID = telegramchatrecapsoptionsDescRecapTargetChatID.Default.(int64)
+	// telegramchatrecapsoptionsDescRecapPersona is the schema descriptor for recap_persona field.
+	telegramchatrecapsoptionsDescRecapPersona := telegramchatrecapsoptionsFields[9].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapPersona holds the default value on creation for the recap_persona field.
+	telegramchatrecapsoptions.DefaultRecapPersona = telegramchatrecapsoptionsDescRecapPersona.Default.(string)
+	// telegramchatrecapsoptionsDescIncludeBotMessages is the schema descriptor for include_bot_messages field.
+	telegramchatrecapsoptionsDescIncludeBotMessages := telegramchatrecapsoptionsFields[10].Descriptor()
+	// telegramchatrecapsoptions.DefaultIncludeBotMessages holds the default value on creation for the include_bot_messages field.
+	telegramchatrecapsoptions.DefaultIncludeBotMessages = telegramchatrecapsoptionsDescIncludeBotMessages.Default.(bool)
+	// telegramchatrecapsoptionsDescAutoRecapsSnoozedUntil is the schema descriptor for auto_recaps_snoozed_until field.
+	telegramchatrecapsoptionsDescAutoRecapsSnoozedUntil := telegramchatrecapsoptionsFields[11].Descriptor()
+	// telegramchatrecapsoptions.DefaultAutoRecapsSnoozedUntil holds the default value on creation for the auto_recaps_snoozed_until field.
+	telegramchatrecapsoptions.DefaultAutoRecapsSnoozedUntil = telegramchatrecapsoptionsDescAutoRecapsSnoozedUntil.Default.(int64)
+	// telegramchatrecapsoptionsDescQuietNoticeEnabled is the schema descriptor for quiet_notice_enabled field.
+	telegramchatrecapsoptionsDescQuietNoticeEnabled := telegramchatrecapsoptionsFields[12].Descriptor()
+	// telegramchatrecapsoptions.DefaultQuietNoticeEnabled holds the default value on creation for the quiet_notice_enabled field.
+	telegramchatrecapsoptions.DefaultQuietNoticeEnabled = telegramchatrecapsoptionsDescQuietNoticeEnabled.Default.(bool)
+	// telegramchatrecapsoptionsDescLastQuietNoticeAt is the schema descriptor for last_quiet_notice_at field.
+	telegramchatrecapsoptionsDescLastQuietNoticeAt := telegramchatrecapsoptionsFields[13].Descriptor()
+	// telegramchatrecapsoptions.DefaultLastQuietNoticeAt holds the default value on creation for the last_quiet_notice_at field.
+	telegramchatrecapsoptions.DefaultLastQuietNoticeAt = telegramchatrecapsoptionsDescLastQuietNoticeAt.Default.(int64)
+	// telegramchatrecapsoptionsDescPerTopicMessages is the schema descriptor for per_topic_messages field.
+	telegramchatrecapsoptionsDescPerTopicMessages := telegramchatrecapsoptionsFields[14].Descriptor()
+	// telegramchatrecapsoptions.DefaultPerTopicMessages holds the default value on creation for the per_topic_messages field.
+	telegramchatrecapsoptions.DefaultPerTopicMessages = telegramchatrecapsoptionsDescPerTopicMessages.Default.(bool)
+	// telegramchatrecapsoptionsDescSummaryTemperature is the schema descriptor for summary_temperature field.
+	telegramchatrecapsoptionsDescSummaryTemperature := telegramchatrecapsoptionsFields[15].Descriptor()
+	// telegramchatrecapsoptions.DefaultSummaryTemperature holds the default value on creation for the summary_temperature field.
+	telegramchatrecapsoptions.DefaultSummaryTemperature = telegramchatrecapsoptionsDescSummaryTemperature.Default.(float64)
+	// telegramchatrecapsoptionsDescRecapOutputFormat is the schema descriptor for recap_output_format field.
+	telegramchatrecapsoptionsDescRecapOutputFormat := telegramchatrecapsoptionsFields[16].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapOutputFormat holds the default value on creation for the recap_output_format field.
+	telegramchatrecapsoptions.DefaultRecapOutputFormat = telegramchatrecapsoptionsDescRecapOutputFormat.Default.(int)
+	// telegramchatrecapsoptionsDescMinMessageLengthForSummary is the schema descriptor for min_message_length_for_summary field.
+	telegramchatrecapsoptionsDescMinMessageLengthForSummary := telegramchatrecapsoptionsFields[17].Descriptor()
+	// telegramchatrecapsoptions.DefaultMinMessageLengthForSummary holds the default value on creation for the min_message_length_for_summary field.
+	telegramchatrecapsoptions.DefaultMinMessageLengthForSummary = telegramchatrecapsoptionsDescMinMessageLengthForSummary.Default.(int)
+	// telegramchatrecapsoptionsDescCountShortMessagesForActivity is the schema descriptor for count_short_messages_for_activity field.
+	telegramchatrecapsoptionsDescCountShortMessagesForActivity := telegramchatrecapsoptionsFields[18].Descriptor()
+	// telegramchatrecapsoptions.DefaultCountShortMessagesForActivity holds the default value on creation for the count_short_messages_for_activity field.
+	telegramchatrecapsoptions.DefaultCountShortMessagesForActivity = telegramchatrecapsoptionsDescCountShortMessagesForActivity.Default.(bool)
+	// telegramchatrecapsoptionsDescSummaryLanguages is the schema descriptor for summary_languages field.
+	telegramchatrecapsoptionsDescSummaryLanguages := telegramchatrecapsoptionsFields[19].Descriptor()
+	// telegramchatrecapsoptions.DefaultSummaryLanguages holds the default value on creation for the summary_languages field.
+	telegramchatrecapsoptions.DefaultSummaryLanguages = telegramchatrecapsoptionsDescSummaryLanguages.Default.(string)
+	// telegramchatrecapsoptionsDescSubscribeMinMembershipDays is the schema descriptor for subscribe_min_membership_days field.
+	telegramchatrecapsoptionsDescSubscribeMinMembershipDays := telegramchatrecapsoptionsFields[20].Descriptor()
+	// telegramchatrecapsoptions.DefaultSubscribeMinMembershipDays holds the default value on creation for the subscribe_min_membership_days field.
+	telegramchatrecapsoptions.DefaultSubscribeMinMembershipDays = telegramchatrecapsoptionsDescSubscribeMinMembershipDays.Default.(int)
+	// telegramchatrecapsoptionsDescSubscribeMinMemberStatus is the schema descriptor for subscribe_min_member_status field.
+	telegramchatrecapsoptionsDescSubscribeMinMemberStatus := telegramchatrecapsoptionsFields[21].Descriptor()
+	// telegramchatrecapsoptions.DefaultSubscribeMinMemberStatus holds the default value on creation for the subscribe_min_member_status field.
+	telegramchatrecapsoptions.DefaultSubscribeMinMemberStatus = telegramchatrecapsoptionsDescSubscribeMinMemberStatus.Default.(int)
+	// telegramchatrecapsoptionsDescTopKeywordsCount is the schema descriptor for top_keywords_count field.
+	telegramchatrecapsoptionsDescTopKeywordsCount := telegramchatrecapsoptionsFields[22].Descriptor()
+	// telegramchatrecapsoptions.DefaultTopKeywordsCount holds the default value on creation for the top_keywords_count field.
+	telegramchatrecapsoptions.DefaultTopKeywordsCount = telegramchatrecapsoptionsDescTopKeywordsCount.Default.(int)
+	// telegramchatrecapsoptionsDescDedupForwards is the schema descriptor for dedup_forwards field.
+	telegramchatrecapsoptionsDescDedupForwards := telegramchatrecapsoptionsFields[23].Descriptor()
+	// telegramchatrecapsoptions.DefaultDedupForwards holds the default value on creation for the dedup_forwards field.
+	telegramchatrecapsoptions.DefaultDedupForwards = telegramchatrecapsoptionsDescDedupForwards.Default.(bool)
+	// telegramchatrecapsoptionsDescStoreMessageContent is the schema descriptor for store_message_content field.
+	telegramchatrecapsoptionsDescStoreMessageContent := telegramchatrecapsoptionsFields[24].Descriptor()
+	// telegramchatrecapsoptions.DefaultStoreMessageContent holds the default value on creation for the store_message_content field.
+	telegramchatrecapsoptions.DefaultStoreMessageContent = telegramchatrecapsoptionsDescStoreMessageContent.Default.(bool)
+	// telegramchatrecapsoptionsDescAnonymizeParticipants is the schema descriptor for anonymize_participants field.
+	telegramchatrecapsoptionsDescAnonymizeParticipants := telegramchatrecapsoptionsFields[25].Descriptor()
+	// telegramchatrecapsoptions.DefaultAnonymizeParticipants holds the default value on creation for the anonymize_participants field.
+	telegramchatrecapsoptions.DefaultAnonymizeParticipants = telegramchatrecapsoptionsDescAnonymizeParticipants.Default.(bool)
+	// telegramchatrecapsoptionsDescManualRecapPrivate is the schema descriptor for manual_recap_private field.
+	telegramchatrecapsoptionsDescManualRecapPrivate := telegramchatrecapsoptionsFields[26].Descriptor()
+	// telegramchatrecapsoptions.DefaultManualRecapPrivate holds the default value on creation for the manual_recap_private field.
+	telegramchatrecapsoptions.DefaultManualRecapPrivate = telegramchatrecapsoptionsDescManualRecapPrivate.Default.(bool)
+	// telegramchatrecapsoptionsDescRecapInProgressTemplate is the schema descriptor for recap_in_progress_template field.
+	telegramchatrecapsoptionsDescRecapInProgressTemplate := telegramchatrecapsoptionsFields[27].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapInProgressTemplate holds the default value on creation for the recap_in_progress_template field.
+	telegramchatrecapsoptions.DefaultRecapInProgressTemplate = telegramchatrecapsoptionsDescRecapInProgressTemplate.Default.(string)
+	// telegramchatrecapsoptionsDescExcludedMessageTypes is the schema descriptor for excluded_message_types field.
+	telegramchatrecapsoptionsDescExcludedMessageTypes := telegramchatrecapsoptionsFields[28].Descriptor()
+	// telegramchatrecapsoptions.DefaultExcludedMessageTypes holds the default value on creation for the excluded_message_types field.
+	telegramchatrecapsoptions.DefaultExcludedMessageTypes = telegramchatrecapsoptionsDescExcludedMessageTypes.Default.(int)
+	// telegramchatrecapsoptionsDescRelatedMessagesCount is the schema descriptor for related_messages_count field.
+	telegramchatrecapsoptionsDescRelatedMessagesCount := telegramchatrecapsoptionsFields[30].Descriptor()
+	// telegramchatrecapsoptions.DefaultRelatedMessagesCount holds the default value on creation for the related_messages_count field.
+	telegramchatrecapsoptions.DefaultRelatedMessagesCount = telegramchatrecapsoptionsDescRelatedMessagesCount.Default.(int)
+	// telegramchatrecapsoptionsDescIncrementalRecap is the schema descriptor for incremental_recap field.
+	telegramchatrecapsoptionsDescIncrementalRecap := telegramchatrecapsoptionsFields[31].Descriptor()
+	// telegramchatrecapsoptions.DefaultIncrementalRecap holds the default value on creation for the incremental_recap field.
+	telegramchatrecapsoptions.DefaultIncrementalRecap = telegramchatrecapsoptionsDescIncrementalRecap.Default.(bool)
+	// telegramchatrecapsoptionsDescRecapLinkPreview is the schema descriptor for recap_link_preview field.
+	telegramchatrecapsoptionsDescRecapLinkPreview := telegramchatrecapsoptionsFields[32].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapLinkPreview holds the default value on creation for the recap_link_preview field.
+	telegramchatrecapsoptions.DefaultRecapLinkPreview = telegramchatrecapsoptionsDescRecapLinkPreview.Default.(bool)
+	// telegramchatrecapsoptionsDescAutoUnpinAfterSeconds is the schema descriptor for auto_unpin_after_seconds field.
+	telegramchatrecapsoptionsDescAutoUnpinAfterSeconds := telegramchatrecapsoptionsFields[33].Descriptor()
+	// telegramchatrecapsoptions.DefaultAutoUnpinAfterSeconds holds the default value on creation for the auto_unpin_after_seconds field.
+	telegramchatrecapsoptions.DefaultAutoUnpinAfterSeconds = telegramchatrecapsoptionsDescAutoUnpinAfterSeconds.Default.(int64)
+	// telegramchatrecapsoptionsDescRecapThreadID is the schema descriptor for recap_thread_id field.
+	telegramchatrecapsoptionsDescRecapThreadID := telegramchatrecapsoptionsFields[34].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapThreadID holds the default value on creation for the recap_thread_id field.
+	telegramchatrecapsoptions.DefaultRecapThreadID = telegramchatrecapsoptionsDescRecapThreadID.Default.(int)
+	// telegramchatrecapsoptionsDescManualRecapRateLimitExemptAdmins is the schema descriptor for manual_recap_rate_limit_exempt_admins field.
+	telegramchatrecapsoptionsDescManualRecapRateLimitExemptAdmins := telegramchatrecapsoptionsFields[35].Descriptor()
+	// telegramchatrecapsoptions.DefaultManualRecapRateLimitExemptAdmins holds the default value on creation for the manual_recap_rate_limit_exempt_admins field.
+	telegramchatrecapsoptions.DefaultManualRecapRateLimitExemptAdmins = telegramchatrecapsoptionsDescManualRecapRateLimitExemptAdmins.Default.(bool)
+	// telegramchatrecapsoptionsDescManualRecapMinRole is the schema descriptor for manual_recap_min_role field.
+	telegramchatrecapsoptionsDescManualRecapMinRole := telegramchatrecapsoptionsFields[36].Descriptor()
+	// telegramchatrecapsoptions.DefaultManualRecapMinRole holds the default value on creation for the manual_recap_min_role field.
+	telegramchatrecapsoptions.DefaultManualRecapMinRole = telegramchatrecapsoptionsDescManualRecapMinRole.Default.(int)
+	// telegramchatrecapsoptionsDescApprovalRequired is the schema descriptor for approval_required field.
+	telegramchatrecapsoptionsDescApprovalRequired := telegramchatrecapsoptionsFields[37].Descriptor()
+	// telegramchatrecapsoptions.DefaultApprovalRequired holds the default value on creation for the approval_required field.
+	telegramchatrecapsoptions.DefaultApprovalRequired = telegramchatrecapsoptionsDescApprovalRequired.Default.(bool)
+	// telegramchatrecapsoptionsDescCollectOnly is the schema descriptor for collect_only field.
+	telegramchatrecapsoptionsDescCollectOnly := telegramchatrecapsoptionsFields[38].Descriptor()
+	// telegramchatrecapsoptions.DefaultCollectOnly holds the default value on creation for the collect_only field.
+	telegramchatrecapsoptions.DefaultCollectOnly = telegramchatrecapsoptionsDescCollectOnly.Default.(bool)
+	// telegramchatrecapsoptionsDescRecapDocumentAttachment is the schema descriptor for recap_document_attachment field.
+	telegramchatrecapsoptionsDescRecapDocumentAttachment := telegramchatrecapsoptionsFields[39].Descriptor()
+	// telegramchatrecapsoptions.DefaultRecapDocumentAttachment holds the default value on creation for the recap_document_attachment field.
+	telegramchatrecapsoptions.DefaultRecapDocumentAttachment = telegramchatrecapsoptionsDescRecapDocumentAttachment.Default.(bool)
+	// telegramchatrecapsoptionsDescSummaryMaxTokens is the schema descriptor for summary_max_tokens field.
+	telegramchatrecapsoptionsDescSummaryMaxTokens := telegramchatrecapsoptionsFields[40].Descriptor()
+	// telegramchatrecapsoptions.DefaultSummaryMaxTokens holds the default value on creation for the summary_max_tokens field.
+	telegramchatrecapsoptions.DefaultSummaryMaxTokens = telegramchatrecapsoptionsDescSummaryMaxTokens.Default.(int)
 	// telegramchatrecapsoptionsDescCreatedAt is the schema descriptor for created_at field.
-	telegramchatrecapsoptionsDescCreatedAt := telegramchatrecapsoptionsFields[6].Descriptor()
+	telegramchatrecapsoptionsDescCreatedAt := telegramchatrecapsoptionsFields[41].Descriptor()
 	// telegramchatrecapsoptions.DefaultCreatedAt holds the default value on creation for the created_at field.
 	telegramchatrecapsoptions.DefaultCreatedAt = telegramchatrecapsoptionsDescCreatedAt.Default.(func() int64)
 	// telegramchatrecapsoptionsDescUpdatedAt is the schema descriptor for updated_at field.
-	telegramchatrecapsoptionsDescUpdatedAt := telegramchatrecapsoptionsFields[7].Descriptor()
+	telegramchatrecapsoptionsDescUpdatedAt := telegramchatrecapsoptionsFields[42].Descriptor()
 	// telegramchatrecapsoptions.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	telegramchatrecapsoptions.DefaultUpdatedAt = telegramchatrecapsoptionsDescUpdatedAt.Default.(func() int64)
 	// telegramchatrecapsoptionsDescID is the schema descriptor for id field.
 	telegramchatrecapsoptionsDescID := telegramchatrecapsoptionsFields[0].Descriptor()
 	// telegramchatrecapsoptions.DefaultID holds the default value on creation for the id field.
 	telegramchatrecapsoptions.DefaultID = telegramchatrecapsoptionsDescID.Default.(func() uuid.UUID)
+	telegramuserpreferencesFields := schema.TelegramUserPreferences{}.Fields()
+	_ = telegramuserpreferencesFields
+	// telegramuserpreferencesDescBatchPrivateRecaps is the schema descriptor for batch_private_recaps field.
+	telegramuserpreferencesDescBatchPrivateRecaps := telegramuserpreferencesFields[2].Descriptor()
+	// telegramuserpreferences.DefaultBatchPrivateRecaps holds the default value on creation for the batch_private_recaps field.
+	telegramuserpreferences.DefaultBatchPrivateRecaps = telegramuserpreferencesDescBatchPrivateRecaps.Default.(bool)
+	// telegramuserpreferencesDescCreatedAt is the schema descriptor for created_at field.
+	telegramuserpreferencesDescCreatedAt := telegramuserpreferencesFields[3].Descriptor()
+	// telegramuserpreferences.DefaultCreatedAt holds the default value on creation for the created_at field.
+	telegramuserpreferences.DefaultCreatedAt = telegramuserpreferencesDescCreatedAt.Default.(func() int64)
+	// telegramuserpreferencesDescUpdatedAt is the schema descriptor for updated_at field.
+	telegramuserpreferencesDescUpdatedAt := telegramuserpreferencesFields[4].Descriptor()
+	// telegramuserpreferences.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	telegramuserpreferences.DefaultUpdatedAt = telegramuserpreferencesDescUpdatedAt.Default.(func() int64)
+	// telegramuserpreferencesDescID is the schema descriptor for id field.
+	telegramuserpreferencesDescID := telegramuserpreferencesFields[0].Descriptor()
+	// telegramuserpreferences.DefaultID holds the default value on creation for the id field.
+	telegramuserpreferences.DefaultID = telegramuserpreferencesDescID.Default.(func() uuid.UUID)
 }
@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
 )
 
@@ -24,6 +25,7 @@ func (ChatHistories) Fields() []ent.Field {
 		field.Int64("user_id").Default(0),
 		field.Text("username").Default(""),
 		field.Text("full_name").Default(""),
+		field.Bool("is_bot").Default(false),
 		field.Text("text").Default(""),
 		field.Int64("replied_to_message_id").Default(0),
 		field.Int64("replied_to_user_id").Default(0),
@@ -34,6 +36,12 @@ func (ChatHistories) Fields() []ent.Field {
 		field.Int64("chatted_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 		field.Bool("embedded").Default(false),
 		field.Int("from_platform").Default(0),
+		field.Text("poll_id").Default(""),
+		field.Text("poll_question").Default(""),
+		field.JSON("poll_options", []tgbotapi.PollOption{}).Optional(),
+		field.Int("poll_total_voter_count").Default(0),
+		field.Bool("poll_is_closed").Default(false),
+		field.Int("message_types").Default(0),
 		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 	}
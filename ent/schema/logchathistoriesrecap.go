@@ -26,6 +26,8 @@ func (LogChatHistoriesRecap) Fields() []ent.Field {
 		field.Int("total_token_usage").Default(0),
 		field.Int("recap_type").Default(0),
 		field.String("model_name").Default(""),
+		field.Int("window_hours").Default(0),
+		field.Bool("is_auto_recap").Default(false),
 		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 	}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LogChatHistoriesRecapFailures holds the schema definition for the LogChatHistoriesRecapFailures entity.
+type LogChatHistoriesRecapFailures struct {
+	ent.Schema
+}
+
+// Fields of the LogChatHistoriesRecapFailures.
+func (LogChatHistoriesRecapFailures) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Int64("chat_id").Default(0),
+		field.Int("window_hours").Default(0),
+		field.Int64("window_since").Default(0),
+		field.Int64("window_until").Default(0),
+		field.Text("error").Default(""),
+		field.Bool("is_auto_recap").Default(false),
+		field.Bool("resolved").Default(false),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Edges of the LogChatHistoriesRecapFailures.
+func (LogChatHistoriesRecapFailures) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RecapFeedback holds the schema definition for the RecapFeedback entity.
+type RecapFeedback struct {
+	ent.Schema
+}
+
+// Fields of the RecapFeedback.
+func (RecapFeedback) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Int64("chat_id").Default(0),
+		field.UUID("log_id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.Int64("user_id").Default(0),
+		field.Text("text").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Edges of the RecapFeedback.
+func (RecapFeedback) Edges() []ent.Edge {
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 )
 
 // TelegramChatRecapsOptions holds the schema definition for the TelegramChatRecapsOptions entity.
@@ -22,6 +24,41 @@ func (TelegramChatRecapsOptions) Fields() []ent.Field {
 		field.Int64("manual_recap_rate_per_seconds").Default(0),
 		field.Int("auto_recap_rates_per_day").Default(0),
 		field.Bool("pin_auto_recap_message").Default(false),
+		field.Bool("pin_auto_recap_message_silently").Default(true),
+		field.String("recap_disclaimer").Default(""),
+		field.Int64("recap_target_chat_id").Default(0),
+		field.String("recap_persona").Default(""),
+		field.Bool("include_bot_messages").Default(false),
+		field.Int64("auto_recaps_snoozed_until").Default(0),
+		field.Bool("quiet_notice_enabled").Default(false),
+		field.Int64("last_quiet_notice_at").Default(0),
+		field.Bool("per_topic_messages").Default(false),
+		field.Float("summary_temperature").Default(-1),
+		field.Int("recap_output_format").Default(0),
+		field.Int("min_message_length_for_summary").Default(0),
+		field.Bool("count_short_messages_for_activity").Default(true),
+		field.String("summary_languages").Default(""),
+		field.Int("subscribe_min_membership_days").Default(0),
+		field.Int("subscribe_min_member_status").Default(0),
+		field.Int("top_keywords_count").Default(0),
+		field.Bool("dedup_forwards").Default(false),
+		field.Bool("store_message_content").Default(true),
+		field.Bool("anonymize_participants").Default(false),
+		field.Bool("manual_recap_private").Default(false),
+		field.String("recap_in_progress_template").Default(""),
+		field.Int("excluded_message_types").Default(int(tgchat.DefaultExcludedMessageTypes)),
+		field.JSON("recap_weekdays", []time.Weekday{}).Optional(),
+		field.Int("related_messages_count").Default(0),
+		field.Bool("incremental_recap").Default(false),
+		field.Bool("recap_link_preview").Default(true),
+		field.Int64("auto_unpin_after_seconds").Default(0),
+		field.Int("recap_thread_id").Default(0),
+		field.Bool("manual_recap_rate_limit_exempt_admins").Default(false),
+		field.Int("manual_recap_min_role").Default(0),
+		field.Bool("approval_required").Default(false),
+		field.Bool("collect_only").Default(false),
+		field.Bool("recap_document_attachment").Default(false),
+		field.Int("summary_max_tokens").Default(0),
 		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
 	}
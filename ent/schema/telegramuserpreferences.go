@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TelegramUserPreferences holds the schema definition for the TelegramUserPreferences entity.
+type TelegramUserPreferences struct {
+	ent.Schema
+}
+
+// Fields of the TelegramUserPreferences.
+func (TelegramUserPreferences) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Int64("user_id").Unique(),
+		field.Bool("batch_private_recaps").Default(false),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+	}
+}
+
+// Edges of the TelegramUserPreferences.
+func (TelegramUserPreferences) Edges() []ent.Edge {
+	return nil
+}
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -27,6 +29,76 @@ type TelegramChatRecapsOptions struct {
 	AutoRecapRatesPerDay int `json:"auto_recap_rates_per_day,omitempty"`
 	// PinAutoRecapMessage holds the value of the "pin_auto_recap_message" field.
 	PinAutoRecapMessage bool `json:"pin_auto_recap_message,omitempty"`
+	// PinAutoRecapMessageSilently holds the value of the "pin_auto_recap_message_silently" field.
+	PinAutoRecapMessageSilently bool `json:"pin_auto_recap_message_silently,omitempty"`
+	// RecapDisclaimer holds the value of the "recap_disclaimer" field.
+	RecapDisclaimer string `json:"recap_disclaimer,omitempty"`
+	// RecapTargetChatID holds the value of the "recap_target_chat_id" field.
+	RecapTargetChatID int64 `json:"recap_target_chat_id,omitempty"`
+	// RecapPersona holds the value of the "recap_persona" field.
+	RecapPersona string `json:"recap_persona,omitempty"`
+	// IncludeBotMessages holds the value of the "include_bot_messages" field.
+	IncludeBotMessages bool `json:"include_bot_messages,omitempty"`
+	// AutoRecapsSnoozedUntil holds the value of the "auto_recaps_snoozed_until" field.
+	AutoRecapsSnoozedUntil int64 `json:"auto_recaps_snoozed_until,omitempty"`
+	// QuietNoticeEnabled holds the value of the "quiet_notice_enabled" field.
+	QuietNoticeEnabled bool `json:"quiet_notice_enabled,omitempty"`
+	// LastQuietNoticeAt holds the value of the "last_quiet_notice_at" field.
+	LastQuietNoticeAt int64 `json:"last_quiet_notice_at,omitempty"`
+	// PerTopicMessages holds the value of the "per_topic_messages" field.
+	PerTopicMessages bool `json:"per_topic_messages,omitempty"`
+	// SummaryTemperature holds the value of the "summary_temperature" field.
+	SummaryTemperature float64 `json:"summary_temperature,omitempty"`
+	// RecapOutputFormat holds the value of the "recap_output_format" field.
+	RecapOutputFormat int `json:"recap_output_format,omitempty"`
+	// MinMessageLengthForSummary holds the value of the "min_message_length_for_summary" field.
+	MinMessageLengthForSummary int `json:"min_message_length_for_summary,omitempty"`
+	// CountShortMessagesForActivity holds the value of the "count_short_messages_for_activity" field.
+	CountShortMessagesForActivity bool `json:"count_short_messages_for_activity,omitempty"`
+	// SummaryLanguages holds the value of the "summary_languages" field.
+	SummaryLanguages string `json:"summary_languages,omitempty"`
+	// SubscribeMinMembershipDays holds the value of the "subscribe_min_membership_days" field.
+	SubscribeMinMembershipDays int `json:"subscribe_min_membership_days,omitempty"`
+	// SubscribeMinMemberStatus holds the value of the "subscribe_min_member_status" field.
+	SubscribeMinMemberStatus int `json:"subscribe_min_member_status,omitempty"`
+	// TopKeywordsCount holds the value of the "top_keywords_count" field.
+	TopKeywordsCount int `json:"top_keywords_count,omitempty"`
+	// DedupForwards holds the value of the "dedup_forwards" field.
+	DedupForwards bool `json:"dedup_forwards,omitempty"`
+	// StoreMessageContent holds the value of the "store_message_content" field.
+	StoreMessageContent bool `json:"store_message_content,omitempty"`
+	// AnonymizeParticipants holds the value of the "anonymize_participants" field.
+	AnonymizeParticipants bool `json:"anonymize_participants,omitempty"`
+	// ManualRecapPrivate holds the value of the "manual_recap_private" field.
+	ManualRecapPrivate bool `json:"manual_recap_private,omitempty"`
+	// RecapInProgressTemplate holds the value of the "recap_in_progress_template" field.
+	RecapInProgressTemplate string `json:"recap_in_progress_template,omitempty"`
+	// ExcludedMessageTypes holds the value of the "excluded_message_types" field.
+	ExcludedMessageTypes int `json:"excluded_message_types,omitempty"`
+	// RecapWeekdays holds the value of the "recap_weekdays" field.
+	RecapWeekdays []time.Weekday `json:"recap_weekdays,omitempty"`
+	// RelatedMessagesCount holds the value of the "related_messages_count" field.
+	RelatedMessagesCount int `json:"related_messages_count,omitempty"`
+	// IncrementalRecap holds the value of the "incremental_recap" field.
+	IncrementalRecap bool `json:"incremental_recap,omitempty"`
+	// RecapLinkPreview holds the value of the "recap_link_preview" field.
+	RecapLinkPreview bool `json:"recap_link_preview,omitempty"`
+	// AutoUnpinAfterSeconds holds the value of the "auto_unpin_after_seconds" field.
+	AutoUnpinAfterSeconds int64 `json:"auto_unpin_after_seconds,omitempty"`
+	// RecapThreadID holds the value of the "recap_thread_id" field.
+	RecapThreadID int `json:"recap_thread_id,omitempty"`
+	// ManualRecapRateLimitExemptAdmins holds the value of the "manual_recap_rate_limit_exempt_admins" field.
+	ManualRecapRateLimitExemptAdmins bool `json:"manual_recap_rate_limit_exempt_admins,omitempty"`
+	// ManualRecapMinRole holds the value of the "manual_recap_min_role" field.
+	ManualRecapMinRole int `json:"manual_recap_min_role,omitempty"`
+	// ApprovalRequired holds the value of the "approval_required" field.
+	ApprovalRequired bool `json:"approval_required,omitempty"`
+	// CollectOnly holds the value of the "collect_only" field.
+	CollectOnly bool `json:"collect_only,omitempty"`
+	// RecapDocumentAttachment holds the value of the "recap_document_attachment" field.
+	RecapDocumentAttachment bool `json:"recap_document_attachment,omitempty"`
+	// SummaryMaxTokens holds the value of the "summary_max_tokens" field.
+	SummaryMaxTokens int `json:"summary_max_tokens,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -39,10 +111,16 @@ func (*TelegramChatRecapsOptions) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case telegramchatrecapsoptions.FieldPinAutoRecapMessage:
+		case telegramchatrecapsoptions.FieldRecapWeekdays:
+			values[i] = new([]byte)
+		case telegramchatrecapsoptions.FieldPinAutoRecapMessage, telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently, telegramchatrecapsoptions.FieldIncludeBotMessages, telegramchatrecapsoptions.FieldQuietNoticeEnabled, telegramchatrecapsoptions.FieldPerTopicMessages, telegramchatrecapsoptions.FieldCountShortMessagesForActivity, telegramchatrecapsoptions.FieldDedupForwards, telegramchatrecapsoptions.FieldStoreMessageContent, telegramchatrecapsoptions.FieldAnonymizeParticipants, telegramchatrecapsoptions.FieldManualRecapPrivate, telegramchatrecapsoptions.FieldIncrementalRecap, telegramchatrecapsoptions.FieldRecapLinkPreview, telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins, telegramchatrecapsoptions.FieldApprovalRequired, telegramchatrecapsoptions.FieldCollectOnly, telegramchatrecapsoptions.FieldRecapDocumentAttachment:
 			values[i] = new(sql.NullBool)
-		case telegramchatrecapsoptions.FieldChatID, telegramchatrecapsoptions.FieldAutoRecapSendMode, telegramchatrecapsoptions.FieldManualRecapRatePerSeconds, telegramchatrecapsoptions.FieldAutoRecapRatesPerDay, telegramchatrecapsoptions.FieldCreatedAt, telegramchatrecapsoptions.FieldUpdatedAt:
+		case telegramchatrecapsoptions.FieldSummaryTemperature:
+			values[i] = new(sql.NullFloat64)
+		case telegramchatrecapsoptions.FieldChatID, telegramchatrecapsoptions.FieldAutoRecapSendMode, telegramchatrecapsoptions.FieldManualRecapRatePerSeconds, telegramchatrecapsoptions.FieldAutoRecapRatesPerDay, telegramchatrecapsoptions.FieldRecapTargetChatID, telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil, telegramchatrecapsoptions.FieldLastQuietNoticeAt, telegramchatrecapsoptions.FieldRecapOutputFormat, telegramchatrecapsoptions.FieldMinMessageLengthForSummary, telegramchatrecapsoptions.FieldSubscribeMinMembershipDays, telegramchatrecapsoptions.FieldSubscribeMinMemberStatus, telegramchatrecapsoptions.FieldTopKeywordsCount, telegramchatrecapsoptions.FieldExcludedMessageTypes, telegramchatrecapsoptions.FieldRelatedMessagesCount, telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds, telegramchatrecapsoptions.FieldRecapThreadID, telegramchatrecapsoptions.FieldManualRecapMinRole, telegramchatrecapsoptions.FieldSummaryMaxTokens, telegramchatrecapsoptions.FieldCreatedAt, telegramchatrecapsoptions.FieldUpdatedAt:
 			values[i] = new(sql.NullInt64)
+		case telegramchatrecapsoptions.FieldRecapDisclaimer, telegramchatrecapsoptions.FieldRecapPersona, telegramchatrecapsoptions.FieldSummaryLanguages, telegramchatrecapsoptions.FieldRecapInProgressTemplate:
+			values[i] = new(sql.NullString)
 		case telegramchatrecapsoptions.FieldID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -96,6 +174,218 @@ func (_m *TelegramChatRecapsOptions) assignValues(columns []string, values []any
 			} else if value.Valid {
 				_m.PinAutoRecapMessage = value.Bool
 			}
+		case telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pin_auto_recap_message_silently", values[i])
+			} else if value.Valid {
+				_m.PinAutoRecapMessageSilently = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldRecapDisclaimer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_disclaimer", values[i])
+			} else if value.Valid {
+				_m.RecapDisclaimer = value.String
+			}
+		case telegramchatrecapsoptions.FieldRecapTargetChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_target_chat_id", values[i])
+			} else if value.Valid {
+				_m.RecapTargetChatID = value.Int64
+			}
+		case telegramchatrecapsoptions.FieldRecapPersona:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_persona", values[i])
+			} else if value.Valid {
+				_m.RecapPersona = value.String
+			}
+		case telegramchatrecapsoptions.FieldIncludeBotMessages:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_bot_messages", values[i])
+			} else if value.Valid {
+				_m.IncludeBotMessages = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_recaps_snoozed_until", values[i])
+			} else if value.Valid {
+				_m.AutoRecapsSnoozedUntil = value.Int64
+			}
+		case telegramchatrecapsoptions.FieldQuietNoticeEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_notice_enabled", values[i])
+			} else if value.Valid {
+				_m.QuietNoticeEnabled = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldLastQuietNoticeAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_quiet_notice_at", values[i])
+			} else if value.Valid {
+				_m.LastQuietNoticeAt = value.Int64
+			}
+		case telegramchatrecapsoptions.FieldPerTopicMessages:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field per_topic_messages", values[i])
+			} else if value.Valid {
+				_m.PerTopicMessages = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldSummaryTemperature:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_temperature", values[i])
+			} else if value.Valid {
+				_m.SummaryTemperature = value.Float64
+			}
+		case telegramchatrecapsoptions.FieldRecapOutputFormat:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_output_format", values[i])
+			} else if value.Valid {
+				_m.RecapOutputFormat = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldMinMessageLengthForSummary:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_message_length_for_summary", values[i])
+			} else if value.Valid {
+				_m.MinMessageLengthForSummary = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldCountShortMessagesForActivity:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field count_short_messages_for_activity", values[i])
+			} else if value.Valid {
+				_m.CountShortMessagesForActivity = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldSummaryLanguages:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_languages", values[i])
+			} else if value.Valid {
+				_m.SummaryLanguages = value.String
+			}
+		case telegramchatrecapsoptions.FieldSubscribeMinMembershipDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscribe_min_membership_days", values[i])
+			} else if value.Valid {
+				_m.SubscribeMinMembershipDays = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldSubscribeMinMemberStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscribe_min_member_status", values[i])
+			} else if value.Valid {
+				_m.SubscribeMinMemberStatus = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldTopKeywordsCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field top_keywords_count", values[i])
+			} else if value.Valid {
+				_m.TopKeywordsCount = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldDedupForwards:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_forwards", values[i])
+			} else if value.Valid {
+				_m.DedupForwards = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldStoreMessageContent:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field store_message_content", values[i])
+			} else if value.Valid {
+				_m.StoreMessageContent = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldAnonymizeParticipants:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anonymize_participants", values[i])
+			} else if value.Valid {
+				_m.AnonymizeParticipants = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldManualRecapPrivate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field manual_recap_private", values[i])
+			} else if value.Valid {
+				_m.ManualRecapPrivate = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldRecapInProgressTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_in_progress_template", values[i])
+			} else if value.Valid {
+				_m.RecapInProgressTemplate = value.String
+			}
+		case telegramchatrecapsoptions.FieldExcludedMessageTypes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field excluded_message_types", values[i])
+			} else if value.Valid {
+				_m.ExcludedMessageTypes = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldRecapWeekdays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_weekdays", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RecapWeekdays); err != nil {
+					return fmt.Errorf("unmarshal field recap_weekdays: %w", err)
+				}
+			}
+		case telegramchatrecapsoptions.FieldRelatedMessagesCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field related_messages_count", values[i])
+			} else if value.Valid {
+				_m.RelatedMessagesCount = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldIncrementalRecap:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field incremental_recap", values[i])
+			} else if value.Valid {
+				_m.IncrementalRecap = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldRecapLinkPreview:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_link_preview", values[i])
+			} else if value.Valid {
+				_m.RecapLinkPreview = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_unpin_after_seconds", values[i])
+			} else if value.Valid {
+				_m.AutoUnpinAfterSeconds = value.Int64
+			}
+		case telegramchatrecapsoptions.FieldRecapThreadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_thread_id", values[i])
+			} else if value.Valid {
+				_m.RecapThreadID = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field manual_recap_rate_limit_exempt_admins", values[i])
+			} else if value.Valid {
+				_m.ManualRecapRateLimitExemptAdmins = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldManualRecapMinRole:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field manual_recap_min_role", values[i])
+			} else if value.Valid {
+				_m.ManualRecapMinRole = int(value.Int64)
+			}
+		case telegramchatrecapsoptions.FieldApprovalRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_required", values[i])
+			} else if value.Valid {
+				_m.ApprovalRequired = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldCollectOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field collect_only", values[i])
+			} else if value.Valid {
+				_m.CollectOnly = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldRecapDocumentAttachment:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field recap_document_attachment", values[i])
+			} else if value.Valid {
+				_m.RecapDocumentAttachment = value.Bool
+			}
+		case telegramchatrecapsoptions.FieldSummaryMaxTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_max_tokens", values[i])
+			} else if value.Valid {
+				_m.SummaryMaxTokens = int(value.Int64)
+			}
 		case telegramchatrecapsoptions.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -159,6 +449,111 @@ func (_m *TelegramChatRecapsOptions) String() string {
 	builder.WriteString("pin_auto_recap_message=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PinAutoRecapMessage))
 	builder.WriteString(", ")
+	builder.WriteString("pin_auto_recap_message_silently=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PinAutoRecapMessageSilently))
+	builder.WriteString(", ")
+	builder.WriteString("recap_disclaimer=")
+	builder.WriteString(_m.RecapDisclaimer)
+	builder.WriteString(", ")
+	builder.WriteString("recap_target_chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecapTargetChatID))
+	builder.WriteString(", ")
+	builder.WriteString("recap_persona=")
+	builder.WriteString(_m.RecapPersona)
+	builder.WriteString(", ")
+	builder.WriteString("include_bot_messages=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeBotMessages))
+	builder.WriteString(", ")
+	builder.WriteString("auto_recaps_snoozed_until=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoRecapsSnoozedUntil))
+	builder.WriteString(", ")
+	builder.WriteString("quiet_notice_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuietNoticeEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("last_quiet_notice_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastQuietNoticeAt))
+	builder.WriteString(", ")
+	builder.WriteString("per_topic_messages=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PerTopicMessages))
+	builder.WriteString(", ")
+	builder.WriteString("summary_temperature=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SummaryTemperature))
+	builder.WriteString(", ")
+	builder.WriteString("recap_output_format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecapOutputFormat))
+	builder.WriteString(", ")
+	builder.WriteString("min_message_length_for_summary=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinMessageLengthForSummary))
+	builder.WriteString(", ")
+	builder.WriteString("count_short_messages_for_activity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CountShortMessagesForActivity))
+	builder.WriteString(", ")
+	builder.WriteString("summary_languages=")
+	builder.WriteString(_m.SummaryLanguages)
+	builder.WriteString(", ")
+	builder.WriteString("subscribe_min_membership_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscribeMinMembershipDays))
+	builder.WriteString(", ")
+	builder.WriteString("subscribe_min_member_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscribeMinMemberStatus))
+	builder.WriteString(", ")
+	builder.WriteString("top_keywords_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TopKeywordsCount))
+	builder.WriteString(", ")
+	builder.WriteString("dedup_forwards=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DedupForwards))
+	builder.WriteString(", ")
+	builder.WriteString("store_message_content=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StoreMessageContent))
+	builder.WriteString(", ")
+	builder.WriteString("anonymize_participants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnonymizeParticipants))
+	builder.WriteString(", ")
+	builder.WriteString("manual_recap_private=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ManualRecapPrivate))
+	builder.WriteString(", ")
+	builder.WriteString("recap_in_progress_template=")
+	builder.WriteString(_m.RecapInProgressTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("excluded_message_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExcludedMessageTypes))
+	builder.WriteString(", ")
+	builder.WriteString("recap_weekdays=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecapWeekdays))
+	builder.WriteString(", ")
+	builder.WriteString("related_messages_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RelatedMessagesCount))
+	builder.WriteString(", ")
+	builder.WriteString("incremental_recap=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncrementalRecap))
+	builder.WriteString(", ")
+	builder.WriteString("recap_link_preview=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecapLinkPreview))
+	builder.WriteString(", ")
+	builder.WriteString("auto_unpin_after_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoUnpinAfterSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("recap_thread_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecapThreadID))
+	builder.WriteString(", ")
+	builder.WriteString("manual_recap_rate_limit_exempt_admins=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ManualRecapRateLimitExemptAdmins))
+	builder.WriteString(", ")
+	builder.WriteString("manual_recap_min_role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ManualRecapMinRole))
+	builder.WriteString(", ")
+	builder.WriteString("approval_required=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovalRequired))
+	builder.WriteString(", ")
+	builder.WriteString("collect_only=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectOnly))
+	builder.WriteString(", ")
+	builder.WriteString("recap_document_attachment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecapDocumentAttachment))
+	builder.WriteString(", ")
+	builder.WriteString("summary_max_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SummaryMaxTokens))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
 	builder.WriteString(", ")
@@ -22,6 +22,76 @@ const (
 	FieldAutoRecapRatesPerDay = "auto_recap_rates_per_day"
 	// FieldPinAutoRecapMessage holds the string denoting the pin_auto_recap_message field in the database.
 	FieldPinAutoRecapMessage = "pin_auto_recap_message"
+	// FieldPinAutoRecapMessageSilently holds the string denoting the pin_auto_recap_message_silently field in the database.
+	FieldPinAutoRecapMessageSilently = "pin_auto_recap_message_silently"
+	// FieldRecapDisclaimer holds the string denoting the recap_disclaimer field in the database.
+	FieldRecapDisclaimer = "recap_disclaimer"
+	// FieldRecapTargetChatID holds the string denoting the recap_target_chat_id field in the database.
+	FieldRecapTargetChatID = "recap_target_chat_id"
+	// FieldRecapPersona holds the string denoting the recap_persona field in the database.
+	FieldRecapPersona = "recap_persona"
+	// FieldIncludeBotMessages holds the string denoting the include_bot_messages field in the database.
+	FieldIncludeBotMessages = "include_bot_messages"
+	// FieldAutoRecapsSnoozedUntil holds the string denoting the auto_recaps_snoozed_until field in the database.
+	FieldAutoRecapsSnoozedUntil = "auto_recaps_snoozed_until"
+	// FieldQuietNoticeEnabled holds the string denoting the quiet_notice_enabled field in the database.
+	FieldQuietNoticeEnabled = "quiet_notice_enabled"
+	// FieldLastQuietNoticeAt holds the string denoting the last_quiet_notice_at field in the database.
+	FieldLastQuietNoticeAt = "last_quiet_notice_at"
+	// FieldPerTopicMessages holds the string denoting the per_topic_messages field in the database.
+	FieldPerTopicMessages = "per_topic_messages"
+	// FieldSummaryTemperature holds the string denoting the summary_temperature field in the database.
+	FieldSummaryTemperature = "summary_temperature"
+	// FieldRecapOutputFormat holds the string denoting the recap_output_format field in the database.
+	FieldRecapOutputFormat = "recap_output_format"
+	// FieldMinMessageLengthForSummary holds the string denoting the min_message_length_for_summary field in the database.
+	FieldMinMessageLengthForSummary = "min_message_length_for_summary"
+	// FieldCountShortMessagesForActivity holds the string denoting the count_short_messages_for_activity field in the database.
+	FieldCountShortMessagesForActivity = "count_short_messages_for_activity"
+	// FieldSummaryLanguages holds the string denoting the summary_languages field in the database.
+	FieldSummaryLanguages = "summary_languages"
+	// FieldSubscribeMinMembershipDays holds the string denoting the subscribe_min_membership_days field in the database.
+	FieldSubscribeMinMembershipDays = "subscribe_min_membership_days"
+	// FieldSubscribeMinMemberStatus holds the string denoting the subscribe_min_member_status field in the database.
+	FieldSubscribeMinMemberStatus = "subscribe_min_member_status"
+	// FieldTopKeywordsCount holds the string denoting the top_keywords_count field in the database.
+	FieldTopKeywordsCount = "top_keywords_count"
+	// FieldDedupForwards holds the string denoting the dedup_forwards field in the database.
+	FieldDedupForwards = "dedup_forwards"
+	// FieldStoreMessageContent holds the string denoting the store_message_content field in the database.
+	FieldStoreMessageContent = "store_message_content"
+	// FieldAnonymizeParticipants holds the string denoting the anonymize_participants field in the database.
+	FieldAnonymizeParticipants = "anonymize_participants"
+	// FieldManualRecapPrivate holds the string denoting the manual_recap_private field in the database.
+	FieldManualRecapPrivate = "manual_recap_private"
+	// FieldRecapInProgressTemplate holds the string denoting the recap_in_progress_template field in the database.
+	FieldRecapInProgressTemplate = "recap_in_progress_template"
+	// FieldExcludedMessageTypes holds the string denoting the excluded_message_types field in the database.
+	FieldExcludedMessageTypes = "excluded_message_types"
+	// FieldRecapWeekdays holds the string denoting the recap_weekdays field in the database.
+	FieldRecapWeekdays = "recap_weekdays"
+	// FieldRelatedMessagesCount holds the string denoting the related_messages_count field in the database.
+	FieldRelatedMessagesCount = "related_messages_count"
+	// FieldIncrementalRecap holds the string denoting the incremental_recap field in the database.
+	FieldIncrementalRecap = "incremental_recap"
+	// FieldRecapLinkPreview holds the string denoting the recap_link_preview field in the database.
+	FieldRecapLinkPreview = "recap_link_preview"
+	// FieldAutoUnpinAfterSeconds holds the string denoting the auto_unpin_after_seconds field in the database.
+	FieldAutoUnpinAfterSeconds = "auto_unpin_after_seconds"
+	// FieldRecapThreadID holds the string denoting the recap_thread_id field in the database.
+	FieldRecapThreadID = "recap_thread_id"
+	// FieldManualRecapRateLimitExemptAdmins holds the string denoting the manual_recap_rate_limit_exempt_admins field in the database.
+	FieldManualRecapRateLimitExemptAdmins = "manual_recap_rate_limit_exempt_admins"
+	// FieldManualRecapMinRole holds the string denoting the manual_recap_min_role field in the database.
+	FieldManualRecapMinRole = "manual_recap_min_role"
+	// FieldApprovalRequired holds the string denoting the approval_required field in the database.
+	FieldApprovalRequired = "approval_required"
+	// FieldCollectOnly holds the string denoting the collect_only field in the database.
+	FieldCollectOnly = "collect_only"
+	// FieldRecapDocumentAttachment holds the string denoting the recap_document_attachment field in the database.
+	FieldRecapDocumentAttachment = "recap_document_attachment"
+	// FieldSummaryMaxTokens holds the string denoting the summary_max_tokens field in the database.
+	FieldSummaryMaxTokens = "summary_max_tokens"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -38,6 +108,41 @@ var Columns = []string{
 	FieldManualRecapRatePerSeconds,
 	FieldAutoRecapRatesPerDay,
 	FieldPinAutoRecapMessage,
+	FieldPinAutoRecapMessageSilently,
+	FieldRecapDisclaimer,
+	FieldRecapTargetChatID,
+	FieldRecapPersona,
+	FieldIncludeBotMessages,
+	FieldAutoRecapsSnoozedUntil,
+	FieldQuietNoticeEnabled,
+	FieldLastQuietNoticeAt,
+	FieldPerTopicMessages,
+	FieldSummaryTemperature,
+	FieldRecapOutputFormat,
+	FieldMinMessageLengthForSummary,
+	FieldCountShortMessagesForActivity,
+	FieldSummaryLanguages,
+	FieldSubscribeMinMembershipDays,
+	FieldSubscribeMinMemberStatus,
+	FieldTopKeywordsCount,
+	FieldDedupForwards,
+	FieldStoreMessageContent,
+	FieldAnonymizeParticipants,
+	FieldManualRecapPrivate,
+	FieldRecapInProgressTemplate,
+	FieldExcludedMessageTypes,
+	FieldRecapWeekdays,
+	FieldRelatedMessagesCount,
+	FieldIncrementalRecap,
+	FieldRecapLinkPreview,
+	FieldAutoUnpinAfterSeconds,
+	FieldRecapThreadID,
+	FieldManualRecapRateLimitExemptAdmins,
+	FieldManualRecapMinRole,
+	FieldApprovalRequired,
+	FieldCollectOnly,
+	FieldRecapDocumentAttachment,
+	FieldSummaryMaxTokens,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -61,6 +166,74 @@ var (
 	DefaultAutoRecapRatesPerDay int
 	// DefaultPinAutoRecapMessage holds the default value on creation for the "pin_auto_recap_message" field.
 	DefaultPinAutoRecapMessage bool
+	// DefaultPinAutoRecapMessageSilently holds the default value on creation for the "pin_auto_recap_message_silently" field.
+	DefaultPinAutoRecapMessageSilently bool
+	// DefaultRecapDisclaimer holds the default value on creation for the "recap_disclaimer" field.
+	DefaultRecapDisclaimer string
+	// DefaultRecapTargetChatID holds the default value on creation for the "recap_target_chat_id" field.
+	DefaultRecapTargetChatID int64
+	// DefaultRecapPersona holds the default value on creation for the "recap_persona" field.
+	DefaultRecapPersona string
+	// DefaultIncludeBotMessages holds the default value on creation for the "include_bot_messages" field.
+	DefaultIncludeBotMessages bool
+	// DefaultAutoRecapsSnoozedUntil holds the default value on creation for the "auto_recaps_snoozed_until" field.
+	DefaultAutoRecapsSnoozedUntil int64
+	// DefaultQuietNoticeEnabled holds the default value on creation for the "quiet_notice_enabled" field.
+	DefaultQuietNoticeEnabled bool
+	// DefaultLastQuietNoticeAt holds the default value on creation for the "last_quiet_notice_at" field.
+	DefaultLastQuietNoticeAt int64
+	// DefaultPerTopicMessages holds the default value on creation for the "per_topic_messages" field.
+	DefaultPerTopicMessages bool
+	// DefaultSummaryTemperature holds the default value on creation for the "summary_temperature" field.
+	DefaultSummaryTemperature float64
+	// DefaultRecapOutputFormat holds the default value on creation for the "recap_output_format" field.
+	DefaultRecapOutputFormat int
+	// DefaultMinMessageLengthForSummary holds the default value on creation for the "min_message_length_for_summary" field.
+	DefaultMinMessageLengthForSummary int
+	// DefaultCountShortMessagesForActivity holds the default value on creation for the "count_short_messages_for_activity" field.
+	DefaultCountShortMessagesForActivity bool
+	// DefaultSummaryLanguages holds the default value on creation for the "summary_languages" field.
+	DefaultSummaryLanguages string
+	// DefaultSubscribeMinMembershipDays holds the default value on creation for the "subscribe_min_membership_days" field.
+	DefaultSubscribeMinMembershipDays int
+	// DefaultSubscribeMinMemberStatus holds the default value on creation for the "subscribe_min_member_status" field.
+	DefaultSubscribeMinMemberStatus int
+	// DefaultTopKeywordsCount holds the default value on creation for the "top_keywords_count" field.
+	DefaultTopKeywordsCount int
+	// DefaultDedupForwards holds the default value on creation for the "dedup_forwards" field.
+	DefaultDedupForwards bool
+	// DefaultStoreMessageContent holds the default value on creation for the "store_message_content" field.
+	DefaultStoreMessageContent bool
+	// DefaultAnonymizeParticipants holds the default value on creation for the "anonymize_participants" field.
+	DefaultAnonymizeParticipants bool
+	// DefaultManualRecapPrivate holds the default value on creation for the "manual_recap_private" field.
+	DefaultManualRecapPrivate bool
+	// DefaultRecapInProgressTemplate holds the default value on creation for the "recap_in_progress_template" field.
+	DefaultRecapInProgressTemplate string
+	// DefaultExcludedMessageTypes holds the default value on creation for the "excluded_message_types" field.
+	DefaultExcludedMessageTypes int
+	// DefaultRelatedMessagesCount holds the default value on creation for the "related_messages_count" field.
+	DefaultRelatedMessagesCount int
+	// DefaultIncrementalRecap holds the default value on creation for the "incremental_recap" field.
+	DefaultIncrementalRecap bool
+	// DefaultRecapLinkPreview holds the default value on creation for the "recap_link_preview" field.
+	DefaultRecapLinkPreview bool
+	// DefaultAutoUnpinAfterSeconds holds the default value on creation for the "auto_unpin_after_seconds" field.
+	DefaultAutoUnpinAfterSeconds int64
+	// DefaultRecapThreadID holds the default value on creation for the "recap_thread_id" field.
+	DefaultRecapThreadID int
+	// DefaultManualRecapRateLimitExemptAdmins holds the default value on creation for the "manual_recap_rate_limit_exempt_admins" field.
+	DefaultManualRecapRateLimitExemptAdmins bool
+	// DefaultManualRecapMinRole holds the default value on creation for the "manual_recap_min_role" field.
+	DefaultManualRecapMinRole int
+	// DefaultApprovalRequired holds the default value on creation for the "approval_required" field.
+	DefaultApprovalRequired bool
+	// DefaultCollectOnly holds the default value on creation for the "collect_only" field.
+	DefaultCollectOnly bool
+	// DefaultRecapDocumentAttachment holds the default value on creation for the "recap_document_attachment" field.
+	DefaultRecapDocumentAttachment bool
+	// DefaultSummaryMaxTokens holds the default value on creation for the "summary_max_tokens" field.
+	DefaultSummaryMaxTokens int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() int64
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -102,6 +275,176 @@ func ByPinAutoRecapMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPinAutoRecapMessage, opts...).ToFunc()
 }
 
+// ByPinAutoRecapMessageSilently orders the results by the pin_auto_recap_message_silently field.
+func ByPinAutoRecapMessageSilently(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinAutoRecapMessageSilently, opts...).ToFunc()
+}
+
+// ByRecapDisclaimer orders the results by the recap_disclaimer field.
+func ByRecapDisclaimer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapDisclaimer, opts...).ToFunc()
+}
+
+// ByRecapTargetChatID orders the results by the recap_target_chat_id field.
+func ByRecapTargetChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapTargetChatID, opts...).ToFunc()
+}
+
+// ByRecapPersona orders the results by the recap_persona field.
+func ByRecapPersona(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapPersona, opts...).ToFunc()
+}
+
+// ByIncludeBotMessages orders the results by the include_bot_messages field.
+func ByIncludeBotMessages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeBotMessages, opts...).ToFunc()
+}
+
+// ByAutoRecapsSnoozedUntil orders the results by the auto_recaps_snoozed_until field.
+func ByAutoRecapsSnoozedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoRecapsSnoozedUntil, opts...).ToFunc()
+}
+
+// ByQuietNoticeEnabled orders the results by the quiet_notice_enabled field.
+func ByQuietNoticeEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietNoticeEnabled, opts...).ToFunc()
+}
+
+// ByLastQuietNoticeAt orders the results by the last_quiet_notice_at field.
+func ByLastQuietNoticeAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastQuietNoticeAt, opts...).ToFunc()
+}
+
+// ByPerTopicMessages orders the results by the per_topic_messages field.
+func ByPerTopicMessages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPerTopicMessages, opts...).ToFunc()
+}
+
+// BySummaryTemperature orders the results by the summary_temperature field.
+func BySummaryTemperature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryTemperature, opts...).ToFunc()
+}
+
+// ByRecapOutputFormat orders the results by the recap_output_format field.
+func ByRecapOutputFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapOutputFormat, opts...).ToFunc()
+}
+
+// ByMinMessageLengthForSummary orders the results by the min_message_length_for_summary field.
+func ByMinMessageLengthForSummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinMessageLengthForSummary, opts...).ToFunc()
+}
+
+// ByCountShortMessagesForActivity orders the results by the count_short_messages_for_activity field.
+func ByCountShortMessagesForActivity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountShortMessagesForActivity, opts...).ToFunc()
+}
+
+// BySummaryLanguages orders the results by the summary_languages field.
+func BySummaryLanguages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryLanguages, opts...).ToFunc()
+}
+
+// BySubscribeMinMembershipDays orders the results by the subscribe_min_membership_days field.
+func BySubscribeMinMembershipDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscribeMinMembershipDays, opts...).ToFunc()
+}
+
+// BySubscribeMinMemberStatus orders the results by the subscribe_min_member_status field.
+func BySubscribeMinMemberStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscribeMinMemberStatus, opts...).ToFunc()
+}
+
+// ByTopKeywordsCount orders the results by the top_keywords_count field.
+func ByTopKeywordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopKeywordsCount, opts...).ToFunc()
+}
+
+// ByDedupForwards orders the results by the dedup_forwards field.
+func ByDedupForwards(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupForwards, opts...).ToFunc()
+}
+
+// ByStoreMessageContent orders the results by the store_message_content field.
+func ByStoreMessageContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreMessageContent, opts...).ToFunc()
+}
+
+// ByAnonymizeParticipants orders the results by the anonymize_participants field.
+func ByAnonymizeParticipants(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnonymizeParticipants, opts...).ToFunc()
+}
+
+// ByManualRecapPrivate orders the results by the manual_recap_private field.
+func ByManualRecapPrivate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManualRecapPrivate, opts...).ToFunc()
+}
+
+// ByRecapInProgressTemplate orders the results by the recap_in_progress_template field.
+func ByRecapInProgressTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapInProgressTemplate, opts...).ToFunc()
+}
+
+// ByExcludedMessageTypes orders the results by the excluded_message_types field.
+func ByExcludedMessageTypes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExcludedMessageTypes, opts...).ToFunc()
+}
+
+// ByRelatedMessagesCount orders the results by the related_messages_count field.
+func ByRelatedMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelatedMessagesCount, opts...).ToFunc()
+}
+
+// ByIncrementalRecap orders the results by the incremental_recap field.
+func ByIncrementalRecap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncrementalRecap, opts...).ToFunc()
+}
+
+// ByRecapLinkPreview orders the results by the recap_link_preview field.
+func ByRecapLinkPreview(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapLinkPreview, opts...).ToFunc()
+}
+
+// ByAutoUnpinAfterSeconds orders the results by the auto_unpin_after_seconds field.
+func ByAutoUnpinAfterSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoUnpinAfterSeconds, opts...).ToFunc()
+}
+
+// ByRecapThreadID orders the results by the recap_thread_id field.
+func ByRecapThreadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapThreadID, opts...).ToFunc()
+}
+
+// ByManualRecapRateLimitExemptAdmins orders the results by the manual_recap_rate_limit_exempt_admins field.
+func ByManualRecapRateLimitExemptAdmins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManualRecapRateLimitExemptAdmins, opts...).ToFunc()
+}
+
+// ByManualRecapMinRole orders the results by the manual_recap_min_role field.
+func ByManualRecapMinRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManualRecapMinRole, opts...).ToFunc()
+}
+
+// ByApprovalRequired orders the results by the approval_required field.
+func ByApprovalRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalRequired, opts...).ToFunc()
+}
+
+// ByCollectOnly orders the results by the collect_only field.
+func ByCollectOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectOnly, opts...).ToFunc()
+}
+
+// ByRecapDocumentAttachment orders the results by the recap_document_attachment field.
+func ByRecapDocumentAttachment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecapDocumentAttachment, opts...).ToFunc()
+}
+
+// BySummaryMaxTokens orders the results by the summary_max_tokens field.
+func BySummaryMaxTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryMaxTokens, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -78,6 +78,176 @@ func PinAutoRecapMessage(v bool) predicate.TelegramChatRecapsOptions {
 	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldPinAutoRecapMessage, v))
 }
 
+// PinAutoRecapMessageSilently applies equality check predicate on the "pin_auto_recap_message_silently" field. It's identical to PinAutoRecapMessageSilentlyEQ.
+func PinAutoRecapMessageSilently(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldPinAutoRecapMessageSilently, v))
+}
+
+// RecapDisclaimer applies equality check predicate on the "recap_disclaimer" field. It's identical to RecapDisclaimerEQ.
+func RecapDisclaimer(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapDisclaimer, v))
+}
+
+// RecapTargetChatID applies equality check predicate on the "recap_target_chat_id" field. It's identical to RecapTargetChatIDEQ.
+func RecapTargetChatID(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapTargetChatID, v))
+}
+
+// RecapPersona applies equality check predicate on the "recap_persona" field. It's identical to RecapPersonaEQ.
+func RecapPersona(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapPersona, v))
+}
+
+// IncludeBotMessages applies equality check predicate on the "include_bot_messages" field. It's identical to IncludeBotMessagesEQ.
+func IncludeBotMessages(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldIncludeBotMessages, v))
+}
+
+// AutoRecapsSnoozedUntil applies equality check predicate on the "auto_recaps_snoozed_until" field. It's identical to AutoRecapsSnoozedUntilEQ.
+func AutoRecapsSnoozedUntil(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldAutoRecapsSnoozedUntil, v))
+}
+
+// QuietNoticeEnabled applies equality check predicate on the "quiet_notice_enabled" field. It's identical to QuietNoticeEnabledEQ.
+func QuietNoticeEnabled(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldQuietNoticeEnabled, v))
+}
+
+// LastQuietNoticeAt applies equality check predicate on the "last_quiet_notice_at" field. It's identical to LastQuietNoticeAtEQ.
+func LastQuietNoticeAt(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldLastQuietNoticeAt, v))
+}
+
+// PerTopicMessages applies equality check predicate on the "per_topic_messages" field. It's identical to PerTopicMessagesEQ.
+func PerTopicMessages(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldPerTopicMessages, v))
+}
+
+// SummaryTemperature applies equality check predicate on the "summary_temperature" field. It's identical to SummaryTemperatureEQ.
+func SummaryTemperature(v float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSummaryTemperature, v))
+}
+
+// RecapOutputFormat applies equality check predicate on the "recap_output_format" field. It's identical to RecapOutputFormatEQ.
+func RecapOutputFormat(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapOutputFormat, v))
+}
+
+// MinMessageLengthForSummary applies equality check predicate on the "min_message_length_for_summary" field. It's identical to MinMessageLengthForSummaryEQ.
+func MinMessageLengthForSummary(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldMinMessageLengthForSummary, v))
+}
+
+// CountShortMessagesForActivity applies equality check predicate on the "count_short_messages_for_activity" field. It's identical to CountShortMessagesForActivityEQ.
+func CountShortMessagesForActivity(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldCountShortMessagesForActivity, v))
+}
+
+// SummaryLanguages applies equality check predicate on the "summary_languages" field. It's identical to SummaryLanguagesEQ.
+func SummaryLanguages(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSummaryLanguages, v))
+}
+
+// SubscribeMinMembershipDays applies equality check predicate on the "subscribe_min_membership_days" field. It's identical to SubscribeMinMembershipDaysEQ.
+func SubscribeMinMembershipDays(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSubscribeMinMembershipDays, v))
+}
+
+// SubscribeMinMemberStatus applies equality check predicate on the "subscribe_min_member_status" field. It's identical to SubscribeMinMemberStatusEQ.
+func SubscribeMinMemberStatus(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSubscribeMinMemberStatus, v))
+}
+
+// TopKeywordsCount applies equality check predicate on the "top_keywords_count" field. It's identical to TopKeywordsCountEQ.
+func TopKeywordsCount(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldTopKeywordsCount, v))
+}
+
+// DedupForwards applies equality check predicate on the "dedup_forwards" field. It's identical to DedupForwardsEQ.
+func DedupForwards(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldDedupForwards, v))
+}
+
+// StoreMessageContent applies equality check predicate on the "store_message_content" field. It's identical to StoreMessageContentEQ.
+func StoreMessageContent(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldStoreMessageContent, v))
+}
+
+// AnonymizeParticipants applies equality check predicate on the "anonymize_participants" field. It's identical to AnonymizeParticipantsEQ.
+func AnonymizeParticipants(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldAnonymizeParticipants, v))
+}
+
+// ManualRecapPrivate applies equality check predicate on the "manual_recap_private" field. It's identical to ManualRecapPrivateEQ.
+func ManualRecapPrivate(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldManualRecapPrivate, v))
+}
+
+// RecapInProgressTemplate applies equality check predicate on the "recap_in_progress_template" field. It's identical to RecapInProgressTemplateEQ.
+func RecapInProgressTemplate(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapInProgressTemplate, v))
+}
+
+// ExcludedMessageTypes applies equality check predicate on the "excluded_message_types" field. It's identical to ExcludedMessageTypesEQ.
+func ExcludedMessageTypes(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldExcludedMessageTypes, v))
+}
+
+// RelatedMessagesCount applies equality check predicate on the "related_messages_count" field. It's identical to RelatedMessagesCountEQ.
+func RelatedMessagesCount(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRelatedMessagesCount, v))
+}
+
+// IncrementalRecap applies equality check predicate on the "incremental_recap" field. It's identical to IncrementalRecapEQ.
+func IncrementalRecap(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldIncrementalRecap, v))
+}
+
+// RecapLinkPreview applies equality check predicate on the "recap_link_preview" field. It's identical to RecapLinkPreviewEQ.
+func RecapLinkPreview(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapLinkPreview, v))
+}
+
+// AutoUnpinAfterSeconds applies equality check predicate on the "auto_unpin_after_seconds" field. It's identical to AutoUnpinAfterSecondsEQ.
+func AutoUnpinAfterSeconds(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldAutoUnpinAfterSeconds, v))
+}
+
+// RecapThreadID applies equality check predicate on the "recap_thread_id" field. It's identical to RecapThreadIDEQ.
+func RecapThreadID(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapThreadID, v))
+}
+
+// ManualRecapRateLimitExemptAdmins applies equality check predicate on the "manual_recap_rate_limit_exempt_admins" field. It's identical to ManualRecapRateLimitExemptAdminsEQ.
+func ManualRecapRateLimitExemptAdmins(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldManualRecapRateLimitExemptAdmins, v))
+}
+
+// ManualRecapMinRole applies equality check predicate on the "manual_recap_min_role" field. It's identical to ManualRecapMinRoleEQ.
+func ManualRecapMinRole(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldManualRecapMinRole, v))
+}
+
+// ApprovalRequired applies equality check predicate on the "approval_required" field. It's identical to ApprovalRequiredEQ.
+func ApprovalRequired(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldApprovalRequired, v))
+}
+
+// CollectOnly applies equality check predicate on the "collect_only" field. It's identical to CollectOnlyEQ.
+func CollectOnly(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldCollectOnly, v))
+}
+
+// RecapDocumentAttachment applies equality check predicate on the "recap_document_attachment" field. It's identical to RecapDocumentAttachmentEQ.
+func RecapDocumentAttachment(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapDocumentAttachment, v))
+}
+
+// SummaryMaxTokens applies equality check predicate on the "summary_max_tokens" field. It's identical to SummaryMaxTokensEQ.
+func SummaryMaxTokens(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSummaryMaxTokens, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.TelegramChatRecapsOptions {
 	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldCreatedAt, v))
@@ -258,6 +428,1026 @@ func PinAutoRecapMessageNEQ(v bool) predicate.TelegramChatRecapsOptions {
 	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldPinAutoRecapMessage, v))
 }
 
+// PinAutoRecapMessageSilentlyEQ applies the EQ predicate on the "pin_auto_recap_message_silently" field.
+func PinAutoRecapMessageSilentlyEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldPinAutoRecapMessageSilently, v))
+}
+
+// PinAutoRecapMessageSilentlyNEQ applies the NEQ predicate on the "pin_auto_recap_message_silently" field.
+func PinAutoRecapMessageSilentlyNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldPinAutoRecapMessageSilently, v))
+}
+
+// RecapDisclaimerEQ applies the EQ predicate on the "recap_disclaimer" field.
+func RecapDisclaimerEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerNEQ applies the NEQ predicate on the "recap_disclaimer" field.
+func RecapDisclaimerNEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerIn applies the In predicate on the "recap_disclaimer" field.
+func RecapDisclaimerIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldRecapDisclaimer, vs...))
+}
+
+// RecapDisclaimerNotIn applies the NotIn predicate on the "recap_disclaimer" field.
+func RecapDisclaimerNotIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldRecapDisclaimer, vs...))
+}
+
+// RecapDisclaimerGT applies the GT predicate on the "recap_disclaimer" field.
+func RecapDisclaimerGT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerGTE applies the GTE predicate on the "recap_disclaimer" field.
+func RecapDisclaimerGTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerLT applies the LT predicate on the "recap_disclaimer" field.
+func RecapDisclaimerLT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerLTE applies the LTE predicate on the "recap_disclaimer" field.
+func RecapDisclaimerLTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerContains applies the Contains predicate on the "recap_disclaimer" field.
+func RecapDisclaimerContains(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContains(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerHasPrefix applies the HasPrefix predicate on the "recap_disclaimer" field.
+func RecapDisclaimerHasPrefix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasPrefix(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerHasSuffix applies the HasSuffix predicate on the "recap_disclaimer" field.
+func RecapDisclaimerHasSuffix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasSuffix(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerEqualFold applies the EqualFold predicate on the "recap_disclaimer" field.
+func RecapDisclaimerEqualFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEqualFold(FieldRecapDisclaimer, v))
+}
+
+// RecapDisclaimerContainsFold applies the ContainsFold predicate on the "recap_disclaimer" field.
+func RecapDisclaimerContainsFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContainsFold(FieldRecapDisclaimer, v))
+}
+
+// RecapTargetChatIDEQ applies the EQ predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapTargetChatID, v))
+}
+
+// RecapTargetChatIDNEQ applies the NEQ predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDNEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapTargetChatID, v))
+}
+
+// RecapTargetChatIDIn applies the In predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldRecapTargetChatID, vs...))
+}
+
+// RecapTargetChatIDNotIn applies the NotIn predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDNotIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldRecapTargetChatID, vs...))
+}
+
+// RecapTargetChatIDGT applies the GT predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDGT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldRecapTargetChatID, v))
+}
+
+// RecapTargetChatIDGTE applies the GTE predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDGTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldRecapTargetChatID, v))
+}
+
+// RecapTargetChatIDLT applies the LT predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDLT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldRecapTargetChatID, v))
+}
+
+// RecapTargetChatIDLTE applies the LTE predicate on the "recap_target_chat_id" field.
+func RecapTargetChatIDLTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldRecapTargetChatID, v))
+}
+
+// RecapPersonaEQ applies the EQ predicate on the "recap_persona" field.
+func RecapPersonaEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapPersona, v))
+}
+
+// RecapPersonaNEQ applies the NEQ predicate on the "recap_persona" field.
+func RecapPersonaNEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapPersona, v))
+}
+
+// RecapPersonaIn applies the In predicate on the "recap_persona" field.
+func RecapPersonaIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldRecapPersona, vs...))
+}
+
+// RecapPersonaNotIn applies the NotIn predicate on the "recap_persona" field.
+func RecapPersonaNotIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldRecapPersona, vs...))
+}
+
+// RecapPersonaGT applies the GT predicate on the "recap_persona" field.
+func RecapPersonaGT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldRecapPersona, v))
+}
+
+// RecapPersonaGTE applies the GTE predicate on the "recap_persona" field.
+func RecapPersonaGTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldRecapPersona, v))
+}
+
+// RecapPersonaLT applies the LT predicate on the "recap_persona" field.
+func RecapPersonaLT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldRecapPersona, v))
+}
+
+// RecapPersonaLTE applies the LTE predicate on the "recap_persona" field.
+func RecapPersonaLTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldRecapPersona, v))
+}
+
+// RecapPersonaContains applies the Contains predicate on the "recap_persona" field.
+func RecapPersonaContains(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContains(FieldRecapPersona, v))
+}
+
+// RecapPersonaHasPrefix applies the HasPrefix predicate on the "recap_persona" field.
+func RecapPersonaHasPrefix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasPrefix(FieldRecapPersona, v))
+}
+
+// RecapPersonaHasSuffix applies the HasSuffix predicate on the "recap_persona" field.
+func RecapPersonaHasSuffix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasSuffix(FieldRecapPersona, v))
+}
+
+// RecapPersonaEqualFold applies the EqualFold predicate on the "recap_persona" field.
+func RecapPersonaEqualFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEqualFold(FieldRecapPersona, v))
+}
+
+// RecapPersonaContainsFold applies the ContainsFold predicate on the "recap_persona" field.
+func RecapPersonaContainsFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContainsFold(FieldRecapPersona, v))
+}
+
+// IncludeBotMessagesEQ applies the EQ predicate on the "include_bot_messages" field.
+func IncludeBotMessagesEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldIncludeBotMessages, v))
+}
+
+// IncludeBotMessagesNEQ applies the NEQ predicate on the "include_bot_messages" field.
+func IncludeBotMessagesNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldIncludeBotMessages, v))
+}
+
+// AutoRecapsSnoozedUntilEQ applies the EQ predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldAutoRecapsSnoozedUntil, v))
+}
+
+// AutoRecapsSnoozedUntilNEQ applies the NEQ predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilNEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldAutoRecapsSnoozedUntil, v))
+}
+
+// AutoRecapsSnoozedUntilIn applies the In predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldAutoRecapsSnoozedUntil, vs...))
+}
+
+// AutoRecapsSnoozedUntilNotIn applies the NotIn predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilNotIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldAutoRecapsSnoozedUntil, vs...))
+}
+
+// AutoRecapsSnoozedUntilGT applies the GT predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilGT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldAutoRecapsSnoozedUntil, v))
+}
+
+// AutoRecapsSnoozedUntilGTE applies the GTE predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilGTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldAutoRecapsSnoozedUntil, v))
+}
+
+// AutoRecapsSnoozedUntilLT applies the LT predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilLT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldAutoRecapsSnoozedUntil, v))
+}
+
+// AutoRecapsSnoozedUntilLTE applies the LTE predicate on the "auto_recaps_snoozed_until" field.
+func AutoRecapsSnoozedUntilLTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldAutoRecapsSnoozedUntil, v))
+}
+
+// QuietNoticeEnabledEQ applies the EQ predicate on the "quiet_notice_enabled" field.
+func QuietNoticeEnabledEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldQuietNoticeEnabled, v))
+}
+
+// QuietNoticeEnabledNEQ applies the NEQ predicate on the "quiet_notice_enabled" field.
+func QuietNoticeEnabledNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldQuietNoticeEnabled, v))
+}
+
+// LastQuietNoticeAtEQ applies the EQ predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldLastQuietNoticeAt, v))
+}
+
+// LastQuietNoticeAtNEQ applies the NEQ predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtNEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldLastQuietNoticeAt, v))
+}
+
+// LastQuietNoticeAtIn applies the In predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldLastQuietNoticeAt, vs...))
+}
+
+// LastQuietNoticeAtNotIn applies the NotIn predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtNotIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldLastQuietNoticeAt, vs...))
+}
+
+// LastQuietNoticeAtGT applies the GT predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtGT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldLastQuietNoticeAt, v))
+}
+
+// LastQuietNoticeAtGTE applies the GTE predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtGTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldLastQuietNoticeAt, v))
+}
+
+// LastQuietNoticeAtLT applies the LT predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtLT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldLastQuietNoticeAt, v))
+}
+
+// LastQuietNoticeAtLTE applies the LTE predicate on the "last_quiet_notice_at" field.
+func LastQuietNoticeAtLTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldLastQuietNoticeAt, v))
+}
+
+// PerTopicMessagesEQ applies the EQ predicate on the "per_topic_messages" field.
+func PerTopicMessagesEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldPerTopicMessages, v))
+}
+
+// PerTopicMessagesNEQ applies the NEQ predicate on the "per_topic_messages" field.
+func PerTopicMessagesNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldPerTopicMessages, v))
+}
+
+// SummaryTemperatureEQ applies the EQ predicate on the "summary_temperature" field.
+func SummaryTemperatureEQ(v float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSummaryTemperature, v))
+}
+
+// SummaryTemperatureNEQ applies the NEQ predicate on the "summary_temperature" field.
+func SummaryTemperatureNEQ(v float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldSummaryTemperature, v))
+}
+
+// SummaryTemperatureIn applies the In predicate on the "summary_temperature" field.
+func SummaryTemperatureIn(vs ...float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldSummaryTemperature, vs...))
+}
+
+// SummaryTemperatureNotIn applies the NotIn predicate on the "summary_temperature" field.
+func SummaryTemperatureNotIn(vs ...float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldSummaryTemperature, vs...))
+}
+
+// SummaryTemperatureGT applies the GT predicate on the "summary_temperature" field.
+func SummaryTemperatureGT(v float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldSummaryTemperature, v))
+}
+
+// SummaryTemperatureGTE applies the GTE predicate on the "summary_temperature" field.
+func SummaryTemperatureGTE(v float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldSummaryTemperature, v))
+}
+
+// SummaryTemperatureLT applies the LT predicate on the "summary_temperature" field.
+func SummaryTemperatureLT(v float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldSummaryTemperature, v))
+}
+
+// SummaryTemperatureLTE applies the LTE predicate on the "summary_temperature" field.
+func SummaryTemperatureLTE(v float64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldSummaryTemperature, v))
+}
+
+// RecapOutputFormatEQ applies the EQ predicate on the "recap_output_format" field.
+func RecapOutputFormatEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapOutputFormat, v))
+}
+
+// RecapOutputFormatNEQ applies the NEQ predicate on the "recap_output_format" field.
+func RecapOutputFormatNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapOutputFormat, v))
+}
+
+// RecapOutputFormatIn applies the In predicate on the "recap_output_format" field.
+func RecapOutputFormatIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldRecapOutputFormat, vs...))
+}
+
+// RecapOutputFormatNotIn applies the NotIn predicate on the "recap_output_format" field.
+func RecapOutputFormatNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldRecapOutputFormat, vs...))
+}
+
+// RecapOutputFormatGT applies the GT predicate on the "recap_output_format" field.
+func RecapOutputFormatGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldRecapOutputFormat, v))
+}
+
+// RecapOutputFormatGTE applies the GTE predicate on the "recap_output_format" field.
+func RecapOutputFormatGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldRecapOutputFormat, v))
+}
+
+// RecapOutputFormatLT applies the LT predicate on the "recap_output_format" field.
+func RecapOutputFormatLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldRecapOutputFormat, v))
+}
+
+// RecapOutputFormatLTE applies the LTE predicate on the "recap_output_format" field.
+func RecapOutputFormatLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldRecapOutputFormat, v))
+}
+
+// MinMessageLengthForSummaryEQ applies the EQ predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldMinMessageLengthForSummary, v))
+}
+
+// MinMessageLengthForSummaryNEQ applies the NEQ predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldMinMessageLengthForSummary, v))
+}
+
+// MinMessageLengthForSummaryIn applies the In predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldMinMessageLengthForSummary, vs...))
+}
+
+// MinMessageLengthForSummaryNotIn applies the NotIn predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldMinMessageLengthForSummary, vs...))
+}
+
+// MinMessageLengthForSummaryGT applies the GT predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldMinMessageLengthForSummary, v))
+}
+
+// MinMessageLengthForSummaryGTE applies the GTE predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldMinMessageLengthForSummary, v))
+}
+
+// MinMessageLengthForSummaryLT applies the LT predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldMinMessageLengthForSummary, v))
+}
+
+// MinMessageLengthForSummaryLTE applies the LTE predicate on the "min_message_length_for_summary" field.
+func MinMessageLengthForSummaryLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldMinMessageLengthForSummary, v))
+}
+
+// CountShortMessagesForActivityEQ applies the EQ predicate on the "count_short_messages_for_activity" field.
+func CountShortMessagesForActivityEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldCountShortMessagesForActivity, v))
+}
+
+// CountShortMessagesForActivityNEQ applies the NEQ predicate on the "count_short_messages_for_activity" field.
+func CountShortMessagesForActivityNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldCountShortMessagesForActivity, v))
+}
+
+// SummaryLanguagesEQ applies the EQ predicate on the "summary_languages" field.
+func SummaryLanguagesEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesNEQ applies the NEQ predicate on the "summary_languages" field.
+func SummaryLanguagesNEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesIn applies the In predicate on the "summary_languages" field.
+func SummaryLanguagesIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldSummaryLanguages, vs...))
+}
+
+// SummaryLanguagesNotIn applies the NotIn predicate on the "summary_languages" field.
+func SummaryLanguagesNotIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldSummaryLanguages, vs...))
+}
+
+// SummaryLanguagesGT applies the GT predicate on the "summary_languages" field.
+func SummaryLanguagesGT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesGTE applies the GTE predicate on the "summary_languages" field.
+func SummaryLanguagesGTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesLT applies the LT predicate on the "summary_languages" field.
+func SummaryLanguagesLT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesLTE applies the LTE predicate on the "summary_languages" field.
+func SummaryLanguagesLTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesContains applies the Contains predicate on the "summary_languages" field.
+func SummaryLanguagesContains(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContains(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesHasPrefix applies the HasPrefix predicate on the "summary_languages" field.
+func SummaryLanguagesHasPrefix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasPrefix(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesHasSuffix applies the HasSuffix predicate on the "summary_languages" field.
+func SummaryLanguagesHasSuffix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasSuffix(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesEqualFold applies the EqualFold predicate on the "summary_languages" field.
+func SummaryLanguagesEqualFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEqualFold(FieldSummaryLanguages, v))
+}
+
+// SummaryLanguagesContainsFold applies the ContainsFold predicate on the "summary_languages" field.
+func SummaryLanguagesContainsFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContainsFold(FieldSummaryLanguages, v))
+}
+
+// SubscribeMinMembershipDaysEQ applies the EQ predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSubscribeMinMembershipDays, v))
+}
+
+// SubscribeMinMembershipDaysNEQ applies the NEQ predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldSubscribeMinMembershipDays, v))
+}
+
+// SubscribeMinMembershipDaysIn applies the In predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldSubscribeMinMembershipDays, vs...))
+}
+
+// SubscribeMinMembershipDaysNotIn applies the NotIn predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldSubscribeMinMembershipDays, vs...))
+}
+
+// SubscribeMinMembershipDaysGT applies the GT predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldSubscribeMinMembershipDays, v))
+}
+
+// SubscribeMinMembershipDaysGTE applies the GTE predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldSubscribeMinMembershipDays, v))
+}
+
+// SubscribeMinMembershipDaysLT applies the LT predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldSubscribeMinMembershipDays, v))
+}
+
+// SubscribeMinMembershipDaysLTE applies the LTE predicate on the "subscribe_min_membership_days" field.
+func SubscribeMinMembershipDaysLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldSubscribeMinMembershipDays, v))
+}
+
+// SubscribeMinMemberStatusEQ applies the EQ predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSubscribeMinMemberStatus, v))
+}
+
+// SubscribeMinMemberStatusNEQ applies the NEQ predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldSubscribeMinMemberStatus, v))
+}
+
+// SubscribeMinMemberStatusIn applies the In predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldSubscribeMinMemberStatus, vs...))
+}
+
+// SubscribeMinMemberStatusNotIn applies the NotIn predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldSubscribeMinMemberStatus, vs...))
+}
+
+// SubscribeMinMemberStatusGT applies the GT predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldSubscribeMinMemberStatus, v))
+}
+
+// SubscribeMinMemberStatusGTE applies the GTE predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldSubscribeMinMemberStatus, v))
+}
+
+// SubscribeMinMemberStatusLT applies the LT predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldSubscribeMinMemberStatus, v))
+}
+
+// SubscribeMinMemberStatusLTE applies the LTE predicate on the "subscribe_min_member_status" field.
+func SubscribeMinMemberStatusLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldSubscribeMinMemberStatus, v))
+}
+
+// TopKeywordsCountEQ applies the EQ predicate on the "top_keywords_count" field.
+func TopKeywordsCountEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldTopKeywordsCount, v))
+}
+
+// TopKeywordsCountNEQ applies the NEQ predicate on the "top_keywords_count" field.
+func TopKeywordsCountNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldTopKeywordsCount, v))
+}
+
+// TopKeywordsCountIn applies the In predicate on the "top_keywords_count" field.
+func TopKeywordsCountIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldTopKeywordsCount, vs...))
+}
+
+// TopKeywordsCountNotIn applies the NotIn predicate on the "top_keywords_count" field.
+func TopKeywordsCountNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldTopKeywordsCount, vs...))
+}
+
+// TopKeywordsCountGT applies the GT predicate on the "top_keywords_count" field.
+func TopKeywordsCountGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldTopKeywordsCount, v))
+}
+
+// TopKeywordsCountGTE applies the GTE predicate on the "top_keywords_count" field.
+func TopKeywordsCountGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldTopKeywordsCount, v))
+}
+
+// TopKeywordsCountLT applies the LT predicate on the "top_keywords_count" field.
+func TopKeywordsCountLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldTopKeywordsCount, v))
+}
+
+// TopKeywordsCountLTE applies the LTE predicate on the "top_keywords_count" field.
+func TopKeywordsCountLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldTopKeywordsCount, v))
+}
+
+// DedupForwardsEQ applies the EQ predicate on the "dedup_forwards" field.
+func DedupForwardsEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldDedupForwards, v))
+}
+
+// DedupForwardsNEQ applies the NEQ predicate on the "dedup_forwards" field.
+func DedupForwardsNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldDedupForwards, v))
+}
+
+// StoreMessageContentEQ applies the EQ predicate on the "store_message_content" field.
+func StoreMessageContentEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldStoreMessageContent, v))
+}
+
+// StoreMessageContentNEQ applies the NEQ predicate on the "store_message_content" field.
+func StoreMessageContentNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldStoreMessageContent, v))
+}
+
+// AnonymizeParticipantsEQ applies the EQ predicate on the "anonymize_participants" field.
+func AnonymizeParticipantsEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldAnonymizeParticipants, v))
+}
+
+// AnonymizeParticipantsNEQ applies the NEQ predicate on the "anonymize_participants" field.
+func AnonymizeParticipantsNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldAnonymizeParticipants, v))
+}
+
+// ManualRecapPrivateEQ applies the EQ predicate on the "manual_recap_private" field.
+func ManualRecapPrivateEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldManualRecapPrivate, v))
+}
+
+// ManualRecapPrivateNEQ applies the NEQ predicate on the "manual_recap_private" field.
+func ManualRecapPrivateNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldManualRecapPrivate, v))
+}
+
+// RecapInProgressTemplateEQ applies the EQ predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateNEQ applies the NEQ predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateNEQ(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateIn applies the In predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldRecapInProgressTemplate, vs...))
+}
+
+// RecapInProgressTemplateNotIn applies the NotIn predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateNotIn(vs ...string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldRecapInProgressTemplate, vs...))
+}
+
+// RecapInProgressTemplateGT applies the GT predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateGT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateGTE applies the GTE predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateGTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateLT applies the LT predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateLT(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateLTE applies the LTE predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateLTE(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateContains applies the Contains predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateContains(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContains(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateHasPrefix applies the HasPrefix predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateHasPrefix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasPrefix(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateHasSuffix applies the HasSuffix predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateHasSuffix(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldHasSuffix(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateEqualFold applies the EqualFold predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateEqualFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEqualFold(FieldRecapInProgressTemplate, v))
+}
+
+// RecapInProgressTemplateContainsFold applies the ContainsFold predicate on the "recap_in_progress_template" field.
+func RecapInProgressTemplateContainsFold(v string) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldContainsFold(FieldRecapInProgressTemplate, v))
+}
+
+// ExcludedMessageTypesEQ applies the EQ predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldExcludedMessageTypes, v))
+}
+
+// ExcludedMessageTypesNEQ applies the NEQ predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldExcludedMessageTypes, v))
+}
+
+// ExcludedMessageTypesIn applies the In predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldExcludedMessageTypes, vs...))
+}
+
+// ExcludedMessageTypesNotIn applies the NotIn predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldExcludedMessageTypes, vs...))
+}
+
+// ExcludedMessageTypesGT applies the GT predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldExcludedMessageTypes, v))
+}
+
+// ExcludedMessageTypesGTE applies the GTE predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldExcludedMessageTypes, v))
+}
+
+// ExcludedMessageTypesLT applies the LT predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldExcludedMessageTypes, v))
+}
+
+// ExcludedMessageTypesLTE applies the LTE predicate on the "excluded_message_types" field.
+func ExcludedMessageTypesLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldExcludedMessageTypes, v))
+}
+
+// RecapWeekdaysIsNil applies the IsNil predicate on the "recap_weekdays" field.
+func RecapWeekdaysIsNil() predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIsNull(FieldRecapWeekdays))
+}
+
+// RecapWeekdaysNotNil applies the NotNil predicate on the "recap_weekdays" field.
+func RecapWeekdaysNotNil() predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotNull(FieldRecapWeekdays))
+}
+
+// RelatedMessagesCountEQ applies the EQ predicate on the "related_messages_count" field.
+func RelatedMessagesCountEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRelatedMessagesCount, v))
+}
+
+// RelatedMessagesCountNEQ applies the NEQ predicate on the "related_messages_count" field.
+func RelatedMessagesCountNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRelatedMessagesCount, v))
+}
+
+// RelatedMessagesCountIn applies the In predicate on the "related_messages_count" field.
+func RelatedMessagesCountIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldRelatedMessagesCount, vs...))
+}
+
+// RelatedMessagesCountNotIn applies the NotIn predicate on the "related_messages_count" field.
+func RelatedMessagesCountNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldRelatedMessagesCount, vs...))
+}
+
+// RelatedMessagesCountGT applies the GT predicate on the "related_messages_count" field.
+func RelatedMessagesCountGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldRelatedMessagesCount, v))
+}
+
+// RelatedMessagesCountGTE applies the GTE predicate on the "related_messages_count" field.
+func RelatedMessagesCountGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldRelatedMessagesCount, v))
+}
+
+// RelatedMessagesCountLT applies the LT predicate on the "related_messages_count" field.
+func RelatedMessagesCountLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldRelatedMessagesCount, v))
+}
+
+// RelatedMessagesCountLTE applies the LTE predicate on the "related_messages_count" field.
+func RelatedMessagesCountLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldRelatedMessagesCount, v))
+}
+
+// IncrementalRecapEQ applies the EQ predicate on the "incremental_recap" field.
+func IncrementalRecapEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldIncrementalRecap, v))
+}
+
+// IncrementalRecapNEQ applies the NEQ predicate on the "incremental_recap" field.
+func IncrementalRecapNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldIncrementalRecap, v))
+}
+
+// RecapLinkPreviewEQ applies the EQ predicate on the "recap_link_preview" field.
+func RecapLinkPreviewEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapLinkPreview, v))
+}
+
+// RecapLinkPreviewNEQ applies the NEQ predicate on the "recap_link_preview" field.
+func RecapLinkPreviewNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapLinkPreview, v))
+}
+
+// AutoUnpinAfterSecondsEQ applies the EQ predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldAutoUnpinAfterSeconds, v))
+}
+
+// AutoUnpinAfterSecondsNEQ applies the NEQ predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsNEQ(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldAutoUnpinAfterSeconds, v))
+}
+
+// AutoUnpinAfterSecondsIn applies the In predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldAutoUnpinAfterSeconds, vs...))
+}
+
+// AutoUnpinAfterSecondsNotIn applies the NotIn predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsNotIn(vs ...int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldAutoUnpinAfterSeconds, vs...))
+}
+
+// AutoUnpinAfterSecondsGT applies the GT predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsGT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldAutoUnpinAfterSeconds, v))
+}
+
+// AutoUnpinAfterSecondsGTE applies the GTE predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsGTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldAutoUnpinAfterSeconds, v))
+}
+
+// AutoUnpinAfterSecondsLT applies the LT predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsLT(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldAutoUnpinAfterSeconds, v))
+}
+
+// AutoUnpinAfterSecondsLTE applies the LTE predicate on the "auto_unpin_after_seconds" field.
+func AutoUnpinAfterSecondsLTE(v int64) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldAutoUnpinAfterSeconds, v))
+}
+
+// RecapThreadIDEQ applies the EQ predicate on the "recap_thread_id" field.
+func RecapThreadIDEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapThreadID, v))
+}
+
+// RecapThreadIDNEQ applies the NEQ predicate on the "recap_thread_id" field.
+func RecapThreadIDNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapThreadID, v))
+}
+
+// RecapThreadIDIn applies the In predicate on the "recap_thread_id" field.
+func RecapThreadIDIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldRecapThreadID, vs...))
+}
+
+// RecapThreadIDNotIn applies the NotIn predicate on the "recap_thread_id" field.
+func RecapThreadIDNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldRecapThreadID, vs...))
+}
+
+// RecapThreadIDGT applies the GT predicate on the "recap_thread_id" field.
+func RecapThreadIDGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldRecapThreadID, v))
+}
+
+// RecapThreadIDGTE applies the GTE predicate on the "recap_thread_id" field.
+func RecapThreadIDGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldRecapThreadID, v))
+}
+
+// RecapThreadIDLT applies the LT predicate on the "recap_thread_id" field.
+func RecapThreadIDLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldRecapThreadID, v))
+}
+
+// RecapThreadIDLTE applies the LTE predicate on the "recap_thread_id" field.
+func RecapThreadIDLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldRecapThreadID, v))
+}
+
+// ManualRecapRateLimitExemptAdminsEQ applies the EQ predicate on the "manual_recap_rate_limit_exempt_admins" field.
+func ManualRecapRateLimitExemptAdminsEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldManualRecapRateLimitExemptAdmins, v))
+}
+
+// ManualRecapRateLimitExemptAdminsNEQ applies the NEQ predicate on the "manual_recap_rate_limit_exempt_admins" field.
+func ManualRecapRateLimitExemptAdminsNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldManualRecapRateLimitExemptAdmins, v))
+}
+
+// ManualRecapMinRoleEQ applies the EQ predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldManualRecapMinRole, v))
+}
+
+// ManualRecapMinRoleNEQ applies the NEQ predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldManualRecapMinRole, v))
+}
+
+// ManualRecapMinRoleIn applies the In predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldManualRecapMinRole, vs...))
+}
+
+// ManualRecapMinRoleNotIn applies the NotIn predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldManualRecapMinRole, vs...))
+}
+
+// ManualRecapMinRoleGT applies the GT predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldManualRecapMinRole, v))
+}
+
+// ManualRecapMinRoleGTE applies the GTE predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldManualRecapMinRole, v))
+}
+
+// ManualRecapMinRoleLT applies the LT predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldManualRecapMinRole, v))
+}
+
+// ManualRecapMinRoleLTE applies the LTE predicate on the "manual_recap_min_role" field.
+func ManualRecapMinRoleLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldManualRecapMinRole, v))
+}
+
+// ApprovalRequiredEQ applies the EQ predicate on the "approval_required" field.
+func ApprovalRequiredEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldApprovalRequired, v))
+}
+
+// ApprovalRequiredNEQ applies the NEQ predicate on the "approval_required" field.
+func ApprovalRequiredNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldApprovalRequired, v))
+}
+
+// CollectOnlyEQ applies the EQ predicate on the "collect_only" field.
+func CollectOnlyEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldCollectOnly, v))
+}
+
+// CollectOnlyNEQ applies the NEQ predicate on the "collect_only" field.
+func CollectOnlyNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldCollectOnly, v))
+}
+
+// RecapDocumentAttachmentEQ applies the EQ predicate on the "recap_document_attachment" field.
+func RecapDocumentAttachmentEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldRecapDocumentAttachment, v))
+}
+
+// RecapDocumentAttachmentNEQ applies the NEQ predicate on the "recap_document_attachment" field.
+func RecapDocumentAttachmentNEQ(v bool) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldRecapDocumentAttachment, v))
+}
+
+// SummaryMaxTokensEQ applies the EQ predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldSummaryMaxTokens, v))
+}
+
+// SummaryMaxTokensNEQ applies the NEQ predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensNEQ(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNEQ(FieldSummaryMaxTokens, v))
+}
+
+// SummaryMaxTokensIn applies the In predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldIn(FieldSummaryMaxTokens, vs...))
+}
+
+// SummaryMaxTokensNotIn applies the NotIn predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensNotIn(vs ...int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldNotIn(FieldSummaryMaxTokens, vs...))
+}
+
+// SummaryMaxTokensGT applies the GT predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensGT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGT(FieldSummaryMaxTokens, v))
+}
+
+// SummaryMaxTokensGTE applies the GTE predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensGTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldGTE(FieldSummaryMaxTokens, v))
+}
+
+// SummaryMaxTokensLT applies the LT predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensLT(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLT(FieldSummaryMaxTokens, v))
+}
+
+// SummaryMaxTokensLTE applies the LTE predicate on the "summary_max_tokens" field.
+func SummaryMaxTokensLTE(v int) predicate.TelegramChatRecapsOptions {
+	return predicate.TelegramChatRecapsOptions(sql.FieldLTE(FieldSummaryMaxTokens, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v int64) predicate.TelegramChatRecapsOptions {
 	return predicate.TelegramChatRecapsOptions(sql.FieldEQ(FieldCreatedAt, v))
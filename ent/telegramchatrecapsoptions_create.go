@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -82,6 +83,488 @@ func (_c *TelegramChatRecapsOptionsCreate) SetNillablePinAutoRecapMessage(v *boo
 	return _c
 }
 
+// SetPinAutoRecapMessageSilently sets the "pin_auto_recap_message_silently" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetPinAutoRecapMessageSilently(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetPinAutoRecapMessageSilently(v)
+	return _c
+}
+
+// SetNillablePinAutoRecapMessageSilently sets the "pin_auto_recap_message_silently" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillablePinAutoRecapMessageSilently(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetPinAutoRecapMessageSilently(*v)
+	}
+	return _c
+}
+
+// SetRecapDisclaimer sets the "recap_disclaimer" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapDisclaimer(v string) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapDisclaimer(v)
+	return _c
+}
+
+// SetNillableRecapDisclaimer sets the "recap_disclaimer" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapDisclaimer(v *string) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapDisclaimer(*v)
+	}
+	return _c
+}
+
+// SetRecapTargetChatID sets the "recap_target_chat_id" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapTargetChatID(v int64) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapTargetChatID(v)
+	return _c
+}
+
+// SetNillableRecapTargetChatID sets the "recap_target_chat_id" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapTargetChatID(v *int64) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapTargetChatID(*v)
+	}
+	return _c
+}
+
+// SetRecapPersona sets the "recap_persona" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapPersona(v string) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapPersona(v)
+	return _c
+}
+
+// SetNillableRecapPersona sets the "recap_persona" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapPersona(v *string) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapPersona(*v)
+	}
+	return _c
+}
+
+// SetIncludeBotMessages sets the "include_bot_messages" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetIncludeBotMessages(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetIncludeBotMessages(v)
+	return _c
+}
+
+// SetNillableIncludeBotMessages sets the "include_bot_messages" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableIncludeBotMessages(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetIncludeBotMessages(*v)
+	}
+	return _c
+}
+
+// SetAutoRecapsSnoozedUntil sets the "auto_recaps_snoozed_until" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetAutoRecapsSnoozedUntil(v int64) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetAutoRecapsSnoozedUntil(v)
+	return _c
+}
+
+// SetNillableAutoRecapsSnoozedUntil sets the "auto_recaps_snoozed_until" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableAutoRecapsSnoozedUntil(v *int64) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetAutoRecapsSnoozedUntil(*v)
+	}
+	return _c
+}
+
+// SetQuietNoticeEnabled sets the "quiet_notice_enabled" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetQuietNoticeEnabled(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetQuietNoticeEnabled(v)
+	return _c
+}
+
+// SetNillableQuietNoticeEnabled sets the "quiet_notice_enabled" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableQuietNoticeEnabled(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetQuietNoticeEnabled(*v)
+	}
+	return _c
+}
+
+// SetLastQuietNoticeAt sets the "last_quiet_notice_at" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetLastQuietNoticeAt(v int64) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetLastQuietNoticeAt(v)
+	return _c
+}
+
+// SetNillableLastQuietNoticeAt sets the "last_quiet_notice_at" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableLastQuietNoticeAt(v *int64) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetLastQuietNoticeAt(*v)
+	}
+	return _c
+}
+
+// SetPerTopicMessages sets the "per_topic_messages" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetPerTopicMessages(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetPerTopicMessages(v)
+	return _c
+}
+
+// SetNillablePerTopicMessages sets the "per_topic_messages" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillablePerTopicMessages(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetPerTopicMessages(*v)
+	}
+	return _c
+}
+
+// SetSummaryTemperature sets the "summary_temperature" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetSummaryTemperature(v float64) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetSummaryTemperature(v)
+	return _c
+}
+
+// SetNillableSummaryTemperature sets the "summary_temperature" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableSummaryTemperature(v *float64) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetSummaryTemperature(*v)
+	}
+	return _c
+}
+
+// SetRecapOutputFormat sets the "recap_output_format" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapOutputFormat(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapOutputFormat(v)
+	return _c
+}
+
+// SetNillableRecapOutputFormat sets the "recap_output_format" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapOutputFormat(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapOutputFormat(*v)
+	}
+	return _c
+}
+
+// SetMinMessageLengthForSummary sets the "min_message_length_for_summary" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetMinMessageLengthForSummary(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetMinMessageLengthForSummary(v)
+	return _c
+}
+
+// SetNillableMinMessageLengthForSummary sets the "min_message_length_for_summary" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableMinMessageLengthForSummary(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetMinMessageLengthForSummary(*v)
+	}
+	return _c
+}
+
+// SetCountShortMessagesForActivity sets the "count_short_messages_for_activity" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetCountShortMessagesForActivity(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetCountShortMessagesForActivity(v)
+	return _c
+}
+
+// SetNillableCountShortMessagesForActivity sets the "count_short_messages_for_activity" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableCountShortMessagesForActivity(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetCountShortMessagesForActivity(*v)
+	}
+	return _c
+}
+
+// SetSummaryLanguages sets the "summary_languages" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetSummaryLanguages(v string) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetSummaryLanguages(v)
+	return _c
+}
+
+// SetNillableSummaryLanguages sets the "summary_languages" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableSummaryLanguages(v *string) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetSummaryLanguages(*v)
+	}
+	return _c
+}
+
+// SetSubscribeMinMembershipDays sets the "subscribe_min_membership_days" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetSubscribeMinMembershipDays(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetSubscribeMinMembershipDays(v)
+	return _c
+}
+
+// SetNillableSubscribeMinMembershipDays sets the "subscribe_min_membership_days" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableSubscribeMinMembershipDays(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetSubscribeMinMembershipDays(*v)
+	}
+	return _c
+}
+
+// SetSubscribeMinMemberStatus sets the "subscribe_min_member_status" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetSubscribeMinMemberStatus(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetSubscribeMinMemberStatus(v)
+	return _c
+}
+
+// SetNillableSubscribeMinMemberStatus sets the "subscribe_min_member_status" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableSubscribeMinMemberStatus(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetSubscribeMinMemberStatus(*v)
+	}
+	return _c
+}
+
+// SetTopKeywordsCount sets the "top_keywords_count" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetTopKeywordsCount(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetTopKeywordsCount(v)
+	return _c
+}
+
+// SetNillableTopKeywordsCount sets the "top_keywords_count" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableTopKeywordsCount(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetTopKeywordsCount(*v)
+	}
+	return _c
+}
+
+// SetDedupForwards sets the "dedup_forwards" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetDedupForwards(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetDedupForwards(v)
+	return _c
+}
+
+// SetNillableDedupForwards sets the "dedup_forwards" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableDedupForwards(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetDedupForwards(*v)
+	}
+	return _c
+}
+
+// SetStoreMessageContent sets the "store_message_content" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetStoreMessageContent(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetStoreMessageContent(v)
+	return _c
+}
+
+// SetNillableStoreMessageContent sets the "store_message_content" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableStoreMessageContent(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetStoreMessageContent(*v)
+	}
+	return _c
+}
+
+// SetAnonymizeParticipants sets the "anonymize_participants" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetAnonymizeParticipants(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetAnonymizeParticipants(v)
+	return _c
+}
+
+// SetNillableAnonymizeParticipants sets the "anonymize_participants" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableAnonymizeParticipants(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetAnonymizeParticipants(*v)
+	}
+	return _c
+}
+
+// SetManualRecapPrivate sets the "manual_recap_private" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetManualRecapPrivate(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetManualRecapPrivate(v)
+	return _c
+}
+
+// SetNillableManualRecapPrivate sets the "manual_recap_private" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableManualRecapPrivate(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetManualRecapPrivate(*v)
+	}
+	return _c
+}
+
+// SetRecapInProgressTemplate sets the "recap_in_progress_template" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapInProgressTemplate(v string) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapInProgressTemplate(v)
+	return _c
+}
+
+// SetNillableRecapInProgressTemplate sets the "recap_in_progress_template" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapInProgressTemplate(v *string) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapInProgressTemplate(*v)
+	}
+	return _c
+}
+
+// SetExcludedMessageTypes sets the "excluded_message_types" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetExcludedMessageTypes(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetExcludedMessageTypes(v)
+	return _c
+}
+
+// SetNillableExcludedMessageTypes sets the "excluded_message_types" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableExcludedMessageTypes(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetExcludedMessageTypes(*v)
+	}
+	return _c
+}
+
+// SetRecapWeekdays sets the "recap_weekdays" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapWeekdays(v []time.Weekday) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapWeekdays(v)
+	return _c
+}
+
+// SetRelatedMessagesCount sets the "related_messages_count" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRelatedMessagesCount(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRelatedMessagesCount(v)
+	return _c
+}
+
+// SetNillableRelatedMessagesCount sets the "related_messages_count" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRelatedMessagesCount(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRelatedMessagesCount(*v)
+	}
+	return _c
+}
+
+// SetIncrementalRecap sets the "incremental_recap" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetIncrementalRecap(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetIncrementalRecap(v)
+	return _c
+}
+
+// SetNillableIncrementalRecap sets the "incremental_recap" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableIncrementalRecap(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetIncrementalRecap(*v)
+	}
+	return _c
+}
+
+// SetRecapLinkPreview sets the "recap_link_preview" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapLinkPreview(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapLinkPreview(v)
+	return _c
+}
+
+// SetNillableRecapLinkPreview sets the "recap_link_preview" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapLinkPreview(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapLinkPreview(*v)
+	}
+	return _c
+}
+
+// SetAutoUnpinAfterSeconds sets the "auto_unpin_after_seconds" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetAutoUnpinAfterSeconds(v int64) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetAutoUnpinAfterSeconds(v)
+	return _c
+}
+
+// SetNillableAutoUnpinAfterSeconds sets the "auto_unpin_after_seconds" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableAutoUnpinAfterSeconds(v *int64) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetAutoUnpinAfterSeconds(*v)
+	}
+	return _c
+}
+
+// SetRecapThreadID sets the "recap_thread_id" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapThreadID(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapThreadID(v)
+	return _c
+}
+
+// SetNillableRecapThreadID sets the "recap_thread_id" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapThreadID(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapThreadID(*v)
+	}
+	return _c
+}
+
+// SetManualRecapRateLimitExemptAdmins sets the "manual_recap_rate_limit_exempt_admins" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetManualRecapRateLimitExemptAdmins(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetManualRecapRateLimitExemptAdmins(v)
+	return _c
+}
+
+// SetNillableManualRecapRateLimitExemptAdmins sets the "manual_recap_rate_limit_exempt_admins" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableManualRecapRateLimitExemptAdmins(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetManualRecapRateLimitExemptAdmins(*v)
+	}
+	return _c
+}
+
+// SetManualRecapMinRole sets the "manual_recap_min_role" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetManualRecapMinRole(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetManualRecapMinRole(v)
+	return _c
+}
+
+// SetNillableManualRecapMinRole sets the "manual_recap_min_role" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableManualRecapMinRole(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetManualRecapMinRole(*v)
+	}
+	return _c
+}
+
+// SetApprovalRequired sets the "approval_required" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetApprovalRequired(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetApprovalRequired(v)
+	return _c
+}
+
+// SetNillableApprovalRequired sets the "approval_required" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableApprovalRequired(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetApprovalRequired(*v)
+	}
+	return _c
+}
+
+// SetCollectOnly sets the "collect_only" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetCollectOnly(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetCollectOnly(v)
+	return _c
+}
+
+// SetNillableCollectOnly sets the "collect_only" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableCollectOnly(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetCollectOnly(*v)
+	}
+	return _c
+}
+
+// SetRecapDocumentAttachment sets the "recap_document_attachment" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetRecapDocumentAttachment(v bool) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetRecapDocumentAttachment(v)
+	return _c
+}
+
+// SetNillableRecapDocumentAttachment sets the "recap_document_attachment" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableRecapDocumentAttachment(v *bool) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetRecapDocumentAttachment(*v)
+	}
+	return _c
+}
+
+// SetSummaryMaxTokens sets the "summary_max_tokens" field.
+func (_c *TelegramChatRecapsOptionsCreate) SetSummaryMaxTokens(v int) *TelegramChatRecapsOptionsCreate {
+	_c.mutation.SetSummaryMaxTokens(v)
+	return _c
+}
+
+// SetNillableSummaryMaxTokens sets the "summary_max_tokens" field if the given value is not nil.
+func (_c *TelegramChatRecapsOptionsCreate) SetNillableSummaryMaxTokens(v *int) *TelegramChatRecapsOptionsCreate {
+	if v != nil {
+		_c.SetSummaryMaxTokens(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TelegramChatRecapsOptionsCreate) SetCreatedAt(v int64) *TelegramChatRecapsOptionsCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -175,6 +658,142 @@ func (_c *TelegramChatRecapsOptionsCreate) defaults() {
 		v := telegramchatrecapsoptions.DefaultPinAutoRecapMessage
 		_c.mutation.SetPinAutoRecapMessage(v)
 	}
+	if _, ok := _c.mutation.PinAutoRecapMessageSilently(); !ok {
+		v := telegramchatrecapsoptions.DefaultPinAutoRecapMessageSilently
+		_c.mutation.SetPinAutoRecapMessageSilently(v)
+	}
+	if _, ok := _c.mutation.RecapDisclaimer(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapDisclaimer
+		_c.mutation.SetRecapDisclaimer(v)
+	}
+	if _, ok := _c.mutation.RecapTargetChatID(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapTargetChatID
+		_c.mutation.SetRecapTargetChatID(v)
+	}
+	if _, ok := _c.mutation.RecapPersona(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapPersona
+		_c.mutation.SetRecapPersona(v)
+	}
+	if _, ok := _c.mutation.IncludeBotMessages(); !ok {
+		v := telegramchatrecapsoptions.DefaultIncludeBotMessages
+		_c.mutation.SetIncludeBotMessages(v)
+	}
+	if _, ok := _c.mutation.AutoRecapsSnoozedUntil(); !ok {
+		v := telegramchatrecapsoptions.DefaultAutoRecapsSnoozedUntil
+		_c.mutation.SetAutoRecapsSnoozedUntil(v)
+	}
+	if _, ok := _c.mutation.QuietNoticeEnabled(); !ok {
+		v := telegramchatrecapsoptions.DefaultQuietNoticeEnabled
+		_c.mutation.SetQuietNoticeEnabled(v)
+	}
+	if _, ok := _c.mutation.LastQuietNoticeAt(); !ok {
+		v := telegramchatrecapsoptions.DefaultLastQuietNoticeAt
+		_c.mutation.SetLastQuietNoticeAt(v)
+	}
+	if _, ok := _c.mutation.PerTopicMessages(); !ok {
+		v := telegramchatrecapsoptions.DefaultPerTopicMessages
+		_c.mutation.SetPerTopicMessages(v)
+	}
+	if _, ok := _c.mutation.SummaryTemperature(); !ok {
+		v := telegramchatrecapsoptions.DefaultSummaryTemperature
+		_c.mutation.SetSummaryTemperature(v)
+	}
+	if _, ok := _c.mutation.RecapOutputFormat(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapOutputFormat
+		_c.mutation.SetRecapOutputFormat(v)
+	}
+	if _, ok := _c.mutation.MinMessageLengthForSummary(); !ok {
+		v := telegramchatrecapsoptions.DefaultMinMessageLengthForSummary
+		_c.mutation.SetMinMessageLengthForSummary(v)
+	}
+	if _, ok := _c.mutation.CountShortMessagesForActivity(); !ok {
+		v := telegramchatrecapsoptions.DefaultCountShortMessagesForActivity
+		_c.mutation.SetCountShortMessagesForActivity(v)
+	}
+	if _, ok := _c.mutation.SummaryLanguages(); !ok {
+		v := telegramchatrecapsoptions.DefaultSummaryLanguages
+		_c.mutation.SetSummaryLanguages(v)
+	}
+	if _, ok := _c.mutation.SubscribeMinMembershipDays(); !ok {
+		v := telegramchatrecapsoptions.DefaultSubscribeMinMembershipDays
+		_c.mutation.SetSubscribeMinMembershipDays(v)
+	}
+	if _, ok := _c.mutation.SubscribeMinMemberStatus(); !ok {
+		v := telegramchatrecapsoptions.DefaultSubscribeMinMemberStatus
+		_c.mutation.SetSubscribeMinMemberStatus(v)
+	}
+	if _, ok := _c.mutation.TopKeywordsCount(); !ok {
+		v := telegramchatrecapsoptions.DefaultTopKeywordsCount
+		_c.mutation.SetTopKeywordsCount(v)
+	}
+	if _, ok := _c.mutation.DedupForwards(); !ok {
+		v := telegramchatrecapsoptions.DefaultDedupForwards
+		_c.mutation.SetDedupForwards(v)
+	}
+	if _, ok := _c.mutation.StoreMessageContent(); !ok {
+		v := telegramchatrecapsoptions.DefaultStoreMessageContent
+		_c.mutation.SetStoreMessageContent(v)
+	}
+	if _, ok := _c.mutation.AnonymizeParticipants(); !ok {
+		v := telegramchatrecapsoptions.DefaultAnonymizeParticipants
+		_c.mutation.SetAnonymizeParticipants(v)
+	}
+	if _, ok := _c.mutation.ManualRecapPrivate(); !ok {
+		v := telegramchatrecapsoptions.DefaultManualRecapPrivate
+		_c.mutation.SetManualRecapPrivate(v)
+	}
+	if _, ok := _c.mutation.RecapInProgressTemplate(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapInProgressTemplate
+		_c.mutation.SetRecapInProgressTemplate(v)
+	}
+	if _, ok := _c.mutation.ExcludedMessageTypes(); !ok {
+		v := telegramchatrecapsoptions.DefaultExcludedMessageTypes
+		_c.mutation.SetExcludedMessageTypes(v)
+	}
+	if _, ok := _c.mutation.RelatedMessagesCount(); !ok {
+		v := telegramchatrecapsoptions.DefaultRelatedMessagesCount
+		_c.mutation.SetRelatedMessagesCount(v)
+	}
+	if _, ok := _c.mutation.IncrementalRecap(); !ok {
+		v := telegramchatrecapsoptions.DefaultIncrementalRecap
+		_c.mutation.SetIncrementalRecap(v)
+	}
+	if _, ok := _c.mutation.RecapLinkPreview(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapLinkPreview
+		_c.mutation.SetRecapLinkPreview(v)
+	}
+	if _, ok := _c.mutation.AutoUnpinAfterSeconds(); !ok {
+		v := telegramchatrecapsoptions.DefaultAutoUnpinAfterSeconds
+		_c.mutation.SetAutoUnpinAfterSeconds(v)
+	}
+	if _, ok := _c.mutation.RecapThreadID(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapThreadID
+		_c.mutation.SetRecapThreadID(v)
+	}
+	if _, ok := _c.mutation.ManualRecapRateLimitExemptAdmins(); !ok {
+		v := telegramchatrecapsoptions.DefaultManualRecapRateLimitExemptAdmins
+		_c.mutation.SetManualRecapRateLimitExemptAdmins(v)
+	}
+	if _, ok := _c.mutation.ManualRecapMinRole(); !ok {
+		v := telegramchatrecapsoptions.DefaultManualRecapMinRole
+		_c.mutation.SetManualRecapMinRole(v)
+	}
+	if _, ok := _c.mutation.ApprovalRequired(); !ok {
+		v := telegramchatrecapsoptions.DefaultApprovalRequired
+		_c.mutation.SetApprovalRequired(v)
+	}
+	if _, ok := _c.mutation.CollectOnly(); !ok {
+		v := telegramchatrecapsoptions.DefaultCollectOnly
+		_c.mutation.SetCollectOnly(v)
+	}
+	if _, ok := _c.mutation.RecapDocumentAttachment(); !ok {
+		v := telegramchatrecapsoptions.DefaultRecapDocumentAttachment
+		_c.mutation.SetRecapDocumentAttachment(v)
+	}
+	if _, ok := _c.mutation.SummaryMaxTokens(); !ok {
+		v := telegramchatrecapsoptions.DefaultSummaryMaxTokens
+		_c.mutation.SetSummaryMaxTokens(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := telegramchatrecapsoptions.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -206,6 +825,108 @@ func (_c *TelegramChatRecapsOptionsCreate) check() error {
 	if _, ok := _c.mutation.PinAutoRecapMessage(); !ok {
 		return &ValidationError{Name: "pin_auto_recap_message", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.pin_auto_recap_message"`)}
 	}
+	if _, ok := _c.mutation.PinAutoRecapMessageSilently(); !ok {
+		return &ValidationError{Name: "pin_auto_recap_message_silently", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.pin_auto_recap_message_silently"`)}
+	}
+	if _, ok := _c.mutation.RecapDisclaimer(); !ok {
+		return &ValidationError{Name: "recap_disclaimer", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_disclaimer"`)}
+	}
+	if _, ok := _c.mutation.RecapTargetChatID(); !ok {
+		return &ValidationError{Name: "recap_target_chat_id", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_target_chat_id"`)}
+	}
+	if _, ok := _c.mutation.RecapPersona(); !ok {
+		return &ValidationError{Name: "recap_persona", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_persona"`)}
+	}
+	if _, ok := _c.mutation.IncludeBotMessages(); !ok {
+		return &ValidationError{Name: "include_bot_messages", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.include_bot_messages"`)}
+	}
+	if _, ok := _c.mutation.AutoRecapsSnoozedUntil(); !ok {
+		return &ValidationError{Name: "auto_recaps_snoozed_until", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.auto_recaps_snoozed_until"`)}
+	}
+	if _, ok := _c.mutation.QuietNoticeEnabled(); !ok {
+		return &ValidationError{Name: "quiet_notice_enabled", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.quiet_notice_enabled"`)}
+	}
+	if _, ok := _c.mutation.LastQuietNoticeAt(); !ok {
+		return &ValidationError{Name: "last_quiet_notice_at", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.last_quiet_notice_at"`)}
+	}
+	if _, ok := _c.mutation.PerTopicMessages(); !ok {
+		return &ValidationError{Name: "per_topic_messages", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.per_topic_messages"`)}
+	}
+	if _, ok := _c.mutation.SummaryTemperature(); !ok {
+		return &ValidationError{Name: "summary_temperature", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.summary_temperature"`)}
+	}
+	if _, ok := _c.mutation.RecapOutputFormat(); !ok {
+		return &ValidationError{Name: "recap_output_format", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_output_format"`)}
+	}
+	if _, ok := _c.mutation.MinMessageLengthForSummary(); !ok {
+		return &ValidationError{Name: "min_message_length_for_summary", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.min_message_length_for_summary"`)}
+	}
+	if _, ok := _c.mutation.CountShortMessagesForActivity(); !ok {
+		return &ValidationError{Name: "count_short_messages_for_activity", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.count_short_messages_for_activity"`)}
+	}
+	if _, ok := _c.mutation.SummaryLanguages(); !ok {
+		return &ValidationError{Name: "summary_languages", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.summary_languages"`)}
+	}
+	if _, ok := _c.mutation.SubscribeMinMembershipDays(); !ok {
+		return &ValidationError{Name: "subscribe_min_membership_days", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.subscribe_min_membership_days"`)}
+	}
+	if _, ok := _c.mutation.SubscribeMinMemberStatus(); !ok {
+		return &ValidationError{Name: "subscribe_min_member_status", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.subscribe_min_member_status"`)}
+	}
+	if _, ok := _c.mutation.TopKeywordsCount(); !ok {
+		return &ValidationError{Name: "top_keywords_count", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.top_keywords_count"`)}
+	}
+	if _, ok := _c.mutation.DedupForwards(); !ok {
+		return &ValidationError{Name: "dedup_forwards", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.dedup_forwards"`)}
+	}
+	if _, ok := _c.mutation.StoreMessageContent(); !ok {
+		return &ValidationError{Name: "store_message_content", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.store_message_content"`)}
+	}
+	if _, ok := _c.mutation.AnonymizeParticipants(); !ok {
+		return &ValidationError{Name: "anonymize_participants", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.anonymize_participants"`)}
+	}
+	if _, ok := _c.mutation.ManualRecapPrivate(); !ok {
+		return &ValidationError{Name: "manual_recap_private", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.manual_recap_private"`)}
+	}
+	if _, ok := _c.mutation.RecapInProgressTemplate(); !ok {
+		return &ValidationError{Name: "recap_in_progress_template", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_in_progress_template"`)}
+	}
+	if _, ok := _c.mutation.ExcludedMessageTypes(); !ok {
+		return &ValidationError{Name: "excluded_message_types", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.excluded_message_types"`)}
+	}
+	if _, ok := _c.mutation.RelatedMessagesCount(); !ok {
+		return &ValidationError{Name: "related_messages_count", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.related_messages_count"`)}
+	}
+	if _, ok := _c.mutation.IncrementalRecap(); !ok {
+		return &ValidationError{Name: "incremental_recap", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.incremental_recap"`)}
+	}
+	if _, ok := _c.mutation.RecapLinkPreview(); !ok {
+		return &ValidationError{Name: "recap_link_preview", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_link_preview"`)}
+	}
+	if _, ok := _c.mutation.AutoUnpinAfterSeconds(); !ok {
+		return &ValidationError{Name: "auto_unpin_after_seconds", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.auto_unpin_after_seconds"`)}
+	}
+	if _, ok := _c.mutation.RecapThreadID(); !ok {
+		return &ValidationError{Name: "recap_thread_id", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_thread_id"`)}
+	}
+	if _, ok := _c.mutation.ManualRecapRateLimitExemptAdmins(); !ok {
+		return &ValidationError{Name: "manual_recap_rate_limit_exempt_admins", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.manual_recap_rate_limit_exempt_admins"`)}
+	}
+	if _, ok := _c.mutation.ManualRecapMinRole(); !ok {
+		return &ValidationError{Name: "manual_recap_min_role", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.manual_recap_min_role"`)}
+	}
+	if _, ok := _c.mutation.ApprovalRequired(); !ok {
+		return &ValidationError{Name: "approval_required", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.approval_required"`)}
+	}
+	if _, ok := _c.mutation.CollectOnly(); !ok {
+		return &ValidationError{Name: "collect_only", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.collect_only"`)}
+	}
+	if _, ok := _c.mutation.RecapDocumentAttachment(); !ok {
+		return &ValidationError{Name: "recap_document_attachment", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.recap_document_attachment"`)}
+	}
+	if _, ok := _c.mutation.SummaryMaxTokens(); !ok {
+		return &ValidationError{Name: "summary_max_tokens", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.summary_max_tokens"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TelegramChatRecapsOptions.created_at"`)}
 	}
@@ -268,6 +989,146 @@ func (_c *TelegramChatRecapsOptionsCreate) createSpec() (*TelegramChatRecapsOpti
 		_spec.SetField(telegramchatrecapsoptions.FieldPinAutoRecapMessage, field.TypeBool, value)
 		_node.PinAutoRecapMessage = value
 	}
+	if value, ok := _c.mutation.PinAutoRecapMessageSilently(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently, field.TypeBool, value)
+		_node.PinAutoRecapMessageSilently = value
+	}
+	if value, ok := _c.mutation.RecapDisclaimer(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapDisclaimer, field.TypeString, value)
+		_node.RecapDisclaimer = value
+	}
+	if value, ok := _c.mutation.RecapTargetChatID(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapTargetChatID, field.TypeInt64, value)
+		_node.RecapTargetChatID = value
+	}
+	if value, ok := _c.mutation.RecapPersona(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapPersona, field.TypeString, value)
+		_node.RecapPersona = value
+	}
+	if value, ok := _c.mutation.IncludeBotMessages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldIncludeBotMessages, field.TypeBool, value)
+		_node.IncludeBotMessages = value
+	}
+	if value, ok := _c.mutation.AutoRecapsSnoozedUntil(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil, field.TypeInt64, value)
+		_node.AutoRecapsSnoozedUntil = value
+	}
+	if value, ok := _c.mutation.QuietNoticeEnabled(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldQuietNoticeEnabled, field.TypeBool, value)
+		_node.QuietNoticeEnabled = value
+	}
+	if value, ok := _c.mutation.LastQuietNoticeAt(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldLastQuietNoticeAt, field.TypeInt64, value)
+		_node.LastQuietNoticeAt = value
+	}
+	if value, ok := _c.mutation.PerTopicMessages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldPerTopicMessages, field.TypeBool, value)
+		_node.PerTopicMessages = value
+	}
+	if value, ok := _c.mutation.SummaryTemperature(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryTemperature, field.TypeFloat64, value)
+		_node.SummaryTemperature = value
+	}
+	if value, ok := _c.mutation.RecapOutputFormat(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapOutputFormat, field.TypeInt, value)
+		_node.RecapOutputFormat = value
+	}
+	if value, ok := _c.mutation.MinMessageLengthForSummary(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldMinMessageLengthForSummary, field.TypeInt, value)
+		_node.MinMessageLengthForSummary = value
+	}
+	if value, ok := _c.mutation.CountShortMessagesForActivity(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldCountShortMessagesForActivity, field.TypeBool, value)
+		_node.CountShortMessagesForActivity = value
+	}
+	if value, ok := _c.mutation.SummaryLanguages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryLanguages, field.TypeString, value)
+		_node.SummaryLanguages = value
+	}
+	if value, ok := _c.mutation.SubscribeMinMembershipDays(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSubscribeMinMembershipDays, field.TypeInt, value)
+		_node.SubscribeMinMembershipDays = value
+	}
+	if value, ok := _c.mutation.SubscribeMinMemberStatus(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSubscribeMinMemberStatus, field.TypeInt, value)
+		_node.SubscribeMinMemberStatus = value
+	}
+	if value, ok := _c.mutation.TopKeywordsCount(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldTopKeywordsCount, field.TypeInt, value)
+		_node.TopKeywordsCount = value
+	}
+	if value, ok := _c.mutation.DedupForwards(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldDedupForwards, field.TypeBool, value)
+		_node.DedupForwards = value
+	}
+	if value, ok := _c.mutation.StoreMessageContent(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldStoreMessageContent, field.TypeBool, value)
+		_node.StoreMessageContent = value
+	}
+	if value, ok := _c.mutation.AnonymizeParticipants(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAnonymizeParticipants, field.TypeBool, value)
+		_node.AnonymizeParticipants = value
+	}
+	if value, ok := _c.mutation.ManualRecapPrivate(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapPrivate, field.TypeBool, value)
+		_node.ManualRecapPrivate = value
+	}
+	if value, ok := _c.mutation.RecapInProgressTemplate(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapInProgressTemplate, field.TypeString, value)
+		_node.RecapInProgressTemplate = value
+	}
+	if value, ok := _c.mutation.ExcludedMessageTypes(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldExcludedMessageTypes, field.TypeInt, value)
+		_node.ExcludedMessageTypes = value
+	}
+	if value, ok := _c.mutation.RecapWeekdays(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapWeekdays, field.TypeJSON, value)
+		_node.RecapWeekdays = value
+	}
+	if value, ok := _c.mutation.RelatedMessagesCount(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRelatedMessagesCount, field.TypeInt, value)
+		_node.RelatedMessagesCount = value
+	}
+	if value, ok := _c.mutation.IncrementalRecap(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldIncrementalRecap, field.TypeBool, value)
+		_node.IncrementalRecap = value
+	}
+	if value, ok := _c.mutation.RecapLinkPreview(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapLinkPreview, field.TypeBool, value)
+		_node.RecapLinkPreview = value
+	}
+	if value, ok := _c.mutation.AutoUnpinAfterSeconds(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds, field.TypeInt64, value)
+		_node.AutoUnpinAfterSeconds = value
+	}
+	if value, ok := _c.mutation.RecapThreadID(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapThreadID, field.TypeInt, value)
+		_node.RecapThreadID = value
+	}
+	if value, ok := _c.mutation.ManualRecapRateLimitExemptAdmins(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins, field.TypeBool, value)
+		_node.ManualRecapRateLimitExemptAdmins = value
+	}
+	if value, ok := _c.mutation.ManualRecapMinRole(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapMinRole, field.TypeInt, value)
+		_node.ManualRecapMinRole = value
+	}
+	if value, ok := _c.mutation.ApprovalRequired(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldApprovalRequired, field.TypeBool, value)
+		_node.ApprovalRequired = value
+	}
+	if value, ok := _c.mutation.CollectOnly(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldCollectOnly, field.TypeBool, value)
+		_node.CollectOnly = value
+	}
+	if value, ok := _c.mutation.RecapDocumentAttachment(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapDocumentAttachment, field.TypeBool, value)
+		_node.RecapDocumentAttachment = value
+	}
+	if value, ok := _c.mutation.SummaryMaxTokens(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryMaxTokens, field.TypeInt, value)
+		_node.SummaryMaxTokens = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(telegramchatrecapsoptions.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/nekomeowww/insights-bot/ent/internal"
 	"github.com/nekomeowww/insights-bot/ent/predicate"
@@ -126,6 +128,605 @@ func (_u *TelegramChatRecapsOptionsUpdate) SetNillablePinAutoRecapMessage(v *boo
 	return _u
 }
 
+// SetPinAutoRecapMessageSilently sets the "pin_auto_recap_message_silently" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetPinAutoRecapMessageSilently(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetPinAutoRecapMessageSilently(v)
+	return _u
+}
+
+// SetNillablePinAutoRecapMessageSilently sets the "pin_auto_recap_message_silently" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillablePinAutoRecapMessageSilently(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetPinAutoRecapMessageSilently(*v)
+	}
+	return _u
+}
+
+// SetRecapDisclaimer sets the "recap_disclaimer" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapDisclaimer(v string) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetRecapDisclaimer(v)
+	return _u
+}
+
+// SetNillableRecapDisclaimer sets the "recap_disclaimer" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapDisclaimer(v *string) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapDisclaimer(*v)
+	}
+	return _u
+}
+
+// SetRecapTargetChatID sets the "recap_target_chat_id" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapTargetChatID(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetRecapTargetChatID()
+	_u.mutation.SetRecapTargetChatID(v)
+	return _u
+}
+
+// SetNillableRecapTargetChatID sets the "recap_target_chat_id" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapTargetChatID(v *int64) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapTargetChatID(*v)
+	}
+	return _u
+}
+
+// AddRecapTargetChatID adds value to the "recap_target_chat_id" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddRecapTargetChatID(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddRecapTargetChatID(v)
+	return _u
+}
+
+// SetRecapPersona sets the "recap_persona" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapPersona(v string) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetRecapPersona(v)
+	return _u
+}
+
+// SetNillableRecapPersona sets the "recap_persona" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapPersona(v *string) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapPersona(*v)
+	}
+	return _u
+}
+
+// SetIncludeBotMessages sets the "include_bot_messages" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetIncludeBotMessages(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetIncludeBotMessages(v)
+	return _u
+}
+
+// SetNillableIncludeBotMessages sets the "include_bot_messages" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableIncludeBotMessages(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetIncludeBotMessages(*v)
+	}
+	return _u
+}
+
+// SetAutoRecapsSnoozedUntil sets the "auto_recaps_snoozed_until" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetAutoRecapsSnoozedUntil(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetAutoRecapsSnoozedUntil()
+	_u.mutation.SetAutoRecapsSnoozedUntil(v)
+	return _u
+}
+
+// SetNillableAutoRecapsSnoozedUntil sets the "auto_recaps_snoozed_until" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableAutoRecapsSnoozedUntil(v *int64) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetAutoRecapsSnoozedUntil(*v)
+	}
+	return _u
+}
+
+// AddAutoRecapsSnoozedUntil adds value to the "auto_recaps_snoozed_until" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddAutoRecapsSnoozedUntil(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddAutoRecapsSnoozedUntil(v)
+	return _u
+}
+
+// SetQuietNoticeEnabled sets the "quiet_notice_enabled" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetQuietNoticeEnabled(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetQuietNoticeEnabled(v)
+	return _u
+}
+
+// SetNillableQuietNoticeEnabled sets the "quiet_notice_enabled" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableQuietNoticeEnabled(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetQuietNoticeEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastQuietNoticeAt sets the "last_quiet_notice_at" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetLastQuietNoticeAt(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetLastQuietNoticeAt()
+	_u.mutation.SetLastQuietNoticeAt(v)
+	return _u
+}
+
+// SetNillableLastQuietNoticeAt sets the "last_quiet_notice_at" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableLastQuietNoticeAt(v *int64) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetLastQuietNoticeAt(*v)
+	}
+	return _u
+}
+
+// AddLastQuietNoticeAt adds value to the "last_quiet_notice_at" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddLastQuietNoticeAt(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddLastQuietNoticeAt(v)
+	return _u
+}
+
+// SetPerTopicMessages sets the "per_topic_messages" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetPerTopicMessages(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetPerTopicMessages(v)
+	return _u
+}
+
+// SetNillablePerTopicMessages sets the "per_topic_messages" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillablePerTopicMessages(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetPerTopicMessages(*v)
+	}
+	return _u
+}
+
+// SetSummaryTemperature sets the "summary_temperature" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetSummaryTemperature(v float64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetSummaryTemperature()
+	_u.mutation.SetSummaryTemperature(v)
+	return _u
+}
+
+// SetNillableSummaryTemperature sets the "summary_temperature" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableSummaryTemperature(v *float64) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetSummaryTemperature(*v)
+	}
+	return _u
+}
+
+// AddSummaryTemperature adds value to the "summary_temperature" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddSummaryTemperature(v float64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddSummaryTemperature(v)
+	return _u
+}
+
+// SetRecapOutputFormat sets the "recap_output_format" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapOutputFormat(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetRecapOutputFormat()
+	_u.mutation.SetRecapOutputFormat(v)
+	return _u
+}
+
+// SetNillableRecapOutputFormat sets the "recap_output_format" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapOutputFormat(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapOutputFormat(*v)
+	}
+	return _u
+}
+
+// AddRecapOutputFormat adds value to the "recap_output_format" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddRecapOutputFormat(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddRecapOutputFormat(v)
+	return _u
+}
+
+// SetMinMessageLengthForSummary sets the "min_message_length_for_summary" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetMinMessageLengthForSummary(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetMinMessageLengthForSummary()
+	_u.mutation.SetMinMessageLengthForSummary(v)
+	return _u
+}
+
+// SetNillableMinMessageLengthForSummary sets the "min_message_length_for_summary" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableMinMessageLengthForSummary(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetMinMessageLengthForSummary(*v)
+	}
+	return _u
+}
+
+// AddMinMessageLengthForSummary adds value to the "min_message_length_for_summary" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddMinMessageLengthForSummary(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddMinMessageLengthForSummary(v)
+	return _u
+}
+
+// SetCountShortMessagesForActivity sets the "count_short_messages_for_activity" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetCountShortMessagesForActivity(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetCountShortMessagesForActivity(v)
+	return _u
+}
+
+// SetNillableCountShortMessagesForActivity sets the "count_short_messages_for_activity" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableCountShortMessagesForActivity(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetCountShortMessagesForActivity(*v)
+	}
+	return _u
+}
+
+// SetSummaryLanguages sets the "summary_languages" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetSummaryLanguages(v string) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetSummaryLanguages(v)
+	return _u
+}
+
+// SetNillableSummaryLanguages sets the "summary_languages" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableSummaryLanguages(v *string) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetSummaryLanguages(*v)
+	}
+	return _u
+}
+
+// SetSubscribeMinMembershipDays sets the "subscribe_min_membership_days" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetSubscribeMinMembershipDays(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetSubscribeMinMembershipDays()
+	_u.mutation.SetSubscribeMinMembershipDays(v)
+	return _u
+}
+
+// SetNillableSubscribeMinMembershipDays sets the "subscribe_min_membership_days" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableSubscribeMinMembershipDays(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetSubscribeMinMembershipDays(*v)
+	}
+	return _u
+}
+
+// AddSubscribeMinMembershipDays adds value to the "subscribe_min_membership_days" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddSubscribeMinMembershipDays(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddSubscribeMinMembershipDays(v)
+	return _u
+}
+
+// SetSubscribeMinMemberStatus sets the "subscribe_min_member_status" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetSubscribeMinMemberStatus(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetSubscribeMinMemberStatus()
+	_u.mutation.SetSubscribeMinMemberStatus(v)
+	return _u
+}
+
+// SetNillableSubscribeMinMemberStatus sets the "subscribe_min_member_status" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableSubscribeMinMemberStatus(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetSubscribeMinMemberStatus(*v)
+	}
+	return _u
+}
+
+// AddSubscribeMinMemberStatus adds value to the "subscribe_min_member_status" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddSubscribeMinMemberStatus(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddSubscribeMinMemberStatus(v)
+	return _u
+}
+
+// SetTopKeywordsCount sets the "top_keywords_count" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetTopKeywordsCount(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetTopKeywordsCount()
+	_u.mutation.SetTopKeywordsCount(v)
+	return _u
+}
+
+// SetNillableTopKeywordsCount sets the "top_keywords_count" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableTopKeywordsCount(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetTopKeywordsCount(*v)
+	}
+	return _u
+}
+
+// AddTopKeywordsCount adds value to the "top_keywords_count" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddTopKeywordsCount(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddTopKeywordsCount(v)
+	return _u
+}
+
+// SetDedupForwards sets the "dedup_forwards" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetDedupForwards(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetDedupForwards(v)
+	return _u
+}
+
+// SetNillableDedupForwards sets the "dedup_forwards" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableDedupForwards(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetDedupForwards(*v)
+	}
+	return _u
+}
+
+// SetStoreMessageContent sets the "store_message_content" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetStoreMessageContent(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetStoreMessageContent(v)
+	return _u
+}
+
+// SetNillableStoreMessageContent sets the "store_message_content" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableStoreMessageContent(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetStoreMessageContent(*v)
+	}
+	return _u
+}
+
+// SetAnonymizeParticipants sets the "anonymize_participants" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetAnonymizeParticipants(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetAnonymizeParticipants(v)
+	return _u
+}
+
+// SetNillableAnonymizeParticipants sets the "anonymize_participants" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableAnonymizeParticipants(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetAnonymizeParticipants(*v)
+	}
+	return _u
+}
+
+// SetManualRecapPrivate sets the "manual_recap_private" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetManualRecapPrivate(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetManualRecapPrivate(v)
+	return _u
+}
+
+// SetNillableManualRecapPrivate sets the "manual_recap_private" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableManualRecapPrivate(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetManualRecapPrivate(*v)
+	}
+	return _u
+}
+
+// SetRecapInProgressTemplate sets the "recap_in_progress_template" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapInProgressTemplate(v string) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetRecapInProgressTemplate(v)
+	return _u
+}
+
+// SetNillableRecapInProgressTemplate sets the "recap_in_progress_template" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapInProgressTemplate(v *string) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapInProgressTemplate(*v)
+	}
+	return _u
+}
+
+// SetExcludedMessageTypes sets the "excluded_message_types" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetExcludedMessageTypes(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetExcludedMessageTypes()
+	_u.mutation.SetExcludedMessageTypes(v)
+	return _u
+}
+
+// SetNillableExcludedMessageTypes sets the "excluded_message_types" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableExcludedMessageTypes(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetExcludedMessageTypes(*v)
+	}
+	return _u
+}
+
+// AddExcludedMessageTypes adds value to the "excluded_message_types" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddExcludedMessageTypes(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddExcludedMessageTypes(v)
+	return _u
+}
+
+// SetRecapWeekdays sets the "recap_weekdays" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapWeekdays(v []time.Weekday) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetRecapWeekdays(v)
+	return _u
+}
+
+// AppendRecapWeekdays appends value to the "recap_weekdays" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AppendRecapWeekdays(v []time.Weekday) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AppendRecapWeekdays(v)
+	return _u
+}
+
+// ClearRecapWeekdays clears the value of the "recap_weekdays" field.
+func (_u *TelegramChatRecapsOptionsUpdate) ClearRecapWeekdays() *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ClearRecapWeekdays()
+	return _u
+}
+
+// SetRelatedMessagesCount sets the "related_messages_count" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRelatedMessagesCount(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetRelatedMessagesCount()
+	_u.mutation.SetRelatedMessagesCount(v)
+	return _u
+}
+
+// SetNillableRelatedMessagesCount sets the "related_messages_count" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRelatedMessagesCount(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRelatedMessagesCount(*v)
+	}
+	return _u
+}
+
+// AddRelatedMessagesCount adds value to the "related_messages_count" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddRelatedMessagesCount(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddRelatedMessagesCount(v)
+	return _u
+}
+
+// SetIncrementalRecap sets the "incremental_recap" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetIncrementalRecap(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetIncrementalRecap(v)
+	return _u
+}
+
+// SetNillableIncrementalRecap sets the "incremental_recap" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableIncrementalRecap(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetIncrementalRecap(*v)
+	}
+	return _u
+}
+
+// SetRecapLinkPreview sets the "recap_link_preview" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapLinkPreview(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetRecapLinkPreview(v)
+	return _u
+}
+
+// SetNillableRecapLinkPreview sets the "recap_link_preview" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapLinkPreview(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapLinkPreview(*v)
+	}
+	return _u
+}
+
+// SetAutoUnpinAfterSeconds sets the "auto_unpin_after_seconds" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetAutoUnpinAfterSeconds(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetAutoUnpinAfterSeconds()
+	_u.mutation.SetAutoUnpinAfterSeconds(v)
+	return _u
+}
+
+// SetNillableAutoUnpinAfterSeconds sets the "auto_unpin_after_seconds" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableAutoUnpinAfterSeconds(v *int64) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetAutoUnpinAfterSeconds(*v)
+	}
+	return _u
+}
+
+// AddAutoUnpinAfterSeconds adds value to the "auto_unpin_after_seconds" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddAutoUnpinAfterSeconds(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddAutoUnpinAfterSeconds(v)
+	return _u
+}
+
+// SetRecapThreadID sets the "recap_thread_id" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapThreadID(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetRecapThreadID()
+	_u.mutation.SetRecapThreadID(v)
+	return _u
+}
+
+// SetNillableRecapThreadID sets the "recap_thread_id" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapThreadID(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapThreadID(*v)
+	}
+	return _u
+}
+
+// AddRecapThreadID adds value to the "recap_thread_id" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddRecapThreadID(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddRecapThreadID(v)
+	return _u
+}
+
+// SetManualRecapRateLimitExemptAdmins sets the "manual_recap_rate_limit_exempt_admins" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetManualRecapRateLimitExemptAdmins(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetManualRecapRateLimitExemptAdmins(v)
+	return _u
+}
+
+// SetNillableManualRecapRateLimitExemptAdmins sets the "manual_recap_rate_limit_exempt_admins" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableManualRecapRateLimitExemptAdmins(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetManualRecapRateLimitExemptAdmins(*v)
+	}
+	return _u
+}
+
+// SetManualRecapMinRole sets the "manual_recap_min_role" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetManualRecapMinRole(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetManualRecapMinRole()
+	_u.mutation.SetManualRecapMinRole(v)
+	return _u
+}
+
+// SetNillableManualRecapMinRole sets the "manual_recap_min_role" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableManualRecapMinRole(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetManualRecapMinRole(*v)
+	}
+	return _u
+}
+
+// AddManualRecapMinRole adds value to the "manual_recap_min_role" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddManualRecapMinRole(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddManualRecapMinRole(v)
+	return _u
+}
+
+// SetApprovalRequired sets the "approval_required" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetApprovalRequired(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetApprovalRequired(v)
+	return _u
+}
+
+// SetNillableApprovalRequired sets the "approval_required" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableApprovalRequired(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetApprovalRequired(*v)
+	}
+	return _u
+}
+
+// SetCollectOnly sets the "collect_only" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetCollectOnly(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetCollectOnly(v)
+	return _u
+}
+
+// SetNillableCollectOnly sets the "collect_only" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableCollectOnly(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetCollectOnly(*v)
+	}
+	return _u
+}
+
+// SetRecapDocumentAttachment sets the "recap_document_attachment" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetRecapDocumentAttachment(v bool) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.SetRecapDocumentAttachment(v)
+	return _u
+}
+
+// SetNillableRecapDocumentAttachment sets the "recap_document_attachment" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableRecapDocumentAttachment(v *bool) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetRecapDocumentAttachment(*v)
+	}
+	return _u
+}
+
+// SetSummaryMaxTokens sets the "summary_max_tokens" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetSummaryMaxTokens(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetSummaryMaxTokens()
+	_u.mutation.SetSummaryMaxTokens(v)
+	return _u
+}
+
+// SetNillableSummaryMaxTokens sets the "summary_max_tokens" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableSummaryMaxTokens(v *int) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetSummaryMaxTokens(*v)
+	}
+	return _u
+}
+
+// AddSummaryMaxTokens adds value to the "summary_max_tokens" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddSummaryMaxTokens(v int) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddSummaryMaxTokens(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TelegramChatRecapsOptionsUpdate) SetCreatedAt(v int64) *TelegramChatRecapsOptionsUpdate {
 	_u.mutation.ResetCreatedAt()
@@ -133,241 +734,998 @@ func (_u *TelegramChatRecapsOptionsUpdate) SetCreatedAt(v int64) *TelegramChatRe
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *TelegramChatRecapsOptionsUpdate) SetNillableCreatedAt(v *int64) *TelegramChatRecapsOptionsUpdate {
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableCreatedAt(v *int64) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddCreatedAt(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TelegramChatRecapsOptionsUpdate) SetUpdatedAt(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdate) SetNillableUpdatedAt(v *int64) *TelegramChatRecapsOptionsUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *TelegramChatRecapsOptionsUpdate) AddUpdatedAt(v int64) *TelegramChatRecapsOptionsUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TelegramChatRecapsOptionsMutation object of the builder.
+func (_u *TelegramChatRecapsOptionsUpdate) Mutation() *TelegramChatRecapsOptionsMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TelegramChatRecapsOptionsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TelegramChatRecapsOptionsUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TelegramChatRecapsOptionsUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TelegramChatRecapsOptionsUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TelegramChatRecapsOptionsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(telegramchatrecapsoptions.Table, telegramchatrecapsoptions.Columns, sqlgraph.NewFieldSpec(telegramchatrecapsoptions.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AutoRecapSendMode(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoRecapSendMode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAutoRecapSendMode(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldAutoRecapSendMode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ManualRecapRatePerSeconds(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapRatePerSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedManualRecapRatePerSeconds(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldManualRecapRatePerSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AutoRecapRatesPerDay(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoRecapRatesPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAutoRecapRatesPerDay(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldAutoRecapRatesPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PinAutoRecapMessage(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldPinAutoRecapMessage, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PinAutoRecapMessageSilently(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapDisclaimer(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapDisclaimer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecapTargetChatID(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapTargetChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRecapTargetChatID(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRecapTargetChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecapPersona(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapPersona, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IncludeBotMessages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldIncludeBotMessages, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoRecapsSnoozedUntil(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAutoRecapsSnoozedUntil(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.QuietNoticeEnabled(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldQuietNoticeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastQuietNoticeAt(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldLastQuietNoticeAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastQuietNoticeAt(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldLastQuietNoticeAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PerTopicMessages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldPerTopicMessages, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SummaryTemperature(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSummaryTemperature(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSummaryTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RecapOutputFormat(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapOutputFormat, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecapOutputFormat(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRecapOutputFormat, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MinMessageLengthForSummary(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldMinMessageLengthForSummary, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinMessageLengthForSummary(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldMinMessageLengthForSummary, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CountShortMessagesForActivity(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldCountShortMessagesForActivity, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SummaryLanguages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryLanguages, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubscribeMinMembershipDays(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSubscribeMinMembershipDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscribeMinMembershipDays(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSubscribeMinMembershipDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SubscribeMinMemberStatus(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSubscribeMinMemberStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscribeMinMemberStatus(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSubscribeMinMemberStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TopKeywordsCount(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldTopKeywordsCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTopKeywordsCount(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldTopKeywordsCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DedupForwards(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldDedupForwards, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StoreMessageContent(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldStoreMessageContent, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AnonymizeParticipants(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAnonymizeParticipants, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ManualRecapPrivate(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapPrivate, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapInProgressTemplate(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapInProgressTemplate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExcludedMessageTypes(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldExcludedMessageTypes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExcludedMessageTypes(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldExcludedMessageTypes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RecapWeekdays(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapWeekdays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRecapWeekdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, telegramchatrecapsoptions.FieldRecapWeekdays, value)
+		})
+	}
+	if _u.mutation.RecapWeekdaysCleared() {
+		_spec.ClearField(telegramchatrecapsoptions.FieldRecapWeekdays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RelatedMessagesCount(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRelatedMessagesCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRelatedMessagesCount(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRelatedMessagesCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncrementalRecap(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldIncrementalRecap, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapLinkPreview(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapLinkPreview, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoUnpinAfterSeconds(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAutoUnpinAfterSeconds(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecapThreadID(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapThreadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecapThreadID(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRecapThreadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ManualRecapRateLimitExemptAdmins(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ManualRecapMinRole(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapMinRole, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedManualRecapMinRole(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldManualRecapMinRole, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ApprovalRequired(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldApprovalRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CollectOnly(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldCollectOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapDocumentAttachment(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapDocumentAttachment, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SummaryMaxTokens(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryMaxTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSummaryMaxTokens(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSummaryMaxTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.TelegramChatRecapsOptions
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegramchatrecapsoptions.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TelegramChatRecapsOptionsUpdateOne is the builder for updating a single TelegramChatRecapsOptions entity.
+type TelegramChatRecapsOptionsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TelegramChatRecapsOptionsMutation
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetChatID(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableChatID(v *int64) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddChatID(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetAutoRecapSendMode sets the "auto_recap_send_mode" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetAutoRecapSendMode(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetAutoRecapSendMode()
+	_u.mutation.SetAutoRecapSendMode(v)
+	return _u
+}
+
+// SetNillableAutoRecapSendMode sets the "auto_recap_send_mode" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableAutoRecapSendMode(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetAutoRecapSendMode(*v)
+	}
+	return _u
+}
+
+// AddAutoRecapSendMode adds value to the "auto_recap_send_mode" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddAutoRecapSendMode(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddAutoRecapSendMode(v)
+	return _u
+}
+
+// SetManualRecapRatePerSeconds sets the "manual_recap_rate_per_seconds" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetManualRecapRatePerSeconds(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetManualRecapRatePerSeconds()
+	_u.mutation.SetManualRecapRatePerSeconds(v)
+	return _u
+}
+
+// SetNillableManualRecapRatePerSeconds sets the "manual_recap_rate_per_seconds" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableManualRecapRatePerSeconds(v *int64) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetManualRecapRatePerSeconds(*v)
+	}
+	return _u
+}
+
+// AddManualRecapRatePerSeconds adds value to the "manual_recap_rate_per_seconds" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddManualRecapRatePerSeconds(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddManualRecapRatePerSeconds(v)
+	return _u
+}
+
+// SetAutoRecapRatesPerDay sets the "auto_recap_rates_per_day" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetAutoRecapRatesPerDay(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetAutoRecapRatesPerDay()
+	_u.mutation.SetAutoRecapRatesPerDay(v)
+	return _u
+}
+
+// SetNillableAutoRecapRatesPerDay sets the "auto_recap_rates_per_day" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableAutoRecapRatesPerDay(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetAutoRecapRatesPerDay(*v)
+	}
+	return _u
+}
+
+// AddAutoRecapRatesPerDay adds value to the "auto_recap_rates_per_day" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddAutoRecapRatesPerDay(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddAutoRecapRatesPerDay(v)
+	return _u
+}
+
+// SetPinAutoRecapMessage sets the "pin_auto_recap_message" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetPinAutoRecapMessage(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetPinAutoRecapMessage(v)
+	return _u
+}
+
+// SetNillablePinAutoRecapMessage sets the "pin_auto_recap_message" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillablePinAutoRecapMessage(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetPinAutoRecapMessage(*v)
+	}
+	return _u
+}
+
+// SetPinAutoRecapMessageSilently sets the "pin_auto_recap_message_silently" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetPinAutoRecapMessageSilently(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetPinAutoRecapMessageSilently(v)
+	return _u
+}
+
+// SetNillablePinAutoRecapMessageSilently sets the "pin_auto_recap_message_silently" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillablePinAutoRecapMessageSilently(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetPinAutoRecapMessageSilently(*v)
+	}
+	return _u
+}
+
+// SetRecapDisclaimer sets the "recap_disclaimer" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapDisclaimer(v string) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetRecapDisclaimer(v)
+	return _u
+}
+
+// SetNillableRecapDisclaimer sets the "recap_disclaimer" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapDisclaimer(v *string) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetRecapDisclaimer(*v)
+	}
+	return _u
+}
+
+// SetRecapTargetChatID sets the "recap_target_chat_id" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapTargetChatID(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetRecapTargetChatID()
+	_u.mutation.SetRecapTargetChatID(v)
+	return _u
+}
+
+// SetNillableRecapTargetChatID sets the "recap_target_chat_id" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapTargetChatID(v *int64) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetRecapTargetChatID(*v)
+	}
+	return _u
+}
+
+// AddRecapTargetChatID adds value to the "recap_target_chat_id" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddRecapTargetChatID(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddRecapTargetChatID(v)
+	return _u
+}
+
+// SetRecapPersona sets the "recap_persona" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapPersona(v string) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetRecapPersona(v)
+	return _u
+}
+
+// SetNillableRecapPersona sets the "recap_persona" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapPersona(v *string) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetRecapPersona(*v)
+	}
+	return _u
+}
+
+// SetIncludeBotMessages sets the "include_bot_messages" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetIncludeBotMessages(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetIncludeBotMessages(v)
+	return _u
+}
+
+// SetNillableIncludeBotMessages sets the "include_bot_messages" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableIncludeBotMessages(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetIncludeBotMessages(*v)
+	}
+	return _u
+}
+
+// SetAutoRecapsSnoozedUntil sets the "auto_recaps_snoozed_until" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetAutoRecapsSnoozedUntil(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetAutoRecapsSnoozedUntil()
+	_u.mutation.SetAutoRecapsSnoozedUntil(v)
+	return _u
+}
+
+// SetNillableAutoRecapsSnoozedUntil sets the "auto_recaps_snoozed_until" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableAutoRecapsSnoozedUntil(v *int64) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetAutoRecapsSnoozedUntil(*v)
+	}
+	return _u
+}
+
+// AddAutoRecapsSnoozedUntil adds value to the "auto_recaps_snoozed_until" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddAutoRecapsSnoozedUntil(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddAutoRecapsSnoozedUntil(v)
+	return _u
+}
+
+// SetQuietNoticeEnabled sets the "quiet_notice_enabled" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetQuietNoticeEnabled(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetQuietNoticeEnabled(v)
+	return _u
+}
+
+// SetNillableQuietNoticeEnabled sets the "quiet_notice_enabled" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableQuietNoticeEnabled(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetQuietNoticeEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastQuietNoticeAt sets the "last_quiet_notice_at" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetLastQuietNoticeAt(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetLastQuietNoticeAt()
+	_u.mutation.SetLastQuietNoticeAt(v)
+	return _u
+}
+
+// SetNillableLastQuietNoticeAt sets the "last_quiet_notice_at" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableLastQuietNoticeAt(v *int64) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetLastQuietNoticeAt(*v)
+	}
+	return _u
+}
+
+// AddLastQuietNoticeAt adds value to the "last_quiet_notice_at" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddLastQuietNoticeAt(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddLastQuietNoticeAt(v)
+	return _u
+}
+
+// SetPerTopicMessages sets the "per_topic_messages" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetPerTopicMessages(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetPerTopicMessages(v)
+	return _u
+}
+
+// SetNillablePerTopicMessages sets the "per_topic_messages" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillablePerTopicMessages(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetPerTopicMessages(*v)
+	}
+	return _u
+}
+
+// SetSummaryTemperature sets the "summary_temperature" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetSummaryTemperature(v float64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetSummaryTemperature()
+	_u.mutation.SetSummaryTemperature(v)
+	return _u
+}
+
+// SetNillableSummaryTemperature sets the "summary_temperature" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableSummaryTemperature(v *float64) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetSummaryTemperature(*v)
+	}
+	return _u
+}
+
+// AddSummaryTemperature adds value to the "summary_temperature" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddSummaryTemperature(v float64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddSummaryTemperature(v)
+	return _u
+}
+
+// SetRecapOutputFormat sets the "recap_output_format" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapOutputFormat(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetRecapOutputFormat()
+	_u.mutation.SetRecapOutputFormat(v)
+	return _u
+}
+
+// SetNillableRecapOutputFormat sets the "recap_output_format" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapOutputFormat(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetRecapOutputFormat(*v)
+	}
+	return _u
+}
+
+// AddRecapOutputFormat adds value to the "recap_output_format" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddRecapOutputFormat(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddRecapOutputFormat(v)
+	return _u
+}
+
+// SetMinMessageLengthForSummary sets the "min_message_length_for_summary" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetMinMessageLengthForSummary(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetMinMessageLengthForSummary()
+	_u.mutation.SetMinMessageLengthForSummary(v)
+	return _u
+}
+
+// SetNillableMinMessageLengthForSummary sets the "min_message_length_for_summary" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableMinMessageLengthForSummary(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetMinMessageLengthForSummary(*v)
+	}
+	return _u
+}
+
+// AddMinMessageLengthForSummary adds value to the "min_message_length_for_summary" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddMinMessageLengthForSummary(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddMinMessageLengthForSummary(v)
+	return _u
+}
+
+// SetCountShortMessagesForActivity sets the "count_short_messages_for_activity" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetCountShortMessagesForActivity(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetCountShortMessagesForActivity(v)
+	return _u
+}
+
+// SetNillableCountShortMessagesForActivity sets the "count_short_messages_for_activity" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableCountShortMessagesForActivity(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetCountShortMessagesForActivity(*v)
+	}
+	return _u
+}
+
+// SetSummaryLanguages sets the "summary_languages" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetSummaryLanguages(v string) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetSummaryLanguages(v)
+	return _u
+}
+
+// SetNillableSummaryLanguages sets the "summary_languages" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableSummaryLanguages(v *string) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetSummaryLanguages(*v)
+	}
+	return _u
+}
+
+// SetSubscribeMinMembershipDays sets the "subscribe_min_membership_days" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetSubscribeMinMembershipDays(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetSubscribeMinMembershipDays()
+	_u.mutation.SetSubscribeMinMembershipDays(v)
+	return _u
+}
+
+// SetNillableSubscribeMinMembershipDays sets the "subscribe_min_membership_days" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableSubscribeMinMembershipDays(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetSubscribeMinMembershipDays(*v)
+	}
+	return _u
+}
+
+// AddSubscribeMinMembershipDays adds value to the "subscribe_min_membership_days" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddSubscribeMinMembershipDays(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddSubscribeMinMembershipDays(v)
+	return _u
+}
+
+// SetSubscribeMinMemberStatus sets the "subscribe_min_member_status" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetSubscribeMinMemberStatus(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetSubscribeMinMemberStatus()
+	_u.mutation.SetSubscribeMinMemberStatus(v)
+	return _u
+}
+
+// SetNillableSubscribeMinMemberStatus sets the "subscribe_min_member_status" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableSubscribeMinMemberStatus(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetSubscribeMinMemberStatus(*v)
+	}
+	return _u
+}
+
+// AddSubscribeMinMemberStatus adds value to the "subscribe_min_member_status" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddSubscribeMinMemberStatus(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddSubscribeMinMemberStatus(v)
+	return _u
+}
+
+// SetTopKeywordsCount sets the "top_keywords_count" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetTopKeywordsCount(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetTopKeywordsCount()
+	_u.mutation.SetTopKeywordsCount(v)
+	return _u
+}
+
+// SetNillableTopKeywordsCount sets the "top_keywords_count" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableTopKeywordsCount(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetTopKeywordsCount(*v)
+	}
+	return _u
+}
+
+// AddTopKeywordsCount adds value to the "top_keywords_count" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddTopKeywordsCount(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddTopKeywordsCount(v)
+	return _u
+}
+
+// SetDedupForwards sets the "dedup_forwards" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetDedupForwards(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetDedupForwards(v)
+	return _u
+}
+
+// SetNillableDedupForwards sets the "dedup_forwards" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableDedupForwards(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetDedupForwards(*v)
+	}
+	return _u
+}
+
+// SetStoreMessageContent sets the "store_message_content" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetStoreMessageContent(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetStoreMessageContent(v)
+	return _u
+}
+
+// SetNillableStoreMessageContent sets the "store_message_content" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableStoreMessageContent(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetStoreMessageContent(*v)
+	}
+	return _u
+}
+
+// SetAnonymizeParticipants sets the "anonymize_participants" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetAnonymizeParticipants(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetAnonymizeParticipants(v)
+	return _u
+}
+
+// SetNillableAnonymizeParticipants sets the "anonymize_participants" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableAnonymizeParticipants(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetAnonymizeParticipants(*v)
+	}
+	return _u
+}
+
+// SetManualRecapPrivate sets the "manual_recap_private" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetManualRecapPrivate(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetManualRecapPrivate(v)
+	return _u
+}
+
+// SetNillableManualRecapPrivate sets the "manual_recap_private" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableManualRecapPrivate(v *bool) *TelegramChatRecapsOptionsUpdateOne {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetManualRecapPrivate(*v)
 	}
 	return _u
 }
 
-// AddCreatedAt adds value to the "created_at" field.
-func (_u *TelegramChatRecapsOptionsUpdate) AddCreatedAt(v int64) *TelegramChatRecapsOptionsUpdate {
-	_u.mutation.AddCreatedAt(v)
+// SetRecapInProgressTemplate sets the "recap_in_progress_template" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapInProgressTemplate(v string) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetRecapInProgressTemplate(v)
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *TelegramChatRecapsOptionsUpdate) SetUpdatedAt(v int64) *TelegramChatRecapsOptionsUpdate {
-	_u.mutation.ResetUpdatedAt()
-	_u.mutation.SetUpdatedAt(v)
+// SetNillableRecapInProgressTemplate sets the "recap_in_progress_template" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapInProgressTemplate(v *string) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetRecapInProgressTemplate(*v)
+	}
 	return _u
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_u *TelegramChatRecapsOptionsUpdate) SetNillableUpdatedAt(v *int64) *TelegramChatRecapsOptionsUpdate {
+// SetExcludedMessageTypes sets the "excluded_message_types" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetExcludedMessageTypes(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetExcludedMessageTypes()
+	_u.mutation.SetExcludedMessageTypes(v)
+	return _u
+}
+
+// SetNillableExcludedMessageTypes sets the "excluded_message_types" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableExcludedMessageTypes(v *int) *TelegramChatRecapsOptionsUpdateOne {
 	if v != nil {
-		_u.SetUpdatedAt(*v)
+		_u.SetExcludedMessageTypes(*v)
 	}
 	return _u
 }
 
-// AddUpdatedAt adds value to the "updated_at" field.
-func (_u *TelegramChatRecapsOptionsUpdate) AddUpdatedAt(v int64) *TelegramChatRecapsOptionsUpdate {
-	_u.mutation.AddUpdatedAt(v)
+// AddExcludedMessageTypes adds value to the "excluded_message_types" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddExcludedMessageTypes(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddExcludedMessageTypes(v)
 	return _u
 }
 
-// Mutation returns the TelegramChatRecapsOptionsMutation object of the builder.
-func (_u *TelegramChatRecapsOptionsUpdate) Mutation() *TelegramChatRecapsOptionsMutation {
-	return _u.mutation
+// SetRecapWeekdays sets the "recap_weekdays" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapWeekdays(v []time.Weekday) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetRecapWeekdays(v)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *TelegramChatRecapsOptionsUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// AppendRecapWeekdays appends value to the "recap_weekdays" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AppendRecapWeekdays(v []time.Weekday) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AppendRecapWeekdays(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *TelegramChatRecapsOptionsUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// ClearRecapWeekdays clears the value of the "recap_weekdays" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) ClearRecapWeekdays() *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ClearRecapWeekdays()
+	return _u
 }
 
-// Exec executes the query.
-func (_u *TelegramChatRecapsOptionsUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetRelatedMessagesCount sets the "related_messages_count" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRelatedMessagesCount(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetRelatedMessagesCount()
+	_u.mutation.SetRelatedMessagesCount(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *TelegramChatRecapsOptionsUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableRelatedMessagesCount sets the "related_messages_count" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRelatedMessagesCount(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetRelatedMessagesCount(*v)
 	}
+	return _u
 }
 
-func (_u *TelegramChatRecapsOptionsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(telegramchatrecapsoptions.Table, telegramchatrecapsoptions.Columns, sqlgraph.NewFieldSpec(telegramchatrecapsoptions.FieldID, field.TypeUUID))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.ChatID(); ok {
-		_spec.SetField(telegramchatrecapsoptions.FieldChatID, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AddedChatID(); ok {
-		_spec.AddField(telegramchatrecapsoptions.FieldChatID, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AutoRecapSendMode(); ok {
-		_spec.SetField(telegramchatrecapsoptions.FieldAutoRecapSendMode, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedAutoRecapSendMode(); ok {
-		_spec.AddField(telegramchatrecapsoptions.FieldAutoRecapSendMode, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.ManualRecapRatePerSeconds(); ok {
-		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapRatePerSeconds, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AddedManualRecapRatePerSeconds(); ok {
-		_spec.AddField(telegramchatrecapsoptions.FieldManualRecapRatePerSeconds, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AutoRecapRatesPerDay(); ok {
-		_spec.SetField(telegramchatrecapsoptions.FieldAutoRecapRatesPerDay, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedAutoRecapRatesPerDay(); ok {
-		_spec.AddField(telegramchatrecapsoptions.FieldAutoRecapRatesPerDay, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.PinAutoRecapMessage(); ok {
-		_spec.SetField(telegramchatrecapsoptions.FieldPinAutoRecapMessage, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(telegramchatrecapsoptions.FieldCreatedAt, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AddedCreatedAt(); ok {
-		_spec.AddField(telegramchatrecapsoptions.FieldCreatedAt, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(telegramchatrecapsoptions.FieldUpdatedAt, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
-		_spec.AddField(telegramchatrecapsoptions.FieldUpdatedAt, field.TypeInt64, value)
-	}
-	_spec.Node.Schema = _u.schemaConfig.TelegramChatRecapsOptions
-	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{telegramchatrecapsoptions.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+// AddRelatedMessagesCount adds value to the "related_messages_count" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddRelatedMessagesCount(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddRelatedMessagesCount(v)
+	return _u
+}
+
+// SetIncrementalRecap sets the "incremental_recap" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetIncrementalRecap(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetIncrementalRecap(v)
+	return _u
+}
+
+// SetNillableIncrementalRecap sets the "incremental_recap" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableIncrementalRecap(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetIncrementalRecap(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// TelegramChatRecapsOptionsUpdateOne is the builder for updating a single TelegramChatRecapsOptions entity.
-type TelegramChatRecapsOptionsUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TelegramChatRecapsOptionsMutation
+// SetRecapLinkPreview sets the "recap_link_preview" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapLinkPreview(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetRecapLinkPreview(v)
+	return _u
 }
 
-// SetChatID sets the "chat_id" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetChatID(v int64) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.ResetChatID()
-	_u.mutation.SetChatID(v)
+// SetNillableRecapLinkPreview sets the "recap_link_preview" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapLinkPreview(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetRecapLinkPreview(*v)
+	}
 	return _u
 }
 
-// SetNillableChatID sets the "chat_id" field if the given value is not nil.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableChatID(v *int64) *TelegramChatRecapsOptionsUpdateOne {
+// SetAutoUnpinAfterSeconds sets the "auto_unpin_after_seconds" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetAutoUnpinAfterSeconds(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetAutoUnpinAfterSeconds()
+	_u.mutation.SetAutoUnpinAfterSeconds(v)
+	return _u
+}
+
+// SetNillableAutoUnpinAfterSeconds sets the "auto_unpin_after_seconds" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableAutoUnpinAfterSeconds(v *int64) *TelegramChatRecapsOptionsUpdateOne {
 	if v != nil {
-		_u.SetChatID(*v)
+		_u.SetAutoUnpinAfterSeconds(*v)
 	}
 	return _u
 }
 
-// AddChatID adds value to the "chat_id" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) AddChatID(v int64) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.AddChatID(v)
+// AddAutoUnpinAfterSeconds adds value to the "auto_unpin_after_seconds" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddAutoUnpinAfterSeconds(v int64) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddAutoUnpinAfterSeconds(v)
 	return _u
 }
 
-// SetAutoRecapSendMode sets the "auto_recap_send_mode" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetAutoRecapSendMode(v int) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.ResetAutoRecapSendMode()
-	_u.mutation.SetAutoRecapSendMode(v)
+// SetRecapThreadID sets the "recap_thread_id" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapThreadID(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetRecapThreadID()
+	_u.mutation.SetRecapThreadID(v)
 	return _u
 }
 
-// SetNillableAutoRecapSendMode sets the "auto_recap_send_mode" field if the given value is not nil.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableAutoRecapSendMode(v *int) *TelegramChatRecapsOptionsUpdateOne {
+// SetNillableRecapThreadID sets the "recap_thread_id" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapThreadID(v *int) *TelegramChatRecapsOptionsUpdateOne {
 	if v != nil {
-		_u.SetAutoRecapSendMode(*v)
+		_u.SetRecapThreadID(*v)
 	}
 	return _u
 }
 
-// AddAutoRecapSendMode adds value to the "auto_recap_send_mode" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) AddAutoRecapSendMode(v int) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.AddAutoRecapSendMode(v)
+// AddRecapThreadID adds value to the "recap_thread_id" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddRecapThreadID(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddRecapThreadID(v)
 	return _u
 }
 
-// SetManualRecapRatePerSeconds sets the "manual_recap_rate_per_seconds" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetManualRecapRatePerSeconds(v int64) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.ResetManualRecapRatePerSeconds()
-	_u.mutation.SetManualRecapRatePerSeconds(v)
+// SetManualRecapRateLimitExemptAdmins sets the "manual_recap_rate_limit_exempt_admins" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetManualRecapRateLimitExemptAdmins(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetManualRecapRateLimitExemptAdmins(v)
 	return _u
 }
 
-// SetNillableManualRecapRatePerSeconds sets the "manual_recap_rate_per_seconds" field if the given value is not nil.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableManualRecapRatePerSeconds(v *int64) *TelegramChatRecapsOptionsUpdateOne {
+// SetNillableManualRecapRateLimitExemptAdmins sets the "manual_recap_rate_limit_exempt_admins" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableManualRecapRateLimitExemptAdmins(v *bool) *TelegramChatRecapsOptionsUpdateOne {
 	if v != nil {
-		_u.SetManualRecapRatePerSeconds(*v)
+		_u.SetManualRecapRateLimitExemptAdmins(*v)
 	}
 	return _u
 }
 
-// AddManualRecapRatePerSeconds adds value to the "manual_recap_rate_per_seconds" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) AddManualRecapRatePerSeconds(v int64) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.AddManualRecapRatePerSeconds(v)
+// SetManualRecapMinRole sets the "manual_recap_min_role" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetManualRecapMinRole(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetManualRecapMinRole()
+	_u.mutation.SetManualRecapMinRole(v)
 	return _u
 }
 
-// SetAutoRecapRatesPerDay sets the "auto_recap_rates_per_day" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetAutoRecapRatesPerDay(v int) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.ResetAutoRecapRatesPerDay()
-	_u.mutation.SetAutoRecapRatesPerDay(v)
+// SetNillableManualRecapMinRole sets the "manual_recap_min_role" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableManualRecapMinRole(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetManualRecapMinRole(*v)
+	}
 	return _u
 }
 
-// SetNillableAutoRecapRatesPerDay sets the "auto_recap_rates_per_day" field if the given value is not nil.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableAutoRecapRatesPerDay(v *int) *TelegramChatRecapsOptionsUpdateOne {
+// AddManualRecapMinRole adds value to the "manual_recap_min_role" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddManualRecapMinRole(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddManualRecapMinRole(v)
+	return _u
+}
+
+// SetApprovalRequired sets the "approval_required" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetApprovalRequired(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetApprovalRequired(v)
+	return _u
+}
+
+// SetNillableApprovalRequired sets the "approval_required" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableApprovalRequired(v *bool) *TelegramChatRecapsOptionsUpdateOne {
 	if v != nil {
-		_u.SetAutoRecapRatesPerDay(*v)
+		_u.SetApprovalRequired(*v)
 	}
 	return _u
 }
 
-// AddAutoRecapRatesPerDay adds value to the "auto_recap_rates_per_day" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) AddAutoRecapRatesPerDay(v int) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.AddAutoRecapRatesPerDay(v)
+// SetCollectOnly sets the "collect_only" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetCollectOnly(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetCollectOnly(v)
 	return _u
 }
 
-// SetPinAutoRecapMessage sets the "pin_auto_recap_message" field.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetPinAutoRecapMessage(v bool) *TelegramChatRecapsOptionsUpdateOne {
-	_u.mutation.SetPinAutoRecapMessage(v)
+// SetNillableCollectOnly sets the "collect_only" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableCollectOnly(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetCollectOnly(*v)
+	}
 	return _u
 }
 
-// SetNillablePinAutoRecapMessage sets the "pin_auto_recap_message" field if the given value is not nil.
-func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillablePinAutoRecapMessage(v *bool) *TelegramChatRecapsOptionsUpdateOne {
+// SetRecapDocumentAttachment sets the "recap_document_attachment" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetRecapDocumentAttachment(v bool) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.SetRecapDocumentAttachment(v)
+	return _u
+}
+
+// SetNillableRecapDocumentAttachment sets the "recap_document_attachment" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableRecapDocumentAttachment(v *bool) *TelegramChatRecapsOptionsUpdateOne {
 	if v != nil {
-		_u.SetPinAutoRecapMessage(*v)
+		_u.SetRecapDocumentAttachment(*v)
+	}
+	return _u
+}
+
+// SetSummaryMaxTokens sets the "summary_max_tokens" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetSummaryMaxTokens(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.ResetSummaryMaxTokens()
+	_u.mutation.SetSummaryMaxTokens(v)
+	return _u
+}
+
+// SetNillableSummaryMaxTokens sets the "summary_max_tokens" field if the given value is not nil.
+func (_u *TelegramChatRecapsOptionsUpdateOne) SetNillableSummaryMaxTokens(v *int) *TelegramChatRecapsOptionsUpdateOne {
+	if v != nil {
+		_u.SetSummaryMaxTokens(*v)
 	}
 	return _u
 }
 
+// AddSummaryMaxTokens adds value to the "summary_max_tokens" field.
+func (_u *TelegramChatRecapsOptionsUpdateOne) AddSummaryMaxTokens(v int) *TelegramChatRecapsOptionsUpdateOne {
+	_u.mutation.AddSummaryMaxTokens(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TelegramChatRecapsOptionsUpdateOne) SetCreatedAt(v int64) *TelegramChatRecapsOptionsUpdateOne {
 	_u.mutation.ResetCreatedAt()
@@ -508,6 +1866,164 @@ func (_u *TelegramChatRecapsOptionsUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := _u.mutation.PinAutoRecapMessage(); ok {
 		_spec.SetField(telegramchatrecapsoptions.FieldPinAutoRecapMessage, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PinAutoRecapMessageSilently(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldPinAutoRecapMessageSilently, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapDisclaimer(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapDisclaimer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecapTargetChatID(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapTargetChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRecapTargetChatID(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRecapTargetChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecapPersona(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapPersona, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IncludeBotMessages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldIncludeBotMessages, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoRecapsSnoozedUntil(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAutoRecapsSnoozedUntil(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldAutoRecapsSnoozedUntil, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.QuietNoticeEnabled(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldQuietNoticeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastQuietNoticeAt(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldLastQuietNoticeAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastQuietNoticeAt(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldLastQuietNoticeAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PerTopicMessages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldPerTopicMessages, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SummaryTemperature(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSummaryTemperature(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSummaryTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RecapOutputFormat(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapOutputFormat, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecapOutputFormat(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRecapOutputFormat, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MinMessageLengthForSummary(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldMinMessageLengthForSummary, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinMessageLengthForSummary(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldMinMessageLengthForSummary, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CountShortMessagesForActivity(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldCountShortMessagesForActivity, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SummaryLanguages(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryLanguages, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubscribeMinMembershipDays(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSubscribeMinMembershipDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscribeMinMembershipDays(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSubscribeMinMembershipDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SubscribeMinMemberStatus(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSubscribeMinMemberStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscribeMinMemberStatus(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSubscribeMinMemberStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TopKeywordsCount(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldTopKeywordsCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTopKeywordsCount(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldTopKeywordsCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DedupForwards(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldDedupForwards, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StoreMessageContent(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldStoreMessageContent, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AnonymizeParticipants(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAnonymizeParticipants, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ManualRecapPrivate(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapPrivate, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapInProgressTemplate(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapInProgressTemplate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExcludedMessageTypes(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldExcludedMessageTypes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExcludedMessageTypes(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldExcludedMessageTypes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RecapWeekdays(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapWeekdays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRecapWeekdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, telegramchatrecapsoptions.FieldRecapWeekdays, value)
+		})
+	}
+	if _u.mutation.RecapWeekdaysCleared() {
+		_spec.ClearField(telegramchatrecapsoptions.FieldRecapWeekdays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RelatedMessagesCount(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRelatedMessagesCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRelatedMessagesCount(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRelatedMessagesCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncrementalRecap(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldIncrementalRecap, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapLinkPreview(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapLinkPreview, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoUnpinAfterSeconds(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAutoUnpinAfterSeconds(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldAutoUnpinAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecapThreadID(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapThreadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecapThreadID(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldRecapThreadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ManualRecapRateLimitExemptAdmins(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapRateLimitExemptAdmins, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ManualRecapMinRole(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldManualRecapMinRole, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedManualRecapMinRole(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldManualRecapMinRole, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ApprovalRequired(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldApprovalRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CollectOnly(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldCollectOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecapDocumentAttachment(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldRecapDocumentAttachment, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SummaryMaxTokens(); ok {
+		_spec.SetField(telegramchatrecapsoptions.FieldSummaryMaxTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSummaryMaxTokens(); ok {
+		_spec.AddField(telegramchatrecapsoptions.FieldSummaryMaxTokens, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(telegramchatrecapsoptions.FieldCreatedAt, field.TypeInt64, value)
 	}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
+)
+
+// TelegramUserPreferences is the model entity for the TelegramUserPreferences schema.
+type TelegramUserPreferences struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// BatchPrivateRecaps holds the value of the "batch_private_recaps" field.
+	BatchPrivateRecaps bool `json:"batch_private_recaps,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TelegramUserPreferences) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case telegramuserpreferences.FieldBatchPrivateRecaps:
+			values[i] = new(sql.NullBool)
+		case telegramuserpreferences.FieldUserID, telegramuserpreferences.FieldCreatedAt, telegramuserpreferences.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case telegramuserpreferences.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TelegramUserPreferences fields.
+func (_m *TelegramUserPreferences) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case telegramuserpreferences.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case telegramuserpreferences.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case telegramuserpreferences.FieldBatchPrivateRecaps:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_private_recaps", values[i])
+			} else if value.Valid {
+				_m.BatchPrivateRecaps = value.Bool
+			}
+		case telegramuserpreferences.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case telegramuserpreferences.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TelegramUserPreferences.
+// This includes values selected through modifiers, order, etc.
+func (_m *TelegramUserPreferences) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TelegramUserPreferences.
+// Note that you need to call TelegramUserPreferences.Unwrap() before calling this method if this TelegramUserPreferences
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TelegramUserPreferences) Update() *TelegramUserPreferencesUpdateOne {
+	return NewTelegramUserPreferencesClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TelegramUserPreferences entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TelegramUserPreferences) Unwrap() *TelegramUserPreferences {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TelegramUserPreferences is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TelegramUserPreferences) String() string {
+	var builder strings.Builder
+	builder.WriteString("TelegramUserPreferences(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("batch_private_recaps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchPrivateRecaps))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TelegramUserPreferencesSlice is a parsable slice of TelegramUserPreferences.
+type TelegramUserPreferencesSlice []*TelegramUserPreferences
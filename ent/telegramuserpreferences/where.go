@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package telegramuserpreferences
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldUserID, v))
+}
+
+// BatchPrivateRecaps applies equality check predicate on the "batch_private_recaps" field. It's identical to BatchPrivateRecapsEQ.
+func BatchPrivateRecaps(v bool) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldBatchPrivateRecaps, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLTE(FieldUserID, v))
+}
+
+// BatchPrivateRecapsEQ applies the EQ predicate on the "batch_private_recaps" field.
+func BatchPrivateRecapsEQ(v bool) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldBatchPrivateRecaps, v))
+}
+
+// BatchPrivateRecapsNEQ applies the NEQ predicate on the "batch_private_recaps" field.
+func BatchPrivateRecapsNEQ(v bool) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNEQ(FieldBatchPrivateRecaps, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TelegramUserPreferences) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TelegramUserPreferences) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TelegramUserPreferences) predicate.TelegramUserPreferences {
+	return predicate.TelegramUserPreferences(sql.NotPredicates(p))
+}
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
+)
+
+// TelegramUserPreferencesCreate is the builder for creating a TelegramUserPreferences entity.
+type TelegramUserPreferencesCreate struct {
+	config
+	mutation *TelegramUserPreferencesMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TelegramUserPreferencesCreate) SetUserID(v int64) *TelegramUserPreferencesCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetBatchPrivateRecaps sets the "batch_private_recaps" field.
+func (_c *TelegramUserPreferencesCreate) SetBatchPrivateRecaps(v bool) *TelegramUserPreferencesCreate {
+	_c.mutation.SetBatchPrivateRecaps(v)
+	return _c
+}
+
+// SetNillableBatchPrivateRecaps sets the "batch_private_recaps" field if the given value is not nil.
+func (_c *TelegramUserPreferencesCreate) SetNillableBatchPrivateRecaps(v *bool) *TelegramUserPreferencesCreate {
+	if v != nil {
+		_c.SetBatchPrivateRecaps(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TelegramUserPreferencesCreate) SetCreatedAt(v int64) *TelegramUserPreferencesCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TelegramUserPreferencesCreate) SetNillableCreatedAt(v *int64) *TelegramUserPreferencesCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TelegramUserPreferencesCreate) SetUpdatedAt(v int64) *TelegramUserPreferencesCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TelegramUserPreferencesCreate) SetNillableUpdatedAt(v *int64) *TelegramUserPreferencesCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TelegramUserPreferencesCreate) SetID(v uuid.UUID) *TelegramUserPreferencesCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TelegramUserPreferencesCreate) SetNillableID(v *uuid.UUID) *TelegramUserPreferencesCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TelegramUserPreferencesMutation object of the builder.
+func (_c *TelegramUserPreferencesCreate) Mutation() *TelegramUserPreferencesMutation {
+	return _c.mutation
+}
+
+// Save creates the TelegramUserPreferences in the database.
+func (_c *TelegramUserPreferencesCreate) Save(ctx context.Context) (*TelegramUserPreferences, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TelegramUserPreferencesCreate) SaveX(ctx context.Context) *TelegramUserPreferences {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TelegramUserPreferencesCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TelegramUserPreferencesCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TelegramUserPreferencesCreate) defaults() {
+	if _, ok := _c.mutation.BatchPrivateRecaps(); !ok {
+		v := telegramuserpreferences.DefaultBatchPrivateRecaps
+		_c.mutation.SetBatchPrivateRecaps(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := telegramuserpreferences.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := telegramuserpreferences.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := telegramuserpreferences.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TelegramUserPreferencesCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TelegramUserPreferences.user_id"`)}
+	}
+	if _, ok := _c.mutation.BatchPrivateRecaps(); !ok {
+		return &ValidationError{Name: "batch_private_recaps", err: errors.New(`ent: missing required field "TelegramUserPreferences.batch_private_recaps"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TelegramUserPreferences.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TelegramUserPreferences.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TelegramUserPreferencesCreate) sqlSave(ctx context.Context) (*TelegramUserPreferences, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TelegramUserPreferencesCreate) createSpec() (*TelegramUserPreferences, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TelegramUserPreferences{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(telegramuserpreferences.Table, sqlgraph.NewFieldSpec(telegramuserpreferences.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = _c.schemaConfig.TelegramUserPreferences
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(telegramuserpreferences.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.BatchPrivateRecaps(); ok {
+		_spec.SetField(telegramuserpreferences.FieldBatchPrivateRecaps, field.TypeBool, value)
+		_node.BatchPrivateRecaps = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(telegramuserpreferences.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(telegramuserpreferences.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TelegramUserPreferencesCreateBulk is the builder for creating many TelegramUserPreferences entities in bulk.
+type TelegramUserPreferencesCreateBulk struct {
+	config
+	err      error
+	builders []*TelegramUserPreferencesCreate
+}
+
+// Save creates the TelegramUserPreferences entities in the database.
+func (_c *TelegramUserPreferencesCreateBulk) Save(ctx context.Context) ([]*TelegramUserPreferences, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TelegramUserPreferences, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TelegramUserPreferencesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TelegramUserPreferencesCreateBulk) SaveX(ctx context.Context) []*TelegramUserPreferences {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TelegramUserPreferencesCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TelegramUserPreferencesCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nekomeowww/insights-bot/ent/internal"
+	"github.com/nekomeowww/insights-bot/ent/predicate"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
+)
+
+// TelegramUserPreferencesUpdate is the builder for updating TelegramUserPreferences entities.
+type TelegramUserPreferencesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TelegramUserPreferencesMutation
+}
+
+// Where appends a list predicates to the TelegramUserPreferencesUpdate builder.
+func (_u *TelegramUserPreferencesUpdate) Where(ps ...predicate.TelegramUserPreferences) *TelegramUserPreferencesUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TelegramUserPreferencesUpdate) SetUserID(v int64) *TelegramUserPreferencesUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdate) SetNillableUserID(v *int64) *TelegramUserPreferencesUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TelegramUserPreferencesUpdate) AddUserID(v int64) *TelegramUserPreferencesUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetBatchPrivateRecaps sets the "batch_private_recaps" field.
+func (_u *TelegramUserPreferencesUpdate) SetBatchPrivateRecaps(v bool) *TelegramUserPreferencesUpdate {
+	_u.mutation.SetBatchPrivateRecaps(v)
+	return _u
+}
+
+// SetNillableBatchPrivateRecaps sets the "batch_private_recaps" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdate) SetNillableBatchPrivateRecaps(v *bool) *TelegramUserPreferencesUpdate {
+	if v != nil {
+		_u.SetBatchPrivateRecaps(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TelegramUserPreferencesUpdate) SetCreatedAt(v int64) *TelegramUserPreferencesUpdate {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdate) SetNillableCreatedAt(v *int64) *TelegramUserPreferencesUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *TelegramUserPreferencesUpdate) AddCreatedAt(v int64) *TelegramUserPreferencesUpdate {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TelegramUserPreferencesUpdate) SetUpdatedAt(v int64) *TelegramUserPreferencesUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdate) SetNillableUpdatedAt(v *int64) *TelegramUserPreferencesUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *TelegramUserPreferencesUpdate) AddUpdatedAt(v int64) *TelegramUserPreferencesUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TelegramUserPreferencesMutation object of the builder.
+func (_u *TelegramUserPreferencesUpdate) Mutation() *TelegramUserPreferencesMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TelegramUserPreferencesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TelegramUserPreferencesUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TelegramUserPreferencesUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TelegramUserPreferencesUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TelegramUserPreferencesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(telegramuserpreferences.Table, telegramuserpreferences.Columns, sqlgraph.NewFieldSpec(telegramuserpreferences.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(telegramuserpreferences.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(telegramuserpreferences.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BatchPrivateRecaps(); ok {
+		_spec.SetField(telegramuserpreferences.FieldBatchPrivateRecaps, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(telegramuserpreferences.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(telegramuserpreferences.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(telegramuserpreferences.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(telegramuserpreferences.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.TelegramUserPreferences
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegramuserpreferences.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TelegramUserPreferencesUpdateOne is the builder for updating a single TelegramUserPreferences entity.
+type TelegramUserPreferencesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TelegramUserPreferencesMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TelegramUserPreferencesUpdateOne) SetUserID(v int64) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdateOne) SetNillableUserID(v *int64) *TelegramUserPreferencesUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TelegramUserPreferencesUpdateOne) AddUserID(v int64) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetBatchPrivateRecaps sets the "batch_private_recaps" field.
+func (_u *TelegramUserPreferencesUpdateOne) SetBatchPrivateRecaps(v bool) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.SetBatchPrivateRecaps(v)
+	return _u
+}
+
+// SetNillableBatchPrivateRecaps sets the "batch_private_recaps" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdateOne) SetNillableBatchPrivateRecaps(v *bool) *TelegramUserPreferencesUpdateOne {
+	if v != nil {
+		_u.SetBatchPrivateRecaps(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TelegramUserPreferencesUpdateOne) SetCreatedAt(v int64) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.ResetCreatedAt()
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdateOne) SetNillableCreatedAt(v *int64) *TelegramUserPreferencesUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddCreatedAt adds value to the "created_at" field.
+func (_u *TelegramUserPreferencesUpdateOne) AddCreatedAt(v int64) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.AddCreatedAt(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TelegramUserPreferencesUpdateOne) SetUpdatedAt(v int64) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TelegramUserPreferencesUpdateOne) SetNillableUpdatedAt(v *int64) *TelegramUserPreferencesUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *TelegramUserPreferencesUpdateOne) AddUpdatedAt(v int64) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TelegramUserPreferencesMutation object of the builder.
+func (_u *TelegramUserPreferencesUpdateOne) Mutation() *TelegramUserPreferencesMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TelegramUserPreferencesUpdate builder.
+func (_u *TelegramUserPreferencesUpdateOne) Where(ps ...predicate.TelegramUserPreferences) *TelegramUserPreferencesUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TelegramUserPreferencesUpdateOne) Select(field string, fields ...string) *TelegramUserPreferencesUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TelegramUserPreferences entity.
+func (_u *TelegramUserPreferencesUpdateOne) Save(ctx context.Context) (*TelegramUserPreferences, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TelegramUserPreferencesUpdateOne) SaveX(ctx context.Context) *TelegramUserPreferences {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TelegramUserPreferencesUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TelegramUserPreferencesUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TelegramUserPreferencesUpdateOne) sqlSave(ctx context.Context) (_node *TelegramUserPreferences, err error) {
+	_spec := sqlgraph.NewUpdateSpec(telegramuserpreferences.Table, telegramuserpreferences.Columns, sqlgraph.NewFieldSpec(telegramuserpreferences.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TelegramUserPreferences.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, telegramuserpreferences.FieldID)
+		for _, f := range fields {
+			if !telegramuserpreferences.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != telegramuserpreferences.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(telegramuserpreferences.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(telegramuserpreferences.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BatchPrivateRecaps(); ok {
+		_spec.SetField(telegramuserpreferences.FieldBatchPrivateRecaps, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(telegramuserpreferences.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(telegramuserpreferences.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(telegramuserpreferences.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(telegramuserpreferences.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.TelegramUserPreferences
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &TelegramUserPreferences{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{telegramuserpreferences.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
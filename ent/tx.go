@@ -20,10 +20,14 @@ type Tx struct {
 	FeedbackSummarizationsReactions *FeedbackSummarizationsReactionsClient
 	// LogChatHistoriesRecap is the client for interacting with the LogChatHistoriesRecap builders.
 	LogChatHistoriesRecap *LogChatHistoriesRecapClient
+	// LogChatHistoriesRecapFailures is the client for interacting with the LogChatHistoriesRecapFailures builders.
+	LogChatHistoriesRecapFailures *LogChatHistoriesRecapFailuresClient
 	// LogSummarizations is the client for interacting with the LogSummarizations builders.
 	LogSummarizations *LogSummarizationsClient
 	// MetricOpenAIChatCompletionTokenUsage is the client for interacting with the MetricOpenAIChatCompletionTokenUsage builders.
 	MetricOpenAIChatCompletionTokenUsage *MetricOpenAIChatCompletionTokenUsageClient
+	// RecapFeedback is the client for interacting with the RecapFeedback builders.
+	RecapFeedback *RecapFeedbackClient
 	// SentMessages is the client for interacting with the SentMessages builders.
 	SentMessages *SentMessagesClient
 	// SlackOAuthCredentials is the client for interacting with the SlackOAuthCredentials builders.
@@ -34,6 +38,8 @@ type Tx struct {
 	TelegramChatFeatureFlags *TelegramChatFeatureFlagsClient
 	// TelegramChatRecapsOptions is the client for interacting with the TelegramChatRecapsOptions builders.
 	TelegramChatRecapsOptions *TelegramChatRecapsOptionsClient
+	// TelegramUserPreferences is the client for interacting with the TelegramUserPreferences builders.
+	TelegramUserPreferences *TelegramUserPreferencesClient
 
 	// lazily loaded.
 	client     *Client
@@ -169,13 +175,16 @@ func (tx *Tx) init() {
 	tx.FeedbackChatHistoriesRecapsReactions = NewFeedbackChatHistoriesRecapsReactionsClient(tx.config)
 	tx.FeedbackSummarizationsReactions = NewFeedbackSummarizationsReactionsClient(tx.config)
 	tx.LogChatHistoriesRecap = NewLogChatHistoriesRecapClient(tx.config)
+	tx.LogChatHistoriesRecapFailures = NewLogChatHistoriesRecapFailuresClient(tx.config)
 	tx.LogSummarizations = NewLogSummarizationsClient(tx.config)
 	tx.MetricOpenAIChatCompletionTokenUsage = NewMetricOpenAIChatCompletionTokenUsageClient(tx.config)
+	tx.RecapFeedback = NewRecapFeedbackClient(tx.config)
 	tx.SentMessages = NewSentMessagesClient(tx.config)
 	tx.SlackOAuthCredentials = NewSlackOAuthCredentialsClient(tx.config)
 	tx.TelegramChatAutoRecapsSubscribers = NewTelegramChatAutoRecapsSubscribersClient(tx.config)
 	tx.TelegramChatFeatureFlags = NewTelegramChatFeatureFlagsClient(tx.config)
 	tx.TelegramChatRecapsOptions = NewTelegramChatRecapsOptionsClient(tx.config)
+	tx.TelegramUserPreferences = NewTelegramUserPreferencesClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -23,7 +23,8 @@ func NewModules() fx.Option {
 type NewHandlersParam struct {
 	fx.In
 
-	Dispatcher *tgbot.Dispatcher
+	Dispatcher    *tgbot.Dispatcher
+	Registrations tgbot.Registrations
 
 	RecapHandlers         *recap.Handlers
 	SummarizeHandlers     *summarize.Handlers
@@ -34,6 +35,7 @@ type NewHandlersParam struct {
 type Handlers struct {
 	Dispatcher    *tgbot.Dispatcher
 	HandlerGroups []tgbot.HandlerGroup
+	Registrations tgbot.Registrations
 }
 
 func NewHandlers() func(param NewHandlersParam) *Handlers {
@@ -46,6 +48,7 @@ func NewHandlers() func(param NewHandlersParam) *Handlers {
 				param.WelcomeHandlers,
 				param.ChatMigrationHandlers,
 			},
+			Registrations: param.Registrations,
 		}
 	}
 }
@@ -54,4 +57,6 @@ func (h *Handlers) InstallAll() {
 	for _, g := range h.HandlerGroups {
 		g.Install(h.Dispatcher)
 	}
+
+	h.Dispatcher.Register(h.Registrations)
 }
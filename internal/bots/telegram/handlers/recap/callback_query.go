@@ -2,18 +2,20 @@ package recap
 
 import (
 	"errors"
-	"fmt"
-	"strconv"
-	"strings"
+	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/samber/lo"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
 	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
 	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/webhook"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/logger"
 	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
@@ -23,27 +25,47 @@ import (
 type NewCallbackQueryHandlerParams struct {
 	fx.In
 
+	Config        *configs.Config
 	Logger        *logger.Logger
 	ChatHistories *chathistories.Model
 	TgChats       *tgchats.Model
+	Webhook       *webhook.Client
 }
 
 type CallbackQueryHandler struct {
+	config        *configs.Config
 	logger        *logger.Logger
 	chatHistories *chathistories.Model
 	tgchats       *tgchats.Model
+	webhook       *webhook.Client
+
+	voteKeyboardEdits *recapVoteKeyboardEditDebouncer
 }
 
 func NewCallbackQueryHandler() func(NewCallbackQueryHandlerParams) *CallbackQueryHandler {
 	return func(param NewCallbackQueryHandlerParams) *CallbackQueryHandler {
 		return &CallbackQueryHandler{
+			config:        param.Config,
 			logger:        param.Logger,
 			chatHistories: param.ChatHistories,
 			tgchats:       param.TgChats,
+			webhook:       param.Webhook,
+
+			voteKeyboardEdits: newRecapVoteKeyboardEditDebouncer(recapVoteKeyboardEditInterval),
 		}
 	}
 }
 
+// safeKeyboardFrom copies the inline keyboard of the message, an empty keyboard
+// is returned if the keyboard has already been removed from the message.
+func safeKeyboardFrom(msg *tgbotapi.Message) tgbotapi.InlineKeyboardMarkup {
+	if msg == nil || msg.ReplyMarkup == nil {
+		return tgbotapi.InlineKeyboardMarkup{InlineKeyboard: make([][]tgbotapi.InlineKeyboardButton, 0)}
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...)
+}
+
 func shouldSkipCallbackQueryHandlingByCheckingActionData[
 	D recap.ConfigureRecapToggleActionData | recap.ConfigureRecapAssignModeActionData | recap.ConfigureRecapCompleteActionData | recap.ConfigureAutoRecapRatesPerDayActionData,
 ](c *tgbot.Context, actionData D, chatID, fromID int64) bool {
@@ -95,8 +117,6 @@ func shouldSkipCallbackQueryHandlingByCheckingActionData[
 func (h *CallbackQueryHandler) handleCallbackQueryToggle(c *tgbot.Context) (tgbot.Response, error) {
 	msg := c.Update.CallbackQuery.Message
 
-	generalErrorMessage := configureRecapGeneralInstructionMessage + "\n\n" + "应用聊天记录回顾功能的配置时出现了问题，请稍后再试！"
-
 	fromID := c.Update.CallbackQuery.From.ID
 	chatID := msg.Chat.ID
 	chatTitle := msg.Chat.Title
@@ -109,11 +129,14 @@ func (h *CallbackQueryHandler) handleCallbackQueryToggle(c *tgbot.Context) (tgbo
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(generalErrorMessage).
+			WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.applyFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	messageOptions := h.recapsOptionForMessages(chatID)
+	generalErrorMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.applyFailed")
+
 	shouldSkip := shouldSkipCallbackQueryHandlingByCheckingActionData(c, actionData, chatID, fromID)
 	if shouldSkip {
 		return nil, nil
@@ -134,30 +157,32 @@ func (h *CallbackQueryHandler) handleCallbackQueryToggle(c *tgbot.Context) (tgbo
 
 		if errors.Is(err, errOperationCanNotBeDone) {
 			return nil, tgbot.
-				NewMessageError(configureRecapGeneralInstructionMessage + "\n\n" + err.Error()).
+				NewMessageError(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapOperationErrorMessage(c, messageOptions, err)).
 				WithEdit(msg).
 				WithParseModeHTML().
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 
 		return nil, tgbot.
 			NewExceptionError(err).
 			WithMessage(generalErrorMessage).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.unavailable")).
 			WithEdit(c.Update.Message).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	var firstScheduleTime time.Time
+
 	if actionData.Status {
-		errMessage := configureRecapGeneralInstructionMessage + "\n\n" + "聊天记录回顾功能开启失败，请稍后再试！"
+		errMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.enableFailed")
 
 		err = h.tgchats.EnableChatHistoriesRecapForGroups(chatID, telegram.ChatType(chatType), chatTitle)
 		if err != nil {
@@ -165,19 +190,19 @@ func (h *CallbackQueryHandler) handleCallbackQueryToggle(c *tgbot.Context) (tgbo
 				NewExceptionError(err).
 				WithMessage(errMessage).
 				WithEdit(msg).
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 
-		err = h.tgchats.QueueOneSendChatHistoriesRecapTaskForChatID(chatID, options)
+		firstScheduleTime, err = h.tgchats.QueueFirstSendChatHistoriesRecapTaskForChatID(chatID, options)
 		if err != nil {
 			return nil, tgbot.
 				NewExceptionError(err).
 				WithMessage(errMessage).
 				WithEdit(msg).
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 	} else {
-		errMessage := configureRecapGeneralInstructionMessage + "\n\n" + "聊天记录回顾功能关闭失败，请稍后再试！"
+		errMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.disableFailed")
 
 		err = h.tgchats.DisableChatHistoriesRecapForGroups(chatID, telegram.ChatType(chatType), chatTitle)
 		if err != nil {
@@ -185,42 +210,47 @@ func (h *CallbackQueryHandler) handleCallbackQueryToggle(c *tgbot.Context) (tgbo
 				NewExceptionError(err).
 				WithMessage(errMessage).
 				WithEdit(msg).
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 	}
 
-	markup, err := newRecapInlineKeyboardMarkup(
-		c,
-		chatID,
-		fromID,
-		actionData.Status,
-		tgchat.AutoRecapSendMode(options.AutoRecapSendMode),
-		lo.Ternary(options.AutoRecapRatesPerDay == 0, 4, options.AutoRecapRatesPerDay),
-		options.PinAutoRecapMessage,
-	)
+	markup, err := newRecapInlineKeyboardMarkup(c, chatID, fromID, actionData.Status, options)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.unavailable")).
 			WithEdit(c.Update.Message).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	language := h.tgchats.FindRecapLanguageForGroups(chatID)
+
 	return c.NewEditMessageTextAndReplyMarkup(messageID,
-		lo.Ternary(
+		newConfigureRecapMessageText(c, actionData.Status, options, language, lo.Ternary(
 			actionData.Status,
-			configureRecapGeneralInstructionMessage+"\n\n"+"聊天记录回顾功能已开启，开启后将会自动收集群组中的聊天记录并定时发送聊天回顾快报。",
-			configureRecapGeneralInstructionMessage+"\n\n"+"聊天记录回顾功能已关闭，关闭后将不会再收集群组中的聊天记录了。",
-		),
+			recapT(c, options, "configure.enabled", i18n.M{"Duration": formatDurationUntil(c, options, firstScheduleTime)}),
+			recapT(c, options, "configure.disabled"),
+		)),
 		markup,
-	), nil
+	).WithParseModeHTML(), nil
+}
+
+// recapModeAssignedMessage describes how the auto recaps are delivered after
+// the recap mode is switched to mode.
+func recapModeAssignedMessage(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, mode tgchat.AutoRecapSendMode) string {
+	switch mode {
+	case tgchat.AutoRecapSendModeOnlyPrivateSubscriptions:
+		return recapT(c, options, "configure.modeAssigned.onlyPrivateSubscriptions", i18n.M{"Mode": recapSendModeText(c, options, mode)})
+	case tgchat.AutoRecapSendModeDigestOnly:
+		return recapT(c, options, "configure.modeAssigned.digestOnly", i18n.M{"Mode": recapSendModeText(c, options, mode)})
+	default:
+		return recapT(c, options, "configure.modeAssigned.publicly", i18n.M{"Mode": recapSendModeText(c, options, tgchat.AutoRecapSendModePublicly)})
+	}
 }
 
 func (h *CallbackQueryHandler) handleCallbackQueryAssignMode(c *tgbot.Context) (tgbot.Response, error) {
 	msg := c.Update.CallbackQuery.Message
 
-	generalErrorMessage := configureRecapGeneralInstructionMessage + "\n\n" + "应用聊天记录回顾功能的配置时出现了问题，请稍后再试！"
-
 	fromID := c.Update.CallbackQuery.From.ID
 	chatID := msg.Chat.ID
 	chatTitle := msg.Chat.Title
@@ -232,11 +262,14 @@ func (h *CallbackQueryHandler) handleCallbackQueryAssignMode(c *tgbot.Context) (
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(generalErrorMessage).
+			WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.applyFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	messageOptions := h.recapsOptionForMessages(chatID)
+	generalErrorMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.applyFailed")
+
 	shouldSkip := shouldSkipCallbackQueryHandlingByCheckingActionData(c, actionData, chatID, fromID)
 	if shouldSkip {
 		return nil, nil
@@ -257,17 +290,17 @@ func (h *CallbackQueryHandler) handleCallbackQueryAssignMode(c *tgbot.Context) (
 
 		if errors.Is(err, errOperationCanNotBeDone) || errors.Is(err, errCreatorPermissionRequired) {
 			return nil, tgbot.
-				NewMessageError(configureRecapGeneralInstructionMessage + "\n\n" + err.Error()).
+				NewMessageError(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapOperationErrorMessage(c, messageOptions, err)).
 				WithEdit(msg).
 				WithParseModeHTML().
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 
 		return nil, tgbot.
 			NewExceptionError(err).
 			WithMessage(generalErrorMessage).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	err = h.tgchats.SetRecapsRecapMode(chatID, actionData.Mode)
@@ -276,7 +309,7 @@ func (h *CallbackQueryHandler) handleCallbackQueryAssignMode(c *tgbot.Context) (
 			NewExceptionError(err).
 			WithMessage(generalErrorMessage).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	h.logger.Info("assigned recap mode for chat", zap.String("recap_mode", actionData.Mode.String()))
@@ -285,43 +318,33 @@ func (h *CallbackQueryHandler) handleCallbackQueryAssignMode(c *tgbot.Context) (
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(configureRecapGeneralInstructionMessage + "\n\n" + "聊天记录回顾模式设定失败，请稍后再试！").
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.assignModeFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.unavailable")).
 			WithEdit(c.Update.Message).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
-	markup, err := newRecapInlineKeyboardMarkup(
-		c,
-		chatID,
-		fromID,
-		has,
-		actionData.Mode,
-		lo.Ternary(options.AutoRecapRatesPerDay == 0, 4, options.AutoRecapRatesPerDay),
-		options.PinAutoRecapMessage,
-	)
+	markup, err := newRecapInlineKeyboardMarkup(c, chatID, fromID, has, options)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.unavailable")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	language := h.tgchats.FindRecapLanguageForGroups(chatID)
+
 	return c.NewEditMessageTextAndReplyMarkup(messageID,
-		lo.Ternary(
-			actionData.Mode == tgchat.AutoRecapSendModePublicly,
-			configureRecapGeneralInstructionMessage+"\n\n"+"聊天记录回顾模式已切换为<b>"+tgchat.AutoRecapSendModePublicly.String()+"</b>，将会自动收集群组中的聊天记录并定时发送聊天回顾快报。",
-			configureRecapGeneralInstructionMessage+"\n\n"+"聊天记录回顾模式已切换为<b>"+tgchat.AutoRecapSendModeOnlyPrivateSubscriptions.String()+"</b>，将会自动收集群组中的聊天记录并定时发送聊天回顾快报给通过 /subscribe_recap 命令订阅了本群组聊天回顾用户。",
-		),
+		newConfigureRecapMessageText(c, has, options, language, recapModeAssignedMessage(c, options, actionData.Mode)),
 		markup,
 	).WithParseModeHTML(), nil
 }
@@ -329,8 +352,6 @@ func (h *CallbackQueryHandler) handleCallbackQueryAssignMode(c *tgbot.Context) (
 func (h *CallbackQueryHandler) handleCallbackQueryComplete(c *tgbot.Context) (tgbot.Response, error) {
 	msg := c.Update.CallbackQuery.Message
 
-	generalErrorMessage := configureRecapGeneralInstructionMessage + "\n\n" + "应用聊天记录回顾功能的配置时出现了问题，请稍后再试！"
-
 	fromID := c.Update.CallbackQuery.From.ID
 	chatID := msg.Chat.ID
 	messageID := msg.MessageID
@@ -341,11 +362,13 @@ func (h *CallbackQueryHandler) handleCallbackQueryComplete(c *tgbot.Context) (tg
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(generalErrorMessage).
+			WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.applyFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	messageOptions := h.recapsOptionForMessages(chatID)
+
 	shouldSkip := shouldSkipCallbackQueryHandlingByCheckingActionData(c, actionData, chatID, fromID)
 	if shouldSkip {
 		return nil, nil
@@ -356,9 +379,9 @@ func (h *CallbackQueryHandler) handleCallbackQueryComplete(c *tgbot.Context) (tg
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.unavailable")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	if !is && !c.Bot.IsGroupAnonymousBot(c.Update.CallbackQuery.From) {
@@ -385,9 +408,9 @@ func (h *CallbackQueryHandler) handleCallbackQueryUnsubscribe(c *tgbot.Context)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("取消订阅时出现了问题，请稍后再试！").
+			WithMessage(recapT(c, nil, "unsubscribeFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	if actionData.FromID != fromID {
@@ -395,13 +418,15 @@ func (h *CallbackQueryHandler) handleCallbackQueryUnsubscribe(c *tgbot.Context)
 		return nil, nil
 	}
 
+	options := h.recapsOptionForMessages(actionData.ChatID)
+
 	err = h.tgchats.UnsubscribeToAutoRecaps(actionData.ChatID, fromID)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("取消订阅时出现了问题，请稍后再试！").
+			WithMessage(recapT(c, options, "unsubscribeFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	var inlineKeyboardMarkup tgbotapi.InlineKeyboardMarkup
@@ -414,14 +439,12 @@ func (h *CallbackQueryHandler) handleCallbackQueryUnsubscribe(c *tgbot.Context)
 
 	c.Bot.MayRequest(tgbotapi.NewEditMessageReplyMarkup(chatID, msg.MessageID, inlineKeyboardMarkup))
 
-	return c.NewMessage(fmt.Sprintf("已成功取消订阅群组 <b>%s</b> 的定时聊天回顾。", tgbot.EscapeHTMLSymbols(actionData.ChatTitle))).WithParseModeHTML(), nil
+	return c.NewMessage(recapT(c, options, "unsubscribed", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(actionData.ChatTitle)})).WithParseModeHTML(), nil
 }
 
 func (h *CallbackQueryHandler) handleAutoRecapRatesPerDaySelect(c *tgbot.Context) (tgbot.Response, error) {
 	msg := c.Update.CallbackQuery.Message
 
-	generalErrorMessage := configureRecapGeneralInstructionMessage + "\n\n" + "应用聊天记录回顾功能的配置时出现了问题，请稍后再试！"
-
 	fromID := c.Update.CallbackQuery.From.ID
 	chatID := msg.Chat.ID
 	chatTitle := msg.Chat.Title
@@ -433,16 +456,23 @@ func (h *CallbackQueryHandler) handleAutoRecapRatesPerDaySelect(c *tgbot.Context
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(generalErrorMessage).
+			WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.applyFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	messageOptions := h.recapsOptionForMessages(chatID)
+	generalErrorMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.applyFailed")
+
 	shouldSkip := shouldSkipCallbackQueryHandlingByCheckingActionData(c, actionData, chatID, fromID)
 	if shouldSkip {
 		return nil, nil
 	}
 
+	if !tgchats.IsValidAutoRecapRatesPerDay(actionData.Rates) {
+		return nil, nil
+	}
+
 	// check whether the actor is admin or creator, and whether the bot is admin
 	err = checkAssignMode(c, chatID, c.Update.CallbackQuery.From)
 	if err != nil {
@@ -458,76 +488,71 @@ func (h *CallbackQueryHandler) handleAutoRecapRatesPerDaySelect(c *tgbot.Context
 
 		if errors.Is(err, errOperationCanNotBeDone) || errors.Is(err, errCreatorPermissionRequired) {
 			return nil, tgbot.
-				NewMessageError(configureRecapGeneralInstructionMessage + "\n\n" + err.Error()).
+				NewMessageError(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapOperationErrorMessage(c, messageOptions, err)).
 				WithEdit(msg).
 				WithParseModeHTML().
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 
 		return nil, tgbot.
 			NewExceptionError(err).
 			WithMessage(generalErrorMessage).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	err = h.tgchats.SetAutoRecapRatesPerDay(chatID, actionData.Rates)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(configureRecapGeneralInstructionMessage + "\n\n" + "每天自动创建回顾频率次数设定失败，请稍后再试！").
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.ratesPerDayFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(configureRecapGeneralInstructionMessage + "\n\n" + "每天自动创建回顾频率次数设定失败，请稍后再试！").
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.ratesPerDayFailed")).
 			WithEdit(c.Update.Message).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	err = h.tgchats.QueueOneSendChatHistoriesRecapTaskForChatID(chatID, options)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(configureRecapGeneralInstructionMessage + "\n\n" + "每天自动创建回顾频率次数设定失败，请稍后再试！").
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.ratesPerDayFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(configureRecapGeneralInstructionMessage + "\n\n" + "每天自动创建回顾频率次数设定失败，请稍后再试！").
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.ratesPerDayFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
-	markup, err := newRecapInlineKeyboardMarkup(
-		c,
-		chatID,
-		fromID,
-		has,
-		tgchat.AutoRecapSendMode(options.AutoRecapSendMode),
-		actionData.Rates,
-		options.PinAutoRecapMessage,
-	)
+	markup, err := newRecapInlineKeyboardMarkup(c, chatID, fromID, has, options)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(configureRecapGeneralInstructionMessage + "\n\n" + "每天自动创建回顾频率次数设定失败，请稍后再试！").
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.ratesPerDayFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	language := h.tgchats.FindRecapLanguageForGroups(chatID)
+
 	return c.NewEditMessageTextAndReplyMarkup(messageID,
-		configureRecapGeneralInstructionMessage+"\n\n"+"每天自动创建聊天回顾的频率次数已设定为 <b>"+strconv.FormatInt(int64(actionData.Rates), 10)+"</b>，将会自动收集群组中的聊天记录并在 "+strings.Join(lo.Map(tgchats.MapScheduleHours[actionData.Rates], func(item int64, _ int) string {
-			return fmt.Sprintf("<b>%02d:00</b>", item)
-		}), "，")+" 发送聊天回顾快报。",
+		newConfigureRecapMessageText(c, has, options, language, recapT(c, options, "configure.ratesPerDaySet", i18n.M{
+			"Rates":    actionData.Rates,
+			"Schedule": formatAutoRecapSchedule(c, options, actionData.Rates, language),
+		})),
 		markup,
 	).WithParseModeHTML(), nil
 }
@@ -535,10 +560,9 @@ func (h *CallbackQueryHandler) handleAutoRecapRatesPerDaySelect(c *tgbot.Context
 func (h *CallbackQueryHandler) handleCallbackQueryPin(c *tgbot.Context) (tgbot.Response, error) {
 	msg := c.Update.CallbackQuery.Message
 
-	generalErrorMessage := configureRecapGeneralInstructionMessage + "\n\n" + "应用聊天记录回顾消息置顶功能的配置时出现了问题，请稍后再试！"
-
 	fromID := c.Update.CallbackQuery.From.ID
 	chatID := msg.Chat.ID
+	chatTitle := msg.Chat.Title
 	messageID := msg.MessageID
 
 	var actionData recap.ConfigureRecapToggleActionData
@@ -547,11 +571,14 @@ func (h *CallbackQueryHandler) handleCallbackQueryPin(c *tgbot.Context) (tgbot.R
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage(generalErrorMessage).
+			WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.pinApplyFailed")).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	messageOptions := h.recapsOptionForMessages(chatID)
+	generalErrorMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.pinApplyFailed")
+
 	// todo: Is this necessary for pin message?
 	//shouldSkip := shouldSkipCallbackQueryHandlingByCheckingActionData(c, actionData, chatID, fromID)
 	//if shouldSkip {
@@ -573,30 +600,21 @@ func (h *CallbackQueryHandler) handleCallbackQueryPin(c *tgbot.Context) (tgbot.R
 
 		if errors.Is(err, errOperationCanNotBeDone) || errors.Is(err, errCreatorPermissionRequired) {
 			return nil, tgbot.
-				NewMessageError(configureRecapGeneralInstructionMessage + "\n\n" + err.Error()).
+				NewMessageError(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapOperationErrorMessage(c, messageOptions, err)).
 				WithEdit(msg).
 				WithParseModeHTML().
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 
 		return nil, tgbot.
 			NewExceptionError(err).
 			WithMessage(generalErrorMessage).
 			WithEdit(msg).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
-	}
-
-	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
-	if err != nil {
-		return nil, tgbot.
-			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾消息置顶功能，请稍后再试！").
-			WithEdit(c.Update.Message).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
 	if actionData.Status {
-		errMessage := configureRecapGeneralInstructionMessage + "\n\n" + "聊天记录回顾消息置顶功能开启失败，请稍后再试！"
+		errMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.pinEnableFailed")
 
 		err = h.tgchats.EnablePinAutoRecapMessage(chatID)
 		if err != nil {
@@ -604,10 +622,10 @@ func (h *CallbackQueryHandler) handleCallbackQueryPin(c *tgbot.Context) (tgbot.R
 				NewExceptionError(err).
 				WithMessage(errMessage).
 				WithEdit(msg).
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 	} else {
-		errMessage := configureRecapGeneralInstructionMessage + "\n\n" + "聊天记录回顾消息置顶功能关闭失败，请稍后再试！"
+		errMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.pinDisableFailed")
 
 		err = h.tgchats.DisablePinAutoRecapMessage(chatID)
 		if err != nil {
@@ -615,33 +633,417 @@ func (h *CallbackQueryHandler) handleCallbackQueryPin(c *tgbot.Context) (tgbot.R
 				NewExceptionError(err).
 				WithMessage(errMessage).
 				WithEdit(msg).
-				WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+				WithReplyMarkup(safeKeyboardFrom(msg))
 		}
 	}
 
-	markup, err := newRecapInlineKeyboardMarkup(
-		c,
-		chatID,
-		fromID,
-		actionData.Status,
-		tgchat.AutoRecapSendMode(options.AutoRecapSendMode),
-		lo.Ternary(options.AutoRecapRatesPerDay == 0, 4, options.AutoRecapRatesPerDay),
-		actionData.Status,
-	)
+	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾消息置顶功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.pinUnavailable")).
 			WithEdit(c.Update.Message).
-			WithReplyMarkup(tgbotapi.NewInlineKeyboardMarkup(msg.ReplyMarkup.InlineKeyboard...))
+			WithReplyMarkup(safeKeyboardFrom(msg))
 	}
 
+	markup, err := newRecapInlineKeyboardMarkup(c, chatID, fromID, has, options)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "configure.pinUnavailable")).
+			WithEdit(c.Update.Message).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	language := h.tgchats.FindRecapLanguageForGroups(chatID)
+
 	return c.NewEditMessageTextAndReplyMarkup(messageID,
-		lo.Ternary(
-			actionData.Status,
-			configureRecapGeneralInstructionMessage+"\n\n"+"聊天记录回顾消息置顶功能已开启，开启后将会自动收集群组中的聊天记录并定时发送聊天回顾快报。",
-			configureRecapGeneralInstructionMessage+"\n\n"+"聊天记录回顾消息置顶功能已关闭，关闭后将不会再收集群组中的聊天记录了。",
-		),
+		newConfigureRecapMessageText(c, has, options, language, recapT(c, options, lo.Ternary(actionData.Status, "configure.pinEnabled", "configure.pinDisabled"))),
 		markup,
-	), nil
+	).WithParseModeHTML(), nil
+}
+
+// recapOptionToggle is a recap option turned on and off by a pair of buttons
+// on the configure keyboard, every one of them is handled by the
+// handleCallbackQueryOptionToggle of its route.
+type recapOptionToggle struct {
+	route string
+	// key is the key of the label, the name and the on and off messages of
+	// the option under modules.telegram.recap.configure.toggles.
+	key string
+
+	enabled func(options *ent.TelegramChatRecapsOptions) bool
+	set     func(m *tgchats.Model, chatID int64, status bool) error
+}
+
+var (
+	recapPinSilentlyToggle = recapOptionToggle{
+		route:   "recap/configure/pin_silently",
+		key:     "pinSilently",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.PinAutoRecapMessageSilently },
+		set:     (*tgchats.Model).SetPinAutoRecapMessageSilently,
+	}
+	recapIncludeBotMessagesToggle = recapOptionToggle{
+		route:   "recap/configure/include_bot_messages",
+		key:     "includeBotMessages",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.IncludeBotMessages },
+		set:     (*tgchats.Model).SetIncludeBotMessages,
+	}
+	recapQuietNoticeToggle = recapOptionToggle{
+		route:   "recap/configure/quiet_notice",
+		key:     "quietNotice",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.QuietNoticeEnabled },
+		set:     (*tgchats.Model).SetQuietNoticeEnabled,
+	}
+	recapPerTopicMessagesToggle = recapOptionToggle{
+		route:   "recap/configure/per_topic_messages",
+		key:     "perTopicMessages",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.PerTopicMessages },
+		set:     (*tgchats.Model).SetPerTopicMessages,
+	}
+	recapCountShortMessagesToggle = recapOptionToggle{
+		route:   "recap/configure/count_short_messages",
+		key:     "countShortMessages",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.CountShortMessagesForActivity },
+		set:     (*tgchats.Model).SetCountShortMessagesForActivity,
+	}
+	recapDedupForwardsToggle = recapOptionToggle{
+		route:   "recap/configure/dedup_forwards",
+		key:     "dedupForwards",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.DedupForwards },
+		set:     (*tgchats.Model).SetDedupForwards,
+	}
+	recapStoreMessageContentToggle = recapOptionToggle{
+		route:   "recap/configure/store_message_content",
+		key:     "storeMessageContent",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.StoreMessageContent },
+		set:     (*tgchats.Model).SetStoreMessageContent,
+	}
+	recapAnonymizeParticipantsToggle = recapOptionToggle{
+		route:   "recap/configure/anonymize_participants",
+		key:     "anonymizeParticipants",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.AnonymizeParticipants },
+		set:     (*tgchats.Model).SetAnonymizeParticipants,
+	}
+	recapManualRecapPrivateToggle = recapOptionToggle{
+		route:   "recap/configure/manual_recap_private",
+		key:     "manualRecapPrivate",
+		enabled: func(options *ent.TelegramChatRecapsOptions) bool { return options.ManualRecapPrivate },
+		set:     (*tgchats.Model).SetManualRecapPrivate,
+	}
+)
+
+// recapOptionToggles are all the recapOptionToggle, in the order on the
+// configure keyboard.
+var recapOptionToggles = []recapOptionToggle{
+	recapPinSilentlyToggle,
+	recapIncludeBotMessagesToggle,
+	recapQuietNoticeToggle,
+	recapPerTopicMessagesToggle,
+	recapCountShortMessagesToggle,
+	recapDedupForwardsToggle,
+	recapStoreMessageContentToggle,
+	recapAnonymizeParticipantsToggle,
+	recapManualRecapPrivateToggle,
+}
+
+func (h *CallbackQueryHandler) handleCallbackQueryOptionToggle(toggle recapOptionToggle) func(c *tgbot.Context) (tgbot.Response, error) {
+	return func(c *tgbot.Context) (tgbot.Response, error) {
+		msg := c.Update.CallbackQuery.Message
+
+		fromID := c.Update.CallbackQuery.From.ID
+		chatID := msg.Chat.ID
+		chatTitle := msg.Chat.Title
+		messageID := msg.MessageID
+
+		var actionData recap.ConfigureRecapOptionToggleData
+
+		err := c.BindFromCallbackQueryData(&actionData)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.toggleApplyFailed", i18n.M{"Name": recapT(c, nil, "configure.toggles."+toggle.key+".name")})).
+				WithEdit(msg).
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		messageOptions := h.recapsOptionForMessages(chatID)
+		toggleName := recapT(c, messageOptions, "configure.toggles."+toggle.key+".name")
+		generalErrorMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.toggleApplyFailed", i18n.M{"Name": toggleName})
+
+		// check whether the actor is admin or creator, and whether the bot is admin
+		err = checkAssignMode(c, chatID, c.Update.CallbackQuery.From)
+		if err != nil {
+			if errors.Is(err, errAdministratorPermissionRequired) {
+				h.logger.Debug("action skipped, callback query is not from an admin or creator",
+					zap.Int64("from_id", fromID),
+					zap.Int64("chat_id", chatID),
+					zap.String("permission_check_result", err.Error()),
+				)
+
+				return nil, nil
+			}
+
+			if errors.Is(err, errOperationCanNotBeDone) || errors.Is(err, errCreatorPermissionRequired) {
+				return nil, tgbot.
+					NewMessageError(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapOperationErrorMessage(c, messageOptions, err)).
+					WithEdit(msg).
+					WithParseModeHTML().
+					WithReplyMarkup(safeKeyboardFrom(msg))
+			}
+
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(generalErrorMessage).
+				WithEdit(msg).
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		err = toggle.set(h.tgchats, chatID, actionData.Status)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, lo.Ternary(actionData.Status, "configure.toggleEnableFailed", "configure.toggleDisableFailed"), i18n.M{"Name": toggleName})).
+				WithEdit(msg).
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(generalErrorMessage).
+				WithEdit(msg).
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(generalErrorMessage).
+				WithEdit(msg).
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		markup, err := newRecapInlineKeyboardMarkup(c, chatID, fromID, has, options)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(generalErrorMessage).
+				WithEdit(msg).
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		language := h.tgchats.FindRecapLanguageForGroups(chatID)
+
+		return c.NewEditMessageTextAndReplyMarkup(messageID,
+			newConfigureRecapMessageText(c, has, options, language, recapT(c, options, lo.Ternary(actionData.Status, "configure.toggleEnabled", "configure.toggleDisabled"), i18n.M{
+				"Name":    recapT(c, options, "configure.toggles."+toggle.key+".name"),
+				"Message": recapT(c, options, "configure.toggles."+toggle.key+lo.Ternary(actionData.Status, ".on", ".off"), i18n.M{"Hours": int(chathistories.EphemeralChatHistoriesRetention.Hours())}),
+			})),
+			markup,
+		).WithParseModeHTML(), nil
+	}
+}
+
+func (h *CallbackQueryHandler) handleCallbackQueryOutputFormat(c *tgbot.Context) (tgbot.Response, error) {
+	msg := c.Update.CallbackQuery.Message
+
+	fromID := c.Update.CallbackQuery.From.ID
+	chatID := msg.Chat.ID
+	chatTitle := msg.Chat.Title
+	messageID := msg.MessageID
+
+	var actionData recap.ConfigureRecapOutputFormatData
+
+	err := c.BindFromCallbackQueryData(&actionData)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.outputFormatApplyFailed")).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	messageOptions := h.recapsOptionForMessages(chatID)
+	generalErrorMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.outputFormatApplyFailed")
+
+	// check whether the actor is admin or creator, and whether the bot is admin
+	err = checkAssignMode(c, chatID, c.Update.CallbackQuery.From)
+	if err != nil {
+		if errors.Is(err, errAdministratorPermissionRequired) {
+			h.logger.Debug("action skipped, callback query is not from an admin or creator",
+				zap.Int64("from_id", fromID),
+				zap.Int64("chat_id", chatID),
+				zap.String("permission_check_result", err.Error()),
+			)
+
+			return nil, nil
+		}
+
+		if errors.Is(err, errOperationCanNotBeDone) || errors.Is(err, errCreatorPermissionRequired) {
+			return nil, tgbot.
+				NewMessageError(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapOperationErrorMessage(c, messageOptions, err)).
+				WithEdit(msg).
+				WithParseModeHTML().
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	err = h.tgchats.SetRecapOutputFormat(chatID, actionData.Format)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.outputFormatFailed")).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	markup, err := newRecapInlineKeyboardMarkup(c, chatID, fromID, has, options)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	language := h.tgchats.FindRecapLanguageForGroups(chatID)
+
+	return c.NewEditMessageTextAndReplyMarkup(messageID,
+		newConfigureRecapMessageText(c, has, options, language, recapT(c, options, lo.Ternary(actionData.Format == tgchat.RecapOutputFormatBullets, "configure.outputFormatBullets", "configure.outputFormatProse"))),
+		markup,
+	).WithParseModeHTML(), nil
+}
+
+func (h *CallbackQueryHandler) handleCallbackQueryManualRecapMinRole(c *tgbot.Context) (tgbot.Response, error) {
+	msg := c.Update.CallbackQuery.Message
+
+	fromID := c.Update.CallbackQuery.From.ID
+	chatID := msg.Chat.ID
+	chatTitle := msg.Chat.Title
+	messageID := msg.MessageID
+
+	var actionData recap.ConfigureRecapManualRecapMinRoleData
+
+	err := c.BindFromCallbackQueryData(&actionData)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(configureRecapGeneralInstruction(c, nil) + "\n\n" + recapT(c, nil, "configure.minRoleApplyFailed")).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	messageOptions := h.recapsOptionForMessages(chatID)
+	generalErrorMessage := configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.minRoleApplyFailed")
+
+	// check whether the actor is admin or creator, and whether the bot is admin
+	err = checkAssignMode(c, chatID, c.Update.CallbackQuery.From)
+	if err != nil {
+		if errors.Is(err, errAdministratorPermissionRequired) {
+			h.logger.Debug("action skipped, callback query is not from an admin or creator",
+				zap.Int64("from_id", fromID),
+				zap.Int64("chat_id", chatID),
+				zap.String("permission_check_result", err.Error()),
+			)
+
+			return nil, nil
+		}
+
+		if errors.Is(err, errOperationCanNotBeDone) || errors.Is(err, errCreatorPermissionRequired) {
+			return nil, tgbot.
+				NewMessageError(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapOperationErrorMessage(c, messageOptions, err)).
+				WithEdit(msg).
+				WithParseModeHTML().
+				WithReplyMarkup(safeKeyboardFrom(msg))
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	err = h.tgchats.SetManualRecapMinRole(chatID, actionData.Role)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(configureRecapGeneralInstruction(c, messageOptions) + "\n\n" + recapT(c, messageOptions, "configure.minRoleFailed")).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	markup, err := newRecapInlineKeyboardMarkup(c, chatID, fromID, has, options)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(generalErrorMessage).
+			WithEdit(msg).
+			WithReplyMarkup(safeKeyboardFrom(msg))
+	}
+
+	language := h.tgchats.FindRecapLanguageForGroups(chatID)
+
+	return c.NewEditMessageTextAndReplyMarkup(messageID,
+		newConfigureRecapMessageText(c, has, options, language, lo.Ternary(
+			actionData.Role == tgchat.ManualRecapMinRoleEveryone,
+			recapT(c, options, "configure.minRoleEveryone"),
+			recapT(c, options, "configure.minRoleSet", i18n.M{"Role": recapMinRoleText(c, options, actionData.Role)}),
+		)),
+		markup,
+	).WithParseModeHTML(), nil
 }
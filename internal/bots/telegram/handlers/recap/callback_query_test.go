@@ -0,0 +1,118 @@
+package recap
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func TestSafeKeyboardFrom(t *testing.T) {
+	t.Run("NilReplyMarkup", func(t *testing.T) {
+		markup := safeKeyboardFrom(&tgbotapi.Message{})
+		assert.NotNil(t, markup.InlineKeyboard)
+		assert.Empty(t, markup.InlineKeyboard)
+	})
+
+	t.Run("NilMessage", func(t *testing.T) {
+		markup := safeKeyboardFrom(nil)
+		assert.Empty(t, markup.InlineKeyboard)
+	})
+
+	t.Run("CopiesInlineKeyboard", func(t *testing.T) {
+		keyboard := tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonData("开启", "data")))
+
+		markup := safeKeyboardFrom(&tgbotapi.Message{ReplyMarkup: &keyboard})
+		assert.Equal(t, keyboard, markup)
+	})
+}
+
+func TestHandleCallbackQueryWithoutReplyMarkup(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		methods []string
+		edited  string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		methods = append(methods, method)
+
+		switch method {
+		case "getMe":
+			_, _ = fmt.Fprint(w, `{"ok":true,"result":{"id":1,"is_bot":true,"username":"insights_bot"}}`)
+		case "editMessageText":
+			edited = r.Form.Get("message_id")
+			_, _ = fmt.Fprint(w, `{"ok":true,"result":{"message_id":2}}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"ok":true,"result":true}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	bot, err := tgbotapi.NewBotAPIWithClient("token", server.URL+"/bot%s/%s", server.Client())
+	require.NoError(t, err)
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: configs.NewTestConfig()()})
+	require.NoError(t, err)
+
+	// the keyboard of the message has already been removed, and no action
+	// data could be bound, which leads to the error path of the handler
+	update := tgbotapi.Update{
+		CallbackQuery: &tgbotapi.CallbackQuery{
+			From: &tgbotapi.User{ID: 1},
+			Message: &tgbotapi.Message{
+				MessageID: 2,
+				Chat:      &tgbotapi.Chat{ID: -100123456789, Type: "supergroup"},
+			},
+		},
+	}
+
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	h := &CallbackQueryHandler{logger: logger}
+
+	require.NotPanics(t, func() {
+		_, err = tgbot.NewHandler(h.handleCallbackQueryOptionToggle(recapPerTopicMessagesToggle)).Handle(tgbot.NewContext(bot, update, logger, i, nil))
+	})
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Contains(t, methods, "editMessageText")
+	assert.Equal(t, "2", edited)
+}
+
+func TestRecapModeAssignedMessage(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+
+	assert.Contains(t, recapModeAssignedMessage(c, nil, tgchat.AutoRecapSendModePublicly), "<b>公开</b>")
+	assert.Contains(t, recapModeAssignedMessage(c, nil, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions), "<b>私聊</b>")
+
+	message := recapModeAssignedMessage(c, nil, tgchat.AutoRecapSendModeDigestOnly)
+	assert.Contains(t, message, "<b>仅摘要</b>")
+	assert.Contains(t, message, "/subscribe_recap")
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/nekomeowww/insights-bot/internal/datastore"
 	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
 	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/internal/models/tgusers"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/webhook"
 	"github.com/nekomeowww/insights-bot/pkg/logger"
 	"go.uber.org/fx"
 )
@@ -15,16 +17,20 @@ type NewCommandHandlerParams struct {
 	Config        *configs.Config
 	Logger        *logger.Logger
 	TgChats       *tgchats.Model
+	TgUsers       *tgusers.Model
 	ChatHistories *chathistories.Model
 	Redis         *datastore.Redis
+	Webhook       *webhook.Client
 }
 
 type CommandHandler struct {
 	config        *configs.Config
 	logger        *logger.Logger
 	tgchats       *tgchats.Model
+	tgusers       *tgusers.Model
 	chathistories *chathistories.Model
 	redis         *datastore.Redis
+	webhook       *webhook.Client
 }
 
 func NewRecapCommandHandler() func(NewCommandHandlerParams) *CommandHandler {
@@ -33,8 +39,10 @@ func NewRecapCommandHandler() func(NewCommandHandlerParams) *CommandHandler {
 			config:        param.Config,
 			logger:        param.Logger,
 			tgchats:       param.TgChats,
+			tgusers:       param.TgUsers,
 			chathistories: param.ChatHistories,
 			redis:         param.Redis,
+			webhook:       param.Webhook,
 		}
 	}
 }
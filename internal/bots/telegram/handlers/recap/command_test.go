@@ -0,0 +1,123 @@
+package recap
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/nekomeowww/xo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/datastore"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/logger"
+	"github.com/nekomeowww/insights-bot/pkg/tutils"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+// commandHandlerTest is the CommandHandler backed by the test database and a
+// fake Telegram Bot API server, on which every member is an administrator.
+type commandHandlerTest struct {
+	h      *CommandHandler
+	ent    *datastore.Ent
+	bot    *tgbotapi.BotAPI
+	logger *logger.Logger
+	i18n   *i18n.I18n
+
+	mu      sync.Mutex
+	methods []string
+}
+
+func newCommandHandlerTest(t *testing.T) *commandHandlerTest {
+	t.Helper()
+
+	ht := &commandHandlerTest{methods: make([]string, 0)}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+
+		ht.mu.Lock()
+		ht.methods = append(ht.methods, method)
+		ht.mu.Unlock()
+
+		switch method {
+		case "getChatMember":
+			_, _ = fmt.Fprint(w, `{"ok":true,"result":{"status":"administrator"}}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"ok":true,"result":{"id":1,"is_bot":true,"username":"insights_bot"}}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var err error
+
+	ht.bot, err = tgbotapi.NewBotAPIWithClient("token", server.URL+"/bot%s/%s", server.Client())
+	require.NoError(t, err)
+
+	ht.logger, err = lib.NewLogger()(lib.NewLoggerParams{Configs: configs.NewTestConfig()()})
+	require.NoError(t, err)
+
+	ht.i18n, err = i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	ht.ent, err = datastore.NewEnt()(datastore.NewEntParams{
+		Lifecycle: tutils.NewEmtpyLifecycle(),
+		Configs:   configs.NewTestConfig()(),
+	})
+	require.NoError(t, err)
+
+	model, err := tgchats.NewModel()(tgchats.NewModelParams{
+		Ent:    ht.ent,
+		Logger: ht.logger,
+	})
+	require.NoError(t, err)
+
+	ht.h = &CommandHandler{logger: ht.logger, tgchats: model}
+
+	return ht
+}
+
+// newGroup creates a supergroup with the recaps enabled.
+func (ht *commandHandlerTest) newGroup(t *testing.T) *tgbotapi.Chat {
+	t.Helper()
+
+	chat := &tgbotapi.Chat{ID: xo.RandomInt64(), Type: string(telegram.ChatTypeSuperGroup), Title: xo.RandomHashString(6)}
+
+	err := ht.h.tgchats.EnableChatHistoriesRecapForGroups(chat.ID, telegram.ChatTypeSuperGroup, chat.Title)
+	require.NoError(t, err)
+
+	return chat
+}
+
+// newContext creates the context of the command sent by an administrator in
+// the chat.
+func (ht *commandHandlerTest) newContext(chat *tgbotapi.Chat, command string, arguments string) *tgbot.Context {
+	update := tgbotapi.Update{
+		Message: &tgbotapi.Message{
+			MessageID: 1,
+			From:      &tgbotapi.User{ID: 2},
+			Chat:      chat,
+			Text:      strings.TrimSpace(command + " " + arguments),
+			Entities:  []tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: len(command)}},
+		},
+	}
+
+	return tgbot.NewContext(ht.bot, update, ht.logger, ht.i18n, nil)
+}
+
+// calledMethods returns the methods of the Bot API called so far.
+func (ht *commandHandlerTest) calledMethods() []string {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+
+	return append([]string(nil), ht.methods...)
+}
@@ -3,25 +3,60 @@ package recap
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/samber/lo"
 
 	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
 	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 )
 
 var (
-	errOperationCanNotBeDone                                   = errors.New("抱歉，此操作无法进行")
-	errAdministratorPermissionRequired                         = errors.New("<b>管理员</b>")
-	errCreatorPermissionRequired                               = errors.New("<b>群组创建者</b>")
-	errToggleRecapPermissionDeniedDueToAdministratorIsRequired = fmt.Errorf("%w，只有%w或%w角色可以开启/关闭聊天记录回顾功能。", errOperationCanNotBeDone, errAdministratorPermissionRequired, errCreatorPermissionRequired)
-	errAssignModePermissionDeniedDueToAdministratorIsRequired  = fmt.Errorf("%w，只有%w角色可以配置聊天记录回顾的模式。", errOperationCanNotBeDone, errCreatorPermissionRequired)
+	errOperationCanNotBeDone           = errors.New("operation can not be done")
+	errAdministratorPermissionRequired = errors.New("administrator permission required")
+	errCreatorPermissionRequired       = errors.New("creator permission required")
 )
 
+// recapOperationError is returned by the checks before configuring the recaps
+// and is shown to the user, the message is localized by the key under the
+// operationErrors key of the locales, the sentinel errors are wrapped so that
+// the callers can tell the reasons apart.
+type recapOperationError struct {
+	key  string
+	errs []error
+}
+
+func newRecapOperationError(key string, errs ...error) error {
+	return &recapOperationError{key: key, errs: errs}
+}
+
+func (e *recapOperationError) Error() string {
+	return "recap operation can not be done: " + e.key
+}
+
+func (e *recapOperationError) Unwrap() []error {
+	return e.errs
+}
+
+// recapOperationErrorMessage localizes the message of the error returned by
+// the checks.
+func recapOperationErrorMessage(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, err error) string {
+	var operationErr *recapOperationError
+	if !errors.As(err, &operationErr) {
+		return err.Error()
+	}
+
+	return recapT(c, options, "operationErrors."+operationErr.key)
+}
+
 func checkBotIsAdmin(ctx *tgbot.Context) error {
 	is, err := ctx.IsBotAdministrator()
 	if err != nil {
@@ -29,7 +64,7 @@ func checkBotIsAdmin(ctx *tgbot.Context) error {
 	}
 
 	if !is {
-		return fmt.Errorf("%w，%s", errOperationCanNotBeDone, "现在机器人不是<b>群组管理员</b>，已经不会记录任何聊天记录了。如果需要配置聊天记录回顾功能，<b>请先将机器人设为群组管理员</b>，然后再次执行命令后再试")
+		return newRecapOperationError("botNotAdministrator", errOperationCanNotBeDone)
 	}
 
 	return nil
@@ -42,11 +77,40 @@ func checkToggle(ctx *tgbot.Context, _ int64, user *tgbotapi.User) error {
 	}
 
 	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, telegram.ChatType(ctx.Update.FromChat().Type)) {
-		return fmt.Errorf("%w，%s", errOperationCanNotBeDone, "聊天记录回顾功能只有<b>群组</b>和<b>超级群组</b>的管理员可以配置哦！\n请将 Bot 添加到群组中，并配置 Bot 为管理员后使用管理员权限的用户账户为 Bot 进行配置吧。")
+		return newRecapOperationError("groupsOnly", errOperationCanNotBeDone)
+	}
+
+	if user == nil {
+		return newRecapOperationError("administratorRequired", errAdministratorPermissionRequired)
+	}
+
+	is, err := ctx.IsUserMemberStatus(user.ID, []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !is && !ctx.Bot.IsGroupAnonymousBot(user) {
+		return newRecapOperationError("toggleAdministratorRequired", errOperationCanNotBeDone, errAdministratorPermissionRequired, errCreatorPermissionRequired)
+	}
+
+	return nil
+}
+
+func checkConfigure(ctx *tgbot.Context, user *tgbotapi.User) error {
+	err := checkBotIsAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, telegram.ChatType(ctx.Update.FromChat().Type)) {
+		return newRecapOperationError("groupsOnly", errOperationCanNotBeDone)
 	}
 
 	if user == nil {
-		return fmt.Errorf("%s，只有%w角色可以进行此操作", errOperationCanNotBeDone, errAdministratorPermissionRequired)
+		return newRecapOperationError("administratorRequired", errOperationCanNotBeDone, errAdministratorPermissionRequired)
 	}
 
 	is, err := ctx.IsUserMemberStatus(user.ID, []telegram.MemberStatus{
@@ -58,7 +122,7 @@ func checkToggle(ctx *tgbot.Context, _ int64, user *tgbotapi.User) error {
 	}
 
 	if !is && !ctx.Bot.IsGroupAnonymousBot(user) {
-		return fmt.Errorf("%s，%w", errOperationCanNotBeDone, errToggleRecapPermissionDeniedDueToAdministratorIsRequired)
+		return newRecapOperationError("configureAdministratorRequired", errOperationCanNotBeDone, errAdministratorPermissionRequired, errCreatorPermissionRequired)
 	}
 
 	return nil
@@ -71,11 +135,11 @@ func checkAssignMode(ctx *tgbot.Context, _ int64, user *tgbotapi.User) error {
 	}
 
 	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, telegram.ChatType(ctx.Update.FromChat().Type)) {
-		return fmt.Errorf("%w，%s", errOperationCanNotBeDone, "聊天记录回顾功能只有<b>群组</b>和<b>超级群组</b>的管理员可以配置哦！\n请将 Bot 添加到群组中，并配置 Bot 为管理员后使用管理员权限的用户账户为 Bot 进行配置吧。")
+		return newRecapOperationError("groupsOnly", errOperationCanNotBeDone)
 	}
 
 	if user == nil {
-		return fmt.Errorf("%s，只有%w角色可以进行此操作", errOperationCanNotBeDone, errAdministratorPermissionRequired)
+		return newRecapOperationError("administratorRequired", errAdministratorPermissionRequired)
 	}
 
 	is, err := ctx.IsUserMemberStatus(user.ID, []telegram.MemberStatus{telegram.MemberStatusCreator})
@@ -90,10 +154,10 @@ func checkAssignMode(ctx *tgbot.Context, _ int64, user *tgbotapi.User) error {
 		}
 
 		if !isAdmin && !ctx.Bot.IsGroupAnonymousBot(user) {
-			return fmt.Errorf("%s，只有%w角色可以进行此操作", errOperationCanNotBeDone, errAdministratorPermissionRequired)
+			return newRecapOperationError("administratorRequired", errAdministratorPermissionRequired)
 		}
 
-		return fmt.Errorf("%w，%w", errOperationCanNotBeDone, errAssignModePermissionDeniedDueToAdministratorIsRequired)
+		return newRecapOperationError("assignModeCreatorRequired", errOperationCanNotBeDone, errCreatorPermissionRequired)
 	}
 
 	return nil
@@ -104,10 +168,18 @@ func newRecapInlineKeyboardMarkup(
 	chatID int64,
 	fromID int64,
 	currentRecapStatusOn bool,
-	currentRecapMode tgchat.AutoRecapSendMode,
-	currentAutoRecapRatesPerDay int,
-	currentPinStatusOn bool,
+	options *ent.TelegramChatRecapsOptions,
 ) (tgbotapi.InlineKeyboardMarkup, error) {
+	currentRecapMode := tgchat.AutoRecapSendMode(options.AutoRecapSendMode)
+	currentAutoRecapRatesPerDay := lo.Ternary(options.AutoRecapRatesPerDay == 0, 4, options.AutoRecapRatesPerDay)
+	currentOutputFormat := tgchat.RecapOutputFormat(options.RecapOutputFormat)
+	currentManualRecapMinRole := tgchat.ManualRecapMinRole(options.ManualRecapMinRole)
+	on := recapT(c, options, "on")
+	off := recapT(c, options, "off")
+	publicly := recapSendModeText(c, options, tgchat.AutoRecapSendModePublicly)
+	privately := recapSendModeText(c, options, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions)
+	digestOnly := recapSendModeText(c, options, tgchat.AutoRecapSendModeDigestOnly)
+
 	nopData, err := c.Bot.AssignOneNopCallbackQueryData()
 	if err != nil {
 		return tgbotapi.InlineKeyboardMarkup{}, err
@@ -133,17 +205,12 @@ func newRecapInlineKeyboardMarkup(
 		return tgbotapi.InlineKeyboardMarkup{}, err
 	}
 
-	completeData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/complete", recap.ConfigureRecapCompleteActionData{ChatID: chatID, FromID: fromID})
-	if err != nil {
-		return tgbotapi.InlineKeyboardMarkup{}, err
-	}
-
-	togglePinData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/pin", recap.ConfigureRecapPinMessageData{Status: true, ChatID: chatID})
+	digestOnlyData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/assign_mode", recap.ConfigureRecapAssignModeActionData{Mode: tgchat.AutoRecapSendModeDigestOnly, ChatID: chatID, FromID: fromID})
 	if err != nil {
 		return tgbotapi.InlineKeyboardMarkup{}, err
 	}
 
-	toggleUnpinData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/pin", recap.ConfigureRecapPinMessageData{Status: false, ChatID: chatID})
+	completeData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/complete", recap.ConfigureRecapCompleteActionData{ChatID: chatID, FromID: fromID})
 	if err != nil {
 		return tgbotapi.InlineKeyboardMarkup{}, err
 	}
@@ -151,98 +218,329 @@ func newRecapInlineKeyboardMarkup(
 	if !currentRecapStatusOn {
 		return tgbotapi.NewInlineKeyboardMarkup(
 			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("🔈 聊天记录回顾", nopData),
+				tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.recap"), nopData),
 			),
 			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapStatusOn, "🔘 开启", "开启"), toggleOnData),
-				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(!currentRecapStatusOn, "🔘 关闭", "关闭"), toggleOffData),
+				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapStatusOn, "🔘 "+on, on), toggleOnData),
+				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(!currentRecapStatusOn, "🔘 "+off, off), toggleOffData),
 			),
 			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📩 聊天记录回顾投递方式", nopData),
+				tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.sendMode"), nopData),
 			),
 			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModePublicly, "🔘 "+tgchat.AutoRecapSendModePublicly.String(), tgchat.AutoRecapSendModePublicly.String()), publicData),
-				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, "🔘 "+tgchat.AutoRecapSendModeOnlyPrivateSubscriptions.String(), tgchat.AutoRecapSendModeOnlyPrivateSubscriptions.String()), privateData),
+				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModePublicly, "🔘 "+publicly, publicly), publicData),
+				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, "🔘 "+privately, privately), privateData),
+				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModeDigestOnly, "🔘 "+digestOnly, digestOnly), digestOnlyData),
 			),
 			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("✅ 完成", completeData),
+				tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.complete"), completeData),
 			),
 		), nil
 	}
 
-	twoTimePerDayData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/auto_recap_rates_per_day", recap.ConfigureAutoRecapRatesPerDayActionData{Rates: 2, ChatID: chatID, FromID: fromID})
+	togglePinData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/pin", recap.ConfigureRecapPinMessageData{Status: true, ChatID: chatID})
 	if err != nil {
 		return tgbotapi.InlineKeyboardMarkup{}, err
 	}
 
-	threeTimePerDayData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/auto_recap_rates_per_day", recap.ConfigureAutoRecapRatesPerDayActionData{Rates: 3, ChatID: chatID, FromID: fromID})
+	toggleUnpinData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/pin", recap.ConfigureRecapPinMessageData{Status: false, ChatID: chatID})
+	if err != nil {
+		return tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	proseOutputFormatData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/output_format", recap.ConfigureRecapOutputFormatData{Format: tgchat.RecapOutputFormatProse, ChatID: chatID})
+	if err != nil {
+		return tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	bulletsOutputFormatData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/output_format", recap.ConfigureRecapOutputFormatData{Format: tgchat.RecapOutputFormatBullets, ChatID: chatID})
+	if err != nil {
+		return tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	prose := recapOutputFormatText(c, options, tgchat.RecapOutputFormatProse)
+	bullets := recapOutputFormatText(c, options, tgchat.RecapOutputFormatBullets)
+
+	ratesPerDayButtons := make([]tgbotapi.InlineKeyboardButton, 0, len(tgchats.AutoRecapRatesPerDayOptions))
+
+	for _, rates := range tgchats.AutoRecapRatesPerDayOptions {
+		ratesPerDayData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/auto_recap_rates_per_day", recap.ConfigureAutoRecapRatesPerDayActionData{Rates: rates, ChatID: chatID, FromID: fromID})
+		if err != nil {
+			return tgbotapi.InlineKeyboardMarkup{}, err
+		}
+
+		text := recapT(c, options, "configure.buttons.ratesPerDayOption", i18n.M{"Rates": rates})
+		ratesPerDayButtons = append(ratesPerDayButtons, tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentAutoRecapRatesPerDay == rates, "🔘 "+text, text), ratesPerDayData))
+	}
+
+	ratesPerDayRows := lo.Map(lo.Chunk(ratesPerDayButtons, 4), func(buttons []tgbotapi.InlineKeyboardButton, _ int) []tgbotapi.InlineKeyboardButton {
+		return tgbotapi.NewInlineKeyboardRow(buttons...)
+	})
+
+	manualRecapMinRoleButtons := make([]tgbotapi.InlineKeyboardButton, 0, 3)
+
+	for _, role := range []tgchat.ManualRecapMinRole{
+		tgchat.ManualRecapMinRoleEveryone,
+		tgchat.ManualRecapMinRoleAdministrator,
+		tgchat.ManualRecapMinRoleCreator,
+	} {
+		manualRecapMinRoleData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/manual_recap_min_role", recap.ConfigureRecapManualRecapMinRoleData{Role: role, ChatID: chatID})
+		if err != nil {
+			return tgbotapi.InlineKeyboardMarkup{}, err
+		}
+
+		text := recapMinRoleText(c, options, role)
+		manualRecapMinRoleButtons = append(manualRecapMinRoleButtons, tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentManualRecapMinRole == role, "🔘 "+text, text), manualRecapMinRoleData))
+	}
+
+	deliveryToggleRows, err := newRecapOptionToggleRows(c, chatID, options, nopData,
+		recapPinSilentlyToggle,
+		recapIncludeBotMessagesToggle,
+		recapQuietNoticeToggle,
+		recapPerTopicMessagesToggle,
+	)
 	if err != nil {
 		return tgbotapi.InlineKeyboardMarkup{}, err
 	}
 
-	fourTimePerDayData, err := c.Bot.AssignOneCallbackQueryData("recap/configure/auto_recap_rates_per_day", recap.ConfigureAutoRecapRatesPerDayActionData{Rates: 4, ChatID: chatID, FromID: fromID})
+	contentToggleRows, err := newRecapOptionToggleRows(c, chatID, options, nopData,
+		recapCountShortMessagesToggle,
+		recapDedupForwardsToggle,
+		recapStoreMessageContentToggle,
+		recapAnonymizeParticipantsToggle,
+		recapManualRecapPrivateToggle,
+	)
 	if err != nil {
 		return tgbotapi.InlineKeyboardMarkup{}, err
 	}
 
-	return tgbotapi.NewInlineKeyboardMarkup(
+	rows := [][]tgbotapi.InlineKeyboardButton{
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("🔈 聊天记录回顾", nopData),
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.recap"), nopData),
 		),
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapStatusOn, "🔘 开启", "开启"), toggleOnData),
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(!currentRecapStatusOn, "🔘 关闭", "关闭"), toggleOffData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapStatusOn, "🔘 "+on, on), toggleOnData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(!currentRecapStatusOn, "🔘 "+off, off), toggleOffData),
 		),
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("📩 聊天记录回顾投递方式", nopData),
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.sendMode"), nopData),
 		),
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModePublicly, "🔘 "+tgchat.AutoRecapSendModePublicly.String(), tgchat.AutoRecapSendModePublicly.String()), publicData),
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, "🔘 "+tgchat.AutoRecapSendModeOnlyPrivateSubscriptions.String(), tgchat.AutoRecapSendModeOnlyPrivateSubscriptions.String()), privateData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModePublicly, "🔘 "+publicly, publicly), publicData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, "🔘 "+privately, privately), privateData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentRecapMode == tgchat.AutoRecapSendModeDigestOnly, "🔘 "+digestOnly, digestOnly), digestOnlyData),
 		),
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("🛎️ 每天自动创建回顾次数", nopData),
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.ratesPerDay"), nopData),
 		),
+	}
+	rows = append(rows, ratesPerDayRows...)
+	rows = append(rows,
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentAutoRecapRatesPerDay == 2, "🔘 2 次", "2 次"), twoTimePerDayData),
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentAutoRecapRatesPerDay == 3, "🔘 3 次", "3 次"), threeTimePerDayData),
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentAutoRecapRatesPerDay == 4, "🔘 4 次", "4 次"), fourTimePerDayData),
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.pin"), nopData),
 		),
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("🪧 置顶聊天记录回顾", nopData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(options.PinAutoRecapMessage, "🔘 "+on, on), togglePinData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(!options.PinAutoRecapMessage, "🔘 "+off, off), toggleUnpinData),
 		),
+	)
+	rows = append(rows, deliveryToggleRows...)
+	rows = append(rows,
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.outputFormat"), nopData),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentOutputFormat == tgchat.RecapOutputFormatProse, "🔘 "+prose, prose), proseOutputFormatData),
+			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentOutputFormat == tgchat.RecapOutputFormatBullets, "🔘 "+bullets, bullets), bulletsOutputFormatData),
+		),
+	)
+	rows = append(rows, contentToggleRows...)
+	rows = append(rows,
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(currentPinStatusOn, "🔘 开启", "开启"), togglePinData),
-			tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(!currentPinStatusOn, "🔘 关闭", "关闭"), toggleUnpinData),
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.manualRecapMinRole"), nopData),
 		),
+		tgbotapi.NewInlineKeyboardRow(manualRecapMinRoleButtons...),
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("✅ 完成", completeData),
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.buttons.complete"), completeData),
 		),
-	), nil
+	)
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...), nil
 }
 
-const (
-	configureRecapGeneralInstructionMessage = "好的。请在下面点击你想配置的选项进行操作吧。"
-)
+// newRecapOptionToggleRows creates the header row and the on and off buttons
+// row of every toggle.
+func newRecapOptionToggleRows(
+	c *tgbot.Context,
+	chatID int64,
+	options *ent.TelegramChatRecapsOptions,
+	nopData string,
+	toggles ...recapOptionToggle,
+) ([][]tgbotapi.InlineKeyboardButton, error) {
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(toggles)*2)
+
+	for _, toggle := range toggles {
+		onData, err := c.Bot.AssignOneCallbackQueryData(toggle.route, recap.ConfigureRecapOptionToggleData{Status: true, ChatID: chatID})
+		if err != nil {
+			return nil, err
+		}
+
+		offData, err := c.Bot.AssignOneCallbackQueryData(toggle.route, recap.ConfigureRecapOptionToggleData{Status: false, ChatID: chatID})
+		if err != nil {
+			return nil, err
+		}
+
+		enabled := toggle.enabled(options)
+		on := recapT(c, options, "on")
+		off := recapT(c, options, "off")
+
+		rows = append(rows,
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "configure.toggles."+toggle.key+".label"), nopData),
+			),
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(enabled, "🔘 "+on, on), onData),
+				tgbotapi.NewInlineKeyboardButtonData(lo.Ternary(!enabled, "🔘 "+off, off), offData),
+			),
+		)
+	}
+
+	return rows, nil
+}
+
+// configureRecapGeneralInstruction returns the instruction shown above the
+// buttons of the configure message.
+func configureRecapGeneralInstruction(c *tgbot.Context, options *ent.TelegramChatRecapsOptions) string {
+	return recapT(c, options, "configure.instruction")
+}
+
+// formatRecapOptionsSummary renders every current recap option of the chat
+// into a compact HTML block, options can be nil if the chat has never been
+// configured before. The schedule hours are formatted for the language.
+func formatRecapOptionsSummary(c *tgbot.Context, recapEnabled bool, options *ent.TelegramChatRecapsOptions, language string) string {
+	if options == nil {
+		options = &ent.TelegramChatRecapsOptions{AutoRecapSendMode: int(tgchat.AutoRecapSendModePublicly), PinAutoRecapMessageSilently: true, RecapLinkPreview: true}
+	}
+
+	ratesPerDay := lo.Ternary(options.AutoRecapRatesPerDay == 0, 4, options.AutoRecapRatesPerDay)
+	scheduleHours := formatAutoRecapSchedule(c, options, ratesPerDay, language)
+
+	bold := func(text string) string {
+		return "<b>" + text + "</b>"
+	}
+	onOff := func(enabled bool) string {
+		return bold(recapOnOff(c, options, enabled))
+	}
+	label := func(key string) string {
+		return recapT(c, options, "configure.summary."+key)
+	}
+	value := func(key string, args ...any) string {
+		return recapT(c, options, "configure.summary.values."+key, args...)
+	}
+
+	lines := []string{
+		label("title"),
+		label("recap") + onOff(recapEnabled),
+		label("sendMode") + bold(recapSendModeText(c, options, tgchat.AutoRecapSendMode(options.AutoRecapSendMode))),
+		label("ratesPerDay") + value("ratesPerDay", i18n.M{"Rates": ratesPerDay, "Schedule": scheduleHours}),
+		label("weekdays") + bold(formatRecapWeekdays(c, options, options.RecapWeekdays)),
+		label("pin") + onOff(options.PinAutoRecapMessage),
+		label("pinSilently") + onOff(options.PinAutoRecapMessageSilently),
+		label("autoUnpin") + lo.Ternary(tgchats.AutoUnpinAfter(options) == 0, onOff(false), bold(value("after", i18n.M{"Duration": recapDuration(c, options, tgchats.AutoUnpinAfter(options))}))),
+		label("includeBotMessages") + onOff(options.IncludeBotMessages),
+		label("quietNotice") + onOff(options.QuietNoticeEnabled),
+		label("perTopicMessages") + onOff(options.PerTopicMessages),
+		label("outputFormat") + bold(recapOutputFormatText(c, options, tgchat.RecapOutputFormat(options.RecapOutputFormat))),
+		label("minMessageLength") + bold(lo.Ternary(options.MinMessageLengthForSummary <= 0, value("unlimited"), value("characters", i18n.M{"Count": options.MinMessageLengthForSummary}))),
+		label("countShortMessages") + onOff(options.CountShortMessagesForActivity),
+		label("excludedMessageTypes") + bold(recapMessageTypesText(c, options, tgchat.MessageTypes(options.ExcludedMessageTypes))),
+		label("dedupForwards") + onOff(options.DedupForwards),
+		label("storeMessageContent") + lo.Ternary(options.StoreMessageContent, onOff(true), value("ephemeralOnly", i18n.M{"Hours": int(chathistories.EphemeralChatHistoriesRetention.Hours())})),
+		label("anonymizeParticipants") + onOff(options.AnonymizeParticipants),
+		label("manualRecapPrivate") + onOff(options.ManualRecapPrivate),
+		label("manualRecapMinRole") + bold(recapMinRoleText(c, options, tgchat.ManualRecapMinRole(options.ManualRecapMinRole))),
+		label("topKeywords") + lo.Ternary(options.TopKeywordsCount <= 0, onOff(false), bold(value("keywords", i18n.M{"Count": options.TopKeywordsCount}))),
+		label("relatedMessages") + lo.Ternary(options.RelatedMessagesCount <= 0, onOff(false), bold(value("messages", i18n.M{"Count": options.RelatedMessagesCount}))),
+		label("incremental") + onOff(options.IncrementalRecap),
+		label("linkPreview") + bold(lo.Ternary(tgchats.RecapLinkPreviewEnabled(options), value("shown"), value("hidden"))),
+		label("document") + onOff(options.RecapDocumentAttachment),
+		label("rateLimitExemptAdmins") + onOff(options.ManualRecapRateLimitExemptAdmins),
+		label("approval") + onOff(options.ApprovalRequired),
+		label("collectOnly") + onOff(options.CollectOnly),
+		label("languages") + bold(lo.Ternary(options.SummaryLanguages == "", value("defaultLanguage"), tgbot.EscapeHTMLSymbols(strings.Join(tgchats.ParseSummaryLanguages(options.SummaryLanguages), recapT(c, options, "enumerationSeparator"))))),
+		label("subscribeRequirements") + formatSubscribeRecapRequirements(c, options, options.SubscribeMinMembershipDays, tgchat.SubscribeRecapMemberStatusRequirement(options.SubscribeMinMemberStatus)),
+		label("targetChat") + lo.Ternary(options.RecapTargetChatID == 0, bold(value("currentGroup")), fmt.Sprintf("<code>%d</code>", options.RecapTargetChatID)),
+		label("thread") + lo.Ternary(tgchats.RecapThreadID(options) == 0, bold(value("default")), fmt.Sprintf("<code>%d</code>", tgchats.RecapThreadID(options))),
+		label("persona") + bold(lo.Ternary(options.RecapPersona == "", value("default"), tgbot.EscapeHTMLSymbols(options.RecapPersona))),
+		label("temperature") + bold(lo.Ternary(options.SummaryTemperature < 0, value("default"), fmt.Sprintf("%g", options.SummaryTemperature))),
+		label("maxTokens") + bold(lo.Ternary(options.SummaryMaxTokens <= 0, value("default"), fmt.Sprintf("%d", options.SummaryMaxTokens))),
+		label("inProgressText") + bold(lo.Ternary(options.RecapInProgressTemplate == "", value("default"), value("custom"))),
+		label("disclaimer") + bold(lo.Ternary(options.RecapDisclaimer == "", value("notSet"), tgbot.EscapeHTMLSymbols(options.RecapDisclaimer))),
+	}
+
+	if tgchats.IsAutoRecapsSnoozed(options, time.Now()) {
+		lines = append(lines, label("snoozed")+bold(value("resumesAfter", i18n.M{"Duration": formatDurationUntil(c, options, time.UnixMilli(options.AutoRecapsSnoozedUntil))})))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// formatAutoRecapSchedule formats the schedule hours of the rates per day, the
+// schedules that are too many to be listed are formatted as the interval.
+func formatAutoRecapSchedule(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, ratesPerDay int, language string) string {
+	scheduleHours := tgchats.MapScheduleHours[ratesPerDay]
+	if len(scheduleHours) > 4 {
+		return recapT(c, options, "configure.scheduleEvery", i18n.M{
+			"Start": i18n.FormatClockHour(language, int(scheduleHours[0])),
+			"Hours": 24 / ratesPerDay,
+		})
+	}
+
+	return strings.Join(lo.Map(scheduleHours, func(item int64, _ int) string {
+		return i18n.FormatClockHour(language, int(item))
+	}), recapT(c, options, "enumerationSeparator"))
+}
+
+// formatDurationUntil formats the duration from now until t, rounded up to
+// the next minute.
+func formatDurationUntil(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, t time.Time) string {
+	return recapDuration(c, options, lo.Max([]time.Duration{time.Until(t), time.Minute}))
+}
+
+// newConfigureRecapMessageText composes the configure message with the
+// options summary at the top, message will be appended after the general
+// instruction if not empty.
+func newConfigureRecapMessageText(c *tgbot.Context, recapEnabled bool, options *ent.TelegramChatRecapsOptions, language string, message string) string {
+	text := formatRecapOptionsSummary(c, recapEnabled, options, language) + "\n\n" + configureRecapGeneralInstruction(c, options)
+	if message != "" {
+		text += "\n\n" + message
+	}
+
+	return text
+}
 
 func (h *CommandHandler) handleConfigureRecapCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+
 	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
 	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
-		return nil, tgbot.NewMessageError("只有在群组和超级群组内才可以配置聊天记录回顾功能哦！").WithReply(c.Update.Message)
+		return nil, tgbot.NewMessageError(recapT(c, nil, "configure.groupsOnly")).WithReply(c.Update.Message)
 	}
 
+	messageOptions := h.recapsOptionForMessages(chatID)
+
 	err := checkBotIsAdmin(c)
 	if err != nil {
 		if errors.Is(err, errOperationCanNotBeDone) || errors.Is(err, errCreatorPermissionRequired) {
 			return nil, tgbot.
-				NewMessageError(err.Error()).
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
 				WithReply(c.Update.Message).
 				WithParseModeHTML()
 		}
 
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.unavailable")).
 			WithReply(c.Update.Message)
 	}
 
@@ -253,47 +551,38 @@ func (h *CommandHandler) handleConfigureRecapCommand(c *tgbot.Context) (tgbot.Re
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "configure.unavailable")).
 			WithReply(c.Update.Message)
 	}
 
 	if !is && !c.Bot.IsGroupAnonymousBot(c.Update.Message.From) {
 		return nil, tgbot.
-			NewMessageError(fmt.Errorf("%w，%s", errOperationCanNotBeDone, "需要<b>管理员</b>权限才能配置聊天记录回顾功能。").Error()).
+			NewMessageError(recapT(c, messageOptions, "operationErrors.configureAdministratorRequired")).
 			WithReply(c.Update.Message).
 			WithParseModeHTML()
 	}
 
-	chatID := c.Update.Message.Chat.ID
-
 	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, c.Update.Message.Chat.Title)
 	if err != nil {
-		return nil, tgbot.NewExceptionError(err).WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").WithReply(c.Update.Message)
+		return nil, tgbot.NewExceptionError(err).WithMessage(recapT(c, messageOptions, "configure.unavailable")).WithReply(c.Update.Message)
 	}
 
 	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
 	if err != nil {
-		return nil, tgbot.NewExceptionError(err).WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").WithReply(c.Update.Message)
+		return nil, tgbot.NewExceptionError(err).WithMessage(recapT(c, messageOptions, "configure.unavailable")).WithReply(c.Update.Message)
 	}
 
 	if options == nil {
-		options = &ent.TelegramChatRecapsOptions{AutoRecapSendMode: int(tgchat.AutoRecapSendModePublicly)}
+		options = &ent.TelegramChatRecapsOptions{AutoRecapSendMode: int(tgchat.AutoRecapSendModePublicly), PinAutoRecapMessageSilently: true, RecapLinkPreview: true}
 	}
 
-	markup, err := newRecapInlineKeyboardMarkup(
-		c,
-		chatID,
-		c.Update.Message.From.ID,
-		has,
-		tgchat.AutoRecapSendMode(options.AutoRecapSendMode),
-		lo.Ternary(options.AutoRecapRatesPerDay == 0, 4, options.AutoRecapRatesPerDay),
-		options.PinAutoRecapMessage,
-	)
+	markup, err := newRecapInlineKeyboardMarkup(c, chatID, c.Update.Message.From.ID, has, options)
 	if err != nil {
-		return nil, tgbot.NewExceptionError(err).WithMessage("暂时无法配置聊天记录回顾功能，请稍后再试！").WithReply(c.Update.Message)
+		return nil, tgbot.NewExceptionError(err).WithMessage(recapT(c, options, "configure.unavailable")).WithReply(c.Update.Message)
 	}
 
 	return c.
-		NewMessageReplyTo(configureRecapGeneralInstructionMessage, c.Update.Message.MessageID).
-		WithReplyMarkup(markup), nil
+		NewMessageReplyTo(newConfigureRecapMessageText(c, has, options, h.tgchats.FindRecapLanguageForGroups(chatID), ""), c.Update.Message.MessageID).
+		WithReplyMarkup(markup).
+		WithParseModeHTML(), nil
 }
@@ -1,6 +1,9 @@
 package recap
 
 import (
+	"sync"
+	"time"
+
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
 	"github.com/nekomeowww/insights-bot/ent/feedbackchathistoriesrecapsreactions"
@@ -9,6 +12,64 @@ import (
 	"go.uber.org/zap"
 )
 
+// recapVoteKeyboardEditInterval is the window within which the votes on the
+// same recap message are coalesced into one edit of the vote buttons.
+const recapVoteKeyboardEditInterval = 2 * time.Second
+
+type recapVoteKeyboardEditKey struct {
+	chatID    int64
+	messageID int
+}
+
+// recapVoteKeyboardEditDebouncer coalesces the edits of the vote buttons of
+// the same recap message, only the first edit scheduled within the interval is
+// armed and the rest are dropped, the armed edit looks up the latest counts
+// when it runs.
+type recapVoteKeyboardEditDebouncer struct {
+	mutex     sync.Mutex
+	interval  time.Duration
+	pending   map[recapVoteKeyboardEditKey]struct{}
+	afterFunc func(d time.Duration, f func())
+}
+
+func newRecapVoteKeyboardEditDebouncer(interval time.Duration) *recapVoteKeyboardEditDebouncer {
+	return &recapVoteKeyboardEditDebouncer{
+		interval: interval,
+		pending:  make(map[recapVoteKeyboardEditKey]struct{}),
+		afterFunc: func(d time.Duration, f func()) {
+			time.AfterFunc(d, f)
+		},
+	}
+}
+
+// schedule arms edit to run after the interval unless an edit of the message
+// is already pending, reports whether edit was armed.
+func (d *recapVoteKeyboardEditDebouncer) schedule(chatID int64, messageID int, edit func()) bool {
+	key := recapVoteKeyboardEditKey{chatID: chatID, messageID: messageID}
+
+	d.mutex.Lock()
+	if _, ok := d.pending[key]; ok {
+		d.mutex.Unlock()
+
+		return false
+	}
+
+	d.pending[key] = struct{}{}
+	d.mutex.Unlock()
+
+	d.afterFunc(d.interval, func() {
+		// released before editing, so that the votes during the edit schedule
+		// another one with the counts after them
+		d.mutex.Lock()
+		delete(d.pending, key)
+		d.mutex.Unlock()
+
+		edit()
+	})
+
+	return true
+}
+
 func (h *CallbackQueryHandler) handleCallbackQueryReact(c *tgbot.Context) (tgbot.Response, error) {
 	messageID := c.Update.CallbackQuery.Message.MessageID
 
@@ -62,6 +123,18 @@ func (h *CallbackQueryHandler) handleCallbackQueryReact(c *tgbot.Context) (tgbot
 		return nil, nil
 	}
 
+	// the votes are recorded right away, but the edits of the keyboard are
+	// coalesced to avoid flooding Telegram when the buttons are clicked rapidly
+	h.voteKeyboardEdits.schedule(c.Update.CallbackQuery.Message.Chat.ID, messageID, func() {
+		h.editRecapVoteKeyboard(c, data, logID, messageID)
+	})
+
+	return nil, nil
+}
+
+// editRecapVoteKeyboard updates the counts on the vote buttons of the recap
+// message to the latest votes.
+func (h *CallbackQueryHandler) editRecapVoteKeyboard(c *tgbot.Context, data recap.FeedbackRecapReactionActionData, logID uuid.UUID, messageID int) {
 	counts, err := h.chatHistories.FindFeedbackRecapsReactionCountsForChatIDAndLogID(data.ChatID, logID)
 	if err != nil {
 		h.logger.Error("failed to find feedback recaps reactions for chat id and log id",
@@ -72,7 +145,7 @@ func (h *CallbackQueryHandler) handleCallbackQueryReact(c *tgbot.Context) (tgbot
 			zap.String("log_id", data.LogID),
 		)
 
-		return nil, nil
+		return
 	}
 
 	upVoteButton, err := h.chatHistories.NewFeedbackRecapsUpVoteButton(c.Bot, data.ChatID, logID, counts.UpVotes)
@@ -85,7 +158,7 @@ func (h *CallbackQueryHandler) handleCallbackQueryReact(c *tgbot.Context) (tgbot
 			zap.String("log_id", data.LogID),
 		)
 
-		return nil, nil
+		return
 	}
 
 	downVoteButton, err := h.chatHistories.NewFeedbackRecapsDownVoteButton(c.Bot, data.ChatID, logID, counts.DownVotes)
@@ -98,7 +171,7 @@ func (h *CallbackQueryHandler) handleCallbackQueryReact(c *tgbot.Context) (tgbot
 			zap.String("log_id", data.LogID),
 		)
 
-		return nil, nil
+		return
 	}
 
 	lmaoButton, err := h.chatHistories.NewFeedbackRecapsLmaoButton(c.Bot, data.ChatID, logID, counts.Lmao)
@@ -111,12 +184,12 @@ func (h *CallbackQueryHandler) handleCallbackQueryReact(c *tgbot.Context) (tgbot
 			zap.String("log_id", data.LogID),
 		)
 
-		return nil, nil
+		return
 	}
 
 	inlineKeyboardMarkup := c.Update.CallbackQuery.Message.ReplyMarkup
 	if inlineKeyboardMarkup == nil || len(inlineKeyboardMarkup.InlineKeyboard) == 0 {
-		return nil, nil
+		return
 	}
 
 	for i := range inlineKeyboardMarkup.InlineKeyboard {
@@ -134,6 +207,4 @@ func (h *CallbackQueryHandler) handleCallbackQueryReact(c *tgbot.Context) (tgbot
 	}
 
 	c.Bot.MayRequest(tgbotapi.NewEditMessageReplyMarkup(data.ChatID, messageID, *inlineKeyboardMarkup))
-
-	return nil, nil
 }
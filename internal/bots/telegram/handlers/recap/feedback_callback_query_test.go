@@ -0,0 +1,57 @@
+package recap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecapVoteKeyboardEditDebouncer(t *testing.T) {
+	var armed []func()
+
+	debouncer := newRecapVoteKeyboardEditDebouncer(recapVoteKeyboardEditInterval)
+	debouncer.afterFunc = func(d time.Duration, f func()) {
+		assert.Equal(t, recapVoteKeyboardEditInterval, d)
+
+		armed = append(armed, f)
+	}
+
+	var edits int
+
+	edit := func() {
+		edits++
+	}
+
+	t.Run("RapidVotesAreCoalesced", func(t *testing.T) {
+		assert.True(t, debouncer.schedule(-100123, 1, edit))
+
+		for i := 0; i < 10; i++ {
+			assert.False(t, debouncer.schedule(-100123, 1, edit))
+		}
+
+		require.Len(t, armed, 1)
+		assert.Zero(t, edits)
+
+		armed[0]()
+		assert.Equal(t, 1, edits)
+	})
+
+	t.Run("VotesAfterTheWindowAreEditedAgain", func(t *testing.T) {
+		assert.True(t, debouncer.schedule(-100123, 1, edit))
+		assert.False(t, debouncer.schedule(-100123, 1, edit))
+
+		require.Len(t, armed, 2)
+
+		armed[1]()
+		assert.Equal(t, 2, edits)
+	})
+
+	t.Run("MessagesAreDebouncedSeparately", func(t *testing.T) {
+		assert.True(t, debouncer.schedule(-100123, 2, edit))
+		assert.True(t, debouncer.schedule(-100456, 1, edit))
+
+		require.Len(t, armed, 4)
+	})
+}
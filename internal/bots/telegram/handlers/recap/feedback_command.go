@@ -0,0 +1,120 @@
+package recap
+
+import (
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
+)
+
+// findRecapFeedbackActionDataFromMessage finds the reaction action data that
+// was assigned to the feedback buttons of the recap message.
+func findRecapFeedbackActionDataFromMessage(bot *tgbot.Bot, message *tgbotapi.Message) (*recap.FeedbackRecapReactionActionData, error) {
+	if message.ReplyMarkup == nil {
+		return nil, nil
+	}
+
+	for _, row := range message.ReplyMarkup.InlineKeyboard {
+		for _, button := range row {
+			if button.CallbackData == nil {
+				continue
+			}
+
+			var data recap.FeedbackRecapReactionActionData
+
+			found, err := bot.FetchCallbackQueryActionData("smr/summarization/feedback/react", *button.CallbackData, &data)
+			if err != nil {
+				return nil, err
+			}
+
+			if found {
+				return &data, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (h *CommandHandler) handleFeedbackCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	replyToMessage := c.Update.Message.ReplyToMessage
+	if replyToMessage == nil || replyToMessage.From == nil || replyToMessage.From.ID != c.Bot.Self.ID {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "feedback.replyRequired")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	text := strings.TrimSpace(c.Update.Message.CommandArguments())
+	if text == "" {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "feedback.textRequired")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	data, err := findRecapFeedbackActionDataFromMessage(c.Bot, replyToMessage)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "feedback.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if data == nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "feedback.unrecognized")).
+			WithReply(c.Update.Message)
+	}
+
+	// feedback to the recaps delivered in private follows the recapped chat
+	if data.ChatID != chatID {
+		messageOptions = h.recapsOptionForMessages(data.ChatID)
+	}
+
+	logID, err := uuid.Parse(data.LogID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "feedback.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	_, err = h.chathistories.SaveRecapTextFeedback(data.ChatID, logID, c.Update.Message.From.ID, text)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "feedback.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if h.config.Telegram.FeedbackChatID != 0 {
+		msg := tgbotapi.NewMessage(h.config.Telegram.FeedbackChatID, recapT(c, messageOptions, "feedback.received", i18n.M{
+			"ChatID":   data.ChatID,
+			"User":     tgbot.EscapeHTMLSymbols(tgbot.FullNameFromFirstAndLastName(c.Update.Message.From.FirstName, c.Update.Message.From.LastName)),
+			"LogID":    logID.String(),
+			"Feedback": tgbot.EscapeHTMLSymbols(text),
+		}))
+		msg.ParseMode = tgbotapi.ModeHTML
+
+		_, err = c.Bot.Send(msg)
+		if err != nil {
+			h.logger.Error("failed to forward recap feedback to feedback chat",
+				zap.Error(err),
+				zap.Int64("feedback_chat_id", h.config.Telegram.FeedbackChatID),
+				zap.Int64("chat_id", data.ChatID),
+				zap.String("log_id", data.LogID),
+			)
+		}
+	}
+
+	return c.NewMessageReplyTo(recapT(c, messageOptions, "feedback.thanks"), c.Update.Message.MessageID), nil
+}
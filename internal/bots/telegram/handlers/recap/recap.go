@@ -1,10 +1,8 @@
 package recap
 
 import (
-	"fmt"
-
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
-	"github.com/samber/lo"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"go.uber.org/fx"
 )
 
@@ -39,48 +37,300 @@ func NewHandlers() func(NewHandlersParams) *Handlers {
 
 func (h *Handlers) Install(dispatcher *tgbot.Dispatcher) {
 	dispatcher.OnCommandGroup(func(c *tgbot.Context) string {
-		return "聊天回顾"
+		return c.T("commands.groups.recap.name")
 	}, []tgbot.Command{
 		{
 			Command: "recap",
 			Handler: tgbot.NewHandler(h.command.handleRecapCommand),
 			HelpMessage: func(c *tgbot.Context) string {
-				return "总结过去的聊天记录并生成回顾快报"
+				return c.T("commands.groups.recap.commands.recap.help", i18n.M{"MaxHours": RecapCustomHoursMax})
+			},
+		},
+		{
+			Command: "recap_range",
+			Handler: tgbot.NewHandler(h.command.handleRecapRangeCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapRange.help", i18n.M{"MaxHours": RecapCustomHoursMax})
+			},
+		},
+		{
+			Command: "recap_preview",
+			Handler: tgbot.NewHandler(h.command.handleRecapPreviewCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapPreview.help")
 			},
 		},
 		{
 			Command: "configure_recap",
 			Handler: tgbot.NewHandler(h.command.handleConfigureRecapCommand),
 			HelpMessage: func(c *tgbot.Context) string {
-				return "配置聊天记录回顾（需要管理权限，<b>请在配置的时候尽量避免使用匿名用户身份或者其他群组的身份进行配置，可能会导致权限检查异常而配置失败。</b>）"
+				return c.T("commands.groups.recap.commands.configureRecap.help")
+			},
+		},
+		{
+			Command: "set_recap_disclaimer",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapDisclaimerCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapDisclaimer.help")
+			},
+		},
+		{
+			Command: "set_recap_target_chat",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapTargetChatCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapTargetChat.help")
+			},
+		},
+		{
+			Command: "set_recap_persona",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapPersonaCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapPersona.help")
+			},
+		},
+		{
+			Command: "set_recap_temperature",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapTemperatureCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapTemperature.help")
+			},
+		},
+		{
+			Command: "set_recap_max_tokens",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapMaxTokensCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapMaxTokens.help")
+			},
+		},
+		{
+			Command: "set_recap_languages",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapLanguagesCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapLanguages.help")
+			},
+		},
+		{
+			Command: "set_recap_min_message_length",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapMinMessageLengthCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapMinMessageLength.help")
+			},
+		},
+		{
+			Command: "set_recap_excluded_message_types",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapExcludedMessageTypesCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapExcludedMessageTypes.help")
+			},
+		},
+		{
+			Command: "set_recap_weekdays",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapWeekdaysCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapWeekdays.help")
+			},
+		},
+		{
+			Command: "set_recap_in_progress_text",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapInProgressTextCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapInProgressText.help")
+			},
+		},
+		{
+			Command: "set_recap_keywords",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapKeywordsCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapKeywords.help")
+			},
+		},
+		{
+			Command: "set_recap_related_messages",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapRelatedMessagesCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapRelatedMessages.help")
+			},
+		},
+		{
+			Command: "set_recap_incremental",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapIncrementalCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapIncremental.help")
+			},
+		},
+		{
+			Command: "set_recap_link_preview",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapLinkPreviewCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapLinkPreview.help")
+			},
+		},
+		{
+			Command: "recap_backfill",
+			Handler: tgbot.NewHandler(h.command.handleRecapBackfillCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapBackfill.help")
+			},
+		},
+		{
+			Command: "set_recap_auto_unpin",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapAutoUnpinCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapAutoUnpin.help")
+			},
+		},
+		{
+			Command: "set_recap_thread",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapThreadCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapThread.help")
+			},
+		},
+		{
+			Command: "set_recap_document",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapDocumentCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapDocument.help")
+			},
+		},
+		{
+			Command: "set_recap_rate_limit_exempt_admins",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapRateLimitExemptAdminsCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapRateLimitExemptAdmins.help")
+			},
+		},
+		{
+			Command: "set_recap_approval",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapApprovalCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapApproval.help")
+			},
+		},
+		{
+			Command: "set_recap_collect_only",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapCollectOnlyCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapCollectOnly.help")
+			},
+		},
+		{
+			Command: "set_recap_min_role",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapMinRoleCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapMinRole.help")
+			},
+		},
+		{
+			Command: "subscribe_user",
+			Handler: tgbot.NewHandler(h.command.handleSubscribeUserCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.subscribeUser.help")
+			},
+		},
+		{
+			Command: "set_recap_subscribe_requirement",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapSubscribeRequirementCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapSubscribeRequirement.help")
+			},
+		},
+		{
+			Command: "recap_topic",
+			Handler: tgbot.NewHandler(h.command.handleRecapTopicCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapTopic.help")
+			},
+		},
+		{
+			Command: "recap_diagnose",
+			Handler: tgbot.NewHandler(h.command.handleRecapDiagnoseCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapDiagnose.help")
+			},
+		},
+		{
+			Command: "recap_voters",
+			Handler: tgbot.NewHandler(h.command.handleRecapVotersCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapVoters.help")
+			},
+		},
+		{
+			Command: "recap_usage",
+			Handler: tgbot.NewHandler(h.command.handleRecapUsageCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapUsage.help")
+			},
+		},
+		{
+			Command: "recap_retry_last",
+			Handler: tgbot.NewHandler(h.command.handleRecapRetryLastCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapRetryLast.help")
+			},
+		},
+		{
+			Command: "recap_snooze",
+			Handler: tgbot.NewHandler(h.command.handleRecapSnoozeCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapSnooze.help")
+			},
+		},
+		{
+			Command: "recap_unsnooze",
+			Handler: tgbot.NewHandler(h.command.handleRecapUnsnoozeCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.recapUnsnooze.help")
+			},
+		},
+		{
+			Command: "feedback",
+			Handler: tgbot.NewHandler(h.command.handleFeedbackCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.feedback.help")
 			},
 		},
 		{
 			Command: "recap_forwarded_start",
 			Handler: tgbot.NewHandler(h.command.handleRecapForwardedStartCommand),
 			HelpMessage: func(c *tgbot.Context) string {
-				return "使 Bot 接收在私聊中转发给 Bot 的消息，并在发送 /recap_forwarded 后开始总结"
+				return c.T("commands.groups.recap.commands.recapForwardedStart.help")
 			},
 		},
 		{
 			Command: "recap_forwarded",
 			Handler: tgbot.NewHandler(h.command.handleRecapForwardedCommand),
 			HelpMessage: func(c *tgbot.Context) string {
-				return "使 Bot 停止接收在私聊中转发给 Bot 的消息，对已经转发过的消息进行总结"
+				return c.T("commands.groups.recap.commands.recapForwarded.help")
 			},
 		},
 		{
 			Command: "subscribe_recap",
 			Handler: tgbot.NewHandler(h.command.handleSubscribeRecapCommand),
 			HelpMessage: func(c *tgbot.Context) string {
-				return "订阅当前群组的定时聊天回顾"
+				return c.T("commands.groups.recap.commands.subscribeRecap.help")
 			},
 		},
 		{
 			Command: "unsubscribe_recap",
 			Handler: tgbot.NewHandler(h.command.handleUnsubscribeRecapCommand),
 			HelpMessage: func(c *tgbot.Context) string {
-				return "取消订阅当前群组的定时聊天回顾"
+				return c.T("commands.groups.recap.commands.unsubscribeRecap.help")
+			},
+		},
+		{
+			Command: "set_recap_batch",
+			Handler: tgbot.NewHandler(h.command.handleSetRecapBatchCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.setRecapBatch.help")
+			},
+		},
+		{
+			Command: "whois_recap",
+			Handler: tgbot.NewHandler(h.command.handleWhoisRecapCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.recap.commands.whoisRecap.help")
 			},
 		},
 	})
@@ -97,6 +347,16 @@ func (h *Handlers) Install(dispatcher *tgbot.Dispatcher) {
 	dispatcher.OnCallbackQuery("recap/recap/feedback/react", tgbot.NewHandler(h.callbackQuery.handleCallbackQueryReact))
 	dispatcher.OnCallbackQuery("recap/configure/auto_recap_rates_per_day", tgbot.NewHandler(h.callbackQuery.handleAutoRecapRatesPerDaySelect))
 	dispatcher.OnCallbackQuery("recap/configure/pin", tgbot.NewHandler(h.callbackQuery.handleCallbackQueryPin))
+	dispatcher.OnCallbackQuery("recap/configure/output_format", tgbot.NewHandler(h.callbackQuery.handleCallbackQueryOutputFormat))
+	dispatcher.OnCallbackQuery("recap/configure/manual_recap_min_role", tgbot.NewHandler(h.callbackQuery.handleCallbackQueryManualRecapMinRole))
+
+	for _, toggle := range recapOptionToggles {
+		dispatcher.OnCallbackQuery(toggle.route, tgbot.NewHandler(h.callbackQuery.handleCallbackQueryOptionToggle(toggle)))
+	}
+
+	dispatcher.OnCallbackQuery("recap/preview/publish", tgbot.NewHandler(h.callbackQuery.handleCallbackQueryPublishPreview))
+	dispatcher.OnCallbackQuery("recap/approval/approve", tgbot.NewHandler(h.callbackQuery.handleCallbackQueryApproveRecap))
+	dispatcher.OnCallbackQuery("recap/approval/discard", tgbot.NewHandler(h.callbackQuery.handleCallbackQueryDiscardRecap))
 
 	dispatcher.OnLeftChatMember(tgbot.NewHandler(h.command.handleChatMemberLeft))
 }
@@ -105,7 +365,4 @@ var (
 	RecapSelectHourAvailable = []int64{
 		1, 2, 4, 6, 12, 24,
 	}
-	RecapSelectHourAvailableText = lo.SliceToMap(RecapSelectHourAvailable, func(item int64) (int64, string) {
-		return item, fmt.Sprintf("%d 小时", item)
-	})
 )
@@ -0,0 +1,67 @@
+package recap
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"go.uber.org/zap"
+)
+
+type recapAboutTemplateData struct {
+	BotUsername string
+	Hashtags    string
+}
+
+// renderRecapAbout renders the about text with the configured template, the
+// default text will be used if the template is empty or fails to be rendered.
+func renderRecapAbout(tmpl string, defaultText string, data recapAboutTemplateData) (string, error) {
+	if strings.TrimSpace(tmpl) == "" {
+		return defaultText, nil
+	}
+
+	text, err := executeRecapAboutTemplate(tmpl, data)
+	if err != nil {
+		return defaultText, err
+	}
+
+	return text, nil
+}
+
+func executeRecapAboutTemplate(tmpl string, data recapAboutTemplateData) (string, error) {
+	t, err := template.New("recap_about").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+
+	err = t.Execute(&buffer, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+func (h *CommandHandler) handleWhoisRecapCommand(c *tgbot.Context) (tgbot.Response, error) {
+	messageOptions := h.recapsOptionForMessages(c.Update.Message.Chat.ID)
+
+	data := recapAboutTemplateData{
+		BotUsername: c.Bot.Self.UserName,
+		Hashtags:    recaprender.FormatHashtags(h.config.Recap.Hashtags),
+	}
+
+	text, err := renderRecapAbout(h.config.Recap.AboutTemplate, recapT(c, messageOptions, "about.default", i18n.M{
+		"BotUsername": data.BotUsername,
+		"Hashtags":    data.Hashtags,
+	}), data)
+	if err != nil {
+		h.logger.Warn("failed to render the configured recap about template, fallbacks to the default one", zap.Error(err))
+	}
+
+	return c.NewMessageReplyTo(text, c.Update.Message.MessageID).WithParseModeHTML(), nil
+}
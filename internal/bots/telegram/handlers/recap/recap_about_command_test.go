@@ -0,0 +1,48 @@
+package recap
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+func TestRenderRecapAbout(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+	data := recapAboutTemplateData{BotUsername: "insights_bot", Hashtags: "#recap"}
+	defaultText := recapT(c, nil, "about.default", i18n.M{"BotUsername": data.BotUsername, "Hashtags": data.Hashtags})
+
+	t.Run("Default", func(t *testing.T) {
+		text, err := renderRecapAbout("", defaultText, data)
+		require.NoError(t, err)
+
+		assert.Contains(t, text, "@insights_bot")
+		assert.Contains(t, text, "#recap")
+		assert.Contains(t, text, "/subscribe_recap")
+		assert.Contains(t, text, "/configure_recap")
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		text, err := renderRecapAbout("由 @{{ .BotUsername }} 生成，标签为 {{ .Hashtags }}", defaultText, data)
+		require.NoError(t, err)
+
+		assert.Equal(t, "由 @insights_bot 生成，标签为 #recap", text)
+	})
+
+	t.Run("InvalidFallbacksToDefault", func(t *testing.T) {
+		text, err := renderRecapAbout("{{ .BotUsername", defaultText, data)
+		require.Error(t, err)
+		assert.Equal(t, defaultText, text)
+
+		text, err = renderRecapAbout("{{ .Unknown }}", defaultText, data)
+		require.Error(t, err)
+		assert.Equal(t, defaultText, text)
+	})
+}
@@ -0,0 +1,138 @@
+package recap
+
+import (
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+// bindRecapApprovalActionData binds the action data of the approval buttons
+// along with the options to localize the replies with, nil will be returned if
+// the button is not for the user or the user is no longer an administrator of
+// the chat.
+func (h *CallbackQueryHandler) bindRecapApprovalActionData(c *tgbot.Context) (*recap.RecapApprovalActionData, *ent.TelegramChatRecapsOptions, error) {
+	var data recap.RecapApprovalActionData
+
+	err := c.BindFromCallbackQueryData(&data)
+	if err != nil {
+		return nil, nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, nil, "approvalReview.failed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	if data.FromID != c.Update.CallbackQuery.From.ID {
+		return nil, nil, nil
+	}
+
+	messageOptions := h.recapsOptionForMessages(data.ChatID)
+
+	is, err := c.Bot.IsUserMemberStatus(data.ChatID, data.FromID, []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+	})
+	if err != nil {
+		return nil, nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "approvalReview.failed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	if !is {
+		return nil, nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "approvalReview.administratorRequired")).
+			WithReply(c.Update.CallbackQuery.Message).
+			WithParseModeHTML()
+	}
+
+	return &data, messageOptions, nil
+}
+
+func (h *CallbackQueryHandler) newRecapApprovalExpiredError(c *tgbot.Context, options *ent.TelegramChatRecapsOptions) error {
+	return tgbot.
+		NewMessageError(recapT(c, options, "approvalReview.expired", i18n.M{"Hours": h.config.Recap.ApprovalExpiryHours})).
+		WithReply(c.Update.CallbackQuery.Message)
+}
+
+func (h *CallbackQueryHandler) editRecapApprovalResult(c *tgbot.Context, result string) (tgbot.Response, error) {
+	nopData, err := c.Bot.AssignOneNopCallbackQueryData()
+	if err != nil {
+		h.logger.Error("failed to assign nop callback query data", zap.Error(err))
+		return nil, nil
+	}
+
+	return c.NewEditMessageReplyMarkup(c.Update.CallbackQuery.Message.MessageID, tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(result, nopData),
+		),
+	)), nil
+}
+
+func (h *CallbackQueryHandler) handleCallbackQueryApproveRecap(c *tgbot.Context) (tgbot.Response, error) {
+	data, messageOptions, err := h.bindRecapApprovalActionData(c)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	approval, err := h.chatHistories.FindOneChatHistoriesRecapApproval(data.ApprovalID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "approvalReview.failed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	if approval == nil {
+		return nil, h.newRecapApprovalExpiredError(c, messageOptions)
+	}
+
+	// the recap is taken out and published by the auto recap service, so
+	// that it is delivered to the subscribers as well
+	err = h.chatHistories.QueueOneApprovedChatHistoriesRecap(data.ChatID, data.ApprovalID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "approvalReview.failed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	h.logger.Info("chat histories recap approved",
+		zap.Int64("chat_id", data.ChatID),
+		zap.Int64("approved_by", data.FromID),
+		zap.String("approval_id", data.ApprovalID),
+	)
+
+	return h.editRecapApprovalResult(c, recapT(c, messageOptions, "approvalReview.published"))
+}
+
+func (h *CallbackQueryHandler) handleCallbackQueryDiscardRecap(c *tgbot.Context) (tgbot.Response, error) {
+	data, messageOptions, err := h.bindRecapApprovalActionData(c)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	approval, err := h.chatHistories.TakeOneChatHistoriesRecapApproval(data.ApprovalID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "approvalReview.failed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	if approval == nil {
+		return nil, h.newRecapApprovalExpiredError(c, messageOptions)
+	}
+
+	h.logger.Info("chat histories recap discarded",
+		zap.Int64("chat_id", data.ChatID),
+		zap.Int64("discarded_by", data.FromID),
+		zap.String("approval_id", data.ApprovalID),
+	)
+
+	return h.editRecapApprovalResult(c, recapT(c, messageOptions, "approvalReview.discarded"))
+}
@@ -0,0 +1,66 @@
+package recap
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+const (
+	recapAutoUnpinMaxDuration = 7 * 24 * time.Hour
+)
+
+// parseRecapAutoUnpinArgument parses the argument of /set_recap_auto_unpin,
+// off means the pinned recap message is never unpinned automatically.
+func parseRecapAutoUnpinArgument(s string) (time.Duration, error) {
+	if strings.EqualFold(strings.TrimSpace(s), "off") {
+		return 0, nil
+	}
+
+	return parseRecapDuration(s, recapAutoUnpinMaxDuration)
+}
+
+func (h *CommandHandler) handleSetRecapAutoUnpinCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "autoUnpin.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	autoUnpinAfter, err := parseRecapAutoUnpinArgument(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "autoUnpin.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetAutoUnpinAfter(chatID, autoUnpinAfter)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "autoUnpin.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if autoUnpinAfter == 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "autoUnpin.off"), c.Update.Message.MessageID), nil
+	}
+
+	return c.NewMessageReplyTo(recapT(c, messageOptions, "autoUnpin.set", i18n.M{"Duration": recapDuration(c, messageOptions, autoUnpinAfter)}), c.Update.Message.MessageID), nil
+}
@@ -0,0 +1,28 @@
+package recap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecapAutoUnpinArgument(t *testing.T) {
+	d, err := parseRecapAutoUnpinArgument("12h")
+	require.NoError(t, err)
+	assert.Equal(t, 12*time.Hour, d)
+
+	d, err = parseRecapAutoUnpinArgument("1d")
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, d)
+
+	d, err = parseRecapAutoUnpinArgument(" OFF ")
+	require.NoError(t, err)
+	assert.Zero(t, d)
+
+	for _, arg := range []string{"", "30s", "8d", "soon"} {
+		_, err = parseRecapAutoUnpinArgument(arg)
+		assert.ErrorIs(t, err, errInvalidRecapDuration, arg)
+	}
+}
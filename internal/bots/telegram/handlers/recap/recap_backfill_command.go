@@ -0,0 +1,168 @@
+package recap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// recapBackfillExportMaxSize is the max size of the chat export file accepted
+// by /recap_backfill, which is also the max size of the files that bots are
+// allowed to download from Telegram.
+const recapBackfillExportMaxSize = 20 * 1024 * 1024
+
+var errRecapBackfillExportTooLarge = errors.New("chat export is too large")
+
+// recapBackfillExportDocument returns the chat export file attached to the
+// message replied by the command, nil will be returned if there is none.
+func recapBackfillExportDocument(message *tgbotapi.Message) *tgbotapi.Document {
+	if message == nil || message.ReplyToMessage == nil || message.ReplyToMessage.Document == nil {
+		return nil
+	}
+
+	document := message.ReplyToMessage.Document
+	if !strings.HasSuffix(strings.ToLower(document.FileName), ".json") && document.MimeType != "application/json" {
+		return nil
+	}
+
+	return document
+}
+
+func (h *CommandHandler) downloadRecapBackfillExport(c *tgbot.Context, document *tgbotapi.Document) ([]byte, error) {
+	if document.FileSize > recapBackfillExportMaxSize {
+		return nil, errRecapBackfillExportTooLarge
+	}
+
+	url, err := c.Bot.GetFileDirectURL(document.FileID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download chat export, status code: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, recapBackfillExportMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > recapBackfillExportMaxSize {
+		return nil, errRecapBackfillExportTooLarge
+	}
+
+	return data, nil
+}
+
+func (h *CommandHandler) handleRecapBackfillCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "backfill.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "backfill.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	// the chat histories of the chats opted out of storing message content
+	// only live in Redis for a day, the imported ones would be either stored
+	// durably or dropped at once
+	if !options.StoreMessageContent {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "backfill.storeDisabled")).
+			WithReply(c.Update.Message)
+	}
+
+	document := recapBackfillExportDocument(c.Update.Message)
+	if document == nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "backfill.replyRequired")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	data, err := h.downloadRecapBackfillExport(c, document)
+	if err != nil {
+		if errors.Is(err, errRecapBackfillExportTooLarge) {
+			return nil, tgbot.
+				NewMessageError(recapT(c, options, "backfill.tooLarge", i18n.M{"Size": recapBackfillExportMaxSize / 1024 / 1024})).
+				WithReply(c.Update.Message)
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "backfill.downloadFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	histories, err := chathistories.ParseTelegramExportChatHistories(chatID, c.Update.Message.Chat.Type, c.Update.Message.Chat.Title, data)
+	if err != nil {
+		if errors.Is(err, chathistories.ErrInvalidTelegramExport) {
+			return nil, tgbot.
+				NewMessageError(recapT(c, options, "backfill.unrecognized")).
+				WithReply(c.Update.Message)
+		}
+		if errors.Is(err, chathistories.ErrTelegramExportChatMismatched) {
+			return nil, tgbot.
+				NewMessageError(recapT(c, options, "backfill.chatMismatched")).
+				WithReply(c.Update.Message)
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "backfill.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	inserted, err := h.chathistories.BulkInsert(histories)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "backfill.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.NewMessageReplyTo(recapT(c, options, "backfill.imported", i18n.M{"Inserted": inserted, "Skipped": len(histories) - inserted}), c.Update.Message.MessageID), nil
+}
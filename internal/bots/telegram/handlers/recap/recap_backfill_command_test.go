@@ -0,0 +1,58 @@
+package recap
+
+import (
+	"context"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+)
+
+func TestRecapBackfillExportDocument(t *testing.T) {
+	assert.Nil(t, recapBackfillExportDocument(&tgbotapi.Message{}))
+	assert.Nil(t, recapBackfillExportDocument(&tgbotapi.Message{ReplyToMessage: &tgbotapi.Message{Text: "hello"}}))
+	assert.Nil(t, recapBackfillExportDocument(&tgbotapi.Message{ReplyToMessage: &tgbotapi.Message{Document: &tgbotapi.Document{FileName: "notes.pdf", MimeType: "application/pdf"}}}))
+
+	document := recapBackfillExportDocument(&tgbotapi.Message{ReplyToMessage: &tgbotapi.Message{Document: &tgbotapi.Document{FileID: "file", FileName: "result.JSON"}}})
+	if assert.NotNil(t, document) {
+		assert.Equal(t, "file", document.FileID)
+	}
+
+	assert.NotNil(t, recapBackfillExportDocument(&tgbotapi.Message{ReplyToMessage: &tgbotapi.Message{Document: &tgbotapi.Document{FileName: "export", MimeType: "application/json"}}}))
+}
+
+func TestRecapBackfillCommandRefusesChatsNotStoringMessageContent(t *testing.T) {
+	ht := newCommandHandlerTest(t)
+	chat := ht.newGroup(t)
+
+	err := ht.h.tgchats.SetStoreMessageContent(chat.ID, false)
+	require.NoError(t, err)
+
+	c := ht.newContext(chat, "/recap_backfill", "")
+	c.Update.Message.ReplyToMessage = &tgbotapi.Message{
+		MessageID: 2,
+		Chat:      chat,
+		Document:  &tgbotapi.Document{FileID: "file", FileName: "result.json", MimeType: "application/json"},
+	}
+
+	_, err = ht.h.handleRecapBackfillCommand(c)
+
+	var messageError tgbot.MessageError
+
+	require.ErrorAs(t, err, &messageError)
+	assert.Contains(t, messageError.Error(), "保存聊天记录内容")
+
+	// refused before the export is downloaded
+	assert.NotContains(t, ht.calledMethods(), "getFile")
+
+	count, err := ht.ent.ChatHistories.
+		Query().
+		Where(chathistories.ChatID(chat.ID)).
+		Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
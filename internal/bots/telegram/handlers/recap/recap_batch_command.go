@@ -0,0 +1,73 @@
+package recap
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgusers"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+var errInvalidOnOffArgument = errors.New("invalid on or off argument")
+
+// parseOnOffArgument parses on or off, false will be returned for ok if the
+// argument is empty, which means only to show the current setting.
+func parseOnOffArgument(arg string) (on bool, ok bool, err error) {
+	switch strings.ToLower(strings.TrimSpace(arg)) {
+	case "":
+		return false, false, nil
+	case "on":
+		return true, true, nil
+	case "off":
+		return false, true, nil
+	default:
+		return false, false, errInvalidOnOffArgument
+	}
+}
+
+func (h *CommandHandler) handleSetRecapBatchCommand(c *tgbot.Context) (tgbot.Response, error) {
+	if !lo.Contains([]telegram.ChatType{telegram.ChatTypePrivate}, telegram.ChatType(c.Update.Message.Chat.Type)) {
+		return nil, tgbot.NewMessageError(c.T("modules.telegram.recap.batch.privateOnly")).WithReply(c.Update.Message)
+	}
+
+	userID := c.Update.Message.From.ID
+
+	batch, ok, err := parseOnOffArgument(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(c.T("modules.telegram.recap.batch.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	if !ok {
+		preferences, err := h.tgusers.FindOneOrCreatePreferences(userID)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(c.T("modules.telegram.recap.batch.findFailed")).
+				WithReply(c.Update.Message)
+		}
+
+		batch = preferences.BatchPrivateRecaps
+	} else {
+		err = h.tgusers.SetBatchPrivateRecaps(userID, batch)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(c.T("modules.telegram.recap.batch.setFailed")).
+				WithReply(c.Update.Message)
+		}
+	}
+
+	return c.
+		NewMessageReplyTo(lo.Ternary(batch,
+			c.T("modules.telegram.recap.batch.on", i18n.M{"Hour": tgusers.PrivateRecapDigestHour}),
+			c.T("modules.telegram.recap.batch.off"),
+		), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
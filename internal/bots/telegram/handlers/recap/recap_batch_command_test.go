@@ -0,0 +1,27 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOnOffArgument(t *testing.T) {
+	_, ok, err := parseOnOffArgument("")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	batch, ok, err := parseOnOffArgument(" ON ")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, batch)
+
+	batch, ok, err = parseOnOffArgument("off")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, batch)
+
+	_, _, err = parseOnOffArgument("daily")
+	require.ErrorIs(t, err, errInvalidOnOffArgument)
+}
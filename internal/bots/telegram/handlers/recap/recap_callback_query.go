@@ -2,19 +2,56 @@ package recap
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/webhook"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
 	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 )
 
+// recapProgressEditInterval is the minimum interval between two edits of the
+// in progress message to stay within the rate limits of Telegram.
+const recapProgressEditInterval = 3 * time.Second
+
+// newRecapProgressEditor returns a progress handler that edits the in progress
+// message with the number of summarized topics, edits are throttled by
+// recapProgressEditInterval and skipped if the number didn't change.
+func newRecapProgressEditor(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, messageID int, inProgressText string) func(topicsCount int) {
+	var (
+		lastEditedAt          time.Time
+		lastEditedTopicsCount int
+	)
+
+	return func(topicsCount int) {
+		if topicsCount == lastEditedTopicsCount || time.Since(lastEditedAt) < recapProgressEditInterval {
+			return
+		}
+
+		lastEditedAt = time.Now()
+		lastEditedTopicsCount = topicsCount
+
+		editConfig := tgbotapi.NewEditMessageText(
+			c.Update.CallbackQuery.Message.Chat.ID,
+			messageID,
+			inProgressText+"\n\n"+recapT(c, options, "topicsSummarized", i18n.M{"Count": topicsCount}),
+		)
+		editConfig.ParseMode = tgbotapi.ModeHTML
+
+		c.Bot.MayRequest(editConfig)
+	}
+}
+
 func (h *CallbackQueryHandler) handleCallbackQuerySelectHours(c *tgbot.Context) (tgbot.Response, error) {
 	messageID := c.Update.CallbackQuery.Message.MessageID
 
@@ -26,7 +63,7 @@ func (h *CallbackQueryHandler) handleCallbackQuerySelectHours(c *tgbot.Context)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			WithMessage(recapT(c, nil, "failedToGenerate")).
 			WithReply(replyToMessage)
 	}
 
@@ -36,17 +73,38 @@ func (h *CallbackQueryHandler) handleCallbackQuerySelectHours(c *tgbot.Context)
 			WithReply(replyToMessage)
 	}
 
-	var inProgressText string
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(data.ChatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(replyToMessage)
+	}
+
+	// the chat may have switched to collect only after the hours were offered
+	if tgchats.IsRecapCollectOnly(options) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "collectOnly")).
+			WithReply(replyToMessage)
+	}
 
-	switch data.RecapMode {
-	case tgchat.AutoRecapSendModePublicly:
-		inProgressText = fmt.Sprintf("正在为过去 %d 个小时的聊天记录生成回顾，请稍等...", data.Hour)
-	case tgchat.AutoRecapSendModeOnlyPrivateSubscriptions:
-		inProgressText = fmt.Sprintf("正在为 <b>%s</b> 过去 %d 个小时的聊天记录生成回顾，请稍等...", tgbot.EscapeHTMLSymbols(data.ChatTitle), data.Hour)
-	default:
-		inProgressText = fmt.Sprintf("正在为过去 %d 个小时的聊天记录生成回顾，请稍等...", data.Hour)
+	// the minimum role is checked again against the member who clicked, since
+	// anyone is able to click the buttons offered in the group
+	rejection, err := manualRecapMinRoleRejection(c, data.ChatID, c.Update.CallbackQuery.From, options)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(replyToMessage)
 	}
 
+	if rejection != "" {
+		return nil, tgbot.
+			NewMessageError(rejection).
+			WithReply(replyToMessage)
+	}
+
+	language := tgchats.RecapDisplayLanguage(h.tgchats.FindRecapLanguageForGroups(data.ChatID), options)
+	inProgressText := renderRecapInProgressText(options.RecapInProgressTemplate, language, data.RecapMode, data.Hour, data.ChatTitle)
+
 	editConfig := tgbotapi.NewEditMessageTextAndMarkup(
 		c.Update.CallbackQuery.Message.Chat.ID,
 		messageID,
@@ -65,20 +123,18 @@ func (h *CallbackQueryHandler) handleCallbackQuerySelectHours(c *tgbot.Context)
 	histories, err := h.chatHistories.FindChatHistoriesByTimeBefore(data.ChatID, time.Duration(data.Hour)*time.Hour)
 	if err != nil {
 		return nil, tgbot.
-			NewExceptionError(err).WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			NewExceptionError(err).WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(replyToMessage)
 	}
 
-	if len(histories) <= 5 {
-		var errMessage string
+	histories = chathistories.FilterBotChatHistories(histories, options.IncludeBotMessages)
+	histories = chathistories.FilterExcludedChatHistories(histories, tgchat.MessageTypes(options.ExcludedMessageTypes))
+	histories, activityCount := chathistories.FilterShortChatHistories(histories, options.MinMessageLengthForSummary, options.CountShortMessagesForActivity)
 
-		switch data.RecapMode {
-		case tgchat.AutoRecapSendModePublicly:
-			errMessage = fmt.Sprintf("最近 %d 小时内暂时没有超过 5 条的聊天记录可以生成聊天回顾哦，要再多聊点之后再试试吗？", data.Hour)
-		case tgchat.AutoRecapSendModeOnlyPrivateSubscriptions:
-			errMessage = fmt.Sprintf("最近 %d 小时内暂时没有超过 5 条的聊天记录可以生成聊天回顾哦，要再等待群内成员多聊点之后再试试吗？", data.Hour)
-		default:
-			errMessage = fmt.Sprintf("最近 %d 小时内暂时没有超过 5 条的聊天记录可以生成聊天回顾哦，要再多聊点之后再试试吗？", data.Hour)
+	if activityCount <= 5 || len(histories) == 0 {
+		errMessage := recapT(c, options, "notEnoughHistories", i18n.M{"Hours": data.Hour})
+		if data.RecapMode == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions {
+			errMessage = recapT(c, options, "notEnoughHistoriesInPrivateMode", i18n.M{"Hours": data.Hour})
 		}
 
 		return nil, tgbot.
@@ -86,62 +142,82 @@ func (h *CallbackQueryHandler) handleCallbackQuerySelectHours(c *tgbot.Context)
 			WithReply(replyToMessage)
 	}
 
+	// Only the distinct messages of a flooded window are summarized, which
+	// keeps the recaps of raids short and cheap.
+	histories, _ = h.chatHistories.FilterFloodChatHistories(histories)
+
 	chatType := telegram.ChatType(c.Update.CallbackQuery.Message.Chat.Type)
 
-	logID, summarizations, err := h.chatHistories.SummarizeChatHistories(data.ChatID, chatType, histories)
+	logID, summarizations, err := h.chatHistories.SummarizeChatHistories(
+		data.ChatID,
+		chatType,
+		histories,
+		chathistories.WithSummarizeChatHistoriesPersona(options.RecapPersona),
+		chathistories.WithSummarizeChatHistoriesTemperature(options.SummaryTemperature),
+		chathistories.WithSummarizeChatHistoriesMaxTokens(options.SummaryMaxTokens),
+		chathistories.WithSummarizeChatHistoriesOutputFormat(tgchat.RecapOutputFormat(options.RecapOutputFormat)),
+		chathistories.WithSummarizeChatHistoriesLanguages(tgchats.ParseSummaryLanguages(options.SummaryLanguages)),
+		chathistories.WithSummarizeChatHistoriesTopKeywords(options.TopKeywordsCount),
+		chathistories.WithSummarizeChatHistoriesRelatedMessages(options.RelatedMessagesCount),
+		chathistories.WithSummarizeChatHistoriesIncrementalRecap(options.IncrementalRecap),
+		chathistories.WithSummarizeChatHistoriesDedupForwards(options.DedupForwards),
+		chathistories.WithSummarizeChatHistoriesAnonymizeParticipants(options.AnonymizeParticipants),
+		chathistories.WithSummarizeChatHistoriesProgress(newRecapProgressEditor(c, options, messageID, inProgressText)),
+		chathistories.WithSummarizeChatHistoriesWindow(int(data.Hour), false),
+	)
+	if message, ok := recapModerationErrorMessage(c, options, err); ok {
+		return nil, tgbot.
+			NewMessageError(message).
+			WithReply(replyToMessage)
+	}
+
 	if err != nil {
 		return nil, tgbot.
-			NewExceptionError(err).WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			NewExceptionError(err).WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(replyToMessage)
 	}
 
 	counts, err := h.chatHistories.FindFeedbackRecapsReactionCountsForChatIDAndLogID(data.ChatID, logID)
 	if err != nil {
 		return nil, tgbot.
-			NewExceptionError(err).WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			NewExceptionError(err).WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(replyToMessage)
 	}
 
 	inlineKeyboardMarkup, err := h.chatHistories.NewVoteRecapInlineKeyboardMarkup(c.Bot, data.ChatID, logID, counts.UpVotes, counts.DownVotes, counts.Lmao)
 	if err != nil {
 		return nil, tgbot.
-			NewExceptionError(err).WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			NewExceptionError(err).WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(replyToMessage)
 	}
 
-	summarizations = lo.Filter(summarizations, func(item string, _ int) bool { return item != "" })
+	rawSummarizations := summarizations
+
+	summarizations = recaprender.RenderSummariesToHTML(summarizations)
 	if len(summarizations) == 0 {
 		return nil, tgbot.
-			NewMessageError("聊天记录回顾生成失败，请稍后再试！").
+			NewMessageError(recapT(c, options, "failedToGenerate")).
 			WithReply(replyToMessage)
 	}
 
-	for i, s := range summarizations {
-		summarizations[i] = tgbot.ReplaceMarkdownTitlesToTelegramBoldElement(s)
-	}
+	earliestChattedAt, latestChattedAt := chathistories.ChatHistoriesChattedAtRange(histories)
 
-	summarizationBatches := tgbot.SplitMessagesAgainstLengthLimitIntoMessageGroups(summarizations)
-	for i, b := range summarizationBatches {
-		var content string
-
-		text := fmt.Sprintf("<blockquote expandable>%s</blockquote>", strings.Join(b, "\n\n"))
-
-		if len(summarizationBatches) > 1 {
-			content = fmt.Sprintf("%s\n\n(%d/%d)\n%s#recap\n<em>🤖️ Generated by chatGPT</em>",
-				text,
-				i+1,
-				len(summarizationBatches),
-				lo.Ternary(chatType == telegram.ChatTypeGroup, "\n<b>Tips: </b>由于群组不是超级群组（supergroup），因此消息链接引用暂时被禁用了，如果希望使用该功能，请通过短时间内将群组开放为公共群组并还原回私有群组，或通过其他操作将本群组升级为超级群组后，该功能方可恢复正常运作。\n\n", ""),
-			)
-		} else {
-			content = fmt.Sprintf("%s\n\n%s#recap\n<em>🤖️ Generated by chatGPT</em>",
-				text,
-				lo.Ternary(chatType == telegram.ChatTypeGroup, "<b>Tips: </b>由于群组不是超级群组（supergroup），因此消息链接引用暂时被禁用了，如果希望使用该功能，请通过短时间内将群组开放为公共群组并还原回私有群组，或通过其他操作将本群组升级为超级群组后，该功能方可恢复正常运作。\n\n", ""),
-			)
-		}
+	header := tgchats.FormatRecapDisclaimer(options) + h.chatHistories.FormatChatHistoriesChattedAtRange(earliestChattedAt, latestChattedAt, language)
 
-		msg := tgbotapi.NewMessage(c.Update.CallbackQuery.Message.Chat.ID, content)
-		msg.ParseMode = tgbotapi.ModeHTML
+	var firstSentMsg *tgbotapi.Message
+
+	summarizationBatches := recaprender.SplitIntoPages(summarizations, false)
+	for i, b := range summarizationBatches {
+		content := recaprender.BuildTelegramMessage(b, recaprender.MessageOptions{
+			Header:   header,
+			ChatType: chatType,
+			Page:     i + 1,
+			Pages:    len(summarizationBatches),
+			Hashtags: h.config.Recap.Hashtags,
+			Texts:    recapTexts(c, options),
+		})
+
+		msg := recaprender.NewMessage(c.Update.CallbackQuery.Message.Chat.ID, content, tgchats.RecapLinkPreviewEnabled(options))
 		msg.ReplyMarkup = inlineKeyboardMarkup
 
 		if c.Update.CallbackQuery.Message.ReplyToMessage != nil {
@@ -153,9 +229,16 @@ func (h *CallbackQueryHandler) handleCallbackQuerySelectHours(c *tgbot.Context)
 			zap.String("text", msg.Text),
 		)
 
-		c.Bot.MaySend(msg)
+		sentMsg := c.Bot.MaySendThreadMessage(newManualRecapThreadMessage(data.ChatID, msg, options))
+		if i == 0 {
+			firstSentMsg = sentMsg
+		}
 	}
 
+	mayAttachManualRecapDocument(c, h.logger, options, firstSentMsg, recaprender.BuildMarkdownDocument(data.ChatTitle, header, rawSummarizations))
+
+	h.webhook.PublishRecap(webhook.NewRecapPublishedPayload(data.ChatID, logID, summarizations, webhook.RecapPublishedModeManual))
+
 	// Delete the waiting message after recap generation is complete
 	deleteConfig := tgbotapi.NewDeleteMessage(c.Update.CallbackQuery.Message.Chat.ID, messageID)
 
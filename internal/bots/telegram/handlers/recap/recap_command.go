@@ -2,12 +2,17 @@ package recap
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
 	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
@@ -16,7 +21,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func newRecapSelectHoursInlineKeyboardButtons(ctx *tgbot.Context, chatID int64, chatTitle string, recapMode tgchat.AutoRecapSendMode) (tgbotapi.InlineKeyboardMarkup, error) {
+func newRecapSelectHoursInlineKeyboardButtons(ctx *tgbot.Context, options *ent.TelegramChatRecapsOptions, chatID int64, chatTitle string, recapMode tgchat.AutoRecapSendMode) (tgbotapi.InlineKeyboardMarkup, error) {
 	buttons := make([]tgbotapi.InlineKeyboardButton, 0, len(RecapSelectHourAvailable))
 
 	for _, v := range RecapSelectHourAvailable {
@@ -31,7 +36,7 @@ func newRecapSelectHoursInlineKeyboardButtons(ctx *tgbot.Context, chatID int64,
 		}
 
 		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-			RecapSelectHourAvailableText[v],
+			recapT(ctx, options, "hoursOption", i18n.M{"Hours": v}),
 			data,
 		))
 	}
@@ -43,10 +48,129 @@ func newRecapSelectHoursInlineKeyboardButtons(ctx *tgbot.Context, chatID int64,
 	), nil
 }
 
+// manualRecapSendMode returns where the manual recaps requested by /recap are
+// delivered, they are sent to the requester privately either if the chat is
+// in the private subscriptions mode or if the manual recaps are configured to
+// be private.
+func manualRecapSendMode(options *ent.TelegramChatRecapsOptions) tgchat.AutoRecapSendMode {
+	if options == nil {
+		return tgchat.AutoRecapSendModePublicly
+	}
+
+	if tgchat.AutoRecapSendMode(options.AutoRecapSendMode) == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions || options.ManualRecapPrivate {
+		return tgchat.AutoRecapSendModeOnlyPrivateSubscriptions
+	}
+
+	return tgchat.AutoRecapSendModePublicly
+}
+
+// manualRecapRateLimitExempt reports whether the requester of /recap is
+// exempted from the rate limit, only the administrators are exempted and only
+// if the chat has opted in.
+func manualRecapRateLimitExempt(options *ent.TelegramChatRecapsOptions, isAdmin bool) bool {
+	return options != nil && options.ManualRecapRateLimitExemptAdmins && isAdmin
+}
+
+func (h *CommandHandler) isExemptFromManualRecapRateLimit(c *tgbot.Context, options *ent.TelegramChatRecapsOptions) bool {
+	// the member status is only looked up if the chat has opted in
+	if !manualRecapRateLimitExempt(options, true) || c.Update.Message.From == nil {
+		return false
+	}
+	if c.Bot.IsGroupAnonymousBot(c.Update.Message.From) {
+		return true
+	}
+
+	isAdmin, err := c.IsUserMemberStatus(c.Update.Message.From.ID, []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+	})
+	if err != nil {
+		h.logger.Error("failed to check member status for rate limit exemption of command /recap", zap.Error(err))
+
+		return false
+	}
+
+	return manualRecapRateLimitExempt(options, isAdmin)
+}
+
+// manualRecapMinRoleStatuses returns the member statuses that are allowed to
+// create the manual recaps under the role, nil means everyone is allowed.
+func manualRecapMinRoleStatuses(role tgchat.ManualRecapMinRole) []telegram.MemberStatus {
+	switch role {
+	case tgchat.ManualRecapMinRoleAdministrator:
+		return []telegram.MemberStatus{telegram.MemberStatusCreator, telegram.MemberStatusAdministrator}
+	case tgchat.ManualRecapMinRoleCreator:
+		return []telegram.MemberStatus{telegram.MemberStatusCreator}
+	default:
+		return nil
+	}
+}
+
+// manualRecapMinRoleAllows reports whether the member with the status is
+// allowed to create the manual recaps under the role.
+func manualRecapMinRoleAllows(role tgchat.ManualRecapMinRole, status telegram.MemberStatus) bool {
+	statuses := manualRecapMinRoleStatuses(role)
+
+	return len(statuses) == 0 || lo.Contains(statuses, status)
+}
+
+// manualRecapMinRoleRejection returns the message to reject the user with if
+// the user is below the minimum role of the chat, empty if allowed.
+func manualRecapMinRoleRejection(c *tgbot.Context, chatID int64, from *tgbotapi.User, options *ent.TelegramChatRecapsOptions) (string, error) {
+	role := tgchat.ManualRecapMinRole(options.ManualRecapMinRole)
+
+	// the member status is only looked up if the chat has restricted /recap
+	if manualRecapMinRoleAllows(role, telegram.MemberStatusMember) {
+		return "", nil
+	}
+
+	var allowed bool
+
+	if c.Bot.IsGroupAnonymousBot(from) {
+		// anonymous administrators can not be told apart from the creator
+		allowed = manualRecapMinRoleAllows(role, telegram.MemberStatusAdministrator)
+	} else {
+		is, err := c.Bot.IsUserMemberStatus(chatID, from.ID, manualRecapMinRoleStatuses(role))
+		if err != nil {
+			return "", err
+		}
+
+		allowed = is
+	}
+
+	if allowed {
+		return "", nil
+	}
+
+	return recapT(c, options, lo.Ternary(role == tgchat.ManualRecapMinRoleCreator, "minRoleCreatorRequired", "minRoleAdministratorRequired")), nil
+}
+
+// checkManualRecapMinRole rejects the member below the minimum role of the
+// chat, every command creating a manual recap, such as /recap, /recap_range,
+// /recap_topic, /recap_preview and /recap_retry_last, should check it, and so
+// should the callback queries creating one.
+func (h *CommandHandler) checkManualRecapMinRole(c *tgbot.Context, options *ent.TelegramChatRecapsOptions) error {
+	message, err := manualRecapMinRoleRejection(c, c.Update.Message.Chat.ID, c.Update.Message.From, options)
+	if err != nil {
+		return tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	if message != "" {
+		return tgbot.
+			NewMessageError(message).
+			WithReply(c.Update.Message)
+	}
+
+	return nil
+}
+
 func (h *CommandHandler) handleRecapCommand(c *tgbot.Context) (tgbot.Response, error) {
 	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
 	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
-		return nil, tgbot.NewMessageError("只有在群组和超级群组内才可以创建聊天记录回顾哦！").WithReply(c.Update.Message)
+		return nil, tgbot.NewMessageError(recapT(c, nil, "onlyGroups")).WithReply(c.Update.Message)
 	}
 
 	chatID := c.Update.Message.Chat.ID
@@ -56,13 +180,13 @@ func (h *CommandHandler) handleRecapCommand(c *tgbot.Context) (tgbot.Response, e
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			WithMessage(recapT(c, nil, "failedToGenerate")).
 			WithReply(c.Update.Message)
 	}
 
 	if !has {
 		return nil, tgbot.
-			NewMessageError("聊天记录回顾功能在当前群组尚未启用，需要在群组管理员通过 /configure_recap 命令配置功能启用后才可以创建聊天回顾哦。").
+			NewMessageError(recapT(c, nil, "notEnabled")).
 			WithReply(c.Update.Message)
 	}
 
@@ -70,62 +194,96 @@ func (h *CommandHandler) handleRecapCommand(c *tgbot.Context) (tgbot.Response, e
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			WithMessage(recapT(c, nil, "failedToGenerate")).
 			WithReply(c.Update.Message)
 	}
 
-	if options != nil && tgchat.AutoRecapSendMode(options.AutoRecapSendMode) == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions {
-		return h.handleRecapCommandForPrivateSubscriptionsMode(c)
+	err = h.checkManualRecapMinRole(c, options)
+	if err != nil {
+		return nil, err
 	}
 
-	rateLimitInterval := h.tgchats.ManualRecapRatePerSeconds(options)
+	if tgchats.IsRecapCollectOnly(options) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "collectOnly")).
+			WithReply(c.Update.Message)
+	}
 
-	_, ttl, ok, err := c.RateLimitForCommand(chatID, "/recap", 1, rateLimitInterval)
+	hour, hasHour, err := parseRecapHoursArgument(c.Update.Message.CommandArguments())
 	if err != nil {
-		h.logger.Error("failed to check rate limit for command /recap", zap.Error(err))
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "invalidHours", i18n.M{"MaxHours": RecapCustomHoursMax})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
 	}
 
-	if !ok {
-		rateLimitIntervalMinutes := lo.Ternary(rateLimitInterval/time.Minute <= 1, 1, rateLimitInterval/time.Minute)
+	if manualRecapSendMode(options) == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions {
+		if hasHour {
+			return nil, tgbot.
+				NewMessageError(recapT(c, options, "hoursNotSupportedInPrivateMode")).
+				WithReply(c.Update.Message)
+		}
 
-		return nil, tgbot.
-			NewMessageError(fmt.Sprintf("很抱歉，您的操作触发了我们的限制机制，为了保证系统的可用性，本命令每最多 %d 分钟最多使用一次，请您耐心等待 %d 分钟后再试，感谢您的理解和支持。", rateLimitIntervalMinutes, lo.Ternary(ttl/time.Minute <= 1, 1, ttl/time.Minute))).
-			WithReply(c.Update.Message)
+		return h.handleRecapCommandForPrivateSubscriptionsMode(c, options)
+	}
+
+	if !h.isExemptFromManualRecapRateLimit(c, options) {
+		rateLimitInterval := h.tgchats.ManualRecapRatePerSeconds(options)
+
+		_, ttl, ok, err := c.RateLimitForCommand(chatID, "/recap", 1, rateLimitInterval)
+		if err != nil {
+			h.logger.Error("failed to check rate limit for command /recap", zap.Error(err))
+		}
+
+		if !ok {
+			rateLimitIntervalMinutes := lo.Ternary(rateLimitInterval/time.Minute <= 1, 1, rateLimitInterval/time.Minute)
+
+			return nil, tgbot.
+				NewMessageError(recapT(c, options, "rateLimitExceeded", i18n.M{
+					"Minutes":           int64(rateLimitIntervalMinutes),
+					"SecondsToBeWaited": int64(math.Ceil(ttl.Seconds())),
+				})).
+				WithReply(c.Update.Message)
+		}
 	}
 
-	inlineKeyboardButtons, err := newRecapSelectHoursInlineKeyboardButtons(c, chatID, chatTitle, tgchat.AutoRecapSendModePublicly)
+	if hasHour {
+		return h.handleRecapCommandWithHours(c, options, hour)
+	}
+
+	inlineKeyboardButtons, err := newRecapSelectHoursInlineKeyboardButtons(c, options, chatID, chatTitle, tgchat.AutoRecapSendModePublicly)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(c.Update.Message)
 	}
 
 	return c.
-		NewMessageReplyTo("请问您要为过去几个小时内的聊天创建回顾呢？", c.Update.Message.MessageID).
+		NewMessageReplyTo(recapT(c, options, "selectHours"), c.Update.Message.MessageID).
 		WithReplyMarkup(inlineKeyboardButtons), nil
 }
 
-func (h *CommandHandler) handleRecapCommandForPrivateSubscriptionsMode(c *tgbot.Context) (tgbot.Response, error) {
+func (h *CommandHandler) handleRecapCommandForPrivateSubscriptionsMode(c *tgbot.Context, options *ent.TelegramChatRecapsOptions) (tgbot.Response, error) {
 	chatID := c.Update.Message.Chat.ID
 	fromID := c.Update.Message.From.ID
 
 	if c.Bot.IsGroupAnonymousBot(c.Update.Message.From) {
 		return nil, tgbot.
-			NewMessageError("匿名管理员无法在设定为私聊回顾模式的群组内请求创建聊天记录回顾哦！如果需要创建聊天记录回顾，必须先将发送角色切换为普通用户然后再试哦。").
+			NewMessageError(recapT(c, options, "anonymousAdminInPrivateMode")).
 			WithReply(c.Update.Message).
 			WithDeleteLater(fromID, chatID)
 	}
 
 	chatTitle := c.Update.Message.Chat.Title
-	msg := tgbotapi.NewMessage(fromID, fmt.Sprintf("您正在请求为群组 <b>%s</b> 创建聊天回顾。\n请问您要为过去几个小时内的聊天创建回顾呢？", tgbot.EscapeHTMLSymbols(c.Update.Message.Chat.Title)))
+	msg := tgbotapi.NewMessage(fromID, recapT(c, options, "selectHoursForChat", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(c.Update.Message.Chat.Title)}))
 	msg.ParseMode = tgbotapi.ModeHTML
 
-	inlineKeyboardButtons, err := newRecapSelectHoursInlineKeyboardButtons(c, chatID, chatTitle, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions)
+	inlineKeyboardButtons, err := newRecapSelectHoursInlineKeyboardButtons(c, options, chatID, chatTitle, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(c.Update.Message)
 	}
 
@@ -143,18 +301,26 @@ func (h *CommandHandler) handleRecapCommandForPrivateSubscriptionsMode(c *tgbot.
 		return nil, nil
 	}
 
-	hashKey, hashKeyErr := h.setRecapForPrivateSubscriptionModeStartCommandContext(chatID, chatTitle)
+	hashKey, hashKeyErr := h.setRecapForPrivateSubscriptionModeStartCommandContext(chatID, chatTitle, privateSubscriptionStartCommandActionSelectHours)
+	if hashKeyErr != nil {
+		return nil, tgbot.
+			NewExceptionError(hashKeyErr).
+			WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	latestHashKey, hashKeyErr := h.setRecapForPrivateSubscriptionModeStartCommandContext(chatID, chatTitle, privateSubscriptionStartCommandActionLatest)
 	if hashKeyErr != nil {
 		return nil, tgbot.
 			NewExceptionError(hashKeyErr).
-			WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(c.Update.Message)
 	}
 
 	if c.Bot.IsCannotInitiateChatWithUserErr(err) {
-		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newRecapCommandWhenUserNeverStartedChat(c.Bot, hashKey))
+		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newRecapCommandWhenUserNeverStartedChat(c, options, hashKey, latestHashKey))
 	} else if c.Bot.IsBotWasBlockedByTheUserErr(err) {
-		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newRecapCommandWhenUserBlockedMessage(c.Bot, hashKey))
+		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newRecapCommandWhenUserBlockedMessage(c, options, hashKey, latestHashKey))
 	} else {
 		h.logger.Error("failed to send private message to user",
 			zap.String("message", xo.SprintJSON(msg)),
@@ -182,11 +348,20 @@ func (h *CommandHandler) handleStartCommandWithPrivateSubscriptionsRecap(c *tgbo
 		return nil, nil
 	}
 
-	inlineKeyboardButtons, err := newRecapSelectHoursInlineKeyboardButtons(c, context.ChatID, context.ChatTitle, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions)
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(context.ChatID)
+	if err != nil {
+		h.logger.Error("failed to find recaps option", zap.Error(err), zap.Int64("chat_id", context.ChatID))
+	}
+
+	if context.Action == privateSubscriptionStartCommandActionLatest {
+		return h.handleStartCommandWithLatestRecap(c, context, options)
+	}
+
+	inlineKeyboardButtons, err := newRecapSelectHoursInlineKeyboardButtons(c, options, context.ChatID, context.ChatTitle, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("聊天记录回顾生成失败，请稍后再试！").
+			WithMessage(recapT(c, options, "failedToGenerate")).
 			WithReply(c.Update.Message)
 	}
 
@@ -196,11 +371,72 @@ func (h *CommandHandler) handleStartCommandWithPrivateSubscriptionsRecap(c *tgbo
 	}
 
 	return c.
-		NewMessageReplyTo(fmt.Sprintf("您正在请求为群组 <b>%s</b> 创建聊天回顾。\n请问您要为过去几个小时内的聊天创建回顾呢？", tgbot.EscapeHTMLSymbols(context.ChatTitle)), c.Update.Message.MessageID).
+		NewMessageReplyTo(recapT(c, options, "selectHoursForChat", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(context.ChatTitle)}), c.Update.Message.MessageID).
 		WithReplyMarkup(inlineKeyboardButtons).
 		WithParseModeHTML(), nil
 }
 
+// handleStartCommandWithLatestRecap delivers the latest recap of the chat to
+// the user who followed the deep link, the hours selection will be sent
+// instead if the chat has no recap yet.
+func (h *CommandHandler) handleStartCommandWithLatestRecap(c *tgbot.Context, context *privateSubscriptionStartCommandContext, options *ent.TelegramChatRecapsOptions) (tgbot.Response, error) {
+	err := c.Bot.DeleteAllDeleteLaterMessages(c.Update.Message.From.ID)
+	if err != nil {
+		h.logger.Error("failed to delete all delete later messages", zap.Error(err))
+	}
+
+	log, err := h.chathistories.FindLastChatHistoriesRecap(context.ChatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "failedToFindLatest")).
+			WithReply(c.Update.Message)
+	}
+
+	summarizations := make([]string, 0)
+	if log != nil {
+		summarizations = chathistories.SplitChatHistoriesRecapOutputs(log.RecapOutputs)
+	}
+
+	if len(summarizations) == 0 {
+		inlineKeyboardButtons, err := newRecapSelectHoursInlineKeyboardButtons(c, options, context.ChatID, context.ChatTitle, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(recapT(c, options, "failedToGenerate")).
+				WithReply(c.Update.Message)
+		}
+
+		return c.
+			NewMessageReplyTo(recapT(c, options, "noRecapYet", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(context.ChatTitle)}), c.Update.Message.MessageID).
+			WithReplyMarkup(inlineKeyboardButtons).
+			WithParseModeHTML(), nil
+	}
+
+	for i, s := range summarizations {
+		summarizations[i] = tgbot.ReplaceMarkdownTitlesToTelegramBoldElement(s)
+	}
+
+	header := recapT(c, options, "latestRecapHeader", i18n.M{
+		"ChatTitle": tgbot.EscapeHTMLSymbols(context.ChatTitle),
+		"Hours":     log.WindowHours,
+	})
+
+	summarizationBatches := tgbot.SplitMessagesAgainstLengthLimitIntoMessageGroups(summarizations)
+	for i, b := range summarizationBatches {
+		content := fmt.Sprintf("%s\n\n%s<blockquote expandable>%s</blockquote>\n\n", header, tgchats.FormatRecapDisclaimer(options), strings.Join(b, "\n\n"))
+		if len(summarizationBatches) > 1 {
+			content += fmt.Sprintf("(%d/%d)\n", i+1, len(summarizationBatches))
+		}
+
+		msg := recaprender.NewMessage(c.Update.Message.Chat.ID, content+recaprender.FormatHashtags(h.config.Recap.Hashtags)+"\n"+recapT(c, options, "generatedBy"), tgchats.RecapLinkPreviewEnabled(options))
+
+		c.Bot.MaySend(msg)
+	}
+
+	return nil, nil
+}
+
 func (h *CommandHandler) handleChatMemberLeft(c *tgbot.Context) (tgbot.Response, error) {
 	if c.Update.Message.LeftChatMember == nil {
 		return nil, nil
@@ -269,7 +505,12 @@ func (h *CommandHandler) handleChatMemberLeft(c *tgbot.Context) (tgbot.Response,
 		h.logger.Error("failed to unsubscribe to auto recaps", zap.Error(err))
 	}
 
-	msg := tgbotapi.NewMessage(subscriber.UserID, fmt.Sprintf("由于您已不再是 <b>%s</b> 的成员，因此已自动帮您取消了您所订阅的聊天记录回顾。", tgbot.EscapeHTMLSymbols(c.Update.Message.Chat.Title)))
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		h.logger.Error("failed to find recaps option", zap.Error(err), zap.Int64("chat_id", chatID))
+	}
+
+	msg := tgbotapi.NewMessage(subscriber.UserID, recapT(c, options, "memberLeftUnsubscribed", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(c.Update.Message.Chat.Title)}))
 	msg.ParseMode = tgbotapi.ModeHTML
 	c.Bot.MaySend(msg)
 
@@ -0,0 +1,86 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func TestManualRecapSendMode(t *testing.T) {
+	assert.Equal(t, tgchat.AutoRecapSendModePublicly, manualRecapSendMode(nil))
+	assert.Equal(t, tgchat.AutoRecapSendModePublicly, manualRecapSendMode(&ent.TelegramChatRecapsOptions{
+		AutoRecapSendMode: int(tgchat.AutoRecapSendModePublicly),
+	}))
+	assert.Equal(t, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, manualRecapSendMode(&ent.TelegramChatRecapsOptions{
+		AutoRecapSendMode:  int(tgchat.AutoRecapSendModePublicly),
+		ManualRecapPrivate: true,
+	}))
+	assert.Equal(t, tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, manualRecapSendMode(&ent.TelegramChatRecapsOptions{
+		AutoRecapSendMode: int(tgchat.AutoRecapSendModeOnlyPrivateSubscriptions),
+	}))
+}
+
+func TestManualRecapRateLimitExempt(t *testing.T) {
+	optedIn := &ent.TelegramChatRecapsOptions{ManualRecapRateLimitExemptAdmins: true}
+
+	t.Run("Admin", func(t *testing.T) {
+		assert.True(t, manualRecapRateLimitExempt(optedIn, true))
+	})
+
+	t.Run("Member", func(t *testing.T) {
+		assert.False(t, manualRecapRateLimitExempt(optedIn, false))
+	})
+
+	t.Run("NotOptedIn", func(t *testing.T) {
+		assert.False(t, manualRecapRateLimitExempt(&ent.TelegramChatRecapsOptions{}, true))
+		assert.False(t, manualRecapRateLimitExempt(nil, true))
+	})
+}
+
+func TestManualRecapMinRoleAllows(t *testing.T) {
+	t.Run("Everyone", func(t *testing.T) {
+		assert.True(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleEveryone, telegram.MemberStatusMember))
+		assert.True(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleEveryone, telegram.MemberStatusAdministrator))
+		assert.True(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleEveryone, telegram.MemberStatusCreator))
+	})
+
+	t.Run("Administrator", func(t *testing.T) {
+		assert.False(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleAdministrator, telegram.MemberStatusMember))
+		assert.True(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleAdministrator, telegram.MemberStatusAdministrator))
+		assert.True(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleAdministrator, telegram.MemberStatusCreator))
+	})
+
+	t.Run("Creator", func(t *testing.T) {
+		assert.False(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleCreator, telegram.MemberStatusMember))
+		assert.False(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleCreator, telegram.MemberStatusAdministrator))
+		assert.True(t, manualRecapMinRoleAllows(tgchat.ManualRecapMinRoleCreator, telegram.MemberStatusCreator))
+	})
+}
+
+func TestParseManualRecapMinRoleArgument(t *testing.T) {
+	role, ok, err := parseManualRecapMinRoleArgument(" Admin ")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, tgchat.ManualRecapMinRoleAdministrator, role)
+
+	role, ok, err = parseManualRecapMinRoleArgument("creator")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, tgchat.ManualRecapMinRoleCreator, role)
+
+	role, ok, err = parseManualRecapMinRoleArgument("everyone")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, tgchat.ManualRecapMinRoleEveryone, role)
+
+	_, ok, err = parseManualRecapMinRoleArgument("")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = parseManualRecapMinRoleArgument("moderator")
+	assert.Error(t, err)
+}
@@ -0,0 +1,198 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/webhook"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// RecapCustomHoursMax is the maximum hours of the chat histories that can be
+// recapped by /recap with the hours argument.
+const RecapCustomHoursMax int64 = 72
+
+var (
+	errInvalidRecapHours    = errors.New("invalid recap hours")
+	errRecapHoursOutOfRange = errors.New("recap hours out of range")
+)
+
+// parseRecapHoursArgument parses the optional hours argument of /recap, false
+// will be returned for ok if the argument is empty, which means the hours
+// should be selected from the inline keyboard.
+func parseRecapHoursArgument(arg string) (hour int64, ok bool, err error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return 0, false, nil
+	}
+
+	hour, err = strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %q", errInvalidRecapHours, arg)
+	}
+
+	if hour < 1 || hour > RecapCustomHoursMax {
+		return 0, false, fmt.Errorf("%w: %d", errRecapHoursOutOfRange, hour)
+	}
+
+	return hour, true, nil
+}
+
+// handleRecapCommandWithHours creates the recap for the chat histories of the
+// past hours right away instead of asking for the hours with the inline
+// keyboard.
+func (h *CommandHandler) handleRecapCommandWithHours(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, hour int64) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+
+	histories, err := h.chathistories.FindChatHistoriesByTimeBefore(chatID, time.Duration(hour)*time.Hour)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	histories = chathistories.FilterBotChatHistories(histories, options.IncludeBotMessages)
+	histories = chathistories.FilterExcludedChatHistories(histories, tgchat.MessageTypes(options.ExcludedMessageTypes))
+	histories, activityCount := chathistories.FilterShortChatHistories(histories, options.MinMessageLengthForSummary, options.CountShortMessagesForActivity)
+
+	if activityCount <= 5 || len(histories) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "notEnoughHistories", i18n.M{"Hours": hour})).
+			WithReply(c.Update.Message)
+	}
+
+	language := tgchats.RecapDisplayLanguage(h.tgchats.FindRecapLanguageForGroups(chatID), options)
+
+	return h.summarizeAndSendManualRecap(c, options, histories, hour, renderRecapInProgressText(options.RecapInProgressTemplate, language, tgchat.AutoRecapSendModePublicly, hour, chatTitle))
+}
+
+// summarizeAndSendManualRecap summarizes the chat histories that have passed
+// the threshold and sends the recap to the chat as a reply to the command,
+// windowHours is the hours the chat histories span.
+func (h *CommandHandler) summarizeAndSendManualRecap(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, histories []*ent.ChatHistories, windowHours int64, inProgressText string) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
+
+	// Only the distinct messages of a flooded window are summarized, which
+	// keeps the recaps of raids short and cheap.
+	histories, _ = h.chathistories.FilterFloodChatHistories(histories)
+
+	language := tgchats.RecapDisplayLanguage(h.tgchats.FindRecapLanguageForGroups(chatID), options)
+
+	inProgressMessage, err := c.Bot.Send(tgbotapi.MessageConfig{
+		BaseChat:  tgbotapi.BaseChat{ChatID: chatID, ReplyToMessageID: c.Update.Message.MessageID},
+		Text:      inProgressText,
+		ParseMode: tgbotapi.ModeHTML,
+	})
+	if err != nil {
+		h.logger.Error("failed to send in progress message", zap.Error(err))
+	}
+
+	logID, summarizations, err := h.chathistories.SummarizeChatHistories(
+		chatID,
+		chatType,
+		histories,
+		chathistories.WithSummarizeChatHistoriesPersona(options.RecapPersona),
+		chathistories.WithSummarizeChatHistoriesTemperature(options.SummaryTemperature),
+		chathistories.WithSummarizeChatHistoriesMaxTokens(options.SummaryMaxTokens),
+		chathistories.WithSummarizeChatHistoriesOutputFormat(tgchat.RecapOutputFormat(options.RecapOutputFormat)),
+		chathistories.WithSummarizeChatHistoriesLanguages(tgchats.ParseSummaryLanguages(options.SummaryLanguages)),
+		chathistories.WithSummarizeChatHistoriesTopKeywords(options.TopKeywordsCount),
+		chathistories.WithSummarizeChatHistoriesRelatedMessages(options.RelatedMessagesCount),
+		chathistories.WithSummarizeChatHistoriesIncrementalRecap(options.IncrementalRecap),
+		chathistories.WithSummarizeChatHistoriesDedupForwards(options.DedupForwards),
+		chathistories.WithSummarizeChatHistoriesAnonymizeParticipants(options.AnonymizeParticipants),
+		chathistories.WithSummarizeChatHistoriesWindow(int(windowHours), false),
+	)
+
+	if inProgressMessage.MessageID != 0 {
+		c.Bot.MayRequest(tgbotapi.NewDeleteMessage(chatID, inProgressMessage.MessageID))
+	}
+
+	if message, ok := recapModerationErrorMessage(c, options, err); ok {
+		return nil, tgbot.
+			NewMessageError(message).
+			WithReply(c.Update.Message)
+	}
+
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	counts, err := h.chathistories.FindFeedbackRecapsReactionCountsForChatIDAndLogID(chatID, logID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	inlineKeyboardMarkup, err := h.chathistories.NewVoteRecapInlineKeyboardMarkup(c.Bot, chatID, logID, counts.UpVotes, counts.DownVotes, counts.Lmao)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	rawSummarizations := summarizations
+
+	summarizations = recaprender.RenderSummariesToHTML(summarizations)
+	if len(summarizations) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	earliestChattedAt, latestChattedAt := chathistories.ChatHistoriesChattedAtRange(histories)
+
+	header := tgchats.FormatRecapDisclaimer(options) + h.chathistories.FormatChatHistoriesChattedAtRange(earliestChattedAt, latestChattedAt, language)
+
+	var firstSentMsg *tgbotapi.Message
+
+	summarizationBatches := recaprender.SplitIntoPages(summarizations, false)
+	for i, b := range summarizationBatches {
+		content := recaprender.BuildTelegramMessage(b, recaprender.MessageOptions{
+			Header:   header,
+			ChatType: chatType,
+			Page:     i + 1,
+			Pages:    len(summarizationBatches),
+			Hashtags: h.config.Recap.Hashtags,
+			Texts:    recapTexts(c, options),
+		})
+
+		msg := recaprender.NewMessage(chatID, content, tgchats.RecapLinkPreviewEnabled(options))
+		msg.ReplyMarkup = inlineKeyboardMarkup
+		msg.ReplyToMessageID = c.Update.Message.MessageID
+
+		sentMsg := c.Bot.MaySendThreadMessage(newManualRecapThreadMessage(chatID, msg, options))
+		if i == 0 {
+			firstSentMsg = sentMsg
+		}
+	}
+
+	mayAttachManualRecapDocument(c, h.logger, options, firstSentMsg, recaprender.BuildMarkdownDocument(chatTitle, header, rawSummarizations))
+
+	h.webhook.PublishRecap(webhook.NewRecapPublishedPayload(chatID, logID, summarizations, webhook.RecapPublishedModeManual))
+
+	return nil, nil
+}
@@ -0,0 +1,44 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecapHoursArgument(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		_, ok, err := parseRecapHoursArgument("  ")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		hour, ok, err := parseRecapHoursArgument(" 5 ")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, int64(5), hour)
+
+		hour, ok, err = parseRecapHoursArgument("72")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, RecapCustomHoursMax, hour)
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		for _, arg := range []string{"0", "-3", "73", "1000"} {
+			_, ok, err := parseRecapHoursArgument(arg)
+			require.ErrorIs(t, err, errRecapHoursOutOfRange, arg)
+			assert.False(t, ok)
+		}
+	})
+
+	t.Run("NonNumeric", func(t *testing.T) {
+		for _, arg := range []string{"five", "5h", "1.5", "5 6"} {
+			_, ok, err := parseRecapHoursArgument(arg)
+			require.ErrorIs(t, err, errInvalidRecapHours, arg)
+			assert.False(t, ok)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package recap
+
+import (
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// recapDiagnosis is what /recap_diagnose checks in the chat.
+type recapDiagnosis struct {
+	ChatType telegram.ChatType
+	// BotMember is the chat member of the bot itself.
+	BotMember    tgbotapi.ChatMember
+	RecapEnabled bool
+	// Options can be nil if the chat has never been configured before.
+	Options *ent.TelegramChatRecapsOptions
+}
+
+type recapDiagnoseCheck struct {
+	passed bool
+	title  string
+	hint   string
+}
+
+func (d recapDiagnosis) checks(c *tgbot.Context) []recapDiagnoseCheck {
+	botIsAdmin := d.BotMember.Status == string(telegram.MemberStatusAdministrator)
+	pinEnabled := d.Options != nil && d.Options.PinAutoRecapMessage
+
+	return []recapDiagnoseCheck{
+		{
+			passed: botIsAdmin,
+			title:  recapT(c, d.Options, "diagnose.botIsAdministrator.title"),
+			hint:   recapT(c, d.Options, "diagnose.botIsAdministrator.hint"),
+		},
+		{
+			passed: botIsAdmin && d.BotMember.CanPinMessages,
+			title:  recapT(c, d.Options, "diagnose.botCanPinMessages.title"),
+			hint:   recapT(c, d.Options, lo.Ternary(pinEnabled, "diagnose.botCanPinMessages.pinEnabledHint", "diagnose.botCanPinMessages.hint")),
+		},
+		{
+			passed: botIsAdmin && d.BotMember.CanDeleteMessages,
+			title:  recapT(c, d.Options, "diagnose.botCanDeleteMessages.title"),
+			hint:   recapT(c, d.Options, "diagnose.botCanDeleteMessages.hint"),
+		},
+		{
+			passed: d.ChatType == telegram.ChatTypeSuperGroup,
+			title:  recapT(c, d.Options, "diagnose.superGroup.title"),
+			hint:   recapT(c, d.Options, "diagnose.superGroup.hint"),
+		},
+		{
+			passed: d.RecapEnabled,
+			title:  recapT(c, d.Options, "diagnose.recapEnabled.title"),
+			hint:   recapT(c, d.Options, "diagnose.recapEnabled.hint"),
+		},
+	}
+}
+
+// formatRecapDiagnosis renders the diagnosis into a checklist, the hints of
+// the failed checks are addressed to the administrators since only they can
+// fix them.
+func formatRecapDiagnosis(c *tgbot.Context, d recapDiagnosis, isAdmin bool) string {
+	lines := []string{recapT(c, d.Options, "diagnose.title"), ""}
+	failed := 0
+
+	for _, check := range d.checks(c) {
+		if check.passed {
+			lines = append(lines, "✅ "+check.title)
+			continue
+		}
+
+		failed++
+
+		lines = append(lines, "❌ "+check.title, "    "+check.hint)
+	}
+
+	if d.RecapEnabled {
+		options := d.Options
+		if options == nil {
+			options = &ent.TelegramChatRecapsOptions{AutoRecapSendMode: int(tgchat.AutoRecapSendModePublicly)}
+		}
+
+		lines = append(lines,
+			"",
+			recapT(c, options, "diagnose.sendMode", i18n.M{"Mode": recapSendModeText(c, options, tgchat.AutoRecapSendMode(options.AutoRecapSendMode))}),
+			recapT(c, options, "diagnose.ratesPerDay", i18n.M{"Rates": lo.Ternary(options.AutoRecapRatesPerDay == 0, 4, options.AutoRecapRatesPerDay)}),
+			recapT(c, options, "diagnose.pin", i18n.M{"State": recapOnOff(c, options, options.PinAutoRecapMessage)}),
+		)
+	}
+
+	lines = append(lines, "")
+
+	switch {
+	case failed == 0:
+		lines = append(lines, recapT(c, d.Options, "diagnose.allPassed"))
+	case isAdmin:
+		lines = append(lines, recapT(c, d.Options, "diagnose.failed", i18n.M{"Count": failed}))
+	default:
+		lines = append(lines, recapT(c, d.Options, "diagnose.failedForMember", i18n.M{"Count": failed}))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (h *CommandHandler) handleRecapDiagnoseCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
+		return nil, tgbot.NewMessageError(recapT(c, messageOptions, "diagnose.groupsOnly")).WithReply(c.Update.Message)
+	}
+
+	botMember, err := c.Bot.GetChatMember(tgbotapi.GetChatMemberConfig{ChatConfigWithUser: tgbotapi.ChatConfigWithUser{ChatID: chatID, UserID: c.Bot.Self.ID}})
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "diagnose.unavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	enabled, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, c.Update.Message.Chat.Title)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "diagnose.unavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "diagnose.unavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	isAdmin := c.Bot.IsGroupAnonymousBot(c.Update.Message.From)
+	if !isAdmin && c.Update.Message.From != nil {
+		isAdmin, err = c.IsUserMemberStatus(c.Update.Message.From.ID, []telegram.MemberStatus{
+			telegram.MemberStatusCreator,
+			telegram.MemberStatusAdministrator,
+		})
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(recapT(c, options, "diagnose.unavailable")).
+				WithReply(c.Update.Message)
+		}
+	}
+
+	return c.
+		NewMessageReplyTo(formatRecapDiagnosis(c, recapDiagnosis{
+			ChatType:     chatType,
+			BotMember:    botMember,
+			RecapEnabled: enabled,
+			Options:      options,
+		}, isAdmin), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
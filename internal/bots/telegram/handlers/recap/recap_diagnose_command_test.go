@@ -0,0 +1,77 @@
+package recap
+
+import (
+	"path/filepath"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func TestFormatRecapDiagnosis(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+
+	t.Run("AllPassed", func(t *testing.T) {
+		text := formatRecapDiagnosis(c, recapDiagnosis{
+			ChatType: telegram.ChatTypeSuperGroup,
+			BotMember: tgbotapi.ChatMember{
+				Status:            string(telegram.MemberStatusAdministrator),
+				CanPinMessages:    true,
+				CanDeleteMessages: true,
+			},
+			RecapEnabled: true,
+			Options:      &ent.TelegramChatRecapsOptions{AutoRecapRatesPerDay: 2, PinAutoRecapMessage: true},
+		}, false)
+
+		assert.Contains(t, text, "✅ 机器人是群组管理员")
+		assert.Contains(t, text, "✅ 机器人可以置顶消息")
+		assert.Contains(t, text, "✅ 机器人可以删除消息")
+		assert.Contains(t, text, "✅ 已开启聊天记录回顾")
+		assert.Contains(t, text, "每天自动创建回顾：<b>2 次</b>")
+		assert.NotContains(t, text, "❌")
+		assert.Contains(t, text, "一切正常")
+	})
+
+	t.Run("BotIsNotAdministrator", func(t *testing.T) {
+		text := formatRecapDiagnosis(c, recapDiagnosis{
+			ChatType: telegram.ChatTypeSuperGroup,
+			BotMember: tgbotapi.ChatMember{
+				Status:         string(telegram.MemberStatusMember),
+				CanPinMessages: true,
+			},
+			RecapEnabled: false,
+		}, true)
+
+		assert.Contains(t, text, "❌ 机器人是群组管理员\n    请将机器人设为群组管理员")
+		assert.Contains(t, text, "❌ 机器人可以置顶消息")
+		assert.Contains(t, text, "❌ 已开启聊天记录回顾\n    请使用 /configure_recap 开启聊天记录回顾。")
+		assert.NotContains(t, text, "投递方式")
+		assert.Contains(t, text, "共有 4 项检查未通过，请按照上面的提示进行修复。")
+	})
+
+	t.Run("MissingPinPermissionForNonAdministrator", func(t *testing.T) {
+		text := formatRecapDiagnosis(c, recapDiagnosis{
+			ChatType: telegram.ChatTypeGroup,
+			BotMember: tgbotapi.ChatMember{
+				Status:            string(telegram.MemberStatusAdministrator),
+				CanDeleteMessages: true,
+			},
+			RecapEnabled: true,
+			Options:      &ent.TelegramChatRecapsOptions{PinAutoRecapMessage: true},
+		}, false)
+
+		assert.Contains(t, text, "✅ 机器人是群组管理员")
+		assert.Contains(t, text, "❌ 机器人可以置顶消息\n    已开启置顶聊天记录回顾")
+		assert.Contains(t, text, "❌ 群组是超级群组")
+		assert.Contains(t, text, "只有群组管理员可以进行修复")
+	})
+}
@@ -0,0 +1,57 @@
+package recap
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+const recapDisclaimerMaxLength = 200
+
+func (h *CommandHandler) handleSetRecapDisclaimerCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "disclaimer.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	disclaimer := strings.TrimSpace(c.Update.Message.CommandArguments())
+	if utf8.RuneCountInString(disclaimer) > recapDisclaimerMaxLength {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "disclaimer.tooLong", i18n.M{"MaxLength": recapDisclaimerMaxLength})).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.tgchats.SetRecapDisclaimer(chatID, disclaimer)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "disclaimer.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if disclaimer == "" {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "disclaimer.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "disclaimer.set", i18n.M{"Disclaimer": tgchats.FormatRecapDisclaimer(&ent.TelegramChatRecapsOptions{RecapDisclaimer: disclaimer})}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
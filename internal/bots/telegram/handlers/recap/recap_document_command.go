@@ -0,0 +1,78 @@
+package recap
+
+import (
+	"errors"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/logger"
+)
+
+// mayAttachManualRecapDocument attaches the full recap document to the first
+// manual recap message if the chat has enabled it, the recap message is left
+// alone if the document can not be sent.
+func mayAttachManualRecapDocument(c *tgbot.Context, logger *logger.Logger, options *ent.TelegramChatRecapsOptions, sentMsg *tgbotapi.Message, content string) {
+	if options == nil || !options.RecapDocumentAttachment || sentMsg == nil || sentMsg.Chat == nil || sentMsg.MessageID == 0 {
+		return
+	}
+
+	document, ok := recaprender.NewDocument(sentMsg.Chat.ID, sentMsg.MessageID, recaprender.DocumentFileName(time.Now()), content)
+	if !ok {
+		logger.Warn("recap document exceeds the size limit, skipping...",
+			zap.Int64("chat_id", sentMsg.Chat.ID),
+			zap.Int("size", len(content)),
+		)
+
+		return
+	}
+
+	c.Bot.MaySend(document)
+}
+
+func (h *CommandHandler) handleSetRecapDocumentCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "document.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	attachment, ok, err := parseOnOffArgument(c.Update.Message.CommandArguments())
+	if err != nil || !ok {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "document.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetRecapDocumentAttachment(chatID, attachment)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "document.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.
+		NewMessageReplyTo(lo.Ternary(attachment,
+			recapT(c, messageOptions, "document.on"),
+			recapT(c, messageOptions, "document.off"),
+		), c.Update.Message.MessageID), nil
+}
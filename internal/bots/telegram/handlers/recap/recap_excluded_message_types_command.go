@@ -0,0 +1,88 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+var recapExcludedMessageTypesByName = map[string]tgchat.MessageTypes{
+	"service":   tgchat.MessageTypeService,
+	"forwarded": tgchat.MessageTypeForwarded,
+	"media":     tgchat.MessageTypeMediaOnly,
+}
+
+// parseRecapExcludedMessageTypes parses the space or comma separated message
+// type names, empty argument restores the default and "none" excludes nothing.
+func parseRecapExcludedMessageTypes(arg string) (tgchat.MessageTypes, error) {
+	names := strings.FieldsFunc(strings.ToLower(arg), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	if len(names) == 0 {
+		return tgchat.DefaultExcludedMessageTypes, nil
+	}
+
+	var excluded tgchat.MessageTypes
+
+	for _, name := range names {
+		if name == "none" {
+			continue
+		}
+
+		messageType, ok := recapExcludedMessageTypesByName[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown message type %q", name)
+		}
+
+		excluded |= messageType
+	}
+
+	return excluded, nil
+}
+
+func (h *CommandHandler) handleSetRecapExcludedMessageTypesCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "excludedMessageTypes.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	excluded, err := parseRecapExcludedMessageTypes(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "excludedMessageTypes.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetExcludedMessageTypes(chatID, excluded)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "excludedMessageTypes.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "excludedMessageTypes.set", i18n.M{
+			"Types": recapMessageTypesText(c, messageOptions, excluded),
+		}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
@@ -0,0 +1,28 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func TestParseRecapExcludedMessageTypes(t *testing.T) {
+	excluded, err := parseRecapExcludedMessageTypes("")
+	require.NoError(t, err)
+	assert.Equal(t, tgchat.DefaultExcludedMessageTypes, excluded)
+
+	excluded, err = parseRecapExcludedMessageTypes("none")
+	require.NoError(t, err)
+	assert.Equal(t, tgchat.MessageTypes(0), excluded)
+
+	excluded, err = parseRecapExcludedMessageTypes("service, Forwarded media")
+	require.NoError(t, err)
+	assert.Equal(t, tgchat.MessageTypeService|tgchat.MessageTypeForwarded|tgchat.MessageTypeMediaOnly, excluded)
+	assert.Equal(t, "服务消息、转发消息、无文字的媒体消息", excluded.String())
+
+	_, err = parseRecapExcludedMessageTypes("service photos")
+	require.Error(t, err)
+}
@@ -0,0 +1,153 @@
+package recap
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/logger"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// recapT localizes the fixed string of the recap flow under the
+// modules.telegram.recap key of the locales for the chat, see
+// tgchats.RecapLocale.
+func recapT(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, key string, args ...any) string {
+	return c.I18n.TWithLanguage(tgchats.RecapLocale(options), "modules.telegram.recap."+key, args...)
+}
+
+// recapTexts localizes the fixed strings of the recap messages for the chat
+// in the same locale as recapT.
+func recapTexts(c *tgbot.Context, options *ent.TelegramChatRecapsOptions) recaprender.Texts {
+	return recaprender.NewTexts(c.I18n, tgchats.RecapLocale(options))
+}
+
+// findRecapsOptionForMessages finds the recap options of the chat to localize
+// the messages sent before the options are loaded, nil is returned if the chat
+// has never been configured or the options failed to be found, in which case
+// the messages are localized in the default locale.
+func findRecapsOptionForMessages(model *tgchats.Model, logger *logger.Logger, chatID int64) *ent.TelegramChatRecapsOptions {
+	options, err := model.FindOneRecapsOption(chatID)
+	if err != nil {
+		logger.Warn("failed to find recaps option for messages, fallbacks to default locale",
+			zap.Int64("chat_id", chatID),
+			zap.Error(err),
+		)
+
+		return nil
+	}
+
+	return options
+}
+
+func (h *CommandHandler) recapsOptionForMessages(chatID int64) *ent.TelegramChatRecapsOptions {
+	return findRecapsOptionForMessages(h.tgchats, h.logger, chatID)
+}
+
+func (h *CallbackQueryHandler) recapsOptionForMessages(chatID int64) *ent.TelegramChatRecapsOptions {
+	return findRecapsOptionForMessages(h.tgchats, h.logger, chatID)
+}
+
+// recapDuration localizes the remaining duration, durations under a minute
+// are shown in seconds, others are rounded up to the next minute.
+func recapDuration(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, d time.Duration) string {
+	if d < time.Minute {
+		return recapT(c, options, "duration.seconds", i18n.M{"Seconds": lo.Max([]int64{int64(math.Ceil(d.Seconds())), 1})})
+	}
+
+	minutes := int64(math.Ceil(d.Minutes()))
+	if minutes < 60 {
+		return recapT(c, options, "duration.minutes", i18n.M{"Minutes": minutes})
+	}
+
+	if minutes%60 == 0 {
+		return recapT(c, options, "duration.hours", i18n.M{"Hours": minutes / 60})
+	}
+
+	return recapT(c, options, "duration.hoursMinutes", i18n.M{"Hours": minutes / 60, "Minutes": minutes % 60})
+}
+
+// recapOnOff localizes the state of a switch.
+func recapOnOff(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, on bool) string {
+	return recapT(c, options, lo.Ternary(on, "on", "off"))
+}
+
+func recapSendModeText(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, mode tgchat.AutoRecapSendMode) string {
+	switch mode {
+	case tgchat.AutoRecapSendModePublicly:
+		return recapT(c, options, "sendModes.publicly")
+	case tgchat.AutoRecapSendModeOnlyPrivateSubscriptions:
+		return recapT(c, options, "sendModes.onlyPrivateSubscriptions")
+	case tgchat.AutoRecapSendModeDigestOnly:
+		return recapT(c, options, "sendModes.digestOnly")
+	default:
+		return recapT(c, options, "unknown")
+	}
+}
+
+func recapOutputFormatText(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, format tgchat.RecapOutputFormat) string {
+	switch format {
+	case tgchat.RecapOutputFormatProse:
+		return recapT(c, options, "outputFormats.prose")
+	case tgchat.RecapOutputFormatBullets:
+		return recapT(c, options, "outputFormats.bullets")
+	default:
+		return recapT(c, options, "unknown")
+	}
+}
+
+func recapMinRoleText(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, role tgchat.ManualRecapMinRole) string {
+	switch role {
+	case tgchat.ManualRecapMinRoleEveryone:
+		return recapT(c, options, "minRoles.everyone")
+	case tgchat.ManualRecapMinRoleAdministrator:
+		return recapT(c, options, "minRoles.administrator")
+	case tgchat.ManualRecapMinRoleCreator:
+		return recapT(c, options, "minRoles.creator")
+	default:
+		return recapT(c, options, "unknown")
+	}
+}
+
+func recapSubscribeRequirementText(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, requirement tgchat.SubscribeRecapMemberStatusRequirement) string {
+	switch requirement {
+	case tgchat.SubscribeRecapMemberStatusRequirementMember:
+		return recapT(c, options, "subscribeRequirements.member")
+	case tgchat.SubscribeRecapMemberStatusRequirementUnrestricted:
+		return recapT(c, options, "subscribeRequirements.unrestricted")
+	case tgchat.SubscribeRecapMemberStatusRequirementAdministrator:
+		return recapT(c, options, "subscribeRequirements.administrator")
+	default:
+		return recapT(c, options, "unknown")
+	}
+}
+
+func recapMessageTypesText(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, types tgchat.MessageTypes) string {
+	names := make([]string, 0, 3)
+
+	if types.Has(tgchat.MessageTypeService) {
+		names = append(names, recapT(c, options, "messageTypes.service"))
+	}
+
+	if types.Has(tgchat.MessageTypeForwarded) {
+		names = append(names, recapT(c, options, "messageTypes.forwarded"))
+	}
+
+	if types.Has(tgchat.MessageTypeMediaOnly) {
+		names = append(names, recapT(c, options, "messageTypes.mediaOnly"))
+	}
+
+	if len(names) == 0 {
+		return recapT(c, options, "messageTypes.none")
+	}
+
+	return strings.Join(names, recapT(c, options, "enumerationSeparator"))
+}
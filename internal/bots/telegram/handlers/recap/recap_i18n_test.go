@@ -0,0 +1,114 @@
+package recap
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// sampleRecapFlowMessages renders the fixed strings a chat sees in a manual
+// recap, from selecting the hours to the recap itself.
+func sampleRecapFlowMessages(t *testing.T, c *tgbot.Context, options *ent.TelegramChatRecapsOptions, chatLanguage string) []string {
+	t.Helper()
+
+	language := tgchats.RecapDisplayLanguage(chatLanguage, options)
+	inProgressText := renderRecapInProgressText(options.RecapInProgressTemplate, language, tgchat.AutoRecapSendModePublicly, 6, "Neko")
+	header := chathistories.FormatChatHistoriesChattedAtRange(1700000000000, 1700006400000, time.UTC, language)
+
+	// the topic is written in the characters shared by both of the scripts, so
+	// that only the fixed strings of the templates are checked
+	summarizations := []*openai.ChatHistorySummarizationOutputs{{
+		TopicName:    "周末爬山",
+		SinceID:      1,
+		Participants: []string{"Neko", "Ayaka"},
+		Discussion:   []*openai.ChatHistorySummarizationOutputsDiscussion{{Point: "周六上午集合", KeyIDs: []int64{1}}},
+		Conclusion:   "周六上午集合",
+	}}
+
+	prose, err := chathistories.RenderRecapTemplates(-100123456789, telegram.ChatTypeSuperGroup, summarizations, tgchat.RecapOutputFormatProse, chathistories.NewRecapTexts(c.I18n, tgchats.RecapLocale(options)))
+	require.NoError(t, err)
+
+	bullets, err := chathistories.RenderRecapTemplates(-100123456789, telegram.ChatTypeGroup, summarizations, tgchat.RecapOutputFormatBullets, chathistories.NewRecapTexts(c.I18n, tgchats.RecapLocale(options)))
+	require.NoError(t, err)
+
+	return []string{
+		recapT(c, options, "selectHoursForChat", i18n.M{"ChatTitle": "Neko"}),
+		recapT(c, options, "notEnoughHistories", i18n.M{"Hours": 6}),
+		inProgressText + "\n\n" + recapT(c, options, "topicsSummarized", i18n.M{"Count": 3}),
+		recaprender.BuildTelegramMessage([]string{"<b>周末爬山</b>"}, recaprender.MessageOptions{
+			Header:   header,
+			ChatType: telegram.ChatTypeGroup,
+			Page:     1,
+			Pages:    1,
+			Texts:    recapTexts(c, options),
+		}),
+		recaprender.BuildDigestOnlyMessage([]string{"<b>周末爬山</b>"}, recaprender.MessageOptions{
+			Header:   header,
+			ChatType: telegram.ChatTypeGroup,
+			Texts:    recapTexts(c, options),
+		}),
+		recapT(c, options, "privateSubscriptionHeader", i18n.M{"ChatTitle": "Neko"}),
+		recapT(c, options, "privateRecapDigestHeader", i18n.M{"Count": 2}),
+		recapT(c, options, "quietNoticeForSubscriber", i18n.M{"ChatTitle": "Neko", "Hours": 6}),
+		recapT(c, options, "collectOnly"),
+		recapT(c, options, "minRoleAdministratorRequired"),
+		recapT(c, options, "minRoleCreatorRequired"),
+		recapT(c, options, "rangeUsage"),
+		recapT(c, options, "rangeInProgress", i18n.M{
+			"Range": recapT(c, options, "range", i18n.M{"Start": "2024-01-02 00:00", "End": "2024-01-03 00:00"}),
+			"Count": 6,
+		}),
+		recapT(c, options, "approvalNotice", i18n.M{"ChatTitle": "Neko", "Hours": 24}),
+		recapT(c, options, "moderationHeldNotice", i18n.M{"ChatTitle": "Neko", "Hours": 24}),
+		recapT(c, options, "topicRecapHeader", i18n.M{"Hours": 6, "Keyword": "爬山"}),
+		recapT(c, options, "previewHeader", i18n.M{"ChatTitle": "Neko", "Hours": 6}),
+		recapT(c, options, "retryLastHistoriesGone"),
+		recapT(c, options, "retryLastInProgress", i18n.M{"Hours": 6, "Count": 6}),
+		recapT(c, options, "retryLastAutoRecapQueued"),
+		strings.Join(prose, "\n\n"),
+		strings.Join(bullets, "\n\n"),
+	}
+}
+
+func TestRecapFlowDoesNotMixChineseScripts(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+
+	testCases := []struct {
+		name             string
+		summaryLanguages string
+		chatLanguage     string
+		script           i18n.ChineseScript
+	}{
+		{name: "Default", script: i18n.ChineseScriptSimplified},
+		{name: "Simplified", summaryLanguages: "简体中文", chatLanguage: "zh-TW", script: i18n.ChineseScriptSimplified},
+		{name: "Traditional", summaryLanguages: "繁體中文", chatLanguage: "zh-CN", script: i18n.ChineseScriptTraditional},
+		{name: "TraditionalTag", summaryLanguages: "zh-Hant", script: i18n.ChineseScriptTraditional},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := &ent.TelegramChatRecapsOptions{SummaryLanguages: tc.summaryLanguages}
+
+			for _, message := range sampleRecapFlowMessages(t, c, options, tc.chatLanguage) {
+				assert.Equal(t, tc.script, i18n.ChineseScriptOf(message), message)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package recap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+const recapInProgressTemplateMaxLength = 200
+
+type recapInProgressTemplates struct {
+	publicly                 string
+	onlyPrivateSubscriptions string
+}
+
+var (
+	recapInProgressTemplatesChinese = recapInProgressTemplates{
+		publicly:                 "正在为过去 {{ .Hour }} 个小时的聊天记录生成回顾，请稍等...",
+		onlyPrivateSubscriptions: "正在为 <b>{{ .ChatTitle }}</b> 过去 {{ .Hour }} 个小时的聊天记录生成回顾，请稍等...",
+	}
+	recapInProgressTemplatesTraditionalChinese = recapInProgressTemplates{
+		publicly:                 "正在為過去 {{ .Hour }} 個小時的聊天紀錄產生回顧，請稍候...",
+		onlyPrivateSubscriptions: "正在為 <b>{{ .ChatTitle }}</b> 過去 {{ .Hour }} 個小時的聊天紀錄產生回顧，請稍候...",
+	}
+	recapInProgressTemplatesJapanese = recapInProgressTemplates{
+		publicly:                 "過去 {{ .Hour }} 時間のチャット履歴のまとめを作成しています。しばらくお待ちください...",
+		onlyPrivateSubscriptions: "<b>{{ .ChatTitle }}</b> の過去 {{ .Hour }} 時間のチャット履歴のまとめを作成しています。しばらくお待ちください...",
+	}
+	recapInProgressTemplatesEnglish = recapInProgressTemplates{
+		publicly:                 "Generating the recap of the chat histories in the past {{ .Hour }} hours, please wait...",
+		onlyPrivateSubscriptions: "Generating the recap of the chat histories of <b>{{ .ChatTitle }}</b> in the past {{ .Hour }} hours, please wait...",
+	}
+)
+
+// recapInProgressTemplatesOf returns the default in progress templates for
+// the language, Chinese will be used for empty or unknown languages since the
+// recaps are written in Chinese.
+func recapInProgressTemplatesOf(lang string) recapInProgressTemplates {
+	if lang == "" {
+		return recapInProgressTemplatesChinese
+	}
+
+	base, _ := language.Make(lang).Base()
+
+	switch base.String() {
+	case "en":
+		return recapInProgressTemplatesEnglish
+	case "ja":
+		return recapInProgressTemplatesJapanese
+	case "zh":
+		return lo.Ternary(i18n.IsTraditionalChinese(lang), recapInProgressTemplatesTraditionalChinese, recapInProgressTemplatesChinese)
+	default:
+		return recapInProgressTemplatesChinese
+	}
+}
+
+type recapInProgressTemplateData struct {
+	Hour      int64
+	ChatTitle string
+	// IsPrivateSubscription is true if the recap is requested in the private
+	// chat for the chat of ChatTitle.
+	IsPrivateSubscription bool
+}
+
+func executeRecapInProgressTemplate(tmpl string, data recapInProgressTemplateData) (string, error) {
+	t, err := template.New("recap_in_progress").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+
+	err = t.Execute(&buffer, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// validateRecapInProgressTemplate validates the custom in progress template
+// by rendering it with the sample data.
+func validateRecapInProgressTemplate(tmpl string) error {
+	if utf8.RuneCountInString(tmpl) > recapInProgressTemplateMaxLength {
+		return fmt.Errorf("in progress template is longer than %d characters", recapInProgressTemplateMaxLength)
+	}
+
+	text, err := executeRecapInProgressTemplate(tmpl, recapInProgressTemplateData{Hour: 1, ChatTitle: "ChatTitle"})
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return errors.New("in progress template renders to empty text")
+	}
+
+	return nil
+}
+
+// renderRecapInProgressText renders the text shown while the recap is being
+// generated with the custom template of the chat, the default one of the
+// language and the recap mode will be used if no custom template was set or
+// it fails to be rendered.
+func renderRecapInProgressText(customTemplate string, lang string, mode tgchat.AutoRecapSendMode, hour int64, chatTitle string) string {
+	data := recapInProgressTemplateData{
+		Hour:                  hour,
+		ChatTitle:             tgbot.EscapeHTMLSymbols(chatTitle),
+		IsPrivateSubscription: mode == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions,
+	}
+
+	if strings.TrimSpace(customTemplate) != "" {
+		text, err := executeRecapInProgressTemplate(customTemplate, data)
+		if err == nil && strings.TrimSpace(text) != "" {
+			return text
+		}
+	}
+
+	templates := recapInProgressTemplatesOf(lang)
+	defaultTemplate := templates.publicly
+
+	if data.IsPrivateSubscription {
+		defaultTemplate = templates.onlyPrivateSubscriptions
+	}
+
+	text, _ := executeRecapInProgressTemplate(defaultTemplate, data)
+
+	return text
+}
@@ -0,0 +1,60 @@
+package recap
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func (h *CommandHandler) handleSetRecapInProgressTextCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "inProgressText.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	tmpl := strings.TrimSpace(c.Update.Message.CommandArguments())
+	if tmpl != "" {
+		err = validateRecapInProgressTemplate(tmpl)
+		if err != nil {
+			return nil, tgbot.
+				NewMessageError(recapT(c, messageOptions, "inProgressText.invalid", i18n.M{"MaxLength": recapInProgressTemplateMaxLength})).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+	}
+
+	err = h.tgchats.SetRecapInProgressTemplate(chatID, tmpl)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "inProgressText.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if tmpl == "" {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "inProgressText.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "inProgressText.set", i18n.M{
+			"Hours":   6,
+			"Example": renderRecapInProgressText(tmpl, "", tgchat.AutoRecapSendModePublicly, 6, c.Update.Message.Chat.Title),
+		}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
@@ -0,0 +1,43 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func TestRenderRecapInProgressText(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		assert.Equal(t, "正在为过去 6 个小时的聊天记录生成回顾，请稍等...", renderRecapInProgressText("", "", tgchat.AutoRecapSendModePublicly, 6, "Neko & Friends"))
+		assert.Equal(t, "正在为 <b>Neko &amp; Friends</b> 过去 6 个小时的聊天记录生成回顾，请稍等...", renderRecapInProgressText("", "", tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, 6, "Neko & Friends"))
+	})
+
+	t.Run("Language", func(t *testing.T) {
+		assert.Equal(t, "Generating the recap of the chat histories in the past 6 hours, please wait...", renderRecapInProgressText("", "en", tgchat.AutoRecapSendModePublicly, 6, "Neko"))
+		assert.Equal(t, "Generating the recap of the chat histories of <b>Neko</b> in the past 6 hours, please wait...", renderRecapInProgressText("", "en-US", tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, 6, "Neko"))
+		assert.Equal(t, "正在为过去 6 个小时的聊天记录生成回顾，请稍等...", renderRecapInProgressText("", "zh-CN", tgchat.AutoRecapSendModePublicly, 6, "Neko"))
+		assert.Equal(t, "正在為過去 6 個小時的聊天紀錄產生回顧，請稍候...", renderRecapInProgressText("", "zh-TW", tgchat.AutoRecapSendModePublicly, 6, "Neko"))
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		tmpl := "{{ if .IsPrivateSubscription }}{{ .ChatTitle }} 的{{ end }}最近 {{ .Hour }} 小时回顾马上就好～"
+
+		assert.Equal(t, "最近 12 小时回顾马上就好～", renderRecapInProgressText(tmpl, "en", tgchat.AutoRecapSendModePublicly, 12, "Neko"))
+		assert.Equal(t, "Neko 的最近 12 小时回顾马上就好～", renderRecapInProgressText(tmpl, "en", tgchat.AutoRecapSendModeOnlyPrivateSubscriptions, 12, "Neko"))
+	})
+
+	t.Run("InvalidCustomFallbacksToDefault", func(t *testing.T) {
+		assert.Equal(t, "正在为过去 6 个小时的聊天记录生成回顾，请稍等...", renderRecapInProgressText("{{ .Hour", "", tgchat.AutoRecapSendModePublicly, 6, "Neko"))
+		assert.Equal(t, "正在为过去 6 个小时的聊天记录生成回顾，请稍等...", renderRecapInProgressText("{{ .Unknown }}", "", tgchat.AutoRecapSendModePublicly, 6, "Neko"))
+	})
+}
+
+func TestValidateRecapInProgressTemplate(t *testing.T) {
+	require.NoError(t, validateRecapInProgressTemplate("稍等，正在整理过去 {{ .Hour }} 小时的聊天..."))
+	require.Error(t, validateRecapInProgressTemplate("{{ .Hour"))
+	require.Error(t, validateRecapInProgressTemplate("{{ .Minutes }}"))
+	require.Error(t, validateRecapInProgressTemplate("{{ if false }}x{{ end }}"))
+}
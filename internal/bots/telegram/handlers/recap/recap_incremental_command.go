@@ -0,0 +1,51 @@
+package recap
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+)
+
+func (h *CommandHandler) handleSetRecapIncrementalCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "incremental.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	incremental, ok, err := parseOnOffArgument(c.Update.Message.CommandArguments())
+	if err != nil || !ok {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "incremental.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetIncrementalRecap(chatID, incremental)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "incremental.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.
+		NewMessageReplyTo(lo.Ternary(incremental,
+			recapT(c, messageOptions, "incremental.on"),
+			recapT(c, messageOptions, "incremental.off"),
+		), c.Update.Message.MessageID), nil
+}
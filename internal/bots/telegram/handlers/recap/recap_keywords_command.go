@@ -0,0 +1,76 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// parseRecapKeywordsCount parses the keywords count argument, empty argument
+// disables the keywords and is represented as 0.
+func parseRecapKeywordsCount(arg string) (int, error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return 0, nil
+	}
+
+	count, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("invalid keywords count %q", arg)
+	}
+
+	if count < 0 || count > tgchats.TopKeywordsCountMax {
+		return 0, fmt.Errorf("keywords count %q is out of range", arg)
+	}
+
+	return count, nil
+}
+
+func (h *CommandHandler) handleSetRecapKeywordsCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "keywords.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	count, err := parseRecapKeywordsCount(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "keywords.invalid", i18n.M{"Max": tgchats.TopKeywordsCountMax})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	count, err = h.tgchats.SetTopKeywordsCount(chatID, count)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "keywords.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if count == 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "keywords.off"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "keywords.set", i18n.M{"Count": count}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
@@ -0,0 +1,23 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecapKeywordsCount(t *testing.T) {
+	count, err := parseRecapKeywordsCount("")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = parseRecapKeywordsCount(" 5 ")
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	for _, arg := range []string{"-1", "11", "abc"} {
+		_, err = parseRecapKeywordsCount(arg)
+		assert.Error(t, err, arg)
+	}
+}
@@ -0,0 +1,52 @@
+package recap
+
+import (
+	"errors"
+	"html"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+func (h *CommandHandler) handleSetRecapLanguagesCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "languages.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	languages, err := h.tgchats.SetSummaryLanguages(chatID, tgchats.ParseSummaryLanguages(c.Update.Message.CommandArguments()))
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "languages.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if len(languages) == 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "languages.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "languages.set", i18n.M{
+			"Languages": strings.Join(lo.Map(languages, func(item string, _ int) string { return "<code>" + html.EscapeString(item) + "</code>" }), recapT(c, messageOptions, "enumerationSeparator")),
+			"MaxCount":  tgchats.SummaryLanguagesMaxCount,
+		}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
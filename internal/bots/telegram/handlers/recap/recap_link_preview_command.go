@@ -0,0 +1,51 @@
+package recap
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+)
+
+func (h *CommandHandler) handleSetRecapLinkPreviewCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "linkPreview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	linkPreview, ok, err := parseOnOffArgument(c.Update.Message.CommandArguments())
+	if err != nil || !ok {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "linkPreview.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetRecapLinkPreview(chatID, linkPreview)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "linkPreview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.
+		NewMessageReplyTo(lo.Ternary(linkPreview,
+			recapT(c, messageOptions, "linkPreview.on"),
+			recapT(c, messageOptions, "linkPreview.off"),
+		), c.Update.Message.MessageID), nil
+}
@@ -0,0 +1,76 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// parseRecapMaxTokens parses the max tokens argument, empty argument resets
+// the max tokens and is represented as 0.
+func parseRecapMaxTokens(arg string) (int, error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return 0, nil
+	}
+
+	maxTokens, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max tokens %q", arg)
+	}
+
+	if maxTokens <= 0 {
+		return 0, fmt.Errorf("max tokens %q is not positive", arg)
+	}
+
+	return tgchats.ClampSummaryMaxTokens(maxTokens), nil
+}
+
+func (h *CommandHandler) handleSetRecapMaxTokensCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "maxTokens.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	maxTokens, err := parseRecapMaxTokens(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "maxTokens.invalid", i18n.M{"Min": tgchats.SummaryMaxTokensMin, "Max": tgchats.SummaryMaxTokensMax})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	maxTokens, err = h.tgchats.SetSummaryMaxTokens(chatID, maxTokens)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "maxTokens.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if maxTokens == 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "maxTokens.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "maxTokens.set", i18n.M{"MaxTokens": maxTokens}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
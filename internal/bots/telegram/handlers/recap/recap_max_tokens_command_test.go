@@ -0,0 +1,33 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+)
+
+func TestParseRecapMaxTokens(t *testing.T) {
+	maxTokens, err := parseRecapMaxTokens("")
+	require.NoError(t, err)
+	assert.Zero(t, maxTokens)
+
+	maxTokens, err = parseRecapMaxTokens(" 2048 ")
+	require.NoError(t, err)
+	assert.Equal(t, 2048, maxTokens)
+
+	maxTokens, err = parseRecapMaxTokens("100")
+	require.NoError(t, err)
+	assert.Equal(t, tgchats.SummaryMaxTokensMin, maxTokens)
+
+	maxTokens, err = parseRecapMaxTokens("1000000")
+	require.NoError(t, err)
+	assert.Equal(t, tgchats.SummaryMaxTokensMax, maxTokens)
+
+	for _, arg := range []string{"0", "-1", "abc", "1.5"} {
+		_, err = parseRecapMaxTokens(arg)
+		assert.Error(t, err, arg)
+	}
+}
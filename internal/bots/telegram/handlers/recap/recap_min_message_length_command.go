@@ -0,0 +1,75 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// parseRecapMinMessageLength parses the minimum message length argument,
+// empty argument disables the filter and is represented as 0.
+func parseRecapMinMessageLength(arg string) (int, error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return 0, nil
+	}
+
+	minLength, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("invalid minimum message length %q", arg)
+	}
+
+	if minLength < 0 {
+		return 0, fmt.Errorf("minimum message length %q is negative", arg)
+	}
+
+	return minLength, nil
+}
+
+func (h *CommandHandler) handleSetRecapMinMessageLengthCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "minMessageLength.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	minLength, err := parseRecapMinMessageLength(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "minMessageLength.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetMinMessageLengthForSummary(chatID, minLength)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "minMessageLength.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if minLength == 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "minMessageLength.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "minMessageLength.set", i18n.M{"Length": minLength}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
@@ -0,0 +1,71 @@
+package recap
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+var errInvalidManualRecapMinRoleArgument = errors.New("invalid manual recap min role argument")
+
+// parseManualRecapMinRoleArgument parses the argument of /set_recap_min_role,
+// ok is false if the argument is empty.
+func parseManualRecapMinRoleArgument(arg string) (role tgchat.ManualRecapMinRole, ok bool, err error) {
+	switch strings.ToLower(strings.TrimSpace(arg)) {
+	case "":
+		return tgchat.ManualRecapMinRoleEveryone, false, nil
+	case "everyone":
+		return tgchat.ManualRecapMinRoleEveryone, true, nil
+	case "admin":
+		return tgchat.ManualRecapMinRoleAdministrator, true, nil
+	case "creator":
+		return tgchat.ManualRecapMinRoleCreator, true, nil
+	default:
+		return tgchat.ManualRecapMinRoleEveryone, false, errInvalidManualRecapMinRoleArgument
+	}
+}
+
+func (h *CommandHandler) handleSetRecapMinRoleCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "minRole.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	role, ok, err := parseManualRecapMinRoleArgument(c.Update.Message.CommandArguments())
+	if err != nil || !ok {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "minRole.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetManualRecapMinRole(chatID, role)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "minRole.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if role == tgchat.ManualRecapMinRoleEveryone {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "minRole.everyone"), c.Update.Message.MessageID), nil
+	}
+
+	return c.NewMessageReplyTo(recapT(c, messageOptions, "minRole.set", i18n.M{"Role": recapMinRoleText(c, messageOptions, role)}), c.Update.Message.MessageID), nil
+}
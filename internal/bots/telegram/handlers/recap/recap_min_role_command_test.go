@@ -0,0 +1,57 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// newManualRecapMinRoleTestContext prepares a group only allowing its creator
+// to create the recaps, and the context of an administrator sending the
+// command in it, the administrators can configure the recaps but are below
+// the minimum role.
+func newManualRecapMinRoleTestContext(t *testing.T, command string, arguments string) (*CommandHandler, *tgbot.Context) {
+	t.Helper()
+
+	ht := newCommandHandlerTest(t)
+	chat := ht.newGroup(t)
+
+	err := ht.h.tgchats.SetManualRecapMinRole(chat.ID, tgchat.ManualRecapMinRoleCreator)
+	require.NoError(t, err)
+
+	return ht.h, ht.newContext(chat, command, arguments)
+}
+
+func assertManualRecapMinRoleRejected(t *testing.T, c *tgbot.Context, err error) {
+	t.Helper()
+
+	var messageError tgbot.MessageError
+
+	require.ErrorAs(t, err, &messageError)
+	assert.Equal(t, recapT(c, nil, "minRoleCreatorRequired"), messageError.Error())
+}
+
+func TestRecapTopicCommandChecksManualRecapMinRole(t *testing.T) {
+	h, c := newManualRecapMinRoleTestContext(t, "/recap_topic", "爬山")
+
+	_, err := h.handleRecapTopicCommand(c)
+	assertManualRecapMinRoleRejected(t, c, err)
+}
+
+func TestRecapPreviewCommandChecksManualRecapMinRole(t *testing.T) {
+	h, c := newManualRecapMinRoleTestContext(t, "/recap_preview", "")
+
+	_, err := h.handleRecapPreviewCommand(c)
+	assertManualRecapMinRoleRejected(t, c, err)
+}
+
+func TestRecapRetryLastCommandChecksManualRecapMinRole(t *testing.T) {
+	h, c := newManualRecapMinRoleTestContext(t, "/recap_retry_last", "")
+
+	_, err := h.handleRecapRetryLastCommand(c)
+	assertManualRecapMinRoleRejected(t, c, err)
+}
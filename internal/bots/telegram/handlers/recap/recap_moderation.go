@@ -0,0 +1,22 @@
+package recap
+
+import (
+	"errors"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+)
+
+// recapModerationErrorMessage returns the message to reply with if the recap
+// was stopped by the moderation, false will be returned for the other errors.
+func recapModerationErrorMessage(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, err error) (string, bool) {
+	switch {
+	case errors.Is(err, chathistories.ErrChatHistoriesRecapFlagged):
+		return recapT(c, options, "moderation.flagged"), true
+	case errors.Is(err, chathistories.ErrChatHistoriesRecapHeldForModeration):
+		return recapT(c, options, "moderation.held"), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,64 @@
+package recap
+
+import (
+	"errors"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+func (h *CommandHandler) handleSetRecapPersonaCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "persona.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	persona := strings.TrimSpace(c.Update.Message.CommandArguments())
+	if _, ok := openai.ChatHistorySummarizationPersonaPresets[strings.ToLower(persona)]; ok {
+		persona = strings.ToLower(persona)
+	} else {
+		persona = openai.SanitizeChatHistorySummarizationPersona(persona)
+	}
+
+	err = h.tgchats.SetRecapPersona(chatID, persona)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "persona.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if persona == "" {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "persona.reset"), c.Update.Message.MessageID), nil
+	}
+
+	presets := lo.Keys(openai.ChatHistorySummarizationPersonaPresets)
+	sort.Strings(presets)
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "persona.set", i18n.M{
+			"Persona":   html.EscapeString(persona),
+			"Presets":   strings.Join(lo.Map(presets, func(item string, _ int) string { return "<code>" + item + "</code>" }), recapT(c, messageOptions, "enumerationSeparator")),
+			"MaxLength": openai.ChatHistorySummarizationPersonaMaxLength,
+		}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
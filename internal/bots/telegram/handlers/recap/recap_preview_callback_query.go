@@ -0,0 +1,134 @@
+package recap
+
+import (
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func (h *CallbackQueryHandler) handleCallbackQueryPublishPreview(c *tgbot.Context) (tgbot.Response, error) {
+	messageID := c.Update.CallbackQuery.Message.MessageID
+	fromID := c.Update.CallbackQuery.From.ID
+
+	var data recap.PublishRecapPreviewActionData
+
+	err := c.BindFromCallbackQueryData(&data)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, nil, "preview.publishFailed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	if data.FromID != fromID {
+		return nil, nil
+	}
+
+	messageOptions := h.recapsOptionForMessages(data.ChatID)
+
+	is, err := c.Bot.IsUserMemberStatus(data.ChatID, fromID, []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+	})
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "preview.publishFailed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	if !is {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "preview.publishAdministratorRequired")).
+			WithReply(c.Update.CallbackQuery.Message).
+			WithParseModeHTML()
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(data.ChatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "preview.publishFailed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	// take the preview out atomically to prevent it from being published twice
+	preview, err := h.chatHistories.TakeOneChatHistoriesRecapPreview(data.PreviewID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.publishFailed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	if preview == nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "preview.expired")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	logID, err := h.chatHistories.SaveOneChatHistoriesRecap(preview)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.publishFailed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	inlineKeyboardMarkup, err := h.chatHistories.NewVoteRecapInlineKeyboardMarkup(c.Bot, data.ChatID, logID, 0, 0, 0)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.publishFailed")).
+			WithReply(c.Update.CallbackQuery.Message)
+	}
+
+	summarizations := recaprender.RenderSummariesToHTML(preview.Summarizations)
+
+	language := tgchats.RecapDisplayLanguage(h.tgchats.FindRecapLanguageForGroups(data.ChatID), options)
+
+	summarizationBatches := recaprender.SplitIntoPages(summarizations, false)
+	for i, b := range summarizationBatches {
+		content := recaprender.BuildTelegramMessage(b, recaprender.MessageOptions{
+			Header:   tgchats.FormatRecapDisclaimer(options) + h.chatHistories.FormatChatHistoriesChattedAtRange(preview.EarliestChattedAt, preview.LatestChattedAt, language),
+			ChatType: preview.ChatType,
+			Page:     i + 1,
+			Pages:    len(summarizationBatches),
+			Hashtags: h.config.Recap.Hashtags,
+			Texts:    recapTexts(c, options),
+		})
+
+		msg := recaprender.NewMessage(data.ChatID, content, tgchats.RecapLinkPreviewEnabled(options))
+		msg.ReplyMarkup = inlineKeyboardMarkup
+
+		h.logger.Info("publishing chat histories recap preview for chat",
+			zap.Int64("chat_id", data.ChatID),
+			zap.String("text", msg.Text),
+		)
+
+		_, err = c.Bot.SendThreadMessageWithFloodControl(newManualRecapThreadMessage(data.ChatID, msg, options))
+		if err != nil {
+			h.logger.Error("failed to publish chat histories recap preview",
+				zap.Int64("chat_id", data.ChatID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	nopData, err := c.Bot.AssignOneNopCallbackQueryData()
+	if err != nil {
+		h.logger.Error("failed to assign nop callback query data", zap.Error(err))
+		return nil, nil
+	}
+
+	return c.NewEditMessageReplyMarkup(messageID, tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(recapT(c, options, "preview.published"), nopData),
+		),
+	)), nil
+}
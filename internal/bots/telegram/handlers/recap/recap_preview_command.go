@@ -0,0 +1,242 @@
+package recap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+const recapPreviewDefaultHour int64 = 6
+
+func (h *CommandHandler) handleRecapPreviewCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+	fromID := c.Update.Message.From.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
+	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
+		return nil, tgbot.NewMessageError(recapT(c, messageOptions, "preview.groupsOnly")).WithReply(c.Update.Message)
+	}
+
+	if c.Bot.IsGroupAnonymousBot(c.Update.Message.From) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "preview.anonymousAdmin")).
+			WithReply(c.Update.Message)
+	}
+
+	is, err := c.IsUserMemberStatus(fromID, []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+	})
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if !is {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "preview.previewAdministratorRequired")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if !has {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "preview.notEnabled")).
+			WithReply(c.Update.Message)
+	}
+
+	hour := recapPreviewDefaultHour
+
+	args := strings.TrimSpace(c.Update.Message.CommandArguments())
+	if args != "" {
+		parsedHour, err := strconv.ParseInt(args, 10, 64)
+		if err != nil || !lo.Contains(RecapSelectHourAvailable, parsedHour) {
+			return nil, tgbot.
+				NewMessageError(recapT(c, messageOptions, "preview.invalidHours", i18n.M{"Hours": strings.Join(lo.Map(RecapSelectHourAvailable, func(item int64, _ int) string {
+					return strconv.FormatInt(item, 10)
+				}), recapT(c, messageOptions, "enumerationSeparator"))})).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		hour = parsedHour
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.checkManualRecapMinRole(c, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if tgchats.IsRecapCollectOnly(options) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "collectOnly")).
+			WithReply(c.Update.Message)
+	}
+
+	rateLimitInterval := h.tgchats.ManualRecapRatePerSeconds(options)
+
+	_, ttl, ok, err := c.RateLimitForCommand(chatID, "/recap_preview", 1, rateLimitInterval)
+	if err != nil {
+		h.logger.Error("failed to check rate limit for command /recap_preview", zap.Error(err))
+	}
+
+	if !ok {
+		rateLimitIntervalMinutes := lo.Ternary(rateLimitInterval/time.Minute <= 1, 1, rateLimitInterval/time.Minute)
+
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "preview.rateLimitExceeded", i18n.M{
+				"Minutes":  int64(rateLimitIntervalMinutes),
+				"Duration": recapDuration(c, options, ttl),
+			})).
+			WithReply(c.Update.Message)
+	}
+
+	histories, err := h.chathistories.FindChatHistoriesByTimeBefore(chatID, time.Duration(hour)*time.Hour)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	histories = chathistories.FilterBotChatHistories(histories, options.IncludeBotMessages)
+	histories = chathistories.FilterExcludedChatHistories(histories, tgchat.MessageTypes(options.ExcludedMessageTypes))
+	histories, activityCount := chathistories.FilterShortChatHistories(histories, options.MinMessageLengthForSummary, options.CountShortMessagesForActivity)
+
+	if activityCount <= 5 || len(histories) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "notEnoughHistories", i18n.M{"Hours": hour})).
+			WithReply(c.Update.Message)
+	}
+
+	generated, err := h.chathistories.GenerateChatHistoriesRecap(
+		chatID,
+		chatType,
+		histories,
+		chathistories.WithSummarizeChatHistoriesPersona(options.RecapPersona),
+		chathistories.WithSummarizeChatHistoriesTemperature(options.SummaryTemperature),
+		chathistories.WithSummarizeChatHistoriesMaxTokens(options.SummaryMaxTokens),
+		chathistories.WithSummarizeChatHistoriesOutputFormat(tgchat.RecapOutputFormat(options.RecapOutputFormat)),
+		chathistories.WithSummarizeChatHistoriesLanguages(tgchats.ParseSummaryLanguages(options.SummaryLanguages)),
+		chathistories.WithSummarizeChatHistoriesTopKeywords(options.TopKeywordsCount),
+		chathistories.WithSummarizeChatHistoriesRelatedMessages(options.RelatedMessagesCount),
+		chathistories.WithSummarizeChatHistoriesIncrementalRecap(options.IncrementalRecap),
+		chathistories.WithSummarizeChatHistoriesDedupForwards(options.DedupForwards),
+		chathistories.WithSummarizeChatHistoriesAnonymizeParticipants(options.AnonymizeParticipants),
+		chathistories.WithSummarizeChatHistoriesWindow(int(hour), false),
+	)
+	if message, ok := recapModerationErrorMessage(c, options, err); ok {
+		return nil, tgbot.
+			NewMessageError(message).
+			WithReply(c.Update.Message)
+	}
+
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	summarizations := lo.Filter(generated.Summarizations, func(item string, _ int) bool { return item != "" })
+	if len(summarizations) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	generated.Summarizations = summarizations
+
+	previewID, err := h.chathistories.SaveOneChatHistoriesRecapPreview(generated)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	inlineKeyboardMarkup, err := h.chathistories.NewRecapPreviewInlineKeyboardMarkup(c.Bot, previewID, chatID, fromID, chathistories.NewRecapTexts(c.I18n, tgchats.RecapLocale(options)))
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	for i, s := range summarizations {
+		summarizations[i] = tgbot.ReplaceMarkdownTitlesToTelegramBoldElement(s)
+	}
+
+	language := tgchats.RecapDisplayLanguage(h.tgchats.FindRecapLanguageForGroups(chatID), options)
+
+	summarizationBatches := tgbot.SplitMessagesAgainstLengthLimitIntoMessageGroups(summarizations)
+	for i, b := range summarizationBatches {
+		content := fmt.Sprintf("%s\n\n%s%s%s<blockquote expandable>%s</blockquote>",
+			recapT(c, options, "previewHeader", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle), "Hours": hour}),
+			generated.Moderation.FormatWarning(),
+			tgchats.FormatRecapDisclaimer(options),
+			h.chathistories.FormatChatHistoriesChattedAtRange(generated.EarliestChattedAt, generated.LatestChattedAt, language),
+			strings.Join(b, "\n\n"),
+		)
+		if len(summarizationBatches) > 1 {
+			content = fmt.Sprintf("%s\n\n(%d/%d)", content, i+1, len(summarizationBatches))
+		}
+
+		msg := tgbotapi.NewMessage(fromID, content)
+		msg.ParseMode = tgbotapi.ModeHTML
+
+		if i == len(summarizationBatches)-1 {
+			msg.ReplyMarkup = inlineKeyboardMarkup
+		}
+
+		_, err = c.Bot.Send(msg)
+		if err == nil {
+			continue
+		}
+
+		if c.Bot.IsCannotInitiateChatWithUserErr(err) || c.Bot.IsBotWasBlockedByTheUserErr(err) {
+			return nil, tgbot.
+				NewMessageError(recapT(c, options, "preview.cannotSendPrivately")).
+				WithReply(c.Update.Message)
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "preview.sendFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.NewMessageReplyTo(recapT(c, options, "preview.sent"), c.Update.Message.MessageID), nil
+}
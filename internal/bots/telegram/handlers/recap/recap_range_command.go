@@ -0,0 +1,205 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// RecapRangeMaxSpan is the maximum span of the chat histories that can be
+// recapped by /recap_range.
+const RecapRangeMaxSpan = time.Duration(RecapCustomHoursMax) * time.Hour
+
+var (
+	errInvalidRecapRange  = errors.New("invalid recap range")
+	errRecapRangeInverted = errors.New("recap range start is not before the end")
+	errRecapRangeTooLong  = errors.New("recap range too long")
+)
+
+// recapRangeDateTimeLayouts are the layouts accepted by /recap_range besides
+// RFC 3339, they are interpreted in the configured timezone.
+var recapRangeDateTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseRecapRangeTime parses one of the timestamps of /recap_range, dateOnly
+// tells whether only the date was given.
+func parseRecapRangeTime(value string, location *time.Location) (t time.Time, dateOnly bool, err error) {
+	t, err = time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, false, nil
+	}
+
+	for _, layout := range recapRangeDateTimeLayouts {
+		t, err = time.ParseInLocation(layout, value, location)
+		if err == nil {
+			return t, layout == "2006-01-02", nil
+		}
+	}
+
+	return time.Time{}, false, fmt.Errorf("%w: %q", errInvalidRecapRange, value)
+}
+
+// parseRecapRangeArguments parses the start and the end of /recap_range, an
+// end with only the date covers the whole day, so that both of the arguments
+// being the same date recaps that day.
+func parseRecapRangeArguments(arg string, location *time.Location) (start time.Time, end time.Time, err error) {
+	fields := strings.Fields(arg)
+	if len(fields) != 2 {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: expected 2 arguments, got %d", errInvalidRecapRange, len(fields))
+	}
+
+	start, _, err = parseRecapRangeTime(fields[0], location)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end, dateOnly, err := parseRecapRangeTime(fields[1], location)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if dateOnly {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %s, %s", errRecapRangeInverted, start, end)
+	}
+
+	if end.Sub(start) > RecapRangeMaxSpan {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %s", errRecapRangeTooLong, end.Sub(start))
+	}
+
+	return start, end, nil
+}
+
+func (h *CommandHandler) handleRecapRangeCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
+	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
+		return nil, tgbot.NewMessageError(recapT(c, nil, "onlyGroups")).WithReply(c.Update.Message)
+	}
+
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+
+	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, nil, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	if !has {
+		return nil, tgbot.
+			NewMessageError(recapT(c, nil, "notEnabled")).
+			WithReply(c.Update.Message)
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, nil, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.checkManualRecapMinRole(c, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if tgchats.IsRecapCollectOnly(options) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "collectOnly")).
+			WithReply(c.Update.Message)
+	}
+
+	if manualRecapSendMode(options) == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "rangeNotSupportedInPrivateMode")).
+			WithReply(c.Update.Message)
+	}
+
+	location := h.config.Location()
+
+	start, end, err := parseRecapRangeArguments(c.Update.Message.CommandArguments(), location)
+	if err != nil {
+		message := recapT(c, options, "rangeUsage")
+
+		switch {
+		case errors.Is(err, errRecapRangeInverted):
+			message = recapT(c, options, "rangeInverted")
+		case errors.Is(err, errRecapRangeTooLong):
+			message = recapT(c, options, "rangeTooLong", i18n.M{"MaxHours": RecapCustomHoursMax})
+		}
+
+		return nil, tgbot.
+			NewMessageError(message).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	if !h.isExemptFromManualRecapRateLimit(c, options) {
+		rateLimitInterval := h.tgchats.ManualRecapRatePerSeconds(options)
+
+		// shares the rate limit with /recap
+		_, ttl, ok, err := c.RateLimitForCommand(chatID, "/recap", 1, rateLimitInterval)
+		if err != nil {
+			h.logger.Error("failed to check rate limit for command /recap_range", zap.Error(err))
+		}
+
+		if !ok {
+			rateLimitIntervalMinutes := lo.Ternary(rateLimitInterval/time.Minute <= 1, 1, rateLimitInterval/time.Minute)
+
+			return nil, tgbot.
+				NewMessageError(recapT(c, options, "rateLimitExceeded", i18n.M{
+					"Minutes":           int64(rateLimitIntervalMinutes),
+					"SecondsToBeWaited": int64(math.Ceil(ttl.Seconds())),
+				})).
+				WithReply(c.Update.Message)
+		}
+	}
+
+	histories, err := h.chathistories.FindChatHistoriesBetween(chatID, start, end)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "failedToGenerate")).
+			WithReply(c.Update.Message)
+	}
+
+	histories = chathistories.FilterBotChatHistories(histories, options.IncludeBotMessages)
+	histories = chathistories.FilterExcludedChatHistories(histories, tgchat.MessageTypes(options.ExcludedMessageTypes))
+	histories, activityCount := chathistories.FilterShortChatHistories(histories, options.MinMessageLengthForSummary, options.CountShortMessagesForActivity)
+
+	rangeText := recapT(c, options, "range", i18n.M{
+		"Start": start.In(location).Format("2006-01-02 15:04"),
+		"End":   end.In(location).Format("2006-01-02 15:04"),
+	})
+
+	if activityCount <= 5 || len(histories) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "rangeNotEnoughHistories", i18n.M{"Range": rangeText, "Count": activityCount})).
+			WithReply(c.Update.Message)
+	}
+
+	windowHours := int64(math.Ceil(end.Sub(start).Hours()))
+
+	return h.summarizeAndSendManualRecap(c, options, histories, windowHours, recapT(c, options, "rangeInProgress", i18n.M{"Range": rangeText, "Count": len(histories)}))
+}
@@ -0,0 +1,59 @@
+package recap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecapRangeArguments(t *testing.T) {
+	location := time.FixedZone("Local", 8*60*60)
+
+	t.Run("Dates", func(t *testing.T) {
+		start, end, err := parseRecapRangeArguments("2024-01-02 2024-01-02", location)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, location), start)
+		assert.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, location), end)
+	})
+
+	t.Run("DateTimes", func(t *testing.T) {
+		start, end, err := parseRecapRangeArguments(" 2024-01-02T09:30  2024-01-02T18:00:15 ", location)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 2, 9, 30, 0, 0, location), start)
+		assert.Equal(t, time.Date(2024, 1, 2, 18, 0, 15, 0, location), end)
+	})
+
+	t.Run("RFC3339", func(t *testing.T) {
+		start, end, err := parseRecapRangeArguments("2024-01-02T00:00:00Z 2024-01-02T12:00:00+08:00", location)
+		require.NoError(t, err)
+		assert.True(t, start.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+		assert.True(t, end.Equal(time.Date(2024, 1, 2, 4, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, arg := range []string{"", "2024-01-02", "2024-01-02 2024-01-03 2024-01-04", "yesterday today", "2024-13-01 2024-13-02"} {
+			_, _, err := parseRecapRangeArguments(arg, location)
+			require.ErrorIs(t, err, errInvalidRecapRange, arg)
+		}
+	})
+
+	t.Run("Inverted", func(t *testing.T) {
+		for _, arg := range []string{"2024-01-03 2024-01-02", "2024-01-02T12:00 2024-01-02T12:00", "2024-01-02T12:00 2024-01-02T09:00"} {
+			_, _, err := parseRecapRangeArguments(arg, location)
+			require.ErrorIs(t, err, errRecapRangeInverted, arg)
+		}
+	})
+
+	t.Run("TooLong", func(t *testing.T) {
+		_, _, err := parseRecapRangeArguments("2024-01-01 2024-01-03", location)
+		require.NoError(t, err)
+
+		_, _, err = parseRecapRangeArguments("2024-01-01 2024-01-04", location)
+		require.ErrorIs(t, err, errRecapRangeTooLong)
+
+		_, _, err = parseRecapRangeArguments("2024-01-01T00:00 2024-01-04T00:01", location)
+		require.ErrorIs(t, err, errRecapRangeTooLong)
+	})
+}
@@ -0,0 +1,51 @@
+package recap
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+)
+
+func (h *CommandHandler) handleSetRecapRateLimitExemptAdminsCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "rateLimitExemptAdmins.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	exempt, ok, err := parseOnOffArgument(c.Update.Message.CommandArguments())
+	if err != nil || !ok {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "rateLimitExemptAdmins.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetManualRecapRateLimitExemptAdmins(chatID, exempt)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "rateLimitExemptAdmins.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.
+		NewMessageReplyTo(lo.Ternary(exempt,
+			recapT(c, messageOptions, "rateLimitExemptAdmins.on"),
+			recapT(c, messageOptions, "rateLimitExemptAdmins.off"),
+		), c.Update.Message.MessageID), nil
+}
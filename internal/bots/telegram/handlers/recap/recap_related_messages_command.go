@@ -0,0 +1,76 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// parseRecapRelatedMessagesCount parses the related messages count argument,
+// empty argument disables the related messages and is represented as 0.
+func parseRecapRelatedMessagesCount(arg string) (int, error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return 0, nil
+	}
+
+	count, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("invalid related messages count %q", arg)
+	}
+
+	if count < 0 || count > tgchats.RelatedMessagesCountMax {
+		return 0, fmt.Errorf("related messages count %q is out of range", arg)
+	}
+
+	return count, nil
+}
+
+func (h *CommandHandler) handleSetRecapRelatedMessagesCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "relatedMessages.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	count, err := parseRecapRelatedMessagesCount(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "relatedMessages.invalid", i18n.M{"Max": tgchats.RelatedMessagesCountMax})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	count, err = h.tgchats.SetRelatedMessagesCount(chatID, count)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "relatedMessages.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if count == 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "relatedMessages.off"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "relatedMessages.set", i18n.M{"Count": count}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
@@ -0,0 +1,23 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecapRelatedMessagesCount(t *testing.T) {
+	count, err := parseRecapRelatedMessagesCount("")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = parseRecapRelatedMessagesCount(" 3 ")
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	for _, arg := range []string{"-1", "6", "abc"} {
+		_, err = parseRecapRelatedMessagesCount(arg)
+		assert.Error(t, err, arg)
+	}
+}
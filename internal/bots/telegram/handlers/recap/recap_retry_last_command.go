@@ -0,0 +1,252 @@
+package recap
+
+import (
+	"errors"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func (h *CommandHandler) handleRecapRetryLastCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(err.Error()).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(c.T("modules.telegram.recap.retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.checkManualRecapMinRole(c, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if tgchats.IsRecapCollectOnly(options) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "collectOnly")).
+			WithReply(c.Update.Message)
+	}
+
+	failure, err := h.chathistories.FindLastFailedRecap(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	if failure == nil {
+		return c.NewMessageReplyTo(recapT(c, options, "retryLastNoFailure"), c.Update.Message.MessageID), nil
+	}
+
+	histories, err := h.chathistories.FindChatHistoriesBetween(chatID, time.UnixMilli(failure.WindowSince), time.UnixMilli(failure.WindowUntil))
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	histories = chathistories.FilterBotChatHistories(histories, options.IncludeBotMessages)
+	histories = chathistories.FilterExcludedChatHistories(histories, tgchat.MessageTypes(options.ExcludedMessageTypes))
+	histories, _ = chathistories.FilterShortChatHistories(histories, options.MinMessageLengthForSummary, options.CountShortMessagesForActivity)
+
+	if len(histories) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "retryLastHistoriesGone")).
+			WithReply(c.Update.Message)
+	}
+
+	inProgressMessage, err := c.Bot.Send(tgbotapi.MessageConfig{
+		BaseChat: tgbotapi.BaseChat{ChatID: chatID, ReplyToMessageID: c.Update.Message.MessageID},
+		Text: recapT(c, options, "retryLastInProgress", i18n.M{
+			"Hours": failure.WindowHours,
+			"Count": len(histories),
+		}),
+	})
+	if err != nil {
+		h.logger.Error("failed to send in progress message", zap.Error(err))
+	}
+
+	recap, err := h.chathistories.GenerateChatHistoriesRecap(
+		chatID,
+		chatType,
+		histories,
+		chathistories.WithSummarizeChatHistoriesPersona(options.RecapPersona),
+		chathistories.WithSummarizeChatHistoriesTemperature(options.SummaryTemperature),
+		chathistories.WithSummarizeChatHistoriesMaxTokens(options.SummaryMaxTokens),
+		chathistories.WithSummarizeChatHistoriesOutputFormat(tgchat.RecapOutputFormat(options.RecapOutputFormat)),
+		chathistories.WithSummarizeChatHistoriesLanguages(tgchats.ParseSummaryLanguages(options.SummaryLanguages)),
+		chathistories.WithSummarizeChatHistoriesTopKeywords(options.TopKeywordsCount),
+		chathistories.WithSummarizeChatHistoriesRelatedMessages(options.RelatedMessagesCount),
+		chathistories.WithSummarizeChatHistoriesIncrementalRecap(options.IncrementalRecap),
+		chathistories.WithSummarizeChatHistoriesDedupForwards(options.DedupForwards),
+		chathistories.WithSummarizeChatHistoriesAnonymizeParticipants(options.AnonymizeParticipants),
+		chathistories.WithSummarizeChatHistoriesWindow(failure.WindowHours, failure.IsAutoRecap),
+	)
+	if err == nil && recap.HeldForModeration {
+		err = chathistories.ErrChatHistoriesRecapHeldForModeration
+	}
+
+	if inProgressMessage.MessageID != 0 {
+		c.Bot.MayRequest(tgbotapi.NewDeleteMessage(chatID, inProgressMessage.MessageID))
+	}
+
+	if err != nil {
+		h.logger.Error("failed to retry the last failed recap",
+			zap.Int64("chat_id", chatID),
+			zap.String("failure_id", failure.ID.String()),
+			zap.Error(err),
+		)
+
+		return nil, retryLastFailedRecapError(c, options, err)
+	}
+
+	summarizations := recaprender.RenderSummariesToHTML(recap.Summarizations)
+	if len(summarizations) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "retryLastNoTopics")).
+			WithReply(c.Update.Message)
+	}
+
+	if failure.IsAutoRecap {
+		return h.republishLastFailedAutoRecap(c, options, failure, recap)
+	}
+
+	logID, err := h.chathistories.SaveOneChatHistoriesRecap(recap)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	counts, err := h.chathistories.FindFeedbackRecapsReactionCountsForChatIDAndLogID(chatID, logID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	inlineKeyboardMarkup, err := h.chathistories.NewVoteRecapInlineKeyboardMarkup(c.Bot, chatID, logID, counts.UpVotes, counts.DownVotes, counts.Lmao)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.chathistories.ResolveChatHistoriesRecapFailure(failure.ID)
+	if err != nil {
+		h.logger.Error("failed to resolve chat histories recap failure",
+			zap.Int64("chat_id", chatID),
+			zap.String("failure_id", failure.ID.String()),
+			zap.Error(err),
+		)
+	}
+
+	earliestChattedAt, latestChattedAt := chathistories.ChatHistoriesChattedAtRange(histories)
+	language := tgchats.RecapDisplayLanguage(h.tgchats.FindRecapLanguageForGroups(chatID), options)
+
+	summarizationBatches := recaprender.SplitIntoPages(summarizations, options.PerTopicMessages)
+	for i, b := range summarizationBatches {
+		content := recaprender.BuildTelegramMessage(b, recaprender.MessageOptions{
+			Header:   tgchats.FormatRecapDisclaimer(options) + h.chathistories.FormatChatHistoriesChattedAtRange(earliestChattedAt, latestChattedAt, language),
+			ChatType: chatType,
+			Page:     i + 1,
+			Pages:    len(summarizationBatches),
+			Hashtags: h.config.Recap.Hashtags,
+			Texts:    recapTexts(c, options),
+		})
+
+		msg := recaprender.NewMessage(chatID, content, tgchats.RecapLinkPreviewEnabled(options))
+		msg.ReplyMarkup = inlineKeyboardMarkup
+		msg.ReplyToMessageID = c.Update.Message.MessageID
+
+		c.Bot.MaySendThreadMessage(newManualRecapThreadMessage(chatID, msg, options))
+	}
+
+	return nil, nil
+}
+
+// republishLastFailedAutoRecap hands the regenerated auto recap over to the
+// auto recap service, so that it is published the same way as the scheduled
+// ones, to the recap target chat and the subscribers.
+func (h *CommandHandler) republishLastFailedAutoRecap(
+	c *tgbot.Context,
+	options *ent.TelegramChatRecapsOptions,
+	failure *ent.LogChatHistoriesRecapFailures,
+	recap *chathistories.ChatHistoriesRecap,
+) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+
+	approvalID, err := h.chathistories.SaveOneChatHistoriesRecapApproval(recap, time.Duration(h.config.Recap.ApprovalExpiryHours)*time.Hour)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.chathistories.QueueOneApprovedChatHistoriesRecap(chatID, approvalID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "retryLastUnavailable")).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.chathistories.ResolveChatHistoriesRecapFailure(failure.ID)
+	if err != nil {
+		h.logger.Error("failed to resolve chat histories recap failure",
+			zap.Int64("chat_id", chatID),
+			zap.String("failure_id", failure.ID.String()),
+			zap.Error(err),
+		)
+	}
+
+	return c.NewMessageReplyTo(recapT(c, options, "retryLastAutoRecapQueued"), c.Update.Message.MessageID), nil
+}
+
+// retryLastFailedRecapError maps the error of the retry to the message to
+// reply with, the raw error is only logged.
+func retryLastFailedRecapError(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, err error) error {
+	if message, ok := recapModerationErrorMessage(c, options, err); ok {
+		return tgbot.
+			NewMessageError(message).
+			WithReply(c.Update.Message)
+	}
+
+	return tgbot.
+		NewMessageError(recapT(c, options, "retryLastStillFailed")).
+		WithReply(c.Update.Message)
+}
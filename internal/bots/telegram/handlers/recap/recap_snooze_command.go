@@ -0,0 +1,172 @@
+package recap
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+const (
+	recapSnoozeMaxDuration = 30 * 24 * time.Hour
+)
+
+var (
+	errInvalidRecapDuration = errors.New("invalid recap duration")
+)
+
+// parseRecapSnoozeDuration parses durations like 30m, 2h or 3d.
+func parseRecapSnoozeDuration(s string) (time.Duration, error) {
+	return parseRecapDuration(s, recapSnoozeMaxDuration)
+}
+
+// parseRecapDuration parses durations like 30m, 2h or 3d, which are at least
+// one minute and at most maxDuration.
+func parseRecapDuration(s string, maxDuration time.Duration) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return 0, errInvalidRecapDuration
+	}
+
+	var (
+		duration time.Duration
+		err      error
+	)
+
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		var parsedDays int64
+
+		parsedDays, err = strconv.ParseInt(days, 10, 64)
+		duration = time.Duration(parsedDays) * 24 * time.Hour
+	} else {
+		duration, err = time.ParseDuration(s)
+	}
+
+	if err != nil || duration < time.Minute || duration > maxDuration {
+		return 0, errInvalidRecapDuration
+	}
+
+	return duration, nil
+}
+
+func (h *CommandHandler) notifyAutoRecapsSubscribers(c *tgbot.Context, chatID int64, text string) {
+	subscribers, err := h.tgchats.FindAutoRecapsSubscribers(chatID)
+	if err != nil {
+		h.logger.Error("failed to find auto recaps subscribers", zap.Int64("chat_id", chatID), zap.Error(err))
+		return
+	}
+
+	for _, subscriber := range subscribers {
+		msg := tgbotapi.NewMessage(subscriber.UserID, text)
+		msg.ParseMode = tgbotapi.ModeHTML
+
+		_, err = c.Bot.Send(msg)
+		if err != nil && !c.Bot.IsCannotInitiateChatWithUserErr(err) && !c.Bot.IsBotWasBlockedByTheUserErr(err) {
+			h.logger.Error("failed to send private message to subscriber",
+				zap.Int64("user_id", subscriber.UserID),
+				zap.Int64("chat_id", chatID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (h *CommandHandler) handleRecapSnoozeCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "snooze.snoozeFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	duration, err := parseRecapSnoozeDuration(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "snooze.invalidDuration")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	snoozedUntil := time.Now().Add(duration)
+
+	err = h.tgchats.SetAutoRecapsSnoozedUntil(chatID, snoozedUntil.UnixMilli())
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "snooze.snoozeFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	h.notifyAutoRecapsSubscribers(c, chatID, recapT(c, messageOptions, "snooze.subscribersSnoozed", i18n.M{
+		"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle),
+		"Duration":  formatDurationUntil(c, messageOptions, snoozedUntil),
+	}))
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "snooze.snoozed", i18n.M{"Duration": formatDurationUntil(c, messageOptions, snoozedUntil)}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
+
+func (h *CommandHandler) handleRecapUnsnoozeCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "snooze.unsnoozeFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "snooze.unsnoozeFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	if !tgchats.IsAutoRecapsSnoozed(options, time.Now()) {
+		return c.NewMessageReplyTo(recapT(c, options, "snooze.notSnoozed"), c.Update.Message.MessageID), nil
+	}
+
+	err = h.tgchats.SetAutoRecapsSnoozedUntil(chatID, 0)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "snooze.unsnoozeFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	h.notifyAutoRecapsSubscribers(c, chatID, recapT(c, options, "snooze.subscribersUnsnoozed", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle)}))
+
+	return c.NewMessageReplyTo(recapT(c, options, "snooze.unsnoozed"), c.Update.Message.MessageID), nil
+}
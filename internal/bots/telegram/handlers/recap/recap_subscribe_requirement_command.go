@@ -0,0 +1,108 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+var subscribeRecapMemberStatusRequirementNames = map[string]tgchat.SubscribeRecapMemberStatusRequirement{
+	"member":       tgchat.SubscribeRecapMemberStatusRequirementMember,
+	"unrestricted": tgchat.SubscribeRecapMemberStatusRequirementUnrestricted,
+	"admin":        tgchat.SubscribeRecapMemberStatusRequirementAdministrator,
+}
+
+// parseRecapSubscribeRequirement parses the arguments in form of
+// "<days> [member|unrestricted|admin]", empty arguments reset the requirements.
+func parseRecapSubscribeRequirement(args string) (int, tgchat.SubscribeRecapMemberStatusRequirement, error) {
+	fields := strings.Fields(args)
+	if len(fields) == 0 {
+		return 0, tgchat.SubscribeRecapMemberStatusRequirementMember, nil
+	}
+	if len(fields) > 2 {
+		return 0, 0, fmt.Errorf("too many arguments %q", args)
+	}
+
+	days, err := strconv.Atoi(fields[0])
+	if err != nil || days < 0 {
+		return 0, 0, fmt.Errorf("invalid days %q", fields[0])
+	}
+	if len(fields) == 1 {
+		return days, tgchat.SubscribeRecapMemberStatusRequirementMember, nil
+	}
+
+	status, ok := subscribeRecapMemberStatusRequirementNames[strings.ToLower(fields[1])]
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid member status %q", fields[1])
+	}
+
+	return days, status, nil
+}
+
+// formatSubscribeRecapRequirements formats the requirements of subscribing
+// the recaps of the chat in the locale of options.
+func formatSubscribeRecapRequirements(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, days int, status tgchat.SubscribeRecapMemberStatusRequirement) string {
+	if days <= 0 && status == tgchat.SubscribeRecapMemberStatusRequirementMember {
+		return "<b>" + recapT(c, options, "subscribeRequirement.unlimited") + "</b>"
+	}
+	if days <= 0 {
+		return "<b>" + recapSubscribeRequirementText(c, options, status) + "</b>"
+	}
+
+	return "<b>" + recapT(c, options, "subscribeRequirement.withDays", i18n.M{
+		"Status": recapSubscribeRequirementText(c, options, status),
+		"Days":   days,
+	}) + "</b>"
+}
+
+func (h *CommandHandler) handleSetRecapSubscribeRequirementCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribeRequirement.setFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	days, status, err := parseRecapSubscribeRequirement(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "subscribeRequirement.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetSubscribeRecapRequirements(chatID, days, status)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribeRequirement.setFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	if days == 0 && status == tgchat.SubscribeRecapMemberStatusRequirementMember {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "subscribeRequirement.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "subscribeRequirement.set", i18n.M{
+			"Requirements": formatSubscribeRecapRequirements(c, messageOptions, days, status),
+		}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
@@ -0,0 +1,147 @@
+package recap
+
+import (
+	"errors"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/nekomeowww/fo"
+	"github.com/nekomeowww/xo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// recapSubscribeUserTarget returns the member that /subscribe_user subscribes
+// for, which is the sender of the message replied by the command. The reason
+// of rejection will be returned if there is no such member.
+func recapSubscribeUserTarget(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, message *tgbotapi.Message) (*tgbotapi.User, string) {
+	if message == nil || message.ReplyToMessage == nil {
+		return nil, recapT(c, options, "subscribeUser.replyRequired")
+	}
+
+	replyTo := message.ReplyToMessage
+	if replyTo.SenderChat != nil || replyTo.From == nil {
+		return nil, recapT(c, options, "subscribeUser.senderChat")
+	}
+	if replyTo.From.IsBot {
+		return nil, recapT(c, options, "subscribeUser.bot")
+	}
+
+	return replyTo.From, ""
+}
+
+func (h *CommandHandler) handleSubscribeUserCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribeUser.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	target, reason := recapSubscribeUserTarget(c, messageOptions, c.Update.Message)
+	if target == nil {
+		return nil, tgbot.NewMessageError(reason).WithReply(c.Update.Message)
+	}
+
+	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribeUser.failed")).
+			WithReply(c.Update.Message)
+	}
+	if !has {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "subscribeUser.notEnabled")).
+			WithReply(c.Update.Message)
+	}
+
+	reason, err = h.checkSubscribeRecapRequirementsOfUser(c, chatID, target.ID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribeUser.failed")).
+			WithReply(c.Update.Message)
+	}
+	if reason != "" {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "subscribeUser.rejected", i18n.M{"Name": tgbot.FullNameFromFirstAndLastName(target.FirstName, target.LastName), "Reason": reason})).
+			WithReply(c.Update.Message)
+	}
+
+	// The subscription is only recorded once the bot is able to reach the
+	// member in private, which also lets the member know about it and
+	// unsubscribe whenever they want.
+	msg := tgbotapi.NewMessage(target.ID, recapT(c, messageOptions, "subscribeUser.notice", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle)}))
+	msg.ParseMode = tgbotapi.ModeHTML
+
+	_, err = c.Bot.Send(msg)
+	if err == nil {
+		err = h.tgchats.SubscribeToAutoRecaps(chatID, target.ID)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(recapT(c, messageOptions, "subscribeUser.failed")).
+				WithReply(c.Update.Message)
+		}
+
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "subscribeUser.subscribed", i18n.M{"Name": tgbot.FullNameFromFirstAndLastName(target.FirstName, target.LastName)}), c.Update.Message.MessageID), nil
+	}
+
+	if !c.Bot.IsCannotInitiateChatWithUserErr(err) && !c.Bot.IsBotWasBlockedByTheUserErr(err) {
+		h.logger.Error("failed to send private message to user",
+			zap.String("message", xo.SprintJSON(msg)),
+			zap.Int64("chat_id", target.ID),
+			zap.Error(err),
+		)
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribeUser.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	hashKey, err := h.setSubscribeStartCommandContext(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribeUser.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	guidance := newSubscribeRecapCommandWhenUserNeverStartedChat(c, messageOptions, hashKey)
+	if c.Bot.IsBotWasBlockedByTheUserErr(err) {
+		guidance = newSubscribeRecapCommandWhenUserBlockedMessage(c, messageOptions, hashKey)
+	}
+
+	// The guidance is addressed to the member, so it replies to the message of
+	// the member rather than the command, and it will be cleaned up once the
+	// member has subscribed through the link in it.
+	guidanceMsg := tgbotapi.NewMessage(chatID, guidance)
+	guidanceMsg.ReplyToMessageID = c.Update.Message.ReplyToMessage.MessageID
+	guidanceMsg.ParseMode = tgbotapi.ModeHTML
+
+	sentMsg := c.Bot.MaySend(guidanceMsg)
+
+	may := fo.NewMay0().Use(func(err error, messageArgs ...any) {
+		h.logger.Error("failed to push one delete later message", zap.Error(err))
+	})
+
+	may.Invoke(c.Bot.PushOneDeleteLaterMessage(target.ID, chatID, sentMsg.MessageID))
+
+	return nil, nil
+}
@@ -0,0 +1,45 @@
+package recap
+
+import (
+	"path/filepath"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+func TestRecapSubscribeUserTarget(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+
+	t.Run("Eligible", func(t *testing.T) {
+		target, reason := recapSubscribeUserTarget(c, nil, &tgbotapi.Message{ReplyToMessage: &tgbotapi.Message{From: &tgbotapi.User{ID: 1001, FirstName: "Alice"}}})
+		assert.Empty(t, reason)
+		if assert.NotNil(t, target) {
+			assert.Equal(t, int64(1001), target.ID)
+		}
+	})
+
+	t.Run("Ineligible", func(t *testing.T) {
+		messages := []*tgbotapi.Message{
+			nil,
+			{},
+			{ReplyToMessage: &tgbotapi.Message{}},
+			{ReplyToMessage: &tgbotapi.Message{From: &tgbotapi.User{ID: 1002, IsBot: true}}},
+			{ReplyToMessage: &tgbotapi.Message{From: &tgbotapi.User{ID: 136817688, IsBot: true}, SenderChat: &tgbotapi.Chat{ID: -1001}}},
+			{ReplyToMessage: &tgbotapi.Message{From: &tgbotapi.User{ID: 1087968824, IsBot: true, UserName: "GroupAnonymousBot", FirstName: "Group"}}},
+		}
+
+		for _, message := range messages {
+			target, reason := recapSubscribeUserTarget(c, nil, message)
+			assert.Nil(t, target)
+			assert.NotEmpty(t, reason)
+		}
+	})
+}
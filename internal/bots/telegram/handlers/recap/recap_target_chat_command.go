@@ -0,0 +1,93 @@
+package recap
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func (h *CommandHandler) handleSetRecapTargetChatCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "targetChat.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	args := strings.TrimSpace(c.Update.Message.CommandArguments())
+	if args == "" {
+		args = "0"
+	}
+
+	targetChatID, err := strconv.ParseInt(args, 10, 64)
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "targetChat.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	if targetChatID == 0 || targetChatID == chatID {
+		err = h.tgchats.SetRecapTargetChatID(chatID, 0)
+		if err != nil {
+			return nil, tgbot.
+				NewExceptionError(err).
+				WithMessage(recapT(c, messageOptions, "targetChat.failed")).
+				WithReply(c.Update.Message)
+		}
+
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "targetChat.reset"), c.Update.Message.MessageID), nil
+	}
+
+	if c.Bot.IsGroupAnonymousBot(c.Update.Message.From) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "targetChat.anonymousAdmin")).
+			WithReply(c.Update.Message)
+	}
+
+	isBotMember, err := c.Bot.IsUserMemberStatus(targetChatID, c.Bot.Self.ID, []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+		telegram.MemberStatusMember,
+	})
+	if err != nil || !isBotMember {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "targetChat.botNotMember")).
+			WithReply(c.Update.Message)
+	}
+
+	isUserAdmin, err := c.Bot.IsUserMemberStatus(targetChatID, c.Update.Message.From.ID, []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+	})
+	if err != nil || !isUserAdmin {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "targetChat.permissionDenied")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetRecapTargetChatID(chatID, targetChatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "targetChat.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.NewMessageReplyTo(recapT(c, messageOptions, "targetChat.set"), c.Update.Message.MessageID), nil
+}
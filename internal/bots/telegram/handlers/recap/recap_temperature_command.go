@@ -0,0 +1,77 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// parseRecapTemperature parses the temperature argument, empty argument
+// resets the temperature and is represented as -1.
+func parseRecapTemperature(arg string) (float64, error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return -1, nil
+	}
+
+	temperature, err := strconv.ParseFloat(arg, 64)
+	if err != nil || math.IsNaN(temperature) || math.IsInf(temperature, 0) {
+		return 0, fmt.Errorf("invalid temperature %q", arg)
+	}
+
+	if temperature < 0 {
+		return 0, fmt.Errorf("temperature %q is negative", arg)
+	}
+
+	return tgchats.ClampSummaryTemperature(temperature), nil
+}
+
+func (h *CommandHandler) handleSetRecapTemperatureCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "temperature.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	temperature, err := parseRecapTemperature(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "temperature.invalid", i18n.M{"Min": float64(tgchats.SummaryTemperatureMin), "Max": tgchats.SummaryTemperatureMax})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	temperature, err = h.tgchats.SetSummaryTemperature(chatID, temperature)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "temperature.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if temperature < 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "temperature.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, messageOptions, "temperature.set", i18n.M{"Temperature": temperature}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
@@ -0,0 +1,106 @@
+package recap
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+)
+
+var errInvalidRecapThreadID = errors.New("invalid recap thread id")
+
+// parseRecapThreadIDArgument parses the argument of /set_recap_thread, off
+// means the recaps are sent as usual.
+func parseRecapThreadIDArgument(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "off") {
+		return 0, nil
+	}
+
+	threadID, err := strconv.Atoi(s)
+	if err != nil || threadID <= 0 {
+		return 0, errInvalidRecapThreadID
+	}
+
+	return threadID, nil
+}
+
+// newManualRecapThreadMessage sends the manual recap of the chat into the
+// recap thread configured for it instead of replying to the request, which
+// may be in another thread. The recaps sent to anywhere other than the chat
+// itself, such as the private chat of the requester, have no thread.
+func newManualRecapThreadMessage(chatID int64, msg tgbotapi.MessageConfig, options *ent.TelegramChatRecapsOptions) tgbot.ThreadMessageConfig {
+	threadID := tgchats.RecapThreadID(options)
+	if threadID == 0 || msg.ChatID != chatID {
+		return tgbot.NewThreadMessageConfig(msg, 0)
+	}
+
+	msg.ReplyToMessageID = 0
+
+	return tgbot.NewThreadMessageConfig(msg, threadID)
+}
+
+func (h *CommandHandler) handleSetRecapThreadCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "thread.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	threadID, err := parseRecapThreadIDArgument(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "thread.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	if threadID != 0 {
+		// Telegram provides no way to look up a topic, so the thread is validated
+		// by sending the confirmation into it.
+		_, err = c.Bot.SendThreadMessage(tgbot.NewThreadMessageConfig(tgbotapi.NewMessage(chatID, recapT(c, messageOptions, "thread.confirmation")), threadID))
+		if err != nil {
+			h.logger.Warn("failed to send message into recap thread",
+				zap.Int64("chat_id", chatID),
+				zap.Int("thread_id", threadID),
+				zap.Error(err),
+			)
+
+			return nil, tgbot.
+				NewMessageError(recapT(c, messageOptions, "thread.unavailable")).
+				WithReply(c.Update.Message)
+		}
+	}
+
+	err = h.tgchats.SetRecapThreadID(chatID, threadID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "thread.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if threadID == 0 {
+		return c.NewMessageReplyTo(recapT(c, messageOptions, "thread.reset"), c.Update.Message.MessageID), nil
+	}
+
+	return c.NewMessageReplyTo(recapT(c, messageOptions, "thread.set"), c.Update.Message.MessageID), nil
+}
@@ -0,0 +1,58 @@
+package recap
+
+import (
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+func TestParseRecapThreadIDArgument(t *testing.T) {
+	threadID, err := parseRecapThreadIDArgument(" 7 ")
+	require.NoError(t, err)
+	assert.Equal(t, 7, threadID)
+
+	threadID, err = parseRecapThreadIDArgument("OFF")
+	require.NoError(t, err)
+	assert.Zero(t, threadID)
+
+	for _, arg := range []string{"", "0", "-1", "general"} {
+		_, err = parseRecapThreadIDArgument(arg)
+		assert.ErrorIs(t, err, errInvalidRecapThreadID, arg)
+	}
+}
+
+func TestNewManualRecapThreadMessage(t *testing.T) {
+	options := &ent.TelegramChatRecapsOptions{RecapThreadID: 7}
+
+	t.Run("InThread", func(t *testing.T) {
+		msg := tgbotapi.NewMessage(-100123456789, "recap")
+		msg.ReplyToMessageID = 42
+
+		config := newManualRecapThreadMessage(-100123456789, msg, options)
+		assert.Equal(t, 7, config.MessageThreadID)
+		assert.Zero(t, config.ReplyToMessageID)
+		assert.Equal(t, "recap", config.Text)
+	})
+
+	t.Run("WithoutThread", func(t *testing.T) {
+		msg := tgbotapi.NewMessage(-100123456789, "recap")
+		msg.ReplyToMessageID = 42
+
+		config := newManualRecapThreadMessage(-100123456789, msg, &ent.TelegramChatRecapsOptions{})
+		assert.Zero(t, config.MessageThreadID)
+		assert.Equal(t, 42, config.ReplyToMessageID)
+	})
+
+	t.Run("PrivateChat", func(t *testing.T) {
+		msg := tgbotapi.NewMessage(123456789, "recap")
+		msg.ReplyToMessageID = 42
+
+		config := newManualRecapThreadMessage(-100123456789, msg, options)
+		assert.Zero(t, config.MessageThreadID)
+		assert.Equal(t, 42, config.ReplyToMessageID)
+	})
+}
@@ -0,0 +1,252 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+const (
+	recapTopicDefaultHour      int64 = 24
+	recapTopicKeywordMaxLength       = 32
+)
+
+// parseRecapTopicArguments parses the arguments in form of
+// "<keyword> [hours]", the trailing number is treated as hours only if it is
+// one of RecapSelectHourAvailable, otherwise it is a part of the keyword.
+func parseRecapTopicArguments(args string) (string, int64, error) {
+	fields := strings.Fields(args)
+	hour := recapTopicDefaultHour
+
+	if len(fields) > 1 {
+		parsedHour, err := strconv.ParseInt(fields[len(fields)-1], 10, 64)
+		if err == nil && lo.Contains(RecapSelectHourAvailable, parsedHour) {
+			hour = parsedHour
+			fields = fields[:len(fields)-1]
+		}
+	}
+
+	keyword := strings.Join(fields, " ")
+	if keyword == "" {
+		return "", 0, errors.New("empty keyword")
+	}
+	if utf8.RuneCountInString(keyword) > recapTopicKeywordMaxLength {
+		return "", 0, fmt.Errorf("keyword %q is too long", keyword)
+	}
+
+	return keyword, hour, nil
+}
+
+func (h *CommandHandler) handleRecapTopicCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	chatTitle := c.Update.Message.Chat.Title
+	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	has, err := h.tgchats.HasChatHistoriesRecapEnabledForGroups(chatID, chatTitle)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if !has {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "topic.notEnabled")).
+			WithReply(c.Update.Message)
+	}
+
+	keyword, hour, err := parseRecapTopicArguments(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "topic.invalid", i18n.M{
+				"MaxLength": recapTopicKeywordMaxLength,
+				"Hours": strings.Join(lo.Map(RecapSelectHourAvailable, func(item int64, _ int) string {
+					return strconv.FormatInt(item, 10)
+				}), recapT(c, messageOptions, "enumerationSeparator")),
+				"DefaultHours": recapTopicDefaultHour,
+			})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	err = h.checkManualRecapMinRole(c, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if tgchats.IsRecapCollectOnly(options) {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "collectOnly")).
+			WithReply(c.Update.Message)
+	}
+
+	rateLimitInterval := h.tgchats.ManualRecapRatePerSeconds(options)
+
+	_, ttl, ok, err := c.RateLimitForCommand(chatID, "/recap_topic", 1, rateLimitInterval)
+	if err != nil {
+		h.logger.Error("failed to check rate limit for command /recap_topic", zap.Error(err))
+	}
+
+	if !ok {
+		rateLimitIntervalMinutes := lo.Ternary(rateLimitInterval/time.Minute <= 1, 1, rateLimitInterval/time.Minute)
+
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "preview.rateLimitExceeded", i18n.M{
+				"Minutes":  int64(rateLimitIntervalMinutes),
+				"Duration": recapDuration(c, options, ttl),
+			})).
+			WithReply(c.Update.Message)
+	}
+
+	histories, err := h.chathistories.FindChatHistoriesByTimeBeforeMatching(chatID, time.Duration(hour)*time.Hour, keyword)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	histories = chathistories.FilterBotChatHistories(histories, options.IncludeBotMessages)
+	histories = chathistories.FilterExcludedChatHistories(histories, tgchat.MessageTypes(options.ExcludedMessageTypes))
+	histories, activityCount := chathistories.FilterShortChatHistories(histories, options.MinMessageLengthForSummary, options.CountShortMessagesForActivity)
+
+	if activityCount <= 5 || len(histories) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "topic.notEnoughHistories", i18n.M{"Hours": hour, "Count": activityCount, "Keyword": tgbot.EscapeHTMLSymbols(keyword)})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	inProgressMessage, err := c.Bot.Send(tgbotapi.MessageConfig{
+		BaseChat: tgbotapi.BaseChat{ChatID: chatID, ReplyToMessageID: c.Update.Message.MessageID},
+		Text:     recapT(c, options, "topic.inProgress", i18n.M{"Hours": hour, "Keyword": keyword, "Count": len(histories)}),
+	})
+	if err != nil {
+		h.logger.Error("failed to send in progress message", zap.Error(err))
+	}
+
+	logID, summarizations, err := h.chathistories.SummarizeChatHistories(
+		chatID,
+		chatType,
+		histories,
+		chathistories.WithSummarizeChatHistoriesPersona(options.RecapPersona),
+		chathistories.WithSummarizeChatHistoriesTemperature(options.SummaryTemperature),
+		chathistories.WithSummarizeChatHistoriesMaxTokens(options.SummaryMaxTokens),
+		chathistories.WithSummarizeChatHistoriesOutputFormat(tgchat.RecapOutputFormat(options.RecapOutputFormat)),
+		chathistories.WithSummarizeChatHistoriesLanguages(tgchats.ParseSummaryLanguages(options.SummaryLanguages)),
+		chathistories.WithSummarizeChatHistoriesTopKeywords(options.TopKeywordsCount),
+		chathistories.WithSummarizeChatHistoriesRelatedMessages(options.RelatedMessagesCount),
+		chathistories.WithSummarizeChatHistoriesIncrementalRecap(options.IncrementalRecap),
+		chathistories.WithSummarizeChatHistoriesDedupForwards(options.DedupForwards),
+		chathistories.WithSummarizeChatHistoriesAnonymizeParticipants(options.AnonymizeParticipants),
+		chathistories.WithSummarizeChatHistoriesWindow(int(hour), false),
+	)
+
+	if inProgressMessage.MessageID != 0 {
+		c.Bot.MayRequest(tgbotapi.NewDeleteMessage(chatID, inProgressMessage.MessageID))
+	}
+
+	if message, ok := recapModerationErrorMessage(c, options, err); ok {
+		return nil, tgbot.
+			NewMessageError(message).
+			WithReply(c.Update.Message)
+	}
+
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	summarizations = lo.Filter(summarizations, func(item string, _ int) bool { return item != "" })
+	if len(summarizations) == 0 {
+		return nil, tgbot.
+			NewMessageError(recapT(c, options, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	counts, err := h.chathistories.FindFeedbackRecapsReactionCountsForChatIDAndLogID(chatID, logID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	inlineKeyboardMarkup, err := h.chathistories.NewVoteRecapInlineKeyboardMarkup(c.Bot, chatID, logID, counts.UpVotes, counts.DownVotes, counts.Lmao)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, options, "topic.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	for i, s := range summarizations {
+		summarizations[i] = tgbot.ReplaceMarkdownTitlesToTelegramBoldElement(s)
+	}
+
+	earliestChattedAt, latestChattedAt := chathistories.ChatHistoriesChattedAtRange(histories)
+	language := tgchats.RecapDisplayLanguage(h.tgchats.FindRecapLanguageForGroups(chatID), options)
+
+	summarizationBatches := tgbot.SplitMessagesAgainstLengthLimitIntoMessageGroups(summarizations)
+	for i, b := range summarizationBatches {
+		content := fmt.Sprintf("%s\n\n%s%s<blockquote expandable>%s</blockquote>",
+			recapT(c, options, "topicRecapHeader", i18n.M{"Hours": hour, "Keyword": tgbot.EscapeHTMLSymbols(keyword)}),
+			tgchats.FormatRecapDisclaimer(options),
+			h.chathistories.FormatChatHistoriesChattedAtRange(earliestChattedAt, latestChattedAt, language),
+			strings.Join(b, "\n\n"),
+		)
+		if len(summarizationBatches) > 1 {
+			content = fmt.Sprintf("%s\n\n(%d/%d)", content, i+1, len(summarizationBatches))
+		}
+
+		msg := recaprender.NewMessage(chatID, fmt.Sprintf("%s\n\n%s\n<em>🤖️ Generated by chatGPT</em>", content, recaprender.FormatHashtags(h.config.Recap.Hashtags)), tgchats.RecapLinkPreviewEnabled(options))
+		msg.ReplyMarkup = inlineKeyboardMarkup
+		msg.ReplyToMessageID = c.Update.Message.MessageID
+
+		c.Bot.MaySendThreadMessage(newManualRecapThreadMessage(chatID, msg, options))
+	}
+
+	return nil, nil
+}
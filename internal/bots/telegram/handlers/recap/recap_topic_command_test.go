@@ -0,0 +1,38 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecapTopicArguments(t *testing.T) {
+	keyword, hour, err := parseRecapTopicArguments("发布")
+	require.NoError(t, err)
+	assert.Equal(t, "发布", keyword)
+	assert.Equal(t, recapTopicDefaultHour, hour)
+
+	keyword, hour, err = parseRecapTopicArguments(" insights  bot 6 ")
+	require.NoError(t, err)
+	assert.Equal(t, "insights bot", keyword)
+	assert.Equal(t, int64(6), hour)
+
+	// numbers that are not available hours are part of the keyword
+	keyword, hour, err = parseRecapTopicArguments("release 2024")
+	require.NoError(t, err)
+	assert.Equal(t, "release 2024", keyword)
+	assert.Equal(t, recapTopicDefaultHour, hour)
+
+	// a single number is always the keyword
+	keyword, hour, err = parseRecapTopicArguments("12")
+	require.NoError(t, err)
+	assert.Equal(t, "12", keyword)
+	assert.Equal(t, recapTopicDefaultHour, hour)
+
+	_, _, err = parseRecapTopicArguments("  ")
+	assert.Error(t, err)
+
+	_, _, err = parseRecapTopicArguments("这是一个非常非常非常非常非常非常非常非常非常非常长的关键词而且还要更长")
+	assert.Error(t, err)
+}
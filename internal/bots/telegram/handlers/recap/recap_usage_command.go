@@ -0,0 +1,68 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+func formatRecapUsage(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, usage chathistories.TokenUsage, since time.Time, promptTokenPrice, completionTokenPrice float64) string {
+	lines := []string{
+		recapT(c, options, "usage.title", i18n.M{"Since": since.Format("2006-01-02")}),
+		"",
+		recapT(c, options, "usage.recaps", i18n.M{"Count": usage.Recaps}),
+		recapT(c, options, "usage.promptTokens", i18n.M{"Count": usage.PromptTokens}),
+		recapT(c, options, "usage.completionTokens", i18n.M{"Count": usage.CompletionTokens}),
+		recapT(c, options, "usage.totalTokens", i18n.M{"Count": usage.TotalTokens}),
+	}
+
+	if promptTokenPrice > 0 || completionTokenPrice > 0 {
+		lines = append(lines, recapT(c, options, "usage.estimatedCost", i18n.M{"Cost": fmt.Sprintf("%.4f", usage.EstimatedCost(promptTokenPrice, completionTokenPrice))}))
+	} else {
+		lines = append(lines, recapT(c, options, "usage.priceNotConfigured"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (h *CommandHandler) handleRecapUsageCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "usage.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	location := h.config.Location()
+
+	since := chathistories.StartOfMonth(time.Now().In(location))
+
+	usage, err := h.chathistories.TokenUsageForChat(chatID, since)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "usage.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.
+		NewMessageReplyTo(formatRecapUsage(c, messageOptions, usage, since, h.config.OpenAI.PromptTokenPrice, h.config.OpenAI.CompletionTokenPrice), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
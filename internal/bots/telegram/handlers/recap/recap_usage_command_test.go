@@ -0,0 +1,27 @@
+package recap
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+func TestFormatRecapUsage(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+	since := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	usage := chathistories.TokenUsage{Recaps: 3, PromptTokens: 2_000_000, CompletionTokens: 500_000, TotalTokens: 2_500_000}
+
+	assert.Contains(t, formatRecapUsage(c, nil, usage, since, 0.5, 1.5), "预估费用：<b>$1.7500</b>")
+	assert.Contains(t, formatRecapUsage(c, nil, usage, since, 0.5, 1.5), "2024-02-01")
+	assert.Contains(t, formatRecapUsage(c, nil, usage, since, 0, 0), "未配置 token 单价")
+}
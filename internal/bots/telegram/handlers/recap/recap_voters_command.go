@@ -0,0 +1,169 @@
+package recap
+
+import (
+	"fmt"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+var (
+	errRecapVotersAnonymousAdministrator = newRecapOperationError("votersAnonymousAdministrator")
+	errRecapVotersPermissionDenied       = newRecapOperationError("votersAdministratorRequired", errOperationCanNotBeDone, errAdministratorPermissionRequired)
+)
+
+// checkRecapVotersPermission tells whether the member may see who voted on
+// the recaps of the group, the voters are only shown to the administrators
+// to keep the votes of the members private.
+func checkRecapVotersPermission(isGroupAnonymousBot bool, member tgbotapi.ChatMember) error {
+	if isGroupAnonymousBot {
+		return errRecapVotersAnonymousAdministrator
+	}
+
+	if !lo.Contains([]telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+	}, telegram.MemberStatus(member.Status)) {
+		return errRecapVotersPermissionDenied
+	}
+
+	return nil
+}
+
+// formatRecapVoters lists the voters of the recap per reaction type, names
+// holds the display names of the voters, voters without a name are shown by
+// their ids.
+func formatRecapVoters(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, voters chathistories.FeedbackChatHistoriesRecapsVoters, names map[int64]string) string {
+	formatVoters := func(emoji string, userIDs []int64) string {
+		if len(userIDs) == 0 {
+			return recapT(c, options, "voters.noVoters", i18n.M{"Emoji": emoji})
+		}
+
+		return recapT(c, options, "voters.list", i18n.M{"Emoji": emoji, "Count": len(userIDs), "Voters": strings.Join(lo.Map(userIDs, func(userID int64, _ int) string {
+			name, ok := names[userID]
+			if !ok || name == "" {
+				return fmt.Sprintf(`<a href="tg://user?id=%d"><code>%d</code></a>`, userID, userID)
+			}
+
+			return fmt.Sprintf(`<a href="tg://user?id=%d">%s</a>`, userID, tgbot.EscapeHTMLSymbols(name))
+		}), recapT(c, options, "enumerationSeparator"))})
+	}
+
+	return strings.Join([]string{
+		recapT(c, options, "voters.title"),
+		"",
+		formatVoters("👍", voters.UpVotes),
+		formatVoters("👎", voters.DownVotes),
+		formatVoters("🤣", voters.Lmao),
+	}, "\n")
+}
+
+func (h *CommandHandler) handleRecapVotersCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	replyToMessage := c.Update.Message.ReplyToMessage
+	if replyToMessage == nil || replyToMessage.From == nil || replyToMessage.From.ID != c.Bot.Self.ID {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "voters.replyRequired")).
+			WithReply(c.Update.Message)
+	}
+
+	data, err := findRecapFeedbackActionDataFromMessage(c.Bot, replyToMessage)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "voters.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	if data == nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "voters.unrecognized")).
+			WithReply(c.Update.Message)
+	}
+
+	// the recap may be replied in another chat such as the private chat with
+	// the bot, the replies are localized in the locale of the recapped chat
+	if data.ChatID != chatID {
+		messageOptions = h.recapsOptionForMessages(data.ChatID)
+	}
+
+	fromID := c.Update.Message.From.ID
+
+	member, err := c.Bot.GetChatMember(tgbotapi.GetChatMemberConfig{ChatConfigWithUser: tgbotapi.ChatConfigWithUser{ChatID: data.ChatID, UserID: fromID}})
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "voters.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	err = checkRecapVotersPermission(c.Bot.IsGroupAnonymousBot(c.Update.Message.From), member)
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	logID, err := uuid.Parse(data.LogID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "voters.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	voters, err := h.chathistories.FindVotersForLogID(logID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "voters.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	names := make(map[int64]string)
+
+	for _, userID := range lo.Uniq(lo.Flatten([][]int64{voters.UpVotes, voters.DownVotes, voters.Lmao})) {
+		voter, err := c.Bot.GetChatMember(tgbotapi.GetChatMemberConfig{ChatConfigWithUser: tgbotapi.ChatConfigWithUser{ChatID: data.ChatID, UserID: userID}})
+		if err != nil || voter.User == nil {
+			continue
+		}
+
+		names[userID] = tgbot.FullNameFromFirstAndLastName(voter.User.FirstName, voter.User.LastName)
+	}
+
+	content := formatRecapVoters(c, messageOptions, voters, names)
+
+	if telegram.ChatType(c.Update.Message.Chat.Type) == telegram.ChatTypePrivate {
+		return c.NewMessageReplyTo(content, c.Update.Message.MessageID).WithParseModeHTML(), nil
+	}
+
+	msg := tgbotapi.NewMessage(fromID, content)
+	msg.ParseMode = tgbotapi.ModeHTML
+
+	_, err = c.Bot.Send(msg)
+	if err != nil {
+		if c.Bot.IsCannotInitiateChatWithUserErr(err) || c.Bot.IsBotWasBlockedByTheUserErr(err) {
+			return nil, tgbot.
+				NewMessageError(recapT(c, messageOptions, "voters.cannotSendPrivately")).
+				WithReply(c.Update.Message)
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "voters.failed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.NewMessageReplyTo(recapT(c, messageOptions, "voters.sent"), c.Update.Message.MessageID), nil
+}
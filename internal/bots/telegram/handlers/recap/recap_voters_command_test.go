@@ -0,0 +1,42 @@
+package recap
+
+import (
+	"path/filepath"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func TestCheckRecapVotersPermission(t *testing.T) {
+	assert.NoError(t, checkRecapVotersPermission(false, tgbotapi.ChatMember{Status: string(telegram.MemberStatusCreator)}))
+	assert.NoError(t, checkRecapVotersPermission(false, tgbotapi.ChatMember{Status: string(telegram.MemberStatusAdministrator)}))
+	assert.ErrorIs(t, checkRecapVotersPermission(false, tgbotapi.ChatMember{Status: string(telegram.MemberStatusMember)}), errRecapVotersPermissionDenied)
+	assert.ErrorIs(t, checkRecapVotersPermission(false, tgbotapi.ChatMember{Status: string(telegram.MemberStatusLeft)}), errRecapVotersPermissionDenied)
+	assert.ErrorIs(t, checkRecapVotersPermission(true, tgbotapi.ChatMember{Status: string(telegram.MemberStatusAdministrator)}), errRecapVotersAnonymousAdministrator)
+}
+
+func TestFormatRecapVoters(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+
+	text := formatRecapVoters(c, nil, chathistories.FeedbackChatHistoriesRecapsVoters{
+		UpVotes: []int64{1, 2},
+		Lmao:    []int64{3},
+	}, map[int64]string{
+		1: "Alice",
+		3: "Tom & Jerry",
+	})
+
+	assert.Contains(t, text, `👍 2 人：<a href="tg://user?id=1">Alice</a>、<a href="tg://user?id=2"><code>2</code></a>`)
+	assert.Contains(t, text, "👎 0 人")
+	assert.Contains(t, text, `🤣 1 人：<a href="tg://user?id=3">Tom &amp; Jerry</a>`)
+}
@@ -0,0 +1,137 @@
+package recap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+var recapWeekdaysByName = map[string][]time.Weekday{
+	"mon":      {time.Monday},
+	"tue":      {time.Tuesday},
+	"wed":      {time.Wednesday},
+	"thu":      {time.Thursday},
+	"fri":      {time.Friday},
+	"sat":      {time.Saturday},
+	"sun":      {time.Sunday},
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekends": {time.Saturday, time.Sunday},
+}
+
+var recapWeekdayNameKeys = map[time.Weekday]string{
+	time.Sunday:    "weekdays.sun",
+	time.Monday:    "weekdays.mon",
+	time.Tuesday:   "weekdays.tue",
+	time.Wednesday: "weekdays.wed",
+	time.Thursday:  "weekdays.thu",
+	time.Friday:    "weekdays.fri",
+	time.Saturday:  "weekdays.sat",
+}
+
+// parseRecapWeekdays parses the space or comma separated weekday names, empty
+// argument or "all" enables every day.
+func parseRecapWeekdays(arg string) ([]time.Weekday, error) {
+	names := strings.FieldsFunc(strings.ToLower(arg), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	weekdays := make([]time.Weekday, 0, 7)
+
+	for _, name := range names {
+		if name == "all" {
+			return nil, nil
+		}
+
+		days, ok := recapWeekdaysByName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q", name)
+		}
+
+		weekdays = append(weekdays, days...)
+	}
+
+	return tgchats.NormalizeRecapWeekdays(weekdays), nil
+}
+
+// formatRecapWeekdays formats the weekdays to generate the auto recaps on,
+// starting from Monday.
+func formatRecapWeekdays(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, weekdays []time.Weekday) string {
+	weekdays = tgchats.NormalizeRecapWeekdays(weekdays)
+	if len(weekdays) == 0 {
+		return recapT(c, options, "weekdays.everyday")
+	}
+
+	names := lo.FilterMap([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}, func(weekday time.Weekday, _ int) (string, bool) {
+		return recapT(c, options, recapWeekdayNameKeys[weekday]), lo.Contains(weekdays, weekday)
+	})
+
+	return strings.Join(names, recapT(c, options, "enumerationSeparator"))
+}
+
+func (h *CommandHandler) handleSetRecapWeekdaysCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
+	err := checkConfigure(c, c.Update.Message.From)
+	if err != nil {
+		if errors.Is(err, errOperationCanNotBeDone) {
+			return nil, tgbot.
+				NewMessageError(recapOperationErrorMessage(c, messageOptions, err)).
+				WithReply(c.Update.Message).
+				WithParseModeHTML()
+		}
+
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "weekdays.setFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	weekdays, err := parseRecapWeekdays(c.Update.Message.CommandArguments())
+	if err != nil {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "weekdays.invalid")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	err = h.tgchats.SetRecapWeekdays(chatID, weekdays)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "weekdays.setFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "weekdays.setFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	// reschedule so that the next auto recap lands on an enabled weekday
+	err = h.tgchats.QueueOneSendChatHistoriesRecapTaskForChatID(chatID, options)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "weekdays.setFailed")).
+			WithReply(c.Update.Message)
+	}
+
+	return c.
+		NewMessageReplyTo(recapT(c, options, "weekdays.set", i18n.M{
+			"Weekdays": formatRecapWeekdays(c, options, weekdays),
+		}), c.Update.Message.MessageID).
+		WithParseModeHTML(), nil
+}
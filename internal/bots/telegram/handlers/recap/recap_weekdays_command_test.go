@@ -0,0 +1,42 @@
+package recap
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+func TestParseRecapWeekdays(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+
+	weekdays, err := parseRecapWeekdays("")
+	require.NoError(t, err)
+	assert.Empty(t, weekdays)
+	assert.Equal(t, "每天", formatRecapWeekdays(c, nil, weekdays))
+
+	weekdays, err = parseRecapWeekdays("weekdays")
+	require.NoError(t, err)
+	assert.Equal(t, []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, weekdays)
+	assert.Equal(t, "周一、周二、周三、周四、周五", formatRecapWeekdays(c, nil, weekdays))
+
+	weekdays, err = parseRecapWeekdays("Sun, mon fri")
+	require.NoError(t, err)
+	assert.Equal(t, []time.Weekday{time.Sunday, time.Monday, time.Friday}, weekdays)
+	assert.Equal(t, "周一、周五、周日", formatRecapWeekdays(c, nil, weekdays))
+
+	weekdays, err = parseRecapWeekdays("weekdays weekends")
+	require.NoError(t, err)
+	assert.Empty(t, weekdays)
+
+	_, err = parseRecapWeekdays("mon holiday")
+	require.Error(t, err)
+}
@@ -1,12 +1,15 @@
 package recap
 
 import (
-	"fmt"
 	"strings"
+	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/nekomeowww/insights-bot/ent"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 	"github.com/nekomeowww/xo"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -15,15 +18,16 @@ import (
 func (h *CommandHandler) handleSubscribeRecapCommand(c *tgbot.Context) (tgbot.Response, error) {
 	fromID := c.Update.Message.From.ID
 	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
 
 	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
 	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
-		return nil, tgbot.NewMessageError("只有在群组和超级群组内才可以订阅定时的聊天记录回顾哦！").WithReply(c.Update.Message)
+		return nil, tgbot.NewMessageError(recapT(c, messageOptions, "subscribe.groupsOnly")).WithReply(c.Update.Message)
 	}
 
 	if c.Bot.IsGroupAnonymousBot(c.Update.Message.From) {
 		return nil, tgbot.
-			NewMessageError("匿名管理员无法订阅定时的聊天记录回顾哦！如果需要订阅定时的聊天记录回顾，必须先将发送角色切换为普通用户然后再试哦。").
+			NewMessageError(recapT(c, messageOptions, "subscribe.anonymousAdmin")).
 			WithReply(c.Update.Message).
 			WithDeleteLater(fromID, chatID)
 	}
@@ -34,19 +38,34 @@ func (h *CommandHandler) handleSubscribeRecapCommand(c *tgbot.Context) (tgbot.Re
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("订阅群组定时聊天回顾时出现问题，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "subscribe.failed")).
 			WithReply(c.Update.Message).
 			WithDeleteLater(fromID, chatID)
 	}
 
 	if !has {
 		return nil, tgbot.
-			NewMessageError("聊天记录回顾功能在当前群组尚未启用，需要在群组管理员通过 /configure_recap 命令配置功能启用后才可以订阅聊天回顾哦。").
+			NewMessageError(recapT(c, messageOptions, "subscribe.notEnabled")).
 			WithReply(c.Update.Message).
 			WithDeleteLater(fromID, chatID)
 	}
 
-	msg := tgbotapi.NewMessage(fromID, fmt.Sprintf("您已成功订阅群组 <b>%s</b> 的定时聊天回顾！", tgbot.EscapeHTMLSymbols(c.Update.Message.Chat.Title)))
+	reason, err := h.checkSubscribeRecapRequirementsOfUser(c, chatID, fromID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribe.failed")).
+			WithReply(c.Update.Message).
+			WithDeleteLater(fromID, chatID)
+	}
+	if reason != "" {
+		return nil, tgbot.
+			NewMessageError(reason).
+			WithReply(c.Update.Message).
+			WithDeleteLater(fromID, chatID)
+	}
+
+	msg := tgbotapi.NewMessage(fromID, recapT(c, messageOptions, "subscribe.subscribed", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(c.Update.Message.Chat.Title)}))
 	msg.ParseMode = tgbotapi.ModeHTML
 
 	_, err = c.Bot.Send(msg)
@@ -55,7 +74,7 @@ func (h *CommandHandler) handleSubscribeRecapCommand(c *tgbot.Context) (tgbot.Re
 		if err != nil {
 			return nil, tgbot.
 				NewExceptionError(err).
-				WithMessage("订阅群组定时聊天回顾时出现问题，请稍后再试！").
+				WithMessage(recapT(c, messageOptions, "subscribe.failed")).
 				WithReply(c.Update.Message).
 				WithDeleteLater(fromID, chatID)
 		}
@@ -74,15 +93,15 @@ func (h *CommandHandler) handleSubscribeRecapCommand(c *tgbot.Context) (tgbot.Re
 	if hashKeyErr != nil {
 		return nil, tgbot.
 			NewExceptionError(hashKeyErr).
-			WithMessage("订阅群组定时聊天回顾时出现问题，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "subscribe.failed")).
 			WithReply(c.Update.Message).
 			WithDeleteLater(fromID, chatID)
 	}
 
 	if c.Bot.IsCannotInitiateChatWithUserErr(err) {
-		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newSubscribeRecapCommandWhenUserNeverStartedChat(c.Bot, hashKey))
+		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newSubscribeRecapCommandWhenUserNeverStartedChat(c, messageOptions, hashKey))
 	} else if c.Bot.IsBotWasBlockedByTheUserErr(err) {
-		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newSubscribeRecapCommandWhenUserBlockedMessage(c.Bot, hashKey))
+		return h.handleUserNeverStartedChatOrBlockedErr(c, chatID, chatTitle, newSubscribeRecapCommandWhenUserBlockedMessage(c, messageOptions, hashKey))
 	} else {
 		h.logger.Error("failed to send private message to user",
 			zap.String("message", xo.SprintJSON(msg)),
@@ -114,11 +133,27 @@ func (h *CommandHandler) handleStartCommandWithRecapSubscription(c *tgbot.Contex
 		return nil, nil
 	}
 
+	messageOptions := h.recapsOptionForMessages(context.ChatID)
+
+	reason, err := h.checkSubscribeRecapRequirementsOfUser(c, context.ChatID, c.Update.Message.From.ID)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(recapT(c, messageOptions, "subscribe.failed")).
+			WithReply(c.Update.Message)
+	}
+	if reason != "" {
+		return nil, tgbot.
+			NewMessageError(recapT(c, messageOptions, "subscribe.rejected", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(context.ChatTitle), "Reason": reason})).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
 	err = h.tgchats.SubscribeToAutoRecaps(context.ChatID, c.Update.Message.From.ID)
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("订阅群组定时聊天回顾时出现问题，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "subscribe.failed")).
 			WithReply(c.Update.Message)
 	}
 
@@ -128,18 +163,87 @@ func (h *CommandHandler) handleStartCommandWithRecapSubscription(c *tgbot.Contex
 	}
 
 	return c.
-		NewMessage(fmt.Sprintf("您已成功订阅群组 <b>%s</b> 的定时聊天回顾！", tgbot.EscapeHTMLSymbols(context.ChatTitle))).
+		NewMessage(recapT(c, messageOptions, "subscribe.subscribed", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(context.ChatTitle)})).
 		WithParseModeHTML(), nil
 }
 
+// checkSubscribeRecapRequirements checks whether the member meets the subscribe
+// requirements configured for the chat, returns the reason of rejection or an
+// empty string if the member is allowed to subscribe.
+//
+// Telegram doesn't expose when a member joined the chat, therefore the time the
+// member was first seen chatting is used to measure the membership duration.
+func checkSubscribeRecapRequirements(c *tgbot.Context, options *ent.TelegramChatRecapsOptions, status telegram.MemberStatus, firstChattedAt time.Time, now time.Time) string {
+	allowedStatuses := []telegram.MemberStatus{
+		telegram.MemberStatusCreator,
+		telegram.MemberStatusAdministrator,
+		telegram.MemberStatusMember,
+		telegram.MemberStatusRestricted,
+	}
+
+	requiredStatus := tgchat.SubscribeRecapMemberStatusRequirement(options.SubscribeMinMemberStatus)
+
+	switch requiredStatus {
+	case tgchat.SubscribeRecapMemberStatusRequirementUnrestricted:
+		allowedStatuses = allowedStatuses[:3]
+	case tgchat.SubscribeRecapMemberStatusRequirementAdministrator:
+		allowedStatuses = allowedStatuses[:2]
+	}
+
+	if !lo.Contains(allowedStatuses, status) {
+		return recapT(c, options, "subscribe.memberStatusRequired", i18n.M{"Requirement": recapSubscribeRequirementText(c, options, requiredStatus)})
+	}
+	if options.SubscribeMinMembershipDays <= 0 {
+		return ""
+	}
+	// administrators and the creator are trusted regardless of the duration
+	if lo.Contains([]telegram.MemberStatus{telegram.MemberStatusCreator, telegram.MemberStatusAdministrator}, status) {
+		return ""
+	}
+
+	minMembership := time.Duration(options.SubscribeMinMembershipDays) * 24 * time.Hour
+	if firstChattedAt.IsZero() || now.Sub(firstChattedAt) < minMembership {
+		return recapT(c, options, "subscribe.membershipDaysRequired", i18n.M{"Days": options.SubscribeMinMembershipDays})
+	}
+
+	return ""
+}
+
+func (h *CommandHandler) checkSubscribeRecapRequirementsOfUser(c *tgbot.Context, chatID int64, userID int64) (string, error) {
+	options, err := h.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return "", err
+	}
+	if options.SubscribeMinMembershipDays <= 0 && options.SubscribeMinMemberStatus == int(tgchat.SubscribeRecapMemberStatusRequirementMember) {
+		return "", nil
+	}
+
+	member, err := c.Bot.GetChatMember(tgbotapi.GetChatMemberConfig{ChatConfigWithUser: tgbotapi.ChatConfigWithUser{ChatID: chatID, UserID: userID}})
+	if err != nil {
+		return "", err
+	}
+
+	var firstChattedAt time.Time
+
+	if options.SubscribeMinMembershipDays > 0 {
+		firstChattedAt, err = h.chathistories.FindFirstChattedAtOfUser(chatID, userID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return checkSubscribeRecapRequirements(c, options, telegram.MemberStatus(member.Status), firstChattedAt, time.Now()), nil
+}
+
 func (h *CommandHandler) handleUnsubscribeRecapCommand(c *tgbot.Context) (tgbot.Response, error) {
+	chatID := c.Update.Message.Chat.ID
+	messageOptions := h.recapsOptionForMessages(chatID)
+
 	chatType := telegram.ChatType(c.Update.Message.Chat.Type)
 	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
-		return nil, tgbot.NewMessageError("只有在群组和超级群组内才可以取消订阅定时的聊天记录回顾哦！").WithReply(c.Update.Message)
+		return nil, tgbot.NewMessageError(recapT(c, messageOptions, "subscribe.unsubscribeGroupsOnly")).WithReply(c.Update.Message)
 	}
 
-	chatID := c.Update.Message.Chat.ID
-
 	if c.Bot.IsGroupAnonymousBot(c.Update.Message.From) {
 		c.Bot.MayRequest(tgbotapi.NewDeleteMessage(chatID, c.Update.Message.MessageID))
 		return nil, nil
@@ -152,14 +256,14 @@ func (h *CommandHandler) handleUnsubscribeRecapCommand(c *tgbot.Context) (tgbot.
 	if err != nil {
 		return nil, tgbot.
 			NewExceptionError(err).
-			WithMessage("订阅群组定时聊天回顾时出现问题，请稍后再试！").
+			WithMessage(recapT(c, messageOptions, "subscribe.failed")).
 			WithReply(c.Update.Message).
 			WithDeleteLater(fromID, chatID)
 	}
 
 	c.Bot.MayRequest(tgbotapi.NewDeleteMessage(chatID, c.Update.Message.MessageID))
 
-	msg := tgbotapi.NewMessage(fromID, fmt.Sprintf("您已成功取消订阅群组 <b>%s</b> 的定时聊天回顾！", tgbot.EscapeHTMLSymbols(chatTitle)))
+	msg := tgbotapi.NewMessage(fromID, recapT(c, messageOptions, "subscribe.unsubscribed", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle)}))
 	msg.ParseMode = tgbotapi.ModeHTML
 
 	_, err = c.Bot.Send(msg)
@@ -0,0 +1,80 @@
+package recap
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func TestCheckSubscribeRecapRequirements(t *testing.T) {
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	c := &tgbot.Context{I18n: i}
+
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("NoRequirements", func(t *testing.T) {
+		options := &ent.TelegramChatRecapsOptions{}
+
+		assert.Empty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusRestricted, time.Time{}, now))
+		assert.NotEmpty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusLeft, time.Time{}, now))
+	})
+
+	t.Run("MembershipDays", func(t *testing.T) {
+		options := &ent.TelegramChatRecapsOptions{SubscribeMinMembershipDays: 7}
+
+		// new member who only started chatting yesterday
+		assert.NotEmpty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusMember, now.Add(-24*time.Hour), now))
+		// member who never chatted
+		assert.NotEmpty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusMember, time.Time{}, now))
+		// long-standing member
+		assert.Empty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusMember, now.Add(-30*24*time.Hour), now))
+		// administrators are exempted
+		assert.Empty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusAdministrator, time.Time{}, now))
+	})
+
+	t.Run("MemberStatus", func(t *testing.T) {
+		options := &ent.TelegramChatRecapsOptions{SubscribeMinMemberStatus: int(tgchat.SubscribeRecapMemberStatusRequirementUnrestricted)}
+
+		assert.NotEmpty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusRestricted, time.Time{}, now))
+		assert.Empty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusMember, time.Time{}, now))
+
+		options.SubscribeMinMemberStatus = int(tgchat.SubscribeRecapMemberStatusRequirementAdministrator)
+
+		assert.NotEmpty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusMember, now.Add(-30*24*time.Hour), now))
+		assert.Empty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusAdministrator, time.Time{}, now))
+		assert.Empty(t, checkSubscribeRecapRequirements(c, options, telegram.MemberStatusCreator, time.Time{}, now))
+	})
+}
+
+func TestParseRecapSubscribeRequirement(t *testing.T) {
+	days, status, err := parseRecapSubscribeRequirement("")
+	require.NoError(t, err)
+	assert.Zero(t, days)
+	assert.Equal(t, tgchat.SubscribeRecapMemberStatusRequirementMember, status)
+
+	days, status, err = parseRecapSubscribeRequirement("7")
+	require.NoError(t, err)
+	assert.Equal(t, 7, days)
+	assert.Equal(t, tgchat.SubscribeRecapMemberStatusRequirementMember, status)
+
+	days, status, err = parseRecapSubscribeRequirement(" 0  Admin ")
+	require.NoError(t, err)
+	assert.Zero(t, days)
+	assert.Equal(t, tgchat.SubscribeRecapMemberStatusRequirementAdministrator, status)
+
+	for _, args := range []string{"-1", "abc", "7 owner", "7 admin extra"} {
+		_, _, err = parseRecapSubscribeRequirement(args)
+		assert.Error(t, err, args)
+	}
+}
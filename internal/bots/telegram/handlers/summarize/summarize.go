@@ -3,6 +3,7 @@ package summarize
 import (
 	"github.com/nekomeowww/insights-bot/internal/models/smr"
 	"github.com/nekomeowww/insights-bot/internal/services/smr/smrqueue"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
 	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/logger"
@@ -22,6 +23,7 @@ type NewHandlersParams struct {
 	I18n     *i18n.I18n
 	SMR      *smr.Model
 	SmrQueue *smrqueue.Queue
+	OpenAI   openai.Client
 }
 
 var _ tgbot.HandlerGroup = (*Handlers)(nil)
@@ -31,6 +33,7 @@ type Handlers struct {
 	i18n     *i18n.I18n
 	smr      *smr.Model
 	smrQueue *smrqueue.Queue
+	openAI   openai.Client
 }
 
 func NewHandlers() func(NewHandlersParams) *Handlers {
@@ -40,6 +43,7 @@ func NewHandlers() func(NewHandlersParams) *Handlers {
 			i18n:     param.I18n,
 			smrQueue: param.SmrQueue,
 			smr:      param.SMR,
+			openAI:   param.OpenAI,
 		}
 
 		return handler
@@ -57,6 +61,13 @@ func (h *Handlers) Install(dispatcher *tgbot.Dispatcher) {
 				return c.T("commands.groups.summarization.commands.smr.help")
 			},
 		},
+		{
+			Command: "summarize",
+			Handler: tgbot.NewHandler(h.handleSummarizeCommand),
+			HelpMessage: func(c *tgbot.Context) string {
+				return c.T("commands.groups.summarization.commands.summarize.help")
+			},
+		},
 	})
 
 	dispatcher.OnChannelPost(tgbot.NewHandler(h.HandleChannelPost))
@@ -0,0 +1,93 @@
+package summarize
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+const summarizeMaxInputLength = 30000
+
+func summarizeContentFromMessage(message *tgbotapi.Message) string {
+	content := strings.TrimSpace(message.CommandArguments())
+	if content != "" || message.ReplyToMessage == nil {
+		return content
+	}
+
+	return strings.TrimSpace(lo.Ternary(message.ReplyToMessage.Text != "", message.ReplyToMessage.Text, message.ReplyToMessage.Caption))
+}
+
+func (h *Handlers) handleSummarizeCommand(c *tgbot.Context) (tgbot.Response, error) {
+	content := summarizeContentFromMessage(c.Update.Message)
+	if content == "" {
+		return nil, tgbot.
+			NewMessageError(c.T("commands.groups.summarization.commands.summarize.noContentFound")).
+			WithReply(c.Update.Message).
+			WithParseModeHTML()
+	}
+
+	rateLimitInterval := h.smr.SummarizeWebpageRatePerSeconds()
+
+	_, ttl, ok, err := c.RateLimitForCommand(c.Update.Message.From.ID, "/summarize", 1, rateLimitInterval)
+	if err != nil {
+		h.logger.Error("failed to check rate limit for command /summarize", zap.Error(err))
+	}
+
+	if !ok {
+		return nil, tgbot.
+			NewMessageError(c.T("commands.groups.summarization.commands.summarize.rateLimitExceeded", i18n.M{
+				"Seconds":           int64(rateLimitInterval / time.Second),
+				"SecondsToBeWaited": lo.Ternary(ttl/time.Second <= 1, 1, int64(ttl/time.Second)),
+			})).
+			WithReply(c.Update.Message)
+	}
+
+	var truncated bool
+
+	runes := []rune(content)
+	if len(runes) > summarizeMaxInputLength {
+		content = string(runes[:summarizeMaxInputLength])
+		truncated = true
+	}
+
+	message := tgbotapi.NewMessage(c.Update.Message.Chat.ID, c.T("commands.groups.summarization.commands.summarize.reading"))
+	message.ReplyToMessageID = c.Update.Message.MessageID
+
+	processingMessage, err := c.Bot.Send(message)
+	if err != nil {
+		return nil, tgbot.NewExceptionError(err)
+	}
+
+	resp, err := h.openAI.SummarizeAny(context.Background(), content)
+	if err != nil {
+		return nil, tgbot.
+			NewExceptionError(err).
+			WithMessage(c.T("commands.groups.summarization.commands.summarize.failedToSummarize")).
+			WithEdit(&processingMessage)
+	}
+
+	if len(resp.Choices) == 0 || strings.TrimSpace(resp.Choices[0].Message.Content) == "" {
+		return nil, tgbot.
+			NewMessageError(c.T("commands.groups.summarization.commands.summarize.failedToSummarize")).
+			WithEdit(&processingMessage)
+	}
+
+	summarization := tgbot.EscapeHTMLSymbols(strings.TrimSpace(resp.Choices[0].Message.Content))
+	if truncated {
+		summarization = fmt.Sprintf("%s\n\n<em>%s</em>", summarization, c.T("commands.groups.summarization.commands.summarize.truncated", i18n.M{
+			"MaxLength": summarizeMaxInputLength,
+		}))
+	}
+
+	return c.
+		NewEditMessageText(processingMessage.MessageID, summarization).
+		WithParseModeHTML(), nil
+}
@@ -30,7 +30,18 @@ func RecordMessage(chatHistories *chathistories.Model, tgchats *tgchats.Model) f
 				return
 			}
 
-			err = chatHistories.SaveOneTelegramChatHistory(c.Update.Message)
+			options, err := tgchats.FindOneOrCreateRecapsOption(c.Update.Message.Chat.ID)
+			if err != nil {
+				c.Logger.Error(err.Error())
+				return
+			}
+
+			if options.StoreMessageContent {
+				err = chatHistories.SaveOneTelegramChatHistory(c.Update.Message)
+			} else {
+				err = chatHistories.SaveOneEphemeralTelegramChatHistory(c.Update.Message)
+			}
+
 			if err != nil {
 				c.Logger.Error(err.Error())
 				return
@@ -0,0 +1,21 @@
+package middlewares
+
+import (
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+)
+
+func SyncWithPolls(chatHistories *chathistories.Model) func(c *tgbot.Context, next func()) {
+	return func(c *tgbot.Context, next func()) {
+		if c.Update.Poll == nil {
+			return
+		}
+
+		err := chatHistories.UpdateTelegramChatHistoryPoll(c.Update.Poll)
+		if err != nil {
+			c.Logger.Error(err.Error())
+		}
+
+		next()
+	}
+}
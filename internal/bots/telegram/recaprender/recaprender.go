@@ -0,0 +1,280 @@
+// Package recaprender renders the summarized chat histories into the Telegram
+// messages shared by the manual, the auto and the published preview recaps.
+package recaprender
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+const (
+	// NonSuperGroupTips is appended to the recaps of the basic groups, whose
+	// message links can not be opened.
+	NonSuperGroupTips = "<b>Tips: </b>由于群组不是超级群组（supergroup），因此消息链接引用暂时被禁用了，如果希望使用该功能，请通过短时间内将群组开放为公共群组并还原回私有群组，或通过其他操作将本群组升级为超级群组后，该功能方可恢复正常运作。"
+
+	generatedByFooter = "<em>🤖️ Generated by chatGPT</em>"
+
+	// privateRecapDigestReservedLength is kept out of the length limit of the
+	// private recap digest messages for the greeting, the page counter, the
+	// hashtags and the footer.
+	privateRecapDigestReservedLength = 512
+
+	// MaxDocumentSize is the max size of the files that bots are allowed to
+	// upload to Telegram.
+	MaxDocumentSize = 50 * 1024 * 1024
+)
+
+var (
+	matchHTMLLinks = regexp.MustCompile(`<a href="([^"]*)">([^<]*)</a>`)
+	matchHTMLTags  = regexp.MustCompile(`<[^>]+>`)
+)
+
+// RenderSummariesToHTML drops the empty summarizations and converts the
+// markdown titles of the rest into the Telegram HTML bold elements.
+func RenderSummariesToHTML(summarizations []string) []string {
+	return lo.FilterMap(summarizations, func(item string, _ int) (string, bool) {
+		if item == "" {
+			return "", false
+		}
+
+		return tgbot.ReplaceMarkdownTitlesToTelegramBoldElement(item), true
+	})
+}
+
+// SplitIntoPages splits the rendered summarizations into the pages of
+// messages, one page for each topic if perTopic is true.
+func SplitIntoPages(summarizations []string, perTopic bool) [][]string {
+	if perTopic {
+		return lo.Chunk(summarizations, 1)
+	}
+
+	return tgbot.SplitMessagesAgainstLengthLimitIntoMessageGroups(summarizations)
+}
+
+// FormatHashtags joins the hashtags appended to the recap messages, "#recap"
+// is used if there is none.
+func FormatHashtags(hashtags []string) string {
+	if len(hashtags) == 0 {
+		return "#recap"
+	}
+
+	return strings.Join(hashtags, " ")
+}
+
+// MessageOptions describes how one page of the recap message is built.
+type MessageOptions struct {
+	// Header is rendered right before the quoted summarizations, such as the
+	// disclaimer and the chatted at range.
+	Header   string
+	ChatType telegram.ChatType
+	// Page is the 1-based index of the page, the page counter is rendered
+	// only if there are more than one Pages.
+	Page     int
+	Pages    int
+	Hashtags []string
+	// Texts localizes the fixed strings such as the footer for the chat.
+	Texts Texts
+}
+
+// BuildTelegramMessage builds the HTML text of one page of the recap message.
+func BuildTelegramMessage(page []string, opts MessageOptions) string {
+	text := fmt.Sprintf("%s<blockquote expandable>%s</blockquote>", opts.Header, strings.Join(page, "\n\n"))
+	tips := lo.Ternary(opts.ChatType == telegram.ChatTypeGroup, opts.Texts.NonSuperGroupTips()+"\n\n", "")
+	hashtags := FormatHashtags(opts.Hashtags)
+
+	if opts.Pages > 1 {
+		return fmt.Sprintf("%s\n\n(%d/%d)\n%s%s\n%s",
+			text,
+			opts.Page,
+			opts.Pages,
+			lo.Ternary(tips != "", "\n"+tips, ""),
+			hashtags,
+			opts.Texts.GeneratedBy(),
+		)
+	}
+
+	return fmt.Sprintf("%s\n\n%s%s\n%s", text, tips, hashtags, opts.Texts.GeneratedBy())
+}
+
+// TopicTitles returns the rendered titles of the summarizations, which are the
+// first bold lines of them, the summarizations without a title are skipped.
+func TopicTitles(summarizations []string) []string {
+	return lo.FilterMap(summarizations, func(item string, _ int) (string, bool) {
+		title, _, _ := strings.Cut(item, "\n")
+
+		return title, strings.HasPrefix(title, "<b>")
+	})
+}
+
+// BuildDigestOnlyMessage builds the HTML text of the recap posted to the chat
+// in the digest only send mode, only the titles of the topics are listed and
+// the full recap is left to the private subscribers. The page fields of opts
+// are ignored since the digest always fits in one message.
+func BuildDigestOnlyMessage(summarizations []string, opts MessageOptions) string {
+	titles := TopicTitles(summarizations)
+	tips := lo.Ternary(opts.ChatType == telegram.ChatTypeGroup, opts.Texts.NonSuperGroupTips()+"\n\n", "")
+
+	return fmt.Sprintf("%s%s\n%s\n\n%s\n\n%s%s\n%s",
+		opts.Header,
+		opts.Texts.DigestOnlyTopics(len(titles)),
+		strings.Join(lo.Map(titles, func(title string, _ int) string { return "• " + title }), "\n"),
+		opts.Texts.DigestOnlyNote(),
+		tips,
+		FormatHashtags(opts.Hashtags),
+		opts.Texts.GeneratedBy(),
+	)
+}
+
+// NewMessage creates the HTML message of one page of the recap, the web page
+// preview of the links in it is disabled unless linkPreview is set.
+func NewMessage(chatID int64, text string, linkPreview bool) tgbotapi.MessageConfig {
+	msg := tgbotapi.NewMessage(chatID, text)
+	msg.ParseMode = tgbotapi.ModeHTML
+	msg.DisableWebPagePreview = !linkPreview
+
+	return msg
+}
+
+// DigestSection is the recap of one chat in the private recap digest.
+type DigestSection struct {
+	ChatTitle string
+	// Header is rendered right before the quoted summarizations of the chat.
+	Header string
+	// Summarizations are the summarizations already rendered into HTML.
+	Summarizations []string
+}
+
+// groupAgainstLength groups the items in order so that the items of one group
+// joined by the separator fit in limit runes, the item exceeding the limit on
+// its own is kept as a separate group.
+func groupAgainstLength(items []string, separator string, limit int) [][]string {
+	groups := make([][]string, 0)
+	group := make([]string, 0)
+	length := 0
+
+	for _, item := range items {
+		itemLength := utf8.RuneCountInString(item)
+		if len(group) > 0 && length+utf8.RuneCountInString(separator)+itemLength > limit {
+			groups = append(groups, group)
+			group = make([]string, 0)
+			length = 0
+		}
+
+		if len(group) > 0 {
+			length += utf8.RuneCountInString(separator)
+		}
+
+		group = append(group, item)
+		length += itemLength
+	}
+
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// BuildPrivateRecapDigestMessages combines the recaps of the chats into the
+// HTML texts of the private recap digest, the recap of one chat is split into
+// more than one quotes if it exceeds the length limit of one message, and the
+// quotes are split into more than one messages only if they exceed the limit.
+func BuildPrivateRecapDigestMessages(sections []DigestSection, hashtags []string, texts Texts) []string {
+	limit := tgbot.MessageLengthLimit - privateRecapDigestReservedLength
+	quotes := make([]string, 0)
+	chats := 0
+
+	for _, section := range sections {
+		if len(section.Summarizations) == 0 {
+			continue
+		}
+
+		chats++
+		title := fmt.Sprintf("📌 <b>%s</b>\n", tgbot.EscapeHTMLSymbols(section.ChatTitle))
+		quoteLimit := limit - utf8.RuneCountInString(title+section.Header+"<blockquote expandable></blockquote>")
+
+		for i, page := range groupAgainstLength(section.Summarizations, "\n\n", quoteLimit) {
+			header := ""
+			if i == 0 {
+				header = section.Header
+			}
+
+			quotes = append(quotes, fmt.Sprintf("%s%s<blockquote expandable>%s</blockquote>", title, header, strings.Join(page, "\n\n")))
+		}
+	}
+	if len(quotes) == 0 {
+		return make([]string, 0)
+	}
+
+	pages := groupAgainstLength(quotes, "\n\n", limit)
+	messages := make([]string, 0, len(pages))
+
+	for i, page := range pages {
+		text := strings.Join(page, "\n\n")
+		if i == 0 {
+			text = texts.PrivateRecapDigestHeader(chats) + "\n\n" + text
+		}
+
+		if len(pages) > 1 {
+			text = fmt.Sprintf("%s\n\n(%d/%d)", text, i+1, len(pages))
+		}
+
+		messages = append(messages, fmt.Sprintf("%s\n\n%s\n%s", text, FormatHashtags(hashtags), texts.GeneratedBy()))
+	}
+
+	return messages
+}
+
+// htmlToMarkdown converts the links in the Telegram HTML text into the markdown
+// links, the rest of the tags are stripped and the entities are unescaped.
+func htmlToMarkdown(text string) string {
+	text = matchHTMLLinks.ReplaceAllString(text, "[$2]($1)")
+	text = matchHTMLTags.ReplaceAllString(text, "")
+
+	return html.UnescapeString(text)
+}
+
+// BuildMarkdownDocument builds the markdown document of the full recap, the
+// summarizations are the ones before rendered into HTML, whose titles are
+// still the markdown titles.
+func BuildMarkdownDocument(chatTitle string, header string, summarizations []string) string {
+	sections := lo.FilterMap(summarizations, func(item string, _ int) (string, bool) {
+		return htmlToMarkdown(item), item != ""
+	})
+
+	text := fmt.Sprintf("# %s 聊天记录回顾\n\n", chatTitle)
+	if header = strings.TrimSpace(htmlToMarkdown(header)); header != "" {
+		text += header + "\n\n"
+	}
+
+	return text + strings.Join(sections, "\n\n") + "\n"
+}
+
+// DocumentFileName returns the file name of the recap document created at.
+func DocumentFileName(at time.Time) string {
+	return "recap_" + at.Format("2006-01-02_1504") + ".md"
+}
+
+// NewDocument creates the document of the full recap replying to the recap
+// message, false will be returned if the document exceeds the size limit of
+// Telegram, the recap message is left alone in that case.
+func NewDocument(chatID int64, replyToMessageID int, fileName string, content string) (tgbotapi.DocumentConfig, bool) {
+	if len(content) > MaxDocumentSize {
+		return tgbotapi.DocumentConfig{}, false
+	}
+
+	document := tgbotapi.NewDocument(chatID, tgbotapi.FileBytes{Name: fileName, Bytes: []byte(content)})
+	document.ReplyToMessageID = replyToMessageID
+
+	return document, true
+}
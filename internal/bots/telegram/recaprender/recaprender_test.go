@@ -0,0 +1,218 @@
+package recaprender
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func TestRenderSummariesToHTML(t *testing.T) {
+	rendered := RenderSummariesToHTML([]string{"## 周末爬山\n约好早上八点集合", "", "## 新版本发布\n讨论了更新内容"})
+	require.Len(t, rendered, 2)
+	assert.Equal(t, "<b>周末爬山</b>\n约好早上八点集合", rendered[0])
+	assert.Equal(t, "<b>新版本发布</b>\n讨论了更新内容", rendered[1])
+
+	assert.Empty(t, RenderSummariesToHTML([]string{"", ""}))
+}
+
+func TestSplitIntoPages(t *testing.T) {
+	summarizations := []string{"a", "b", "c"}
+
+	assert.Equal(t, [][]string{{"a", "b", "c"}}, SplitIntoPages(summarizations, false))
+	assert.Equal(t, [][]string{{"a"}, {"b"}, {"c"}}, SplitIntoPages(summarizations, true))
+
+	long := strings.Repeat("长", 3000)
+	assert.Len(t, SplitIntoPages([]string{long, long}, false), 2)
+}
+
+func TestBuildTelegramMessage(t *testing.T) {
+	t.Run("SinglePage", func(t *testing.T) {
+		content := BuildTelegramMessage([]string{"a", "b"}, MessageOptions{
+			Header:   "header\n",
+			ChatType: telegram.ChatTypeSuperGroup,
+			Page:     1,
+			Pages:    1,
+		})
+
+		assert.Equal(t, "header\n<blockquote expandable>a\n\nb</blockquote>\n\n#recap\n<em>🤖️ Generated by chatGPT</em>", content)
+	})
+
+	t.Run("MultiplePages", func(t *testing.T) {
+		content := BuildTelegramMessage([]string{"a"}, MessageOptions{
+			ChatType: telegram.ChatTypeSuperGroup,
+			Page:     2,
+			Pages:    3,
+			Hashtags: []string{"#recap", "#recap_auto"},
+		})
+
+		assert.Equal(t, "<blockquote expandable>a</blockquote>\n\n(2/3)\n#recap #recap_auto\n<em>🤖️ Generated by chatGPT</em>", content)
+	})
+
+	t.Run("CustomHashtags", func(t *testing.T) {
+		content := BuildTelegramMessage([]string{"a"}, MessageOptions{
+			ChatType: telegram.ChatTypeSuperGroup,
+			Page:     1,
+			Pages:    1,
+			Hashtags: []string{"#mybot", "#mybot_auto"},
+		})
+
+		assert.Equal(t, "<blockquote expandable>a</blockquote>\n\n#mybot #mybot_auto\n<em>🤖️ Generated by chatGPT</em>", content)
+	})
+
+	t.Run("LocalizedTexts", func(t *testing.T) {
+		i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "..", "locales")))
+		require.NoError(t, err)
+
+		content := BuildTelegramMessage([]string{"a"}, MessageOptions{
+			ChatType: telegram.ChatTypeGroup,
+			Page:     1,
+			Pages:    1,
+			Texts:    NewTexts(i, "zh-TW"),
+		})
+
+		assert.True(t, strings.HasSuffix(content, "\n\n#recap\n<em>🤖️ 由 chatGPT 產生</em>"))
+		assert.Contains(t, content, "由於群組不是超級群組")
+		assert.False(t, i18n.HasMixedChineseScripts(content))
+	})
+
+	t.Run("BasicGroupTips", func(t *testing.T) {
+		content := BuildTelegramMessage([]string{"a"}, MessageOptions{
+			ChatType: telegram.ChatTypeGroup,
+			Page:     1,
+			Pages:    1,
+		})
+		assert.Equal(t, "<blockquote expandable>a</blockquote>\n\n"+NonSuperGroupTips+"\n\n#recap\n<em>🤖️ Generated by chatGPT</em>", content)
+
+		content = BuildTelegramMessage([]string{"a"}, MessageOptions{
+			ChatType: telegram.ChatTypeGroup,
+			Page:     1,
+			Pages:    2,
+		})
+		assert.Equal(t, "<blockquote expandable>a</blockquote>\n\n(1/2)\n\n"+NonSuperGroupTips+"\n\n#recap\n<em>🤖️ Generated by chatGPT</em>", content)
+	})
+}
+
+func TestTopicTitles(t *testing.T) {
+	titles := TopicTitles([]string{
+		"<b><a href=\"https://t.me/c/123/1\">周末爬山</a></b>\n约好早上八点集合",
+		"没有标题的内容",
+		"<b>新版本发布</b>",
+	})
+
+	assert.Equal(t, []string{"<b><a href=\"https://t.me/c/123/1\">周末爬山</a></b>", "<b>新版本发布</b>"}, titles)
+}
+
+func TestBuildDigestOnlyMessage(t *testing.T) {
+	content := BuildDigestOnlyMessage([]string{"<b>周末爬山</b>\n约好早上八点集合", "<b>新版本发布</b>\n讨论了更新内容"}, MessageOptions{
+		Header:   "header\n",
+		ChatType: telegram.ChatTypeSuperGroup,
+		Page:     1,
+		Pages:    2,
+	})
+
+	assert.Equal(t, "header\n本次回顾共讨论了 2 个话题：\n• <b>周末爬山</b>\n• <b>新版本发布</b>\n\n完整的聊天记录回顾仅通过私聊发送给订阅者，发送 /subscribe_recap 即可订阅。\n\n#recap\n<em>🤖️ Generated by chatGPT</em>", content)
+	assert.NotContains(t, content, "约好早上八点集合")
+	assert.NotContains(t, content, "blockquote")
+
+	content = BuildDigestOnlyMessage([]string{"<b>周末爬山</b>\n约好早上八点集合"}, MessageOptions{ChatType: telegram.ChatTypeGroup})
+	assert.Contains(t, content, NonSuperGroupTips)
+}
+
+func TestNewMessage(t *testing.T) {
+	msg := NewMessage(-100123456789, "<b>recap</b>", true)
+	assert.Equal(t, int64(-100123456789), msg.ChatID)
+	assert.Equal(t, "<b>recap</b>", msg.Text)
+	assert.Equal(t, tgbotapi.ModeHTML, msg.ParseMode)
+	assert.False(t, msg.DisableWebPagePreview)
+
+	msg = NewMessage(-100123456789, "<b>recap</b>", false)
+	assert.True(t, msg.DisableWebPagePreview)
+}
+
+func TestBuildPrivateRecapDigestMessages(t *testing.T) {
+	t.Run("CombinedIntoOneMessage", func(t *testing.T) {
+		messages := BuildPrivateRecapDigestMessages([]DigestSection{
+			{ChatTitle: "Gophers & Co", Header: "header\n", Summarizations: []string{"a", "b"}},
+			{ChatTitle: "Empty"},
+			{ChatTitle: "Rustaceans", Summarizations: []string{"c"}},
+		}, []string{"#recap", "#recap_auto"}, Texts{})
+		require.Len(t, messages, 1)
+
+		assert.Equal(t, "您好，这是您订阅的 2 个群组的定时聊天回顾汇总。\n\n"+
+			"📌 <b>Gophers &amp; Co</b>\nheader\n<blockquote expandable>a\n\nb</blockquote>\n\n"+
+			"📌 <b>Rustaceans</b>\n<blockquote expandable>c</blockquote>\n\n"+
+			"#recap #recap_auto\n<em>🤖️ Generated by chatGPT</em>", messages[0])
+	})
+
+	t.Run("SplitAgainstLengthLimit", func(t *testing.T) {
+		long := strings.Repeat("长", 3000)
+
+		messages := BuildPrivateRecapDigestMessages([]DigestSection{
+			{ChatTitle: "A", Summarizations: []string{long}},
+			{ChatTitle: "B", Summarizations: []string{long}},
+		}, nil, Texts{})
+		require.Len(t, messages, 2)
+
+		assert.True(t, strings.HasPrefix(messages[0], "您好，这是您订阅的 2 个群组"))
+		assert.Contains(t, messages[0], "(1/2)")
+		assert.True(t, strings.HasPrefix(messages[1], "📌 <b>B</b>"))
+		assert.Contains(t, messages[1], "(2/2)\n\n#recap")
+	})
+
+	t.Run("SplitOversizedSection", func(t *testing.T) {
+		long := strings.Repeat("长", 2000)
+
+		messages := BuildPrivateRecapDigestMessages([]DigestSection{
+			{ChatTitle: "A", Header: "header\n", Summarizations: []string{long, long, long}},
+		}, nil, Texts{})
+		require.Len(t, messages, 3)
+
+		for i, message := range messages {
+			assert.Less(t, utf8.RuneCountInString(message), tgbot.MessageLengthLimit)
+			assert.Contains(t, message, "📌 <b>A</b>\n")
+			assert.Equal(t, i == 0, strings.Contains(message, "header"))
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.Empty(t, BuildPrivateRecapDigestMessages([]DigestSection{{ChatTitle: "Empty"}}, nil, Texts{}))
+	})
+}
+
+func TestBuildMarkdownDocument(t *testing.T) {
+	content := BuildMarkdownDocument("Gophers", "<b>免责声明</b>\n", []string{
+		"## <a href=\"https://t.me/c/123/1\">周末爬山</a>\n讨论：\n - 约好早上 8 点 &amp; 在山脚集合 <a href=\"https://t.me/c/123/2\">[1]</a>",
+		"",
+		"## 新版本发布\n结论：下周发布",
+	})
+
+	assert.Equal(t, "# Gophers 聊天记录回顾\n\n免责声明\n\n## [周末爬山](https://t.me/c/123/1)\n讨论：\n - 约好早上 8 点 & 在山脚集合 [[1]](https://t.me/c/123/2)\n\n## 新版本发布\n结论：下周发布\n", content)
+}
+
+func TestNewDocument(t *testing.T) {
+	fileName := DocumentFileName(time.Date(2026, 10, 17, 9, 6, 0, 0, time.UTC))
+	assert.Equal(t, "recap_2026-10-17_0906.md", fileName)
+
+	document, ok := NewDocument(-100123456789, 42, fileName, "# Gophers 聊天记录回顾\n")
+	require.True(t, ok)
+	assert.Equal(t, int64(-100123456789), document.ChatID)
+	assert.Equal(t, 42, document.ReplyToMessageID)
+
+	file, ok := document.File.(tgbotapi.FileBytes)
+	require.True(t, ok)
+	assert.Equal(t, "recap_2026-10-17_0906.md", file.Name)
+	assert.Equal(t, "# Gophers 聊天记录回顾\n", string(file.Bytes))
+
+	_, ok = NewDocument(-100123456789, 42, fileName, strings.Repeat("a", MaxDocumentSize+1))
+	assert.False(t, ok)
+}
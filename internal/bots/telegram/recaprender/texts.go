@@ -0,0 +1,55 @@
+package recaprender
+
+import (
+	"fmt"
+
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// Texts localizes the fixed strings of the recap messages with the
+// modules.telegram.recap messages of the locales, the zero value uses the
+// built-in Simplified Chinese ones.
+type Texts struct {
+	i18n   *i18n.I18n
+	locale string
+}
+
+// NewTexts creates the Texts of the locale, which is usually the
+// tgchats.RecapLocale of the chat.
+func NewTexts(i18n *i18n.I18n, locale string) Texts {
+	return Texts{i18n: i18n, locale: locale}
+}
+
+func (t Texts) t(fallback string, key string, args ...any) string {
+	if t.i18n == nil {
+		return fallback
+	}
+
+	return t.i18n.TWithLanguage(t.locale, "modules.telegram.recap."+key, args...)
+}
+
+// GeneratedBy is the footer of the recap messages.
+func (t Texts) GeneratedBy() string {
+	return t.t(generatedByFooter, "generatedBy")
+}
+
+// NonSuperGroupTips is appended to the recaps of the basic groups.
+func (t Texts) NonSuperGroupTips() string {
+	return t.t(NonSuperGroupTips, "nonSuperGroupTips")
+}
+
+// DigestOnlyTopics introduces the topics listed in the digest only recaps.
+func (t Texts) DigestOnlyTopics(count int) string {
+	return t.t(fmt.Sprintf("本次回顾共讨论了 %d 个话题：", count), "digestOnlyTopics", i18n.M{"Count": count})
+}
+
+// DigestOnlyNote tells the members where the full digest only recaps go.
+func (t Texts) DigestOnlyNote() string {
+	return t.t("完整的聊天记录回顾仅通过私聊发送给订阅者，发送 /subscribe_recap 即可订阅。", "digestOnlyNote")
+}
+
+// PrivateRecapDigestHeader greets the subscriber at the top of the private
+// recap digest of count chats.
+func (t Texts) PrivateRecapDigestHeader(count int) string {
+	return t.t(fmt.Sprintf("您好，这是您订阅的 %d 个群组的定时聊天回顾汇总。", count), "privateRecapDigestHeader", i18n.M{"Count": count})
+}
@@ -46,6 +46,7 @@ func NewBot() func(param NewBotParam) (*tgbot.BotService, error) {
 		dispatcher := param.Dispatcher
 		dispatcher.Use(middlewares.RecordMessage(param.ChatHistories, param.TgChats))
 		dispatcher.Use(middlewares.SyncWithEditedMessage(param.ChatHistories))
+		dispatcher.Use(middlewares.SyncWithPolls(param.ChatHistories))
 
 		param.Handlers.InstallAll()
 
@@ -4,7 +4,11 @@ import (
 	"errors"
 	"log"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/joho/godotenv"
 	"github.com/nekomeowww/xo"
@@ -19,6 +23,7 @@ const (
 	EnvTelegramBotWebhookURL  = "TELEGRAM_BOT_WEBHOOK_URL" //nolint:gosec
 	EnvTelegramBotWebhookPort = "TELEGRAM_BOT_WEBHOOK_PORT"
 	EnvTelegramBotAPIEndpoint = "TELEGRAM_BOT_API_ENDPOINT"
+	EnvTelegramFeedbackChatID = "TELEGRAM_FEEDBACK_CHAT_ID"
 
 	EnvSlackClientID     = "SLACK_CLIENT_ID"
 	EnvSlackClientSecret = "SLACK_CLIENT_SECRET"
@@ -29,10 +34,20 @@ const (
 	EnvDiscordBotWebhookPort = "DISCORD_BOT_WEBHOOK_PORT"
 
 	EnvOpenAIAPISecret                       = "OPENAI_API_SECRET" //nolint:gosec
+	EnvOpenAIAPIKeys                         = "OPENAI_API_KEYS"   //nolint:gosec
 	EnvOpenAIAPIHost                         = "OPENAI_API_HOST"
 	EnvOpenAIAPIModelName                    = "OPENAI_API_MODEL_NAME"
 	EnvOpenAIAPITokenLimit                   = "OPENAI_API_TOKEN_LIMIT"                      //nolint:gosec
 	EnvOpenAIAPIChatHistoriesRecapTokenLimit = "OPENAI_API_CHAT_HISTORIES_RECAP_TOKEN_LIMIT" //nolint:gosec
+	EnvOpenAIAPITemperature                  = "OPENAI_API_TEMPERATURE"
+	EnvOpenAIAPIBaseURL                      = "OPENAI_API_BASE_URL"
+	EnvOpenAIAPIType                         = "OPENAI_API_TYPE"
+	EnvOpenAIAPIVersion                      = "OPENAI_API_VERSION"
+	EnvOpenAIAPIExtraHeaders                 = "OPENAI_API_EXTRA_HEADERS"
+	EnvOpenAICircuitBreakerFailureThreshold  = "OPENAI_CIRCUIT_BREAKER_FAILURE_THRESHOLD"
+	EnvOpenAICircuitBreakerCooldownSeconds   = "OPENAI_CIRCUIT_BREAKER_COOLDOWN_SECONDS"
+	EnvOpenAIPromptTokenPrice                = "OPENAI_PROMPT_TOKEN_PRICE"
+	EnvOpenAICompletionTokenPrice            = "OPENAI_COMPLETION_TOKEN_PRICE"
 
 	EnvPineconeProjectName          = "PINECONE_PROJECT_NAME"
 	EnvPineconeEnvironment          = "PINECONE_ENVIRONMENT"
@@ -56,6 +71,27 @@ const (
 	EnvHardLimitSummarizeWebpageRatePerSeconds = "HARD_LIMIT_SMR_WEBPAGE_RATE_PER_SECONDS"
 
 	EnvLocalesDir = "LOCALES_DIR"
+
+	EnvRecapAdaptivePrompt               = "RECAP_ADAPTIVE_PROMPT"
+	EnvRecapMinContentRichness           = "RECAP_MIN_CONTENT_RICHNESS"
+	EnvRecapFloodMinUniqueRatio          = "RECAP_FLOOD_MIN_UNIQUE_RATIO"
+	EnvRecapFloodMinMessages             = "RECAP_FLOOD_MIN_MESSAGES"
+	EnvRecapApprovalExpiryHours          = "RECAP_APPROVAL_EXPIRY_HOURS"
+	EnvRecapDuplicateSimilarityThreshold = "RECAP_DUPLICATE_SIMILARITY_THRESHOLD"
+	EnvRecapMaxMessagesPerSummary        = "RECAP_MAX_MESSAGES_PER_SUMMARY"
+	EnvRecapFirstAutoRecapWarmUpSeconds  = "RECAP_FIRST_AUTO_RECAP_WARM_UP_SECONDS"
+	EnvRecapWebhookURL                   = "RECAP_WEBHOOK_URL"
+	EnvRecapWebhookSecret                = "RECAP_WEBHOOK_SECRET" //nolint:gosec
+	EnvRecapHashtags                     = "RECAP_HASHTAGS"
+	EnvRecapAutoHashtags                 = "RECAP_AUTO_HASHTAGS"
+	EnvRecapAboutTemplate                = "RECAP_ABOUT_TEMPLATE"
+	EnvRecapModerationMode               = "RECAP_MODERATION_MODE"
+	EnvRecapModerationDenylist           = "RECAP_MODERATION_DENYLIST"
+	EnvRecapModerationOpenAI             = "RECAP_MODERATION_OPENAI"
+	EnvRecapDeliveryGroupRatePerSecond   = "RECAP_DELIVERY_GROUP_RATE_PER_SECOND"
+	EnvRecapDeliveryPrivateRatePerSecond = "RECAP_DELIVERY_PRIVATE_RATE_PER_SECOND"
+	EnvRecapDeliveryConcurrency          = "RECAP_DELIVERY_CONCURRENCY"
+	EnvRecapKeywordsStopWordsDir         = "RECAP_KEYWORDS_STOPWORDS_DIR"
 )
 
 type SectionPineconeIndexes struct {
@@ -90,6 +126,7 @@ type SectionTelegram struct {
 	BotWebhookURL  string
 	BotWebhookPort string
 	BotAPIEndpoint string
+	FeedbackChatID int64
 }
 
 type SectionRedis struct {
@@ -107,12 +144,222 @@ type SectionHardLimit struct {
 	SummarizeWebpageRatePerSeconds int64
 }
 
+type OpenAIAPIType string
+
+const (
+	OpenAIAPITypeOpenAI OpenAIAPIType = "openai"
+	OpenAIAPITypeAzure  OpenAIAPIType = "azure"
+)
+
 type SectionOpenAI struct {
 	Secret                       string
+	APIKeys                      []string
 	Host                         string
+	BaseURL                      string
+	APIType                      OpenAIAPIType
+	APIVersion                   string
+	ExtraHeaders                 map[string]string
 	ModelName                    string
 	TokenLimit                   int64
 	ChatHistoriesRecapTokenLimit int64
+	// Temperature is the sampling temperature of the summarizations, negative
+	// leaves it to the API default.
+	Temperature float64
+
+	CircuitBreakerFailureThreshold int64
+	CircuitBreakerCooldownSeconds  int64
+
+	// PromptTokenPrice and CompletionTokenPrice are the prices in USD per one
+	// million tokens, used to estimate the cost of the recaps.
+	PromptTokenPrice     float64
+	CompletionTokenPrice float64
+}
+
+// parseOpenAIAPIKeys parses comma separated API keys, the secret will be used
+// as the only key if no keys were configured.
+func parseOpenAIAPIKeys(value string, secret string) []string {
+	keys := lo.Uniq(lo.Filter(lo.Map(strings.Split(value, ","), func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	}), func(item string, _ int) bool {
+		return item != ""
+	}))
+	if len(keys) == 0 && secret != "" {
+		keys = []string{secret}
+	}
+
+	return keys
+}
+
+// parseOpenAIAPIExtraHeaders parses headers in the form of
+// "Header-Name=value,Another-Header=value".
+func parseOpenAIAPIExtraHeaders(value string) map[string]string {
+	headers := make(map[string]string)
+
+	for _, pair := range strings.Split(value, ",") {
+		name, headerValue, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			if strings.TrimSpace(pair) != "" {
+				log.Printf("invalid header %s in %s, should be in the form of Header-Name=value", pair, EnvOpenAIAPIExtraHeaders)
+			}
+
+			continue
+		}
+
+		headers[strings.TrimSpace(name)] = strings.TrimSpace(headerValue)
+	}
+
+	return headers
+}
+
+type SectionRecap struct {
+	AdaptivePrompt               bool
+	MinContentRichness           float64
+	DuplicateSimilarityThreshold float64
+	MaxMessagesPerSummary        int
+	// FloodMinUniqueRatio is the minimum ratio of the distinct messages in a
+	// recap window with at least FloodMinMessages messages, the repeated
+	// messages of the windows below it are collapsed before summarizing.
+	FloodMinUniqueRatio float64
+	FloodMinMessages    int
+	// ApprovalExpiryHours is how long the auto recaps of the chats requiring
+	// approval, and the ones held by the moderation, wait for the
+	// administrators before they are discarded.
+	ApprovalExpiryHours int
+	// FirstAutoRecapWarmUpSeconds is the minimum seconds to wait before the
+	// first auto recap after enabling, negative means the recap window length
+	// of the chat.
+	FirstAutoRecapWarmUpSeconds int64
+	// WebhookURL receives a POST request whenever a recap is published, the
+	// request body is signed with WebhookSecret if configured.
+	WebhookURL    string
+	WebhookSecret string
+	// Hashtags are appended to the manual recaps, and AutoHashtags to the
+	// auto recaps, so that the recaps of different bots can be told apart.
+	Hashtags     []string
+	AutoHashtags []string
+	// AboutTemplate is the text/template of the /whois_recap reply, the
+	// built-in one is used if empty, the literal \n in the env is treated as
+	// a line break.
+	AboutTemplate string
+	// ModerationMode decides what to do with the recaps flagged by the
+	// ModerationDenylist or the moderation endpoint of OpenAI if
+	// ModerationOpenAI is enabled.
+	ModerationMode     RecapModerationMode
+	ModerationDenylist []string
+	ModerationOpenAI   bool
+	// DeliveryGroupRatePerSecond and DeliveryPrivateRatePerSecond limit how
+	// many auto recap messages are sent to the groups and to the private
+	// subscribers per second, DeliveryConcurrency is how many target chats
+	// are sent to in parallel.
+	DeliveryGroupRatePerSecond   int
+	DeliveryPrivateRatePerSecond int
+	DeliveryConcurrency          int
+	// KeywordsStopWordsDir holds the stop word lists named by the language,
+	// such as zh.txt and en.txt, the list matching the recap language of the
+	// chat is dropped from the top keywords in addition to the built-in ones.
+	KeywordsStopWordsDir string
+}
+
+type RecapModerationMode string
+
+const (
+	RecapModerationModeOff    RecapModerationMode = ""
+	RecapModerationModeRedact RecapModerationMode = "redact" // Flagged words and topics are hidden from the recap
+	RecapModerationModeHold   RecapModerationMode = "hold"   // Flagged auto recaps are sent to the administrators to be published manually
+	RecapModerationModeDrop   RecapModerationMode = "drop"   // Flagged recaps are not published at all
+)
+
+// parseRecapModerationMode parses the moderation mode, the moderation will be
+// turned off if the value is invalid.
+func parseRecapModerationMode(value string) RecapModerationMode {
+	mode := RecapModerationMode(strings.ToLower(strings.TrimSpace(value)))
+
+	switch mode {
+	case RecapModerationModeOff, RecapModerationModeRedact, RecapModerationModeHold, RecapModerationModeDrop:
+		return mode
+	default:
+		log.Printf("%s value %v is invalid, should be one of redact, hold and drop, fallbacks to off", EnvRecapModerationMode, value)
+
+		return RecapModerationModeOff
+	}
+}
+
+// parseRecapModerationDenylist parses the comma separated words, empty words
+// are dropped.
+func parseRecapModerationDenylist(value string) []string {
+	return lo.Uniq(lo.FilterMap(strings.Split(value, ","), func(item string, _ int) (string, bool) {
+		item = strings.TrimSpace(item)
+
+		return item, item != ""
+	}))
+}
+
+const (
+	DefaultRecapDeliveryGroupRatePerSecond   = 5
+	DefaultRecapDeliveryPrivateRatePerSecond = 25
+	DefaultRecapDeliveryConcurrency          = 10
+)
+
+// parsePositiveInt parses the value as a positive integer, the defaultValue
+// will be used if the value is empty or invalid.
+func parsePositiveInt(envName string, value string, defaultValue int) int {
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		log.Printf("failed to parse %s %v: %v, should be number, fallbacks to %d", envName, value, err, defaultValue)
+
+		return defaultValue
+	}
+
+	if parsed <= 0 {
+		log.Printf("%s value %v is less than or equal to 0, fallbacks to %d", envName, value, defaultValue)
+
+		return defaultValue
+	}
+
+	return parsed
+}
+
+var (
+	DefaultRecapHashtags     = []string{"#recap"}
+	DefaultRecapAutoHashtags = []string{"#recap", "#recap_auto"}
+)
+
+var regexpTelegramHashtag = regexp.MustCompile(`^#[\p{L}\p{M}\p{N}_]+$`)
+
+// IsValidTelegramHashtag reports whether the tag is recognized as a hashtag
+// by Telegram, which consists of letters, digits and underscores after the
+// "#" and can not be made of digits only.
+func IsValidTelegramHashtag(tag string) bool {
+	if !regexpTelegramHashtag.MatchString(tag) {
+		return false
+	}
+
+	return strings.TrimLeftFunc(strings.TrimPrefix(tag, "#"), unicode.IsDigit) != ""
+}
+
+// parseRecapHashtags parses the space or comma separated hashtags, the
+// defaults will be used if the value is empty or contains invalid hashtags.
+func parseRecapHashtags(envName string, value string, defaults []string) []string {
+	tags := lo.Uniq(strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	}))
+	if len(tags) == 0 {
+		return defaults
+	}
+
+	for _, tag := range tags {
+		if !IsValidTelegramHashtag(tag) {
+			log.Printf("%s value %v contains invalid hashtag %s, fallbacks to %s", envName, value, tag, strings.Join(defaults, " "))
+
+			return defaults
+		}
+	}
+
+	return tags
 }
 
 type Config struct {
@@ -129,6 +376,18 @@ type Config struct {
 	LogFilePath          string
 	HardLimit            SectionHardLimit
 	LocalesDir           string
+	Recap                SectionRecap
+}
+
+// Location returns the timezone of the configured TimezoneShiftSeconds, which
+// the recaps are scheduled and the times are displayed and parsed in, UTC is
+// used if none was configured.
+func (c *Config) Location() *time.Location {
+	if c != nil && c.TimezoneShiftSeconds != 0 {
+		return time.FixedZone("Local", int(c.TimezoneShiftSeconds))
+	}
+
+	return time.UTC
 }
 
 func NewConfig() func() (*Config, error) {
@@ -207,6 +466,164 @@ func NewConfig() func() (*Config, error) {
 			log.Printf("%s value %v is greater than token limit, fallbacks to %v", EnvOpenAIAPIChatHistoriesRecapTokenLimit, getEnv(EnvOpenAIAPIChatHistoriesRecapTokenLimit), tokenLimit)
 		}
 
+		openAITemperature, openAITemperatureParseErr := strconv.ParseFloat(getEnv(EnvOpenAIAPITemperature), 64)
+		if openAITemperatureParseErr != nil {
+			if getEnv(EnvOpenAIAPITemperature) != "" {
+				log.Printf("failed to parse %s %v: %v, should be number", EnvOpenAIAPITemperature, getEnv(EnvOpenAIAPITemperature), openAITemperatureParseErr)
+			}
+
+			openAITemperature = -1
+		}
+
+		if openAITemperatureParseErr == nil && (openAITemperature < 0 || openAITemperature > 2) {
+			openAITemperature = -1
+
+			log.Printf("%s value %v is not between 0 and 2, fallbacks to the API default", EnvOpenAIAPITemperature, getEnv(EnvOpenAIAPITemperature))
+		}
+
+		openAICircuitBreakerFailureThreshold, openAICircuitBreakerFailureThresholdParseErr := strconv.ParseInt(getEnv(EnvOpenAICircuitBreakerFailureThreshold), 10, 64)
+		if openAICircuitBreakerFailureThresholdParseErr != nil {
+			if getEnv(EnvOpenAICircuitBreakerFailureThreshold) != "" {
+				log.Printf("failed to parse %s %v: %v, should be number", EnvOpenAICircuitBreakerFailureThreshold, getEnv(EnvOpenAICircuitBreakerFailureThreshold), openAICircuitBreakerFailureThresholdParseErr)
+			}
+
+			openAICircuitBreakerFailureThreshold = 5
+		}
+
+		if openAICircuitBreakerFailureThreshold < 0 {
+			openAICircuitBreakerFailureThreshold = 0
+
+			log.Printf("%s value %v is less than 0, fallbacks to 0", EnvOpenAICircuitBreakerFailureThreshold, getEnv(EnvOpenAICircuitBreakerFailureThreshold))
+		}
+
+		openAICircuitBreakerCooldownSeconds, openAICircuitBreakerCooldownSecondsParseErr := strconv.ParseInt(getEnv(EnvOpenAICircuitBreakerCooldownSeconds), 10, 64)
+		if openAICircuitBreakerCooldownSecondsParseErr != nil && getEnv(EnvOpenAICircuitBreakerCooldownSeconds) != "" {
+			log.Printf("failed to parse %s %v: %v, should be number", EnvOpenAICircuitBreakerCooldownSeconds, getEnv(EnvOpenAICircuitBreakerCooldownSeconds), openAICircuitBreakerCooldownSecondsParseErr)
+		}
+
+		if openAICircuitBreakerCooldownSeconds <= 0 {
+			openAICircuitBreakerCooldownSeconds = 60
+		}
+
+		openAIPromptTokenPrice, openAIPromptTokenPriceParseErr := strconv.ParseFloat(getEnv(EnvOpenAIPromptTokenPrice), 64)
+		if openAIPromptTokenPriceParseErr != nil && getEnv(EnvOpenAIPromptTokenPrice) != "" {
+			log.Printf("failed to parse %s %v: %v, should be number", EnvOpenAIPromptTokenPrice, getEnv(EnvOpenAIPromptTokenPrice), openAIPromptTokenPriceParseErr)
+		}
+
+		openAICompletionTokenPrice, openAICompletionTokenPriceParseErr := strconv.ParseFloat(getEnv(EnvOpenAICompletionTokenPrice), 64)
+		if openAICompletionTokenPriceParseErr != nil && getEnv(EnvOpenAICompletionTokenPrice) != "" {
+			log.Printf("failed to parse %s %v: %v, should be number", EnvOpenAICompletionTokenPrice, getEnv(EnvOpenAICompletionTokenPrice), openAICompletionTokenPriceParseErr)
+		}
+
+		recapMinContentRichness, recapMinContentRichnessParseErr := strconv.ParseFloat(getEnv(EnvRecapMinContentRichness), 64)
+		if recapMinContentRichnessParseErr != nil && getEnv(EnvRecapMinContentRichness) != "" {
+			log.Printf("failed to parse %s %v: %v, should be number", EnvRecapMinContentRichness, getEnv(EnvRecapMinContentRichness), recapMinContentRichnessParseErr)
+		}
+
+		if recapMinContentRichness < 0 {
+			recapMinContentRichness = 0
+
+			log.Printf("%s value %v is less than 0, fallbacks to 0", EnvRecapMinContentRichness, getEnv(EnvRecapMinContentRichness))
+		}
+
+		recapFloodMinUniqueRatio, recapFloodMinUniqueRatioParseErr := strconv.ParseFloat(getEnv(EnvRecapFloodMinUniqueRatio), 64)
+		if recapFloodMinUniqueRatioParseErr != nil && getEnv(EnvRecapFloodMinUniqueRatio) != "" {
+			log.Printf("failed to parse %s %v: %v, should be number", EnvRecapFloodMinUniqueRatio, getEnv(EnvRecapFloodMinUniqueRatio), recapFloodMinUniqueRatioParseErr)
+		}
+
+		if recapFloodMinUniqueRatio < 0 || recapFloodMinUniqueRatio > 1 {
+			recapFloodMinUniqueRatio = 0
+
+			log.Printf("%s value %v is not between 0 and 1, fallbacks to 0", EnvRecapFloodMinUniqueRatio, getEnv(EnvRecapFloodMinUniqueRatio))
+		}
+
+		recapFloodMinMessages, recapFloodMinMessagesParseErr := strconv.Atoi(getEnv(EnvRecapFloodMinMessages))
+		if recapFloodMinMessagesParseErr != nil {
+			if getEnv(EnvRecapFloodMinMessages) != "" {
+				log.Printf("failed to parse %s %v: %v, should be number", EnvRecapFloodMinMessages, getEnv(EnvRecapFloodMinMessages), recapFloodMinMessagesParseErr)
+			}
+
+			recapFloodMinMessages = 50
+		}
+
+		if recapFloodMinMessages < 0 {
+			recapFloodMinMessages = 50
+
+			log.Printf("%s value %v is less than 0, fallbacks to 50", EnvRecapFloodMinMessages, getEnv(EnvRecapFloodMinMessages))
+		}
+
+		recapApprovalExpiryHours, recapApprovalExpiryHoursParseErr := strconv.Atoi(getEnv(EnvRecapApprovalExpiryHours))
+		if recapApprovalExpiryHoursParseErr != nil {
+			if getEnv(EnvRecapApprovalExpiryHours) != "" {
+				log.Printf("failed to parse %s %v: %v, should be number", EnvRecapApprovalExpiryHours, getEnv(EnvRecapApprovalExpiryHours), recapApprovalExpiryHoursParseErr)
+			}
+
+			recapApprovalExpiryHours = 24
+		}
+
+		if recapApprovalExpiryHours <= 0 {
+			recapApprovalExpiryHours = 24
+
+			log.Printf("%s value %v is not greater than 0, fallbacks to 24", EnvRecapApprovalExpiryHours, getEnv(EnvRecapApprovalExpiryHours))
+		}
+
+		recapDuplicateSimilarityThreshold, recapDuplicateSimilarityThresholdParseErr := strconv.ParseFloat(getEnv(EnvRecapDuplicateSimilarityThreshold), 64)
+		if recapDuplicateSimilarityThresholdParseErr != nil {
+			if getEnv(EnvRecapDuplicateSimilarityThreshold) != "" {
+				log.Printf("failed to parse %s %v: %v, should be number", EnvRecapDuplicateSimilarityThreshold, getEnv(EnvRecapDuplicateSimilarityThreshold), recapDuplicateSimilarityThresholdParseErr)
+			}
+
+			recapDuplicateSimilarityThreshold = 0.9
+		}
+
+		if recapDuplicateSimilarityThreshold < 0 || recapDuplicateSimilarityThreshold > 1 {
+			recapDuplicateSimilarityThreshold = 0.9
+
+			log.Printf("%s value %v is not between 0 and 1, fallbacks to 0.9", EnvRecapDuplicateSimilarityThreshold, getEnv(EnvRecapDuplicateSimilarityThreshold))
+		}
+
+		recapMaxMessagesPerSummary, recapMaxMessagesPerSummaryParseErr := strconv.Atoi(getEnv(EnvRecapMaxMessagesPerSummary))
+		if recapMaxMessagesPerSummaryParseErr != nil {
+			if getEnv(EnvRecapMaxMessagesPerSummary) != "" {
+				log.Printf("failed to parse %s %v: %v, should be number", EnvRecapMaxMessagesPerSummary, getEnv(EnvRecapMaxMessagesPerSummary), recapMaxMessagesPerSummaryParseErr)
+			}
+
+			recapMaxMessagesPerSummary = 1000
+		}
+
+		if recapMaxMessagesPerSummary < 0 {
+			recapMaxMessagesPerSummary = 0
+
+			log.Printf("%s value %v is less than 0, fallbacks to 0", EnvRecapMaxMessagesPerSummary, getEnv(EnvRecapMaxMessagesPerSummary))
+		}
+
+		telegramFeedbackChatID, telegramFeedbackChatIDParseErr := strconv.ParseInt(getEnv(EnvTelegramFeedbackChatID), 10, 64)
+		if telegramFeedbackChatIDParseErr != nil && getEnv(EnvTelegramFeedbackChatID) != "" {
+			log.Printf("failed to parse %s %v: %v, should be number", EnvTelegramFeedbackChatID, getEnv(EnvTelegramFeedbackChatID), telegramFeedbackChatIDParseErr)
+		}
+
+		recapFirstAutoRecapWarmUpSeconds, recapFirstAutoRecapWarmUpSecondsParseErr := strconv.ParseInt(getEnv(EnvRecapFirstAutoRecapWarmUpSeconds), 10, 64)
+		if recapFirstAutoRecapWarmUpSecondsParseErr != nil {
+			if getEnv(EnvRecapFirstAutoRecapWarmUpSeconds) != "" {
+				log.Printf("failed to parse %s %v: %v, should be number", EnvRecapFirstAutoRecapWarmUpSeconds, getEnv(EnvRecapFirstAutoRecapWarmUpSeconds), recapFirstAutoRecapWarmUpSecondsParseErr)
+			}
+
+			recapFirstAutoRecapWarmUpSeconds = -1
+		}
+
+		if recapFirstAutoRecapWarmUpSeconds < 0 {
+			recapFirstAutoRecapWarmUpSeconds = -1
+		}
+
+		openAIAPIType := OpenAIAPIType(strings.ToLower(getEnv(EnvOpenAIAPIType)))
+		if openAIAPIType != OpenAIAPITypeOpenAI && openAIAPIType != OpenAIAPITypeAzure {
+			if openAIAPIType != "" {
+				log.Printf("%s value %v is not supported, should be one of openai, azure, fallbacks to openai", EnvOpenAIAPIType, getEnv(EnvOpenAIAPIType))
+			}
+
+			openAIAPIType = OpenAIAPITypeOpenAI
+		}
+
 		return &Config{
 			TimezoneShiftSeconds: lo.Ternary(timezoneShiftSecondsParseErr == nil, lo.Ternary(timezoneShiftSeconds != 0, timezoneShiftSeconds, 0), 0),
 			Telegram: SectionTelegram{
@@ -214,6 +631,7 @@ func NewConfig() func() (*Config, error) {
 				BotWebhookURL:  getEnv(EnvTelegramBotWebhookURL),
 				BotWebhookPort: getEnv(EnvTelegramBotWebhookPort),
 				BotAPIEndpoint: getEnv(EnvTelegramBotAPIEndpoint),
+				FeedbackChatID: telegramFeedbackChatID,
 			},
 			Slack: SectionSlack{
 				Port:         getEnv(EnvSlackWebhookPort),
@@ -222,10 +640,22 @@ func NewConfig() func() (*Config, error) {
 			},
 			OpenAI: SectionOpenAI{
 				Secret:                       getEnv(EnvOpenAIAPISecret),
+				APIKeys:                      parseOpenAIAPIKeys(getEnv(EnvOpenAIAPIKeys), getEnv(EnvOpenAIAPISecret)),
 				Host:                         getEnv(EnvOpenAIAPIHost),
+				BaseURL:                      getEnv(EnvOpenAIAPIBaseURL),
+				APIType:                      openAIAPIType,
+				APIVersion:                   getEnv(EnvOpenAIAPIVersion),
+				ExtraHeaders:                 parseOpenAIAPIExtraHeaders(getEnv(EnvOpenAIAPIExtraHeaders)),
 				ModelName:                    lo.Ternary(getEnv(EnvOpenAIAPIModelName) == "", goopenai.GPT3Dot5Turbo, getEnv(EnvOpenAIAPIModelName)),
 				TokenLimit:                   lo.Ternary(tokenLimitParseErr == nil, lo.Ternary(tokenLimit != 0, tokenLimit, 4096), 4096),
 				ChatHistoriesRecapTokenLimit: lo.Ternary(chatHistoriesRecapTokenLimitParseErr == nil, lo.Ternary(chatHistoriesRecapTokenLimit != 0, chatHistoriesRecapTokenLimit, 2000), 2000),
+				Temperature:                  openAITemperature,
+
+				CircuitBreakerFailureThreshold: openAICircuitBreakerFailureThreshold,
+				CircuitBreakerCooldownSeconds:  openAICircuitBreakerCooldownSeconds,
+
+				PromptTokenPrice:     lo.Ternary(openAIPromptTokenPriceParseErr == nil && openAIPromptTokenPrice > 0, openAIPromptTokenPrice, 0),
+				CompletionTokenPrice: lo.Ternary(openAICompletionTokenPriceParseErr == nil && openAICompletionTokenPrice > 0, openAICompletionTokenPrice, 0),
 			},
 			Pinecone: SectionPinecone{
 				ProjectName: getEnv(EnvPineconeProjectName),
@@ -259,6 +689,28 @@ func NewConfig() func() (*Config, error) {
 				SummarizeWebpageRatePerSeconds: summarizeWebpageRatePerSecondsHardLimit,
 			},
 			LocalesDir: getEnv(EnvLocalesDir),
+			Recap: SectionRecap{
+				AdaptivePrompt:               getEnv(EnvRecapAdaptivePrompt) == "true" || getEnv(EnvRecapAdaptivePrompt) == "1",
+				MinContentRichness:           recapMinContentRichness,
+				FloodMinUniqueRatio:          recapFloodMinUniqueRatio,
+				FloodMinMessages:             recapFloodMinMessages,
+				ApprovalExpiryHours:          recapApprovalExpiryHours,
+				DuplicateSimilarityThreshold: recapDuplicateSimilarityThreshold,
+				MaxMessagesPerSummary:        recapMaxMessagesPerSummary,
+				FirstAutoRecapWarmUpSeconds:  recapFirstAutoRecapWarmUpSeconds,
+				WebhookURL:                   getEnv(EnvRecapWebhookURL),
+				WebhookSecret:                getEnv(EnvRecapWebhookSecret),
+				Hashtags:                     parseRecapHashtags(EnvRecapHashtags, getEnv(EnvRecapHashtags), DefaultRecapHashtags),
+				AutoHashtags:                 parseRecapHashtags(EnvRecapAutoHashtags, getEnv(EnvRecapAutoHashtags), DefaultRecapAutoHashtags),
+				AboutTemplate:                strings.ReplaceAll(getEnv(EnvRecapAboutTemplate), `\n`, "\n"),
+				ModerationMode:               parseRecapModerationMode(getEnv(EnvRecapModerationMode)),
+				ModerationDenylist:           parseRecapModerationDenylist(getEnv(EnvRecapModerationDenylist)),
+				ModerationOpenAI:             getEnv(EnvRecapModerationOpenAI) == "true" || getEnv(EnvRecapModerationOpenAI) == "1",
+				DeliveryGroupRatePerSecond:   parsePositiveInt(EnvRecapDeliveryGroupRatePerSecond, getEnv(EnvRecapDeliveryGroupRatePerSecond), DefaultRecapDeliveryGroupRatePerSecond),
+				DeliveryPrivateRatePerSecond: parsePositiveInt(EnvRecapDeliveryPrivateRatePerSecond, getEnv(EnvRecapDeliveryPrivateRatePerSecond), DefaultRecapDeliveryPrivateRatePerSecond),
+				DeliveryConcurrency:          parsePositiveInt(EnvRecapDeliveryConcurrency, getEnv(EnvRecapDeliveryConcurrency), DefaultRecapDeliveryConcurrency),
+				KeywordsStopWordsDir:         getEnv(EnvRecapKeywordsStopWordsDir),
+			},
 		}, nil
 	}
 }
@@ -0,0 +1,52 @@
+package configs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidTelegramHashtag(t *testing.T) {
+	for _, tag := range []string{"#recap", "#recap_auto", "#mybot_recap", "#回顾", "#recap2024", "#_"} {
+		assert.True(t, IsValidTelegramHashtag(tag), tag)
+	}
+
+	for _, tag := range []string{"recap", "#", "#2024", "#re-cap", "#re cap", "##recap", "#recap!"} {
+		assert.False(t, IsValidTelegramHashtag(tag), tag)
+	}
+}
+
+func TestParseRecapHashtags(t *testing.T) {
+	assert.Equal(t, DefaultRecapAutoHashtags, parseRecapHashtags(EnvRecapAutoHashtags, "", DefaultRecapAutoHashtags))
+	assert.Equal(t, []string{"#mybot", "#mybot_auto"}, parseRecapHashtags(EnvRecapAutoHashtags, "#mybot, #mybot_auto #mybot", DefaultRecapAutoHashtags))
+	assert.Equal(t, DefaultRecapHashtags, parseRecapHashtags(EnvRecapHashtags, "#mybot #my-bot", DefaultRecapHashtags))
+}
+
+func TestParseRecapModerationMode(t *testing.T) {
+	assert.Equal(t, RecapModerationModeOff, parseRecapModerationMode(""))
+	assert.Equal(t, RecapModerationModeRedact, parseRecapModerationMode("redact"))
+	assert.Equal(t, RecapModerationModeHold, parseRecapModerationMode(" Hold "))
+	assert.Equal(t, RecapModerationModeDrop, parseRecapModerationMode("drop"))
+	assert.Equal(t, RecapModerationModeOff, parseRecapModerationMode("delete"))
+}
+
+func TestParseRecapModerationDenylist(t *testing.T) {
+	assert.Empty(t, parseRecapModerationDenylist(""))
+	assert.Equal(t, []string{"spam", "广告"}, parseRecapModerationDenylist("spam, 广告,,spam "))
+}
+
+func TestParsePositiveInt(t *testing.T) {
+	assert.Equal(t, DefaultRecapDeliveryGroupRatePerSecond, parsePositiveInt(EnvRecapDeliveryGroupRatePerSecond, "", DefaultRecapDeliveryGroupRatePerSecond))
+	assert.Equal(t, 20, parsePositiveInt(EnvRecapDeliveryGroupRatePerSecond, " 20 ", DefaultRecapDeliveryGroupRatePerSecond))
+	assert.Equal(t, DefaultRecapDeliveryGroupRatePerSecond, parsePositiveInt(EnvRecapDeliveryGroupRatePerSecond, "0", DefaultRecapDeliveryGroupRatePerSecond))
+	assert.Equal(t, DefaultRecapDeliveryGroupRatePerSecond, parsePositiveInt(EnvRecapDeliveryGroupRatePerSecond, "fast", DefaultRecapDeliveryGroupRatePerSecond))
+}
+
+func TestConfigLocation(t *testing.T) {
+	assert.Equal(t, time.UTC, (*Config)(nil).Location())
+	assert.Equal(t, time.UTC, (&Config{}).Location())
+
+	_, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, (&Config{TimezoneShiftSeconds: 8 * 60 * 60}).Location()).Zone()
+	assert.Equal(t, 8*60*60, offset)
+}
@@ -10,5 +10,8 @@ func NewModules() fx.Option {
 		fx.Provide(NewEnt()),
 		fx.Provide(NewRedis()),
 		fx.Provide(NewAutoRecapTimeCapsuleDigger()),
+		fx.Provide(NewPrivateRecapDigestTimeCapsuleDigger()),
+		fx.Provide(NewAutoUnpinRecapTimeCapsuleDigger()),
+		fx.Provide(NewApprovedAutoRecapTimeCapsuleDigger()),
 	)
 }
@@ -59,3 +59,141 @@ func NewAutoRecapTimeCapsuleDigger() func(NewAutoRecapTimeCapsuleDiggerParams) (
 		return digger, nil
 	}
 }
+
+type NewPrivateRecapDigestTimeCapsuleDiggerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+
+	Logger *logger.Logger
+	Redis  *Redis
+}
+
+type PrivateRecapDigestTimeCapsuleDigger struct {
+	*timecapsule.TimeCapsuleDigger[timecapsules.PrivateRecapDigestCapsule]
+	started bool
+}
+
+func (d *PrivateRecapDigestTimeCapsuleDigger) Check(ctx context.Context) error {
+	return lo.Ternary(d.started, nil, errors.New("digger not started"))
+}
+
+func NewPrivateRecapDigestTimeCapsuleDigger() func(NewPrivateRecapDigestTimeCapsuleDiggerParams) (*PrivateRecapDigestTimeCapsuleDigger, error) {
+	return func(params NewPrivateRecapDigestTimeCapsuleDiggerParams) (*PrivateRecapDigestTimeCapsuleDigger, error) {
+		dataloader := timecapsule.NewRueidisDataloader[timecapsules.PrivateRecapDigestCapsule](redis.TimeCapsulePrivateRecapDigestSortedSetKey.Format(), params.Redis)
+
+		digger := &PrivateRecapDigestTimeCapsuleDigger{TimeCapsuleDigger: timecapsule.NewDigger[timecapsules.PrivateRecapDigestCapsule](
+			dataloader,
+			time.Second,
+			timecapsule.TimeCapsuleDiggerOption{Logger: params.Logger.LogrusLogger},
+		)}
+
+		params.Lifecycle.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				go digger.Start()
+
+				digger.started = true
+
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				digger.Stop()
+				return nil
+			},
+		})
+
+		return digger, nil
+	}
+}
+
+type NewAutoUnpinRecapTimeCapsuleDiggerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+
+	Logger *logger.Logger
+	Redis  *Redis
+}
+
+type AutoUnpinRecapTimeCapsuleDigger struct {
+	*timecapsule.TimeCapsuleDigger[timecapsules.AutoUnpinRecapCapsule]
+	started bool
+}
+
+func (d *AutoUnpinRecapTimeCapsuleDigger) Check(ctx context.Context) error {
+	return lo.Ternary(d.started, nil, errors.New("digger not started"))
+}
+
+func NewAutoUnpinRecapTimeCapsuleDigger() func(NewAutoUnpinRecapTimeCapsuleDiggerParams) (*AutoUnpinRecapTimeCapsuleDigger, error) {
+	return func(params NewAutoUnpinRecapTimeCapsuleDiggerParams) (*AutoUnpinRecapTimeCapsuleDigger, error) {
+		dataloader := timecapsule.NewRueidisDataloader[timecapsules.AutoUnpinRecapCapsule](redis.TimeCapsuleAutoUnpinRecapSortedSetKey.Format(), params.Redis)
+
+		digger := &AutoUnpinRecapTimeCapsuleDigger{TimeCapsuleDigger: timecapsule.NewDigger[timecapsules.AutoUnpinRecapCapsule](
+			dataloader,
+			time.Second,
+			timecapsule.TimeCapsuleDiggerOption{Logger: params.Logger.LogrusLogger},
+		)}
+
+		params.Lifecycle.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				go digger.Start()
+
+				digger.started = true
+
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				digger.Stop()
+				return nil
+			},
+		})
+
+		return digger, nil
+	}
+}
+
+type NewApprovedAutoRecapTimeCapsuleDiggerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+
+	Logger *logger.Logger
+	Redis  *Redis
+}
+
+type ApprovedAutoRecapTimeCapsuleDigger struct {
+	*timecapsule.TimeCapsuleDigger[timecapsules.ApprovedAutoRecapCapsule]
+	started bool
+}
+
+func (d *ApprovedAutoRecapTimeCapsuleDigger) Check(ctx context.Context) error {
+	return lo.Ternary(d.started, nil, errors.New("digger not started"))
+}
+
+func NewApprovedAutoRecapTimeCapsuleDigger() func(NewApprovedAutoRecapTimeCapsuleDiggerParams) (*ApprovedAutoRecapTimeCapsuleDigger, error) {
+	return func(params NewApprovedAutoRecapTimeCapsuleDiggerParams) (*ApprovedAutoRecapTimeCapsuleDigger, error) {
+		dataloader := timecapsule.NewRueidisDataloader[timecapsules.ApprovedAutoRecapCapsule](redis.TimeCapsuleApprovedAutoRecapSortedSetKey.Format(), params.Redis)
+
+		digger := &ApprovedAutoRecapTimeCapsuleDigger{TimeCapsuleDigger: timecapsule.NewDigger[timecapsules.ApprovedAutoRecapCapsule](
+			dataloader,
+			time.Second,
+			timecapsule.TimeCapsuleDiggerOption{Logger: params.Logger.LogrusLogger},
+		)}
+
+		params.Lifecycle.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				go digger.Start()
+
+				digger.started = true
+
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				digger.Stop()
+				return nil
+			},
+		})
+
+		return digger, nil
+	}
+}
@@ -0,0 +1,60 @@
+package chathistories
+
+import (
+	"go.uber.org/zap"
+)
+
+const (
+	// adaptivePromptRecentRecapsCount is the number of the latest recaps of a
+	// chat whose votes are taken into account.
+	adaptivePromptRecentRecapsCount = 5
+	// adaptivePromptMinimumVotes is the minimum number of up votes and down
+	// votes required before the vote ratio is considered meaningful.
+	adaptivePromptMinimumVotes = 3
+	// adaptivePromptDownVotesRatioThreshold is the ratio of down votes to all
+	// up votes and down votes at which the extra instruction gets appended.
+	adaptivePromptDownVotesRatioThreshold = 0.5
+)
+
+const adaptivePromptInstruction = "Previous recaps of this chat were rated unhelpful by its members, so please be more concise and specific this time: " +
+	"prefer fewer but more meaningful topics, and make every point state concrete facts, decisions, or questions instead of vague descriptions."
+
+// adaptivePromptInstructionsFromReactionsCounts returns the extra instructions
+// for the summarization prompt based on the votes of the recent recaps, the
+// instructions will only be returned when the down votes ratio crosses
+// adaptivePromptDownVotesRatioThreshold.
+func adaptivePromptInstructionsFromReactionsCounts(counts FeedbackChatHistoriesRecapsReactionsCounts) []string {
+	votes := counts.UpVotes + counts.DownVotes
+	if votes < adaptivePromptMinimumVotes {
+		return nil
+	}
+
+	if float64(counts.DownVotes)/float64(votes) < adaptivePromptDownVotesRatioThreshold {
+		return nil
+	}
+
+	return []string{adaptivePromptInstruction}
+}
+
+func (m *Model) adaptivePromptInstructions(chatID int64) []string {
+	if !m.config.Recap.AdaptivePrompt {
+		return nil
+	}
+
+	counts, err := m.FindRecentFeedbackRecapsReactionCountsForChatID(chatID, adaptivePromptRecentRecapsCount)
+	if err != nil {
+		m.logger.Error("failed to find recent recaps reaction counts for adaptive prompt, skipping...", zap.Int64("chat_id", chatID), zap.Error(err))
+		return nil
+	}
+
+	instructions := adaptivePromptInstructionsFromReactionsCounts(counts)
+	if len(instructions) > 0 {
+		m.logger.Info("recent recaps were rated unhelpful, appending adaptive instructions to prompt",
+			zap.Int64("chat_id", chatID),
+			zap.Int("up_votes", counts.UpVotes),
+			zap.Int("down_votes", counts.DownVotes),
+		)
+	}
+
+	return instructions
+}
@@ -0,0 +1,45 @@
+package chathistories
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+)
+
+func TestAdaptivePromptInstructionsFromReactionsCounts(t *testing.T) {
+	t.Run("NotEnoughVotes", func(t *testing.T) {
+		assert.Empty(t, adaptivePromptInstructionsFromReactionsCounts(FeedbackChatHistoriesRecapsReactionsCounts{DownVotes: 2}))
+	})
+
+	t.Run("BelowThreshold", func(t *testing.T) {
+		assert.Empty(t, adaptivePromptInstructionsFromReactionsCounts(FeedbackChatHistoriesRecapsReactionsCounts{UpVotes: 3, DownVotes: 2, Lmao: 10}))
+	})
+
+	t.Run("ThresholdCrossed", func(t *testing.T) {
+		assert.Equal(t, []string{adaptivePromptInstruction}, adaptivePromptInstructionsFromReactionsCounts(FeedbackChatHistoriesRecapsReactionsCounts{UpVotes: 1, DownVotes: 3}))
+	})
+
+	t.Run("PromptOnlyContainsInstructionWhenThresholdCrossed", func(t *testing.T) {
+		sb := new(strings.Builder)
+		err := openai.ChatHistorySummarizationPrompt.Execute(sb, openai.NewChatHistorySummarizationPromptInputs(
+			"msgId:1: John sent: Hello",
+			"",
+			adaptivePromptInstructionsFromReactionsCounts(FeedbackChatHistoriesRecapsReactionsCounts{UpVotes: 4, DownVotes: 1})...,
+		))
+		require.NoError(t, err)
+		assert.NotContains(t, sb.String(), adaptivePromptInstruction)
+
+		sb.Reset()
+		err = openai.ChatHistorySummarizationPrompt.Execute(sb, openai.NewChatHistorySummarizationPromptInputs(
+			"msgId:1: John sent: Hello",
+			"",
+			adaptivePromptInstructionsFromReactionsCounts(FeedbackChatHistoriesRecapsReactionsCounts{UpVotes: 1, DownVotes: 4})...,
+		))
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(sb.String(), "\n"+adaptivePromptInstruction))
+	})
+}
@@ -0,0 +1,177 @@
+package chathistories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+)
+
+// WithSummarizeChatHistoriesAnonymizeParticipants replaces the participants
+// with pseudonyms like 用户A and 用户B in both the prompt and the rendered
+// recap, so that the recap never names who said what.
+func WithSummarizeChatHistoriesAnonymizeParticipants(anonymize bool) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.AnonymizeParticipants = anonymize
+	})
+}
+
+func pseudonymAt(index int) string {
+	if index < 26 {
+		return "用户" + string(rune('A'+index))
+	}
+
+	return fmt.Sprintf("用户%d", index+1)
+}
+
+type chatHistoriesPseudonyms struct {
+	byKey      map[string]string
+	pseudonyms []string
+	realNames  map[string]string
+}
+
+func newChatHistoriesPseudonyms() *chatHistoriesPseudonyms {
+	return &chatHistoriesPseudonyms{
+		byKey:      make(map[string]string),
+		pseudonyms: make([]string, 0),
+		realNames:  make(map[string]string),
+	}
+}
+
+func (p *chatHistoriesPseudonyms) of(key string) string {
+	pseudonym, ok := p.byKey[key]
+	if ok {
+		return pseudonym
+	}
+
+	pseudonym = pseudonymAt(len(p.pseudonyms))
+	p.byKey[key] = pseudonym
+	p.pseudonyms = append(p.pseudonyms, pseudonym)
+
+	return pseudonym
+}
+
+func (p *chatHistoriesPseudonyms) ofUser(userID int64, fullName string, username string) string {
+	pseudonym := p.of(fmt.Sprintf("user:%d", userID))
+
+	if utf8.RuneCountInString(fullName) >= 2 {
+		p.realNames[fullName] = pseudonym
+		p.byKey["name:"+fullName] = pseudonym
+	}
+
+	if username != "" {
+		p.realNames["@"+username] = pseudonym
+	}
+
+	return pseudonym
+}
+
+func (p *chatHistoriesPseudonyms) ofName(name string) string {
+	pseudonym := p.of("name:" + name)
+
+	if utf8.RuneCountInString(name) >= 2 {
+		p.realNames[name] = pseudonym
+	}
+
+	return pseudonym
+}
+
+// replacer replaces the real names mentioned in texts, the longer names are
+// matched first so that a name is never partially replaced.
+func (p *chatHistoriesPseudonyms) replacer() *strings.Replacer {
+	names := lo.Keys(p.realNames)
+	sort.SliceStable(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+
+		return names[i] < names[j]
+	})
+
+	oldnew := make([]string, 0, len(names)*2)
+	for _, name := range names {
+		oldnew = append(oldnew, name, p.realNames[name])
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+// AnonymizeChatHistories returns the copies of the chat histories with the
+// participants, the replied participants, the forwarded sources and their
+// mentions in texts replaced by pseudonyms, the same participant always gets
+// the same pseudonym within one call. The assigned pseudonyms are returned in
+// the order of appearance.
+func AnonymizeChatHistories(histories []*ent.ChatHistories) ([]*ent.ChatHistories, []string) {
+	pseudonyms := newChatHistoriesPseudonyms()
+
+	for _, history := range histories {
+		pseudonyms.ofUser(history.UserID, history.FullName, history.Username)
+
+		if history.RepliedToMessageID != 0 {
+			pseudonyms.ofUser(history.RepliedToUserID, history.RepliedToFullName, history.RepliedToUsername)
+		}
+	}
+
+	forwardedPrefixes := make(map[*ent.ChatHistories]string)
+
+	for _, history := range histories {
+		prefix := regexpForwardedChatHistoryPrefix.FindString(history.Text)
+		if prefix == "" {
+			continue
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(prefix, "[forwarded from "), "]: ")
+		forwardedPrefixes[history] = fmt.Sprintf("[forwarded from %s]: ", pseudonyms.ofName(name))
+	}
+
+	replacer := pseudonyms.replacer()
+
+	anonymized := lo.Map(histories, func(history *ent.ChatHistories, _ int) *ent.ChatHistories {
+		copied := *history
+		copied.FullName = pseudonyms.byKey[fmt.Sprintf("user:%d", history.UserID)]
+		copied.Username = ""
+		copied.ChatTitle = ""
+
+		if prefix, ok := forwardedPrefixes[history]; ok {
+			copied.Text = prefix + replacer.Replace(regexpForwardedChatHistoryPrefix.ReplaceAllString(history.Text, ""))
+		} else {
+			copied.Text = replacer.Replace(history.Text)
+		}
+
+		if history.PollQuestion != "" {
+			copied.PollQuestion = replacer.Replace(history.PollQuestion)
+			copied.PollOptions = lo.Map(history.PollOptions, func(option tgbotapi.PollOption, _ int) tgbotapi.PollOption {
+				option.Text = replacer.Replace(option.Text)
+
+				return option
+			})
+		}
+
+		if history.RepliedToMessageID != 0 {
+			copied.RepliedToFullName = pseudonyms.byKey[fmt.Sprintf("user:%d", history.RepliedToUserID)]
+			copied.RepliedToUsername = ""
+			copied.RepliedToText = replacer.Replace(history.RepliedToText)
+		}
+
+		return &copied
+	})
+
+	return anonymized, pseudonyms.pseudonyms
+}
+
+// pseudonymizeParticipants keeps only the known pseudonyms in the participants
+// of the outputs, so that the names made up by the model are dropped as well.
+func pseudonymizeParticipants(outputs []*openai.ChatHistorySummarizationOutputs, pseudonyms []string) {
+	for _, output := range outputs {
+		output.Participants = lo.Filter(output.Participants, func(item string, _ int) bool {
+			return lo.Contains(pseudonyms, strings.TrimPrefix(item, "@"))
+		})
+	}
+}
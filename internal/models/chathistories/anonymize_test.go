@@ -0,0 +1,99 @@
+package chathistories
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func newAnonymizeTestChatHistories() []*ent.ChatHistories {
+	return []*ent.ChatHistories{
+		{MessageID: 100, UserID: 1, FullName: "Alice Liddell", Username: "alice", Text: "明天要不要一起去爬山"},
+		{MessageID: 101, UserID: 2, FullName: "Bob", Username: "bobby", Text: "@alice 可以的，早上八点集合吧", RepliedToMessageID: 100, RepliedToUserID: 1, RepliedToFullName: "Alice Liddell", RepliedToUsername: "alice", RepliedToText: "明天要不要一起去爬山"},
+		{MessageID: 102, UserID: 1, FullName: "Alice Liddell", Username: "alice", Text: "[forwarded from Carol]: 山上天气很好"},
+		{MessageID: 103, UserID: 3, FullName: "Dave", Text: "Bob 记得带水"},
+	}
+}
+
+func TestAnonymizeChatHistories(t *testing.T) {
+	histories := newAnonymizeTestChatHistories()
+
+	anonymized, pseudonyms := AnonymizeChatHistories(histories)
+	require.Len(t, anonymized, 4)
+
+	assert.Equal(t, []string{"用户A", "用户B", "用户C", "用户D"}, pseudonyms)
+
+	assert.Equal(t, "用户A", anonymized[0].FullName)
+	assert.Empty(t, anonymized[0].Username)
+	assert.Equal(t, "用户B", anonymized[1].FullName)
+	assert.Equal(t, "用户A 可以的，早上八点集合吧", anonymized[1].Text)
+	assert.Equal(t, "用户A", anonymized[1].RepliedToFullName)
+	assert.Empty(t, anonymized[1].RepliedToUsername)
+	assert.Equal(t, "用户A", anonymized[2].FullName)
+	assert.Equal(t, "[forwarded from 用户D]: 山上天气很好", anonymized[2].Text)
+	assert.Equal(t, "用户C", anonymized[3].FullName)
+	assert.Equal(t, "用户B 记得带水", anonymized[3].Text)
+
+	// the original chat histories are left untouched
+	assert.Equal(t, "Alice Liddell", histories[0].FullName)
+	assert.Equal(t, "@alice 可以的，早上八点集合吧", histories[1].Text)
+}
+
+func TestGenerateChatHistoriesRecapAnonymizeParticipants(t *testing.T) {
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(t, err)
+
+	var prompt string
+
+	openAIClient := &openaimock.MockClient{}
+	openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+		return []string{s}
+	}
+	openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, s string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+		prompt = s
+
+		return &goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{
+				Content: `[{"topicName":"周末爬山","sinceId":1,"participants":["@用户A","用户B","Alice Liddell"],"discussion":[{"point":"用户A和用户B约好早上八点集合","keyIds":[1,2]}],"conclusion":"周六出发"}]`,
+			}}},
+		}, nil
+	}
+
+	m := &Model{
+		config: config,
+		logger: logger,
+		openAI: openAIClient,
+	}
+
+	recap, err := m.GenerateChatHistoriesRecap(-100123456789, telegram.ChatTypeSuperGroup, newAnonymizeTestChatHistories(), WithSummarizeChatHistoriesAnonymizeParticipants(true))
+	require.NoError(t, err)
+
+	require.Len(t, recap.Outputs, 1)
+	assert.Equal(t, []string{"用户A", "用户B"}, recap.Outputs[0].Participants)
+	assert.Equal(t, []int64{100, 101}, recap.Outputs[0].Discussion[0].KeyIDs)
+
+	rendered := strings.Join(recap.Summarizations, "\n")
+	assert.Contains(t, rendered, "用户A")
+
+	for _, name := range []string{"Alice", "alice", "Bob", "bobby", "Carol", "Dave"} {
+		assert.NotContains(t, prompt, name)
+		assert.NotContains(t, recap.RecapInputs, name)
+		assert.NotContains(t, rendered, name)
+	}
+}
@@ -0,0 +1,291 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// bulkInsertBatchSize is how many chat histories are created within one
+// statement when bulk inserting.
+const bulkInsertBatchSize = 500
+
+var (
+	ErrInvalidTelegramExport        = errors.New("invalid telegram chat export")
+	ErrTelegramExportChatMismatched = errors.New("telegram chat export is not of the chat")
+)
+
+// telegramExportMessage is one message of the result.json exported by
+// Telegram Desktop, only the fields needed by the recaps are decoded.
+type telegramExportMessage struct {
+	ID               int64           `json:"id"`
+	Type             string          `json:"type"`
+	DateUnixtime     string          `json:"date_unixtime"`
+	From             string          `json:"from"`
+	FromID           string          `json:"from_id"`
+	ForwardedFrom    string          `json:"forwarded_from"`
+	ReplyToMessageID int64           `json:"reply_to_message_id"`
+	Text             json.RawMessage `json:"text"`
+}
+
+type telegramExport struct {
+	Name     string                  `json:"name"`
+	Type     string                  `json:"type"`
+	ID       int64                   `json:"id"`
+	Messages []telegramExportMessage `json:"messages"`
+}
+
+// telegramExportOfChat reports whether the id of the export is the chat, the
+// exported id drops the -100 prefix of the supergroups and the minus sign of
+// the basic groups.
+func telegramExportOfChat(exportID int64, chatID int64) bool {
+	if exportID == 0 {
+		return false
+	}
+
+	return formatChatID(chatID) == strconv.FormatInt(exportID, 10) || -exportID == chatID
+}
+
+// textOfTelegramExportMessage flattens the text of the exported message,
+// which is either a plain string or an array of plain strings and text
+// entities.
+func textOfTelegramExportMessage(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+
+	var parts []json.RawMessage
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	for _, part := range parts {
+		var plain string
+		if err := json.Unmarshal(part, &plain); err == nil {
+			sb.WriteString(plain)
+			continue
+		}
+
+		var entity struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(part, &entity); err == nil {
+			sb.WriteString(entity.Text)
+		}
+	}
+
+	return sb.String()
+}
+
+// userIDOfTelegramExportMessage parses the numeric user id out of the from_id
+// of the exported message, such as "user123456".
+func userIDOfTelegramExportMessage(fromID string) int64 {
+	userID, err := strconv.ParseInt(strings.TrimPrefix(fromID, "user"), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return userID
+}
+
+// ParseTelegramExportChatHistories parses the result.json exported by Telegram
+// Desktop into the chat histories of the chat, the service messages and the
+// messages without text are skipped. ErrTelegramExportChatMismatched is
+// returned if the export is of another chat.
+func ParseTelegramExportChatHistories(chatID int64, chatType, chatTitle string, data []byte) ([]*ent.ChatHistories, error) {
+	var export telegramExport
+
+	err := json.Unmarshal(data, &export)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTelegramExport, err)
+	}
+
+	if export.Messages == nil {
+		return nil, fmt.Errorf("%w: no messages found", ErrInvalidTelegramExport)
+	}
+
+	if !telegramExportOfChat(export.ID, chatID) {
+		return nil, fmt.Errorf("%w: exported chat %d, expected %d", ErrTelegramExportChatMismatched, export.ID, chatID)
+	}
+
+	repliedTo := lo.SliceToMap(export.Messages, func(message telegramExportMessage) (int64, telegramExportMessage) {
+		return message.ID, message
+	})
+
+	histories := make([]*ent.ChatHistories, 0, len(export.Messages))
+
+	for _, message := range export.Messages {
+		if message.Type != "message" || message.ID == 0 {
+			continue
+		}
+
+		text := strings.TrimSpace(textOfTelegramExportMessage(message.Text))
+		if text == "" {
+			continue
+		}
+
+		chattedAt, err := strconv.ParseInt(message.DateUnixtime, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		forwarded := message.ForwardedFrom != ""
+		if forwarded {
+			text = fmt.Sprintf("[forwarded from %s]: %s", message.ForwardedFrom, text)
+		}
+
+		history := &ent.ChatHistories{
+			ChatID:       chatID,
+			ChatType:     chatType,
+			ChatTitle:    chatTitle,
+			MessageID:    message.ID,
+			UserID:       userIDOfTelegramExportMessage(message.FromID),
+			FullName:     message.From,
+			Text:         text,
+			FromPlatform: int(FromPlatformTelegram),
+			ChattedAt:    time.Unix(chattedAt, 0).UnixMilli(),
+		}
+
+		if forwarded {
+			history.MessageTypes = int(tgchat.MessageTypeForwarded)
+		}
+
+		if message.ReplyToMessageID != 0 {
+			history.RepliedToMessageID = message.ReplyToMessageID
+			history.RepliedToChatType = chatType
+
+			replied, ok := repliedTo[message.ReplyToMessageID]
+			if ok {
+				history.RepliedToUserID = userIDOfTelegramExportMessage(replied.FromID)
+				history.RepliedToFullName = replied.From
+				history.RepliedToText = strings.TrimSpace(textOfTelegramExportMessage(replied.Text))
+			}
+		}
+
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+// DedupChatHistoriesByMessageID drops the chat histories whose chat and
+// message id have been seen earlier in the slice, the first one is kept.
+func DedupChatHistoriesByMessageID(histories []*ent.ChatHistories) []*ent.ChatHistories {
+	type key struct {
+		chatID    int64
+		messageID int64
+	}
+
+	return lo.UniqBy(lo.Filter(histories, func(history *ent.ChatHistories, _ int) bool {
+		return history != nil
+	}), func(history *ent.ChatHistories) key {
+		return key{chatID: history.ChatID, messageID: history.MessageID}
+	})
+}
+
+// BulkInsert inserts the chat histories that have not been saved yet, the
+// ones duplicated by the chat and message id, either within the histories or
+// against the saved chat histories, are skipped. The number of the inserted
+// chat histories is returned.
+func (m *Model) BulkInsert(histories []*ent.ChatHistories) (int, error) {
+	histories = DedupChatHistoriesByMessageID(histories)
+	if len(histories) == 0 {
+		return 0, nil
+	}
+
+	historiesByChat := lo.GroupBy(histories, func(history *ent.ChatHistories) int64 {
+		return history.ChatID
+	})
+
+	toInsert := make([]*ent.ChatHistories, 0, len(histories))
+
+	for chatID, chatHistories := range historiesByChat {
+		// the message ids are looked up batch by batch, so that the query
+		// stays within the limit of the bind parameters
+		for _, batch := range lo.Chunk(chatHistories, bulkInsertBatchSize) {
+			existing, err := m.ent.ChatHistories.
+				Query().
+				Where(
+					chathistories.ChatID(chatID),
+					chathistories.MessageIDIn(lo.Map(batch, func(history *ent.ChatHistories, _ int) int64 {
+						return history.MessageID
+					})...),
+				).
+				Select(chathistories.FieldMessageID).
+				Ints(context.Background())
+			if err != nil {
+				return 0, err
+			}
+
+			saved := lo.SliceToMap(existing, func(messageID int) (int64, struct{}) {
+				return int64(messageID), struct{}{}
+			})
+
+			toInsert = append(toInsert, lo.Reject(batch, func(history *ent.ChatHistories, _ int) bool {
+				_, ok := saved[history.MessageID]
+				return ok
+			})...)
+		}
+	}
+
+	for _, batch := range lo.Chunk(toInsert, bulkInsertBatchSize) {
+		creates := lo.Map(batch, func(history *ent.ChatHistories, _ int) *ent.ChatHistoriesCreate {
+			create := m.ent.ChatHistories.
+				Create().
+				SetChatID(history.ChatID).
+				SetChatType(history.ChatType).
+				SetChatTitle(history.ChatTitle).
+				SetMessageID(history.MessageID).
+				SetUserID(history.UserID).
+				SetUsername(history.Username).
+				SetFullName(history.FullName).
+				SetIsBot(history.IsBot).
+				SetFromPlatform(history.FromPlatform).
+				SetChattedAt(history.ChattedAt).
+				SetMessageTypes(history.MessageTypes).
+				SetText(history.Text)
+
+			if history.RepliedToMessageID != 0 {
+				create.
+					SetRepliedToMessageID(history.RepliedToMessageID).
+					SetRepliedToUserID(history.RepliedToUserID).
+					SetRepliedToFullName(history.RepliedToFullName).
+					SetRepliedToUsername(history.RepliedToUsername).
+					SetRepliedToText(history.RepliedToText).
+					SetRepliedToChatType(history.RepliedToChatType)
+			}
+
+			return create
+		})
+
+		err := m.ent.ChatHistories.CreateBulk(creates...).Exec(context.Background())
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	m.logger.Info("bulk inserted chat histories",
+		zap.Int("requested", len(histories)),
+		zap.Int("inserted", len(toInsert)),
+	)
+
+	return len(toInsert), nil
+}
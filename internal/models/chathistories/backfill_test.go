@@ -0,0 +1,124 @@
+package chathistories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nekomeowww/xo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/chathistories"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func TestParseTelegramExportChatHistories(t *testing.T) {
+	t.Run("Messages", func(t *testing.T) {
+		data := []byte(`{
+			"name": "Gophers",
+			"type": "private_supergroup",
+			"id": 123456789,
+			"messages": [
+				{"id": 1, "type": "service", "date_unixtime": "1700000000", "actor": "Alice", "action": "invite_members", "text": ""},
+				{"id": 2, "type": "message", "date_unixtime": "1700000060", "from": "Alice", "from_id": "user1001", "text": "周末去爬山吗"},
+				{"id": 3, "type": "message", "date_unixtime": "1700000120", "from": "Bob", "from_id": "user1002", "reply_to_message_id": 2, "text": ["好啊，看看 ", {"type": "link", "text": "https://example.com"}]},
+				{"id": 4, "type": "message", "date_unixtime": "1700000180", "from": "Carol", "from_id": "user1003", "forwarded_from": "Weather", "text": "周六晴"},
+				{"id": 5, "type": "message", "date_unixtime": "1700000240", "from": "Alice", "from_id": "user1001", "photo": "photos/1.jpg", "text": ""}
+			]
+		}`)
+
+		histories, err := ParseTelegramExportChatHistories(-100123456789, "supergroup", "Gophers", data)
+		require.NoError(t, err)
+		require.Len(t, histories, 3)
+
+		assert.Equal(t, int64(-100123456789), histories[0].ChatID)
+		assert.Equal(t, int64(2), histories[0].MessageID)
+		assert.Equal(t, int64(1001), histories[0].UserID)
+		assert.Equal(t, "Alice", histories[0].FullName)
+		assert.Equal(t, "周末去爬山吗", histories[0].Text)
+		assert.Equal(t, int64(1700000060000), histories[0].ChattedAt)
+
+		assert.Equal(t, "好啊，看看 https://example.com", histories[1].Text)
+		assert.Equal(t, int64(2), histories[1].RepliedToMessageID)
+		assert.Equal(t, int64(1001), histories[1].RepliedToUserID)
+		assert.Equal(t, "Alice", histories[1].RepliedToFullName)
+		assert.Equal(t, "周末去爬山吗", histories[1].RepliedToText)
+
+		assert.Equal(t, "[forwarded from Weather]: 周六晴", histories[2].Text)
+		assert.Equal(t, int(tgchat.MessageTypeForwarded), histories[2].MessageTypes)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseTelegramExportChatHistories(1, "supergroup", "Gophers", []byte(`not json`))
+		require.ErrorIs(t, err, ErrInvalidTelegramExport)
+
+		_, err = ParseTelegramExportChatHistories(1, "supergroup", "Gophers", []byte(`{"name": "Gophers"}`))
+		require.ErrorIs(t, err, ErrInvalidTelegramExport)
+	})
+
+	t.Run("ChatMismatched", func(t *testing.T) {
+		data := []byte(`{"name": "Gophers", "type": "private_supergroup", "id": 987654321, "messages": []}`)
+
+		_, err := ParseTelegramExportChatHistories(-100123456789, "supergroup", "Gophers", data)
+		require.ErrorIs(t, err, ErrTelegramExportChatMismatched)
+
+		_, err = ParseTelegramExportChatHistories(-100123456789, "supergroup", "Gophers", []byte(`{"name": "Gophers", "messages": []}`))
+		require.ErrorIs(t, err, ErrTelegramExportChatMismatched)
+
+		histories, err := ParseTelegramExportChatHistories(-987654321, "group", "Gophers", data)
+		require.NoError(t, err)
+		assert.Empty(t, histories)
+	})
+}
+
+func TestDedupChatHistoriesByMessageID(t *testing.T) {
+	histories := DedupChatHistoriesByMessageID([]*ent.ChatHistories{
+		{ChatID: 1, MessageID: 1, Text: "first"},
+		nil,
+		{ChatID: 1, MessageID: 2, Text: "second"},
+		{ChatID: 1, MessageID: 1, Text: "duplicated"},
+		{ChatID: 2, MessageID: 1, Text: "another chat"},
+	})
+	require.Len(t, histories, 3)
+
+	assert.Equal(t, "first", histories[0].Text)
+	assert.Equal(t, "second", histories[1].Text)
+	assert.Equal(t, "another chat", histories[2].Text)
+}
+
+func TestBulkInsert(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	inserted, err := model.BulkInsert([]*ent.ChatHistories{
+		{ChatID: chatID, MessageID: 1, UserID: 1001, FullName: "Alice", Text: "周末去爬山吗", ChattedAt: 1700000060000},
+		{ChatID: chatID, MessageID: 2, UserID: 1002, FullName: "Bob", Text: "好啊", ChattedAt: 1700000120000, RepliedToMessageID: 1, RepliedToUserID: 1001, RepliedToText: "周末去爬山吗"},
+		{ChatID: chatID, MessageID: 2, UserID: 1002, FullName: "Bob", Text: "好啊", ChattedAt: 1700000120000},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, inserted)
+
+	inserted, err = model.BulkInsert([]*ent.ChatHistories{
+		{ChatID: chatID, MessageID: 2, UserID: 1002, FullName: "Bob", Text: "好啊", ChattedAt: 1700000120000},
+		{ChatID: chatID, MessageID: 3, UserID: 1003, FullName: "Carol", Text: "我也去", ChattedAt: 1700000180000},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, inserted)
+
+	histories, err := model.ent.ChatHistories.
+		Query().
+		Where(chathistories.ChatID(chatID)).
+		Order(ent.Asc(chathistories.FieldMessageID)).
+		All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, histories, 3)
+
+	assert.Equal(t, "周末去爬山吗", histories[0].Text)
+	assert.Equal(t, int64(1), histories[1].RepliedToMessageID)
+	assert.Equal(t, "周末去爬山吗", histories[1].RepliedToText)
+	assert.Equal(t, "Carol", histories[2].FullName)
+
+	inserted, err = model.BulkInsert(nil)
+	require.NoError(t, err)
+	assert.Zero(t, inserted)
+}
@@ -0,0 +1,20 @@
+package chathistories
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+// FilterBotChatHistories filters out the chat histories sent by bots unless
+// includeBotMessages is set, so that the messages posted by other bots won't
+// pollute the recaps by default.
+func FilterBotChatHistories(histories []*ent.ChatHistories, includeBotMessages bool) []*ent.ChatHistories {
+	if includeBotMessages {
+		return histories
+	}
+
+	return lo.Filter(histories, func(item *ent.ChatHistories, _ int) bool {
+		return !item.IsBot
+	})
+}
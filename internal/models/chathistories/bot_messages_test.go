@@ -0,0 +1,36 @@
+package chathistories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+func TestFilterBotChatHistories(t *testing.T) {
+	histories := []*ent.ChatHistories{
+		{MessageID: 1, Text: "早上好"},
+		{MessageID: 2, Text: "今日新闻", IsBot: true},
+		{MessageID: 3, Text: "看到了"},
+	}
+
+	t.Run("ExcludeBotMessages", func(t *testing.T) {
+		filtered := FilterBotChatHistories(histories, false)
+		assert.Equal(t, []int64{1, 3}, messageIDsOf(filtered))
+	})
+
+	t.Run("IncludeBotMessages", func(t *testing.T) {
+		filtered := FilterBotChatHistories(histories, true)
+		assert.Equal(t, []int64{1, 2, 3}, messageIDsOf(filtered))
+	})
+}
+
+func messageIDsOf(histories []*ent.ChatHistories) []int64 {
+	ids := make([]int64, 0, len(histories))
+	for _, h := range histories {
+		ids = append(ids, h.MessageID)
+	}
+
+	return ids
+}
@@ -14,17 +14,22 @@ import (
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	lop "github.com/samber/lo/parallel"
+	goopenai "github.com/sashabaranov/go-openai"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
 	"github.com/nekomeowww/insights-bot/ent"
 	"github.com/nekomeowww/insights-bot/ent/chathistories"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
 	"github.com/nekomeowww/insights-bot/internal/configs"
 	"github.com/nekomeowww/insights-bot/internal/datastore"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
 	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/linkprev"
 	"github.com/nekomeowww/insights-bot/pkg/logger"
+	"github.com/nekomeowww/insights-bot/pkg/options"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
 )
 
@@ -51,6 +56,9 @@ type NewModelParams struct {
 	Ent    *datastore.Ent
 	OpenAI openai.Client
 	Redis  *datastore.Redis
+	I18n   *i18n.I18n
+
+	ApprovalDigger *datastore.ApprovedAutoRecapTimeCapsuleDigger
 }
 
 type Model struct {
@@ -60,10 +68,27 @@ type Model struct {
 	openAI   openai.Client
 	linkprev *linkprev.Client
 	redis    *datastore.Redis
+	i18n     *i18n.I18n
+
+	approvalDigger    *datastore.ApprovedAutoRecapTimeCapsuleDigger
+	keywordsStopWords *KeywordsStopWordLists
 }
 
 func NewModel() func(NewModelParams) (*Model, error) {
 	return func(param NewModelParams) (*Model, error) {
+		var keywordsStopWords *KeywordsStopWordLists
+
+		if param.Config.Recap.KeywordsStopWordsDir != "" {
+			stopWords, err := LoadKeywordsStopWords(param.Config.Recap.KeywordsStopWordsDir)
+			if err != nil {
+				return nil, err
+			}
+
+			param.Logger.Info("loaded keywords stop words", zap.Strings("languages", stopWords.Languages))
+
+			keywordsStopWords = stopWords
+		}
+
 		return &Model{
 			config:   param.Config,
 			logger:   param.Logger,
@@ -71,6 +96,10 @@ func NewModel() func(NewModelParams) (*Model, error) {
 			openAI:   param.OpenAI,
 			linkprev: linkprev.NewClient(),
 			redis:    param.Redis,
+			i18n:     param.I18n,
+
+			approvalDigger:    param.ApprovalDigger,
+			keywordsStopWords: keywordsStopWords,
 		}, nil
 	}
 }
@@ -198,7 +227,7 @@ func (m *Model) extractTextFromMessage(message *tgbotapi.Message) (string, error
 	return text, nil
 }
 
-func (m *Model) assignReplyMessageDataForChatHistory(entity *ent.ChatHistoriesCreate, message *tgbotapi.Message) error {
+func (m *Model) assignReplyMessageDataForChatHistory(entity *ent.ChatHistories, message *tgbotapi.Message) error {
 	if message.ReplyToMessage == nil {
 		return nil
 	}
@@ -209,53 +238,125 @@ func (m *Model) assignReplyMessageDataForChatHistory(entity *ent.ChatHistoriesCr
 	}
 
 	if repliedToText != "" {
-		entity.
-			SetRepliedToMessageID(int64(message.ReplyToMessage.MessageID)).
-			SetRepliedToUserID(message.ReplyToMessage.From.ID).
-			SetRepliedToFullName(tgbot.FullNameFromFirstAndLastName(message.ReplyToMessage.From.FirstName, message.ReplyToMessage.From.LastName)).
-			SetRepliedToUsername(message.ReplyToMessage.From.UserName).
-			SetRepliedToText(repliedToText).
-			SetRepliedToChatType(message.ReplyToMessage.Chat.Type)
+		entity.RepliedToMessageID = int64(message.ReplyToMessage.MessageID)
+		entity.RepliedToUserID = message.ReplyToMessage.From.ID
+		entity.RepliedToFullName = tgbot.FullNameFromFirstAndLastName(message.ReplyToMessage.From.FirstName, message.ReplyToMessage.From.LastName)
+		entity.RepliedToUsername = message.ReplyToMessage.From.UserName
+		entity.RepliedToText = repliedToText
+		entity.RepliedToChatType = message.ReplyToMessage.Chat.Type
 	}
 
 	return nil
 }
 
-func (m *Model) SaveOneTelegramChatHistory(message *tgbotapi.Message) error {
-	text, err := m.extractTextFromMessage(message)
-	if err != nil {
-		return err
+// newTelegramChatHistory builds the chat history of the message without
+// persisting it, nil will be returned if there is nothing to record.
+func (m *Model) newTelegramChatHistory(message *tgbotapi.Message) (*ent.ChatHistories, error) {
+	var (
+		text string
+		err  error
+	)
+
+	if message == nil {
+		return nil, nil
+	}
+
+	switch {
+	case message.Poll != nil:
+		text = message.Poll.Question
+	case isServiceMessage(message):
+		text = describeServiceMessage(message)
+	case isMediaOnlyMessage(message):
+		text = describeMediaOnlyMessage(message)
+	default:
+		text, err = m.extractTextFromMessage(message)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if text == "" {
-		return nil
+		return nil, nil
 	}
 
-	telegramChatHistoryCreate := m.ent.ChatHistories.
-		Create().
-		SetChatID(message.Chat.ID).
-		SetChatType(message.Chat.Type).
-		SetChatTitle(message.Chat.Title).
-		SetMessageID(int64(message.MessageID)).
-		SetUserID(message.From.ID).
-		SetUsername(message.From.UserName).
-		SetFullName(tgbot.FullNameFromFirstAndLastName(message.From.FirstName, message.From.LastName)).
-		SetFromPlatform(int(FromPlatformTelegram)).
-		SetChattedAt(time.Unix(int64(message.Date), 0).UnixMilli())
+	telegramChatHistory := &ent.ChatHistories{
+		ChatID:       message.Chat.ID,
+		ChatType:     message.Chat.Type,
+		ChatTitle:    message.Chat.Title,
+		MessageID:    int64(message.MessageID),
+		UserID:       message.From.ID,
+		Username:     message.From.UserName,
+		FullName:     tgbot.FullNameFromFirstAndLastName(message.From.FirstName, message.From.LastName),
+		IsBot:        message.From.IsBot,
+		FromPlatform: int(FromPlatformTelegram),
+		ChattedAt:    time.Unix(int64(message.Date), 0).UnixMilli(),
+		MessageTypes: int(messageTypesOfMessage(message)),
+	}
 
 	if message.ForwardFrom != nil {
-		telegramChatHistoryCreate.SetText(fmt.Sprintf("[forwarded from %s]: %s", tgbot.FullNameFromFirstAndLastName(message.ForwardFrom.FirstName, message.ForwardFrom.LastName), text))
+		telegramChatHistory.Text = fmt.Sprintf("[forwarded from %s]: %s", tgbot.FullNameFromFirstAndLastName(message.ForwardFrom.FirstName, message.ForwardFrom.LastName), text)
 	} else if message.ForwardFromChat != nil {
-		telegramChatHistoryCreate.SetText(fmt.Sprintf("[forwarded from %s]: %s", message.ForwardFromChat.Title, text))
+		telegramChatHistory.Text = fmt.Sprintf("[forwarded from %s]: %s", message.ForwardFromChat.Title, text)
 	} else {
-		telegramChatHistoryCreate.SetText(text)
+		telegramChatHistory.Text = text
+	}
+
+	if message.Poll != nil {
+		assignPollForChatHistory(telegramChatHistory, message.Poll)
+	}
+
+	err = m.assignReplyMessageDataForChatHistory(telegramChatHistory, message)
+	if err != nil {
+		return nil, err
 	}
 
-	err = m.assignReplyMessageDataForChatHistory(telegramChatHistoryCreate, message)
+	return telegramChatHistory, nil
+}
+
+func (m *Model) SaveOneTelegramChatHistory(message *tgbotapi.Message) error {
+	entity, err := m.newTelegramChatHistory(message)
 	if err != nil {
 		return err
 	}
 
+	if entity == nil {
+		return nil
+	}
+
+	telegramChatHistoryCreate := m.ent.ChatHistories.
+		Create().
+		SetChatID(entity.ChatID).
+		SetChatType(entity.ChatType).
+		SetChatTitle(entity.ChatTitle).
+		SetMessageID(entity.MessageID).
+		SetUserID(entity.UserID).
+		SetUsername(entity.Username).
+		SetFullName(entity.FullName).
+		SetIsBot(entity.IsBot).
+		SetFromPlatform(entity.FromPlatform).
+		SetChattedAt(entity.ChattedAt).
+		SetMessageTypes(entity.MessageTypes).
+		SetText(entity.Text)
+
+	if entity.RepliedToMessageID != 0 {
+		telegramChatHistoryCreate.
+			SetRepliedToMessageID(entity.RepliedToMessageID).
+			SetRepliedToUserID(entity.RepliedToUserID).
+			SetRepliedToFullName(entity.RepliedToFullName).
+			SetRepliedToUsername(entity.RepliedToUsername).
+			SetRepliedToText(entity.RepliedToText).
+			SetRepliedToChatType(entity.RepliedToChatType)
+	}
+
+	if entity.PollID != "" {
+		telegramChatHistoryCreate.
+			SetPollID(entity.PollID).
+			SetPollQuestion(entity.PollQuestion).
+			SetPollOptions(entity.PollOptions).
+			SetPollTotalVoterCount(entity.PollTotalVoterCount).
+			SetPollIsClosed(entity.PollIsClosed)
+	}
+
 	telegramChatHistory, err := telegramChatHistoryCreate.Save(context.TODO())
 	if err != nil {
 		return err
@@ -291,22 +392,53 @@ func (m *Model) UpdateOneTelegramChatHistory(message *tgbotapi.Message) error {
 		return nil
 	}
 
+	editedAt := time.Now()
+	if message.EditDate != 0 {
+		editedAt = time.Unix(int64(message.EditDate), 0)
+	}
+
+	return m.UpdateMessageContent(message.Chat.ID, int64(message.MessageID), text, editedAt)
+}
+
+// UpdateMessageContent replaces the text of the chat history of the edited
+// message, as well as the replied to text of the chat histories replying to
+// it, so that the recaps summarize the latest edited text.
+func (m *Model) UpdateMessageContent(chatID int64, messageID int64, newText string, editedAt time.Time) error {
+	err := m.ent.ChatHistories.
+		Update().
+		Where(
+			chathistories.ChatID(chatID),
+			chathistories.MessageID(messageID),
+		).
+		SetText(newText).
+		SetUpdatedAt(editedAt.UnixMilli()).
+		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
 	err = m.ent.ChatHistories.
 		Update().
 		Where(
-			chathistories.ChatID(message.Chat.ID),
-			chathistories.MessageID(int64(message.MessageID)),
+			chathistories.ChatID(chatID),
+			chathistories.RepliedToMessageID(messageID),
 		).
-		SetText(text).
+		SetRepliedToText(newText).
 		Exec(context.Background())
 	if err != nil {
 		return err
 	}
 
+	err = m.updateEphemeralChatHistory(chatID, messageID, newText)
+	if err != nil {
+		return err
+	}
+
 	m.logger.Debug("updated one message",
-		zap.Int64("chat_id", message.Chat.ID),
-		zap.Int("message_id", message.MessageID),
-		zap.String("text", strings.ReplaceAll(text, "\n", " ")),
+		zap.Int64("chat_id", chatID),
+		zap.Int64("message_id", messageID),
+		zap.Time("edited_at", editedAt),
+		zap.String("text", strings.ReplaceAll(newText, "\n", " ")),
 	)
 
 	return nil
@@ -321,6 +453,11 @@ func (m *Model) DeleteAllChatHistoriesByChatID(chatID int64) error {
 		return err
 	}
 
+	err = m.deleteEphemeralChatHistories(chatID)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -344,30 +481,73 @@ func (m *Model) MigrateChatHistoriesOfChatFromChatIDToChatID(fromChatID, toChatI
 	return nil
 }
 
+// FindLastHoursChatHistories finds the chat histories of the chat that were
+// chatted within the last hours.
+func (m *Model) FindLastHoursChatHistories(chatID int64, hours int) ([]*ent.ChatHistories, error) {
+	return m.FindChatHistoriesByTimeBefore(chatID, time.Duration(hours)*time.Hour)
+}
+
 func (m *Model) FindLastOneHourChatHistories(chatID int64) ([]*ent.ChatHistories, error) {
-	return m.FindChatHistoriesByTimeBefore(chatID, time.Hour)
+	return m.FindLastHoursChatHistories(chatID, 1)
 }
 
 func (m *Model) FindLast6HourChatHistories(chatID int64) ([]*ent.ChatHistories, error) {
-	return m.FindChatHistoriesByTimeBefore(chatID, 6*time.Hour)
+	return m.FindLastHoursChatHistories(chatID, 6)
 }
 
 func (m *Model) FindLast8HourChatHistories(chatID int64) ([]*ent.ChatHistories, error) {
-	return m.FindChatHistoriesByTimeBefore(chatID, 8*time.Hour)
+	return m.FindLastHoursChatHistories(chatID, 8)
 }
 
 func (m *Model) FindLast12HourChatHistories(chatID int64) ([]*ent.ChatHistories, error) {
-	return m.FindChatHistoriesByTimeBefore(chatID, 12*time.Hour)
+	return m.FindLastHoursChatHistories(chatID, 12)
 }
 
 func (m *Model) FindChatHistoriesByTimeBefore(chatID int64, before time.Duration) ([]*ent.ChatHistories, error) {
 	m.logger.Info("querying chat histories", zap.Int64("chat_id", chatID))
 
+	since := time.Now().Add(-before)
+
+	telegramChatHistories, err := m.ent.ChatHistories.
+		Query().
+		Where(
+			chathistories.ChatID(chatID),
+			chathistories.ChattedAtGT(since.UnixMilli()),
+		).
+		Order(
+			chathistories.ByMessageID(sql.OrderAsc()),
+		).
+		All(context.TODO())
+	if err != nil {
+		return make([]*ent.ChatHistories, 0), err
+	}
+
+	ephemeralChatHistories, err := m.findEphemeralChatHistoriesSince(chatID, since)
+	if err != nil {
+		return make([]*ent.ChatHistories, 0), err
+	}
+
+	return mergeChatHistories(telegramChatHistories, ephemeralChatHistories), nil
+}
+
+// FindChatHistoriesByTimeBeforeMatching finds the chat histories within the
+// time window whose text contains the keyword, case insensitive.
+func (m *Model) FindChatHistoriesByTimeBeforeMatching(chatID int64, before time.Duration, keyword string) ([]*ent.ChatHistories, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return make([]*ent.ChatHistories, 0), nil
+	}
+
+	m.logger.Info("querying chat histories matching keyword", zap.Int64("chat_id", chatID), zap.String("keyword", keyword))
+
+	since := time.Now().Add(-before)
+
 	telegramChatHistories, err := m.ent.ChatHistories.
 		Query().
 		Where(
 			chathistories.ChatID(chatID),
-			chathistories.ChattedAtGT(time.Now().Add(-before).UnixMilli()),
+			chathistories.ChattedAtGT(since.UnixMilli()),
+			chathistories.TextContainsFold(keyword),
 		).
 		Order(
 			chathistories.ByMessageID(sql.OrderAsc()),
@@ -377,7 +557,47 @@ func (m *Model) FindChatHistoriesByTimeBefore(chatID int64, before time.Duration
 		return make([]*ent.ChatHistories, 0), err
 	}
 
-	return telegramChatHistories, nil
+	ephemeralChatHistories, err := m.findEphemeralChatHistoriesSince(chatID, since)
+	if err != nil {
+		return make([]*ent.ChatHistories, 0), err
+	}
+
+	return mergeChatHistories(telegramChatHistories, filterChatHistoriesByKeyword(ephemeralChatHistories, keyword)), nil
+}
+
+// FindFirstChattedAtOfUser finds the time that the user was first seen chatting
+// in the chat, zero time will be returned if the user never chatted. The time
+// kept for the chats that opted out of storing message content is taken into
+// account as well.
+func (m *Model) FindFirstChattedAtOfUser(chatID int64, userID int64) (time.Time, error) {
+	firstChattedAt, err := m.findFirstChattedAtOfUserFromEphemeral(chatID, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	history, err := m.ent.ChatHistories.
+		Query().
+		Where(
+			chathistories.ChatID(chatID),
+			chathistories.UserID(userID),
+		).
+		Order(
+			chathistories.ByChattedAt(sql.OrderAsc()),
+		).
+		First(context.Background())
+	if err != nil && !ent.IsNotFound(err) {
+		return time.Time{}, err
+	}
+
+	if history != nil && (firstChattedAt == 0 || history.ChattedAt < firstChattedAt) {
+		firstChattedAt = history.ChattedAt
+	}
+
+	if firstChattedAt == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.UnixMilli(firstChattedAt), nil
 }
 
 func formatFullNameAndUsername(fullName, username string) string {
@@ -419,19 +639,53 @@ func (m *Model) decodeMessageIDFromVirtualMessageID(mMessageIDToVirtualMessageID
 	}
 }
 
-func (m *Model) SummarizeChatHistories(chatID int64, chatType telegram.ChatType, histories []*ent.ChatHistories) (uuid.UUID, []string, error) {
-	historiesLLMFriendly := make([]string, 0, len(histories))
-	historiesIncludedMessageIDs := make([]int64, 0)
+// ChatHistoriesRecap is a generated but not yet persisted recap of chat
+// histories.
+type ChatHistoriesRecap struct {
+	ChatID         int64                                     `json:"chat_id"`
+	ChatType       telegram.ChatType                         `json:"chat_type"`
+	RecapInputs    string                                    `json:"recap_inputs"`
+	Summarizations []string                                  `json:"summarizations"`
+	Outputs        []*openai.ChatHistorySummarizationOutputs `json:"outputs"`
+	// Translations are the outputs translated into the other languages, the
+	// rendered ones are appended to Summarizations as well.
+	Translations      []*ChatHistoriesRecapTranslation `json:"translations"`
+	Usage             goopenai.Usage                   `json:"usage"`
+	EarliestChattedAt int64                            `json:"earliest_chatted_at"`
+	LatestChattedAt   int64                            `json:"latest_chatted_at"`
+	WindowHours       int                              `json:"window_hours"`
+	IsAutoRecap       bool                             `json:"is_auto_recap"`
+	// Keywords are the top keywords of the chat histories, the rendered line
+	// is appended to Summarizations as well.
+	Keywords []string `json:"keywords"`
+	// Moderation is set if the recap is flagged by the moderation, the recap
+	// has to be published by the administrators if HeldForModeration is set.
+	Moderation        *ChatHistoriesRecapModeration `json:"moderation,omitempty"`
+	HeldForModeration bool                          `json:"held_for_moderation,omitempty"`
+	// IncrementalRecap is set if the recap emphasizes what is new since the
+	// previous recap, the summary of the recap is kept for the next one once
+	// the recap is saved.
+	IncrementalRecap bool `json:"incremental_recap,omitempty"`
+}
 
-	mMessageIDToVirtualMessageID := m.encodeMessageIDIntoVirtualMessageID(histories)
+// llmFriendlyChatHistories formats the chat histories into the LLM friendly
+// text, and returns the ids of the messages included.
+func llmFriendlyChatHistories(histories []*ent.ChatHistories) (string, []int64) {
+	historiesLLMFriendly := make([]string, 0, len(histories))
+	historiesIncludedMessageIDs := make([]int64, 0, len(histories))
 
 	for _, message := range histories {
+		text := message.Text
+		if message.PollQuestion != "" {
+			text = regexpForwardedChatHistoryPrefix.FindString(message.Text) + formatChatHistoryPoll(message)
+		}
+
 		if message.RepliedToMessageID == 0 {
 			historiesLLMFriendly = append(historiesLLMFriendly, fmt.Sprintf(
 				"msgId:%d: %s sent: %s",
 				message.MessageID,
 				formatFullNameAndUsername(message.FullName, message.Username),
-				message.Text,
+				text,
 			))
 
 			historiesIncludedMessageIDs = append(historiesIncludedMessageIDs, message.MessageID)
@@ -446,43 +700,266 @@ func (m *Model) SummarizeChatHistories(chatID int64, chatType telegram.ChatType,
 				message.MessageID,
 				formatFullNameAndUsername(message.FullName, message.Username),
 				repliedToPartialContextMessage,
-				message.Text,
+				text,
 			))
 
 			historiesIncludedMessageIDs = append(historiesIncludedMessageIDs, message.MessageID)
 		}
 	}
 
-	chatHistories := strings.Join(historiesLLMFriendly, "\n")
+	return strings.Join(historiesLLMFriendly, "\n"), historiesIncludedMessageIDs
+}
+
+func (m *Model) summarizeChatHistoriesCallOptions(chatID int64, opts *SummarizeChatHistoriesCallOptions) []options.CallOptions[openai.SummarizeChatHistoriesCallOptions] {
+	callOpts := []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]{
+		openai.WithSummarizeChatHistoriesExtraInstructions(m.adaptivePromptInstructions(chatID)...),
+		openai.WithSummarizeChatHistoriesExtraInstructions(m.incrementalRecapInstructions(chatID, opts)...),
+		openai.WithSummarizeChatHistoriesPersona(opts.Persona),
+	}
+
+	if opts.Temperature != nil {
+		callOpts = append(callOpts, openai.WithSummarizeChatHistoriesTemperature(*opts.Temperature))
+	}
+
+	if opts.MaxTokens > 0 {
+		callOpts = append(callOpts, openai.WithSummarizeChatHistoriesMaxTokens(opts.MaxTokens))
+	}
+
+	if len(opts.Languages) > 0 {
+		callOpts = append(callOpts, openai.WithSummarizeChatHistoriesLanguage(opts.Languages[0]))
+	}
+
+	return callOpts
+}
+
+// summarizeAndSaveChatHistoriesRecap generates the recap and saves the recap
+// log right away, both the rendered and structured outputs are kept.
+func (m *Model) summarizeAndSaveChatHistoriesRecap(chatID int64, chatType telegram.ChatType, histories []*ent.ChatHistories, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (uuid.UUID, *ChatHistoriesRecap, error) {
+	recap, err := m.GenerateChatHistoriesRecap(chatID, chatType, histories, callOpts...)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	if recap.HeldForModeration {
+		return uuid.Nil, nil, ErrChatHistoriesRecapHeldForModeration
+	}
+
+	logID, err := m.SaveOneChatHistoriesRecap(recap)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return logID, recap, nil
+}
 
-	summarizations, statusUsage, err := m.summarizeChatHistories(chatID, historiesIncludedMessageIDs, chatHistories)
+// SummarizeChatHistoriesStructured summarizes the chat histories and saves the
+// recap log, the parsed outputs are returned with the message ids already
+// decoded, so that callers can render them on their own.
+func (m *Model) SummarizeChatHistoriesStructured(chatID int64, chatType telegram.ChatType, histories []*ent.ChatHistories, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (uuid.UUID, []*openai.ChatHistorySummarizationOutputs, error) {
+	logID, recap, err := m.summarizeAndSaveChatHistoriesRecap(chatID, chatType, histories, callOpts...)
+	if err != nil {
+		return uuid.Nil, make([]*openai.ChatHistorySummarizationOutputs, 0), err
+	}
+
+	return logID, recap.Outputs, nil
+}
+
+func (m *Model) SummarizeChatHistories(chatID int64, chatType telegram.ChatType, histories []*ent.ChatHistories, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (uuid.UUID, []string, error) {
+	logID, recap, err := m.summarizeAndSaveChatHistoriesRecap(chatID, chatType, histories, callOpts...)
 	if err != nil {
 		return uuid.Nil, make([]string, 0), err
 	}
 
+	return logID, recap.Summarizations, nil
+}
+
+// GenerateChatHistoriesRecap summarizes and renders the chat histories without
+// saving any recap logs, SaveOneChatHistoriesRecap should be called afterwards
+// once the recap is going to be published.
+func (m *Model) GenerateChatHistoriesRecap(chatID int64, chatType telegram.ChatType, histories []*ent.ChatHistories, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (*ChatHistoriesRecap, error) {
+	opts := options.ApplyCallOptions(callOpts)
+	if opts.DedupForwards {
+		histories = DedupForwardedChatHistories(histories)
+	}
+
+	var pseudonyms []string
+	if opts.AnonymizeParticipants {
+		histories, pseudonyms = AnonymizeChatHistories(histories)
+	}
+
+	mMessageIDToVirtualMessageID := m.encodeMessageIDIntoVirtualMessageID(histories)
+	chatHistories, historiesIncludedMessageIDs := llmFriendlyChatHistories(histories)
+
+	var (
+		summarizations []*openai.ChatHistorySummarizationOutputs
+		statusUsage    goopenai.Usage
+		truncated      bool
+		err            error
+	)
+
+	if m.config.Recap.MaxMessagesPerSummary > 0 && len(histories) > m.config.Recap.MaxMessagesPerSummary {
+		summarizations, statusUsage, truncated, err = m.SummarizeChatHistoriesChunked(chatID, histories, m.config.Recap.MaxMessagesPerSummary, callOpts...)
+	} else {
+		summarizations, statusUsage, truncated, err = m.summarizeChatHistories(chatID, historiesIncludedMessageIDs, chatHistories, m.summarizeChatHistoriesCallOptions(chatID, opts), opts.OnProgress)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
 	// reverse virtual message id to real message id
 	m.decodeMessageIDFromVirtualMessageID(mMessageIDToVirtualMessageID, summarizations)
 
-	ss, err := m.renderRecapTemplates(chatID, chatType, summarizations)
+	if opts.AnonymizeParticipants {
+		pseudonymizeParticipants(summarizations, pseudonyms)
+	}
+
+	texts := m.recapTexts(opts.Languages)
+
+	ss, err := RenderRecapTemplates(chatID, chatType, summarizations, opts.OutputFormat, texts)
 	if err != nil {
-		return uuid.Nil, make([]string, 0), err
+		return nil, err
+	}
+
+	if truncated {
+		ss = append(ss, texts.PartialRecapNote())
+	}
+
+	if opts.IncrementalRecap {
+		newDevelopments := FormatIncrementalRecapNewDevelopments(summarizations)
+		if newDevelopments != "" {
+			ss = append([]string{newDevelopments}, ss...)
+		}
+	}
+
+	translations, translateUsage := m.translateChatHistoriesRecap(chatID, chatType, summarizations, opts)
+	statusUsage = addUsage(statusUsage, translateUsage)
+
+	for _, t := range translations {
+		ss = append(ss, t.Summarizations...)
+	}
+
+	relatedMessages := FormatRelatedMessageLinks(chatID, chatType, TopRelatedMessageIDs(summarizations, opts.RelatedMessagesCount), texts)
+	if relatedMessages != "" {
+		ss = append(ss, relatedMessages)
 	}
 
+	keywords := ExtractKeywords(lo.Map(histories, func(item *ent.ChatHistories, _ int) string {
+		return item.Text
+	}), opts.TopKeywordsCount, m.keywordsStopWords.ForLanguage(tgchats.RecapLocaleOfSummaryLanguages(opts.Languages)))
+	if len(keywords) > 0 {
+		ss = append(ss, FormatTopKeywords(keywords, texts))
+	}
+
+	earliestChattedAt, latestChattedAt := ChatHistoriesChattedAtRange(histories)
+
+	recap := &ChatHistoriesRecap{
+		ChatID:            chatID,
+		ChatType:          chatType,
+		RecapInputs:       chatHistories,
+		Summarizations:    ss,
+		Outputs:           summarizations,
+		Translations:      translations,
+		Keywords:          keywords,
+		Usage:             statusUsage,
+		EarliestChattedAt: earliestChattedAt,
+		LatestChattedAt:   latestChattedAt,
+		WindowHours:       opts.WindowHours,
+		IsAutoRecap:       opts.IsAutoRecap,
+		IncrementalRecap:  opts.IncrementalRecap,
+	}
+
+	err = m.applyChatHistoriesRecapModeration(recap)
+	if err != nil {
+		return nil, err
+	}
+
+	return recap, nil
+}
+
+func (m *Model) SaveOneChatHistoriesRecap(recap *ChatHistoriesRecap) (uuid.UUID, error) {
 	saved, err := m.ent.LogChatHistoriesRecap.
 		Create().
-		SetChatID(chatID).
-		SetRecapInputs(chatHistories).
-		SetRecapOutputs(strings.Join(ss, "\n")).
-		SetCompletionTokenUsage(statusUsage.CompletionTokens).
-		SetPromptTokenUsage(statusUsage.PromptTokens).
-		SetTotalTokenUsage(statusUsage.TotalTokens).
+		SetChatID(recap.ChatID).
+		SetRecapInputs(recap.RecapInputs).
+		SetRecapOutputs(strings.Join(recap.Summarizations, "\n")).
+		SetCompletionTokenUsage(recap.Usage.CompletionTokens).
+		SetPromptTokenUsage(recap.Usage.PromptTokens).
+		SetTotalTokenUsage(recap.Usage.TotalTokens).
 		SetFromPlatform(int(FromPlatformTelegram)).
 		SetRecapType(int(RecapTypeForGroup)).
 		SetModelName(m.openAI.GetModelName()).
+		SetWindowHours(recap.WindowHours).
+		SetIsAutoRecap(recap.IsAutoRecap).
 		Save(context.Background())
 	if err != nil {
-		return uuid.Nil, make([]string, 0), err
+		return uuid.Nil, err
 	}
 
-	return saved.ID, ss, nil
+	if recap.IncrementalRecap {
+		err = m.SavePreviousChatHistoriesRecapSummary(recap.ChatID, FormatPreviousRecapSummary(recap.Outputs))
+		if err != nil {
+			m.logger.Error("failed to save previous recap summary for incremental recap", zap.Int64("chat_id", recap.ChatID), zap.Error(err))
+		}
+	}
+
+	return saved.ID, nil
+}
+
+// FindLastChatHistoriesRecap finds the last recap log generated for the chat,
+// nil will be returned if there is none.
+func (m *Model) FindLastChatHistoriesRecap(chatID int64) (*ent.LogChatHistoriesRecap, error) {
+	log, err := m.ent.LogChatHistoriesRecap.
+		Query().
+		Where(
+			logchathistoriesrecap.ChatIDEQ(chatID),
+			logchathistoriesrecap.RecapTypeEQ(int(RecapTypeForGroup)),
+		).
+		Order(ent.Desc(logchathistoriesrecap.FieldCreatedAt)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// SplitChatHistoriesRecapOutputs splits the outputs saved in the recap log
+// back into the summarizations of each topic, every topic starts with a
+// markdown title.
+func SplitChatHistoriesRecapOutputs(outputs string) []string {
+	summarizations := make([]string, 0)
+	lines := make([]string, 0)
+
+	for _, line := range strings.Split(outputs, "\n") {
+		if strings.HasPrefix(line, "## ") && len(lines) > 0 {
+			summarizations = append(summarizations, strings.Join(lines, "\n"))
+			lines = make([]string, 0)
+		}
+
+		lines = append(lines, line)
+	}
+
+	if len(lines) > 0 && strings.TrimSpace(strings.Join(lines, "\n")) != "" {
+		summarizations = append(summarizations, strings.Join(lines, "\n"))
+	}
+
+	return summarizations
+}
+
+// FindChatHistoriesRecapLogsByWindowHours finds the recap logs of the chat that
+// covered the given hour window, the latest ones come first.
+func (m *Model) FindChatHistoriesRecapLogsByWindowHours(chatID int64, windowHours int) ([]*ent.LogChatHistoriesRecap, error) {
+	return m.ent.LogChatHistoriesRecap.
+		Query().
+		Where(
+			logchathistoriesrecap.ChatIDEQ(chatID),
+			logchathistoriesrecap.WindowHoursEQ(windowHours),
+		).
+		Order(ent.Desc(logchathistoriesrecap.FieldCreatedAt)).
+		All(context.Background())
 }
@@ -2,7 +2,9 @@ package chathistories
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,7 +21,9 @@ import (
 	"github.com/nekomeowww/insights-bot/internal/lib"
 	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
 	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/options"
 	"github.com/nekomeowww/insights-bot/pkg/tutils"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
 	"github.com/nekomeowww/xo"
 )
 
@@ -50,11 +54,21 @@ func TestMain(m *testing.M) {
 		panic(err)
 	}
 
+	approvalDigger, err := datastore.NewApprovedAutoRecapTimeCapsuleDigger()(datastore.NewApprovedAutoRecapTimeCapsuleDiggerParams{
+		Lifecycle: tutils.NewEmtpyLifecycle(),
+		Logger:    logger,
+		Redis:     redis,
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	model, err = NewModel()(NewModelParams{
-		Ent:    ent,
-		Logger: logger,
-		OpenAI: &openaimock.MockClient{},
-		Redis:  redis,
+		Ent:            ent,
+		Logger:         logger,
+		OpenAI:         &openaimock.MockClient{},
+		Redis:          redis,
+		ApprovalDigger: approvalDigger,
 	})
 	if err != nil {
 		panic(err)
@@ -175,6 +189,57 @@ func TestUpdateOneTelegramChatHistory(t *testing.T) {
 	assert.Equal(message.Text, chatHistory.Text)
 }
 
+func TestUpdateMessageContent(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chat := &tgbotapi.Chat{ID: xo.RandomInt64()}
+	from := &tgbotapi.User{ID: xo.RandomInt64(), FirstName: xo.RandomHashString(5)}
+
+	original := &tgbotapi.Message{
+		MessageID: int(xo.RandomInt64()),
+		From:      from,
+		Chat:      chat,
+		Date:      int(time.Now().Unix()),
+		Text:      "周六去爬山",
+	}
+	err := model.SaveOneTelegramChatHistory(original)
+	require.NoError(err)
+
+	reply := &tgbotapi.Message{
+		MessageID:      original.MessageID + 1,
+		From:           from,
+		Chat:           chat,
+		Date:           int(time.Now().Unix()),
+		Text:           "好的",
+		ReplyToMessage: original,
+	}
+	err = model.SaveOneTelegramChatHistory(reply)
+	require.NoError(err)
+
+	editedAt := time.Now().Add(time.Minute).Truncate(time.Millisecond)
+	err = model.UpdateMessageContent(chat.ID, int64(original.MessageID), "改成周日去爬山", editedAt)
+	require.NoError(err)
+
+	histories, err := model.FindLastHoursChatHistories(chat.ID, 1)
+	require.NoError(err)
+	require.Len(histories, 2)
+
+	edited, ok := lo.Find(histories, func(history *ent.ChatHistories) bool {
+		return history.MessageID == int64(original.MessageID)
+	})
+	require.True(ok)
+	assert.Equal("改成周日去爬山", edited.Text)
+	assert.Equal(editedAt.UnixMilli(), edited.UpdatedAt)
+
+	replied, ok := lo.Find(histories, func(history *ent.ChatHistories) bool {
+		return history.MessageID == int64(reply.MessageID)
+	})
+	require.True(ok)
+	assert.Equal("好的", replied.Text)
+	assert.Equal("改成周日去爬山", replied.RepliedToText)
+}
+
 func TestFindLastOneHourChatHistories(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -294,3 +359,181 @@ func TestDecodeMessageIDFromVirtualMessageID(t *testing.T) {
 		}},
 	}, outputs)
 }
+
+func TestFindLastHoursChatHistories(t *testing.T) {
+	chatID := xo.RandomInt64()
+	now := time.Now()
+
+	for i, before := range []time.Duration{30 * time.Minute, 3 * time.Hour, 7 * time.Hour, 13 * time.Hour, 25 * time.Hour} {
+		_, err := model.ent.ChatHistories.
+			Create().
+			SetChatID(chatID).
+			SetMessageID(int64(i + 1)).
+			SetText(xo.RandomHashString(10)).
+			SetChattedAt(now.Add(-before).UnixMilli()).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		hours    int
+		expected []int64
+	}{
+		{hours: 1, expected: []int64{1}},
+		{hours: 6, expected: []int64{1, 2}},
+		{hours: 8, expected: []int64{1, 2, 3}},
+		{hours: 12, expected: []int64{1, 2, 3}},
+		{hours: 24, expected: []int64{1, 2, 3, 4}},
+		{hours: 48, expected: []int64{1, 2, 3, 4, 5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Last%dHours", tc.hours), func(t *testing.T) {
+			histories, err := model.FindLastHoursChatHistories(chatID, tc.hours)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, lo.Map(histories, func(item *ent.ChatHistories, _ int) int64 {
+				return item.MessageID
+			}))
+		})
+	}
+}
+
+func TestFindChatHistoriesByTimeBeforeMatching(t *testing.T) {
+	chatID := xo.RandomInt64()
+	now := time.Now()
+
+	for i, h := range []struct {
+		text   string
+		before time.Duration
+	}{
+		{text: "新版本什么时候发布？", before: time.Hour},
+		{text: "今天天气不错", before: time.Hour},
+		{text: "Insights Bot 的 Release 已经发布了", before: 2 * time.Hour},
+		{text: "上周的发布会", before: 48 * time.Hour},
+		{text: "insights bot is awesome", before: 3 * time.Hour},
+	} {
+		_, err := model.ent.ChatHistories.
+			Create().
+			SetChatID(chatID).
+			SetMessageID(int64(i + 1)).
+			SetText(h.text).
+			SetChattedAt(now.Add(-h.before).UnixMilli()).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	messageIDs := func(histories []*ent.ChatHistories) []int64 {
+		return lo.Map(histories, func(item *ent.ChatHistories, _ int) int64 {
+			return item.MessageID
+		})
+	}
+
+	histories, err := model.FindChatHistoriesByTimeBeforeMatching(chatID, 24*time.Hour, "发布")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, messageIDs(histories))
+
+	histories, err = model.FindChatHistoriesByTimeBeforeMatching(chatID, 24*time.Hour, " INSIGHTS BOT ")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 5}, messageIDs(histories))
+
+	histories, err = model.FindChatHistoriesByTimeBeforeMatching(chatID, 24*time.Hour, "")
+	require.NoError(t, err)
+	assert.Empty(t, histories)
+}
+
+func TestFindChatHistoriesRecapLogsByWindowHours(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	autoLogID, err := model.SaveOneChatHistoriesRecap(&ChatHistoriesRecap{
+		ChatID:         chatID,
+		Summarizations: []string{xo.RandomHashString(10)},
+		WindowHours:    6,
+		IsAutoRecap:    true,
+	})
+	require.NoError(t, err)
+
+	_, err = model.SaveOneChatHistoriesRecap(&ChatHistoriesRecap{
+		ChatID:         chatID,
+		Summarizations: []string{xo.RandomHashString(10)},
+		WindowHours:    12,
+	})
+	require.NoError(t, err)
+
+	logs, err := model.FindChatHistoriesRecapLogsByWindowHours(chatID, 6)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	assert.Equal(t, autoLogID, logs[0].ID)
+	assert.Equal(t, 6, logs[0].WindowHours)
+	assert.True(t, logs[0].IsAutoRecap)
+
+	logs, err = model.FindChatHistoriesRecapLogsByWindowHours(chatID, 8)
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+}
+
+func TestSplitChatHistoriesRecapOutputs(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		assert.Empty(t, SplitChatHistoriesRecapOutputs(""))
+	})
+
+	t.Run("MultipleTopics", func(t *testing.T) {
+		outputs := strings.Join([]string{
+			"## Topic 1\n参与人：User 1\n讨论：\n - Point 1",
+			"## Topic 2\n- Point 2\n- 结论：Conclusion 2",
+		}, "\n")
+
+		assert.Equal(t, []string{
+			"## Topic 1\n参与人：User 1\n讨论：\n - Point 1",
+			"## Topic 2\n- Point 2\n- 结论：Conclusion 2",
+		}, SplitChatHistoriesRecapOutputs(outputs))
+	})
+}
+
+func TestGenerateChatHistoriesRecapStructuredOutputs(t *testing.T) {
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(t, err)
+
+	openAIClient := &openaimock.MockClient{}
+	openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+		return []string{s}
+	}
+	openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, _ string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+		return &goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{
+				Content: "```json\n" + `[{"topicName":"周末爬山","sinceId":1,"participants":["@User 1","User 2","User 2"],"discussion":[{"point":"约好早上八点在山脚下集合","keyIds":[1,2,3,42]},{"point":"","keyIds":[2]}],"conclusion":"周六出发"},{"topicName":"","sinceId":2,"participants":["User 2"],"discussion":[{"point":"无效的话题","keyIds":[2]}]}]` + "\n```",
+			}}},
+		}, nil
+	}
+
+	m := &Model{
+		config: config,
+		logger: logger,
+		openAI: openAIClient,
+	}
+
+	histories := []*ent.ChatHistories{
+		{MessageID: 100, FullName: "User 1", Text: "明天要不要一起去爬山，天气预报说是晴天"},
+		{MessageID: 101, FullName: "User 2", Text: "可以的，早上八点在山脚下集合吧", RepliedToMessageID: 100},
+	}
+
+	recap, err := m.GenerateChatHistoriesRecap(-100123456789, telegram.ChatTypeSuperGroup, histories)
+	require.NoError(t, err)
+
+	require.Len(t, recap.Outputs, 1)
+	assert.Equal(t, "周末爬山", recap.Outputs[0].TopicName)
+	assert.Equal(t, int64(100), recap.Outputs[0].SinceID)
+	assert.Equal(t, []string{"User 1", "User 2"}, recap.Outputs[0].Participants)
+	assert.Equal(t, "周六出发", recap.Outputs[0].Conclusion)
+	require.Len(t, recap.Outputs[0].Discussion, 1)
+	assert.Equal(t, "约好早上八点在山脚下集合", recap.Outputs[0].Discussion[0].Point)
+	assert.Equal(t, []int64{100, 101}, recap.Outputs[0].Discussion[0].KeyIDs)
+
+	require.Len(t, recap.Summarizations, 1)
+	assert.Contains(t, recap.Summarizations[0], "周末爬山")
+}
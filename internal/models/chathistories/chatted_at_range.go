@@ -0,0 +1,56 @@
+package chathistories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// ChatHistoriesChattedAtRange returns the earliest and latest chatted at time
+// (in milliseconds) of the chat histories, zeros will be returned if there
+// are no chat histories.
+func ChatHistoriesChattedAtRange(histories []*ent.ChatHistories) (int64, int64) {
+	var earliest, latest int64
+
+	for _, h := range histories {
+		if h.ChattedAt == 0 {
+			continue
+		}
+
+		if earliest == 0 || h.ChattedAt < earliest {
+			earliest = h.ChattedAt
+		}
+
+		if h.ChattedAt > latest {
+			latest = h.ChattedAt
+		}
+	}
+
+	return earliest, latest
+}
+
+// FormatChatHistoriesChattedAtRange formats the chatted at range of the chat
+// histories in the given location and the date time format of the language,
+// an empty string will be returned if the range is unknown.
+func FormatChatHistoriesChattedAtRange(earliest, latest int64, location *time.Location, language string) string {
+	if earliest == 0 || latest == 0 {
+		return ""
+	}
+
+	format := lo.Ternary(i18n.IsTraditionalChinese(language), "統計範圍：%s 至 %s\n\n", "统计范围：%s 至 %s\n\n")
+
+	return fmt.Sprintf(format,
+		i18n.FormatDateTime(language, time.UnixMilli(earliest).In(location)),
+		i18n.FormatDateTime(language, time.UnixMilli(latest).In(location)),
+	)
+}
+
+// FormatChatHistoriesChattedAtRange formats the chatted at range of the chat
+// histories with the configured timezone, the same one as the schedules.
+func (m *Model) FormatChatHistoriesChattedAtRange(earliest, latest int64, language string) string {
+	return FormatChatHistoriesChattedAtRange(earliest, latest, m.config.Location(), language)
+}
@@ -0,0 +1,43 @@
+package chathistories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+func TestChatHistoriesChattedAtRange(t *testing.T) {
+	earliest, latest := ChatHistoriesChattedAtRange([]*ent.ChatHistories{
+		{ChattedAt: 1700000300000},
+		{ChattedAt: 0},
+		{ChattedAt: 1700000100000},
+		{ChattedAt: 1700000200000},
+	})
+	assert.Equal(t, int64(1700000100000), earliest)
+	assert.Equal(t, int64(1700000300000), latest)
+
+	earliest, latest = ChatHistoriesChattedAtRange(nil)
+	assert.Zero(t, earliest)
+	assert.Zero(t, latest)
+}
+
+func TestFormatChatHistoriesChattedAtRange(t *testing.T) {
+	location := time.FixedZone("Local", 8*60*60)
+
+	assert.Equal(t,
+		"统计范围：2023-11-15 06:13 至 2023-11-15 08:00\n\n",
+		FormatChatHistoriesChattedAtRange(1700000000000, 1700006400000, location, "zh-hans"),
+	)
+	assert.Equal(t,
+		"统计范围：Nov 15, 2023 6:13 AM 至 Nov 15, 2023 8:00 AM\n\n",
+		FormatChatHistoriesChattedAtRange(1700000000000, 1700006400000, location, "en"),
+	)
+	assert.Equal(t,
+		"統計範圍：2023-11-15 06:13 至 2023-11-15 08:00\n\n",
+		FormatChatHistoriesChattedAtRange(1700000000000, 1700006400000, location, "zh-TW"),
+	)
+	assert.Empty(t, FormatChatHistoriesChattedAtRange(0, 0, location, ""))
+}
@@ -0,0 +1,34 @@
+package chathistories
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+// ContentRichnessOfChatHistories calculates how much content the chat
+// histories have, which is the total characters of all the messages divided
+// by the distinct participants.
+func ContentRichnessOfChatHistories(histories []*ent.ChatHistories) float64 {
+	if len(histories) == 0 {
+		return 0
+	}
+
+	totalCharacters := lo.SumBy(histories, func(item *ent.ChatHistories) int {
+		return utf8.RuneCountInString(strings.TrimSpace(item.Text))
+	})
+	participants := len(lo.UniqBy(histories, func(item *ent.ChatHistories) int64 {
+		return item.UserID
+	}))
+
+	return float64(totalCharacters) / float64(participants)
+}
+
+// IsChatHistoriesContentRichEnough reports whether the chat histories reach
+// the configured minimum content richness and worth to be summarized.
+func (m *Model) IsChatHistoriesContentRichEnough(histories []*ent.ChatHistories) bool {
+	return ContentRichnessOfChatHistories(histories) >= m.config.Recap.MinContentRichness
+}
@@ -0,0 +1,48 @@
+package chathistories
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+)
+
+func TestContentRichnessOfChatHistories(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		assert.Zero(t, ContentRichnessOfChatHistories(nil))
+	})
+
+	t.Run("DividedByDistinctParticipants", func(t *testing.T) {
+		histories := []*ent.ChatHistories{
+			{UserID: 1, Text: "你好"},
+			{UserID: 1, Text: "  hello  "},
+			{UserID: 2, Text: "ok"},
+		}
+
+		assert.InDelta(t, 4.5, ContentRichnessOfChatHistories(histories), 0.0001)
+	})
+}
+
+func TestIsChatHistoriesContentRichEnough(t *testing.T) {
+	m := &Model{config: &configs.Config{Recap: configs.SectionRecap{MinContentRichness: 10}}}
+
+	histories := func(text string) []*ent.ChatHistories {
+		return []*ent.ChatHistories{
+			{UserID: 1, Text: text},
+			{UserID: 2, Text: text},
+		}
+	}
+
+	assert.False(t, m.IsChatHistoriesContentRichEnough(histories(strings.Repeat("a", 9))))
+	assert.True(t, m.IsChatHistoriesContentRichEnough(histories(strings.Repeat("a", 10))))
+	assert.True(t, m.IsChatHistoriesContentRichEnough(histories(strings.Repeat("a", 11))))
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		m := &Model{config: &configs.Config{}}
+
+		assert.True(t, m.IsChatHistoriesContentRichEnough(histories("")))
+	})
+}
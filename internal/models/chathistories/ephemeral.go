@@ -0,0 +1,322 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/google/uuid"
+	"github.com/redis/rueidis"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/types/redis"
+)
+
+// EphemeralChatHistoriesRetention is how long the chat histories of the chats
+// that opted out of storing message content are kept in Redis, it covers the
+// longest recap window.
+const EphemeralChatHistoriesRetention = 25 * time.Hour
+
+// SaveOneEphemeralTelegramChatHistory saves the chat history of the message
+// into Redis with a short TTL instead of the ChatHistories table.
+func (m *Model) SaveOneEphemeralTelegramChatHistory(message *tgbotapi.Message) error {
+	entity, err := m.newTelegramChatHistory(message)
+	if err != nil {
+		return err
+	}
+
+	if entity == nil {
+		return nil
+	}
+
+	entity.ID = uuid.New()
+	entity.CreatedAt = time.Now().UnixMilli()
+	entity.UpdatedAt = entity.CreatedAt
+
+	err = m.saveEphemeralChatHistory(entity)
+	if err != nil {
+		return err
+	}
+
+	err = m.saveFirstChattedAtOfUser(entity.ChatID, entity.UserID, entity.ChattedAt)
+	if err != nil {
+		return err
+	}
+
+	if entity.PollID != "" {
+		err = m.redis.Do(context.Background(), m.redis.B().
+			Set().
+			Key(redis.ChatHistoriesEphemeralPoll1.Format(entity.PollID)).
+			Value(strconv.FormatInt(entity.ChatID, 10)).
+			Ex(EphemeralChatHistoriesRetention).
+			Build(),
+		).Error()
+		if err != nil {
+			return err
+		}
+	}
+
+	m.logger.Debug("saved one ephemeral telegram chat history",
+		zap.Int64("chat_id", entity.ChatID),
+		zap.Int64("message_id", entity.MessageID),
+		zap.String("text", strings.ReplaceAll(entity.Text, "\n", " ")),
+	)
+
+	return nil
+}
+
+func (m *Model) saveEphemeralChatHistory(entity *ent.ChatHistories) error {
+	key := redis.ChatHistoriesEphemeral1.Format(entity.ChatID)
+
+	zaddCmd := m.redis.B().
+		Zadd().
+		Key(key).
+		ScoreMember().
+		ScoreMember(
+			float64(entity.ChattedAt),
+			string(lo.Must(json.Marshal(entity))),
+		).
+		Build()
+
+	err := m.redis.Do(context.Background(), zaddCmd).Error()
+	if err != nil {
+		return err
+	}
+
+	zremrangebyscoreCmd := m.redis.B().
+		Zremrangebyscore().
+		Key(key).
+		Min("-inf").
+		Max("(" + strconv.FormatInt(time.Now().Add(-EphemeralChatHistoriesRetention).UnixMilli(), 10)).
+		Build()
+
+	err = m.redis.Do(context.Background(), zremrangebyscoreCmd).Error()
+	if err != nil {
+		return err
+	}
+
+	expireCmd := m.redis.B().
+		Expire().
+		Key(key).
+		Seconds(int64(EphemeralChatHistoriesRetention.Seconds())).
+		Build()
+
+	err = m.redis.Do(context.Background(), expireCmd).Error()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Model) findEphemeralChatHistoriesSince(chatID int64, since time.Time) ([]*ent.ChatHistories, error) {
+	zrangebyscoreCmd := m.redis.B().
+		Zrangebyscore().
+		Key(redis.ChatHistoriesEphemeral1.Format(chatID)).
+		Min("(" + strconv.FormatInt(since.UnixMilli(), 10)).
+		Max("+inf").
+		Build()
+
+	members, err := m.redis.Do(context.Background(), zrangebyscoreCmd).AsStrSlice()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return make([]*ent.ChatHistories, 0), nil
+		}
+
+		return make([]*ent.ChatHistories, 0), err
+	}
+
+	return unmarshalEphemeralChatHistories(members), nil
+}
+
+func unmarshalEphemeralChatHistories(members []string) []*ent.ChatHistories {
+	histories := make([]*ent.ChatHistories, 0, len(members))
+
+	for _, member := range members {
+		var history ent.ChatHistories
+
+		err := json.Unmarshal([]byte(member), &history)
+		if err != nil {
+			continue
+		}
+
+		histories = append(histories, &history)
+	}
+
+	return histories
+}
+
+// saveFirstChattedAtOfUser keeps the time that the user was first seen
+// chatting in the chat, since the ephemeral chat histories are not kept long
+// enough to tell.
+func (m *Model) saveFirstChattedAtOfUser(chatID int64, userID int64, chattedAt int64) error {
+	if userID == 0 {
+		return nil
+	}
+
+	hsetnxCmd := m.redis.B().
+		Hsetnx().
+		Key(redis.ChatHistoriesFirstChattedAt1.Format(chatID)).
+		Field(strconv.FormatInt(userID, 10)).
+		Value(strconv.FormatInt(chattedAt, 10)).
+		Build()
+
+	return m.redis.Do(context.Background(), hsetnxCmd).Error()
+}
+
+// findFirstChattedAtOfUserFromEphemeral finds the time kept by
+// saveFirstChattedAtOfUser, zero will be returned if the user was never seen.
+func (m *Model) findFirstChattedAtOfUserFromEphemeral(chatID int64, userID int64) (int64, error) {
+	hgetCmd := m.redis.B().
+		Hget().
+		Key(redis.ChatHistoriesFirstChattedAt1.Format(chatID)).
+		Field(strconv.FormatInt(userID, 10)).
+		Build()
+
+	chattedAt, err := m.redis.Do(context.Background(), hgetCmd).AsInt64()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return chattedAt, nil
+}
+
+// updateEphemeralChatHistories applies update to the ephemeral chat histories
+// of the chat matching match if they are still kept.
+func (m *Model) updateEphemeralChatHistories(chatID int64, match func(history *ent.ChatHistories) bool, update func(history *ent.ChatHistories)) error {
+	key := redis.ChatHistoriesEphemeral1.Format(chatID)
+
+	zrangeCmd := m.redis.B().
+		Zrange().
+		Key(key).
+		Min("0").
+		Max("-1").
+		Build()
+
+	members, err := m.redis.Do(context.Background(), zrangeCmd).AsStrSlice()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, member := range members {
+		var history ent.ChatHistories
+
+		err := json.Unmarshal([]byte(member), &history)
+		if err != nil || !match(&history) {
+			continue
+		}
+
+		zremCmd := m.redis.B().
+			Zrem().
+			Key(key).
+			Member(member).
+			Build()
+
+		err = m.redis.Do(context.Background(), zremCmd).Error()
+		if err != nil {
+			return err
+		}
+
+		update(&history)
+		history.UpdatedAt = time.Now().UnixMilli()
+
+		err = m.saveEphemeralChatHistory(&history)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateEphemeralChatHistory replaces the text of the ephemeral chat history
+// of the message if it is still kept.
+func (m *Model) updateEphemeralChatHistory(chatID int64, messageID int64, text string) error {
+	return m.updateEphemeralChatHistories(chatID, func(history *ent.ChatHistories) bool {
+		return history.MessageID == messageID
+	}, func(history *ent.ChatHistories) {
+		history.Text = text
+	})
+}
+
+// updateEphemeralChatHistoryPoll updates the results of the poll kept in the
+// ephemeral chat histories, if any.
+func (m *Model) updateEphemeralChatHistoryPoll(poll *tgbotapi.Poll) error {
+	chatID, err := m.redis.Do(context.Background(), m.redis.B().Get().Key(redis.ChatHistoriesEphemeralPoll1.Format(poll.ID)).Build()).AsInt64()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return m.updateEphemeralChatHistories(chatID, func(history *ent.ChatHistories) bool {
+		return history.PollID == poll.ID
+	}, func(history *ent.ChatHistories) {
+		assignPollForChatHistory(history, poll)
+	})
+}
+
+func (m *Model) deleteEphemeralChatHistories(chatID int64) error {
+	delCmd := m.redis.B().
+		Del().
+		Key(redis.ChatHistoriesEphemeral1.Format(chatID), redis.ChatHistoriesFirstChattedAt1.Format(chatID)).
+		Build()
+
+	return m.redis.Do(context.Background(), delCmd).Error()
+}
+
+// mergeChatHistories merges the durable and the ephemeral chat histories
+// ordered by message id, the durable one wins if a message exists in both.
+func mergeChatHistories(durable []*ent.ChatHistories, ephemeral []*ent.ChatHistories) []*ent.ChatHistories {
+	if len(ephemeral) == 0 {
+		return durable
+	}
+
+	merged := make([]*ent.ChatHistories, 0, len(durable)+len(ephemeral))
+	merged = append(merged, durable...)
+
+	seen := lo.SliceToMap(durable, func(item *ent.ChatHistories) (int64, struct{}) {
+		return item.MessageID, struct{}{}
+	})
+
+	for _, history := range ephemeral {
+		if _, ok := seen[history.MessageID]; ok {
+			continue
+		}
+
+		seen[history.MessageID] = struct{}{}
+		merged = append(merged, history)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].MessageID < merged[j].MessageID
+	})
+
+	return merged
+}
+
+// filterChatHistoriesByKeyword keeps the chat histories whose text contains
+// the keyword, case insensitive.
+func filterChatHistoriesByKeyword(histories []*ent.ChatHistories, keyword string) []*ent.ChatHistories {
+	keyword = strings.ToLower(keyword)
+
+	return lo.Filter(histories, func(item *ent.ChatHistories, _ int) bool {
+		return strings.Contains(strings.ToLower(item.Text), keyword)
+	})
+}
@@ -0,0 +1,188 @@
+package chathistories
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/xo"
+)
+
+func TestSaveOneEphemeralTelegramChatHistory(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(err)
+
+	openAIClient := &openaimock.MockClient{}
+	openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+		return []string{s}
+	}
+	openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, _ string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+		return &goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{
+				Content: `[{"topicName":"周末爬山","sinceId":1,"participants":["User 1","User 2"],"discussion":[{"point":"约好早上八点在山脚下集合","keyIds":[1,2]}],"conclusion":"周六出发"}]`,
+			}}},
+		}, nil
+	}
+
+	m := &Model{
+		config: config,
+		logger: logger,
+		ent:    model.ent,
+		openAI: openAIClient,
+		redis:  model.redis,
+	}
+
+	chatID := xo.RandomInt64()
+	now := time.Now()
+
+	messages := []*tgbotapi.Message{
+		{
+			MessageID: 100,
+			From:      &tgbotapi.User{ID: 1, FirstName: "User 1"},
+			Chat:      &tgbotapi.Chat{ID: chatID, Type: string(telegram.ChatTypeSuperGroup)},
+			Date:      int(now.Add(-2 * time.Minute).Unix()),
+			Text:      "明天要不要一起去爬山，天气预报说是晴天",
+		},
+		{
+			MessageID: 101,
+			From:      &tgbotapi.User{ID: 2, FirstName: "User 2"},
+			Chat:      &tgbotapi.Chat{ID: chatID, Type: string(telegram.ChatTypeSuperGroup)},
+			Date:      int(now.Add(-time.Minute).Unix()),
+			Text:      "可以的，早上八点在山脚下集合吧",
+		},
+	}
+
+	for _, message := range messages {
+		err = m.SaveOneEphemeralTelegramChatHistory(message)
+		require.NoError(err)
+	}
+
+	defer func() {
+		_ = m.DeleteAllChatHistoriesByChatID(chatID)
+	}()
+
+	count, err := m.ent.ChatHistories.
+		Query().
+		Where(chathistories.ChatID(chatID)).
+		Count(context.Background())
+	require.NoError(err)
+	assert.Zero(count)
+
+	histories, err := m.FindChatHistoriesByTimeBefore(chatID, time.Hour)
+	require.NoError(err)
+	require.Len(histories, 2)
+	assert.Equal(int64(100), histories[0].MessageID)
+	assert.Equal("User 1", histories[0].FullName)
+	assert.Equal(int64(101), histories[1].MessageID)
+
+	matched, err := m.FindChatHistoriesByTimeBeforeMatching(chatID, time.Hour, "集合")
+	require.NoError(err)
+	require.Len(matched, 1)
+	assert.Equal(int64(101), matched[0].MessageID)
+
+	firstChattedAt, err := m.FindFirstChattedAtOfUser(chatID, 1)
+	require.NoError(err)
+	assert.Equal(int64(messages[0].Date), firstChattedAt.Unix())
+
+	firstChattedAt, err = m.FindFirstChattedAtOfUser(chatID, 3)
+	require.NoError(err)
+	assert.True(firstChattedAt.IsZero())
+
+	poll := &tgbotapi.Poll{ID: "poll-" + strconv.FormatInt(chatID, 10), Question: "去哪座山？", Options: []tgbotapi.PollOption{{Text: "香山"}, {Text: "百望山"}}}
+
+	err = m.SaveOneEphemeralTelegramChatHistory(&tgbotapi.Message{
+		MessageID: 102,
+		From:      &tgbotapi.User{ID: 1, FirstName: "User 1"},
+		Chat:      &tgbotapi.Chat{ID: chatID, Type: string(telegram.ChatTypeSuperGroup)},
+		Date:      int(now.Unix()),
+		Poll:      poll,
+	})
+	require.NoError(err)
+
+	err = m.UpdateTelegramChatHistoryPoll(&tgbotapi.Poll{ID: poll.ID, Question: poll.Question, Options: []tgbotapi.PollOption{{Text: "香山", VoterCount: 2}, {Text: "百望山"}}, TotalVoterCount: 2, IsClosed: true})
+	require.NoError(err)
+
+	histories, err = m.FindChatHistoriesByTimeBefore(chatID, time.Hour)
+	require.NoError(err)
+	require.Len(histories, 3)
+	assert.Equal(2, histories[2].PollTotalVoterCount)
+	assert.True(histories[2].PollIsClosed)
+
+	edited := *messages[1]
+	edited.Text = "可以的，早上九点在山脚下集合吧"
+
+	err = m.UpdateOneTelegramChatHistory(&edited)
+	require.NoError(err)
+
+	histories, err = m.FindChatHistoriesByTimeBefore(chatID, time.Hour)
+	require.NoError(err)
+	require.Len(histories, 3)
+	assert.Equal(edited.Text, histories[1].Text)
+
+	histories = histories[:2]
+
+	recap, err := m.GenerateChatHistoriesRecap(chatID, telegram.ChatTypeSuperGroup, histories)
+	require.NoError(err)
+	require.Len(recap.Outputs, 1)
+	assert.Equal("周末爬山", recap.Outputs[0].TopicName)
+	assert.Equal([]int64{100, 101}, recap.Outputs[0].Discussion[0].KeyIDs)
+
+	count, err = m.ent.ChatHistories.
+		Query().
+		Where(chathistories.ChatID(chatID)).
+		Count(context.Background())
+	require.NoError(err)
+	assert.Zero(count)
+}
+
+func TestMergeChatHistories(t *testing.T) {
+	durable := []*ent.ChatHistories{
+		{MessageID: 1, Text: "durable 1"},
+		{MessageID: 3, Text: "durable 3"},
+	}
+	ephemeral := []*ent.ChatHistories{
+		{MessageID: 2, Text: "ephemeral 2"},
+		{MessageID: 3, Text: "ephemeral 3"},
+		{MessageID: 4, Text: "ephemeral 4"},
+	}
+
+	merged := mergeChatHistories(durable, ephemeral)
+	require.Len(t, merged, 4)
+	assert.Equal(t, []string{"durable 1", "ephemeral 2", "durable 3", "ephemeral 4"}, []string{merged[0].Text, merged[1].Text, merged[2].Text, merged[3].Text})
+
+	assert.Equal(t, durable, mergeChatHistories(durable, nil))
+}
+
+func TestFilterChatHistoriesByKeyword(t *testing.T) {
+	histories := []*ent.ChatHistories{
+		{MessageID: 1, Text: "Let's talk about Golang"},
+		{MessageID: 2, Text: "周末去爬山"},
+		{MessageID: 3, Text: "golang 1.22 发布了"},
+	}
+
+	filtered := filterChatHistoriesByKeyword(histories, "GoLang")
+	require.Len(t, filtered, 2)
+	assert.Equal(t, int64(1), filtered[0].MessageID)
+	assert.Equal(t, int64(3), filtered[1].MessageID)
+}
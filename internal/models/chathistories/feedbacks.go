@@ -10,6 +10,7 @@ import (
 
 	"github.com/nekomeowww/insights-bot/ent"
 	"github.com/nekomeowww/insights-bot/ent/feedbackchathistoriesrecapsreactions"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
 	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
 )
@@ -49,6 +50,77 @@ func (m *Model) FindFeedbackRecapsReactionCountsForChatIDAndLogID(chatID int64,
 	}, nil
 }
 
+func (m *Model) FindRecentFeedbackRecapsReactionCountsForChatID(chatID int64, recentRecapsCount int) (FeedbackChatHistoriesRecapsReactionsCounts, error) {
+	logIDs, err := m.ent.LogChatHistoriesRecap.
+		Query().
+		Where(logchathistoriesrecap.ChatIDEQ(chatID)).
+		Order(ent.Desc(logchathistoriesrecap.FieldCreatedAt)).
+		Limit(recentRecapsCount).
+		IDs(context.TODO())
+	if err != nil {
+		return FeedbackChatHistoriesRecapsReactionsCounts{}, err
+	}
+
+	if len(logIDs) == 0 {
+		return FeedbackChatHistoriesRecapsReactionsCounts{}, nil
+	}
+
+	votes, err := m.ent.FeedbackChatHistoriesRecapsReactions.
+		Query().
+		Where(
+			feedbackchathistoriesrecapsreactions.ChatIDEQ(chatID),
+			feedbackchathistoriesrecapsreactions.LogIDIn(logIDs...),
+		).
+		All(context.TODO())
+	if err != nil {
+		return FeedbackChatHistoriesRecapsReactionsCounts{}, err
+	}
+
+	return FeedbackChatHistoriesRecapsReactionsCounts{
+		UpVotes: lo.CountBy(votes, func(item *ent.FeedbackChatHistoriesRecapsReactions) bool {
+			return item.Type == feedbackchathistoriesrecapsreactions.TypeUpVote
+		}),
+		DownVotes: lo.CountBy(votes, func(item *ent.FeedbackChatHistoriesRecapsReactions) bool {
+			return item.Type == feedbackchathistoriesrecapsreactions.TypeDownVote
+		}),
+		Lmao: lo.CountBy(votes, func(item *ent.FeedbackChatHistoriesRecapsReactions) bool {
+			return item.Type == feedbackchathistoriesrecapsreactions.TypeLmao
+		}),
+	}, nil
+}
+
+// FeedbackChatHistoriesRecapsVoters is the ids of the users who reacted to
+// the recap, grouped by the reaction types in the order they voted.
+type FeedbackChatHistoriesRecapsVoters struct {
+	UpVotes   []int64
+	DownVotes []int64
+	Lmao      []int64
+}
+
+// FindVotersForLogID finds the users who reacted to the recap of the log id.
+func (m *Model) FindVotersForLogID(logID uuid.UUID) (FeedbackChatHistoriesRecapsVoters, error) {
+	votes, err := m.ent.FeedbackChatHistoriesRecapsReactions.
+		Query().
+		Where(feedbackchathistoriesrecapsreactions.LogIDEQ(logID)).
+		Order(ent.Asc(feedbackchathistoriesrecapsreactions.FieldCreatedAt)).
+		All(context.TODO())
+	if err != nil {
+		return FeedbackChatHistoriesRecapsVoters{}, err
+	}
+
+	votersOf := func(reactionType feedbackchathistoriesrecapsreactions.Type) []int64 {
+		return lo.FilterMap(votes, func(item *ent.FeedbackChatHistoriesRecapsReactions, _ int) (int64, bool) {
+			return item.UserID, item.Type == reactionType
+		})
+	}
+
+	return FeedbackChatHistoriesRecapsVoters{
+		UpVotes:   votersOf(feedbackchathistoriesrecapsreactions.TypeUpVote),
+		DownVotes: votersOf(feedbackchathistoriesrecapsreactions.TypeDownVote),
+		Lmao:      votersOf(feedbackchathistoriesrecapsreactions.TypeLmao),
+	}, nil
+}
+
 func (m *Model) FeedbackRecapsReactToChatIDAndLogID(chatID int64, logID uuid.UUID, userID int64, reactionType feedbackchathistoriesrecapsreactions.Type) error {
 	affectedRows, err := m.ent.FeedbackChatHistoriesRecapsReactions.
 		Delete().
@@ -93,6 +165,18 @@ func (m *Model) FeedbackRecapsReactToChatIDAndLogID(chatID int64, logID uuid.UUI
 	return nil
 }
 
+// SaveRecapTextFeedback saves the free-text feedback that the user left on
+// the recap of the log id.
+func (m *Model) SaveRecapTextFeedback(chatID int64, logID uuid.UUID, userID int64, text string) (*ent.RecapFeedback, error) {
+	return m.ent.RecapFeedback.
+		Create().
+		SetChatID(chatID).
+		SetLogID(logID).
+		SetUserID(userID).
+		SetText(text).
+		Save(context.Background())
+}
+
 func (m *Model) NewFeedbackRecapsUpVoteButton(bot *tgbot.Bot, chatID int64, logID uuid.UUID, upVoteCount int) (tgbotapi.InlineKeyboardButton, error) {
 	upVoteData, err := bot.AssignOneCallbackQueryData("smr/summarization/feedback/react", recap.FeedbackRecapReactionActionData{ChatID: chatID, Type: feedbackchathistoriesrecapsreactions.TypeUpVote, LogID: logID.String()})
 	if err != nil {
@@ -0,0 +1,63 @@
+package chathistories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nekomeowww/xo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent/feedbackchathistoriesrecapsreactions"
+	"github.com/nekomeowww/insights-bot/ent/recapfeedback"
+)
+
+func TestSaveRecapTextFeedback(t *testing.T) {
+	chatID := xo.RandomInt64()
+	logID := uuid.New()
+	userID := xo.RandomInt64()
+
+	saved, err := model.SaveRecapTextFeedback(chatID, logID, userID, "漏掉了关于发布计划的讨论")
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+
+	feedback, err := model.ent.RecapFeedback.
+		Query().
+		Where(recapfeedback.LogID(logID)).
+		Only(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, chatID, feedback.ChatID)
+	assert.Equal(t, userID, feedback.UserID)
+	assert.Equal(t, "漏掉了关于发布计划的讨论", feedback.Text)
+}
+
+func TestFindVotersForLogID(t *testing.T) {
+	chatID := xo.RandomInt64()
+	logID := uuid.New()
+	user1 := xo.RandomInt64()
+	user2 := xo.RandomInt64()
+	user3 := xo.RandomInt64()
+
+	require.NoError(t, model.FeedbackRecapsReactToChatIDAndLogID(chatID, logID, user1, feedbackchathistoriesrecapsreactions.TypeUpVote))
+	require.NoError(t, model.FeedbackRecapsReactToChatIDAndLogID(chatID, logID, user2, feedbackchathistoriesrecapsreactions.TypeUpVote))
+	require.NoError(t, model.FeedbackRecapsReactToChatIDAndLogID(chatID, logID, user3, feedbackchathistoriesrecapsreactions.TypeDownVote))
+	// switching the reaction moves the voter to the new type
+	require.NoError(t, model.FeedbackRecapsReactToChatIDAndLogID(chatID, logID, user2, feedbackchathistoriesrecapsreactions.TypeLmao))
+	// reactions to other recaps are left out
+	require.NoError(t, model.FeedbackRecapsReactToChatIDAndLogID(chatID, uuid.New(), user3, feedbackchathistoriesrecapsreactions.TypeUpVote))
+
+	voters, err := model.FindVotersForLogID(logID)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{user1}, voters.UpVotes)
+	assert.Equal(t, []int64{user3}, voters.DownVotes)
+	assert.Equal(t, []int64{user2}, voters.Lmao)
+
+	voters, err = model.FindVotersForLogID(uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, voters.UpVotes)
+	assert.Empty(t, voters.DownVotes)
+	assert.Empty(t, voters.Lmao)
+}
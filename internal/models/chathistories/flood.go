@@ -0,0 +1,96 @@
+package chathistories
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+// normalizeFloodContent normalizes the text of the chat history in the same
+// way as the forwarded ones, the messages made of emojis or punctuations only
+// are compared with their lower cased trimmed text instead.
+func normalizeFloodContent(text string) string {
+	normalized := normalizeForwardedContent(text)
+	if normalized != "" {
+		return normalized
+	}
+
+	return strings.ToLower(strings.TrimSpace(text))
+}
+
+// UniqueMessageRatioOfChatHistories calculates the ratio of the distinct
+// messages to all the messages of the chat histories, the messages that only
+// differ in whitespaces, punctuations, emojis or cases are considered the
+// same. A window flooded with the same messages has a ratio close to 0.
+func UniqueMessageRatioOfChatHistories(histories []*ent.ChatHistories) float64 {
+	if len(histories) == 0 {
+		return 1
+	}
+
+	distinct := make(map[string]struct{}, len(histories))
+	for _, h := range histories {
+		distinct[normalizeFloodContent(h.Text)] = struct{}{}
+	}
+
+	return float64(len(distinct)) / float64(len(histories))
+}
+
+// IsChatHistoriesFlooded reports whether the chat histories look like a raid
+// or a flood, which is having at least minMessages messages while the unique
+// message ratio is below minUniqueRatio. Nothing is treated as flooded if
+// minUniqueRatio is not positive.
+func IsChatHistoriesFlooded(histories []*ent.ChatHistories, minUniqueRatio float64, minMessages int) bool {
+	if minUniqueRatio <= 0 || len(histories) == 0 || len(histories) < minMessages {
+		return false
+	}
+
+	return UniqueMessageRatioOfChatHistories(histories) < minUniqueRatio
+}
+
+// DedupFloodChatHistories collapses the repeated messages into the earliest
+// one, with "（重复 ×N）" appended to its text, so that only the distinct
+// messages of a flooded window are summarized. The order of the chat
+// histories is preserved and the given chat histories are not modified.
+func DedupFloodChatHistories(histories []*ent.ChatHistories) []*ent.ChatHistories {
+	counts := make(map[string]int, len(histories))
+	for _, h := range histories {
+		counts[normalizeFloodContent(h.Text)]++
+	}
+
+	seen := make(map[string]struct{}, len(counts))
+	deduped := make([]*ent.ChatHistories, 0, len(counts))
+
+	for _, h := range histories {
+		normalized := normalizeFloodContent(h.Text)
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+
+		seen[normalized] = struct{}{}
+
+		if counts[normalized] == 1 {
+			deduped = append(deduped, h)
+			continue
+		}
+
+		collapsed := *h
+		collapsed.Text = fmt.Sprintf("%s（重复 ×%d）", h.Text, counts[normalized])
+		deduped = append(deduped, &collapsed)
+	}
+
+	return deduped
+}
+
+// FilterFloodChatHistories dedups the chat histories with
+// DedupFloodChatHistories if they are flooded according to the configured
+// RECAP_FLOOD_MIN_UNIQUE_RATIO and RECAP_FLOOD_MIN_MESSAGES, the chat
+// histories are returned as is otherwise. The returned bool reports whether
+// the chat histories were flooded.
+func (m *Model) FilterFloodChatHistories(histories []*ent.ChatHistories) ([]*ent.ChatHistories, bool) {
+	if !IsChatHistoriesFlooded(histories, m.config.Recap.FloodMinUniqueRatio, m.config.Recap.FloodMinMessages) {
+		return histories, false
+	}
+
+	return DedupFloodChatHistories(histories), true
+}
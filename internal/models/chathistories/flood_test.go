@@ -0,0 +1,67 @@
+package chathistories
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+)
+
+func floodChatHistories(repeated int, distinct int) []*ent.ChatHistories {
+	histories := make([]*ent.ChatHistories, 0, repeated+distinct)
+	for i := 0; i < repeated; i++ {
+		histories = append(histories, &ent.ChatHistories{MessageID: int64(len(histories) + 1), UserID: int64(i%2 + 1), Text: lo.Ternary(i%2 == 0, "加群送币！！", "加群 送币")})
+	}
+	for i := 0; i < distinct; i++ {
+		histories = append(histories, &ent.ChatHistories{MessageID: int64(len(histories) + 1), UserID: 3, Text: fmt.Sprintf("message %d", i)})
+	}
+
+	return histories
+}
+
+func TestUniqueMessageRatioOfChatHistories(t *testing.T) {
+	assert.Equal(t, float64(1), UniqueMessageRatioOfChatHistories(nil))
+	assert.InDelta(t, 0.1, UniqueMessageRatioOfChatHistories(floodChatHistories(10, 0)), 0.0001)
+	assert.InDelta(t, 0.5, UniqueMessageRatioOfChatHistories(floodChatHistories(3, 1)), 0.0001)
+	assert.InDelta(t, 0.5, UniqueMessageRatioOfChatHistories([]*ent.ChatHistories{{Text: "👍"}, {Text: " 👍 "}}), 0.0001)
+}
+
+func TestIsChatHistoriesFlooded(t *testing.T) {
+	histories := floodChatHistories(90, 10)
+
+	assert.True(t, IsChatHistoriesFlooded(histories, 0.2, 50))
+	assert.False(t, IsChatHistoriesFlooded(histories, 0, 50), "disabled")
+	assert.False(t, IsChatHistoriesFlooded(histories, 0.2, 101), "not enough messages")
+	assert.False(t, IsChatHistoriesFlooded(floodChatHistories(10, 90), 0.2, 50), "diverse enough")
+}
+
+func TestDedupFloodChatHistories(t *testing.T) {
+	histories := floodChatHistories(4, 2)
+
+	deduped := DedupFloodChatHistories(histories)
+	require.Len(t, deduped, 3)
+	assert.Equal(t, int64(1), deduped[0].MessageID)
+	assert.Equal(t, "加群送币！！（重复 ×4）", deduped[0].Text)
+	assert.Equal(t, "message 0", deduped[1].Text)
+	assert.Same(t, histories[4], deduped[1])
+	assert.Equal(t, "message 1", deduped[2].Text)
+	assert.Equal(t, "加群送币！！", histories[0].Text, "original chat histories should not be modified")
+}
+
+func TestFilterFloodChatHistories(t *testing.T) {
+	m := &Model{config: &configs.Config{Recap: configs.SectionRecap{FloodMinUniqueRatio: 0.2, FloodMinMessages: 50}}}
+
+	filtered, flooded := m.FilterFloodChatHistories(floodChatHistories(90, 10))
+	assert.True(t, flooded)
+	assert.Len(t, filtered, 11)
+
+	histories := floodChatHistories(10, 90)
+	filtered, flooded = m.FilterFloodChatHistories(histories)
+	assert.False(t, flooded)
+	assert.Equal(t, histories, filtered)
+}
@@ -0,0 +1,138 @@
+package chathistories
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+)
+
+const (
+	// forwardsNearIdenticalSimilarity is the minimum similarity of two
+	// forwarded messages to be considered as near-identical.
+	forwardsNearIdenticalSimilarity = 0.9
+	// forwardsNearIdenticalMinTokens is the minimum number of distinct tokens
+	// required for the similarity check, short messages have to be identical.
+	forwardsNearIdenticalMinTokens = 10
+)
+
+var regexpForwardedChatHistoryPrefix = regexp.MustCompile(`^\[forwarded from [^\]]*\]: `)
+
+// forwardedChatHistoryContent returns the content of the forwarded message
+// without the "[forwarded from ...]: " prefix added when it was saved.
+func forwardedChatHistoryContent(text string) (string, bool) {
+	prefix := regexpForwardedChatHistoryPrefix.FindString(text)
+	if prefix == "" {
+		return "", false
+	}
+
+	return strings.TrimPrefix(text, prefix), true
+}
+
+// normalizeForwardedContent keeps only the lower cased letters and digits,
+// so that the differences of whitespaces, punctuations and emojis are ignored.
+func normalizeForwardedContent(content string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, content)
+}
+
+type forwardedChatHistoriesGroup struct {
+	survivor   *ent.ChatHistories
+	content    string
+	normalized string
+	tokens     int
+	count      int
+}
+
+func (g *forwardedChatHistoriesGroup) matches(content string, normalized string) bool {
+	if g.normalized == normalized {
+		return true
+	}
+	if g.tokens < forwardsNearIdenticalMinTokens || len(lo.Uniq(tokenizeRecap(content))) < forwardsNearIdenticalMinTokens {
+		return false
+	}
+
+	return RecapsSimilarity(g.content, content) >= forwardsNearIdenticalSimilarity
+}
+
+// DedupForwardedChatHistories collapses the identical or near-identical
+// forwarded messages into the earliest one, with "（转发 ×N）" appended to its
+// text, so that the content forwarded many times won't be over-weighted in the
+// recaps. Messages that are not forwarded are kept as is, the order of the
+// chat histories is preserved and the given chat histories are not modified.
+func DedupForwardedChatHistories(histories []*ent.ChatHistories) []*ent.ChatHistories {
+	sorted := append([]*ent.ChatHistories{}, histories...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].MessageID < sorted[j].MessageID
+	})
+
+	groups := make([]*forwardedChatHistoriesGroup, 0)
+	groupOfHistory := make(map[*ent.ChatHistories]*forwardedChatHistoriesGroup)
+
+	for _, h := range sorted {
+		content, ok := forwardedChatHistoryContent(h.Text)
+		if !ok {
+			continue
+		}
+
+		normalized := normalizeForwardedContent(content)
+		if normalized == "" {
+			continue
+		}
+
+		group, found := lo.Find(groups, func(item *forwardedChatHistoriesGroup) bool {
+			return item.matches(content, normalized)
+		})
+		if !found {
+			group = &forwardedChatHistoriesGroup{
+				survivor:   h,
+				content:    content,
+				normalized: normalized,
+				tokens:     len(lo.Uniq(tokenizeRecap(content))),
+			}
+			groups = append(groups, group)
+		}
+
+		group.count++
+		groupOfHistory[h] = group
+	}
+
+	deduped := make([]*ent.ChatHistories, 0, len(histories))
+
+	for _, h := range histories {
+		group, ok := groupOfHistory[h]
+		if !ok || group.count == 1 {
+			deduped = append(deduped, h)
+			continue
+		}
+		if group.survivor != h {
+			continue
+		}
+
+		collapsed := *h
+		collapsed.Text = fmt.Sprintf("%s（转发 ×%d）", h.Text, group.count)
+		deduped = append(deduped, &collapsed)
+	}
+
+	return deduped
+}
+
+// WithSummarizeChatHistoriesDedupForwards collapses the identical or
+// near-identical forwarded messages before summarizing, see
+// DedupForwardedChatHistories.
+func WithSummarizeChatHistoriesDedupForwards(dedupForwards bool) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.DedupForwards = dedupForwards
+	})
+}
@@ -0,0 +1,61 @@
+package chathistories
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+func TestForwardedChatHistoryContent(t *testing.T) {
+	content, ok := forwardedChatHistoryContent("[forwarded from Channel]: hello")
+	assert.True(t, ok)
+	assert.Equal(t, "hello", content)
+
+	_, ok = forwardedChatHistoryContent("hello [forwarded from Channel]: hello")
+	assert.False(t, ok)
+}
+
+func TestDedupForwardedChatHistories(t *testing.T) {
+	news := "Insights Bot v2 released with recap previews, per chat languages, keyword lines and token usage reports for admins"
+
+	histories := []*ent.ChatHistories{
+		{MessageID: 3, Text: "[forwarded from Channel A]: 新版本发布了！"},
+		{MessageID: 1, Text: "大家看看这个"},
+		{MessageID: 2, Text: "[forwarded from Channel B]: 新版本 发布了"},
+		{MessageID: 4, Text: "[forwarded from Alice]: " + news},
+		{MessageID: 5, Text: "[forwarded from Bob]: " + news + "!!"},
+		{MessageID: 6, Text: "[forwarded from Carol]: " + news + " today"},
+		{MessageID: 7, Text: "[forwarded from Dave]: 完全不同的内容"},
+		{MessageID: 8, Text: "新版本发布了！"},
+	}
+
+	deduped := DedupForwardedChatHistories(histories)
+	require.Len(t, deduped, 5)
+
+	assert.Equal(t, []int64{1, 2, 4, 7, 8}, lo.Map(deduped, func(item *ent.ChatHistories, _ int) int64 {
+		return item.MessageID
+	}))
+	assert.Equal(t, "大家看看这个", deduped[0].Text)
+	// the earliest message survives even if it comes later in the slice
+	assert.Equal(t, "[forwarded from Channel B]: 新版本 发布了（转发 ×2）", deduped[1].Text)
+	// near-identical forwards are collapsed as well
+	assert.Equal(t, "[forwarded from Alice]: "+news+"（转发 ×3）", deduped[2].Text)
+	assert.Equal(t, "[forwarded from Dave]: 完全不同的内容", deduped[3].Text)
+	// messages that are not forwarded are never collapsed
+	assert.Equal(t, "新版本发布了！", deduped[4].Text)
+
+	// the given chat histories are not modified
+	assert.Equal(t, "[forwarded from Channel B]: 新版本 发布了", histories[2].Text)
+}
+
+func TestDedupForwardedChatHistoriesShortForwardsMustBeIdentical(t *testing.T) {
+	deduped := DedupForwardedChatHistories([]*ent.ChatHistories{
+		{MessageID: 1, Text: "[forwarded from A]: 今天下雨"},
+		{MessageID: 2, Text: "[forwarded from B]: 今天不下雨"},
+	})
+	assert.Len(t, deduped, 2)
+}
@@ -0,0 +1,136 @@
+package chathistories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/redis"
+)
+
+// PreviousRecapSummaryRetention is how long the summary of the last recap is
+// kept for the next incremental recap, the recap is summarized as usual if the
+// previous one is older than this.
+const PreviousRecapSummaryRetention = 7 * 24 * time.Hour
+
+const incrementalRecapInstruction = `The topics summarized in the previous recap of this chat were:"""
+%s
+"""
+Please focus on the topics that are new or have changed since the previous recap, and avoid repeating what was already summarized unless there are new developments. ` +
+	`For the topics that continue from the previous recap, add an extra string field "newDevelopments" to the topic object describing briefly what is new since the previous recap.`
+
+// WithSummarizeChatHistoriesIncrementalRecap makes the summarization emphasize
+// what is new since the previous recap of the chat, and keeps the summary of
+// this recap for the next one once it is saved.
+func WithSummarizeChatHistoriesIncrementalRecap(enabled bool) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.IncrementalRecap = enabled
+	})
+}
+
+// FormatPreviousRecapSummary formats the outputs into the compact plain text
+// summary given to the next incremental recap as context.
+func FormatPreviousRecapSummary(outputs []*openai.ChatHistorySummarizationOutputs) string {
+	lines := lo.FilterMap(outputs, func(output *openai.ChatHistorySummarizationOutputs, _ int) (string, bool) {
+		if output == nil || output.TopicName == "" {
+			return "", false
+		}
+
+		points := lo.FilterMap(output.Discussion, func(d *openai.ChatHistorySummarizationOutputsDiscussion, _ int) (string, bool) {
+			if d == nil || d.Point == "" {
+				return "", false
+			}
+
+			return d.Point, true
+		})
+		if output.Conclusion != "" {
+			points = append(points, output.Conclusion)
+		}
+
+		if len(points) == 0 {
+			return "- " + output.TopicName, true
+		}
+
+		return fmt.Sprintf("- %s: %s", output.TopicName, strings.Join(points, "; ")), true
+	})
+
+	return strings.Join(lines, "\n")
+}
+
+// FormatIncrementalRecapNewDevelopments renders the new developments of the
+// topics continued from the previous recap into a markdown section, an empty
+// string will be returned if there is none.
+func FormatIncrementalRecapNewDevelopments(outputs []*openai.ChatHistorySummarizationOutputs) string {
+	lines := lo.FilterMap(outputs, func(output *openai.ChatHistorySummarizationOutputs, _ int) (string, bool) {
+		if output == nil || strings.TrimSpace(output.NewDevelopments) == "" {
+			return "", false
+		}
+
+		return fmt.Sprintf(" - %s：%s", tgbot.EscapeHTMLSymbols(output.TopicName), tgbot.EscapeHTMLSymbols(strings.TrimSpace(output.NewDevelopments))), true
+	})
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return "## 自上次回顾以来的新进展\n" + strings.Join(lines, "\n")
+}
+
+// SavePreviousChatHistoriesRecapSummary keeps the summary of the recap for the
+// next incremental recap of the chat.
+func (m *Model) SavePreviousChatHistoriesRecapSummary(chatID int64, summary string) error {
+	setCmd := m.redis.B().
+		Set().
+		Key(redis.RecapPreviousSummary1.Format(chatID)).
+		Value(summary).
+		ExSeconds(int64(PreviousRecapSummaryRetention.Seconds())).
+		Build()
+
+	return m.redis.Do(context.Background(), setCmd).Error()
+}
+
+// FindPreviousChatHistoriesRecapSummary finds the summary of the previous
+// incremental recap of the chat, an empty string will be returned if there is
+// none.
+func (m *Model) FindPreviousChatHistoriesRecapSummary(chatID int64) (string, error) {
+	getCmd := m.redis.B().
+		Get().
+		Key(redis.RecapPreviousSummary1.Format(chatID)).
+		Build()
+
+	summary, err := m.redis.Do(context.Background(), getCmd).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return summary, nil
+}
+
+func (m *Model) incrementalRecapInstructions(chatID int64, opts *SummarizeChatHistoriesCallOptions) []string {
+	if !opts.IncrementalRecap {
+		return nil
+	}
+
+	summary, err := m.FindPreviousChatHistoriesRecapSummary(chatID)
+	if err != nil {
+		m.logger.Error("failed to find previous recap summary for incremental recap, skipping...", zap.Int64("chat_id", chatID), zap.Error(err))
+		return nil
+	}
+
+	if summary == "" {
+		return nil
+	}
+
+	return []string{fmt.Sprintf(incrementalRecapInstruction, summary)}
+}
@@ -0,0 +1,108 @@
+package chathistories
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/xo"
+)
+
+func TestFormatPreviousRecapSummary(t *testing.T) {
+	summary := FormatPreviousRecapSummary([]*openai.ChatHistorySummarizationOutputs{
+		{TopicName: "周末爬山", Discussion: []*openai.ChatHistorySummarizationOutputsDiscussion{{Point: "约好早上八点集合"}, nil}, Conclusion: "周六出发"},
+		{TopicName: ""},
+		{TopicName: "新版本发布"},
+	})
+
+	assert.Equal(t, "- 周末爬山: 约好早上八点集合; 周六出发\n- 新版本发布", summary)
+	assert.Empty(t, FormatPreviousRecapSummary(nil))
+}
+
+func TestFormatIncrementalRecapNewDevelopments(t *testing.T) {
+	section := FormatIncrementalRecapNewDevelopments([]*openai.ChatHistorySummarizationOutputs{
+		{TopicName: "周末爬山", NewDevelopments: "集合时间改到了九点"},
+		{TopicName: "新版本发布"},
+		{TopicName: "A & B", NewDevelopments: "  确定了发布日期 "},
+	})
+
+	assert.Equal(t, "## 自上次回顾以来的新进展\n - 周末爬山：集合时间改到了九点\n - A &amp; B：确定了发布日期", section)
+	assert.Empty(t, FormatIncrementalRecapNewDevelopments([]*openai.ChatHistorySummarizationOutputs{{TopicName: "新版本发布"}}))
+}
+
+func TestGenerateChatHistoriesRecapIncrementalRecap(t *testing.T) {
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(t, err)
+
+	var extraInstructions []string
+
+	openAIClient := &openaimock.MockClient{}
+	openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+		return []string{s}
+	}
+	openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, _ string, callOpts ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+		extraInstructions = options.ApplyCallOptions(callOpts).ExtraInstructions
+
+		return &goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{
+				Content: `[{"topicName":"周末爬山","sinceId":1,"participants":["User 1","User 2"],"discussion":[{"point":"集合时间改到了九点","keyIds":[1,2]}],"newDevelopments":"集合时间从八点改到了九点"}]`,
+			}}},
+		}, nil
+	}
+
+	m := &Model{
+		config: config,
+		logger: logger,
+		openAI: openAIClient,
+		redis:  model.redis,
+	}
+
+	chatID := xo.RandomInt64()
+	histories := []*ent.ChatHistories{
+		{MessageID: 100, UserID: 1, FullName: "User 1", Text: "集合时间改到九点吧"},
+		{MessageID: 101, UserID: 2, FullName: "User 2", Text: "好的，九点见"},
+	}
+
+	err = m.SavePreviousChatHistoriesRecapSummary(chatID, "- 周末爬山: 约好早上八点集合")
+	require.NoError(t, err)
+
+	t.Run("Enabled", func(t *testing.T) {
+		recap, err := m.GenerateChatHistoriesRecap(chatID, telegram.ChatTypeSuperGroup, histories, WithSummarizeChatHistoriesIncrementalRecap(true))
+		require.NoError(t, err)
+
+		assert.True(t, recap.IncrementalRecap)
+		assert.True(t, lo.SomeBy(extraInstructions, func(instruction string) bool {
+			return strings.Contains(instruction, "- 周末爬山: 约好早上八点集合") && strings.Contains(instruction, "newDevelopments")
+		}))
+
+		require.NotEmpty(t, recap.Summarizations)
+		assert.Equal(t, "## 自上次回顾以来的新进展\n - 周末爬山：集合时间从八点改到了九点", recap.Summarizations[0])
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		recap, err := m.GenerateChatHistoriesRecap(chatID, telegram.ChatTypeSuperGroup, histories)
+		require.NoError(t, err)
+
+		assert.False(t, recap.IncrementalRecap)
+		assert.False(t, lo.SomeBy(extraInstructions, func(instruction string) bool {
+			return strings.Contains(instruction, "周末爬山")
+		}))
+		assert.NotContains(t, strings.Join(recap.Summarizations, "\n"), "自上次回顾以来的新进展")
+	})
+}
@@ -0,0 +1,214 @@
+package chathistories
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/pkg/options"
+)
+
+const (
+	// keywordsMinOccurrences is the minimum number of messages that a keyword
+	// should appear in, so that a single message won't make up the keywords.
+	keywordsMinOccurrences = 2
+	// keywordsMaxCJKNGramLength is the maximum length of n-grams generated for
+	// the CJK texts which are not delimited by spaces.
+	keywordsMaxCJKNGramLength = 4
+)
+
+// keywordsCJKStopCharacters are the characters of function words and pronouns
+// which hardly make up any keywords, CJK texts are split at these characters
+// before n-grams are generated.
+var keywordsCJKStopCharacters = lo.SliceToMap([]rune("的了是在和与與及就都也还還吗嗎呢吧啊哦呀嘛么麼我你您他她它们們这這那个個不没沒很把被让讓给給着著过過哈嗯"), func(item rune) (rune, struct{}) {
+	return item, struct{}{}
+})
+
+var keywordsStopWords = lo.SliceToMap([]string{
+	"a", "about", "also", "an", "and", "are", "as", "at", "be", "but", "by", "can", "com", "did", "do", "does",
+	"for", "from", "had", "has", "have", "he", "how", "http", "https", "if", "in", "is", "it", "its", "just",
+	"like", "lol", "me", "my", "no", "not", "of", "ok", "okay", "on", "or", "she", "should", "so", "that",
+	"the", "then", "there", "they", "this", "to", "too", "very", "was", "we", "were", "what", "why", "will",
+	"with", "would", "www", "yes", "you", "your",
+}, func(item string) (string, struct{}) {
+	return item, struct{}{}
+})
+
+func isKeywordsCJKRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// keywordsCJKNGrams generates the n-grams of the CJK run, the run is split at
+// the stop characters first, and the n-grams being stop words are dropped.
+func keywordsCJKNGrams(run []rune, stopWords *KeywordsStopWords) []string {
+	ngrams := make([]string, 0)
+	segments := make([][]rune, 0)
+	segment := make([]rune, 0)
+
+	for _, r := range run {
+		if stopWords.isCJKStopCharacter(r) {
+			segments = append(segments, segment)
+			segment = make([]rune, 0)
+
+			continue
+		}
+
+		segment = append(segment, r)
+	}
+
+	segments = append(segments, segment)
+
+	for _, s := range segments {
+		for n := 2; n <= keywordsMaxCJKNGramLength && n <= len(s); n++ {
+			for i := 0; i+n <= len(s); i++ {
+				ngram := string(s[i : i+n])
+				if stopWords.isStopWord(ngram) {
+					continue
+				}
+
+				ngrams = append(ngrams, ngram)
+			}
+		}
+	}
+
+	return ngrams
+}
+
+// tokenizeForKeywords tokenizes the text into the keyword candidates, words of
+// the space delimited languages are lower cased and the stop words are
+// dropped, while the CJK texts are turned into n-grams. stopWords can be nil
+// to only drop the built-in stop words.
+func tokenizeForKeywords(text string, stopWords *KeywordsStopWords) []string {
+	tokens := make([]string, 0)
+	run := make([]rune, 0)
+	runIsCJK := false
+
+	flush := func() {
+		defer func() { run = make([]rune, 0) }()
+
+		if len(run) == 0 {
+			return
+		}
+
+		if runIsCJK {
+			tokens = append(tokens, keywordsCJKNGrams(run, stopWords)...)
+			return
+		}
+
+		word := strings.ToLower(string(run))
+		if len(run) < 2 || lo.EveryBy(run, unicode.IsDigit) {
+			return
+		}
+
+		if stopWords.isStopWord(word) {
+			return
+		}
+
+		tokens = append(tokens, word)
+	}
+
+	for _, r := range text {
+		switch {
+		case isKeywordsCJKRune(r):
+			if !runIsCJK {
+				flush()
+			}
+
+			runIsCJK = true
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if runIsCJK {
+				flush()
+			}
+
+			runIsCJK = false
+			run = append(run, r)
+		default:
+			flush()
+		}
+	}
+
+	flush()
+
+	return tokens
+}
+
+// ExtractKeywords extracts at most n of the most frequently mentioned
+// keywords from the texts. Every text counts at most once for a keyword, and
+// keywords overlapping with a more frequent or a longer one are dropped, for
+// example "发布" is dropped if "发布会" is as frequent. stopWords can be nil to
+// only drop the built-in stop words.
+func ExtractKeywords(texts []string, n int, stopWords *KeywordsStopWords) []string {
+	if n <= 0 {
+		return make([]string, 0)
+	}
+
+	counts := make(map[string]int)
+
+	for _, text := range texts {
+		for _, token := range lo.Uniq(tokenizeForKeywords(text, stopWords)) {
+			counts[token]++
+		}
+	}
+
+	candidates := lo.Filter(lo.Keys(counts), func(item string, _ int) bool {
+		return counts[item] >= keywordsMinOccurrences
+	})
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if counts[candidates[i]] != counts[candidates[j]] {
+			return counts[candidates[i]] > counts[candidates[j]]
+		}
+
+		li, lj := utf8.RuneCountInString(candidates[i]), utf8.RuneCountInString(candidates[j])
+		if li != lj {
+			return li > lj
+		}
+
+		return candidates[i] < candidates[j]
+	})
+
+	keywords := make([]string, 0, n)
+
+	for _, c := range candidates {
+		if len(keywords) >= n {
+			break
+		}
+
+		overlapped := lo.SomeBy(keywords, func(item string) bool {
+			return strings.Contains(item, c) || strings.Contains(c, item)
+		})
+		if overlapped {
+			continue
+		}
+
+		keywords = append(keywords, c)
+	}
+
+	return keywords
+}
+
+// FormatTopKeywords renders the keywords into a single line localized by
+// texts, empty string is returned if there are no keywords.
+func FormatTopKeywords(keywords []string, texts RecapTexts) string {
+	if len(keywords) == 0 {
+		return ""
+	}
+
+	return texts.TopKeywords() + strings.Join(lo.Map(keywords, func(item string, _ int) string {
+		return html.EscapeString(item)
+	}), texts.EnumerationSeparator())
+}
+
+// WithSummarizeChatHistoriesTopKeywords appends a line of at most n top
+// keywords extracted from the chat histories to the recap, non-positive n
+// disables it.
+func WithSummarizeChatHistoriesTopKeywords(n int) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.TopKeywordsCount = n
+	})
+}
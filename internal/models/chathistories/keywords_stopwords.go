@@ -0,0 +1,210 @@
+package chathistories
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
+)
+
+var errInvalidKeywordsStopWords = errors.New("invalid keywords stop words")
+
+// KeywordsStopWords are the words dropped from the top keywords besides the
+// built-in ones. A single CJK character splits the CJK texts just like the
+// built-in stop characters, other words drop the keyword candidates equal to
+// them, case insensitively.
+type KeywordsStopWords struct {
+	words         map[string]struct{}
+	cjkCharacters map[rune]struct{}
+}
+
+// NewKeywordsStopWords creates the stop words from the words, words are
+// trimmed and lower cased, empty ones are ignored.
+func NewKeywordsStopWords(words ...string) *KeywordsStopWords {
+	s := &KeywordsStopWords{
+		words:         make(map[string]struct{}),
+		cjkCharacters: make(map[rune]struct{}),
+	}
+
+	s.add(words...)
+
+	return s
+}
+
+func (s *KeywordsStopWords) add(words ...string) {
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word == "" {
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(word)
+		if size == len(word) && isKeywordsCJKRune(r) {
+			s.cjkCharacters[r] = struct{}{}
+
+			continue
+		}
+
+		s.words[word] = struct{}{}
+	}
+}
+
+// isCJKStopCharacter reports whether the CJK texts should be split at r, s
+// can be nil to only use the built-in stop characters.
+func (s *KeywordsStopWords) isCJKStopCharacter(r rune) bool {
+	if _, ok := keywordsCJKStopCharacters[r]; ok {
+		return true
+	}
+
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.cjkCharacters[r]
+
+	return ok
+}
+
+// isStopWord reports whether the lower cased keyword candidate should be
+// dropped, s can be nil to only use the built-in stop words.
+func (s *KeywordsStopWords) isStopWord(word string) bool {
+	if _, ok := keywordsStopWords[word]; ok {
+		return true
+	}
+
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.words[word]
+
+	return ok
+}
+
+// parseKeywordsStopWords parses a stop word list, one word per line, empty
+// lines and lines starting with # are skipped. name is only used in errors.
+func parseKeywordsStopWords(name string, content string) ([]string, error) {
+	words := make([]string, 0)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+
+	for line := 1; scanner.Scan(); line++ {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+
+		if strings.IndexFunc(word, unicode.IsSpace) != -1 {
+			return nil, fmt.Errorf("%w: %s:%d: %q should be a single word", errInvalidKeywordsStopWords, name, line, word)
+		}
+
+		words = append(words, word)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", errInvalidKeywordsStopWords, name, err)
+	}
+
+	return words, nil
+}
+
+// KeywordsStopWordLists are the stop word lists of the languages, the list
+// of the recap language of the chat is used to extract the keywords.
+type KeywordsStopWordLists struct {
+	// Languages are the languages of the loaded lists, sorted.
+	Languages []string
+
+	lists   []*KeywordsStopWords
+	matcher language.Matcher
+}
+
+// ForLanguage returns the stop words of the list matching the language, such
+// as the tgchats.RecapLocale of the chat. nil will be returned to only use the
+// built-in stop words if no list matches, l can be nil as well.
+func (l *KeywordsStopWordLists) ForLanguage(lang string) *KeywordsStopWords {
+	if l == nil || len(l.lists) == 0 {
+		return nil
+	}
+
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil
+	}
+
+	_, index, confidence := l.matcher.Match(tag)
+	if confidence == language.No {
+		return nil
+	}
+
+	return l.lists[index]
+}
+
+// LoadKeywordsStopWords loads the stop word lists in the dir, every list is
+// named by its language, such as zh.txt and en.txt. Any file that is not a
+// valid list fails the loading, so that a typo won't be silently ignored.
+func LoadKeywordsStopWords(dir string) (*KeywordsStopWordLists, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidKeywordsStopWords, err)
+	}
+
+	lists := make(map[string]*KeywordsStopWords)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		name := entry.Name()
+		if filepath.Ext(name) != ".txt" {
+			return nil, fmt.Errorf("%w: %s: should be named like zh.txt", errInvalidKeywordsStopWords, name)
+		}
+
+		lang, err := language.Parse(strings.TrimSuffix(name, ".txt"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", errInvalidKeywordsStopWords, name, err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidKeywordsStopWords, err)
+		}
+
+		words, err := parseKeywordsStopWords(name, string(content))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := lists[lang.String()]; !ok {
+			lists[lang.String()] = NewKeywordsStopWords()
+		}
+
+		lists[lang.String()].add(words...)
+	}
+
+	l := &KeywordsStopWordLists{
+		Languages: lo.Keys(lists),
+		lists:     make([]*KeywordsStopWords, 0, len(lists)),
+	}
+
+	sort.Strings(l.Languages)
+
+	tags := make([]language.Tag, 0, len(l.Languages))
+
+	for _, lang := range l.Languages {
+		tags = append(tags, language.Make(lang))
+		l.lists = append(l.lists, lists[lang])
+	}
+
+	l.matcher = language.NewMatcher(tags)
+
+	return l, nil
+}
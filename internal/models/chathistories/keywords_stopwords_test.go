@@ -0,0 +1,86 @@
+package chathistories
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeywordsStopWords(t *testing.T) {
+	words, err := parseKeywordsStopWords("zh.txt", "# comment\n\n 群主 \nkubernetes\r\n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"群主", "kubernetes"}, words)
+
+	_, err = parseKeywordsStopWords("en.txt", "good morning\n")
+	require.ErrorIs(t, err, errInvalidKeywordsStopWords)
+	assert.ErrorContains(t, err, "en.txt:1")
+}
+
+func TestLoadKeywordsStopWords(t *testing.T) {
+	t.Run("Shipped", func(t *testing.T) {
+		stopWords, err := LoadKeywordsStopWords(filepath.Join("..", "..", "..", "stopwords"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"en", "zh"}, stopWords.Languages)
+		assert.NotNil(t, stopWords.ForLanguage("zh-CN"))
+		assert.NotNil(t, stopWords.ForLanguage("en"))
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "zh-TW.txt"), []byte("群組\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "en.txt"), []byte("Standup\n"), 0o600))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "drafts"), 0o700))
+
+		stopWords, err := LoadKeywordsStopWords(dir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"en", "zh-TW"}, stopWords.Languages)
+		assert.True(t, stopWords.ForLanguage("zh-TW").isStopWord("群組"))
+		assert.False(t, stopWords.ForLanguage("zh-TW").isStopWord("standup"))
+		assert.True(t, stopWords.ForLanguage("en").isStopWord("standup"))
+		assert.False(t, stopWords.ForLanguage("en").isStopWord("群組"))
+		assert.Nil(t, stopWords.ForLanguage("ja"))
+		assert.Nil(t, (*KeywordsStopWordLists)(nil).ForLanguage("en"))
+	})
+
+	t.Run("InvalidFiles", func(t *testing.T) {
+		for name, content := range map[string]string{
+			"README.md":      "stop words",
+			"not a lang.txt": "word",
+			"en.txt":         "two words",
+		} {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+
+			_, err := LoadKeywordsStopWords(dir)
+			require.ErrorIs(t, err, errInvalidKeywordsStopWords, name)
+		}
+	})
+
+	t.Run("MissingDir", func(t *testing.T) {
+		_, err := LoadKeywordsStopWords(filepath.Join(t.TempDir(), "missing"))
+		require.ErrorIs(t, err, errInvalidKeywordsStopWords)
+	})
+}
+
+func TestExtractKeywordsWithStopWords(t *testing.T) {
+	texts := []string{
+		"群主今天的直播几点开始？",
+		"群主说直播推迟了",
+		"Standup moved, the kubernetes upgrade is done",
+		"kubernetes upgrade after standup",
+		"群主的直播我也想看",
+	}
+
+	assert.ElementsMatch(t, []string{"群主", "kubernetes", "standup", "upgrade", "直播"}, ExtractKeywords(texts, 5, nil))
+
+	stopWords := NewKeywordsStopWords("群主", "Standup", "播")
+	keywords := ExtractKeywords(texts, 5, stopWords)
+	assert.NotContains(t, keywords, "群主")
+	assert.NotContains(t, keywords, "standup")
+	// the single character splits the texts, so that 直播 is never generated
+	assert.NotContains(t, keywords, "直播")
+	assert.Equal(t, []string{"kubernetes", "upgrade"}, keywords)
+}
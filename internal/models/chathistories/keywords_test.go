@@ -0,0 +1,55 @@
+package chathistories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenizeForKeywords(t *testing.T) {
+	assert.Equal(t, []string{"go", "release", "out"}, tokenizeForKeywords("The Go release is out, lol 2024", nil))
+	assert.ElementsMatch(t, []string{"版本", "发布", "布会", "发布会"}, tokenizeForKeywords("版本的发布会", nil))
+	// mixed scripts are split into separate runs
+	assert.ElementsMatch(t, []string{"发布", "insights", "bot"}, tokenizeForKeywords("发布insights bot", nil))
+	// single CJK characters left after splitting are dropped
+	assert.Empty(t, tokenizeForKeywords("好的，我们走了", nil))
+}
+
+func TestExtractKeywords(t *testing.T) {
+	t.Run("CJK", func(t *testing.T) {
+		texts := []string{
+			"新版本的发布会什么时候开始？",
+			"发布会应该是明天吧",
+			"我也想看发布会",
+			"今天天气不错",
+			"新版本有什么功能",
+		}
+
+		assert.Equal(t, []string{"发布会", "新版本"}, ExtractKeywords(texts, 3, nil))
+		assert.Equal(t, []string{"发布会"}, ExtractKeywords(texts, 1, nil))
+	})
+
+	t.Run("SpaceDelimited", func(t *testing.T) {
+		texts := []string{
+			"Kubernetes upgrade is done",
+			"the kubernetes upgrade broke the dashboard",
+			"is the dashboard back?",
+			"Kubernetes is hard",
+		}
+
+		assert.Equal(t, []string{"kubernetes", "dashboard", "upgrade"}, ExtractKeywords(texts, 5, nil))
+	})
+
+	t.Run("RepeatedInOneMessage", func(t *testing.T) {
+		assert.Empty(t, ExtractKeywords([]string{"spam spam spam spam"}, 3, nil))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert.Empty(t, ExtractKeywords([]string{"golang", "golang"}, 0, nil))
+	})
+}
+
+func TestFormatTopKeywords(t *testing.T) {
+	assert.Equal(t, "热门关键词：发布会、a&lt;b&gt;", FormatTopKeywords([]string{"发布会", "a<b>"}, RecapTexts{}))
+	assert.Empty(t, FormatTopKeywords(nil, RecapTexts{}))
+}
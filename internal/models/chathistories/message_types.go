@@ -0,0 +1,142 @@
+package chathistories
+
+import (
+	"fmt"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func isServiceMessage(message *tgbotapi.Message) bool {
+	return len(message.NewChatMembers) > 0 ||
+		message.LeftChatMember != nil ||
+		message.PinnedMessage != nil ||
+		message.NewChatTitle != "" ||
+		len(message.NewChatPhoto) > 0 ||
+		message.DeleteChatPhoto
+}
+
+func isMediaOnlyMessage(message *tgbotapi.Message) bool {
+	return message.Text == "" && message.Caption == "" && (len(message.Photo) > 0 ||
+		message.Video != nil ||
+		message.Animation != nil ||
+		message.Sticker != nil ||
+		message.Voice != nil ||
+		message.VideoNote != nil ||
+		message.Audio != nil ||
+		message.Document != nil)
+}
+
+func fullNamesOfUsers(users []tgbotapi.User) string {
+	return strings.Join(lo.Map(users, func(user tgbotapi.User, _ int) string {
+		return tgbot.FullNameFromFirstAndLastName(user.FirstName, user.LastName)
+	}), ", ")
+}
+
+// describeServiceMessage describes the service message as text in the same
+// bracketed form as the forwarded prefix, empty string will be returned for
+// the service messages that are not worth recording.
+func describeServiceMessage(message *tgbotapi.Message) string {
+	switch {
+	case len(message.NewChatMembers) > 0:
+		if message.From != nil && len(message.NewChatMembers) == 1 && message.NewChatMembers[0].ID == message.From.ID {
+			return "[joined the group]"
+		}
+
+		return fmt.Sprintf("[added %s to the group]", fullNamesOfUsers(message.NewChatMembers))
+	case message.LeftChatMember != nil:
+		if message.From != nil && message.LeftChatMember.ID == message.From.ID {
+			return "[left the group]"
+		}
+
+		return fmt.Sprintf("[removed %s from the group]", fullNamesOfUsers([]tgbotapi.User{*message.LeftChatMember}))
+	case message.PinnedMessage != nil:
+		pinned := lo.Ternary(message.PinnedMessage.Caption != "", message.PinnedMessage.Caption, message.PinnedMessage.Text)
+		if pinned == "" {
+			return "[pinned a message]"
+		}
+
+		return fmt.Sprintf("[pinned a message]: %s", pinned)
+	case message.NewChatTitle != "":
+		return fmt.Sprintf("[changed the group title to %s]", message.NewChatTitle)
+	case len(message.NewChatPhoto) > 0:
+		return "[changed the group photo]"
+	case message.DeleteChatPhoto:
+		return "[deleted the group photo]"
+	default:
+		return ""
+	}
+}
+
+// describeMediaOnlyMessage describes the media without caption as text.
+func describeMediaOnlyMessage(message *tgbotapi.Message) string {
+	switch {
+	case len(message.Photo) > 0:
+		return "[sent a photo]"
+	case message.Video != nil:
+		return "[sent a video]"
+	case message.Animation != nil:
+		return "[sent a GIF]"
+	case message.Sticker != nil:
+		return strings.TrimSpace(fmt.Sprintf("[sent a sticker] %s", message.Sticker.Emoji))
+	case message.Voice != nil:
+		return "[sent a voice message]"
+	case message.VideoNote != nil:
+		return "[sent a video message]"
+	case message.Audio != nil:
+		return lo.Ternary(message.Audio.Title != "", fmt.Sprintf("[sent an audio]: %s", message.Audio.Title), "[sent an audio]")
+	case message.Document != nil:
+		return lo.Ternary(message.Document.FileName != "", fmt.Sprintf("[sent a file]: %s", message.Document.FileName), "[sent a file]")
+	default:
+		return ""
+	}
+}
+
+// messageTypesOfMessage detects the types of the message to be recorded
+// along with the chat history.
+func messageTypesOfMessage(message *tgbotapi.Message) tgchat.MessageTypes {
+	var types tgchat.MessageTypes
+
+	if isServiceMessage(message) {
+		types |= tgchat.MessageTypeService
+	}
+
+	if message.ForwardDate != 0 || message.ForwardFrom != nil || message.ForwardFromChat != nil {
+		types |= tgchat.MessageTypeForwarded
+	}
+
+	if isMediaOnlyMessage(message) {
+		types |= tgchat.MessageTypeMediaOnly
+	}
+
+	return types
+}
+
+// MessageTypesOfChatHistory returns the recorded types of the chat history,
+// the chat histories recorded before the types were tracked are detected as
+// forwarded by the text prefix.
+func MessageTypesOfChatHistory(history *ent.ChatHistories) tgchat.MessageTypes {
+	types := tgchat.MessageTypes(history.MessageTypes)
+	if regexpForwardedChatHistoryPrefix.MatchString(history.Text) {
+		types |= tgchat.MessageTypeForwarded
+	}
+
+	return types
+}
+
+// FilterExcludedChatHistories filters out the chat histories of any of the
+// excluded message types.
+func FilterExcludedChatHistories(histories []*ent.ChatHistories, excluded tgchat.MessageTypes) []*ent.ChatHistories {
+	if excluded == 0 {
+		return histories
+	}
+
+	return lo.Filter(histories, func(item *ent.ChatHistories, _ int) bool {
+		return MessageTypesOfChatHistory(item)&excluded == 0
+	})
+}
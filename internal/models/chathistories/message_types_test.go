@@ -0,0 +1,50 @@
+package chathistories
+
+import (
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+func TestMessageTypesOfMessage(t *testing.T) {
+	alice := &tgbotapi.User{ID: 1, FirstName: "Alice"}
+
+	assert.Equal(t, tgchat.MessageTypes(0), messageTypesOfMessage(&tgbotapi.Message{From: alice, Text: "hello"}))
+	assert.Equal(t, tgchat.MessageTypeService, messageTypesOfMessage(&tgbotapi.Message{From: alice, NewChatMembers: []tgbotapi.User{*alice}}))
+	assert.Equal(t, tgchat.MessageTypeForwarded, messageTypesOfMessage(&tgbotapi.Message{From: alice, Text: "hello", ForwardDate: 1700000000}))
+	assert.Equal(t, tgchat.MessageTypeMediaOnly, messageTypesOfMessage(&tgbotapi.Message{From: alice, Photo: []tgbotapi.PhotoSize{{FileID: "photo"}}}))
+	assert.Equal(t, tgchat.MessageTypes(0), messageTypesOfMessage(&tgbotapi.Message{From: alice, Caption: "看看这个", Photo: []tgbotapi.PhotoSize{{FileID: "photo"}}}))
+	assert.Equal(t, tgchat.MessageTypeForwarded|tgchat.MessageTypeMediaOnly, messageTypesOfMessage(&tgbotapi.Message{From: alice, ForwardDate: 1700000000, Sticker: &tgbotapi.Sticker{Emoji: "😂"}}))
+}
+
+func TestDescribeServiceAndMediaOnlyMessage(t *testing.T) {
+	alice := &tgbotapi.User{ID: 1, FirstName: "Alice"}
+	bob := tgbotapi.User{ID: 2, FirstName: "Bob"}
+
+	assert.Equal(t, "[joined the group]", describeServiceMessage(&tgbotapi.Message{From: alice, NewChatMembers: []tgbotapi.User{*alice}}))
+	assert.Equal(t, "[added Bob to the group]", describeServiceMessage(&tgbotapi.Message{From: alice, NewChatMembers: []tgbotapi.User{bob}}))
+	assert.Equal(t, "[removed Bob from the group]", describeServiceMessage(&tgbotapi.Message{From: alice, LeftChatMember: &bob}))
+	assert.Equal(t, "[pinned a message]: 周六八点集合", describeServiceMessage(&tgbotapi.Message{From: alice, PinnedMessage: &tgbotapi.Message{Text: "周六八点集合"}}))
+	assert.Equal(t, "[sent a photo]", describeMediaOnlyMessage(&tgbotapi.Message{Photo: []tgbotapi.PhotoSize{{FileID: "photo"}}}))
+	assert.Equal(t, "[sent a sticker] 😂", describeMediaOnlyMessage(&tgbotapi.Message{Sticker: &tgbotapi.Sticker{Emoji: "😂"}}))
+	assert.Equal(t, "[sent a file]: notes.pdf", describeMediaOnlyMessage(&tgbotapi.Message{Document: &tgbotapi.Document{FileName: "notes.pdf"}}))
+}
+
+func TestFilterExcludedChatHistories(t *testing.T) {
+	text := &ent.ChatHistories{MessageID: 1, Text: "明天去爬山吗"}
+	service := &ent.ChatHistories{MessageID: 2, Text: "[joined the group]", MessageTypes: int(tgchat.MessageTypeService)}
+	forwarded := &ent.ChatHistories{MessageID: 3, Text: "[forwarded from Carol]: 山上天气很好", MessageTypes: int(tgchat.MessageTypeForwarded)}
+	legacyForwarded := &ent.ChatHistories{MessageID: 4, Text: "[forwarded from Carol]: 记得带水"}
+	mediaOnly := &ent.ChatHistories{MessageID: 5, Text: "[sent a photo]", MessageTypes: int(tgchat.MessageTypeMediaOnly)}
+	histories := []*ent.ChatHistories{text, service, forwarded, legacyForwarded, mediaOnly}
+
+	assert.Equal(t, histories, FilterExcludedChatHistories(histories, 0))
+	assert.Equal(t, []*ent.ChatHistories{text, forwarded, legacyForwarded, mediaOnly}, FilterExcludedChatHistories(histories, tgchat.MessageTypeService))
+	assert.Equal(t, []*ent.ChatHistories{text, service, mediaOnly}, FilterExcludedChatHistories(histories, tgchat.MessageTypeForwarded))
+	assert.Equal(t, []*ent.ChatHistories{text, service, forwarded, legacyForwarded}, FilterExcludedChatHistories(histories, tgchat.MessageTypeMediaOnly))
+	assert.Equal(t, []*ent.ChatHistories{text}, FilterExcludedChatHistories(histories, tgchat.MessageTypeService|tgchat.MessageTypeForwarded|tgchat.MessageTypeMediaOnly))
+}
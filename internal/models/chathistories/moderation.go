@@ -0,0 +1,213 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+)
+
+var (
+	// ErrChatHistoriesRecapFlagged is returned when the recap is flagged by
+	// the moderation and the moderation mode is drop.
+	ErrChatHistoriesRecapFlagged = errors.New("chat histories recap is flagged by moderation")
+	// ErrChatHistoriesRecapHeldForModeration is returned when the recap is
+	// flagged by the moderation and the moderation mode is hold, the recap
+	// has to be published by the administrators.
+	ErrChatHistoriesRecapHeldForModeration = errors.New("chat histories recap is held for moderation")
+)
+
+const redactedChatHistoriesRecapTopic = "## 已隐藏的话题\n该话题的内容未通过内容审核，已被隐藏。"
+
+// ChatHistoriesRecapModeration is the result of the moderation of the recap.
+type ChatHistoriesRecapModeration struct {
+	// DenylistHits are the words of the denylist found in the recap.
+	DenylistHits []string `json:"denylist_hits"`
+	// FlaggedTopics are the indexes of the summarizations flagged by the
+	// moderation endpoint of OpenAI.
+	FlaggedTopics []int `json:"flagged_topics"`
+	// Categories are the categories flagged by the moderation endpoint.
+	Categories []string `json:"categories"`
+}
+
+// Flagged reports whether anything in the recap is flagged.
+func (m *ChatHistoriesRecapModeration) Flagged() bool {
+	return m != nil && (len(m.DenylistHits) > 0 || len(m.FlaggedTopics) > 0)
+}
+
+// Reason describes why the recap is flagged.
+func (m *ChatHistoriesRecapModeration) Reason() string {
+	if !m.Flagged() {
+		return ""
+	}
+
+	reasons := make([]string, 0, 2)
+
+	if len(m.DenylistHits) > 0 {
+		reasons = append(reasons, fmt.Sprintf("包含屏蔽词（%s）", strings.Join(m.DenylistHits, "、")))
+	}
+
+	if len(m.FlaggedTopics) > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d 个话题被内容审核标记（%s）", len(m.FlaggedTopics), strings.Join(m.Categories, "、")))
+	}
+
+	return strings.Join(reasons, "，")
+}
+
+// FormatWarning formats the reason into HTML that should be placed at the top
+// of the recaps to be reviewed, an empty string will be returned if nothing is
+// flagged.
+func (m *ChatHistoriesRecapModeration) FormatWarning() string {
+	if !m.Flagged() {
+		return ""
+	}
+
+	return fmt.Sprintf("⚠️ <b>内容审核提示</b>：%s\n\n", html.EscapeString(m.Reason()))
+}
+
+// findDenylistHits finds the words of the denylist in the summarizations,
+// case insensitive.
+func findDenylistHits(summarizations []string, denylist []string) []string {
+	text := strings.ToLower(strings.Join(summarizations, "\n"))
+
+	return lo.Filter(denylist, func(word string, _ int) bool {
+		return strings.Contains(text, strings.ToLower(word))
+	})
+}
+
+// flaggedModerationCategories returns the names of the flagged categories,
+// such as "hate" and "violence/graphic".
+func flaggedModerationCategories(categories any) []string {
+	raw, err := json.Marshal(categories)
+	if err != nil {
+		return nil
+	}
+
+	var flags map[string]bool
+
+	err = json.Unmarshal(raw, &flags)
+	if err != nil {
+		return nil
+	}
+
+	names := lo.Keys(lo.PickBy(flags, func(_ string, flagged bool) bool { return flagged }))
+	sort.Strings(names)
+
+	return names
+}
+
+// moderateChatHistoriesRecap checks the summarizations with the denylist, and
+// the moderation endpoint of OpenAI if enabled. The topics that failed to be
+// checked by OpenAI are treated as not flagged.
+func (m *Model) moderateChatHistoriesRecap(chatID int64, summarizations []string) *ChatHistoriesRecapModeration {
+	moderation := &ChatHistoriesRecapModeration{
+		DenylistHits:  findDenylistHits(summarizations, m.config.Recap.ModerationDenylist),
+		FlaggedTopics: make([]int, 0),
+		Categories:    make([]string, 0),
+	}
+
+	if !m.config.Recap.ModerationOpenAI {
+		return moderation
+	}
+
+	for i, summarization := range summarizations {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		resp, err := m.openAI.ModerateContent(ctx, summarization)
+
+		cancel()
+
+		if err != nil {
+			m.logger.Warn("failed to moderate chat histories recap",
+				zap.Int64("chat_id", chatID),
+				zap.Int("topic", i),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		for _, result := range resp.Results {
+			if !result.Flagged {
+				continue
+			}
+
+			moderation.FlaggedTopics = lo.Uniq(append(moderation.FlaggedTopics, i))
+			moderation.Categories = lo.Uniq(append(moderation.Categories, flaggedModerationCategories(result.Categories)...))
+		}
+	}
+
+	return moderation
+}
+
+// redactChatHistoriesRecap hides the topics flagged by OpenAI, and replaces
+// the words of the denylist with asterisks in the rest.
+func redactChatHistoriesRecap(summarizations []string, moderation *ChatHistoriesRecapModeration) []string {
+	if !moderation.Flagged() {
+		return summarizations
+	}
+
+	var denylistRegexp *regexp.Regexp
+	if len(moderation.DenylistHits) > 0 {
+		denylistRegexp = regexp.MustCompile("(?i)" + strings.Join(lo.Map(moderation.DenylistHits, func(word string, _ int) string {
+			return regexp.QuoteMeta(word)
+		}), "|"))
+	}
+
+	return lo.Map(summarizations, func(summarization string, i int) string {
+		if lo.Contains(moderation.FlaggedTopics, i) {
+			return redactedChatHistoriesRecapTopic
+		}
+
+		if denylistRegexp == nil {
+			return summarization
+		}
+
+		return denylistRegexp.ReplaceAllStringFunc(summarization, func(word string) string {
+			return strings.Repeat("*", len([]rune(word)))
+		})
+	})
+}
+
+// applyChatHistoriesRecapModeration moderates the recap according to the
+// moderation mode configured.
+func (m *Model) applyChatHistoriesRecapModeration(recap *ChatHistoriesRecap) error {
+	if m.config.Recap.ModerationMode == configs.RecapModerationModeOff {
+		return nil
+	}
+
+	moderation := m.moderateChatHistoriesRecap(recap.ChatID, recap.Summarizations)
+	if !moderation.Flagged() {
+		return nil
+	}
+
+	m.logger.Warn("chat histories recap is flagged by moderation",
+		zap.Int64("chat_id", recap.ChatID),
+		zap.String("mode", string(m.config.Recap.ModerationMode)),
+		zap.Strings("denylist_hits", moderation.DenylistHits),
+		zap.Ints("flagged_topics", moderation.FlaggedTopics),
+		zap.Strings("categories", moderation.Categories),
+	)
+
+	recap.Moderation = moderation
+
+	switch m.config.Recap.ModerationMode {
+	case configs.RecapModerationModeRedact:
+		recap.Summarizations = redactChatHistoriesRecap(recap.Summarizations, moderation)
+	case configs.RecapModerationModeDrop:
+		return ErrChatHistoriesRecapFlagged
+	case configs.RecapModerationModeHold:
+		recap.HeldForModeration = true
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package chathistories
+
+import (
+	"context"
+	"testing"
+
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+)
+
+func newModerationTestModel(t *testing.T, mode configs.RecapModerationMode, denylist []string, openAIClient *openaimock.MockClient) *Model {
+	t.Helper()
+
+	config := configs.NewTestConfig()()
+	config.Recap.ModerationMode = mode
+	config.Recap.ModerationDenylist = denylist
+	config.Recap.ModerationOpenAI = openAIClient != nil
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(t, err)
+
+	return &Model{
+		config: config,
+		logger: logger,
+		openAI: openAIClient,
+	}
+}
+
+func TestFindDenylistHits(t *testing.T) {
+	summarizations := []string{"## 周末爬山\n约好早上八点集合", "## 推广\n有人发了 SPAM 链接"}
+
+	assert.Equal(t, []string{"spam"}, findDenylistHits(summarizations, []string{"spam", "广告"}))
+	assert.Empty(t, findDenylistHits(summarizations, []string{"广告"}))
+	assert.Empty(t, findDenylistHits(summarizations, nil))
+}
+
+func TestRedactChatHistoriesRecap(t *testing.T) {
+	summarizations := []string{"## 推广\n有人发了 Spam 链接", "## 争吵\n话题内容"}
+
+	redacted := redactChatHistoriesRecap(summarizations, &ChatHistoriesRecapModeration{
+		DenylistHits:  []string{"spam"},
+		FlaggedTopics: []int{1},
+	})
+	assert.Equal(t, []string{"## 推广\n有人发了 **** 链接", redactedChatHistoriesRecapTopic}, redacted)
+
+	assert.Equal(t, summarizations, redactChatHistoriesRecap(summarizations, &ChatHistoriesRecapModeration{}))
+}
+
+func TestApplyChatHistoriesRecapModeration(t *testing.T) {
+	flagged := []string{"## 推广\n有人发了 spam 链接"}
+	clean := []string{"## 周末爬山\n约好早上八点集合"}
+
+	t.Run("Off", func(t *testing.T) {
+		m := newModerationTestModel(t, configs.RecapModerationModeOff, []string{"spam"}, nil)
+
+		recap := &ChatHistoriesRecap{Summarizations: flagged}
+		require.NoError(t, m.applyChatHistoriesRecapModeration(recap))
+		assert.Nil(t, recap.Moderation)
+		assert.Equal(t, flagged, recap.Summarizations)
+	})
+
+	t.Run("Clean", func(t *testing.T) {
+		m := newModerationTestModel(t, configs.RecapModerationModeDrop, []string{"spam"}, nil)
+
+		recap := &ChatHistoriesRecap{Summarizations: clean}
+		require.NoError(t, m.applyChatHistoriesRecapModeration(recap))
+		assert.Nil(t, recap.Moderation)
+		assert.False(t, recap.HeldForModeration)
+	})
+
+	t.Run("Redact", func(t *testing.T) {
+		m := newModerationTestModel(t, configs.RecapModerationModeRedact, []string{"spam"}, nil)
+
+		recap := &ChatHistoriesRecap{Summarizations: flagged}
+		require.NoError(t, m.applyChatHistoriesRecapModeration(recap))
+		assert.Equal(t, []string{"## 推广\n有人发了 **** 链接"}, recap.Summarizations)
+		assert.Contains(t, recap.Moderation.FormatWarning(), "spam")
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		m := newModerationTestModel(t, configs.RecapModerationModeDrop, []string{"spam"}, nil)
+
+		err := m.applyChatHistoriesRecapModeration(&ChatHistoriesRecap{Summarizations: flagged})
+		require.ErrorIs(t, err, ErrChatHistoriesRecapFlagged)
+	})
+
+	t.Run("Hold", func(t *testing.T) {
+		m := newModerationTestModel(t, configs.RecapModerationModeHold, []string{"spam"}, nil)
+
+		recap := &ChatHistoriesRecap{Summarizations: flagged}
+		require.NoError(t, m.applyChatHistoriesRecapModeration(recap))
+		assert.True(t, recap.HeldForModeration)
+		assert.Equal(t, flagged, recap.Summarizations)
+	})
+
+	t.Run("OpenAI", func(t *testing.T) {
+		openAIClient := &openaimock.MockClient{}
+		openAIClient.ModerateContentStub = func(_ context.Context, content string) (*goopenai.ModerationResponse, error) {
+			result := goopenai.Result{}
+			if content == clean[0] {
+				return &goopenai.ModerationResponse{Results: []goopenai.Result{result}}, nil
+			}
+
+			result.Flagged = true
+			result.Categories.Hate = true
+
+			return &goopenai.ModerationResponse{Results: []goopenai.Result{result}}, nil
+		}
+
+		m := newModerationTestModel(t, configs.RecapModerationModeRedact, nil, openAIClient)
+
+		recap := &ChatHistoriesRecap{Summarizations: []string{clean[0], "## 争吵\n话题内容"}}
+		require.NoError(t, m.applyChatHistoriesRecapModeration(recap))
+		assert.Equal(t, []string{clean[0], redactedChatHistoriesRecapTopic}, recap.Summarizations)
+		assert.Equal(t, []int{1}, recap.Moderation.FlaggedTopics)
+		assert.Equal(t, []string{"hate"}, recap.Moderation.Categories)
+	})
+}
@@ -0,0 +1,68 @@
+package chathistories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/chathistories"
+)
+
+func assignPollForChatHistory(entity *ent.ChatHistories, poll *tgbotapi.Poll) {
+	entity.PollID = poll.ID
+	entity.PollQuestion = poll.Question
+	entity.PollOptions = poll.Options
+	entity.PollTotalVoterCount = poll.TotalVoterCount
+	entity.PollIsClosed = poll.IsClosed
+}
+
+// formatChatHistoryPoll renders the poll of the chat history together with
+// its latest known results, so that the model can mention the outcome.
+func formatChatHistoryPoll(history *ent.ChatHistories) string {
+	results := lo.Map(history.PollOptions, func(option tgbotapi.PollOption, _ int) string {
+		return fmt.Sprintf("%s %d 票", option.Text, option.VoterCount)
+	})
+
+	text := fmt.Sprintf("投票: %s — 结果: %s", history.PollQuestion, lo.Ternary(len(results) > 0, strings.Join(results, "，"), "暂无"))
+	if history.PollIsClosed {
+		return fmt.Sprintf("%s（已结束，共 %d 人投票）", text, history.PollTotalVoterCount)
+	}
+
+	return fmt.Sprintf("%s（共 %d 人投票）", text, history.PollTotalVoterCount)
+}
+
+// UpdateTelegramChatHistoryPoll updates the results of the recorded poll.
+// Telegram only notifies about the polls sent by the bot and the polls that
+// were stopped manually, the results of the rest stay as they were recorded.
+// The chat histories kept in Redis are found by the chat id kept for the poll,
+// since the poll updates carry no chat.
+func (m *Model) UpdateTelegramChatHistoryPoll(poll *tgbotapi.Poll) error {
+	if poll == nil || poll.ID == "" {
+		return nil
+	}
+
+	affected, err := m.ent.ChatHistories.
+		Update().
+		Where(chathistories.PollID(poll.ID)).
+		SetPollOptions(poll.Options).
+		SetPollTotalVoterCount(poll.TotalVoterCount).
+		SetPollIsClosed(poll.IsClosed).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Debug("updated poll results of chat histories",
+		zap.String("poll_id", poll.ID),
+		zap.Int("total_voter_count", poll.TotalVoterCount),
+		zap.Bool("is_closed", poll.IsClosed),
+		zap.Int("affected", affected),
+	)
+
+	return m.updateEphemeralChatHistoryPoll(poll)
+}
@@ -0,0 +1,71 @@
+package chathistories
+
+import (
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+func TestFormatChatHistoryPoll(t *testing.T) {
+	history := &ent.ChatHistories{
+		PollQuestion: "周末去哪里玩",
+		PollOptions: []tgbotapi.PollOption{
+			{Text: "爬山", VoterCount: 3},
+			{Text: "露营", VoterCount: 1},
+		},
+		PollTotalVoterCount: 4,
+	}
+
+	assert.Equal(t, "投票: 周末去哪里玩 — 结果: 爬山 3 票，露营 1 票（共 4 人投票）", formatChatHistoryPoll(history))
+
+	history.PollIsClosed = true
+	assert.Equal(t, "投票: 周末去哪里玩 — 结果: 爬山 3 票，露营 1 票（已结束，共 4 人投票）", formatChatHistoryPoll(history))
+
+	assert.Equal(t, "投票: 周末去哪里玩 — 结果: 暂无（共 0 人投票）", formatChatHistoryPoll(&ent.ChatHistories{PollQuestion: "周末去哪里玩"}))
+}
+
+func TestLLMFriendlyChatHistoriesWithPolls(t *testing.T) {
+	histories := []*ent.ChatHistories{
+		{MessageID: 1, FullName: "Alice", Text: "大家投个票"},
+		{
+			MessageID:           2,
+			FullName:            "Alice",
+			Text:                "周末去哪里玩",
+			PollQuestion:        "周末去哪里玩",
+			PollOptions:         []tgbotapi.PollOption{{Text: "爬山", VoterCount: 3}, {Text: "露营", VoterCount: 1}},
+			PollTotalVoterCount: 4,
+			PollIsClosed:        true,
+		},
+		{
+			MessageID:    3,
+			FullName:     "Bob",
+			Text:         "[forwarded from Carol]: 吃什么",
+			PollQuestion: "吃什么",
+			PollOptions:  []tgbotapi.PollOption{{Text: "火锅"}},
+		},
+	}
+
+	input, messageIDs := llmFriendlyChatHistories(histories)
+	require.Equal(t, []int64{1, 2, 3}, messageIDs)
+
+	assert.Contains(t, input, "msgId:2: Alice sent: 投票: 周末去哪里玩 — 结果: 爬山 3 票，露营 1 票（已结束，共 4 人投票）")
+	assert.Contains(t, input, "msgId:3: Bob sent: [forwarded from Carol]: 投票: 吃什么 — 结果: 火锅 0 票（共 0 人投票）")
+}
+
+func TestAnonymizeChatHistoriesWithPolls(t *testing.T) {
+	histories := []*ent.ChatHistories{
+		{MessageID: 1, UserID: 1, FullName: "Alice", Text: "谁来"},
+		{MessageID: 2, UserID: 2, FullName: "Bob", Text: "Alice 请客吗", PollQuestion: "Alice 请客吗", PollOptions: []tgbotapi.PollOption{{Text: "Alice 请", VoterCount: 1}}},
+	}
+
+	anonymized, _ := AnonymizeChatHistories(histories)
+	require.Len(t, anonymized, 2)
+
+	assert.Equal(t, "用户A 请客吗", anonymized[1].PollQuestion)
+	assert.Equal(t, "用户A 请", anonymized[1].PollOptions[0].Text)
+	assert.Equal(t, "Alice 请", histories[1].PollOptions[0].Text)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
 	"github.com/nekomeowww/insights-bot/pkg/types/redis"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 	"github.com/redis/rueidis"
 	"github.com/samber/lo"
 	"github.com/samber/lo/mutable"
@@ -230,7 +231,7 @@ func (m *Model) SummarizePrivateForwardedChatHistories(userID int64, histories [
 
 	chatHistories := strings.Join(historiesLLMFriendly, "\n")
 
-	summarizations, statusUsage, err := m.summarizeChatHistories(userID, historiesIncludedMessageIDs, chatHistories)
+	summarizations, statusUsage, truncated, err := m.summarizeChatHistories(userID, historiesIncludedMessageIDs, chatHistories, nil, nil)
 	if err != nil {
 		return make([]string, 0), err
 	}
@@ -245,11 +246,17 @@ func (m *Model) SummarizePrivateForwardedChatHistories(userID int64, histories [
 		return item
 	})
 
-	ss, err := m.renderRecapTemplates(0, telegram.ChatTypePrivate, summarizations)
+	texts := m.recapTexts(nil)
+
+	ss, err := RenderRecapTemplates(0, telegram.ChatTypePrivate, summarizations, tgchat.RecapOutputFormatProse, texts)
 	if err != nil {
 		return make([]string, 0), err
 	}
 
+	if truncated {
+		ss = append(ss, texts.PartialRecapNote())
+	}
+
 	err = m.ent.LogChatHistoriesRecap.
 		Create().
 		SetChatID(userID).
@@ -17,12 +17,15 @@ import (
 
 	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/options"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 )
 
 type RecapOutputTemplateInputs struct {
 	ChatID string
 	Recap  *openai.ChatHistorySummarizationOutputs
+	Texts  RecapTexts
 }
 
 func formatChatID(chatID int64) string {
@@ -43,10 +46,10 @@ var RecapOutputTemplate = lo.Must(template.
 		"escape": tgbot.EscapeHTMLSymbols,
 	}).
 	Parse(`{{ $chatID := .ChatID }}{{ if .Recap.SinceID }}## <a href="https://t.me/c/{{ $chatID }}/{{ .Recap.SinceID }}">{{ escape .Recap.TopicName }}</a>{{ else }}## {{ escape .Recap.TopicName }}{{ end }}
-参与人：{{ join .Recap.Participants "，" }}
-讨论：{{ range $di, $d := .Recap.Discussion }}
+{{ .Texts.Participants }}{{ join .Recap.Participants .Texts.ListSeparator }}
+{{ .Texts.Discussion }}{{ range $di, $d := .Recap.Discussion }}
  - {{ escape $d.Point }}{{ if len $d.KeyIDs }} {{ range $cIndex, $c := $d.KeyIDs }}<a href="https://t.me/c/{{ $chatID }}/{{ $c }}">[{{ add $cIndex 1 }}]</a>{{ if not (eq $cIndex (sub (len $d.KeyIDs) 1)) }} {{ end }}{{ end }}{{ end }}{{ end }}{{ if .Recap.Conclusion }}
-结论：{{ escape .Recap.Conclusion }}{{ end }}`))
+{{ .Texts.Conclusion }}{{ escape .Recap.Conclusion }}{{ end }}`))
 
 var RecapWithoutLinksOutputTemplate = lo.Must(template.
 	New("recap output markdown template").
@@ -57,14 +60,97 @@ var RecapWithoutLinksOutputTemplate = lo.Must(template.
 		"escape": tgbot.EscapeHTMLSymbols,
 	}).
 	Parse(`{{ $chatID := .ChatID }}{{ if .Recap.SinceID }}## {{ escape .Recap.TopicName }}{{ else }}## {{ escape .Recap.TopicName }}{{ end }}
-参与人：{{ join .Recap.Participants "，" }}
-讨论：{{ range $di, $d := .Recap.Discussion }}
+{{ .Texts.Participants }}{{ join .Recap.Participants .Texts.ListSeparator }}
+{{ .Texts.Discussion }}{{ range $di, $d := .Recap.Discussion }}
  - {{ escape $d.Point }}{{ end }}{{ if .Recap.Conclusion }}
-结论：{{ escape .Recap.Conclusion }}{{ end }}`))
+{{ .Texts.Conclusion }}{{ escape .Recap.Conclusion }}{{ end }}`))
 
-func (m *Model) summarizeChatHistoriesSlice(chatID int64, s string) ([]*openai.ChatHistorySummarizationOutputs, goopenai.Usage, error) {
+// RecapBulletsOutputTemplate renders the recap as terse bullet points, the
+// conclusion becomes the last bullet.
+var RecapBulletsOutputTemplate = lo.Must(template.
+	New("recap bullets output markdown template").
+	Funcs(template.FuncMap{
+		"join":   strings.Join,
+		"sub":    func(a, b int) int { return a - b },
+		"add":    func(a, b int) int { return a + b },
+		"escape": tgbot.EscapeHTMLSymbols,
+	}).
+	Parse(`{{ $chatID := .ChatID }}{{ if .Recap.SinceID }}## <a href="https://t.me/c/{{ $chatID }}/{{ .Recap.SinceID }}">{{ escape .Recap.TopicName }}</a>{{ else }}## {{ escape .Recap.TopicName }}{{ end }}{{ range $di, $d := .Recap.Discussion }}
+- {{ escape $d.Point }}{{ if len $d.KeyIDs }} {{ range $cIndex, $c := $d.KeyIDs }}<a href="https://t.me/c/{{ $chatID }}/{{ $c }}">[{{ add $cIndex 1 }}]</a>{{ if not (eq $cIndex (sub (len $d.KeyIDs) 1)) }} {{ end }}{{ end }}{{ end }}{{ end }}{{ if .Recap.Conclusion }}
+- {{ .Texts.Conclusion }}{{ escape .Recap.Conclusion }}{{ end }}`))
+
+var RecapBulletsWithoutLinksOutputTemplate = lo.Must(template.
+	New("recap bullets output markdown template").
+	Funcs(template.FuncMap{
+		"escape": tgbot.EscapeHTMLSymbols,
+	}).
+	Parse(`## {{ escape .Recap.TopicName }}{{ range $di, $d := .Recap.Discussion }}
+- {{ escape $d.Point }}{{ end }}{{ if .Recap.Conclusion }}
+- {{ .Texts.Conclusion }}{{ escape .Recap.Conclusion }}{{ end }}`))
+
+var errInvalidSummarizationOutputs = errors.New("invalid chat histories summarization outputs")
+
+// summarizationOnlyValidJSONReminder will be appended to the prompt when the
+// previous outputs were not valid JSON.
+const summarizationOnlyValidJSONReminder = "Return ONLY valid JSON that follows the JSON Schema above, without any code fences, explanations or other text before or after it."
+
+// extractJSONArrayFromSummarization extracts the JSON array from the outputs
+// of the model, code fences and the prose before the first bracket or after
+// the last bracket will be stripped.
+func extractJSONArrayFromSummarization(content string) string {
+	content = strings.TrimSpace(content)
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.TrimPrefix(content, "```")
+	content = strings.TrimSuffix(content, "```")
+
+	start := strings.Index(content, "[")
+	end := strings.LastIndex(content, "]")
+
+	if start == -1 || end == -1 || end < start {
+		return strings.TrimSpace(content)
+	}
+
+	return content[start : end+1]
+}
+
+// salvageTruncatedSummarizationOutputs decodes the complete topics from the
+// outputs that were cut off in the middle of the JSON array, the trailing
+// incomplete topic is dropped.
+func salvageTruncatedSummarizationOutputs(content string) []*openai.ChatHistorySummarizationOutputs {
+	outputs := make([]*openai.ChatHistorySummarizationOutputs, 0)
+
+	start := strings.Index(content, "[")
+	if start == -1 {
+		return outputs
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(content[start:]))
+
+	_, err := decoder.Token()
+	if err != nil {
+		return outputs
+	}
+
+	for decoder.More() {
+		var output *openai.ChatHistorySummarizationOutputs
+
+		err = decoder.Decode(&output)
+		if err != nil {
+			break
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
+
+// summarizeChatHistoriesSlice summarizes one slice of the chat histories,
+// truncated reports whether the outputs were cut off by the max tokens and
+// only the complete topics were salvaged.
+func (m *Model) summarizeChatHistoriesSlice(chatID int64, s string, callOpts []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) ([]*openai.ChatHistorySummarizationOutputs, goopenai.Usage, bool, error) {
 	if s == "" {
-		return make([]*openai.ChatHistorySummarizationOutputs, 0), goopenai.Usage{}, nil
+		return make([]*openai.ChatHistorySummarizationOutputs, 0), goopenai.Usage{}, false, nil
 	}
 
 	m.logger.Info(fmt.Sprintf("✍️ summarizing chat histories:\n%s", s),
@@ -72,13 +158,13 @@ func (m *Model) summarizeChatHistoriesSlice(chatID int64, s string) ([]*openai.C
 		zap.String("model_name", m.openAI.GetModelName()),
 	)
 
-	resp, err := m.openAI.SummarizeChatHistories(context.Background(), s)
+	resp, err := m.openAI.SummarizeChatHistories(context.Background(), s, callOpts...)
 	if err != nil {
-		return nil, goopenai.Usage{}, err
+		return nil, goopenai.Usage{}, false, err
 	}
 
 	if len(resp.Choices) == 0 {
-		return nil, goopenai.Usage{}, nil
+		return nil, goopenai.Usage{}, false, nil
 	}
 
 	m.logger.Info("✅ summarized chat histories",
@@ -87,24 +173,37 @@ func (m *Model) summarizeChatHistoriesSlice(chatID int64, s string) ([]*openai.C
 	)
 
 	if resp.Choices[0].Message.Content == "" {
-		return nil, goopenai.Usage{}, nil
+		return nil, goopenai.Usage{}, false, nil
 	}
 
 	var outputs []*openai.ChatHistorySummarizationOutputs
 
-	resp.Choices[0].Message.Content = strings.TrimPrefix(resp.Choices[0].Message.Content, "```json")
-	resp.Choices[0].Message.Content = strings.TrimPrefix(resp.Choices[0].Message.Content, "```")
-	resp.Choices[0].Message.Content = strings.TrimSuffix(resp.Choices[0].Message.Content, "```")
-
-	err = json.Unmarshal([]byte(resp.Choices[0].Message.Content), &outputs)
+	err = json.Unmarshal([]byte(extractJSONArrayFromSummarization(resp.Choices[0].Message.Content)), &outputs)
+	if err != nil && resp.Choices[0].FinishReason == goopenai.FinishReasonLength {
+		salvaged := salvageTruncatedSummarizationOutputs(resp.Choices[0].Message.Content)
+		if len(salvaged) > 0 {
+			m.logger.Warn("chat history summarization output was truncated by the max tokens, only the complete topics are kept",
+				zap.Int64("chat_id", chatID),
+				zap.String("model_name", m.openAI.GetModelName()),
+				zap.Int("salvaged_topics_count", len(salvaged)),
+			)
+
+			return salvaged, resp.Usage, true, nil
+		}
+	}
 	if err != nil {
 		m.logger.Error("failed to unmarshal chat history summarization output",
+			zap.Int64("chat_id", chatID),
+			zap.String("model_name", m.openAI.GetModelName()),
+			zap.Error(err),
+		)
+		m.logger.Debug("raw chat history summarization output that failed to be unmarshaled",
 			zap.String("content", resp.Choices[0].Message.Content),
 			zap.Int64("chat_id", chatID),
 			zap.String("model_name", m.openAI.GetModelName()),
 		)
 
-		return nil, resp.Usage, err
+		return nil, resp.Usage, false, fmt.Errorf("%w: %w", errInvalidSummarizationOutputs, err)
 	}
 
 	m.logger.Info(fmt.Sprintf("✅ unmarshaled chat history summarization output: %s", fo.May(json.Marshal(outputs))),
@@ -112,7 +211,7 @@ func (m *Model) summarizeChatHistoriesSlice(chatID int64, s string) ([]*openai.C
 		zap.String("model_name", m.openAI.GetModelName()),
 	)
 
-	return outputs, resp.Usage, nil
+	return outputs, resp.Usage, false, nil
 }
 
 func filterOutInvalidFields(messageIDs []int64) func(output *openai.ChatHistorySummarizationOutputs, _ int) *openai.ChatHistorySummarizationOutputs {
@@ -158,23 +257,120 @@ func filterOutMention(output *openai.ChatHistorySummarizationOutputs, _ int) *op
 	return output
 }
 
-func (m *Model) summarizeChatHistories(chatID int64, messageIDs []int64, llmFriendlyChatHistories string) ([]*openai.ChatHistorySummarizationOutputs, goopenai.Usage, error) {
+type SummarizeChatHistoriesCallOptions struct {
+	Persona      string
+	Temperature  *float64
+	MaxTokens    int
+	OutputFormat tgchat.RecapOutputFormat
+	Languages    []string
+	OnProgress   func(topicsCount int)
+	WindowHours  int
+	IsAutoRecap  bool
+
+	TopKeywordsCount      int
+	RelatedMessagesCount  int
+	DedupForwards         bool
+	AnonymizeParticipants bool
+	IncrementalRecap      bool
+}
+
+// WithSummarizeChatHistoriesPersona sets the persona used to phrase the
+// summarization, see openai.ChatHistorySummarizationPersonaPresets.
+func WithSummarizeChatHistoriesPersona(persona string) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.Persona = persona
+	})
+}
+
+// WithSummarizeChatHistoriesTemperature overrides the globally configured
+// sampling temperature, negative temperature is ignored.
+func WithSummarizeChatHistoriesTemperature(temperature float64) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		if temperature < 0 {
+			return
+		}
+
+		o.Temperature = &temperature
+	})
+}
+
+// WithSummarizeChatHistoriesMaxTokens sets the max tokens of the completions,
+// zero or negative leaves it to the API default.
+func WithSummarizeChatHistoriesMaxTokens(maxTokens int) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.MaxTokens = max(maxTokens, 0)
+	})
+}
+
+// WithSummarizeChatHistoriesOutputFormat sets how the summarized topics are
+// rendered, the summarization itself is not affected.
+func WithSummarizeChatHistoriesOutputFormat(format tgchat.RecapOutputFormat) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.OutputFormat = format
+	})
+}
+
+// WithSummarizeChatHistoriesProgress makes the summarization streamed and
+// reports the number of topics summarized so far through onProgress.
+func WithSummarizeChatHistoriesProgress(onProgress func(topicsCount int)) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.OnProgress = onProgress
+	})
+}
+
+// WithSummarizeChatHistoriesWindow records the hour window of the chat
+// histories and whether the recap is an auto recap into the recap log.
+func WithSummarizeChatHistoriesWindow(hours int, isAutoRecap bool) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.WindowHours = hours
+		o.IsAutoRecap = isAutoRecap
+	})
+}
+
+// summarizeChatHistories summarizes the chat histories slice by slice,
+// truncated reports whether any of the slices only has a partial summarization,
+// see RecapTexts.PartialRecapNote.
+func (m *Model) summarizeChatHistories(chatID int64, messageIDs []int64, llmFriendlyChatHistories string, summarizeCallOpts []options.CallOptions[openai.SummarizeChatHistoriesCallOptions], onProgress func(topicsCount int)) ([]*openai.ChatHistorySummarizationOutputs, goopenai.Usage, bool, error) {
 	tokenLimit := m.config.OpenAI.TokenLimit - m.config.OpenAI.ChatHistoriesRecapTokenLimit
 	chatHistoriesSlices := m.openAI.SplitContentBasedByTokenLimitations(llmFriendlyChatHistories, int(tokenLimit))
 	chatHistoriesSummarizations := make([]*openai.ChatHistorySummarizationOutputs, 0, len(chatHistoriesSlices))
 
-	var statusUsage goopenai.Usage
+	var (
+		statusUsage goopenai.Usage
+		truncated   bool
+	)
 
 	for _, s := range chatHistoriesSlices {
 		var outputs []*openai.ChatHistorySummarizationOutputs
 
-		_, _, err := lo.AttemptWithDelay(5, time.Second, func(tried int, delay time.Duration) error {
-			o, usage, err := m.summarizeChatHistoriesSlice(chatID, s)
+		callOpts := append([]options.CallOptions[openai.SummarizeChatHistoriesCallOptions]{}, summarizeCallOpts...)
+
+		if onProgress != nil {
+			summarizedTopicsCount := len(chatHistoriesSummarizations)
+
+			callOpts = append(callOpts, openai.WithSummarizeChatHistoriesStream(func(content string) {
+				onProgress(summarizedTopicsCount + strings.Count(content, `"topicName"`))
+			}))
+		}
+
+		var onlyValidJSONReminded bool
+
+		_, _, err := lo.AttemptWhileWithDelay(5, time.Second, func(tried int, delay time.Duration) (error, bool) {
+			sliceCallOpts := callOpts
+			if onlyValidJSONReminded {
+				sliceCallOpts = append(append([]options.CallOptions[openai.SummarizeChatHistoriesCallOptions]{}, callOpts...), openai.WithSummarizeChatHistoriesExtraInstructions(summarizationOnlyValidJSONReminder))
+			}
+
+			o, usage, sliceTruncated, err := m.summarizeChatHistoriesSlice(chatID, s, sliceCallOpts)
 			statusUsage.CompletionTokens += usage.CompletionTokens
 			statusUsage.PromptTokens += usage.PromptTokens
 			statusUsage.TotalTokens += usage.TotalTokens
 
 			if err != nil {
+				if errors.Is(err, openai.ErrCircuitBreakerOpen) {
+					return err, false
+				}
+
 				m.logger.Error(fmt.Sprintf("failed to summarize chat histories slice: %s, tried %d...", s, tried),
 					zap.Int64("chat_id", chatID),
 					zap.String("model_name", m.openAI.GetModelName()),
@@ -183,7 +379,16 @@ func (m *Model) summarizeChatHistories(chatID int64, messageIDs []int64, llmFrie
 					zap.Int64("calculated_token_limit", tokenLimit),
 				)
 
-				return err
+				if errors.Is(err, errInvalidSummarizationOutputs) {
+					// give up if the outputs are still invalid after reminding
+					if onlyValidJSONReminded {
+						return err, false
+					}
+
+					onlyValidJSONReminded = true
+				}
+
+				return err, true
 			}
 
 			// filter out invalid fields
@@ -202,15 +407,16 @@ func (m *Model) summarizeChatHistories(chatID int64, messageIDs []int64, llmFrie
 					zap.Int64("calculated_token_limit", tokenLimit),
 				)
 
-				return errors.New("no valid outputs")
+				return errors.New("no valid outputs"), true
 			}
 
 			outputs = o
+			truncated = truncated || sliceTruncated
 
-			return nil
+			return nil, true
 		})
 		if err != nil {
-			return make([]*openai.ChatHistorySummarizationOutputs, 0), goopenai.Usage{}, err
+			return make([]*openai.ChatHistorySummarizationOutputs, 0), goopenai.Usage{}, false, err
 		}
 
 		if outputs == nil {
@@ -220,20 +426,34 @@ func (m *Model) summarizeChatHistories(chatID int64, messageIDs []int64, llmFrie
 		chatHistoriesSummarizations = append(chatHistoriesSummarizations, outputs...)
 	}
 
-	return chatHistoriesSummarizations, statusUsage, nil
+	return chatHistoriesSummarizations, statusUsage, truncated, nil
+}
+
+// recapOutputTemplates returns the templates of the output format, the first
+// one links to the messages and the second one doesn't.
+func recapOutputTemplates(format tgchat.RecapOutputFormat) (*template.Template, *template.Template) {
+	if format == tgchat.RecapOutputFormatBullets {
+		return RecapBulletsOutputTemplate, RecapBulletsWithoutLinksOutputTemplate
+	}
+
+	return RecapOutputTemplate, RecapWithoutLinksOutputTemplate
 }
 
-func (m *Model) renderRecapTemplates(chatID int64, chatType telegram.ChatType, summarizations []*openai.ChatHistorySummarizationOutputs) ([]string, error) {
+// RenderRecapTemplates renders the summarized topics with the templates of
+// the format, the fixed strings of the templates are localized by texts.
+func RenderRecapTemplates(chatID int64, chatType telegram.ChatType, summarizations []*openai.ChatHistorySummarizationOutputs, format tgchat.RecapOutputFormat, texts RecapTexts) ([]string, error) {
 	ss := make([]string, 0)
+	withLinksTemplate, withoutLinksTemplate := recapOutputTemplates(format)
 
 	for _, r := range summarizations {
 		sb := new(strings.Builder)
 
 		switch chatType {
 		case telegram.ChatTypeSuperGroup:
-			err := RecapOutputTemplate.Execute(sb, RecapOutputTemplateInputs{
+			err := withLinksTemplate.Execute(sb, RecapOutputTemplateInputs{
 				ChatID: formatChatID(chatID),
 				Recap:  r,
+				Texts:  texts,
 			})
 			if err != nil {
 				return make([]string, 0), err
@@ -241,9 +461,10 @@ func (m *Model) renderRecapTemplates(chatID int64, chatType telegram.ChatType, s
 
 			ss = append(ss, sb.String())
 		case telegram.ChatTypePrivate, telegram.ChatTypeGroup, telegram.ChatTypeChannel:
-			err := RecapWithoutLinksOutputTemplate.Execute(sb, RecapOutputTemplateInputs{
+			err := withoutLinksTemplate.Execute(sb, RecapOutputTemplateInputs{
 				ChatID: formatChatID(chatID),
 				Recap:  r,
+				Texts:  texts,
 			})
 			if err != nil {
 				return make([]string, 0), err
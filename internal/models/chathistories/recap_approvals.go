@@ -0,0 +1,139 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/google/uuid"
+	"github.com/redis/rueidis"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
+	"github.com/nekomeowww/insights-bot/pkg/types/redis"
+	"github.com/nekomeowww/insights-bot/pkg/types/timecapsules"
+)
+
+// SaveOneChatHistoriesRecapApproval holds the auto recap for the approval of
+// the administrators, the recap is discarded once it expires.
+func (m *Model) SaveOneChatHistoriesRecapApproval(recap *ChatHistoriesRecap, expiry time.Duration) (string, error) {
+	content, err := json.Marshal(recap)
+	if err != nil {
+		return "", err
+	}
+
+	approvalID := uuid.New().String()
+
+	setCmd := m.redis.B().
+		Set().
+		Key(redis.RecapApproval1.Format(approvalID)).
+		Value(string(content)).
+		ExSeconds(int64(expiry.Seconds())).
+		Build()
+
+	err = m.redis.Do(context.Background(), setCmd).Error()
+	if err != nil {
+		return "", err
+	}
+
+	return approvalID, nil
+}
+
+// unmarshalStoredChatHistoriesRecap unmarshals the recap kept in Redis, such as
+// the recap previews and the ones waiting for approval, nil will be returned if
+// it does not exist.
+func unmarshalStoredChatHistoriesRecap(result rueidis.RedisResult) (*ChatHistoriesRecap, error) {
+	str, err := result.ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if str == "" {
+		return nil, nil
+	}
+
+	var recap ChatHistoriesRecap
+
+	err = json.Unmarshal([]byte(str), &recap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recap, nil
+}
+
+// FindOneChatHistoriesRecapApproval finds the auto recap waiting for approval,
+// nil will be returned if it was expired, approved or discarded.
+func (m *Model) FindOneChatHistoriesRecapApproval(approvalID string) (*ChatHistoriesRecap, error) {
+	getCmd := m.redis.B().
+		Get().
+		Key(redis.RecapApproval1.Format(approvalID)).
+		Build()
+
+	return unmarshalStoredChatHistoriesRecap(m.redis.Do(context.Background(), getCmd))
+}
+
+// TakeOneChatHistoriesRecapApproval takes out the auto recap waiting for
+// approval, so that it can only be published or discarded once. Nil will be
+// returned if it was expired or taken already.
+func (m *Model) TakeOneChatHistoriesRecapApproval(approvalID string) (*ChatHistoriesRecap, error) {
+	getDelCmd := m.redis.B().
+		Getdel().
+		Key(redis.RecapApproval1.Format(approvalID)).
+		Build()
+
+	return unmarshalStoredChatHistoriesRecap(m.redis.Do(context.Background(), getDelCmd))
+}
+
+// QueueOneApprovedChatHistoriesRecap queues the approved auto recap to be
+// published to the chat and the subscribers by the auto recap service.
+func (m *Model) QueueOneApprovedChatHistoriesRecap(chatID int64, approvalID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := m.approvalDigger.BuryUtil(ctx, timecapsules.ApprovedAutoRecapCapsule{ChatID: chatID, ApprovalID: approvalID}, time.Now().UnixMilli())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("queued approved chat histories recap",
+		zap.Int64("chat_id", chatID),
+		zap.String("approval_id", approvalID),
+	)
+
+	return nil
+}
+
+// NewRecapApprovalInlineKeyboardMarkup creates the inline keyboard to publish
+// or discard the auto recap waiting for approval, only the administrator of
+// fromID is able to use it.
+func (m *Model) NewRecapApprovalInlineKeyboardMarkup(bot *tgbot.Bot, approvalID string, chatID int64, fromID int64, texts RecapTexts) (tgbotapi.InlineKeyboardMarkup, error) {
+	data := recap.RecapApprovalActionData{
+		ApprovalID: approvalID,
+		ChatID:     chatID,
+		FromID:     fromID,
+	}
+
+	approveData, err := bot.AssignOneCallbackQueryData("recap/approval/approve", data)
+	if err != nil {
+		return tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	discardData, err := bot.AssignOneCallbackQueryData("recap/approval/discard", data)
+	if err != nil {
+		return tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(texts.Publish(), approveData),
+			tgbotapi.NewInlineKeyboardButtonData(texts.Discard(), discardData),
+		),
+	), nil
+}
@@ -0,0 +1,73 @@
+package chathistories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nekomeowww/xo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func TestChatHistoriesRecapApprovals(t *testing.T) {
+	newRecap := func() *ChatHistoriesRecap {
+		return &ChatHistoriesRecap{
+			ChatID:         xo.RandomInt64(),
+			ChatType:       telegram.ChatTypeSuperGroup,
+			Summarizations: []string{"## 周末爬山\n约好早上八点集合"},
+			IsAutoRecap:    true,
+		}
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		recap := newRecap()
+
+		approvalID, err := model.SaveOneChatHistoriesRecapApproval(recap, time.Hour)
+		require.NoError(t, err)
+
+		found, err := model.FindOneChatHistoriesRecapApproval(approvalID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, recap.Summarizations, found.Summarizations)
+
+		err = model.QueueOneApprovedChatHistoriesRecap(recap.ChatID, approvalID)
+		require.NoError(t, err)
+
+		taken, err := model.TakeOneChatHistoriesRecapApproval(approvalID)
+		require.NoError(t, err)
+		require.NotNil(t, taken)
+		assert.Equal(t, recap.ChatID, taken.ChatID)
+		assert.Equal(t, recap.ChatType, taken.ChatType)
+
+		// approved by another administrator meanwhile
+		taken, err = model.TakeOneChatHistoriesRecapApproval(approvalID)
+		require.NoError(t, err)
+		assert.Nil(t, taken)
+	})
+
+	t.Run("Discard", func(t *testing.T) {
+		approvalID, err := model.SaveOneChatHistoriesRecapApproval(newRecap(), time.Hour)
+		require.NoError(t, err)
+
+		taken, err := model.TakeOneChatHistoriesRecapApproval(approvalID)
+		require.NoError(t, err)
+		require.NotNil(t, taken)
+
+		found, err := model.FindOneChatHistoriesRecapApproval(approvalID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		approvalID, err := model.SaveOneChatHistoriesRecapApproval(newRecap(), time.Second)
+		require.NoError(t, err)
+
+		time.Sleep(1500 * time.Millisecond)
+
+		found, err := model.FindOneChatHistoriesRecapApproval(approvalID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
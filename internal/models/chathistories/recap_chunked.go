@@ -0,0 +1,143 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	goopenai "github.com/sashabaranov/go-openai"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+)
+
+func addUsage(a, b goopenai.Usage) goopenai.Usage {
+	a.PromptTokens += b.PromptTokens
+	a.CompletionTokens += b.CompletionTokens
+	a.TotalTokens += b.TotalTokens
+
+	return a
+}
+
+// SummarizeChatHistoriesChunked summarizes the chat histories in a map-reduce
+// fashion, the chat histories will be split into chunks of at most
+// maxMessagesPerChunk messages and summarized separately, the topics of all
+// the chunks will then be merged into the final topics, truncated reports
+// whether any of the chunks only has a partial summarization.
+func (m *Model) SummarizeChatHistoriesChunked(chatID int64, histories []*ent.ChatHistories, maxMessagesPerChunk int, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) ([]*openai.ChatHistorySummarizationOutputs, goopenai.Usage, bool, error) {
+	opts := options.ApplyCallOptions(callOpts)
+	summarizeCallOpts := m.summarizeChatHistoriesCallOptions(chatID, opts)
+	chunks := lo.Chunk(histories, lo.Ternary(maxMessagesPerChunk > 0, maxMessagesPerChunk, len(histories)))
+
+	var (
+		statusUsage goopenai.Usage
+		truncated   bool
+	)
+
+	messageIDs := make([]int64, 0, len(histories))
+	summarizations := make([]*openai.ChatHistorySummarizationOutputs, 0)
+
+	for i, chunk := range chunks {
+		chunkChatHistories, chunkMessageIDs := llmFriendlyChatHistories(chunk)
+		messageIDs = append(messageIDs, chunkMessageIDs...)
+
+		var onProgress func(topicsCount int)
+
+		if opts.OnProgress != nil {
+			summarizedTopicsCount := len(summarizations)
+
+			onProgress = func(topicsCount int) {
+				opts.OnProgress(summarizedTopicsCount + topicsCount)
+			}
+		}
+
+		m.logger.Info(fmt.Sprintf("✍️ summarizing chunk %d/%d of chat histories", i+1, len(chunks)),
+			zap.Int64("chat_id", chatID),
+			zap.Int("messages_count", len(chunk)),
+		)
+
+		outputs, usage, chunkTruncated, err := m.summarizeChatHistories(chatID, chunkMessageIDs, chunkChatHistories, summarizeCallOpts, onProgress)
+		statusUsage = addUsage(statusUsage, usage)
+
+		if err != nil {
+			return make([]*openai.ChatHistorySummarizationOutputs, 0), statusUsage, false, err
+		}
+
+		truncated = truncated || chunkTruncated
+
+		summarizations = append(summarizations, outputs...)
+	}
+
+	if len(chunks) <= 1 || len(summarizations) <= 1 {
+		return summarizations, statusUsage, truncated, nil
+	}
+
+	merged, usage, err := m.mergeChatHistoriesSummarizations(chatID, messageIDs, summarizations, opts)
+	statusUsage = addUsage(statusUsage, usage)
+
+	if err != nil {
+		m.logger.Warn("failed to merge chunked chat histories summarizations, fallbacks to the unmerged summarizations",
+			zap.Int64("chat_id", chatID),
+			zap.String("model_name", m.openAI.GetModelName()),
+			zap.Error(err),
+		)
+
+		return summarizations, statusUsage, truncated, nil
+	}
+
+	return merged, statusUsage, truncated, nil
+}
+
+func (m *Model) mergeChatHistoriesSummarizations(chatID int64, messageIDs []int64, summarizations []*openai.ChatHistorySummarizationOutputs, opts *SummarizeChatHistoriesCallOptions) ([]*openai.ChatHistorySummarizationOutputs, goopenai.Usage, error) {
+	summarizationsJSON, err := json.Marshal(summarizations)
+	if err != nil {
+		return nil, goopenai.Usage{}, err
+	}
+
+	mergeCallOpts := []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]{openai.WithSummarizeChatHistoriesPersona(opts.Persona)}
+	if opts.Temperature != nil {
+		mergeCallOpts = append(mergeCallOpts, openai.WithSummarizeChatHistoriesTemperature(*opts.Temperature))
+	}
+	if opts.MaxTokens > 0 {
+		mergeCallOpts = append(mergeCallOpts, openai.WithSummarizeChatHistoriesMaxTokens(opts.MaxTokens))
+	}
+	if len(opts.Languages) > 0 {
+		mergeCallOpts = append(mergeCallOpts, openai.WithSummarizeChatHistoriesLanguage(opts.Languages[0]))
+	}
+
+	resp, err := m.openAI.MergeChatHistoriesSummarizations(context.Background(), string(summarizationsJSON), mergeCallOpts...)
+	if err != nil {
+		return nil, goopenai.Usage{}, err
+	}
+
+	if len(resp.Choices) == 0 || resp.Choices[0].Message.Content == "" {
+		return nil, resp.Usage, errors.New("empty merged summarizations")
+	}
+
+	var outputs []*openai.ChatHistorySummarizationOutputs
+
+	err = json.Unmarshal([]byte(extractJSONArrayFromSummarization(resp.Choices[0].Message.Content)), &outputs)
+	if err != nil {
+		m.logger.Debug("raw merged chat history summarization output that failed to be unmarshaled",
+			zap.String("content", resp.Choices[0].Message.Content),
+			zap.Int64("chat_id", chatID),
+			zap.String("model_name", m.openAI.GetModelName()),
+		)
+
+		return nil, resp.Usage, fmt.Errorf("%w: %w", errInvalidSummarizationOutputs, err)
+	}
+
+	outputs = lo.Map(outputs, filterOutInvalidFields(messageIDs))
+	outputs = lo.Filter(outputs, filterOutInvalidOutputFilterFunc)
+	outputs = lo.Map(outputs, filterOutMention)
+
+	if len(outputs) == 0 {
+		return nil, resp.Usage, errors.New("no valid merged outputs")
+	}
+
+	return outputs, resp.Usage, nil
+}
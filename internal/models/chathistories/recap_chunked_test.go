@@ -0,0 +1,122 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/samber/lo"
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+)
+
+func TestSummarizeChatHistoriesChunked(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(err)
+
+	const maxMessagesPerSummary = 1000
+
+	regexpMessageID := regexp.MustCompile(`(?m)^msgId:(\d+):`)
+
+	var (
+		mutex            sync.Mutex
+		summarizedInputs []string
+		mergedInputs     []string
+	)
+
+	openAIClient := &openaimock.MockClient{}
+	openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+		return []string{s}
+	}
+	openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, s string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+		mutex.Lock()
+		summarizedInputs = append(summarizedInputs, s)
+		mutex.Unlock()
+
+		matches := regexpMessageID.FindAllStringSubmatch(s, -1)
+		firstMessageID := lo.Must(strconv.ParseInt(matches[0][1], 10, 64))
+
+		content := fmt.Sprintf(`[{"topicName":"Release","sinceId":%d,"participants":["User"],"discussion":[{"point":"Point %d","keyIds":[%d]}]}]`, firstMessageID, firstMessageID, firstMessageID)
+
+		return &goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{Content: content}}},
+			Usage:   goopenai.Usage{PromptTokens: 1, CompletionTokens: 1, TotalTokens: 2},
+		}, nil
+	}
+	openAIClient.MergeChatHistoriesSummarizationsStub = func(_ context.Context, s string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+		mergedInputs = append(mergedInputs, s)
+
+		var outputs []*openai.ChatHistorySummarizationOutputs
+		require.NoError(json.Unmarshal([]byte(s), &outputs))
+
+		merged := &openai.ChatHistorySummarizationOutputs{
+			TopicName:    "Release",
+			SinceID:      outputs[0].SinceID,
+			Participants: []string{"User"},
+			Discussion: lo.FlatMap(outputs, func(item *openai.ChatHistorySummarizationOutputs, _ int) []*openai.ChatHistorySummarizationOutputsDiscussion {
+				return item.Discussion
+			}),
+		}
+
+		content := "```json\n" + string(lo.Must(json.Marshal([]*openai.ChatHistorySummarizationOutputs{merged}))) + "\n```"
+
+		return &goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{Content: content}}},
+			Usage:   goopenai.Usage{PromptTokens: 1, CompletionTokens: 1, TotalTokens: 2},
+		}, nil
+	}
+
+	m := &Model{
+		config: config,
+		logger: logger,
+		openAI: openAIClient,
+	}
+
+	histories := make([]*ent.ChatHistories, 0, 3000)
+	for i := 1; i <= 3000; i++ {
+		histories = append(histories, &ent.ChatHistories{
+			MessageID: int64(i),
+			FullName:  "User",
+			Text:      fmt.Sprintf("message %d", i),
+		})
+	}
+
+	outputs, usage, truncated, err := m.SummarizeChatHistoriesChunked(1, histories, maxMessagesPerSummary)
+	require.NoError(err)
+	assert.False(truncated)
+
+	require.Len(summarizedInputs, 3)
+
+	for _, input := range summarizedInputs {
+		assert.LessOrEqual(len(strings.Split(input, "\n")), maxMessagesPerSummary)
+	}
+
+	require.Len(mergedInputs, 1)
+	require.Len(outputs, 1)
+	assert.Equal("Release", outputs[0].TopicName)
+	assert.Equal(int64(1), outputs[0].SinceID)
+	assert.Equal([]string{"Point 1", "Point 1001", "Point 2001"}, lo.Map(outputs[0].Discussion, func(item *openai.ChatHistorySummarizationOutputsDiscussion, _ int) string {
+		return item.Point
+	}))
+	assert.Equal(8, usage.TotalTokens)
+}
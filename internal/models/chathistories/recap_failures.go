@@ -0,0 +1,103 @@
+package chathistories
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/chathistories"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecapfailures"
+)
+
+// SaveOneChatHistoriesRecapFailure records the failed recap of the chat
+// together with the exact window it was generated for, so that it can be
+// re-run later instead of being lost.
+func (m *Model) SaveOneChatHistoriesRecapFailure(chatID int64, windowHours int, since, until time.Time, isAutoRecap bool, recapErr error) error {
+	failure, err := m.ent.LogChatHistoriesRecapFailures.
+		Create().
+		SetChatID(chatID).
+		SetWindowHours(windowHours).
+		SetWindowSince(since.UnixMilli()).
+		SetWindowUntil(until.UnixMilli()).
+		SetIsAutoRecap(isAutoRecap).
+		SetError(recapErr.Error()).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("saved one chat histories recap failure",
+		zap.String("id", failure.ID.String()),
+		zap.Int64("chat_id", chatID),
+		zap.Int("window_hours", windowHours),
+	)
+
+	return nil
+}
+
+// FindLastFailedRecap finds the latest failed recap of the chat that has not
+// been re-run successfully yet, nil will be returned if there is none.
+func (m *Model) FindLastFailedRecap(chatID int64) (*ent.LogChatHistoriesRecapFailures, error) {
+	failure, err := m.ent.LogChatHistoriesRecapFailures.
+		Query().
+		Where(
+			logchathistoriesrecapfailures.ChatIDEQ(chatID),
+			logchathistoriesrecapfailures.ResolvedEQ(false),
+		).
+		Order(ent.Desc(logchathistoriesrecapfailures.FieldCreatedAt)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return failure, nil
+}
+
+// ResolveChatHistoriesRecapFailure marks the failed recap as re-run
+// successfully.
+func (m *Model) ResolveChatHistoriesRecapFailure(id uuid.UUID) error {
+	return m.ent.LogChatHistoriesRecapFailures.
+		UpdateOneID(id).
+		SetResolved(true).
+		SetUpdatedAt(time.Now().UnixMilli()).
+		Exec(context.Background())
+}
+
+// FindChatHistoriesBetween finds the chat histories of the chat that were
+// chatted after since and no later than until.
+func (m *Model) FindChatHistoriesBetween(chatID int64, since, until time.Time) ([]*ent.ChatHistories, error) {
+	telegramChatHistories, err := m.ent.ChatHistories.
+		Query().
+		Where(
+			chathistories.ChatID(chatID),
+			chathistories.ChattedAtGT(since.UnixMilli()),
+			chathistories.ChattedAtLTE(until.UnixMilli()),
+		).
+		Order(
+			chathistories.ByMessageID(sql.OrderAsc()),
+		).
+		All(context.TODO())
+	if err != nil {
+		return make([]*ent.ChatHistories, 0), err
+	}
+
+	ephemeralChatHistories, err := m.findEphemeralChatHistoriesSince(chatID, since)
+	if err != nil {
+		return make([]*ent.ChatHistories, 0), err
+	}
+
+	ephemeralChatHistories = lo.Filter(ephemeralChatHistories, func(item *ent.ChatHistories, _ int) bool {
+		return item.ChattedAt <= until.UnixMilli()
+	})
+
+	return mergeChatHistories(telegramChatHistories, ephemeralChatHistories), nil
+}
@@ -0,0 +1,49 @@
+package chathistories
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nekomeowww/xo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindLastFailedRecap(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	failure, err := model.FindLastFailedRecap(chatID)
+	require.NoError(t, err)
+	assert.Nil(t, failure)
+
+	until := time.Now().Truncate(time.Millisecond)
+	since := until.Add(-6 * time.Hour)
+
+	err = model.SaveOneChatHistoriesRecapFailure(chatID, 6, since.Add(-time.Hour), until.Add(-time.Hour), true, errors.New("older failure"))
+	require.NoError(t, err)
+
+	time.Sleep(time.Millisecond * 5)
+
+	err = model.SaveOneChatHistoriesRecapFailure(chatID, 6, since, until, true, errors.New("openai: context deadline exceeded"))
+	require.NoError(t, err)
+
+	failure, err = model.FindLastFailedRecap(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, failure)
+
+	assert.Equal(t, chatID, failure.ChatID)
+	assert.Equal(t, 6, failure.WindowHours)
+	assert.Equal(t, since.UnixMilli(), failure.WindowSince)
+	assert.Equal(t, until.UnixMilli(), failure.WindowUntil)
+	assert.Equal(t, "openai: context deadline exceeded", failure.Error)
+	assert.True(t, failure.IsAutoRecap)
+
+	err = model.ResolveChatHistoriesRecapFailure(failure.ID)
+	require.NoError(t, err)
+
+	failure, err = model.FindLastFailedRecap(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, failure)
+	assert.Equal(t, "older failure", failure.Error)
+}
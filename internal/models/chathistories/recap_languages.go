@@ -0,0 +1,168 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+
+	goopenai "github.com/sashabaranov/go-openai"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+// ChatHistoriesRecapTranslation is the recap translated into another language.
+type ChatHistoriesRecapTranslation struct {
+	Language       string                                    `json:"language"`
+	Outputs        []*openai.ChatHistorySummarizationOutputs `json:"outputs"`
+	Summarizations []string                                  `json:"summarizations"`
+}
+
+// WithSummarizeChatHistoriesLanguages sets the languages of the recap, the
+// chat histories are summarized in the first language, and the summarized
+// topics are translated into the rest of the languages afterwards.
+func WithSummarizeChatHistoriesLanguages(languages []string) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.Languages = languages
+	})
+}
+
+// mergeTranslatedChatHistoriesSummarizations copies the translated texts onto
+// the original outputs, so that the message ids and the participants are never
+// altered by the translation.
+func mergeTranslatedChatHistoriesSummarizations(outputs []*openai.ChatHistorySummarizationOutputs, translated []*openai.ChatHistorySummarizationOutputs) ([]*openai.ChatHistorySummarizationOutputs, error) {
+	if len(outputs) != len(translated) {
+		return nil, fmt.Errorf("%w: expected %d topics, got %d", errInvalidSummarizationOutputs, len(outputs), len(translated))
+	}
+
+	merged := make([]*openai.ChatHistorySummarizationOutputs, 0, len(outputs))
+
+	for i, o := range outputs {
+		t := translated[i]
+		if t == nil || len(t.Discussion) != len(o.Discussion) {
+			return nil, fmt.Errorf("%w: points of topic %d mismatched", errInvalidSummarizationOutputs, i)
+		}
+
+		m := &openai.ChatHistorySummarizationOutputs{
+			TopicName:    o.TopicName,
+			SinceID:      o.SinceID,
+			Participants: o.Participants,
+			Discussion:   make([]*openai.ChatHistorySummarizationOutputsDiscussion, 0, len(o.Discussion)),
+			Conclusion:   o.Conclusion,
+		}
+		if t.TopicName != "" {
+			m.TopicName = t.TopicName
+		}
+		if t.Conclusion != "" && o.Conclusion != "" {
+			m.Conclusion = t.Conclusion
+		}
+
+		for j, d := range o.Discussion {
+			point := d.Point
+			if t.Discussion[j] != nil && t.Discussion[j].Point != "" {
+				point = t.Discussion[j].Point
+			}
+
+			m.Discussion = append(m.Discussion, &openai.ChatHistorySummarizationOutputsDiscussion{
+				Point:  point,
+				KeyIDs: d.KeyIDs,
+			})
+		}
+
+		merged = append(merged, m)
+	}
+
+	return merged, nil
+}
+
+func (m *Model) translateChatHistoriesSummarizations(outputs []*openai.ChatHistorySummarizationOutputs, language string, opts *SummarizeChatHistoriesCallOptions) ([]*openai.ChatHistorySummarizationOutputs, goopenai.Usage, error) {
+	outputsJSON, err := json.Marshal(outputs)
+	if err != nil {
+		return nil, goopenai.Usage{}, err
+	}
+
+	translateCallOpts := []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]{openai.WithSummarizeChatHistoriesLanguage(language)}
+	if opts.Temperature != nil {
+		translateCallOpts = append(translateCallOpts, openai.WithSummarizeChatHistoriesTemperature(*opts.Temperature))
+	}
+	if opts.MaxTokens > 0 {
+		translateCallOpts = append(translateCallOpts, openai.WithSummarizeChatHistoriesMaxTokens(opts.MaxTokens))
+	}
+
+	resp, err := m.openAI.TranslateChatHistoriesSummarizations(context.Background(), string(outputsJSON), translateCallOpts...)
+	if err != nil {
+		return nil, goopenai.Usage{}, err
+	}
+
+	if len(resp.Choices) == 0 || resp.Choices[0].Message.Content == "" {
+		return nil, resp.Usage, errors.New("empty translated summarizations")
+	}
+
+	var translated []*openai.ChatHistorySummarizationOutputs
+
+	err = json.Unmarshal([]byte(extractJSONArrayFromSummarization(resp.Choices[0].Message.Content)), &translated)
+	if err != nil {
+		return nil, resp.Usage, fmt.Errorf("%w: %w", errInvalidSummarizationOutputs, err)
+	}
+
+	merged, err := mergeTranslatedChatHistoriesSummarizations(outputs, translated)
+	if err != nil {
+		return nil, resp.Usage, err
+	}
+
+	return merged, resp.Usage, nil
+}
+
+// translateChatHistoriesRecap translates the summarized topics into the
+// languages other than the first one, the languages failed to be translated
+// are skipped so that the recap can still be published.
+func (m *Model) translateChatHistoriesRecap(chatID int64, chatType telegram.ChatType, outputs []*openai.ChatHistorySummarizationOutputs, opts *SummarizeChatHistoriesCallOptions) ([]*ChatHistoriesRecapTranslation, goopenai.Usage) {
+	translations := make([]*ChatHistoriesRecapTranslation, 0)
+
+	var statusUsage goopenai.Usage
+
+	if len(opts.Languages) <= 1 || len(outputs) == 0 {
+		return translations, statusUsage
+	}
+
+	for _, language := range opts.Languages[1:] {
+		translated, usage, err := m.translateChatHistoriesSummarizations(outputs, language, opts)
+		statusUsage = addUsage(statusUsage, usage)
+
+		if err != nil {
+			m.logger.Warn("failed to translate chat histories summarizations, skipped",
+				zap.Int64("chat_id", chatID),
+				zap.String("language", language),
+				zap.String("model_name", m.openAI.GetModelName()),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		ss, err := RenderRecapTemplates(chatID, chatType, translated, opts.OutputFormat, m.recapTexts([]string{language}))
+		if err != nil || len(ss) == 0 {
+			m.logger.Warn("failed to render translated chat histories summarizations, skipped",
+				zap.Int64("chat_id", chatID),
+				zap.String("language", language),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		ss[0] = fmt.Sprintf("🌐 <b>%s</b>\n\n%s", html.EscapeString(language), ss[0])
+
+		translations = append(translations, &ChatHistoriesRecapTranslation{
+			Language:       language,
+			Outputs:        translated,
+			Summarizations: ss,
+		})
+	}
+
+	return translations, statusUsage
+}
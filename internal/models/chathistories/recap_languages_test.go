@@ -0,0 +1,158 @@
+package chathistories
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func TestMergeTranslatedChatHistoriesSummarizations(t *testing.T) {
+	outputs := []*openai.ChatHistorySummarizationOutputs{
+		{
+			TopicName:    "周末爬山",
+			SinceID:      100,
+			Participants: []string{"User 1", "User 2"},
+			Discussion:   []*openai.ChatHistorySummarizationOutputsDiscussion{{Point: "约好早上八点集合", KeyIDs: []int64{100, 101}}},
+			Conclusion:   "周六出发",
+		},
+	}
+
+	t.Run("KeepsIDsAndParticipants", func(t *testing.T) {
+		merged, err := mergeTranslatedChatHistoriesSummarizations(outputs, []*openai.ChatHistorySummarizationOutputs{
+			{
+				TopicName:    "Weekend hiking",
+				SinceID:      1,
+				Participants: []string{"Someone"},
+				Discussion:   []*openai.ChatHistorySummarizationOutputsDiscussion{{Point: "Meet at 8 am", KeyIDs: []int64{1}}},
+				Conclusion:   "Leave on Saturday",
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, merged, 1)
+
+		assert.Equal(t, "Weekend hiking", merged[0].TopicName)
+		assert.Equal(t, int64(100), merged[0].SinceID)
+		assert.Equal(t, []string{"User 1", "User 2"}, merged[0].Participants)
+		assert.Equal(t, "Meet at 8 am", merged[0].Discussion[0].Point)
+		assert.Equal(t, []int64{100, 101}, merged[0].Discussion[0].KeyIDs)
+		assert.Equal(t, "Leave on Saturday", merged[0].Conclusion)
+
+		// the original outputs are left untouched
+		assert.Equal(t, "周末爬山", outputs[0].TopicName)
+	})
+
+	t.Run("TopicsMismatched", func(t *testing.T) {
+		_, err := mergeTranslatedChatHistoriesSummarizations(outputs, []*openai.ChatHistorySummarizationOutputs{})
+		assert.ErrorIs(t, err, errInvalidSummarizationOutputs)
+	})
+
+	t.Run("PointsMismatched", func(t *testing.T) {
+		_, err := mergeTranslatedChatHistoriesSummarizations(outputs, []*openai.ChatHistorySummarizationOutputs{{TopicName: "Weekend hiking"}})
+		assert.ErrorIs(t, err, errInvalidSummarizationOutputs)
+	})
+}
+
+func TestGenerateChatHistoriesRecapWithTwoLanguages(t *testing.T) {
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(t, err)
+
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	newModel := func(translated string) (*Model, *openaimock.MockClient) {
+		openAIClient := &openaimock.MockClient{}
+		openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+			return []string{s}
+		}
+		openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, _ string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+			return &goopenai.ChatCompletionResponse{
+				Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{
+					Content: `[{"topicName":"周末爬山","sinceId":1,"participants":["User 1","User 2"],"discussion":[{"point":"约好早上八点在山脚下集合","keyIds":[1,2]}]}]`,
+				}}},
+			}, nil
+		}
+		openAIClient.TranslateChatHistoriesSummarizationsStub = func(_ context.Context, _ string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+			return &goopenai.ChatCompletionResponse{
+				Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{Content: translated}}},
+			}, nil
+		}
+
+		return &Model{config: config, logger: logger, openAI: openAIClient, i18n: i}, openAIClient
+	}
+
+	newHistories := func() []*ent.ChatHistories {
+		return []*ent.ChatHistories{
+			{MessageID: 100, FullName: "User 1", Text: "明天要不要一起去爬山，天气预报说是晴天"},
+			{MessageID: 101, FullName: "User 2", Text: "可以的，早上八点在山脚下集合吧"},
+		}
+	}
+
+	t.Run("Translated", func(t *testing.T) {
+		m, openAIClient := newModel(`[{"topicName":"Weekend hiking","sinceId":1,"participants":["User 1","User 2"],"discussion":[{"point":"Meet at the foot of the mountain at 8 am","keyIds":[1,2]}]}]`)
+
+		recap, err := m.GenerateChatHistoriesRecap(-100123456789, telegram.ChatTypeSuperGroup, newHistories(), WithSummarizeChatHistoriesLanguages([]string{"Simplified Chinese", "English"}))
+		require.NoError(t, err)
+
+		require.Equal(t, 1, openAIClient.SummarizeChatHistoriesCallCount())
+		_, _, summarizeCallOpts := openAIClient.SummarizeChatHistoriesArgsForCall(0)
+		assert.Equal(t, "Simplified Chinese", options.ApplyCallOptions(summarizeCallOpts).Language)
+
+		require.Equal(t, 1, openAIClient.TranslateChatHistoriesSummarizationsCallCount())
+		_, translateInputs, translateCallOpts := openAIClient.TranslateChatHistoriesSummarizationsArgsForCall(0)
+		assert.Contains(t, translateInputs, "约好早上八点在山脚下集合")
+		assert.Equal(t, "English", options.ApplyCallOptions(translateCallOpts).Language)
+
+		require.Len(t, recap.Translations, 1)
+		assert.Equal(t, "English", recap.Translations[0].Language)
+		assert.Equal(t, []int64{100, 101}, recap.Translations[0].Outputs[0].Discussion[0].KeyIDs)
+
+		require.Len(t, recap.Summarizations, 2)
+		assert.Contains(t, recap.Summarizations[0], "约好早上八点在山脚下集合")
+		assert.Contains(t, recap.Summarizations[0], "参与人：User 1，User 2")
+		assert.Contains(t, recap.Summarizations[1], "🌐 <b>English</b>")
+		assert.Contains(t, recap.Summarizations[1], "Meet at the foot of the mountain at 8 am")
+		assert.Contains(t, recap.Summarizations[1], "Participants: User 1, User 2")
+		assert.NotContains(t, recap.Summarizations[1], "参与人")
+		assert.Contains(t, recap.Summarizations[1], `<a href="https://t.me/c/123456789/101">[2]</a>`)
+	})
+
+	t.Run("TranslationFailed", func(t *testing.T) {
+		m, _ := newModel(`[]`)
+
+		recap, err := m.GenerateChatHistoriesRecap(-100123456789, telegram.ChatTypeSuperGroup, newHistories(), WithSummarizeChatHistoriesLanguages([]string{"Simplified Chinese", "English"}))
+		require.NoError(t, err)
+
+		assert.Empty(t, recap.Translations)
+		require.Len(t, recap.Summarizations, 1)
+		assert.Contains(t, recap.Summarizations[0], "约好早上八点在山脚下集合")
+	})
+
+	t.Run("SingleLanguage", func(t *testing.T) {
+		m, openAIClient := newModel(`[]`)
+
+		recap, err := m.GenerateChatHistoriesRecap(-100123456789, telegram.ChatTypeSuperGroup, newHistories(), WithSummarizeChatHistoriesLanguages([]string{"English"}))
+		require.NoError(t, err)
+
+		_, _, summarizeCallOpts := openAIClient.SummarizeChatHistoriesArgsForCall(0)
+		assert.Equal(t, "English", options.ApplyCallOptions(summarizeCallOpts).Language)
+		assert.Zero(t, openAIClient.TranslateChatHistoriesSummarizationsCallCount())
+		assert.Empty(t, recap.Translations)
+	})
+}
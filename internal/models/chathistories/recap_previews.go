@@ -0,0 +1,67 @@
+package chathistories
+
+import (
+	"context"
+	"encoding/json"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/google/uuid"
+
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/bot/handlers/recap"
+	"github.com/nekomeowww/insights-bot/pkg/types/redis"
+)
+
+func (m *Model) SaveOneChatHistoriesRecapPreview(recap *ChatHistoriesRecap) (string, error) {
+	content, err := json.Marshal(recap)
+	if err != nil {
+		return "", err
+	}
+
+	previewID := uuid.New().String()
+
+	setCmd := m.redis.B().
+		Set().
+		Key(redis.RecapPreview1.Format(previewID)).
+		Value(string(content)).
+		ExSeconds(24 * 60 * 60).
+		Build()
+
+	err = m.redis.Do(context.Background(), setCmd).Error()
+	if err != nil {
+		return "", err
+	}
+
+	return previewID, nil
+}
+
+// TakeOneChatHistoriesRecapPreview takes out the recap preview to publish it,
+// the preview is deleted at the same time so that it can only be published
+// once, nil will be returned if it was expired or already published.
+func (m *Model) TakeOneChatHistoriesRecapPreview(previewID string) (*ChatHistoriesRecap, error) {
+	getDelCmd := m.redis.B().
+		Getdel().
+		Key(redis.RecapPreview1.Format(previewID)).
+		Build()
+
+	return unmarshalStoredChatHistoriesRecap(m.redis.Do(context.Background(), getDelCmd))
+}
+
+// NewRecapPreviewInlineKeyboardMarkup creates the inline keyboard to publish
+// the recap preview, only the user of fromID is able to publish it.
+func (m *Model) NewRecapPreviewInlineKeyboardMarkup(bot *tgbot.Bot, previewID string, chatID int64, fromID int64, texts RecapTexts) (tgbotapi.InlineKeyboardMarkup, error) {
+	publishData, err := bot.AssignOneCallbackQueryData("recap/preview/publish", recap.PublishRecapPreviewActionData{
+		PreviewID: previewID,
+		ChatID:    chatID,
+		FromID:    fromID,
+	})
+	if err != nil {
+		return tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(texts.Publish(), publishData),
+		),
+	), nil
+}
@@ -0,0 +1,107 @@
+package chathistories
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
+)
+
+var regexpHTMLTags = regexp.MustCompile(`<[^>]*>`)
+
+// tokenizeRecap splits the recap into lower cased tokens, HTML tags are
+// dropped, words of letters and digits are treated as single tokens while
+// Han characters are treated as one token per character since they are not
+// separated by spaces.
+func tokenizeRecap(recap string) []string {
+	recap = regexpHTMLTags.ReplaceAllString(recap, " ")
+	tokens := make([]string, 0)
+	word := new(strings.Builder)
+
+	flushWord := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, strings.ToLower(word.String()))
+			word.Reset()
+		}
+	}
+
+	for _, r := range recap {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flushWord()
+		}
+	}
+
+	flushWord()
+
+	return tokens
+}
+
+// RecapsSimilarity calculates the token overlap ratio (Jaccard index) of two
+// recaps, 1 means the two recaps share the exactly same tokens while 0 means
+// nothing in common.
+func RecapsSimilarity(a, b string) float64 {
+	tokensA := lo.Uniq(tokenizeRecap(a))
+	tokensB := lo.Uniq(tokenizeRecap(b))
+
+	if len(tokensA) == 0 && len(tokensB) == 0 {
+		return 1
+	}
+
+	intersection := len(lo.Intersect(tokensA, tokensB))
+	union := len(tokensA) + len(tokensB) - intersection
+
+	return float64(intersection) / float64(union)
+}
+
+// FindLastAutoChatHistoriesRecapOutputs finds the outputs of the last auto
+// recap generated for the chat, the manual recaps are left out since they
+// cover different windows, an empty string will be returned if there is none.
+func (m *Model) FindLastAutoChatHistoriesRecapOutputs(chatID int64) (string, error) {
+	log, err := m.ent.LogChatHistoriesRecap.
+		Query().
+		Where(
+			logchathistoriesrecap.ChatIDEQ(chatID),
+			logchathistoriesrecap.RecapTypeEQ(int(RecapTypeForGroup)),
+			logchathistoriesrecap.IsAutoRecap(true),
+		).
+		Order(ent.Desc(logchathistoriesrecap.FieldCreatedAt)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return log.RecapOutputs, nil
+}
+
+// IsChatHistoriesRecapDuplicated reports whether the summarizations are too
+// similar to the previous auto recap of the chat and should not be published.
+func (m *Model) IsChatHistoriesRecapDuplicated(chatID int64, summarizations []string) (bool, float64, error) {
+	previous, err := m.FindLastAutoChatHistoriesRecapOutputs(chatID)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if previous == "" {
+		return false, 0, nil
+	}
+
+	similarity := RecapsSimilarity(previous, strings.Join(summarizations, "\n"))
+
+	return similarity > m.config.Recap.DuplicateSimilarityThreshold, similarity, nil
+}
@@ -0,0 +1,64 @@
+package chathistories
+
+import (
+	"testing"
+
+	"github.com/nekomeowww/xo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeRecap(t *testing.T) {
+	assert.Equal(t,
+		[]string{"讨", "论", "insights", "bot", "v2"},
+		tokenizeRecap(`<a href="https://t.me/c/123/456">讨论</a> Insights-Bot v2`),
+	)
+	assert.Empty(t, tokenizeRecap(""))
+}
+
+func TestRecapsSimilarity(t *testing.T) {
+	t.Run("Identical", func(t *testing.T) {
+		assert.Equal(t, 1.0, RecapsSimilarity("## 新版本发布\n参与人：A，B", "## 新版本发布\n参与人：A，B"))
+	})
+
+	t.Run("BothEmpty", func(t *testing.T) {
+		assert.Equal(t, 1.0, RecapsSimilarity("", ""))
+	})
+
+	t.Run("OneEmpty", func(t *testing.T) {
+		assert.Equal(t, 0.0, RecapsSimilarity("## 新版本发布", ""))
+	})
+
+	t.Run("Disjoint", func(t *testing.T) {
+		assert.Equal(t, 0.0, RecapsSimilarity("hello world", "foo bar"))
+	})
+
+	t.Run("LinksIgnored", func(t *testing.T) {
+		assert.Equal(t, 1.0, RecapsSimilarity(
+			`## <a href="https://t.me/c/123/1">release</a>`,
+			`## <a href="https://t.me/c/123/2">release</a>`,
+		))
+	})
+
+	t.Run("PartialOverlap", func(t *testing.T) {
+		assert.InDelta(t, 0.5, RecapsSimilarity("a b c", "b c d"), 0.0001)
+	})
+}
+
+func TestFindLastAutoChatHistoriesRecapOutputs(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	outputs, err := model.FindLastAutoChatHistoriesRecapOutputs(chatID)
+	require.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	_, err = model.SaveOneChatHistoriesRecap(&ChatHistoriesRecap{ChatID: chatID, Summarizations: []string{"## 自动回顾"}, IsAutoRecap: true})
+	require.NoError(t, err)
+
+	_, err = model.SaveOneChatHistoriesRecap(&ChatHistoriesRecap{ChatID: chatID, Summarizations: []string{"## 手动回顾"}})
+	require.NoError(t, err)
+
+	outputs, err = model.FindLastAutoChatHistoriesRecapOutputs(chatID)
+	require.NoError(t, err)
+	assert.Equal(t, "## 自动回顾", outputs)
+}
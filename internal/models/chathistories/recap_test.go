@@ -1,10 +1,20 @@
 package chathistories
 
 import (
+	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 
+	goopenai "github.com/sashabaranov/go-openai"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
 	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,6 +85,112 @@ func TestFilterOutInvalidFields(t *testing.T) {
 	})
 }
 
+func TestExtractJSONArrayFromSummarization(t *testing.T) {
+	expected := `[{"topicName":"Topic","sinceId":1,"participants":["User 1"],"discussion":[{"point":"Point","keyIds":[1]}]}]`
+
+	t.Run("Plain", func(t *testing.T) {
+		assert.Equal(t, expected, extractJSONArrayFromSummarization(expected))
+	})
+
+	t.Run("Fenced", func(t *testing.T) {
+		assert.Equal(t, expected, extractJSONArrayFromSummarization("```json\n"+expected+"\n```"))
+		assert.Equal(t, expected, extractJSONArrayFromSummarization("```\n"+expected+"\n```\n"))
+	})
+
+	t.Run("LeadingProse", func(t *testing.T) {
+		assert.Equal(t, expected, extractJSONArrayFromSummarization("Sure! Here are the topics:\n\n"+expected))
+	})
+
+	t.Run("TrailingProse", func(t *testing.T) {
+		content := extractJSONArrayFromSummarization("Here are the topics:\n```json\n" + expected + "\n```\nHope this helps.")
+		assert.Equal(t, expected, content)
+
+		var outputs []*openai.ChatHistorySummarizationOutputs
+		require.NoError(t, json.Unmarshal([]byte(content), &outputs))
+		require.Len(t, outputs, 1)
+		assert.Equal(t, "Topic", outputs[0].TopicName)
+	})
+
+	t.Run("NoJSON", func(t *testing.T) {
+		content := extractJSONArrayFromSummarization("I can not summarize the chat histories.")
+
+		var outputs []*openai.ChatHistorySummarizationOutputs
+		require.Error(t, json.Unmarshal([]byte(content), &outputs))
+	})
+}
+
+// truncatedSummarizationOutputs is cut off by the max tokens in the middle of
+// the third topic.
+const truncatedSummarizationOutputs = "```json\n" + `[{"topicName":"Release","sinceId":1,"participants":["User 1"],"discussion":[{"point":"Release plan","keyIds":[1]}]},` +
+	`{"topicName":"Bugs","sinceId":2,"participants":["User 2"],"discussion":[{"point":"Crash on start","keyIds":[2]}],"conclusion":"Fix it"},` +
+	`{"topicName":"Lunch","sinceId":3,"participants":["User 3"],"discussion":[{"point":"Where to`
+
+func TestSalvageTruncatedSummarizationOutputs(t *testing.T) {
+	t.Run("Truncated", func(t *testing.T) {
+		outputs := salvageTruncatedSummarizationOutputs(truncatedSummarizationOutputs)
+		require.Len(t, outputs, 2)
+		assert.Equal(t, "Release", outputs[0].TopicName)
+		assert.Equal(t, "Bugs", outputs[1].TopicName)
+		assert.Equal(t, "Fix it", outputs[1].Conclusion)
+	})
+
+	t.Run("TruncatedInFirstTopic", func(t *testing.T) {
+		assert.Empty(t, salvageTruncatedSummarizationOutputs(`[{"topicName":"Release","sinceId":1,"partic`))
+	})
+
+	t.Run("NoJSON", func(t *testing.T) {
+		assert.Empty(t, salvageTruncatedSummarizationOutputs("I can not summarize the chat histories."))
+	})
+}
+
+func TestSummarizeChatHistoriesTruncated(t *testing.T) {
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(t, err)
+
+	newModel := func(finishReason goopenai.FinishReason) *Model {
+		openAIClient := &openaimock.MockClient{}
+		openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+			return []string{s}
+		}
+		openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, _ string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+			return &goopenai.ChatCompletionResponse{
+				Choices: []goopenai.ChatCompletionChoice{{
+					Message:      goopenai.ChatCompletionMessage{Content: truncatedSummarizationOutputs},
+					FinishReason: finishReason,
+				}},
+				Usage: goopenai.Usage{PromptTokens: 1, CompletionTokens: 1, TotalTokens: 2},
+			}, nil
+		}
+
+		return &Model{
+			config: config,
+			logger: logger,
+			openAI: openAIClient,
+		}
+	}
+
+	t.Run("FinishedDueToLength", func(t *testing.T) {
+		outputs, usage, truncated, err := newModel(goopenai.FinishReasonLength).summarizeChatHistories(1, []int64{1, 2, 3}, "msgId:1: User 1 sent: release", nil, nil)
+		require.NoError(t, err)
+
+		assert.True(t, truncated)
+		require.Len(t, outputs, 2)
+		assert.Equal(t, "Release", outputs[0].TopicName)
+		assert.Equal(t, "Bugs", outputs[1].TopicName)
+		assert.Equal(t, 2, usage.TotalTokens)
+	})
+
+	t.Run("FinishedDueToStop", func(t *testing.T) {
+		_, _, truncated, err := newModel(goopenai.FinishReasonStop).summarizeChatHistories(1, []int64{1, 2, 3}, "msgId:1: User 1 sent: release", nil, nil)
+		require.ErrorIs(t, err, errInvalidSummarizationOutputs)
+		assert.False(t, truncated)
+	})
+}
+
 func TestFilterOutInvalidOutputFilterFunc(t *testing.T) {
 	assert.False(t, filterOutInvalidOutputFilterFunc(&openai.ChatHistorySummarizationOutputs{}, 0))
 }
@@ -245,3 +361,75 @@ func TestFormatFullNameAndUsername(t *testing.T) {
 		})
 	}
 }
+
+func TestRecapBulletsOutputTemplateExecute(t *testing.T) {
+	sb := new(strings.Builder)
+	err := RecapBulletsOutputTemplate.Execute(sb, RecapOutputTemplateInputs{
+		ChatID: formatChatID(-100123456789),
+		Recap: &openai.ChatHistorySummarizationOutputs{
+			TopicName:    "Topic 1",
+			SinceID:      1,
+			Participants: []string{"User 1", "User 2"},
+			Discussion:   []*openai.ChatHistorySummarizationOutputsDiscussion{{Point: "Point 1", KeyIDs: []int64{1, 2}}, {Point: "Point 2"}},
+			Conclusion:   "Conclusion 1",
+		},
+	})
+	require.NoError(t, err)
+
+	expected := `## <a href="https://t.me/c/123456789/1">Topic 1</a>
+- Point 1 <a href="https://t.me/c/123456789/1">[1]</a> <a href="https://t.me/c/123456789/2">[2]</a>
+- Point 2
+- 结论：Conclusion 1`
+	assert.Equal(t, expected, sb.String())
+
+	sb = new(strings.Builder)
+	err = RecapBulletsWithoutLinksOutputTemplate.Execute(sb, RecapOutputTemplateInputs{
+		ChatID: formatChatID(-100123456789),
+		Recap: &openai.ChatHistorySummarizationOutputs{
+			TopicName:    "Topic 2",
+			SinceID:      1,
+			Participants: []string{"User 1"},
+			Discussion:   []*openai.ChatHistorySummarizationOutputsDiscussion{{Point: "Point 1", KeyIDs: []int64{1}}, {Point: "Point 2"}},
+		},
+	})
+	require.NoError(t, err)
+
+	expected = `## Topic 2
+- Point 1
+- Point 2`
+	assert.Equal(t, expected, sb.String())
+}
+
+func TestRenderRecapTemplatesOutputFormat(t *testing.T) {
+	summarizations := []*openai.ChatHistorySummarizationOutputs{{
+		TopicName:    "Topic 1",
+		SinceID:      1,
+		Participants: []string{"User 1", "User 2"},
+		Discussion:   []*openai.ChatHistorySummarizationOutputsDiscussion{{Point: "Point 1", KeyIDs: []int64{1}}},
+		Conclusion:   "Conclusion 1",
+	}}
+
+	t.Run("Prose", func(t *testing.T) {
+		ss, err := RenderRecapTemplates(-100123456789, telegram.ChatTypeSuperGroup, summarizations, tgchat.RecapOutputFormatProse, RecapTexts{})
+		require.NoError(t, err)
+		require.Len(t, ss, 1)
+
+		expected := `## <a href="https://t.me/c/123456789/1">Topic 1</a>
+参与人：User 1，User 2
+讨论：
+ - Point 1 <a href="https://t.me/c/123456789/1">[1]</a>
+结论：Conclusion 1`
+		assert.Equal(t, expected, ss[0])
+	})
+
+	t.Run("Bullets", func(t *testing.T) {
+		ss, err := RenderRecapTemplates(-100123456789, telegram.ChatTypeGroup, summarizations, tgchat.RecapOutputFormatBullets, RecapTexts{})
+		require.NoError(t, err)
+		require.Len(t, ss, 1)
+
+		expected := `## Topic 1
+- Point 1
+- 结论：Conclusion 1`
+		assert.Equal(t, expected, ss[0])
+	})
+}
@@ -0,0 +1,87 @@
+package chathistories
+
+import (
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+)
+
+// RecapTexts localizes the fixed strings of the rendered recaps with the
+// modules.telegram.recap messages of the locales, the zero value uses the
+// built-in Simplified Chinese ones.
+type RecapTexts struct {
+	i18n   *i18n.I18n
+	locale string
+}
+
+// NewRecapTexts creates the RecapTexts of the locale, which is usually the
+// tgchats.RecapLocale of the chat.
+func NewRecapTexts(i18n *i18n.I18n, locale string) RecapTexts {
+	return RecapTexts{i18n: i18n, locale: locale}
+}
+
+// recapTexts returns the RecapTexts of the summary languages of the recap.
+func (m *Model) recapTexts(languages []string) RecapTexts {
+	return NewRecapTexts(m.i18n, tgchats.RecapLocaleOfSummaryLanguages(languages))
+}
+
+func (t RecapTexts) t(fallback string, key string, args ...any) string {
+	if t.i18n == nil {
+		return fallback
+	}
+
+	return t.i18n.TWithLanguage(t.locale, "modules.telegram.recap."+key, args...)
+}
+
+// Participants labels the participants of the topic.
+func (t RecapTexts) Participants() string {
+	return t.t("参与人：", "participantsLabel")
+}
+
+// Discussion labels the discussion points of the topic.
+func (t RecapTexts) Discussion() string {
+	return t.t("讨论：", "discussionLabel")
+}
+
+// Conclusion labels the conclusion of the topic.
+func (t RecapTexts) Conclusion() string {
+	return t.t("结论：", "conclusionLabel")
+}
+
+// ListSeparator separates the items listed in one line, such as the
+// participants.
+func (t RecapTexts) ListSeparator() string {
+	return t.t("，", "listSeparator")
+}
+
+// EnumerationSeparator separates the short items enumerated in one line,
+// such as the keywords.
+func (t RecapTexts) EnumerationSeparator() string {
+	return t.t("、", "enumerationSeparator")
+}
+
+// PartialRecapNote is appended to the recap when the summarization was cut
+// off by the max tokens and only the complete topics were kept.
+func (t RecapTexts) PartialRecapNote() string {
+	return t.t("（因内容过多，部分话题未包含）", "partialRecapNote")
+}
+
+// TopKeywords labels the top keywords of the chat histories.
+func (t RecapTexts) TopKeywords() string {
+	return t.t("热门关键词：", "topKeywordsLabel")
+}
+
+// RelatedMessages labels the links to the key messages of the chat histories.
+func (t RecapTexts) RelatedMessages() string {
+	return t.t("相关消息：", "relatedMessagesLabel")
+}
+
+// Publish labels the button to publish the recap held for approval or
+// preview.
+func (t RecapTexts) Publish() string {
+	return t.t("发布", "publishButton")
+}
+
+// Discard labels the button to discard the recap held for approval.
+func (t RecapTexts) Discard() string {
+	return t.t("丢弃", "discardButton")
+}
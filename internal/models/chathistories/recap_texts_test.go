@@ -0,0 +1,50 @@
+package chathistories
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func TestRecapTexts(t *testing.T) {
+	t.Run("ZeroValue", func(t *testing.T) {
+		texts := RecapTexts{}
+
+		assert.Equal(t, "参与人：", texts.Participants())
+		assert.Equal(t, "（因内容过多，部分话题未包含）", texts.PartialRecapNote())
+	})
+
+	i, err := i18n.NewI18n(i18n.WithLocalesDir(filepath.Join("..", "..", "..", "locales")))
+	require.NoError(t, err)
+
+	t.Run("Localized", func(t *testing.T) {
+		assert.Equal(t, "（因内容过多，部分话题未包含）", NewRecapTexts(i, "zh-CN").PartialRecapNote())
+		assert.Equal(t, "（因內容過多，部分話題未包含）", NewRecapTexts(i, "zh-TW").PartialRecapNote())
+		assert.Equal(t, "(Some topics are not included since there is too much content)", NewRecapTexts(i, "en").PartialRecapNote())
+	})
+
+	t.Run("TopKeywords", func(t *testing.T) {
+		assert.Equal(t, "熱門關鍵字：發布會、a&lt;b&gt;", FormatTopKeywords([]string{"發布會", "a<b>"}, NewRecapTexts(i, "zh-TW")))
+		assert.Equal(t, "Top keywords: launch, a&lt;b&gt;", FormatTopKeywords([]string{"launch", "a<b>"}, NewRecapTexts(i, "en")))
+	})
+
+	t.Run("RelatedMessages", func(t *testing.T) {
+		assert.Equal(t,
+			`相關訊息：<a href="https://t.me/c/123456789/2">[1]</a>`,
+			FormatRelatedMessageLinks(-100123456789, telegram.ChatTypeSuperGroup, []int64{2}, NewRecapTexts(i, "zh-TW")),
+		)
+		assert.Equal(t,
+			`Related messages: <a href="https://t.me/c/123456789/2">[1]</a>`,
+			FormatRelatedMessageLinks(-100123456789, telegram.ChatTypeSuperGroup, []int64{2}, NewRecapTexts(i, "en")),
+		)
+	})
+
+	t.Run("UnknownLocale", func(t *testing.T) {
+		assert.Equal(t, "Participants: ", NewRecapTexts(i, "fr").Participants())
+	})
+}
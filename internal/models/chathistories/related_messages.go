@@ -0,0 +1,81 @@
+package chathistories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+// TopRelatedMessageIDs returns at most n key messages of the summarizations,
+// the messages cited by more discussion points come first, and the ones cited
+// by the same number of points keep the order they were first cited in.
+func TopRelatedMessageIDs(summarizations []*openai.ChatHistorySummarizationOutputs, n int) []int64 {
+	if n <= 0 {
+		return make([]int64, 0)
+	}
+
+	citations := make(map[int64]int)
+	messageIDs := make([]int64, 0)
+
+	for _, s := range summarizations {
+		if s == nil {
+			continue
+		}
+
+		for _, d := range s.Discussion {
+			if d == nil {
+				continue
+			}
+
+			for _, keyID := range lo.Uniq(d.KeyIDs) {
+				if keyID == 0 {
+					continue
+				}
+
+				if _, ok := citations[keyID]; !ok {
+					messageIDs = append(messageIDs, keyID)
+				}
+
+				citations[keyID]++
+			}
+		}
+	}
+
+	sort.SliceStable(messageIDs, func(i, j int) bool {
+		return citations[messageIDs[i]] > citations[messageIDs[j]]
+	})
+
+	if len(messageIDs) > n {
+		messageIDs = messageIDs[:n]
+	}
+
+	return messageIDs
+}
+
+// FormatRelatedMessageLinks renders the links to the messages into a single
+// line localized by texts, empty string is returned if there are no messages
+// or the chat is not a supergroup, whose messages can not be linked to.
+func FormatRelatedMessageLinks(chatID int64, chatType telegram.ChatType, messageIDs []int64, texts RecapTexts) string {
+	if chatType != telegram.ChatTypeSuperGroup || len(messageIDs) == 0 {
+		return ""
+	}
+
+	return texts.RelatedMessages() + strings.Join(lo.Map(messageIDs, func(messageID int64, i int) string {
+		return fmt.Sprintf(`<a href="https://t.me/c/%s/%d">[%d]</a>`, formatChatID(chatID), messageID, i+1)
+	}), " ")
+}
+
+// WithSummarizeChatHistoriesRelatedMessages appends a line of links to at most
+// n key messages of the chat histories to the recap, non-positive n disables
+// it.
+func WithSummarizeChatHistoriesRelatedMessages(n int) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.RelatedMessagesCount = n
+	})
+}
@@ -0,0 +1,52 @@
+package chathistories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func TestTopRelatedMessageIDs(t *testing.T) {
+	summarizations := []*openai.ChatHistorySummarizationOutputs{
+		{
+			TopicName: "周末爬山",
+			Discussion: []*openai.ChatHistorySummarizationOutputsDiscussion{
+				{Point: "约好早上八点集合", KeyIDs: []int64{1, 2, 2}},
+				{Point: "记得带水", KeyIDs: []int64{3, 2}},
+			},
+		},
+		{
+			TopicName: "新版本发布",
+			Discussion: []*openai.ChatHistorySummarizationOutputsDiscussion{
+				{Point: "讨论了更新内容", KeyIDs: []int64{4, 3}},
+			},
+		},
+	}
+
+	assert.Equal(t, []int64{2, 3, 1, 4}, TopRelatedMessageIDs(summarizations, 10))
+	assert.Equal(t, []int64{2, 3}, TopRelatedMessageIDs(summarizations, 2))
+	assert.Empty(t, TopRelatedMessageIDs(summarizations, 0))
+	assert.Empty(t, TopRelatedMessageIDs(nil, 3))
+}
+
+func TestFormatRelatedMessageLinks(t *testing.T) {
+	t.Run("SuperGroup", func(t *testing.T) {
+		assert.Equal(t,
+			`相关消息：<a href="https://t.me/c/123456789/2">[1]</a> <a href="https://t.me/c/123456789/3">[2]</a>`,
+			FormatRelatedMessageLinks(-100123456789, telegram.ChatTypeSuperGroup, []int64{2, 3}, RecapTexts{}),
+		)
+	})
+
+	t.Run("OmittedForNonSuperGroups", func(t *testing.T) {
+		for _, chatType := range []telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypePrivate, telegram.ChatTypeChannel} {
+			assert.Empty(t, FormatRelatedMessageLinks(-123456789, chatType, []int64{2, 3}, RecapTexts{}), chatType)
+		}
+	})
+
+	t.Run("NoMessages", func(t *testing.T) {
+		assert.Empty(t, FormatRelatedMessageLinks(-100123456789, telegram.ChatTypeSuperGroup, nil, RecapTexts{}))
+	})
+}
@@ -40,6 +40,8 @@ func (m *Model) SaveOneTelegramSentMessage(message *tgbotapi.Message, isPinned b
 	return nil
 }
 
+// FindLastTelegramPinnedMessage finds the message pinned last time in the
+// chat, nil will be returned if nothing was pinned before.
 func (m *Model) FindLastTelegramPinnedMessage(chatID int64) (*ent.SentMessages, error) {
 	telegramSentMessage, err := m.ent.SentMessages.
 		Query().
@@ -50,6 +52,10 @@ func (m *Model) FindLastTelegramPinnedMessage(chatID int64) (*ent.SentMessages,
 		Order(ent.Desc(sentmessages.FieldCreatedAt)).
 		First(context.Background())
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
 		return nil, err
 	}
 
@@ -0,0 +1,28 @@
+package chathistories
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+// FilterShortChatHistories filters out the chat histories whose trimmed text
+// is shorter than minLength characters, so that the short messages such as
+// "ok" or "lol" won't dilute the recaps. The returned count is the number of
+// chat histories that should count toward the activity threshold, the short
+// ones are included only if countShortMessages is set. Nothing will be
+// filtered out if minLength is not positive.
+func FilterShortChatHistories(histories []*ent.ChatHistories, minLength int, countShortMessages bool) ([]*ent.ChatHistories, int) {
+	if minLength <= 0 {
+		return histories, len(histories)
+	}
+
+	filtered := lo.Filter(histories, func(item *ent.ChatHistories, _ int) bool {
+		return utf8.RuneCountInString(strings.TrimSpace(item.Text)) >= minLength
+	})
+
+	return filtered, lo.Ternary(countShortMessages, len(histories), len(filtered))
+}
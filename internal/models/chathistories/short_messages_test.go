@@ -0,0 +1,95 @@
+package chathistories
+
+import (
+	"context"
+	"testing"
+
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai/openaimock"
+	"github.com/nekomeowww/insights-bot/pkg/options"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+)
+
+func newShortMessagesChatHistories() []*ent.ChatHistories {
+	return []*ent.ChatHistories{
+		{MessageID: 1, FullName: "User 1", Text: "明天要不要一起去爬山，天气预报说是晴天"},
+		{MessageID: 2, FullName: "User 2", Text: "ok"},
+		{MessageID: 3, FullName: "User 3", Text: " lol "},
+		{MessageID: 4, FullName: "User 2", Text: "好"},
+		{MessageID: 5, FullName: "User 3", Text: "可以的，早上八点在山脚下集合吧"},
+	}
+}
+
+func TestFilterShortChatHistories(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		filtered, count := FilterShortChatHistories(newShortMessagesChatHistories(), 0, false)
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, messageIDsOf(filtered))
+		assert.Equal(t, 5, count)
+	})
+
+	t.Run("ShortMessagesCounted", func(t *testing.T) {
+		filtered, count := FilterShortChatHistories(newShortMessagesChatHistories(), 5, true)
+		assert.Equal(t, []int64{1, 5}, messageIDsOf(filtered))
+		assert.Equal(t, 5, count)
+	})
+
+	t.Run("ShortMessagesNotCounted", func(t *testing.T) {
+		filtered, count := FilterShortChatHistories(newShortMessagesChatHistories(), 5, false)
+		assert.Equal(t, []int64{1, 5}, messageIDsOf(filtered))
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestGenerateChatHistoriesRecapWithoutShortMessages(t *testing.T) {
+	config := configs.NewTestConfig()()
+	config.OpenAI.TokenLimit = 1000000
+	config.OpenAI.ChatHistoriesRecapTokenLimit = 2000
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: config})
+	require.NoError(t, err)
+
+	var prompt string
+
+	openAIClient := &openaimock.MockClient{}
+	openAIClient.SplitContentBasedByTokenLimitationsStub = func(s string, _ int) []string {
+		return []string{s}
+	}
+	openAIClient.SummarizeChatHistoriesStub = func(_ context.Context, s string, _ ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*goopenai.ChatCompletionResponse, error) {
+		prompt = s
+
+		return &goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{Message: goopenai.ChatCompletionMessage{
+				Content: `[{"topicName":"周末爬山","sinceId":1,"participants":["User 1","User 3"],"discussion":[{"point":"约好早上八点在山脚下集合","keyIds":[1,2]}]}]`,
+			}}},
+		}, nil
+	}
+
+	m := &Model{
+		config: config,
+		logger: logger,
+		openAI: openAIClient,
+	}
+
+	histories, _ := FilterShortChatHistories(newShortMessagesChatHistories(), 5, true)
+
+	recap, err := m.GenerateChatHistoriesRecap(-100123456789, telegram.ChatTypeSuperGroup, histories)
+	require.NoError(t, err)
+
+	assert.Contains(t, prompt, "明天要不要一起去爬山")
+	assert.Contains(t, prompt, "早上八点在山脚下集合")
+	assert.NotContains(t, prompt, "sent: ok")
+	assert.NotContains(t, prompt, "lol")
+	assert.NotContains(t, prompt, "sent: 好")
+
+	require.Len(t, recap.Summarizations, 1)
+	assert.Contains(t, recap.Summarizations[0], "周末爬山")
+	assert.Contains(t, recap.Summarizations[0], "约好早上八点在山脚下集合")
+	assert.Contains(t, recap.Summarizations[0], `<a href="https://t.me/c/123456789/5">[2]</a>`)
+}
@@ -0,0 +1,64 @@
+package chathistories
+
+import (
+	"context"
+	"time"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/logchathistoriesrecap"
+)
+
+// TokenUsage is the aggregated OpenAI token usage of the recaps.
+type TokenUsage struct {
+	Recaps           int `json:"recaps"`
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// EstimatedCost estimates the cost of the usage with the prices per one
+// million tokens.
+func (u TokenUsage) EstimatedCost(promptTokenPrice, completionTokenPrice float64) float64 {
+	return float64(u.PromptTokens)/1_000_000*promptTokenPrice + float64(u.CompletionTokens)/1_000_000*completionTokenPrice
+}
+
+// SumTokenUsage sums up the token usage saved in the recap logs.
+func SumTokenUsage(logs []*ent.LogChatHistoriesRecap) TokenUsage {
+	usage := TokenUsage{Recaps: len(logs)}
+
+	for _, log := range logs {
+		usage.PromptTokens += log.PromptTokenUsage
+		usage.CompletionTokens += log.CompletionTokenUsage
+		usage.TotalTokens += log.TotalTokenUsage
+	}
+
+	return usage
+}
+
+// StartOfMonth returns the beginning of the month that t is in, within the
+// location of t.
+func StartOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// TokenUsageForChat aggregates the token usage of the recaps generated for the
+// chat since the given time.
+func (m *Model) TokenUsageForChat(chatID int64, since time.Time) (TokenUsage, error) {
+	logs, err := m.ent.LogChatHistoriesRecap.
+		Query().
+		Where(
+			logchathistoriesrecap.ChatIDEQ(chatID),
+			logchathistoriesrecap.CreatedAtGTE(since.UnixMilli()),
+		).
+		Select(
+			logchathistoriesrecap.FieldPromptTokenUsage,
+			logchathistoriesrecap.FieldCompletionTokenUsage,
+			logchathistoriesrecap.FieldTotalTokenUsage,
+		).
+		All(context.Background())
+	if err != nil {
+		return TokenUsage{}, err
+	}
+
+	return SumTokenUsage(logs), nil
+}
@@ -0,0 +1,55 @@
+package chathistories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nekomeowww/xo"
+	goopenai "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+func TestSumTokenUsage(t *testing.T) {
+	usage := SumTokenUsage([]*ent.LogChatHistoriesRecap{
+		{PromptTokenUsage: 1000, CompletionTokenUsage: 200, TotalTokenUsage: 1200},
+		{PromptTokenUsage: 3000, CompletionTokenUsage: 800, TotalTokenUsage: 3800},
+	})
+	assert.Equal(t, TokenUsage{Recaps: 2, PromptTokens: 4000, CompletionTokens: 1000, TotalTokens: 5000}, usage)
+	assert.InDelta(t, 0.004*0.5+0.001*1.5, usage.EstimatedCost(0.5, 1.5), 1e-9)
+
+	assert.Equal(t, TokenUsage{}, SumTokenUsage(nil))
+	assert.Zero(t, TokenUsage{}.EstimatedCost(0.5, 1.5))
+}
+
+func TestStartOfMonth(t *testing.T) {
+	location := time.FixedZone("Local", 8*60*60)
+
+	assert.Equal(t,
+		time.Date(2024, 2, 1, 0, 0, 0, 0, location),
+		StartOfMonth(time.Date(2024, 2, 29, 23, 59, 0, 0, location)),
+	)
+}
+
+func TestTokenUsageForChat(t *testing.T) {
+	chatID := xo.RandomInt64()
+	since := time.Now()
+
+	for _, u := range []goopenai.Usage{
+		{PromptTokens: 100, CompletionTokens: 20, TotalTokens: 120},
+		{PromptTokens: 300, CompletionTokens: 80, TotalTokens: 380},
+	} {
+		_, err := model.SaveOneChatHistoriesRecap(&ChatHistoriesRecap{ChatID: chatID, Usage: u})
+		require.NoError(t, err)
+	}
+
+	usage, err := model.TokenUsageForChat(chatID, since)
+	require.NoError(t, err)
+	assert.Equal(t, TokenUsage{Recaps: 2, PromptTokens: 400, CompletionTokens: 100, TotalTokens: 500}, usage)
+
+	usage, err = model.TokenUsageForChat(chatID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, TokenUsage{}, usage)
+}
@@ -7,12 +7,14 @@ import (
 	"github.com/nekomeowww/insights-bot/internal/models/logs"
 	"github.com/nekomeowww/insights-bot/internal/models/smr"
 	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/internal/models/tgusers"
 )
 
 func NewModules() fx.Option {
 	return fx.Options(
 		fx.Provide(chathistories.NewModel()),
 		fx.Provide(tgchats.NewModel()),
+		fx.Provide(tgusers.NewModel()),
 		fx.Provide(smr.NewModel()),
 		fx.Provide(logs.NewModel()),
 	)
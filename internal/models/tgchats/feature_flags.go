@@ -80,6 +80,27 @@ func (m *Model) FindLanguageForGroups(chatID int64, chatTitle string) (string, e
 	return featureFlags.FeatureLanguage, nil
 }
 
+// FindRecapLanguageForGroups returns the language that the recaps of the chat
+// should be formatted with, an empty string will be returned if the language
+// is unknown so that the default format will be used.
+func (m *Model) FindRecapLanguageForGroups(chatID int64) string {
+	featureFlags, err := m.findOneFeatureFlagForGroups(chatID, "")
+	if err != nil {
+		m.logger.Warn("failed to find language for groups, fallbacks to default",
+			zap.Int64("chat_id", chatID),
+			zap.Error(err),
+		)
+
+		return ""
+	}
+
+	if featureFlags == nil {
+		return ""
+	}
+
+	return featureFlags.FeatureLanguage
+}
+
 func (m *Model) SetLanguageForGroups(chatID int64, chatType telegram.ChatType, chatTitle string, language string) error {
 	if !lo.Contains([]telegram.ChatType{telegram.ChatTypeGroup, telegram.ChatTypeSuperGroup}, chatType) {
 		return nil
@@ -263,48 +284,110 @@ func (m *Model) QueueSendChatHistoriesRecapTask() {
 }
 
 var MapScheduleHours = map[int][]int64{
+	1: {20},           // queue for 20:00
 	2: {8, 20},        // queue for 08:00, 20:00
 	3: {0, 8, 16},     // queue for 00:00, 08:00, 16:00
 	4: {2, 8, 14, 20}, // queue for 02:00, 08:00, 14:00, 20:00
+
+	// queue for every 24/rate hours since 00:00
+	6:  {0, 4, 8, 12, 16, 20},
+	8:  {0, 3, 6, 9, 12, 15, 18, 21},
+	12: {0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22},
+	24: {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23},
 }
 
-func (m *Model) newNextScheduleTimeForChatHistoriesRecapTasksForChatID(_ int64, rate int) time.Time {
-	location := time.UTC
-	if m.config.TimezoneShiftSeconds != 0 {
-		location = time.FixedZone("Local", int(m.config.TimezoneShiftSeconds))
-	}
+// AutoRecapRatesPerDayOptions are the rates per day that can be chosen for
+// auto recaps, in ascending order.
+var AutoRecapRatesPerDayOptions = []int{1, 2, 3, 4, 6, 8, 12, 24}
+
+func IsValidAutoRecapRatesPerDay(rate int) bool {
+	_, ok := MapScheduleHours[rate]
+	return ok
+}
 
+func (m *Model) newNextScheduleTimeForChatHistoriesRecapTasksForChatID(_ int64, rate int, weekdays []time.Weekday) time.Time {
 	now := time.
-		Now().       // Current time.
-		UTC().       // Resets to UTC.
-		In(location) // Align current timezone with the configured offset (if any) for later calculation.
+		Now().                  // Current time.
+		UTC().                  // Resets to UTC.
+		In(m.config.Location()) // Align current timezone with the configured offset (if any) for later calculation.
+
+	return nextScheduleTimeOnWeekdaysAfter(now, rate, weekdays)
+}
 
+// nextScheduleTimeAfter returns the first schedule time of the rate that is
+// later than after, in the location of after.
+func nextScheduleTimeAfter(after time.Time, rate int) time.Time {
 	scheduleTargets, ok := MapScheduleHours[rate]
 	if !ok {
 		scheduleTargets = MapScheduleHours[4]
 	}
 
-	var (
-		nextScheduleTimeSet bool
-		nextScheduleTime    time.Time
-	)
-
 	for _, target := range scheduleTargets {
-		if now.Hour() < int(target) {
-			nextScheduleTime = time.Date(now.Year(), now.Month(), now.Day(), int(target), 0, 0, 0, location)
-			nextScheduleTimeSet = true
-
-			break
+		nextScheduleTime := time.Date(after.Year(), after.Month(), after.Day(), int(target), 0, 0, 0, after.Location())
+		if nextScheduleTime.After(after) {
+			return nextScheduleTime
 		}
 	}
 
-	if !nextScheduleTimeSet {
-		nextScheduleTime = time.Date(now.Year(), now.Month(), now.Day()+1, int(scheduleTargets[0]), 0, 0, 0, location)
+	return time.Date(after.Year(), after.Month(), after.Day()+1, int(scheduleTargets[0]), 0, 0, 0, after.Location())
+}
+
+// IsAutoRecapWeekday reports whether the auto recaps are generated on the
+// weekday, all days are enabled if weekdays is empty.
+func IsAutoRecapWeekday(weekdays []time.Weekday, weekday time.Weekday) bool {
+	return len(weekdays) == 0 || lo.Contains(weekdays, weekday)
+}
+
+// nextScheduleTimeOnWeekdaysAfter returns the first schedule time of the rate
+// that is later than after and falls on one of the weekdays, the days that
+// are not enabled are skipped.
+func nextScheduleTimeOnWeekdaysAfter(after time.Time, rate int, weekdays []time.Weekday) time.Time {
+	nextScheduleTime := nextScheduleTimeAfter(after, rate)
+
+	// a week later is the same weekday again, no need to look further
+	for i := 0; i < 7 && !IsAutoRecapWeekday(weekdays, nextScheduleTime.Weekday()); i++ {
+		endOfDay := time.Date(nextScheduleTime.Year(), nextScheduleTime.Month(), nextScheduleTime.Day()+1, 0, 0, 0, 0, nextScheduleTime.Location()).Add(-time.Nanosecond)
+		nextScheduleTime = nextScheduleTimeAfter(endOfDay, rate)
 	}
 
 	return nextScheduleTime
 }
 
+// IsAutoRecapWeekdayAt reports whether the auto recaps of the chat are
+// generated on the weekday of now in the configured timezone.
+func (m *Model) IsAutoRecapWeekdayAt(option *ent.TelegramChatRecapsOptions, now time.Time) bool {
+	if option == nil {
+		return true
+	}
+
+	return IsAutoRecapWeekday(option.RecapWeekdays, now.UTC().In(m.config.Location()).Weekday())
+}
+
+// AutoRecapWindowOfRatesPerDay returns the time range of the chat histories
+// that one auto recap covers for the given rates per day.
+func AutoRecapWindowOfRatesPerDay(rate int) time.Duration {
+	if !IsValidAutoRecapRatesPerDay(rate) {
+		rate = 4
+	}
+
+	return 24 * time.Hour / time.Duration(rate)
+}
+
+// newFirstScheduleTimeForChatHistoriesRecapTasks returns the first schedule
+// time that is later than now plus the warm-up period, so that the first auto
+// recap after enabling has enough chat histories to summarize.
+func newFirstScheduleTimeForChatHistoriesRecapTasks(now time.Time, rate int, warmUp time.Duration, weekdays []time.Weekday) time.Time {
+	return nextScheduleTimeOnWeekdaysAfter(now.Add(warmUp), rate, weekdays)
+}
+
+func (m *Model) firstAutoRecapWarmUp(rate int) time.Duration {
+	if m.config.Recap.FirstAutoRecapWarmUpSeconds < 0 {
+		return AutoRecapWindowOfRatesPerDay(rate)
+	}
+
+	return time.Duration(m.config.Recap.FirstAutoRecapWarmUpSeconds) * time.Second
+}
+
 func (m *Model) queueOneSendChatHistoriesRecapTaskForChatIDBasedOnScheduleSets(chatID int64, nextScheduleTime time.Time) error {
 	m.logger.Info("scheduled one send chat histories recap task for chat",
 		zap.Int64("chat_id", chatID),
@@ -329,7 +412,7 @@ func (m *Model) queueOneSendChatHistoriesRecapTaskForChatIDBasedOnScheduleSets(c
 }
 
 func (m *Model) QueueOneSendChatHistoriesRecapTaskForChatID(chatID int64, options *ent.TelegramChatRecapsOptions) error {
-	if !lo.Contains([]int{2, 3, 4}, options.AutoRecapRatesPerDay) {
+	if !IsValidAutoRecapRatesPerDay(options.AutoRecapRatesPerDay) {
 		m.logger.Error("invalid auto recap rates per day, fallbacks, to 4 times a day",
 			zap.Int64("chat_id", chatID),
 			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
@@ -338,7 +421,7 @@ func (m *Model) QueueOneSendChatHistoriesRecapTaskForChatID(chatID int64, option
 		options.AutoRecapRatesPerDay = 4
 	}
 
-	nextScheduleTime := m.newNextScheduleTimeForChatHistoriesRecapTasksForChatID(chatID, options.AutoRecapRatesPerDay)
+	nextScheduleTime := m.newNextScheduleTimeForChatHistoriesRecapTasksForChatID(chatID, options.AutoRecapRatesPerDay, options.RecapWeekdays)
 
 	err := m.queueOneSendChatHistoriesRecapTaskForChatIDBasedOnScheduleSets(chatID, nextScheduleTime)
 	if err != nil {
@@ -354,6 +437,51 @@ func (m *Model) QueueOneSendChatHistoriesRecapTaskForChatID(chatID int64, option
 	return nil
 }
 
+// QueueRetrySendChatHistoriesRecapTaskForChatID moves the next auto recap
+// task of the chat to retryAt so that the skipped recap can be retried, the
+// task stays at the next schedule time if retryAt is not earlier than it. The
+// tasks of the same chat share the same capsule, burying it again only
+// reschedules it.
+func (m *Model) QueueRetrySendChatHistoriesRecapTaskForChatID(chatID int64, options *ent.TelegramChatRecapsOptions, retryAt time.Time) error {
+	rate := options.AutoRecapRatesPerDay
+	if !IsValidAutoRecapRatesPerDay(rate) {
+		rate = 4
+	}
+
+	nextScheduleTime := m.newNextScheduleTimeForChatHistoriesRecapTasksForChatID(chatID, rate, options.RecapWeekdays)
+	if !retryAt.Before(nextScheduleTime) {
+		return nil
+	}
+
+	return m.queueOneSendChatHistoriesRecapTaskForChatIDBasedOnScheduleSets(chatID, retryAt)
+}
+
+// QueueFirstSendChatHistoriesRecapTaskForChatID queues the first auto recap
+// task for the chat that just enabled recaps, the task will be scheduled after
+// the configured warm-up period. The schedule time will be returned.
+func (m *Model) QueueFirstSendChatHistoriesRecapTaskForChatID(chatID int64, options *ent.TelegramChatRecapsOptions) (time.Time, error) {
+	rate := options.AutoRecapRatesPerDay
+	if !IsValidAutoRecapRatesPerDay(rate) {
+		rate = 4
+	}
+
+	now := time.Now().UTC().In(m.config.Location())
+	firstScheduleTime := newFirstScheduleTimeForChatHistoriesRecapTasks(now, rate, m.firstAutoRecapWarmUp(rate), options.RecapWeekdays)
+
+	err := m.queueOneSendChatHistoriesRecapTaskForChatIDBasedOnScheduleSets(chatID, firstScheduleTime)
+	if err != nil {
+		m.logger.Error("failed to queue the first send chat histories recap task",
+			zap.Int64("chat_id", chatID),
+			zap.Int("auto_recap_rates", rate),
+			zap.Error(err),
+		)
+
+		return time.Time{}, err
+	}
+
+	return firstScheduleTime, nil
+}
+
 func (m *Model) DeleteOneFeatureFlagByChatID(chatID int64) error {
 	_, err := m.ent.TelegramChatFeatureFlags.
 		Delete().
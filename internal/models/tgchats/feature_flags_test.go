@@ -2,7 +2,9 @@ package tgchats
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/nekomeowww/insights-bot/ent"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatfeatureflags"
@@ -92,3 +94,111 @@ func TestListChatHistoriesRecapEnabledChats(t *testing.T) {
 	require.Len(chats, 3)
 	assert.ElementsMatch([]int64{chatID1, chatID2, chatID3}, lo.Map(chats, func(item *ent.TelegramChatFeatureFlags, _ int) int64 { return item.ChatID }))
 }
+
+func TestNewFirstScheduleTimeForChatHistoriesRecapTasks(t *testing.T) {
+	now := time.Date(2024, 1, 1, 7, 30, 0, 0, time.UTC)
+
+	t.Run("WithoutWarmUp", func(t *testing.T) {
+		assert.Equal(t, time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), newFirstScheduleTimeForChatHistoriesRecapTasks(now, 4, 0, nil))
+	})
+
+	t.Run("WarmUpWithRecapWindowLength", func(t *testing.T) {
+		assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), newFirstScheduleTimeForChatHistoriesRecapTasks(now, 4, AutoRecapWindowOfRatesPerDay(4), nil))
+	})
+
+	t.Run("WarmUpAcrossDays", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 19, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC), newFirstScheduleTimeForChatHistoriesRecapTasks(now, 2, AutoRecapWindowOfRatesPerDay(2), nil))
+	})
+
+	t.Run("WarmUpEndsOnScheduleTime", func(t *testing.T) {
+		assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), newFirstScheduleTimeForChatHistoriesRecapTasks(now, 4, 30*time.Minute, nil))
+	})
+}
+
+func TestNextScheduleTimeOnWeekdaysAfter(t *testing.T) {
+	businessDays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	t.Run("AllDays", func(t *testing.T) {
+		// 2024-01-05 is a Friday
+		now := time.Date(2024, 1, 5, 21, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC), nextScheduleTimeOnWeekdaysAfter(now, 4, nil))
+	})
+
+	t.Run("SkipWeekends", func(t *testing.T) {
+		now := time.Date(2024, 1, 5, 21, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 8, 2, 0, 0, 0, time.UTC), nextScheduleTimeOnWeekdaysAfter(now, 4, businessDays))
+
+		now = time.Date(2024, 1, 6, 9, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 8, 20, 0, 0, 0, time.UTC), nextScheduleTimeOnWeekdaysAfter(now, 1, businessDays))
+	})
+
+	t.Run("SameDay", func(t *testing.T) {
+		now := time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 5, 14, 0, 0, 0, time.UTC), nextScheduleTimeOnWeekdaysAfter(now, 4, businessDays))
+	})
+
+	t.Run("MidnightSchedule", func(t *testing.T) {
+		now := time.Date(2024, 1, 5, 23, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), nextScheduleTimeOnWeekdaysAfter(now, 3, businessDays))
+	})
+
+	t.Run("OneDayAWeek", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 21, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 8, 20, 0, 0, 0, time.UTC), nextScheduleTimeOnWeekdaysAfter(now, 1, []time.Weekday{time.Monday}))
+	})
+
+	t.Run("FirstScheduleAfterWarmUp", func(t *testing.T) {
+		now := time.Date(2024, 1, 5, 19, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC), newFirstScheduleTimeForChatHistoriesRecapTasks(now, 2, AutoRecapWindowOfRatesPerDay(2), businessDays))
+	})
+}
+
+func TestIsAutoRecapWeekday(t *testing.T) {
+	assert.True(t, IsAutoRecapWeekday(nil, time.Saturday))
+	assert.True(t, IsAutoRecapWeekday([]time.Weekday{time.Monday}, time.Monday))
+	assert.False(t, IsAutoRecapWeekday([]time.Weekday{time.Monday}, time.Sunday))
+}
+
+func TestNormalizeRecapWeekdays(t *testing.T) {
+	assert.Nil(t, NormalizeRecapWeekdays(nil))
+	assert.Nil(t, NormalizeRecapWeekdays([]time.Weekday{0, 1, 2, 3, 4, 5, 6}))
+	assert.Equal(t, []time.Weekday{time.Sunday, time.Friday}, NormalizeRecapWeekdays([]time.Weekday{time.Friday, time.Sunday, time.Friday, 9}))
+}
+
+func TestAutoRecapWindowOfRatesPerDay(t *testing.T) {
+	assert.Equal(t, 12*time.Hour, AutoRecapWindowOfRatesPerDay(2))
+	assert.Equal(t, 8*time.Hour, AutoRecapWindowOfRatesPerDay(3))
+	assert.Equal(t, 6*time.Hour, AutoRecapWindowOfRatesPerDay(4))
+	assert.Equal(t, 6*time.Hour, AutoRecapWindowOfRatesPerDay(0))
+	assert.Equal(t, 24*time.Hour, AutoRecapWindowOfRatesPerDay(1))
+	assert.Equal(t, 4*time.Hour, AutoRecapWindowOfRatesPerDay(6))
+	assert.Equal(t, 3*time.Hour, AutoRecapWindowOfRatesPerDay(8))
+	assert.Equal(t, 2*time.Hour, AutoRecapWindowOfRatesPerDay(12))
+	assert.Equal(t, time.Hour, AutoRecapWindowOfRatesPerDay(24))
+	assert.Equal(t, 6*time.Hour, AutoRecapWindowOfRatesPerDay(5))
+}
+
+func TestMapScheduleHours(t *testing.T) {
+	assert.Len(t, MapScheduleHours, len(AutoRecapRatesPerDayOptions))
+
+	for _, rate := range AutoRecapRatesPerDayOptions {
+		t.Run(fmt.Sprintf("%dTimesPerDay", rate), func(t *testing.T) {
+			require.True(t, IsValidAutoRecapRatesPerDay(rate))
+
+			hours := MapScheduleHours[rate]
+			require.Len(t, hours, rate)
+
+			for i, hour := range hours {
+				assert.GreaterOrEqual(t, hour, int64(0))
+				assert.Less(t, hour, int64(24))
+
+				if i > 0 {
+					assert.Equal(t, AutoRecapWindowOfRatesPerDay(rate), time.Duration(hour-hours[i-1])*time.Hour)
+				}
+			}
+
+			assert.Equal(t, AutoRecapWindowOfRatesPerDay(rate), time.Duration(hours[0]+24-hours[len(hours)-1])*time.Hour)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package tgchats
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+// The locales of the fixed strings in the recap flow, each of them has a
+// bundle in the locales directory.
+const (
+	RecapLocaleSimplifiedChinese  = "zh-CN"
+	RecapLocaleTraditionalChinese = "zh-TW"
+	RecapLocaleEnglish            = "en"
+	RecapLocaleJapanese           = "ja"
+)
+
+// recapLocaleKeywords maps the keywords in the names of the summary languages
+// to the locales, the traditional Chinese ones have to be matched before the
+// other Chinese ones.
+var recapLocaleKeywords = []lo.Tuple2[string, []string]{
+	lo.T2(RecapLocaleTraditionalChinese, []string{"繁", "正體", "traditional"}),
+	lo.T2(RecapLocaleSimplifiedChinese, []string{"简", "簡", "中文", "汉语", "漢語", "chinese"}),
+	lo.T2(RecapLocaleEnglish, []string{"english", "英"}),
+	lo.T2(RecapLocaleJapanese, []string{"日本", "日语", "日文", "japanese"}),
+}
+
+// RecapLocaleOfSummaryLanguages returns the locale of the fixed strings in
+// the recap flow, which follows the first summary language of the chat. Both
+// the language tags such as zh-Hant and the names such as 繁體中文 are
+// understood, Simplified Chinese is used if there is no summary language since
+// the recaps are written in it by default, and English is used for the
+// languages without a bundle.
+func RecapLocaleOfSummaryLanguages(languages []string) string {
+	if len(languages) == 0 {
+		return RecapLocaleSimplifiedChinese
+	}
+
+	tag, err := language.Parse(languages[0])
+	if err == nil && tag != language.Und {
+		base, _ := tag.Base()
+		if base.String() != "zh" {
+			return tag.String()
+		}
+
+		script, _ := tag.Script()
+
+		return lo.Ternary(script.String() == "Hant", RecapLocaleTraditionalChinese, RecapLocaleSimplifiedChinese)
+	}
+
+	name := strings.ToLower(languages[0])
+	for _, keywords := range recapLocaleKeywords {
+		if lo.SomeBy(keywords.B, func(keyword string) bool { return strings.Contains(name, keyword) }) {
+			return keywords.A
+		}
+	}
+
+	return RecapLocaleEnglish
+}
+
+// RecapLocale returns the locale of the fixed strings in the recap flow for
+// the chat, see RecapLocaleOfSummaryLanguages.
+func RecapLocale(option *ent.TelegramChatRecapsOptions) string {
+	if option == nil {
+		return RecapLocaleSimplifiedChinese
+	}
+
+	return RecapLocaleOfSummaryLanguages(ParseSummaryLanguages(option.SummaryLanguages))
+}
+
+// RecapDisplayLanguage returns the language of the in progress texts and the
+// date times in the recap flow of the chat. The language of the chat is kept
+// unless it is empty or Chinese, in which case the locale of the recap flow is
+// used instead, so that the Simplified and the Traditional Chinese are never
+// mixed in one recap.
+func RecapDisplayLanguage(chatLanguage string, option *ent.TelegramChatRecapsOptions) string {
+	if chatLanguage == "" {
+		return RecapLocale(option)
+	}
+
+	base, _ := language.Make(chatLanguage).Base()
+	if base.String() == "zh" {
+		return RecapLocale(option)
+	}
+
+	return chatLanguage
+}
@@ -0,0 +1,52 @@
+package tgchats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nekomeowww/insights-bot/ent"
+)
+
+func TestRecapLocaleOfSummaryLanguages(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		assert.Equal(t, "zh-CN", RecapLocaleOfSummaryLanguages(nil))
+	})
+
+	t.Run("Tags", func(t *testing.T) {
+		assert.Equal(t, "zh-CN", RecapLocaleOfSummaryLanguages([]string{"zh"}))
+		assert.Equal(t, "zh-CN", RecapLocaleOfSummaryLanguages([]string{"zh-Hans"}))
+		assert.Equal(t, "zh-TW", RecapLocaleOfSummaryLanguages([]string{"zh-Hant"}))
+		assert.Equal(t, "zh-TW", RecapLocaleOfSummaryLanguages([]string{"zh-HK"}))
+		assert.Equal(t, "en", RecapLocaleOfSummaryLanguages([]string{"en"}))
+		assert.Equal(t, "ja", RecapLocaleOfSummaryLanguages([]string{"ja", "en"}))
+	})
+
+	t.Run("Names", func(t *testing.T) {
+		assert.Equal(t, "zh-CN", RecapLocaleOfSummaryLanguages([]string{"简体中文"}))
+		assert.Equal(t, "zh-CN", RecapLocaleOfSummaryLanguages([]string{"Chinese"}))
+		assert.Equal(t, "zh-TW", RecapLocaleOfSummaryLanguages([]string{"繁體中文"}))
+		assert.Equal(t, "zh-TW", RecapLocaleOfSummaryLanguages([]string{"Traditional Chinese"}))
+		assert.Equal(t, "en", RecapLocaleOfSummaryLanguages([]string{"English", "简体中文"}))
+		assert.Equal(t, "ja", RecapLocaleOfSummaryLanguages([]string{"日本語"}))
+	})
+
+	t.Run("UnknownFallsBackToEnglish", func(t *testing.T) {
+		assert.Equal(t, "en", RecapLocaleOfSummaryLanguages([]string{"Klingon"}))
+	})
+}
+
+func TestRecapLocale(t *testing.T) {
+	assert.Equal(t, "zh-CN", RecapLocale(nil))
+	assert.Equal(t, "zh-CN", RecapLocale(&ent.TelegramChatRecapsOptions{}))
+	assert.Equal(t, "zh-TW", RecapLocale(&ent.TelegramChatRecapsOptions{SummaryLanguages: "繁體中文,English"}))
+}
+
+func TestRecapDisplayLanguage(t *testing.T) {
+	traditional := &ent.TelegramChatRecapsOptions{SummaryLanguages: "繁體中文"}
+
+	assert.Equal(t, "zh-TW", RecapDisplayLanguage("", traditional))
+	assert.Equal(t, "zh-TW", RecapDisplayLanguage("zh-CN", traditional))
+	assert.Equal(t, "zh-CN", RecapDisplayLanguage("zh-hans", nil))
+	assert.Equal(t, "ja", RecapDisplayLanguage("ja", traditional))
+}
@@ -2,7 +2,11 @@ package tgchats
 
 import (
 	"testing"
+	"time"
 
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
 	"github.com/nekomeowww/xo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,7 +23,7 @@ func TestFindOneOrCreateRecapsOption(t *testing.T) {
 	assert.Empty(t, option.AutoRecapSendMode)
 	assert.Equal(t, 4, option.AutoRecapRatesPerDay)
 
-	option2, err := model.findOneRecapsOption(chatID)
+	option2, err := model.FindOneRecapsOption(chatID)
 	require.NoError(t, err)
 	require.NotNil(t, option2)
 
@@ -38,7 +42,7 @@ func TestSetAutoRecapRatesPerDay(t *testing.T) {
 	err := model.SetAutoRecapRatesPerDay(chatID, 4)
 	require.NoError(t, err)
 
-	option, err := model.findOneRecapsOption(chatID)
+	option, err := model.FindOneRecapsOption(chatID)
 	require.NoError(t, err)
 	require.NotNil(t, option)
 
@@ -48,10 +52,499 @@ func TestSetAutoRecapRatesPerDay(t *testing.T) {
 	err = model.SetAutoRecapRatesPerDay(chatID, 10)
 	require.NoError(t, err)
 
-	option2, err := model.findOneRecapsOption(chatID)
+	option2, err := model.FindOneRecapsOption(chatID)
 	require.NoError(t, err)
 	require.NotNil(t, option2)
 
 	assert.Equal(t, chatID, option2.ChatID)
 	assert.Equal(t, 10, option2.AutoRecapRatesPerDay)
 }
+
+func TestSetRecapDisclaimer(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Empty(t, FormatRecapDisclaimer(option))
+
+	err = model.SetRecapDisclaimer(chatID, "AI 生成内容，<仅供参考>")
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, "AI 生成内容，<仅供参考>", option.RecapDisclaimer)
+	assert.Equal(t, "<b>AI 生成内容，&lt;仅供参考&gt;</b>\n\n", FormatRecapDisclaimer(option))
+
+	err = model.SetRecapDisclaimer(chatID, "")
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Empty(t, FormatRecapDisclaimer(option))
+}
+
+func TestSetSummaryTemperature(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, float64(-1), option.SummaryTemperature)
+
+	temperature, err := model.SetSummaryTemperature(chatID, 0.3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.3, temperature)
+
+	temperature, err = model.SetSummaryTemperature(chatID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, float64(SummaryTemperatureMax), temperature)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, float64(SummaryTemperatureMax), option.SummaryTemperature)
+
+	temperature, err = model.SetSummaryTemperature(chatID, -1)
+	require.NoError(t, err)
+	assert.Equal(t, float64(-1), temperature)
+}
+
+func TestClampSummaryTemperature(t *testing.T) {
+	assert.Equal(t, float64(0), ClampSummaryTemperature(-0.5))
+	assert.Equal(t, 0.8, ClampSummaryTemperature(0.8))
+	assert.Equal(t, 1.5, ClampSummaryTemperature(2))
+}
+
+func TestSetSummaryLanguages(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	languages, err := model.SetSummaryLanguages(chatID, []string{"Simplified Chinese", " English ", "english"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Simplified Chinese", "English"}, languages)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, "Simplified Chinese,English", option.SummaryLanguages)
+
+	languages, err = model.SetSummaryLanguages(chatID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, languages)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Empty(t, option.SummaryLanguages)
+}
+
+func TestParseSummaryLanguages(t *testing.T) {
+	assert.Empty(t, ParseSummaryLanguages(""))
+	assert.Equal(t, []string{"Simplified Chinese", "English"}, ParseSummaryLanguages("Simplified Chinese，English, ,english"))
+	assert.Equal(t, []string{"English", "日本語", "Français"}, ParseSummaryLanguages("English,日本語,Français,Deutsch"))
+}
+
+func TestSetRecapTargetChatID(t *testing.T) {
+	chatID := xo.RandomInt64()
+	targetChatID := xo.RandomInt64()
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, chatID, RecapTargetChatID(option, chatID))
+
+	err = model.SetRecapTargetChatID(chatID, targetChatID)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, targetChatID, RecapTargetChatID(option, chatID))
+
+	err = model.SetRecapTargetChatID(chatID, 0)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, chatID, RecapTargetChatID(option, chatID))
+}
+
+func TestRecapTargetChatID(t *testing.T) {
+	assert.Equal(t, int64(1), RecapTargetChatID(nil, 1))
+	assert.Equal(t, int64(1), RecapTargetChatID(&ent.TelegramChatRecapsOptions{}, 1))
+	assert.Equal(t, int64(2), RecapTargetChatID(&ent.TelegramChatRecapsOptions{RecapTargetChatID: 2}, 1))
+}
+
+func TestSetAutoRecapsSnoozedUntil(t *testing.T) {
+	chatID := xo.RandomInt64()
+	snoozedUntil := time.Now().Add(time.Hour).UnixMilli()
+
+	err := model.SetAutoRecapsSnoozedUntil(chatID, snoozedUntil)
+	require.NoError(t, err)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, snoozedUntil, option.AutoRecapsSnoozedUntil)
+	assert.True(t, IsAutoRecapsSnoozed(option, time.Now()))
+
+	err = model.SetAutoRecapsSnoozedUntil(chatID, 0)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.False(t, IsAutoRecapsSnoozed(option, time.Now()))
+}
+
+func TestIsAutoRecapsSnoozed(t *testing.T) {
+	now := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	t.Run("NotSnoozed", func(t *testing.T) {
+		assert.False(t, IsAutoRecapsSnoozed(nil, now))
+		assert.False(t, IsAutoRecapsSnoozed(&ent.TelegramChatRecapsOptions{}, now))
+	})
+
+	t.Run("SkipWhileSnoozed", func(t *testing.T) {
+		option := &ent.TelegramChatRecapsOptions{AutoRecapsSnoozedUntil: now.Add(2 * time.Hour).UnixMilli()}
+
+		assert.True(t, IsAutoRecapsSnoozed(option, now))
+		assert.True(t, IsAutoRecapsSnoozed(option, now.Add(time.Hour)))
+	})
+
+	t.Run("AutoResume", func(t *testing.T) {
+		option := &ent.TelegramChatRecapsOptions{AutoRecapsSnoozedUntil: now.Add(2 * time.Hour).UnixMilli()}
+
+		assert.False(t, IsAutoRecapsSnoozed(option, now.Add(2*time.Hour)))
+		assert.False(t, IsAutoRecapsSnoozed(option, now.Add(6*time.Hour)))
+	})
+}
+
+func TestShouldSendQuietNotice(t *testing.T) {
+	now := time.Date(2023, 1, 2, 8, 0, 0, 0, time.UTC)
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert.False(t, ShouldSendQuietNotice(nil, now))
+		assert.False(t, ShouldSendQuietNotice(&ent.TelegramChatRecapsOptions{}, now))
+	})
+
+	t.Run("NeverSent", func(t *testing.T) {
+		assert.True(t, ShouldSendQuietNotice(&ent.TelegramChatRecapsOptions{QuietNoticeEnabled: true}, now))
+	})
+
+	t.Run("SentWithinOneDay", func(t *testing.T) {
+		option := &ent.TelegramChatRecapsOptions{
+			QuietNoticeEnabled: true,
+			LastQuietNoticeAt:  now.Add(-23 * time.Hour).UnixMilli(),
+		}
+
+		assert.False(t, ShouldSendQuietNotice(option, now))
+	})
+
+	t.Run("SentOneDayAgo", func(t *testing.T) {
+		option := &ent.TelegramChatRecapsOptions{
+			QuietNoticeEnabled: true,
+			LastQuietNoticeAt:  now.Add(-QuietNoticeInterval).UnixMilli(),
+		}
+
+		assert.True(t, ShouldSendQuietNotice(option, now))
+	})
+}
+
+func TestSetLastQuietNoticeAt(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	err := model.SetQuietNoticeEnabled(chatID, true)
+	require.NoError(t, err)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.True(t, ShouldSendQuietNotice(option, time.Now()))
+
+	err = model.SetLastQuietNoticeAt(chatID, time.Now().UnixMilli())
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.False(t, ShouldSendQuietNotice(option, time.Now()))
+	assert.True(t, ShouldSendQuietNotice(option, time.Now().Add(QuietNoticeInterval)))
+}
+
+func TestSetSubscribeRecapRequirements(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	err := model.SetSubscribeRecapRequirements(chatID, 7, tgchat.SubscribeRecapMemberStatusRequirementUnrestricted)
+	require.NoError(t, err)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, 7, option.SubscribeMinMembershipDays)
+	assert.Equal(t, int(tgchat.SubscribeRecapMemberStatusRequirementUnrestricted), option.SubscribeMinMemberStatus)
+
+	err = model.SetSubscribeRecapRequirements(chatID, -1, tgchat.SubscribeRecapMemberStatusRequirementMember)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Zero(t, option.SubscribeMinMembershipDays)
+	assert.Zero(t, option.SubscribeMinMemberStatus)
+}
+
+func TestSetTopKeywordsCount(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	count, err := model.SetTopKeywordsCount(chatID, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	count, err = model.SetTopKeywordsCount(chatID, TopKeywordsCountMax+1)
+	require.NoError(t, err)
+	assert.Equal(t, TopKeywordsCountMax, count)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, TopKeywordsCountMax, option.TopKeywordsCount)
+}
+
+func TestSetRelatedMessagesCount(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	count, err := model.SetRelatedMessagesCount(chatID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	count, err = model.SetRelatedMessagesCount(chatID, RelatedMessagesCountMax+1)
+	require.NoError(t, err)
+	assert.Equal(t, RelatedMessagesCountMax, count)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, RelatedMessagesCountMax, option.RelatedMessagesCount)
+}
+
+func TestRecapLinkPreviewEnabled(t *testing.T) {
+	assert.True(t, RecapLinkPreviewEnabled(nil))
+	assert.True(t, RecapLinkPreviewEnabled(&ent.TelegramChatRecapsOptions{RecapLinkPreview: true}))
+	assert.False(t, RecapLinkPreviewEnabled(&ent.TelegramChatRecapsOptions{RecapLinkPreview: false}))
+}
+
+func TestSetRecapLinkPreview(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.True(t, RecapLinkPreviewEnabled(option))
+
+	err = model.SetRecapLinkPreview(chatID, false)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.False(t, RecapLinkPreviewEnabled(option))
+}
+
+func TestAutoUnpinAfter(t *testing.T) {
+	assert.Zero(t, AutoUnpinAfter(nil))
+	assert.Zero(t, AutoUnpinAfter(&ent.TelegramChatRecapsOptions{}))
+	assert.Equal(t, 12*time.Hour, AutoUnpinAfter(&ent.TelegramChatRecapsOptions{AutoUnpinAfterSeconds: 12 * 60 * 60}))
+}
+
+func TestSetAutoUnpinAfter(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	err := model.SetAutoUnpinAfter(chatID, 12*time.Hour)
+	require.NoError(t, err)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, 12*time.Hour, AutoUnpinAfter(option))
+
+	err = model.SetAutoUnpinAfter(chatID, 0)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.Zero(t, AutoUnpinAfter(option))
+}
+
+func TestRecapThreadID(t *testing.T) {
+	assert.Zero(t, RecapThreadID(nil))
+	assert.Zero(t, RecapThreadID(&ent.TelegramChatRecapsOptions{}))
+	assert.Equal(t, 7, RecapThreadID(&ent.TelegramChatRecapsOptions{RecapThreadID: 7}))
+}
+
+func TestSetRecapThreadID(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	err := model.SetRecapThreadID(chatID, 7)
+	require.NoError(t, err)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	require.NotNil(t, option)
+	assert.Equal(t, 7, RecapThreadID(option))
+
+	err = model.SetRecapThreadID(chatID, 0)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.Zero(t, RecapThreadID(option))
+}
+
+func TestSetManualRecapRateLimitExemptAdmins(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.False(t, option.ManualRecapRateLimitExemptAdmins)
+
+	err = model.SetManualRecapRateLimitExemptAdmins(chatID, true)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.True(t, option.ManualRecapRateLimitExemptAdmins)
+
+	err = model.SetManualRecapRateLimitExemptAdmins(chatID, false)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.False(t, option.ManualRecapRateLimitExemptAdmins)
+}
+
+func TestSetRecapDocumentAttachment(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	err := model.SetRecapDocumentAttachment(chatID, true)
+	require.NoError(t, err)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.True(t, option.RecapDocumentAttachment)
+
+	err = model.SetRecapDocumentAttachment(chatID, false)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.False(t, option.RecapDocumentAttachment)
+}
+
+func TestClampSummaryMaxTokens(t *testing.T) {
+	assert.Equal(t, SummaryMaxTokensMin, ClampSummaryMaxTokens(1))
+	assert.Equal(t, 2048, ClampSummaryMaxTokens(2048))
+	assert.Equal(t, SummaryMaxTokensMax, ClampSummaryMaxTokens(1000000))
+}
+
+func TestSetSummaryMaxTokens(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	maxTokens, err := model.SetSummaryMaxTokens(chatID, 1000000)
+	require.NoError(t, err)
+	assert.Equal(t, SummaryMaxTokensMax, maxTokens)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.Equal(t, SummaryMaxTokensMax, option.SummaryMaxTokens)
+
+	maxTokens, err = model.SetSummaryMaxTokens(chatID, 0)
+	require.NoError(t, err)
+	assert.Zero(t, maxTokens)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.Zero(t, option.SummaryMaxTokens)
+}
+
+func TestSetRecapApprovalRequired(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.False(t, option.ApprovalRequired)
+
+	err = model.SetRecapApprovalRequired(chatID, true)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.True(t, option.ApprovalRequired)
+
+	err = model.SetRecapApprovalRequired(chatID, false)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.False(t, option.ApprovalRequired)
+}
+
+func TestSetRecapCollectOnly(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	err := model.EnableChatHistoriesRecapForGroups(chatID, telegram.ChatTypeGroup, xo.RandomHashString(6))
+	require.NoError(t, err)
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.False(t, IsRecapCollectOnly(option))
+
+	err = model.SetRecapCollectOnly(chatID, true)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.True(t, IsRecapCollectOnly(option))
+
+	// the chat histories keep being recorded while only collecting
+	enabled, err := model.HasChatHistoriesRecapEnabledForGroups(chatID, "")
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	err = model.SetRecapCollectOnly(chatID, false)
+	require.NoError(t, err)
+
+	option, err = model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.False(t, IsRecapCollectOnly(option))
+}
+
+func TestIsRecapCollectOnly(t *testing.T) {
+	assert.False(t, IsRecapCollectOnly(nil))
+	assert.False(t, IsRecapCollectOnly(&ent.TelegramChatRecapsOptions{}))
+	assert.True(t, IsRecapCollectOnly(&ent.TelegramChatRecapsOptions{CollectOnly: true}))
+}
+
+func TestSetManualRecapMinRole(t *testing.T) {
+	chatID := xo.RandomInt64()
+
+	option, err := model.FindOneOrCreateRecapsOption(chatID)
+	require.NoError(t, err)
+	assert.Equal(t, tgchat.ManualRecapMinRoleEveryone, tgchat.ManualRecapMinRole(option.ManualRecapMinRole))
+
+	for _, role := range []tgchat.ManualRecapMinRole{
+		tgchat.ManualRecapMinRoleAdministrator,
+		tgchat.ManualRecapMinRoleCreator,
+		tgchat.ManualRecapMinRoleEveryone,
+	} {
+		err = model.SetManualRecapMinRole(chatID, role)
+		require.NoError(t, err)
+
+		option, err = model.FindOneOrCreateRecapsOption(chatID)
+		require.NoError(t, err)
+		assert.Equal(t, role, tgchat.ManualRecapMinRole(option.ManualRecapMinRole))
+	}
+}
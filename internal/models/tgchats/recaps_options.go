@@ -2,15 +2,41 @@ package tgchats
 
 import (
 	"context"
+	"fmt"
+	"html"
+	"math"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/nekomeowww/insights-bot/ent"
 	"github.com/nekomeowww/insights-bot/ent/telegramchatrecapsoptions"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
 	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
-func (m *Model) findOneRecapsOption(chatID int64) (*ent.TelegramChatRecapsOptions, error) {
+const (
+	QuietNoticeInterval = 24 * time.Hour
+
+	SummaryTemperatureMin = 0
+	SummaryTemperatureMax = 1.5
+
+	// SummaryMaxTokensMin and SummaryMaxTokensMax bound the max tokens of the
+	// completions of the recaps, the completions are further bounded by the
+	// max output tokens of the model.
+	SummaryMaxTokensMin = 256
+	SummaryMaxTokensMax = 32768
+
+	SummaryLanguagesMaxCount = 3
+
+	TopKeywordsCountMax = 10
+
+	RelatedMessagesCountMax = 5
+)
+
+func (m *Model) FindOneRecapsOption(chatID int64) (*ent.TelegramChatRecapsOptions, error) {
 	option, err := m.ent.TelegramChatRecapsOptions.
 		Query().
 		Where(telegramchatrecapsoptions.ChatID(chatID)).
@@ -27,7 +53,7 @@ func (m *Model) findOneRecapsOption(chatID int64) (*ent.TelegramChatRecapsOption
 }
 
 func (m *Model) FindOneOrCreateRecapsOption(chatID int64) (*ent.TelegramChatRecapsOptions, error) {
-	option, err := m.findOneRecapsOption(chatID)
+	option, err := m.FindOneRecapsOption(chatID)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +76,7 @@ func (m *Model) FindOneOrCreateRecapsOption(chatID int64) (*ent.TelegramChatReca
 }
 
 func (m *Model) SetRecapsRecapMode(chatID int64, recapMode tgchat.AutoRecapSendMode) error {
-	option, err := m.findOneRecapsOption(chatID)
+	option, err := m.FindOneRecapsOption(chatID)
 	if err != nil {
 		return err
 	}
@@ -169,7 +195,7 @@ func (m *Model) EnablePinAutoRecapMessage(chatID int64) error {
 }
 
 func (m *Model) DisablePinAutoRecapMessage(chatID int64) error {
-	option, err := m.findOneRecapsOption(chatID)
+	option, err := m.FindOneRecapsOption(chatID)
 	if err != nil {
 		return err
 	}
@@ -207,3 +233,998 @@ func (m *Model) DisablePinAutoRecapMessage(chatID int64) error {
 
 	return nil
 }
+
+func (m *Model) SetPinAutoRecapMessageSilently(chatID int64, silently bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.PinAutoRecapMessageSilently == silently {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetPinAutoRecapMessageSilently(silently).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated pin auto recap message silently",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("silently", silently),
+	)
+
+	return nil
+}
+
+func (m *Model) SetIncludeBotMessages(chatID int64, include bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.IncludeBotMessages == include {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetIncludeBotMessages(include).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated include bot messages",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("include", include),
+	)
+
+	return nil
+}
+
+// SetAutoRecapsSnoozedUntil snoozes the auto recaps of the chat until the
+// given time in milliseconds, 0 clears the snooze.
+func (m *Model) SetAutoRecapsSnoozedUntil(chatID int64, snoozedUntil int64) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.AutoRecapsSnoozedUntil == snoozedUntil {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetAutoRecapsSnoozedUntil(snoozedUntil).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated auto recaps snoozed until",
+		zap.Int64("chat_id", chatID),
+		zap.Int64("snoozed_until", snoozedUntil),
+	)
+
+	return nil
+}
+
+// IsAutoRecapsSnoozed reports whether the auto recaps of the chat are snoozed
+// at now, the auto recaps resume by themselves once the snooze expires.
+func IsAutoRecapsSnoozed(option *ent.TelegramChatRecapsOptions, now time.Time) bool {
+	if option == nil || option.AutoRecapsSnoozedUntil == 0 {
+		return false
+	}
+
+	return now.UnixMilli() < option.AutoRecapsSnoozedUntil
+}
+
+func (m *Model) SetQuietNoticeEnabled(chatID int64, enabled bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.QuietNoticeEnabled == enabled {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetQuietNoticeEnabled(enabled).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated quiet notice enabled",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("enabled", enabled),
+	)
+
+	return nil
+}
+
+// SetMinMessageLengthForSummary sets the minimum length of the messages to be
+// summarized, the messages shorter than it will be dropped from the recaps,
+// zero or negative length disables the filtering.
+func (m *Model) SetMinMessageLengthForSummary(chatID int64, minLength int) error {
+	if minLength < 0 {
+		minLength = 0
+	}
+
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.MinMessageLengthForSummary == minLength {
+		return nil
+	}
+
+	return m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetMinMessageLengthForSummary(minLength).
+		Exec(context.Background())
+}
+
+func (m *Model) SetCountShortMessagesForActivity(chatID int64, countShortMessages bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.CountShortMessagesForActivity == countShortMessages {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetCountShortMessagesForActivity(countShortMessages).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated count short messages for activity",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("count_short_messages_for_activity", countShortMessages),
+	)
+
+	return nil
+}
+
+// SetExcludedMessageTypes sets the types of the messages to be left out of
+// the recaps.
+func (m *Model) SetExcludedMessageTypes(chatID int64, excluded tgchat.MessageTypes) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if tgchat.MessageTypes(option.ExcludedMessageTypes) == excluded {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetExcludedMessageTypes(int(excluded)).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated excluded message types",
+		zap.Int64("chat_id", chatID),
+		zap.Int("excluded_message_types", int(excluded)),
+	)
+
+	return nil
+}
+
+// NormalizeRecapWeekdays sorts and deduplicates the weekdays, nil will be
+// returned if every day of the week is enabled, which is the default.
+func NormalizeRecapWeekdays(weekdays []time.Weekday) []time.Weekday {
+	weekdays = lo.Uniq(lo.Filter(weekdays, func(weekday time.Weekday, _ int) bool {
+		return weekday >= time.Sunday && weekday <= time.Saturday
+	}))
+	if len(weekdays) == 0 || len(weekdays) == 7 {
+		return nil
+	}
+
+	sort.Slice(weekdays, func(i, j int) bool { return weekdays[i] < weekdays[j] })
+
+	return weekdays
+}
+
+// SetRecapWeekdays sets the weekdays to generate the auto recaps on, every day
+// is enabled if weekdays is empty.
+func (m *Model) SetRecapWeekdays(chatID int64, weekdays []time.Weekday) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	weekdays = NormalizeRecapWeekdays(weekdays)
+
+	update := m.ent.TelegramChatRecapsOptions.UpdateOne(option)
+	if len(weekdays) == 0 {
+		update = update.ClearRecapWeekdays()
+	} else {
+		update = update.SetRecapWeekdays(weekdays)
+	}
+
+	_, err = update.Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated recap weekdays",
+		zap.Int64("chat_id", chatID),
+		zap.Any("recap_weekdays", weekdays),
+	)
+
+	return nil
+}
+
+func (m *Model) SetDedupForwards(chatID int64, dedupForwards bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.DedupForwards == dedupForwards {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetDedupForwards(dedupForwards).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated dedup forwards",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("dedup_forwards", dedupForwards),
+	)
+
+	return nil
+}
+
+func (m *Model) SetStoreMessageContent(chatID int64, storeMessageContent bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.StoreMessageContent == storeMessageContent {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetStoreMessageContent(storeMessageContent).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated store message content",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("store_message_content", storeMessageContent),
+	)
+
+	return nil
+}
+
+func (m *Model) SetAnonymizeParticipants(chatID int64, anonymizeParticipants bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.AnonymizeParticipants == anonymizeParticipants {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetAnonymizeParticipants(anonymizeParticipants).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated anonymize participants",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("anonymize_participants", anonymizeParticipants),
+	)
+
+	return nil
+}
+
+func (m *Model) SetManualRecapPrivate(chatID int64, manualRecapPrivate bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.ManualRecapPrivate == manualRecapPrivate {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetManualRecapPrivate(manualRecapPrivate).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated manual recap private",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("manual_recap_private", manualRecapPrivate),
+	)
+
+	return nil
+}
+
+func (m *Model) SetRecapOutputFormat(chatID int64, format tgchat.RecapOutputFormat) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapOutputFormat == int(format) {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapOutputFormat(int(format)).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated recap output format",
+		zap.Int64("chat_id", chatID),
+		zap.String("recap_output_format", format.String()),
+	)
+
+	return nil
+}
+
+func (m *Model) SetPerTopicMessages(chatID int64, perTopicMessages bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.PerTopicMessages == perTopicMessages {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetPerTopicMessages(perTopicMessages).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated per topic messages",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("per_topic_messages", perTopicMessages),
+	)
+
+	return nil
+}
+
+// SetLastQuietNoticeAt records the time in milliseconds when the quiet notice
+// was sent to the chat for the last time.
+func (m *Model) SetLastQuietNoticeAt(chatID int64, lastQuietNoticeAt int64) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetLastQuietNoticeAt(lastQuietNoticeAt).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ShouldSendQuietNotice reports whether the quiet notice can be sent to the
+// chat at now, the notice is sent at most once per QuietNoticeInterval.
+func ShouldSendQuietNotice(option *ent.TelegramChatRecapsOptions, now time.Time) bool {
+	if option == nil || !option.QuietNoticeEnabled {
+		return false
+	}
+
+	if option.LastQuietNoticeAt == 0 {
+		return true
+	}
+
+	return now.Sub(time.UnixMilli(option.LastQuietNoticeAt)) >= QuietNoticeInterval
+}
+
+func (m *Model) SetRecapDisclaimer(chatID int64, disclaimer string) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapDisclaimer == disclaimer {
+		return nil
+	}
+
+	return m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapDisclaimer(disclaimer).
+		Exec(context.Background())
+}
+
+// SetRecapInProgressTemplate sets the template of the text shown while the
+// manual recap is being generated, empty template restores the default one.
+func (m *Model) SetRecapInProgressTemplate(chatID int64, tmpl string) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapInProgressTemplate == tmpl {
+		return nil
+	}
+
+	return m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapInProgressTemplate(tmpl).
+		Exec(context.Background())
+}
+
+// FormatRecapDisclaimer formats the disclaimer configured for the chat into
+// HTML that should be placed at the top of recap messages, an empty string
+// will be returned if no disclaimer was configured.
+func FormatRecapDisclaimer(option *ent.TelegramChatRecapsOptions) string {
+	if option == nil || option.RecapDisclaimer == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("<b>%s</b>\n\n", html.EscapeString(option.RecapDisclaimer))
+}
+
+// RecapLinkPreviewEnabled reports whether the web page preview of the links
+// in the recap messages should be shown, it is shown by default.
+func RecapLinkPreviewEnabled(option *ent.TelegramChatRecapsOptions) bool {
+	return option == nil || option.RecapLinkPreview
+}
+
+// AutoUnpinAfter returns how long the pinned auto recap message stays pinned
+// before it is unpinned automatically, zero means it is never unpinned until
+// the next one is pinned.
+func AutoUnpinAfter(option *ent.TelegramChatRecapsOptions) time.Duration {
+	if option == nil || option.AutoUnpinAfterSeconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(option.AutoUnpinAfterSeconds) * time.Second
+}
+
+// RecapThreadID returns the thread (forum topic) of the chat that the recaps
+// are sent into, zero means the recaps are sent as usual.
+func RecapThreadID(option *ent.TelegramChatRecapsOptions) int {
+	if option == nil || option.RecapThreadID < 0 {
+		return 0
+	}
+
+	return option.RecapThreadID
+}
+
+func (m *Model) SetRecapTargetChatID(chatID int64, targetChatID int64) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapTargetChatID == targetChatID {
+		return nil
+	}
+
+	return m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapTargetChatID(targetChatID).
+		Exec(context.Background())
+}
+
+// RecapTargetChatID returns the chat id that the recaps of the chat should be
+// sent to, the chat itself will be returned if no target chat was configured.
+func RecapTargetChatID(option *ent.TelegramChatRecapsOptions, chatID int64) int64 {
+	if option == nil || option.RecapTargetChatID == 0 {
+		return chatID
+	}
+
+	return option.RecapTargetChatID
+}
+
+// ClampSummaryTemperature clamps the summary temperature into
+// [SummaryTemperatureMin, SummaryTemperatureMax].
+func ClampSummaryTemperature(temperature float64) float64 {
+	return math.Min(math.Max(temperature, SummaryTemperatureMin), SummaryTemperatureMax)
+}
+
+// SetSummaryTemperature sets the sampling temperature of the recaps, the
+// temperature will be clamped, negative temperature resets it to the globally
+// configured one.
+func (m *Model) SetSummaryTemperature(chatID int64, temperature float64) (float64, error) {
+	if temperature >= 0 {
+		temperature = ClampSummaryTemperature(temperature)
+	} else {
+		temperature = -1
+	}
+
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	if option.SummaryTemperature == temperature {
+		return temperature, nil
+	}
+
+	err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetSummaryTemperature(temperature).
+		Exec(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return temperature, nil
+}
+
+// ClampSummaryMaxTokens clamps the summary max tokens into
+// [SummaryMaxTokensMin, SummaryMaxTokensMax].
+func ClampSummaryMaxTokens(maxTokens int) int {
+	return min(max(maxTokens, SummaryMaxTokensMin), SummaryMaxTokensMax)
+}
+
+// SetSummaryMaxTokens sets the max tokens of the completions of the recaps,
+// the max tokens will be clamped, zero or negative max tokens resets it to the
+// API default.
+func (m *Model) SetSummaryMaxTokens(chatID int64, maxTokens int) (int, error) {
+	if maxTokens > 0 {
+		maxTokens = ClampSummaryMaxTokens(maxTokens)
+	} else {
+		maxTokens = 0
+	}
+
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	if option.SummaryMaxTokens == maxTokens {
+		return maxTokens, nil
+	}
+
+	err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetSummaryMaxTokens(maxTokens).
+		Exec(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return maxTokens, nil
+}
+
+func (m *Model) SetRecapPersona(chatID int64, persona string) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapPersona == persona {
+		return nil
+	}
+
+	return m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapPersona(persona).
+		Exec(context.Background())
+}
+
+// ParseSummaryLanguages parses the comma separated summary languages, empty
+// and duplicated languages are dropped, and only the first
+// SummaryLanguagesMaxCount languages are kept.
+func ParseSummaryLanguages(languages string) []string {
+	parsed := make([]string, 0)
+
+	for _, language := range strings.FieldsFunc(languages, func(r rune) bool { return r == ',' || r == '，' }) {
+		language = openai.SanitizeChatHistorySummarizationLanguage(language)
+		if language == "" || lo.ContainsBy(parsed, func(item string) bool { return strings.EqualFold(item, language) }) {
+			continue
+		}
+
+		parsed = append(parsed, language)
+		if len(parsed) == SummaryLanguagesMaxCount {
+			break
+		}
+	}
+
+	return parsed
+}
+
+// SetSummaryLanguages sets the languages of the recaps, the recaps are
+// summarized in the first language and translated into the rest, empty
+// languages resets it to the default language.
+func (m *Model) SetSummaryLanguages(chatID int64, languages []string) ([]string, error) {
+	languages = ParseSummaryLanguages(strings.Join(languages, ","))
+
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	summaryLanguages := strings.Join(languages, ",")
+	if option.SummaryLanguages == summaryLanguages {
+		return languages, nil
+	}
+
+	err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetSummaryLanguages(summaryLanguages).
+		Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return languages, nil
+}
+
+// SetSubscribeRecapRequirements sets the requirements that members must meet
+// before subscribing to the recaps, non-positive days disables the membership
+// duration requirement.
+func (m *Model) SetSubscribeRecapRequirements(chatID int64, minMembershipDays int, minMemberStatus tgchat.SubscribeRecapMemberStatusRequirement) error {
+	if minMembershipDays < 0 {
+		minMembershipDays = 0
+	}
+
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.SubscribeMinMembershipDays == minMembershipDays && option.SubscribeMinMemberStatus == int(minMemberStatus) {
+		return nil
+	}
+
+	return m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetSubscribeMinMembershipDays(minMembershipDays).
+		SetSubscribeMinMemberStatus(int(minMemberStatus)).
+		Exec(context.Background())
+}
+
+// SetTopKeywordsCount sets the number of top keywords rendered in the recaps,
+// the count is clamped into [0, TopKeywordsCountMax] and 0 disables it.
+func (m *Model) SetTopKeywordsCount(chatID int64, count int) (int, error) {
+	count = lo.Clamp(count, 0, TopKeywordsCountMax)
+
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	if option.TopKeywordsCount == count {
+		return count, nil
+	}
+
+	err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetTopKeywordsCount(count).
+		Exec(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SetRelatedMessagesCount sets the number of links to the key messages
+// rendered in the recaps, the count is clamped into
+// [0, RelatedMessagesCountMax] and 0 disables it.
+func (m *Model) SetRelatedMessagesCount(chatID int64, count int) (int, error) {
+	count = lo.Clamp(count, 0, RelatedMessagesCountMax)
+
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	if option.RelatedMessagesCount == count {
+		return count, nil
+	}
+
+	err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRelatedMessagesCount(count).
+		Exec(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SetIncrementalRecap sets whether the recaps emphasize what is new since the
+// previous recap of the chat.
+func (m *Model) SetIncrementalRecap(chatID int64, incrementalRecap bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.IncrementalRecap == incrementalRecap {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetIncrementalRecap(incrementalRecap).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated incremental recap",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("incremental_recap", incrementalRecap),
+	)
+
+	return nil
+}
+
+// SetRecapLinkPreview sets whether the web page preview of the links in the
+// recap messages is shown.
+func (m *Model) SetRecapLinkPreview(chatID int64, linkPreview bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapLinkPreview == linkPreview {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapLinkPreview(linkPreview).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated recap link preview",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("recap_link_preview", linkPreview),
+	)
+
+	return nil
+}
+
+// SetAutoUnpinAfter sets how long the pinned auto recap message stays pinned
+// before it is unpinned automatically, zero disables the auto unpinning.
+func (m *Model) SetAutoUnpinAfter(chatID int64, autoUnpinAfter time.Duration) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	seconds := int64(autoUnpinAfter.Seconds())
+	if option.AutoUnpinAfterSeconds == seconds {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetAutoUnpinAfterSeconds(seconds).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated auto unpin after",
+		zap.Int64("chat_id", chatID),
+		zap.Duration("auto_unpin_after", autoUnpinAfter),
+	)
+
+	return nil
+}
+
+// SetRecapThreadID sets the thread (forum topic) of the chat that the recaps
+// are sent into, zero sends the recaps as usual.
+func (m *Model) SetRecapThreadID(chatID int64, threadID int) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapThreadID == threadID {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapThreadID(threadID).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated recap thread id",
+		zap.Int64("chat_id", chatID),
+		zap.Int("recap_thread_id", threadID),
+	)
+
+	return nil
+}
+
+// SetManualRecapRateLimitExemptAdmins sets whether the administrators of the
+// chat are exempted from the rate limit of the manual recaps.
+func (m *Model) SetManualRecapRateLimitExemptAdmins(chatID int64, exempt bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.ManualRecapRateLimitExemptAdmins == exempt {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetManualRecapRateLimitExemptAdmins(exempt).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated manual recap rate limit exempt admins",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("manual_recap_rate_limit_exempt_admins", exempt),
+	)
+
+	return nil
+}
+
+// SetRecapDocumentAttachment sets whether the full recap is also attached as
+// a markdown document replying to the recap message.
+func (m *Model) SetRecapDocumentAttachment(chatID int64, attachment bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.RecapDocumentAttachment == attachment {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetRecapDocumentAttachment(attachment).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated recap document attachment",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("recap_document_attachment", attachment),
+	)
+
+	return nil
+}
+
+// SetRecapApprovalRequired sets whether the auto recaps of the chat have to be
+// approved by the administrators before they are published.
+func (m *Model) SetRecapApprovalRequired(chatID int64, required bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.ApprovalRequired == required {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetApprovalRequired(required).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated recap approval required",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("approval_required", required),
+	)
+
+	return nil
+}
+
+// SetRecapCollectOnly sets whether the chat only collects the chat histories,
+// the recaps can't be generated until it is turned off.
+func (m *Model) SetRecapCollectOnly(chatID int64, collectOnly bool) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.CollectOnly == collectOnly {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetCollectOnly(collectOnly).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated recap collect only",
+		zap.Int64("chat_id", chatID),
+		zap.Bool("collect_only", collectOnly),
+	)
+
+	return nil
+}
+
+// IsRecapCollectOnly reports whether the chat only collects the chat
+// histories, neither the manual recaps nor the auto recaps are generated.
+func IsRecapCollectOnly(option *ent.TelegramChatRecapsOptions) bool {
+	return option != nil && option.CollectOnly
+}
+
+// SetManualRecapMinRole sets the lowest role of the members that are allowed
+// to create the recaps with /recap.
+func (m *Model) SetManualRecapMinRole(chatID int64, role tgchat.ManualRecapMinRole) error {
+	option, err := m.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		return err
+	}
+
+	if option.ManualRecapMinRole == int(role) {
+		return nil
+	}
+
+	_, err = m.ent.TelegramChatRecapsOptions.
+		UpdateOne(option).
+		SetManualRecapMinRole(int(role)).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated manual recap min role",
+		zap.Int64("chat_id", chatID),
+		zap.String("manual_recap_min_role", role.String()),
+	)
+
+	return nil
+}
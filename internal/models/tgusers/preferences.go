@@ -0,0 +1,96 @@
+package tgusers
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/ent/telegramuserpreferences"
+)
+
+func (m *Model) findOnePreferences(userID int64) (*ent.TelegramUserPreferences, error) {
+	preferences, err := m.ent.TelegramUserPreferences.
+		Query().
+		Where(telegramuserpreferences.UserID(userID)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+// FindOneOrCreatePreferences finds the preferences of the user, the default
+// preferences will be created if the user has none.
+func (m *Model) FindOneOrCreatePreferences(userID int64) (*ent.TelegramUserPreferences, error) {
+	preferences, err := m.findOnePreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if preferences != nil {
+		return preferences, nil
+	}
+
+	return m.ent.TelegramUserPreferences.
+		Create().
+		SetUserID(userID).
+		Save(context.Background())
+}
+
+// FindBatchPrivateRecapsUserIDs returns the users among userIDs who want their
+// private recaps batched into the daily digest.
+func (m *Model) FindBatchPrivateRecapsUserIDs(userIDs []int64) ([]int64, error) {
+	if len(userIDs) == 0 {
+		return make([]int64, 0), nil
+	}
+
+	preferences, err := m.ent.TelegramUserPreferences.
+		Query().
+		Where(
+			telegramuserpreferences.UserIDIn(userIDs...),
+			telegramuserpreferences.BatchPrivateRecaps(true),
+		).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(preferences, func(item *ent.TelegramUserPreferences, _ int) int64 {
+		return item.UserID
+	}), nil
+}
+
+// SetBatchPrivateRecaps sets whether the private recaps of the user are
+// batched into one daily digest instead of being delivered one by one.
+func (m *Model) SetBatchPrivateRecaps(userID int64, batch bool) error {
+	preferences, err := m.FindOneOrCreatePreferences(userID)
+	if err != nil {
+		return err
+	}
+
+	if preferences.BatchPrivateRecaps == batch {
+		return nil
+	}
+
+	_, err = m.ent.TelegramUserPreferences.
+		UpdateOne(preferences).
+		SetBatchPrivateRecaps(batch).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("updated batch private recaps",
+		zap.Int64("user_id", userID),
+		zap.Bool("batch_private_recaps", batch),
+	)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package tgusers
+
+import (
+	"testing"
+
+	"github.com/nekomeowww/xo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBatchPrivateRecaps(t *testing.T) {
+	userID1 := xo.RandomInt64()
+	userID2 := xo.RandomInt64()
+
+	preferences, err := model.FindOneOrCreatePreferences(userID1)
+	require.NoError(t, err)
+	require.NotNil(t, preferences)
+	assert.False(t, preferences.BatchPrivateRecaps)
+
+	err = model.SetBatchPrivateRecaps(userID1, true)
+	require.NoError(t, err)
+
+	err = model.SetBatchPrivateRecaps(userID2, false)
+	require.NoError(t, err)
+
+	batchedUserIDs, err := model.FindBatchPrivateRecapsUserIDs([]int64{userID1, userID2})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{userID1}, batchedUserIDs)
+
+	err = model.SetBatchPrivateRecaps(userID1, false)
+	require.NoError(t, err)
+
+	batchedUserIDs, err = model.FindBatchPrivateRecapsUserIDs([]int64{userID1, userID2})
+	require.NoError(t, err)
+	assert.Empty(t, batchedUserIDs)
+}
@@ -0,0 +1,179 @@
+package tgusers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/pkg/types/redis"
+	"github.com/nekomeowww/insights-bot/pkg/types/timecapsules"
+)
+
+const (
+	// PrivateRecapDigestHour is the hour of the day when the private recap
+	// digests are delivered, in the configured timezone.
+	PrivateRecapDigestHour = 20
+
+	// privateRecapDigestRetention is how long the undelivered recaps are kept
+	// in case the digest failed to be delivered.
+	privateRecapDigestRetention = 7 * 24 * time.Hour
+
+	// privateRecapDigestRetryInterval is how long to wait before delivering
+	// the digest again once it failed to be delivered.
+	privateRecapDigestRetryInterval = time.Hour
+)
+
+// PrivateRecapDigestItem is one auto recap of a group waiting to be delivered
+// in the private recap digest of the subscriber.
+type PrivateRecapDigestItem struct {
+	ChatID    int64  `json:"chat_id"`
+	ChatTitle string `json:"chat_title"`
+	// Header is rendered right before the summarizations, such as the
+	// disclaimer and the chatted at range.
+	Header string `json:"header"`
+	// Summarizations are the summarizations already rendered into HTML.
+	Summarizations []string `json:"summarizations"`
+	// Locale is the tgchats.RecapLocale of the chat, the fixed strings of the
+	// digest are localized with the locale of the first recap.
+	Locale    string `json:"locale,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// nextPrivateRecapDigestTimeAfter returns the first delivery time of the
+// private recap digests that is later than after, in the location of after.
+func nextPrivateRecapDigestTimeAfter(after time.Time) time.Time {
+	next := time.Date(after.Year(), after.Month(), after.Day(), PrivateRecapDigestHour, 0, 0, 0, after.Location())
+	if next.After(after) {
+		return next
+	}
+
+	return time.Date(after.Year(), after.Month(), after.Day()+1, PrivateRecapDigestHour, 0, 0, 0, after.Location())
+}
+
+// QueuePrivateRecapDigestItem appends the recap to the private recap digest of
+// the user, the delivery of the digest is scheduled when the first recap is
+// queued since the last delivery.
+func (m *Model) QueuePrivateRecapDigestItem(userID int64, item PrivateRecapDigestItem) error {
+	content, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	key := redis.RecapPrivateDigest1.Format(userID)
+
+	results := m.redis.DoMulti(context.Background(),
+		m.redis.B().Rpush().Key(key).Element(string(content)).Build(),
+		m.redis.B().Expire().Key(key).Seconds(int64(privateRecapDigestRetention.Seconds())).Build(),
+	)
+
+	length, err := results[0].AsInt64()
+	if err != nil {
+		return err
+	}
+
+	err = results[1].Error()
+	if err != nil {
+		return err
+	}
+
+	if length > 1 {
+		return nil
+	}
+
+	deliverAt := nextPrivateRecapDigestTimeAfter(time.Now().UTC().In(m.config.Location()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err = m.digger.BuryUtil(ctx, timecapsules.PrivateRecapDigestCapsule{UserID: userID}, deliverAt.UnixMilli())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("scheduled private recap digest for user",
+		zap.Int64("user_id", userID),
+		zap.Time("schedule", deliverAt),
+	)
+
+	return nil
+}
+
+// FindPrivateRecapDigestItems finds all the recaps queued in the private recap
+// digest of the user, together with the count of the queued entries, which is
+// passed to RemovePrivateRecapDigestItems once the digest is delivered, the
+// recaps queued meanwhile are left for the next digest.
+func (m *Model) FindPrivateRecapDigestItems(userID int64) ([]PrivateRecapDigestItem, int64, error) {
+	key := redis.RecapPrivateDigest1.Format(userID)
+
+	contents, err := m.redis.Do(context.Background(), m.redis.B().Lrange().Key(key).Start(0).Stop(-1).Build()).AsStrSlice()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]PrivateRecapDigestItem, 0, len(contents))
+
+	for _, content := range contents {
+		var item PrivateRecapDigestItem
+
+		err = json.Unmarshal([]byte(content), &item)
+		if err != nil {
+			m.logger.Warn("failed to unmarshal private recap digest item, skipping...",
+				zap.Int64("user_id", userID),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items, int64(len(contents)), nil
+}
+
+// RemovePrivateRecapDigestItems removes the first count recaps queued in the
+// private recap digest of the user once they are delivered, the next digest is
+// scheduled if there are recaps queued meanwhile.
+func (m *Model) RemovePrivateRecapDigestItems(userID int64, count int64) error {
+	if count == 0 {
+		return nil
+	}
+
+	key := redis.RecapPrivateDigest1.Format(userID)
+
+	results := m.redis.DoMulti(context.Background(),
+		m.redis.B().Ltrim().Key(key).Start(count).Stop(-1).Build(),
+		m.redis.B().Llen().Key(key).Build(),
+	)
+
+	err := results[0].Error()
+	if err != nil {
+		return err
+	}
+
+	length, err := results[1].AsInt64()
+	if err != nil {
+		return err
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	return m.digger.BuryUtil(ctx, timecapsules.PrivateRecapDigestCapsule{UserID: userID}, nextPrivateRecapDigestTimeAfter(time.Now().UTC().In(m.config.Location())).UnixMilli())
+}
+
+// RetryPrivateRecapDigestLater schedules the private recap digest of the user
+// to be delivered again after the retry interval, the queued recaps are kept
+// until then.
+func (m *Model) RetryPrivateRecapDigestLater(userID int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	return m.digger.BuryUtil(ctx, timecapsules.PrivateRecapDigestCapsule{UserID: userID}, time.Now().Add(privateRecapDigestRetryInterval).UnixMilli())
+}
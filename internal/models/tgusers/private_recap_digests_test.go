@@ -0,0 +1,14 @@
+package tgusers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextPrivateRecapDigestTimeAfter(t *testing.T) {
+	assert.Equal(t, time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC), nextPrivateRecapDigestTimeAfter(time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 1, 2, 20, 0, 0, 0, time.UTC), nextPrivateRecapDigestTimeAfter(time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 1, 2, 20, 0, 0, 0, time.UTC), nextPrivateRecapDigestTimeAfter(time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC)))
+}
@@ -0,0 +1,39 @@
+package tgusers
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/datastore"
+	"github.com/nekomeowww/insights-bot/pkg/logger"
+)
+
+type NewModelParams struct {
+	fx.In
+
+	Config *configs.Config
+	Ent    *datastore.Ent
+	Redis  *datastore.Redis
+	Digger *datastore.PrivateRecapDigestTimeCapsuleDigger
+	Logger *logger.Logger
+}
+
+type Model struct {
+	config *configs.Config
+	ent    *datastore.Ent
+	redis  *datastore.Redis
+	digger *datastore.PrivateRecapDigestTimeCapsuleDigger
+	logger *logger.Logger
+}
+
+func NewModel() func(NewModelParams) (*Model, error) {
+	return func(param NewModelParams) (*Model, error) {
+		return &Model{
+			config: param.Config,
+			ent:    param.Ent,
+			redis:  param.Redis,
+			digger: param.Digger,
+			logger: param.Logger,
+		}, nil
+	}
+}
@@ -0,0 +1,115 @@
+package autorecap
+
+import (
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/nekomeowww/timecapsule/v2"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
+	"github.com/nekomeowww/insights-bot/pkg/types/timecapsules"
+)
+
+// holdChatHistoriesRecapForApproval sends the recap of the chat requiring
+// approval to the administrators instead of publishing it, the recap is
+// published to the chat and the subscribers once any of them approved it, or
+// discarded after the approval expiry.
+func (m *AutoRecapService) holdChatHistoriesRecapForApproval(chatID int64, chatTitle string, chatType telegram.ChatType, recap *chathistories.ChatHistoriesRecap, options *ent.TelegramChatRecapsOptions) {
+	summarizations := recaprender.RenderSummariesToHTML(recap.Summarizations)
+	if len(summarizations) == 0 {
+		return
+	}
+
+	approvalID, err := m.chathistories.SaveOneChatHistoriesRecapApproval(recap, time.Duration(m.config.Recap.ApprovalExpiryHours)*time.Hour)
+	if err != nil {
+		m.logger.Error("failed to save chat histories recap for approval",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	m.logger.Info("chat histories recap is held for approval, sending to administrators...",
+		zap.Int64("chat_id", chatID),
+		zap.String("module", "autorecap"),
+		zap.String("approval_id", approvalID),
+	)
+
+	language := tgchats.RecapDisplayLanguage(m.tgchats.FindRecapLanguageForGroups(chatID), options)
+	header := m.recapT(options, "approvalNotice", i18n.M{
+		"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle),
+		"Hours":     m.config.Recap.ApprovalExpiryHours,
+	}) + "\n\n" + tgchats.FormatRecapDisclaimer(options) + m.chathistories.FormatChatHistoriesChattedAtRange(recap.EarliestChattedAt, recap.LatestChattedAt, language)
+
+	m.sendHeldRecapToAdministrators(chatID, chatType, header, summarizations, options, func(userID int64) (tgbotapi.InlineKeyboardMarkup, error) {
+		return m.chathistories.NewRecapApprovalInlineKeyboardMarkup(m.botService.Bot(), approvalID, chatID, userID, chathistories.NewRecapTexts(m.i18n, tgchats.RecapLocale(options)))
+	})
+}
+
+func (m *AutoRecapService) approvedAutoRecapTimeCapsuleHandler(
+	_ *timecapsule.TimeCapsuleDigger[timecapsules.ApprovedAutoRecapCapsule],
+	capsule *timecapsule.TimeCapsule[timecapsules.ApprovedAutoRecapCapsule],
+) {
+	chatID := capsule.Payload.ChatID
+
+	m.logger.Debug("approved auto recap time capsule handler invoked",
+		zap.Int64("chat_id", chatID),
+		zap.String("approval_id", capsule.Payload.ApprovalID),
+	)
+
+	// taken out first so that the recap approved by several administrators at
+	// once is only published once
+	recap, err := m.chathistories.TakeOneChatHistoriesRecapApproval(capsule.Payload.ApprovalID)
+	if err != nil {
+		m.logger.Error("failed to take approved chat histories recap",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	if recap == nil {
+		m.logger.Warn("approved chat histories recap is expired or published already, skipping...",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.String("approval_id", capsule.Payload.ApprovalID),
+		)
+
+		return
+	}
+
+	options, err := m.tgchats.FindOneOrCreateRecapsOption(chatID)
+	if err != nil {
+		m.logger.Error("failed to find chat recap options", zap.Int64("chat_id", chatID), zap.Error(err))
+		return
+	}
+
+	subscribers, err := m.tgchats.FindAutoRecapsSubscribers(chatID)
+	if err != nil {
+		m.logger.Error("failed to find chat recap subscribers", zap.Int64("chat_id", chatID), zap.Error(err))
+		return
+	}
+
+	chat, err := m.botService.GetChat(tgbotapi.ChatInfoConfig{
+		ChatConfig: tgbotapi.ChatConfig{
+			ChatID: chatID,
+		},
+	})
+	if err != nil {
+		m.logger.Error("failed to get chat", zap.Int64("chat_id", chatID), zap.Error(err))
+		return
+	}
+
+	m.publish(chatID, chat.Title, recap.ChatType, recap, options, subscribers)
+}
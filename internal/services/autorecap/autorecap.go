@@ -2,8 +2,8 @@ package autorecap
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
@@ -13,14 +13,19 @@ import (
 	"github.com/sourcegraph/conc/pool"
 	"go.uber.org/fx"
 	"go.uber.org/multierr"
-	"go.uber.org/ratelimit"
 	"go.uber.org/zap"
 
 	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/configs"
 	"github.com/nekomeowww/insights-bot/internal/datastore"
 	"github.com/nekomeowww/insights-bot/internal/models/chathistories"
 	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/internal/models/tgusers"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/webhook"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
 	"github.com/nekomeowww/insights-bot/pkg/logger"
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
 	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
@@ -32,34 +37,62 @@ type NewAutoRecapParams struct {
 
 	Lifecycle fx.Lifecycle
 
-	Logger        *logger.Logger
-	Bot           *tgbot.BotService
-	ChatHistories *chathistories.Model
-	TgChats       *tgchats.Model
-	Digger        *datastore.AutoRecapTimeCapsuleDigger
+	Config         *configs.Config
+	Logger         *logger.Logger
+	Bot            *tgbot.BotService
+	ChatHistories  *chathistories.Model
+	TgChats        *tgchats.Model
+	TgUsers        *tgusers.Model
+	Digger         *datastore.AutoRecapTimeCapsuleDigger
+	DigestDigger   *datastore.PrivateRecapDigestTimeCapsuleDigger
+	UnpinDigger    *datastore.AutoUnpinRecapTimeCapsuleDigger
+	ApprovalDigger *datastore.ApprovedAutoRecapTimeCapsuleDigger
+	Webhook        *webhook.Client
+	I18n           *i18n.I18n
 }
 
 type AutoRecapService struct {
+	config        *configs.Config
 	logger        *logger.Logger
 	botService    *tgbot.BotService
 	chathistories *chathistories.Model
 	tgchats       *tgchats.Model
+	tgusers       *tgusers.Model
+	webhook       *webhook.Client
+	i18n          *i18n.I18n
 
-	digger  *datastore.AutoRecapTimeCapsuleDigger
-	started bool
+	digger         *datastore.AutoRecapTimeCapsuleDigger
+	digestDigger   *datastore.PrivateRecapDigestTimeCapsuleDigger
+	unpinDigger    *datastore.AutoUnpinRecapTimeCapsuleDigger
+	approvalDigger *datastore.ApprovedAutoRecapTimeCapsuleDigger
+	started        bool
+
+	deliveryLimiters *recapDeliveryLimiters
 }
 
 func NewAutoRecapService() func(NewAutoRecapParams) (*AutoRecapService, error) {
 	return func(params NewAutoRecapParams) (*AutoRecapService, error) {
 		service := &AutoRecapService{
-			logger:        params.Logger,
-			botService:    params.Bot,
-			chathistories: params.ChatHistories,
-			tgchats:       params.TgChats,
-			digger:        params.Digger,
+			config:         params.Config,
+			logger:         params.Logger,
+			botService:     params.Bot,
+			chathistories:  params.ChatHistories,
+			tgchats:        params.TgChats,
+			tgusers:        params.TgUsers,
+			digger:         params.Digger,
+			digestDigger:   params.DigestDigger,
+			unpinDigger:    params.UnpinDigger,
+			approvalDigger: params.ApprovalDigger,
+			webhook:        params.Webhook,
+			i18n:           params.I18n,
+
+			deliveryLimiters: newRecapDeliveryLimiters(params.Config.Recap.DeliveryGroupRatePerSecond, params.Config.Recap.DeliveryPrivateRatePerSecond),
 		}
 
 		service.digger.SetHandler(service.sendChatHistoriesRecapTimeCapsuleHandler)
+		service.digestDigger.SetHandler(service.sendPrivateRecapDigestTimeCapsuleHandler)
+		service.unpinDigger.SetHandler(service.autoUnpinRecapTimeCapsuleHandler)
+		service.approvalDigger.SetHandler(service.approvedAutoRecapTimeCapsuleHandler)
 		service.tgchats.QueueSendChatHistoriesRecapTask()
 
 		// DEBUG: The following is a test feature for auto-recap, please manually fill in the chatID in production
@@ -94,6 +127,13 @@ func Run() func(service *AutoRecapService) {
 	}
 }
 
+// recapTargetChat is the chat or the private subscriber that the auto recap is
+// sent to.
+type recapTargetChat struct {
+	chatID              int64
+	isPrivateSubscriber bool
+}
+
 func (m *AutoRecapService) sendChatHistoriesRecapTimeCapsuleHandler(
 	digger *timecapsule.TimeCapsuleDigger[timecapsules.AutoRecapCapsule],
 	capsule *timecapsule.TimeCapsule[timecapsules.AutoRecapCapsule],
@@ -161,6 +201,34 @@ func (m *AutoRecapService) sendChatHistoriesRecapTimeCapsuleHandler(
 		m.logger.Error("failed to queue one send chat histories recap task for chat", zap.Int64("chat_id", capsule.Payload.ChatID), zap.Error(err))
 	}
 
+	if tgchats.IsAutoRecapsSnoozed(options, time.Now()) {
+		m.logger.Debug("chat histories recap snoozed, skipping...",
+			zap.Int64("chat_id", capsule.Payload.ChatID),
+			zap.Int64("snoozed_until", options.AutoRecapsSnoozedUntil),
+		)
+
+		return
+	}
+
+	// the task is requeued above, so that the auto recaps resume once the chat
+	// stops only collecting the chat histories
+	if tgchats.IsRecapCollectOnly(options) {
+		m.logger.Debug("chat histories recap is collect only, skipping...", zap.Int64("chat_id", capsule.Payload.ChatID))
+
+		return
+	}
+
+	// the task is requeued to the next enabled weekday above, this only
+	// happens when the task was scheduled before the weekdays were changed
+	if !m.tgchats.IsAutoRecapWeekdayAt(options, time.Now()) {
+		m.logger.Debug("chat histories recap disabled on today's weekday, skipping...",
+			zap.Int64("chat_id", capsule.Payload.ChatID),
+			zap.Any("recap_weekdays", options.RecapWeekdays),
+		)
+
+		return
+	}
+
 	if options != nil && tgchat.AutoRecapSendMode(options.AutoRecapSendMode) == tgchat.AutoRecapSendModeOnlyPrivateSubscriptions && len(subscribers) == 0 {
 		m.logger.Debug("chat histories recap send mode is only private subscriptions, but no subscribers, skipping...", zap.Int64("chat_id", capsule.Payload.ChatID))
 
@@ -173,6 +241,54 @@ func (m *AutoRecapService) sendChatHistoriesRecapTimeCapsuleHandler(
 	})
 }
 
+// mayNotifyQuietChat tells the chat that the auto recap was skipped because
+// the chat was quiet, the notice is opt-in and throttled per chat.
+func (m *AutoRecapService) mayNotifyQuietChat(chatID int64, chatTitle string, hours int, options *ent.TelegramChatRecapsOptions, subscribers []*ent.TelegramChatAutoRecapsSubscribers) {
+	now := time.Now()
+	if !tgchats.ShouldSendQuietNotice(options, now) {
+		return
+	}
+
+	err := m.tgchats.SetLastQuietNoticeAt(chatID, now.UnixMilli())
+	if err != nil {
+		m.logger.Error("failed to set last quiet notice at",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	if tgchat.AutoRecapSendMode(options.AutoRecapSendMode).PostsToChat() {
+		_, err = m.botService.Send(tgbotapi.NewMessage(tgchats.RecapTargetChatID(options, chatID), m.recapT(options, "quietNotice", i18n.M{"Hours": hours})))
+		if err != nil {
+			m.logger.Error("failed to send quiet notice",
+				zap.Int64("chat_id", chatID),
+				zap.String("module", "autorecap"),
+				zap.Error(err),
+			)
+		}
+
+		return
+	}
+
+	for _, subscriber := range subscribers {
+		msg := tgbotapi.NewMessage(subscriber.UserID, m.recapT(options, "quietNoticeForSubscriber", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle), "Hours": hours}))
+		msg.ParseMode = tgbotapi.ModeHTML
+
+		_, err = m.botService.Send(msg)
+		if err != nil {
+			m.logger.Error("failed to send quiet notice to subscriber",
+				zap.Int64("chat_id", chatID),
+				zap.Int64("user_id", subscriber.UserID),
+				zap.String("module", "autorecap"),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
 func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatRecapsOptions, subscribers []*ent.TelegramChatAutoRecapsSubscribers) {
 	m.logger.Info("generating chat histories recap for chat",
 		zap.Int64("chat_id", chatID),
@@ -198,50 +314,179 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 
 	chatType := telegram.ChatType(chat.Type)
 
-	mAutoRecapRatesPerDayHours := map[int]int{
-		4: 6,
-		3: 8,
-		2: 12,
+	hours := int(tgchats.AutoRecapWindowOfRatesPerDay(options.AutoRecapRatesPerDay).Hours())
+
+	windowUntil := time.Now()
+	windowSince := windowUntil.Add(-time.Duration(hours) * time.Hour)
+
+	histories, err := m.chathistories.FindChatHistoriesBetween(chatID, windowSince, windowUntil)
+	if err != nil {
+		m.logger.Error(fmt.Sprintf("failed to find last %d hour chat histories", hours),
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
+			zap.Error(err),
+		)
+
+		return
 	}
 
-	hours, ok := mAutoRecapRatesPerDayHours[options.AutoRecapRatesPerDay]
-	if !ok {
-		hours = 6
+	histories = chathistories.FilterBotChatHistories(histories, options.IncludeBotMessages)
+	histories = chathistories.FilterExcludedChatHistories(histories, tgchat.MessageTypes(options.ExcludedMessageTypes))
+	histories, activityCount := chathistories.FilterShortChatHistories(histories, options.MinMessageLengthForSummary, options.CountShortMessagesForActivity)
+	if activityCount <= 5 || len(histories) == 0 {
+		m.logger.Warn("no enough chat histories")
+		m.mayNotifyQuietChat(chatID, chat.Title, hours, options, subscribers)
+
+		return
 	}
 
-	mFindChatHistoriesHoursBefore := map[int]func(chatID int64) ([]*ent.ChatHistories, error){
-		6:  m.chathistories.FindLast6HourChatHistories,
-		8:  m.chathistories.FindLast8HourChatHistories,
-		12: m.chathistories.FindLast12HourChatHistories,
+	uniqueMessageRatio := chathistories.UniqueMessageRatioOfChatHistories(histories)
+
+	histories, flooded := m.chathistories.FilterFloodChatHistories(histories)
+	if flooded {
+		m.logger.Warn("chat histories look like a flood, only the distinct messages will be summarized",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Float64("unique_message_ratio", uniqueMessageRatio),
+			zap.Float64("flood_min_unique_ratio", m.config.Recap.FloodMinUniqueRatio),
+			zap.Int("distinct_messages", len(histories)),
+		)
+
+		if len(histories) <= 5 {
+			m.logger.Warn("no enough distinct chat histories in the flood, skipping...",
+				zap.Int64("chat_id", chatID),
+				zap.String("module", "autorecap"),
+			)
+
+			return
+		}
 	}
 
-	findChatHistories, ok := mFindChatHistoriesHoursBefore[hours]
-	if !ok {
-		findChatHistories = m.chathistories.FindLast6HourChatHistories
+	if !m.chathistories.IsChatHistoriesContentRichEnough(histories) {
+		m.logger.Warn("chat histories are not rich enough to be summarized, skipping...",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
+			zap.Float64("content_richness", chathistories.ContentRichnessOfChatHistories(histories)),
+			zap.Float64("min_content_richness", m.config.Recap.MinContentRichness),
+		)
+
+		return
+	}
+
+	chatTitle := histories[len(histories)-1].ChatTitle
+
+	recap, err := m.chathistories.GenerateChatHistoriesRecap(
+		chatID,
+		chatType,
+		histories,
+		chathistories.WithSummarizeChatHistoriesPersona(options.RecapPersona),
+		chathistories.WithSummarizeChatHistoriesTemperature(options.SummaryTemperature),
+		chathistories.WithSummarizeChatHistoriesMaxTokens(options.SummaryMaxTokens),
+		chathistories.WithSummarizeChatHistoriesOutputFormat(tgchat.RecapOutputFormat(options.RecapOutputFormat)),
+		chathistories.WithSummarizeChatHistoriesLanguages(tgchats.ParseSummaryLanguages(options.SummaryLanguages)),
+		chathistories.WithSummarizeChatHistoriesTopKeywords(options.TopKeywordsCount),
+		chathistories.WithSummarizeChatHistoriesRelatedMessages(options.RelatedMessagesCount),
+		chathistories.WithSummarizeChatHistoriesIncrementalRecap(options.IncrementalRecap),
+		chathistories.WithSummarizeChatHistoriesDedupForwards(options.DedupForwards),
+		chathistories.WithSummarizeChatHistoriesAnonymizeParticipants(options.AnonymizeParticipants),
+		chathistories.WithSummarizeChatHistoriesWindow(hours, true),
+	)
+	if errors.Is(err, openai.ErrCircuitBreakerOpen) {
+		retryAt := time.Now().Add(time.Duration(m.config.OpenAI.CircuitBreakerCooldownSeconds) * time.Second)
+
+		m.logger.Warn("openai circuit breaker is open, skipping and retrying later...",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
+			zap.Time("retry_at", retryAt),
+		)
+
+		err = m.tgchats.QueueRetrySendChatHistoriesRecapTaskForChatID(chatID, options, retryAt)
+		if err != nil {
+			m.logger.Error("failed to queue retry send chat histories recap task for chat",
+				zap.Int64("chat_id", chatID),
+				zap.String("module", "autorecap"),
+				zap.Error(err),
+			)
+		}
+
+		return
+	}
+
+	if errors.Is(err, chathistories.ErrChatHistoriesRecapFlagged) {
+		m.logger.Warn("chat histories recap is flagged by moderation, dropping...",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
+		)
+
+		return
 	}
 
-	histories, err := findChatHistories(chatID)
 	if err != nil {
-		m.logger.Error(fmt.Sprintf("failed to find last %d hour chat histories", hours),
+		m.logger.Error(fmt.Sprintf("failed to summarize last %d hour chat histories", hours),
 			zap.Int64("chat_id", chatID),
 			zap.String("module", "autorecap"),
 			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
 			zap.Error(err),
 		)
 
+		saveErr := m.chathistories.SaveOneChatHistoriesRecapFailure(chatID, hours, windowSince, windowUntil, true, err)
+		if saveErr != nil {
+			m.logger.Error("failed to save chat histories recap failure",
+				zap.Int64("chat_id", chatID),
+				zap.String("module", "autorecap"),
+				zap.Error(saveErr),
+			)
+		}
+
 		return
 	}
 
-	if len(histories) <= 5 {
-		m.logger.Warn("no enough chat histories")
+	if recap.HeldForModeration {
+		m.holdChatHistoriesRecapForModeration(chatID, chatTitle, chatType, recap, options)
+
 		return
 	}
 
-	chatTitle := histories[len(histories)-1].ChatTitle
+	duplicated, similarity, err := m.chathistories.IsChatHistoriesRecapDuplicated(chatID, recap.Summarizations)
+	if err != nil {
+		m.logger.Error("failed to compare recap with the previous recap of the chat",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
+			zap.Error(err),
+		)
+	}
+
+	if duplicated {
+		m.logger.Warn("recap is too similar to the previous recap of the chat, skipping...",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
+			zap.Float64("similarity", similarity),
+			zap.Float64("duplicate_similarity_threshold", m.config.Recap.DuplicateSimilarityThreshold),
+		)
+
+		return
+	}
+
+	if options.ApprovalRequired {
+		m.holdChatHistoriesRecapForApproval(chatID, chatTitle, chatType, recap, options)
 
-	logID, summarizations, err := m.chathistories.SummarizeChatHistories(chatID, chatType, histories)
+		return
+	}
+
+	m.publish(chatID, chatTitle, chatType, recap, options, subscribers)
+}
+
+// publish saves the recap and sends it to the chat and the subscribers.
+func (m *AutoRecapService) publish(chatID int64, chatTitle string, chatType telegram.ChatType, recap *chathistories.ChatHistoriesRecap, options *ent.TelegramChatRecapsOptions, subscribers []*ent.TelegramChatAutoRecapsSubscribers) {
+	logID, err := m.chathistories.SaveOneChatHistoriesRecap(recap)
 	if err != nil {
-		m.logger.Error(fmt.Sprintf("failed to summarize last %d hour chat histories", hours),
+		m.logger.Error("failed to save chat histories recap log",
 			zap.Int64("chat_id", chatID),
 			zap.String("module", "autorecap"),
 			zap.Int("auto_recap_rates", options.AutoRecapRatesPerDay),
@@ -251,6 +496,8 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 		return
 	}
 
+	summarizations := recap.Summarizations
+
 	counts, err := m.chathistories.FindFeedbackRecapsReactionCountsForChatIDAndLogID(chatID, logID)
 	if err != nil {
 		m.logger.Error("failed to find feedback recaps votes for chat",
@@ -276,7 +523,7 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 		return
 	}
 
-	summarizations = lo.Filter(summarizations, func(item string, _ int) bool { return item != "" })
+	summarizations = recaprender.RenderSummariesToHTML(summarizations)
 	if len(summarizations) == 0 {
 		m.logger.Warn("summarization is empty",
 			zap.Int64("chat_id", chatID),
@@ -287,24 +534,20 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 		return
 	}
 
-	for i, s := range summarizations {
-		summarizations[i] = tgbot.ReplaceMarkdownTitlesToTelegramBoldElement(s)
-	}
+	// one message for each topic if per topic messages is enabled, the topics
+	// after the first one are sent as replies to the first one so that they
+	// are threaded together
+	summarizationBatches := recaprender.SplitIntoPages(summarizations, options.PerTopicMessages)
 
-	summarizationBatches := tgbot.SplitMessagesAgainstLengthLimitIntoMessageGroups(summarizations)
+	chatLanguage := m.tgchats.FindRecapLanguageForGroups(chatID)
+	language := tgchats.RecapDisplayLanguage(chatLanguage, options)
+	texts := recaprender.NewTexts(m.i18n, tgchats.RecapLocale(options))
 
-	limiter := ratelimit.New(5)
+	targetChats := make([]recapTargetChat, 0)
 
-	type targetChat struct {
-		chatID              int64
-		isPrivateSubscriber bool
-	}
-
-	targetChats := make([]targetChat, 0)
-
-	if options == nil || tgchat.AutoRecapSendMode(options.AutoRecapSendMode) == tgchat.AutoRecapSendModePublicly {
-		targetChats = append(targetChats, targetChat{
-			chatID:              chatID,
+	if options == nil || tgchat.AutoRecapSendMode(options.AutoRecapSendMode).PostsToChat() {
+		targetChats = append(targetChats, recapTargetChat{
+			chatID:              tgchats.RecapTargetChatID(options, chatID),
 			isPrivateSubscriber: false,
 		})
 	}
@@ -362,7 +605,7 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 				)
 			}
 
-			msg := tgbotapi.NewMessage(subscriber.UserID, fmt.Sprintf("由于您已不再是 <b>%s</b> 的成员，因此已自动帮您取消了您所订阅的聊天记录回顾。", tgbot.EscapeHTMLSymbols(chatTitle)))
+			msg := tgbotapi.NewMessage(subscriber.UserID, m.recapT(options, "memberLeftUnsubscribed", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle)}))
 			msg.ParseMode = tgbotapi.ModeHTML
 
 			_, err = m.botService.Send(msg)
@@ -378,40 +621,55 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 			continue
 		}
 
-		targetChats = append(targetChats, targetChat{
+		targetChats = append(targetChats, recapTargetChat{
 			chatID:              subscriber.UserID,
 			isPrivateSubscriber: true,
 		})
 	}
 
-	for i, b := range summarizationBatches {
-		var content string
+	targetChats = m.queueBatchedPrivateRecaps(chatID, chatTitle, targetChats, tgusers.PrivateRecapDigestItem{
+		ChatID:         chatID,
+		ChatTitle:      chatTitle,
+		Header:         tgchats.FormatRecapDisclaimer(options) + m.chathistories.FormatChatHistoriesChattedAtRange(recap.EarliestChattedAt, recap.LatestChattedAt, tgchats.RecapDisplayLanguage(chatLanguage, nil)),
+		Summarizations: summarizations,
+		Locale:         tgchats.RecapLocale(options),
+		CreatedAt:      time.Now().UnixMilli(),
+	})
 
-		text := fmt.Sprintf("<blockquote expandable>%s</blockquote>", strings.Join(b, "\n\n"))
+	contents := lo.Map(summarizationBatches, func(b []string, i int) string {
+		return recaprender.BuildTelegramMessage(b, recaprender.MessageOptions{
+			Header:   tgchats.FormatRecapDisclaimer(options) + m.chathistories.FormatChatHistoriesChattedAtRange(recap.EarliestChattedAt, recap.LatestChattedAt, language),
+			ChatType: chatType,
+			Page:     i + 1,
+			Pages:    len(summarizationBatches),
+			Hashtags: m.config.Recap.AutoHashtags,
+			Texts:    texts,
+		})
+	})
 
-		if len(summarizationBatches) > 1 {
-			content = fmt.Sprintf("%s\n\n(%d/%d)\n%s#recap #recap_auto\n<em>🤖️ Generated by chatGPT</em>",
-				text,
-				i+1,
-				len(summarizationBatches),
-				lo.Ternary(chatType == telegram.ChatTypeGroup, "\n<b>Tips: </b>由于群组不是超级群组（supergroup），因此消息链接引用暂时被禁用了，如果希望使用该功能，请通过短时间内将群组开放为公共群组并还原回私有群组，或通过其他操作将本群组升级为超级群组后，该功能方可恢复正常运作。\n\n", ""),
-			)
-		} else {
-			content = fmt.Sprintf("%s\n\n%s#recap #recap_auto\n<em>🤖️ Generated by chatGPT</em>",
-				text,
-				lo.Ternary(chatType == telegram.ChatTypeGroup, "<b>Tips: </b>由于群组不是超级群组（supergroup），因此消息链接引用暂时被禁用了，如果希望使用该功能，请通过短时间内将群组开放为公共群组并还原回私有群组，或通过其他操作将本群组升级为超级群组后，该功能方可恢复正常运作。\n\n", ""),
-			)
-		}
+	digest := recaprender.BuildDigestOnlyMessage(summarizations, recaprender.MessageOptions{
+		Header:   tgchats.FormatRecapDisclaimer(options) + m.chathistories.FormatChatHistoriesChattedAtRange(recap.EarliestChattedAt, recap.LatestChattedAt, language),
+		ChatType: chatType,
+		Hashtags: m.config.Recap.AutoHashtags,
+		Texts:    texts,
+	})
+
+	document := recaprender.BuildMarkdownDocument(chatTitle, tgchats.FormatRecapDisclaimer(options)+m.chathistories.FormatChatHistoriesChattedAtRange(recap.EarliestChattedAt, recap.LatestChattedAt, language), recap.Summarizations)
+	documentFileName := recaprender.DocumentFileName(time.Now())
 
-		for _, targetChat := range targetChats {
-			limiter.Take()
+	// the pages are sent to each of the target chats in order, while the
+	// target chats are sent to in parallel within the delivery rate limits
+	deliverToTargetChats(targetChats, m.config.Recap.DeliveryConcurrency, func(targetChat recapTargetChat) {
+		var firstMessageID int
+
+		for i, content := range recapContentsForTargetChat(targetChat, options, contents, digest) {
+			m.deliveryLimiters.take(targetChat)
 			m.logger.Info("sending chat histories recap for chat", zap.Int64("summarized_for_chat_id", chatID), zap.Int64("sending_target_chat_id", targetChat.chatID))
 
-			msg := tgbotapi.NewMessage(targetChat.chatID, "")
-			msg.ParseMode = tgbotapi.ModeHTML
+			msg := recaprender.NewMessage(targetChat.chatID, "", tgchats.RecapLinkPreviewEnabled(options))
 
 			if targetChat.isPrivateSubscriber {
-				msg.Text = fmt.Sprintf("您好，这是您订阅的 <b>%s</b> 群组的定时聊天回顾。\n\n%s", tgbot.EscapeHTMLSymbols(chatTitle), content)
+				msg.Text = m.recapT(options, "privateSubscriptionHeader", i18n.M{"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle)}) + "\n\n" + content
 
 				inlineKeyboardMarkup, err := m.chathistories.NewVoteRecapWithUnsubscribeInlineKeyboardMarkup(m.botService.Bot(), chatID, chatTitle, targetChat.chatID, logID, counts.UpVotes, counts.DownVotes, counts.Lmao)
 				if err != nil {
@@ -430,7 +688,11 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 				msg.ReplyMarkup = inlineKeyboardMarkup
 			}
 
-			sentMsg, err := m.botService.Send(msg)
+			if options.PerTopicMessages && i != 0 {
+				msg.ReplyToMessageID = firstMessageID
+			}
+
+			sentMsg, err := m.botService.Bot().SendThreadMessageWithFloodControl(newRecapThreadMessage(targetChat, msg, options))
 			if err != nil {
 				m.logger.Error("failed to send chat histories recap",
 					zap.Int64("chat_id", chatID),
@@ -439,8 +701,13 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 				)
 			}
 
-			// Check whether the first message of the batch needs to be pinned, if not, skip the pinning process
-			if i != 0 || !options.PinAutoRecapMessage {
+			if i == 0 {
+				firstMessageID = sentMsg.MessageID
+			}
+
+			// Check whether the first message of the batch needs to be pinned, if not, skip the pinning process,
+			// recaps sent to private subscribers are never pinned
+			if i != 0 || !options.PinAutoRecapMessage || targetChat.isPrivateSubscriber {
 				err = m.chathistories.SaveOneTelegramSentMessage(&sentMsg, false)
 				if err != nil {
 					m.logger.Error("failed to save one telegram sent message",
@@ -451,47 +718,174 @@ func (m *AutoRecapService) summarize(chatID int64, options *ent.TelegramChatReca
 				continue // Use continue instead of return, so that the next message can be processed
 			}
 
-			may := fo.NewMay0().Use(func(err error, messageArgs ...any) {
-				if len(messageArgs) == 0 {
-					m.logger.Error(err.Error())
-					return
-				}
+			pinRecapMessage(m.chathistories, m.botService, m.unpinDigger, m.logger, targetChat.chatID, &sentMsg, options.PinAutoRecapMessageSilently, tgchats.AutoUnpinAfter(options))
+		}
 
-				prefix, _ := messageArgs[0].(string)
+		if firstMessageID != 0 && shouldAttachRecapDocument(targetChat, options) {
+			m.deliveryLimiters.take(targetChat)
+			m.sendRecapDocument(chatID, targetChat.chatID, firstMessageID, documentFileName, document)
+		}
+	})
 
-				if len(messageArgs) == 1 {
-					m.logger.Error(prefix, zap.Error(err))
-					return
-				}
+	m.webhook.PublishRecap(webhook.NewRecapPublishedPayload(chatID, logID, summarizations, webhook.RecapPublishedModeAuto))
+}
 
-				fields := make([]zap.Field, 0)
-				fields = append(fields, zap.Error(err))
+// sendRecapDocument attaches the full recap document to the first recap message
+// sent to the target chat, the recap message is left alone if the document
+// can not be sent.
+func (m *AutoRecapService) sendRecapDocument(chatID int64, targetChatID int64, replyToMessageID int, fileName string, content string) {
+	document, ok := recaprender.NewDocument(targetChatID, replyToMessageID, fileName, content)
+	if !ok {
+		m.logger.Warn("recap document exceeds the size limit, skipping...",
+			zap.Int64("chat_id", chatID),
+			zap.Int64("target_chat_id", targetChatID),
+			zap.Int("size", len(content)),
+		)
 
-				for i, v := range messageArgs[1:] {
-					field, ok := v.(zap.Field)
-					if !ok {
-						fields = append(fields, zap.Any(fmt.Sprintf("error_field_%d", i), field))
-					} else {
-						fields = append(fields, field)
-					}
-				}
+		return
+	}
 
-				m.logger.Error(prefix, fields...)
-			})
+	_, err := m.botService.Send(document)
+	if err != nil {
+		m.logger.Error("failed to send recap document",
+			zap.Int64("chat_id", chatID),
+			zap.Int64("target_chat_id", targetChatID),
+			zap.Error(err),
+		)
+	}
+}
 
-			// Unpin the last pinned message
-			lastPinnedMessage, err := m.chathistories.FindLastTelegramPinnedMessage(chatID)
+func (m *AutoRecapService) autoUnpinRecapTimeCapsuleHandler(
+	_ *timecapsule.TimeCapsuleDigger[timecapsules.AutoUnpinRecapCapsule],
+	capsule *timecapsule.TimeCapsule[timecapsules.AutoUnpinRecapCapsule],
+) {
+	m.logger.Debug("auto unpin recap time capsule handler invoked",
+		zap.Int64("chat_id", capsule.Payload.ChatID),
+		zap.Int("message_id", capsule.Payload.MessageID),
+	)
+
+	unpinScheduledRecapMessage(m.chathistories, m.botService, m.logger, capsule.Payload)
+}
+
+// holdChatHistoriesRecapForModeration holds the recap flagged by the moderation
+// for the approval of the administrators just like the chats requiring
+// approval, so that once any of them approved it, it is published to the chat
+// and the subscribers as usual.
+func (m *AutoRecapService) holdChatHistoriesRecapForModeration(chatID int64, chatTitle string, chatType telegram.ChatType, recap *chathistories.ChatHistoriesRecap, options *ent.TelegramChatRecapsOptions) {
+	summarizations := recaprender.RenderSummariesToHTML(recap.Summarizations)
+	if len(summarizations) == 0 {
+		return
+	}
+
+	approvalID, err := m.chathistories.SaveOneChatHistoriesRecapApproval(recap, time.Duration(m.config.Recap.ApprovalExpiryHours)*time.Hour)
+	if err != nil {
+		m.logger.Error("failed to save held chat histories recap for approval",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	m.logger.Warn("chat histories recap is held for moderation, sending to administrators...",
+		zap.Int64("chat_id", chatID),
+		zap.String("module", "autorecap"),
+		zap.String("approval_id", approvalID),
+	)
+
+	language := tgchats.RecapDisplayLanguage(m.tgchats.FindRecapLanguageForGroups(chatID), options)
+	header := m.recapT(options, "moderationHeldNotice", i18n.M{
+		"ChatTitle": tgbot.EscapeHTMLSymbols(chatTitle),
+		"Hours":     m.config.Recap.ApprovalExpiryHours,
+	}) + "\n\n" +
+		recap.Moderation.FormatWarning() +
+		tgchats.FormatRecapDisclaimer(options) +
+		m.chathistories.FormatChatHistoriesChattedAtRange(recap.EarliestChattedAt, recap.LatestChattedAt, language)
+
+	m.sendHeldRecapToAdministrators(chatID, chatType, header, summarizations, options, func(userID int64) (tgbotapi.InlineKeyboardMarkup, error) {
+		return m.chathistories.NewRecapApprovalInlineKeyboardMarkup(m.botService.Bot(), approvalID, chatID, userID, chathistories.NewRecapTexts(m.i18n, tgchats.RecapLocale(options)))
+	})
+}
+
+// sendHeldRecapToAdministrators sends the held recap to each of the
+// administrators of the chat in private, the inline keyboard is attached to
+// the last page.
+func (m *AutoRecapService) sendHeldRecapToAdministrators(
+	chatID int64,
+	chatType telegram.ChatType,
+	header string,
+	summarizations []string,
+	options *ent.TelegramChatRecapsOptions,
+	newInlineKeyboardMarkup func(userID int64) (tgbotapi.InlineKeyboardMarkup, error),
+) {
+	administrators, err := m.botService.GetChatAdministrators(tgbotapi.ChatAdministratorsConfig{
+		ChatConfig: tgbotapi.ChatConfig{
+			ChatID: chatID,
+		},
+	})
+	if err != nil {
+		m.logger.Error("failed to get chat administrators for held chat histories recap",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	pages := recaprender.SplitIntoPages(summarizations, false)
+
+	for _, administrator := range administrators {
+		if administrator.User == nil || administrator.User.IsBot {
+			continue
+		}
+
+		inlineKeyboardMarkup, err := newInlineKeyboardMarkup(administrator.User.ID)
+		if err != nil {
+			m.logger.Error("failed to create held recap inline keyboard markup",
+				zap.Int64("chat_id", chatID),
+				zap.String("module", "autorecap"),
+				zap.Error(err),
+			)
+
+			return
+		}
+
+		for i, page := range pages {
+			msg := recaprender.NewMessage(administrator.User.ID, recaprender.BuildTelegramMessage(page, recaprender.MessageOptions{
+				Header:   lo.Ternary(i == 0, header, ""),
+				ChatType: chatType,
+				Page:     i + 1,
+				Pages:    len(pages),
+				Hashtags: m.config.Recap.AutoHashtags,
+				Texts:    recaprender.NewTexts(m.i18n, tgchats.RecapLocale(options)),
+			}), tgchats.RecapLinkPreviewEnabled(options))
+
+			if i == len(pages)-1 {
+				msg.ReplyMarkup = inlineKeyboardMarkup
+			}
+
+			_, err = m.botService.Send(msg)
 			if err != nil {
-				m.logger.Error("failed to find last pinned message",
+				// administrators who never started a private chat with the bot
+				// can not be reached, the rest are still notified
+				m.logger.Warn("failed to send held chat histories recap to administrator",
 					zap.Int64("chat_id", chatID),
+					zap.Int64("user_id", administrator.User.ID),
+					zap.String("module", "autorecap"),
 					zap.Error(err),
 				)
-			}
 
-			may.Invoke(m.botService.UnpinChatMessage(tgbot.NewUnpinChatMessageConfig(chatID, lastPinnedMessage.MessageID)), "failed to unpin chat message", zap.Int64("chat_id", chatID), zap.Int("message_id", lastPinnedMessage.MessageID))
-			may.Invoke(m.chathistories.UpdatePinnedMessage(lastPinnedMessage.ChatID, lastPinnedMessage.MessageID, false), "failed to save one telegram sent message", zap.Int64("chat_id", lastPinnedMessage.ChatID), zap.Int("message_id", lastPinnedMessage.MessageID))
-			may.Invoke(m.botService.PinChatMessage(tgbot.NewPinChatMessageConfig(chatID, sentMsg.MessageID)), "failed to pin chat message", zap.Int64("chat_id", chatID), zap.Int("message_id", sentMsg.MessageID))
-			may.Invoke(m.chathistories.SaveOneTelegramSentMessage(&sentMsg, true), "failed to save one telegram sent message")
+				break
+			}
 		}
 	}
 }
+
+// recapT localizes the fixed string of the auto recaps under the
+// modules.telegram.recap key of the locales for the chat, see
+// tgchats.RecapLocale.
+func (m *AutoRecapService) recapT(options *ent.TelegramChatRecapsOptions, key string, args ...any) string {
+	return m.i18n.TWithLanguage(tgchats.RecapLocale(options), "modules.telegram.recap."+key, args...)
+}
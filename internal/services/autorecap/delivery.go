@@ -0,0 +1,91 @@
+package autorecap
+
+import (
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/ratelimit"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/models/tgchats"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+// recapDeliveryLimiters limits how fast the auto recaps are sent, the groups
+// and the private subscribers are limited separately since Telegram applies
+// different flood limits to them. The limiters are shared by all the recaps
+// so that the recaps of different chats sent at the same time don't exceed
+// the limits together.
+type recapDeliveryLimiters struct {
+	group   ratelimit.Limiter
+	private ratelimit.Limiter
+}
+
+func newRecapDeliveryLimiters(groupRatePerSecond, privateRatePerSecond int, opts ...ratelimit.Option) *recapDeliveryLimiters {
+	opts = append([]ratelimit.Option{ratelimit.WithoutSlack}, opts...)
+
+	return &recapDeliveryLimiters{
+		group:   ratelimit.New(lo.Ternary(groupRatePerSecond > 0, groupRatePerSecond, configs.DefaultRecapDeliveryGroupRatePerSecond), opts...),
+		private: ratelimit.New(lo.Ternary(privateRatePerSecond > 0, privateRatePerSecond, configs.DefaultRecapDeliveryPrivateRatePerSecond), opts...),
+	}
+}
+
+// take blocks until the next message is allowed to be sent to the target chat.
+func (l *recapDeliveryLimiters) take(targetChat recapTargetChat) {
+	if targetChat.isPrivateSubscriber {
+		l.private.Take()
+
+		return
+	}
+
+	l.group.Take()
+}
+
+// deliverToTargetChats calls deliver for each of the target chats, at most
+// concurrency target chats are delivered in parallel, and returns after all
+// of them are delivered.
+func deliverToTargetChats(targetChats []recapTargetChat, concurrency int, deliver func(targetChat recapTargetChat)) {
+	p := pool.New().WithMaxGoroutines(lo.Ternary(concurrency > 0, concurrency, 1))
+
+	for _, targetChat := range targetChats {
+		p.Go(func() {
+			deliver(targetChat)
+		})
+	}
+
+	p.Wait()
+}
+
+// newRecapThreadMessage sends the recap into the recap thread configured for
+// the chat, recaps sent to the private subscribers have no thread.
+func newRecapThreadMessage(targetChat recapTargetChat, msg tgbotapi.MessageConfig, options *ent.TelegramChatRecapsOptions) tgbot.ThreadMessageConfig {
+	if targetChat.isPrivateSubscriber {
+		return tgbot.NewThreadMessageConfig(msg, 0)
+	}
+
+	return tgbot.NewThreadMessageConfig(msg, tgchats.RecapThreadID(options))
+}
+
+// recapContentsForTargetChat returns the pages of the recap sent to the target
+// chat, the chat itself only receives the digest in the digest only send mode
+// while the private subscribers always receive the full recap.
+func recapContentsForTargetChat(targetChat recapTargetChat, options *ent.TelegramChatRecapsOptions, contents []string, digest string) []string {
+	if targetChat.isPrivateSubscriber || options == nil || tgchat.AutoRecapSendMode(options.AutoRecapSendMode) != tgchat.AutoRecapSendModeDigestOnly {
+		return contents
+	}
+
+	return []string{digest}
+}
+
+// shouldAttachRecapDocument reports whether the full recap document is attached
+// to the recap sent to the target chat, the chat itself never receives it in
+// the digest only send mode since it only receives the digest.
+func shouldAttachRecapDocument(targetChat recapTargetChat, options *ent.TelegramChatRecapsOptions) bool {
+	if options == nil || !options.RecapDocumentAttachment {
+		return false
+	}
+
+	return targetChat.isPrivateSubscriber || tgchat.AutoRecapSendMode(options.AutoRecapSendMode) != tgchat.AutoRecapSendModeDigestOnly
+}
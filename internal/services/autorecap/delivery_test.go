@@ -0,0 +1,173 @@
+package autorecap
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/ratelimit"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/types/tgchat"
+)
+
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func TestRecapDeliveryLimiters(t *testing.T) {
+	t.Run("RatesAreHonored", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(1700000000, 0)}
+		limiters := newRecapDeliveryLimiters(5, 25, ratelimit.WithClock(clock))
+
+		start := clock.Now()
+
+		for i := 0; i < 11; i++ {
+			limiters.take(recapTargetChat{chatID: -100123456789})
+		}
+
+		assert.Equal(t, 2*time.Second, clock.Now().Sub(start))
+
+		start = clock.Now()
+
+		for i := 0; i < 26; i++ {
+			limiters.take(recapTargetChat{chatID: 123456789, isPrivateSubscriber: true})
+		}
+
+		assert.Equal(t, time.Second, clock.Now().Sub(start))
+	})
+
+	t.Run("RatesAreConfigurable", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(1700000000, 0)}
+		limiters := newRecapDeliveryLimiters(1, 100, ratelimit.WithClock(clock))
+
+		start := clock.Now()
+
+		for i := 0; i < 3; i++ {
+			limiters.take(recapTargetChat{chatID: -100123456789})
+		}
+
+		assert.Equal(t, 2*time.Second, clock.Now().Sub(start))
+
+		start = clock.Now()
+
+		for i := 0; i < 101; i++ {
+			limiters.take(recapTargetChat{chatID: 123456789, isPrivateSubscriber: true})
+		}
+
+		assert.Equal(t, time.Second, clock.Now().Sub(start))
+	})
+
+	t.Run("DefaultsForInvalidRates", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(1700000000, 0)}
+		limiters := newRecapDeliveryLimiters(0, -1, ratelimit.WithClock(clock))
+
+		start := clock.Now()
+
+		for i := 0; i < 6; i++ {
+			limiters.take(recapTargetChat{chatID: -100123456789})
+		}
+
+		assert.Equal(t, time.Second, clock.Now().Sub(start))
+	})
+}
+
+func TestDeliverToTargetChats(t *testing.T) {
+	targetChats := make([]recapTargetChat, 0, 50)
+	for i := 0; i < 50; i++ {
+		targetChats = append(targetChats, recapTargetChat{chatID: int64(i), isPrivateSubscriber: true})
+	}
+
+	var (
+		mutex     sync.Mutex
+		delivered = make(map[int64]bool)
+		inFlight  atomic.Int32
+		maxFlight atomic.Int32
+	)
+
+	deliverToTargetChats(targetChats, 4, func(targetChat recapTargetChat) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			observed := maxFlight.Load()
+			if current <= observed || maxFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		delivered[targetChat.chatID] = true
+	})
+
+	assert.Len(t, delivered, 50)
+	assert.LessOrEqual(t, maxFlight.Load(), int32(4))
+	assert.Greater(t, maxFlight.Load(), int32(1))
+}
+
+func TestNewRecapThreadMessage(t *testing.T) {
+	options := &ent.TelegramChatRecapsOptions{RecapThreadID: 7}
+	msg := tgbotapi.NewMessage(-100123456789, "recap")
+
+	config := newRecapThreadMessage(recapTargetChat{chatID: -100123456789}, msg, options)
+	assert.Equal(t, 7, config.MessageThreadID)
+	assert.Equal(t, msg, config.MessageConfig)
+
+	config = newRecapThreadMessage(recapTargetChat{chatID: 123456789, isPrivateSubscriber: true}, tgbotapi.NewMessage(123456789, "recap"), options)
+	assert.Zero(t, config.MessageThreadID)
+
+	config = newRecapThreadMessage(recapTargetChat{chatID: -100123456789}, msg, &ent.TelegramChatRecapsOptions{})
+	assert.Zero(t, config.MessageThreadID)
+}
+
+func TestRecapContentsForTargetChat(t *testing.T) {
+	contents := []string{"page 1", "page 2"}
+	group := recapTargetChat{chatID: -100123456789}
+	subscriber := recapTargetChat{chatID: 123456789, isPrivateSubscriber: true}
+
+	digestOnly := &ent.TelegramChatRecapsOptions{AutoRecapSendMode: int(tgchat.AutoRecapSendModeDigestOnly)}
+	assert.Equal(t, []string{"digest"}, recapContentsForTargetChat(group, digestOnly, contents, "digest"))
+	assert.Equal(t, contents, recapContentsForTargetChat(subscriber, digestOnly, contents, "digest"))
+
+	publicly := &ent.TelegramChatRecapsOptions{AutoRecapSendMode: int(tgchat.AutoRecapSendModePublicly)}
+	assert.Equal(t, contents, recapContentsForTargetChat(group, publicly, contents, "digest"))
+	assert.Equal(t, contents, recapContentsForTargetChat(group, nil, contents, "digest"))
+}
+
+func TestShouldAttachRecapDocument(t *testing.T) {
+	group := recapTargetChat{chatID: -100123456789}
+	subscriber := recapTargetChat{chatID: 123456789, isPrivateSubscriber: true}
+
+	enabled := &ent.TelegramChatRecapsOptions{RecapDocumentAttachment: true}
+	assert.True(t, shouldAttachRecapDocument(group, enabled))
+	assert.True(t, shouldAttachRecapDocument(subscriber, enabled))
+
+	digestOnly := &ent.TelegramChatRecapsOptions{RecapDocumentAttachment: true, AutoRecapSendMode: int(tgchat.AutoRecapSendModeDigestOnly)}
+	assert.False(t, shouldAttachRecapDocument(group, digestOnly))
+	assert.True(t, shouldAttachRecapDocument(subscriber, digestOnly))
+
+	assert.False(t, shouldAttachRecapDocument(group, &ent.TelegramChatRecapsOptions{}))
+	assert.False(t, shouldAttachRecapDocument(group, nil))
+}
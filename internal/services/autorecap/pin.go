@@ -0,0 +1,151 @@
+package autorecap
+
+import (
+	"context"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/logger"
+	"github.com/nekomeowww/insights-bot/pkg/types/timecapsules"
+)
+
+type pinnedMessageStore interface {
+	FindLastTelegramPinnedMessage(chatID int64) (*ent.SentMessages, error)
+	UpdatePinnedMessage(chatID int64, messageID int, isPinned bool) error
+	SaveOneTelegramSentMessage(message *tgbotapi.Message, isPinned bool) error
+}
+
+type messagePinner interface {
+	PinChatMessage(config tgbot.PinChatMessageConfig) error
+	UnpinChatMessage(config tgbot.UnpinChatMessageConfig) error
+}
+
+type unpinScheduler interface {
+	BuryFor(ctx context.Context, payload timecapsules.AutoUnpinRecapCapsule, forTimeRange time.Duration) error
+}
+
+// pinRecapMessage unpins the recap message pinned last time in the chat if
+// there is one, and pins the sent recap message instead, the unpinning of it
+// is scheduled if autoUnpinAfter is set. The failures are logged and the rest
+// of the steps still go on.
+func pinRecapMessage(
+	store pinnedMessageStore,
+	pinner messagePinner,
+	scheduler unpinScheduler,
+	logger *logger.Logger,
+	chatID int64,
+	sentMsg *tgbotapi.Message,
+	silently bool,
+	autoUnpinAfter time.Duration,
+) {
+	lastPinnedMessage, err := store.FindLastTelegramPinnedMessage(chatID)
+	if err != nil {
+		logger.Error("failed to find last pinned message",
+			zap.Int64("chat_id", chatID),
+			zap.Error(err),
+		)
+	}
+
+	// nothing to unpin if nothing was pinned before
+	if lastPinnedMessage != nil && lastPinnedMessage.MessageID != 0 {
+		err = pinner.UnpinChatMessage(tgbot.NewUnpinChatMessageConfig(chatID, lastPinnedMessage.MessageID))
+		if err != nil {
+			logger.Error("failed to unpin chat message",
+				zap.Int64("chat_id", chatID),
+				zap.Int("message_id", lastPinnedMessage.MessageID),
+				zap.Error(err),
+			)
+		}
+
+		err = store.UpdatePinnedMessage(lastPinnedMessage.ChatID, lastPinnedMessage.MessageID, false)
+		if err != nil {
+			logger.Error("failed to save one telegram sent message",
+				zap.Int64("chat_id", lastPinnedMessage.ChatID),
+				zap.Int("message_id", lastPinnedMessage.MessageID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	err = pinner.PinChatMessage(tgbot.NewPinChatMessageConfig(chatID, sentMsg.MessageID, silently))
+	if err != nil {
+		logger.Error("failed to pin chat message",
+			zap.Int64("chat_id", chatID),
+			zap.Int("message_id", sentMsg.MessageID),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	err = store.SaveOneTelegramSentMessage(sentMsg, true)
+	if err != nil {
+		logger.Error("failed to save one telegram sent message",
+			zap.Int64("chat_id", chatID),
+			zap.Error(err),
+		)
+	}
+
+	if autoUnpinAfter <= 0 {
+		return
+	}
+
+	err = scheduler.BuryFor(context.Background(), timecapsules.AutoUnpinRecapCapsule{ChatID: chatID, MessageID: sentMsg.MessageID}, autoUnpinAfter)
+	if err != nil {
+		logger.Error("failed to schedule unpinning of the recap message",
+			zap.Int64("chat_id", chatID),
+			zap.Int("message_id", sentMsg.MessageID),
+			zap.Duration("auto_unpin_after", autoUnpinAfter),
+			zap.Error(err),
+		)
+	}
+}
+
+// unpinScheduledRecapMessage unpins the recap message whose unpinning was
+// scheduled. The scheduled unpinning is cancelled if the message is no longer
+// the last pinned one in the chat, such as a newer recap has been pinned and
+// replaced it already.
+func unpinScheduledRecapMessage(store pinnedMessageStore, pinner messagePinner, logger *logger.Logger, capsule timecapsules.AutoUnpinRecapCapsule) {
+	lastPinnedMessage, err := store.FindLastTelegramPinnedMessage(capsule.ChatID)
+	if err != nil {
+		logger.Error("failed to find last pinned message",
+			zap.Int64("chat_id", capsule.ChatID),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	if lastPinnedMessage == nil || lastPinnedMessage.MessageID != capsule.MessageID {
+		logger.Debug("recap message has been replaced or unpinned already, skipping scheduled unpinning",
+			zap.Int64("chat_id", capsule.ChatID),
+			zap.Int("message_id", capsule.MessageID),
+		)
+
+		return
+	}
+
+	err = pinner.UnpinChatMessage(tgbot.NewUnpinChatMessageConfig(capsule.ChatID, capsule.MessageID))
+	if err != nil {
+		logger.Error("failed to unpin chat message",
+			zap.Int64("chat_id", capsule.ChatID),
+			zap.Int("message_id", capsule.MessageID),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	err = store.UpdatePinnedMessage(capsule.ChatID, capsule.MessageID, false)
+	if err != nil {
+		logger.Error("failed to update pinned message",
+			zap.Int64("chat_id", capsule.ChatID),
+			zap.Int("message_id", capsule.MessageID),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,163 @@
+package autorecap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/ent"
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
+	"github.com/nekomeowww/insights-bot/pkg/types/timecapsules"
+)
+
+type fakePinnedMessageStore struct {
+	lastPinnedMessage *ent.SentMessages
+	unpinnedMessages  []int
+	savedMessages     map[int]bool
+}
+
+func (s *fakePinnedMessageStore) FindLastTelegramPinnedMessage(_ int64) (*ent.SentMessages, error) {
+	return s.lastPinnedMessage, nil
+}
+
+func (s *fakePinnedMessageStore) UpdatePinnedMessage(_ int64, messageID int, isPinned bool) error {
+	if !isPinned {
+		s.unpinnedMessages = append(s.unpinnedMessages, messageID)
+	}
+
+	return nil
+}
+
+func (s *fakePinnedMessageStore) SaveOneTelegramSentMessage(message *tgbotapi.Message, isPinned bool) error {
+	s.savedMessages[message.MessageID] = isPinned
+
+	return nil
+}
+
+type fakeMessagePinner struct {
+	pinned   []int
+	unpinned []int
+}
+
+func (p *fakeMessagePinner) PinChatMessage(config tgbot.PinChatMessageConfig) error {
+	p.pinned = append(p.pinned, config.MessageID)
+
+	return nil
+}
+
+func (p *fakeMessagePinner) UnpinChatMessage(config tgbot.UnpinChatMessageConfig) error {
+	p.unpinned = append(p.unpinned, config.MessageID)
+
+	return nil
+}
+
+type scheduledUnpin struct {
+	capsule timecapsules.AutoUnpinRecapCapsule
+	after   time.Duration
+}
+
+type fakeUnpinScheduler struct {
+	scheduled []scheduledUnpin
+}
+
+func (s *fakeUnpinScheduler) BuryFor(_ context.Context, payload timecapsules.AutoUnpinRecapCapsule, forTimeRange time.Duration) error {
+	s.scheduled = append(s.scheduled, scheduledUnpin{capsule: payload, after: forTimeRange})
+
+	return nil
+}
+
+func TestPinRecapMessage(t *testing.T) {
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: configs.NewTestConfig()()})
+	require.NoError(t, err)
+
+	t.Run("NoPreviousPinnedMessage", func(t *testing.T) {
+		store := &fakePinnedMessageStore{savedMessages: make(map[int]bool)}
+		pinner := &fakeMessagePinner{}
+		scheduler := &fakeUnpinScheduler{}
+
+		pinRecapMessage(store, pinner, scheduler, logger, -100123456789, &tgbotapi.Message{MessageID: 42}, true, 0)
+
+		assert.Empty(t, pinner.unpinned)
+		assert.Empty(t, store.unpinnedMessages)
+		assert.Equal(t, []int{42}, pinner.pinned)
+		assert.Equal(t, map[int]bool{42: true}, store.savedMessages)
+		assert.Empty(t, scheduler.scheduled)
+	})
+
+	t.Run("UnpinsPreviousPinnedMessage", func(t *testing.T) {
+		store := &fakePinnedMessageStore{
+			lastPinnedMessage: &ent.SentMessages{ChatID: -100123456789, MessageID: 41},
+			savedMessages:     make(map[int]bool),
+		}
+		pinner := &fakeMessagePinner{}
+		scheduler := &fakeUnpinScheduler{}
+
+		pinRecapMessage(store, pinner, scheduler, logger, -100123456789, &tgbotapi.Message{MessageID: 42}, true, 0)
+
+		assert.Equal(t, []int{41}, pinner.unpinned)
+		assert.Equal(t, []int{41}, store.unpinnedMessages)
+		assert.Equal(t, []int{42}, pinner.pinned)
+		assert.Equal(t, map[int]bool{42: true}, store.savedMessages)
+		assert.Empty(t, scheduler.scheduled)
+	})
+
+	t.Run("SchedulesUnpin", func(t *testing.T) {
+		store := &fakePinnedMessageStore{savedMessages: make(map[int]bool)}
+		pinner := &fakeMessagePinner{}
+		scheduler := &fakeUnpinScheduler{}
+
+		pinRecapMessage(store, pinner, scheduler, logger, -100123456789, &tgbotapi.Message{MessageID: 42}, true, 12*time.Hour)
+
+		assert.Equal(t, []int{42}, pinner.pinned)
+		assert.Equal(t, []scheduledUnpin{{
+			capsule: timecapsules.AutoUnpinRecapCapsule{ChatID: -100123456789, MessageID: 42},
+			after:   12 * time.Hour,
+		}}, scheduler.scheduled)
+	})
+}
+
+func TestUnpinScheduledRecapMessage(t *testing.T) {
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: configs.NewTestConfig()()})
+	require.NoError(t, err)
+
+	t.Run("UnpinsStillPinnedMessage", func(t *testing.T) {
+		store := &fakePinnedMessageStore{
+			lastPinnedMessage: &ent.SentMessages{ChatID: -100123456789, MessageID: 42},
+			savedMessages:     make(map[int]bool),
+		}
+		pinner := &fakeMessagePinner{}
+
+		unpinScheduledRecapMessage(store, pinner, logger, timecapsules.AutoUnpinRecapCapsule{ChatID: -100123456789, MessageID: 42})
+
+		assert.Equal(t, []int{42}, pinner.unpinned)
+		assert.Equal(t, []int{42}, store.unpinnedMessages)
+	})
+
+	t.Run("SkipsReplacedMessage", func(t *testing.T) {
+		store := &fakePinnedMessageStore{
+			lastPinnedMessage: &ent.SentMessages{ChatID: -100123456789, MessageID: 43},
+			savedMessages:     make(map[int]bool),
+		}
+		pinner := &fakeMessagePinner{}
+
+		unpinScheduledRecapMessage(store, pinner, logger, timecapsules.AutoUnpinRecapCapsule{ChatID: -100123456789, MessageID: 42})
+
+		assert.Empty(t, pinner.unpinned)
+		assert.Empty(t, store.unpinnedMessages)
+	})
+
+	t.Run("SkipsWhenNothingPinned", func(t *testing.T) {
+		store := &fakePinnedMessageStore{savedMessages: make(map[int]bool)}
+		pinner := &fakeMessagePinner{}
+
+		unpinScheduledRecapMessage(store, pinner, logger, timecapsules.AutoUnpinRecapCapsule{ChatID: -100123456789, MessageID: 42})
+
+		assert.Empty(t, pinner.unpinned)
+	})
+}
@@ -0,0 +1,145 @@
+package autorecap
+
+import (
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/nekomeowww/timecapsule/v2"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/bots/telegram/recaprender"
+	"github.com/nekomeowww/insights-bot/internal/models/tgusers"
+	"github.com/nekomeowww/insights-bot/pkg/types/timecapsules"
+)
+
+// splitBatchedRecapTargetChats splits the private subscribers who want their
+// private recaps batched into the daily digest out of the target chats, the
+// rest are sent immediately.
+func splitBatchedRecapTargetChats(targetChats []recapTargetChat, batchedUserIDs []int64) (immediate []recapTargetChat, batched []recapTargetChat) {
+	return lo.FilterReject(targetChats, func(targetChat recapTargetChat, _ int) bool {
+		return !targetChat.isPrivateSubscriber || !lo.Contains(batchedUserIDs, targetChat.chatID)
+	})
+}
+
+// queueBatchedPrivateRecaps queues the recap into the private recap digests of
+// the subscribers who want their private recaps batched, and returns the
+// target chats to send the recap immediately. The recap is sent immediately
+// if the preferences of the subscribers failed to be found.
+func (m *AutoRecapService) queueBatchedPrivateRecaps(chatID int64, chatTitle string, targetChats []recapTargetChat, item tgusers.PrivateRecapDigestItem) []recapTargetChat {
+	privateSubscriberIDs := lo.FilterMap(targetChats, func(targetChat recapTargetChat, _ int) (int64, bool) {
+		return targetChat.chatID, targetChat.isPrivateSubscriber
+	})
+	if len(privateSubscriberIDs) == 0 {
+		return targetChats
+	}
+
+	batchedUserIDs, err := m.tgusers.FindBatchPrivateRecapsUserIDs(privateSubscriberIDs)
+	if err != nil {
+		m.logger.Error("failed to find subscribers who batch private recaps, sending immediately...",
+			zap.Int64("chat_id", chatID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+
+		return targetChats
+	}
+
+	immediate, batched := splitBatchedRecapTargetChats(targetChats, batchedUserIDs)
+
+	for _, targetChat := range batched {
+		err = m.tgusers.QueuePrivateRecapDigestItem(targetChat.chatID, item)
+		if err != nil {
+			m.logger.Error("failed to queue recap into private recap digest, sending immediately...",
+				zap.Int64("chat_id", chatID),
+				zap.String("chat_title", chatTitle),
+				zap.Int64("user_id", targetChat.chatID),
+				zap.String("module", "autorecap"),
+				zap.Error(err),
+			)
+
+			immediate = append(immediate, targetChat)
+		}
+	}
+
+	return immediate
+}
+
+func (m *AutoRecapService) sendPrivateRecapDigestTimeCapsuleHandler(
+	digger *timecapsule.TimeCapsuleDigger[timecapsules.PrivateRecapDigestCapsule],
+	capsule *timecapsule.TimeCapsule[timecapsules.PrivateRecapDigestCapsule],
+) {
+	userID := capsule.Payload.UserID
+
+	items, count, err := m.tgusers.FindPrivateRecapDigestItems(userID)
+	if err != nil {
+		m.logger.Error("failed to find private recap digest items",
+			zap.Int64("user_id", userID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+
+		m.retryPrivateRecapDigestLater(userID)
+
+		return
+	}
+
+	texts := recaprender.Texts{}
+	if len(items) > 0 {
+		texts = recaprender.NewTexts(m.i18n, items[0].Locale)
+	}
+
+	messages := recaprender.BuildPrivateRecapDigestMessages(lo.Map(items, func(item tgusers.PrivateRecapDigestItem, _ int) recaprender.DigestSection {
+		return recaprender.DigestSection{
+			ChatTitle:      item.ChatTitle,
+			Header:         item.Header,
+			Summarizations: item.Summarizations,
+		}
+	}), m.config.Recap.AutoHashtags, texts)
+
+	m.logger.Info("sending private recap digest",
+		zap.Int64("user_id", userID),
+		zap.Int("recaps", len(items)),
+		zap.String("module", "autorecap"),
+	)
+
+	for _, text := range messages {
+		m.deliveryLimiters.take(recapTargetChat{chatID: userID, isPrivateSubscriber: true})
+
+		msg := tgbotapi.NewMessage(userID, text)
+		msg.ParseMode = tgbotapi.ModeHTML
+
+		_, err = m.botService.Bot().SendWithFloodControl(msg)
+		if err != nil {
+			m.logger.Error("failed to send private recap digest, retrying later...",
+				zap.Int64("user_id", userID),
+				zap.String("module", "autorecap"),
+				zap.Error(err),
+			)
+
+			m.retryPrivateRecapDigestLater(userID)
+
+			return
+		}
+	}
+
+	err = m.tgusers.RemovePrivateRecapDigestItems(userID, count)
+	if err != nil {
+		m.logger.Error("failed to remove delivered private recap digest items",
+			zap.Int64("user_id", userID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+	}
+}
+
+// retryPrivateRecapDigestLater keeps the queued recaps and schedules the
+// private recap digest of the user to be delivered again later.
+func (m *AutoRecapService) retryPrivateRecapDigestLater(userID int64) {
+	err := m.tgusers.RetryPrivateRecapDigestLater(userID)
+	if err != nil {
+		m.logger.Error("failed to schedule private recap digest retry",
+			zap.Int64("user_id", userID),
+			zap.String("module", "autorecap"),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package autorecap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitBatchedRecapTargetChats(t *testing.T) {
+	targetChats := []recapTargetChat{
+		{chatID: -100123456789},
+		{chatID: 1, isPrivateSubscriber: true},
+		{chatID: 2, isPrivateSubscriber: true},
+		{chatID: 3, isPrivateSubscriber: true},
+	}
+
+	t.Run("Immediate", func(t *testing.T) {
+		immediate, batched := splitBatchedRecapTargetChats(targetChats, nil)
+		assert.Equal(t, targetChats, immediate)
+		assert.Empty(t, batched)
+	})
+
+	t.Run("Batched", func(t *testing.T) {
+		immediate, batched := splitBatchedRecapTargetChats(targetChats, []int64{2, 3})
+		assert.Equal(t, []recapTargetChat{{chatID: -100123456789}, {chatID: 1, isPrivateSubscriber: true}}, immediate)
+		assert.Equal(t, []recapTargetChat{{chatID: 2, isPrivateSubscriber: true}, {chatID: 3, isPrivateSubscriber: true}}, batched)
+	})
+
+	t.Run("GroupIsNeverBatched", func(t *testing.T) {
+		immediate, batched := splitBatchedRecapTargetChats(targetChats[:1], []int64{-100123456789})
+		assert.Equal(t, targetChats[:1], immediate)
+		assert.Empty(t, batched)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/nekomeowww/insights-bot/internal/services/autorecap"
 	"github.com/nekomeowww/insights-bot/internal/services/pprof"
 	"github.com/nekomeowww/insights-bot/internal/services/smr"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
 	"github.com/nekomeowww/insights-bot/pkg/bots/discordbot"
 	"github.com/nekomeowww/insights-bot/pkg/bots/slackbot"
 	"github.com/nekomeowww/insights-bot/pkg/bots/tgbot"
@@ -28,13 +29,17 @@ type NewHealthParams struct {
 
 	Logger *logger.Logger
 
-	AutoRecapTimeCapsuleDigger *datastore.AutoRecapTimeCapsuleDigger
-	TelegramBot                *tgbot.BotService
-	SlackBot                   *slackbot.BotService
-	DiscordBot                 *discordbot.BotService
-	AutoRecap                  *autorecap.AutoRecapService
-	Pprof                      *pprof.Pprof
-	SmrService                 *smr.Service
+	AutoRecapTimeCapsuleDigger          *datastore.AutoRecapTimeCapsuleDigger
+	PrivateRecapDigestTimeCapsuleDigger *datastore.PrivateRecapDigestTimeCapsuleDigger
+	AutoUnpinRecapTimeCapsuleDigger     *datastore.AutoUnpinRecapTimeCapsuleDigger
+	ApprovedAutoRecapTimeCapsuleDigger  *datastore.ApprovedAutoRecapTimeCapsuleDigger
+	TelegramBot                         *tgbot.BotService
+	SlackBot                            *slackbot.BotService
+	DiscordBot                          *discordbot.BotService
+	AutoRecap                           *autorecap.AutoRecapService
+	Pprof                               *pprof.Pprof
+	SmrService                          *smr.Service
+	OpenAI                              openai.Client
 }
 
 type Health struct {
@@ -56,6 +61,18 @@ func NewHealth() func(NewHealthParams) (*Health, error) {
 				Name:  "auto recap timecapsule digger",
 				Check: params.AutoRecapTimeCapsuleDigger.Check,
 			}),
+			health.WithCheck(health.Check{
+				Name:  "private recap digest timecapsule digger",
+				Check: params.PrivateRecapDigestTimeCapsuleDigger.Check,
+			}),
+			health.WithCheck(health.Check{
+				Name:  "auto unpin recap timecapsule digger",
+				Check: params.AutoUnpinRecapTimeCapsuleDigger.Check,
+			}),
+			health.WithCheck(health.Check{
+				Name:  "approved auto recap timecapsule digger",
+				Check: params.ApprovedAutoRecapTimeCapsuleDigger.Check,
+			}),
 			health.WithCheck(health.Check{
 				Name:  "auto_recap",
 				Check: params.AutoRecap.Check,
@@ -68,6 +85,10 @@ func NewHealth() func(NewHealthParams) (*Health, error) {
 				Name:  "smr_service",
 				Check: params.SmrService.Check,
 			}),
+			health.WithCheck(health.Check{
+				Name:  "openai",
+				Check: params.OpenAI.Check,
+			}),
 		)
 
 		if params.SlackBot != nil {
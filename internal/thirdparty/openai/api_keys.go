@@ -0,0 +1,136 @@
+package openai
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAPIKeyRetryAfter = time.Minute
+)
+
+// apiKeysTransport fails over between the API keys when one of them is rate
+// limited, the rate limited key won't be used again until its retry-after
+// elapsed.
+type apiKeysTransport struct {
+	base         http.RoundTripper
+	keys         []string
+	setAPIKey    func(req *http.Request, key string)
+	now          func() time.Time
+	mutex        sync.Mutex
+	current      int
+	limitedUntil map[int]time.Time
+}
+
+func newAPIKeysTransport(base http.RoundTripper, keys []string, azure bool) *apiKeysTransport {
+	return &apiKeysTransport{
+		base: base,
+		keys: keys,
+		setAPIKey: func(req *http.Request, key string) {
+			if azure {
+				req.Header.Set("api-key", key)
+				return
+			}
+
+			req.Header.Set("Authorization", "Bearer "+key)
+		},
+		now:          time.Now,
+		limitedUntil: make(map[int]time.Time),
+	}
+}
+
+// availableKeys returns the indexes of the keys that are not rate limited,
+// starting from the current one. The current one will be returned if all of
+// the keys are rate limited.
+func (t *apiKeysTransport) availableKeys() []int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := t.now()
+	indexes := make([]int, 0, len(t.keys))
+
+	for i := range t.keys {
+		index := (t.current + i) % len(t.keys)
+		if now.Before(t.limitedUntil[index]) {
+			continue
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	if len(indexes) == 0 {
+		return []int{t.current}
+	}
+
+	return indexes
+}
+
+func (t *apiKeysTransport) markRateLimited(index int, retryAfter time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.limitedUntil[index] = t.now().Add(retryAfter)
+	if t.current == index {
+		t.current = (index + 1) % len(t.keys)
+	}
+}
+
+func (t *apiKeysTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	indexes := t.availableKeys()
+	if req.Body != nil && req.GetBody == nil {
+		// the body can not be replayed, no failover
+		indexes = indexes[:1]
+	}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+
+	for i, index := range indexes {
+		attemptReq := req.Clone(req.Context())
+		if i > 0 && req.GetBody != nil {
+			attemptReq.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		t.setAPIKey(attemptReq, t.keys[index])
+
+		resp, err = t.base.RoundTrip(attemptReq)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+			return resp, err
+		}
+
+		t.markRateLimited(index, parseRetryAfter(resp.Header.Get("Retry-After"), t.now()))
+
+		if i < len(indexes)-1 {
+			_ = resp.Body.Close()
+		}
+	}
+
+	return resp, err
+}
+
+// parseRetryAfter parses the Retry-After header, which can be either seconds
+// or a HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return defaultAPIKeyRetryAfter
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	at, err := http.ParseTime(value)
+	if err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+
+	return defaultAPIKeyRetryAfter
+}
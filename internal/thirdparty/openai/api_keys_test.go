@@ -0,0 +1,108 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+)
+
+func TestAPIKeysFailover(t *testing.T) {
+	var (
+		mutex          sync.Mutex
+		authorizations []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		mutex.Unlock()
+
+		if r.Header.Get("Authorization") == "Bearer key1" {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":{"message":"Rate limit reached","type":"requests"}}`))
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"ok"}}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	config, err := newClientConfig(configs.SectionOpenAI{
+		APIKeys: []string{"key1", "key2"},
+		BaseURL: server.URL + "/v1",
+	}, nil)
+	require.NoError(t, err)
+
+	client := openai.NewClientWithConfig(config)
+
+	resp, err := client.CreateChatCompletion(context.Background(), openai.ChatCompletionRequest{Model: openai.GPT3Dot5Turbo})
+	require.NoError(t, err)
+	require.Len(t, resp.Choices, 1)
+	assert.Equal(t, "ok", resp.Choices[0].Message.Content)
+	assert.Equal(t, []string{"Bearer key1", "Bearer key2"}, authorizations)
+
+	// key1 should be skipped until the retry-after elapsed
+	_, err = client.CreateChatCompletion(context.Background(), openai.ChatCompletionRequest{Model: openai.GPT3Dot5Turbo})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer key1", "Bearer key2", "Bearer key2"}, authorizations)
+}
+
+func TestAPIKeysTransportAllRateLimited(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	transport := newAPIKeysTransport(http.DefaultTransport, []string{"key1", "key2"}, false)
+	transport.now = func() time.Time { return now }
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+
+	// all keys are rate limited, only the current one will be tried
+	resp, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, 3, requests)
+
+	// keys become available again after the default retry-after
+	now = now.Add(defaultAPIKeyRetryAfter)
+
+	resp, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, 5, requests)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, defaultAPIKeyRetryAfter, parseRetryAfter("", now))
+	assert.Equal(t, defaultAPIKeyRetryAfter, parseRetryAfter("invalid", now))
+}
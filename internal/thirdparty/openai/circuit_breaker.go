@@ -0,0 +1,138 @@
+package openai
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	ErrCircuitBreakerOpen = errors.New("openai circuit breaker is open")
+)
+
+type CircuitBreakerState string
+
+const (
+	CircuitBreakerStateClosed   CircuitBreakerState = "closed"
+	CircuitBreakerStateOpen     CircuitBreakerState = "open"
+	CircuitBreakerStateHalfOpen CircuitBreakerState = "half-open"
+)
+
+// circuitBreaker opens after the configured consecutive failures and rejects
+// all requests until the cooldown elapsed, then it half-opens and lets one
+// request through to probe whether the API has recovered.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mutex               sync.Mutex
+	state               CircuitBreakerState
+	consecutiveFailures int
+	openedAt            time.Time
+	probing             bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		state:     CircuitBreakerStateClosed,
+	}
+}
+
+// State returns the current state of the breaker, an open breaker whose
+// cooldown has elapsed is reported as half-open.
+func (b *circuitBreaker) State() CircuitBreakerState {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.state == CircuitBreakerStateOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return CircuitBreakerStateHalfOpen
+	}
+
+	return b.state
+}
+
+func (b *circuitBreaker) allow() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case CircuitBreakerStateOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrCircuitBreakerOpen
+		}
+
+		b.state = CircuitBreakerStateHalfOpen
+		b.probing = true
+
+		return nil
+	case CircuitBreakerStateHalfOpen:
+		// only one probe at a time
+		if b.probing {
+			return ErrCircuitBreakerOpen
+		}
+
+		b.probing = true
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (b *circuitBreaker) onSuccess() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.state = CircuitBreakerStateClosed
+	b.consecutiveFailures = 0
+	b.probing = false
+}
+
+func (b *circuitBreaker) onFailure() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+
+	if b.state == CircuitBreakerStateHalfOpen {
+		b.state = CircuitBreakerStateOpen
+		b.openedAt = b.now()
+
+		return
+	}
+
+	b.consecutiveFailures++
+	if b.consecutiveFailures >= b.threshold {
+		b.state = CircuitBreakerStateOpen
+		b.openedAt = b.now()
+	}
+}
+
+// circuitBreakerTransport fails fast with ErrCircuitBreakerOpen while the
+// breaker is open, transport errors and 5xx responses count as failures.
+type circuitBreakerTransport struct {
+	base    http.RoundTripper
+	breaker *circuitBreaker
+}
+
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	err := t.breaker.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode >= http.StatusInternalServerError {
+		t.breaker.onFailure()
+		return resp, err
+	}
+
+	t.breaker.onSuccess()
+
+	return resp, nil
+}
@@ -0,0 +1,100 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	breaker := newCircuitBreaker(3, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	t.Run("OpensAfterConsecutiveFailures", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			require.NoError(t, breaker.allow())
+			breaker.onFailure()
+			assert.Equal(t, CircuitBreakerStateClosed, breaker.State())
+		}
+
+		require.NoError(t, breaker.allow())
+		breaker.onFailure()
+		assert.Equal(t, CircuitBreakerStateOpen, breaker.State())
+		assert.ErrorIs(t, breaker.allow(), ErrCircuitBreakerOpen)
+	})
+
+	t.Run("HalfOpensAfterCooldown", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		assert.Equal(t, CircuitBreakerStateHalfOpen, breaker.State())
+
+		require.NoError(t, breaker.allow())
+		// only one probe is allowed at a time
+		assert.ErrorIs(t, breaker.allow(), ErrCircuitBreakerOpen)
+	})
+
+	t.Run("ReopensWhenProbeFailed", func(t *testing.T) {
+		breaker.onFailure()
+		assert.Equal(t, CircuitBreakerStateOpen, breaker.State())
+		assert.ErrorIs(t, breaker.allow(), ErrCircuitBreakerOpen)
+	})
+
+	t.Run("ClosesWhenProbeSucceeded", func(t *testing.T) {
+		now = now.Add(time.Minute)
+
+		require.NoError(t, breaker.allow())
+		breaker.onSuccess()
+		assert.Equal(t, CircuitBreakerStateClosed, breaker.State())
+
+		// failures are counted from zero again
+		require.NoError(t, breaker.allow())
+		breaker.onFailure()
+		assert.Equal(t, CircuitBreakerStateClosed, breaker.State())
+	})
+}
+
+func TestCircuitBreakerTransport(t *testing.T) {
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+
+	breaker := newCircuitBreaker(2, time.Hour)
+
+	config, err := newClientConfig(configs.SectionOpenAI{Secret: "secret", BaseURL: server.URL}, breaker)
+	require.NoError(t, err)
+
+	client := openai.NewClientWithConfig(config)
+	request := openai.ChatCompletionRequest{
+		Model:    openai.GPT3Dot5Turbo,
+		Messages: []openai.ChatCompletionMessage{{Role: openai.ChatMessageRoleUser, Content: "hello"}},
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = client.CreateChatCompletion(context.Background(), request)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrCircuitBreakerOpen)
+	}
+
+	assert.Equal(t, CircuitBreakerStateOpen, breaker.State())
+
+	_, err = client.CreateChatCompletion(context.Background(), request)
+	assert.ErrorIs(t, err, ErrCircuitBreakerOpen)
+	assert.Equal(t, int64(2), requests.Load())
+
+	c := &OpenAIClient{breaker: breaker}
+	assert.ErrorIs(t, c.Check(context.Background()), ErrCircuitBreakerOpen)
+}
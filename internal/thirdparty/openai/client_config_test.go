@@ -0,0 +1,95 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+)
+
+func newTestChatCompletionServer(t *testing.T, onRequest func(r *http.Request)) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		onRequest(r)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"ok"}}]}`))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewClientConfig(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		config, err := newClientConfig(configs.SectionOpenAI{Secret: "secret"}, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, openai.APITypeOpenAI, config.APIType)
+		assert.Equal(t, "https://api.openai.com/v1", config.BaseURL)
+	})
+
+	t.Run("Host", func(t *testing.T) {
+		config, err := newClientConfig(configs.SectionOpenAI{Secret: "secret", Host: "https://openai.example.workers.dev/some/path"}, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://openai.example.workers.dev/v1", config.BaseURL)
+	})
+
+	t.Run("OpenAICompatible", func(t *testing.T) {
+		var request *http.Request
+
+		server := newTestChatCompletionServer(t, func(r *http.Request) { request = r })
+
+		config, err := newClientConfig(configs.SectionOpenAI{
+			Secret:       "secret",
+			BaseURL:      server.URL + "/api/v1/",
+			ExtraHeaders: map[string]string{"X-Custom-Header": "custom"},
+		}, nil)
+		require.NoError(t, err)
+
+		_, err = openai.NewClientWithConfig(config).CreateChatCompletion(context.Background(), openai.ChatCompletionRequest{Model: "llama3"})
+		require.NoError(t, err)
+		require.NotNil(t, request)
+
+		assert.Equal(t, "/api/v1/chat/completions", request.URL.Path)
+		assert.Equal(t, "Bearer secret", request.Header.Get("Authorization"))
+		assert.Equal(t, "custom", request.Header.Get("X-Custom-Header"))
+	})
+
+	t.Run("Azure", func(t *testing.T) {
+		var request *http.Request
+
+		server := newTestChatCompletionServer(t, func(r *http.Request) { request = r })
+
+		config, err := newClientConfig(configs.SectionOpenAI{
+			Secret:     "secret",
+			BaseURL:    server.URL,
+			APIType:    configs.OpenAIAPITypeAzure,
+			APIVersion: "2024-02-01",
+		}, nil)
+		require.NoError(t, err)
+
+		_, err = openai.NewClientWithConfig(config).CreateChatCompletion(context.Background(), openai.ChatCompletionRequest{Model: "gpt-35-turbo"})
+		require.NoError(t, err)
+		require.NotNil(t, request)
+
+		assert.Equal(t, "/openai/deployments/gpt-35-turbo/chat/completions", request.URL.Path)
+		assert.Equal(t, "2024-02-01", request.URL.Query().Get("api-version"))
+		assert.Equal(t, "secret", request.Header.Get("api-key"))
+		assert.Empty(t, request.Header.Get("Authorization"))
+	})
+
+	t.Run("AzureWithoutBaseURL", func(t *testing.T) {
+		_, err := newClientConfig(configs.SectionOpenAI{Secret: "secret", APIType: configs.OpenAIAPITypeAzure}, nil)
+		require.Error(t, err)
+	})
+}
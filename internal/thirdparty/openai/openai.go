@@ -4,9 +4,14 @@ package openai
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/pkoukk/tiktoken-go"
@@ -19,29 +24,36 @@ import (
 	"github.com/nekomeowww/insights-bot/internal/configs"
 	"github.com/nekomeowww/insights-bot/internal/datastore"
 	"github.com/nekomeowww/insights-bot/pkg/logger"
+	"github.com/nekomeowww/insights-bot/pkg/options"
 )
 
 //counterfeiter:generate -o openaimock/mock_client.go --fake-name MockClient . Client
 type Client interface {
+	Check(ctx context.Context) error
 	GetModelName() string
 	SplitContentBasedByTokenLimitations(textContent string, limits int) []string
 	SummarizeAny(ctx context.Context, content string) (*openai.ChatCompletionResponse, error)
-	SummarizeChatHistories(ctx context.Context, llmFriendlyChatHistories string) (*openai.ChatCompletionResponse, error)
+	SummarizeChatHistories(ctx context.Context, llmFriendlyChatHistories string, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (*openai.ChatCompletionResponse, error)
+	MergeChatHistoriesSummarizations(ctx context.Context, summarizations string, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (*openai.ChatCompletionResponse, error)
+	TranslateChatHistoriesSummarizations(ctx context.Context, summarizations string, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (*openai.ChatCompletionResponse, error)
 	SummarizeOneChatHistory(ctx context.Context, llmFriendlyChatHistory string) (*openai.ChatCompletionResponse, error)
 	SummarizeWithQuestionsAsSimplifiedChinese(ctx context.Context, title string, by string, content string) (*openai.ChatCompletionResponse, error)
 	TruncateContentBasedOnTokens(textContent string, limits int) string
+	ModerateContent(ctx context.Context, content string) (*openai.ModerationResponse, error)
 }
 
 var _ Client = (*OpenAIClient)(nil)
 
 type OpenAIClient struct {
-	modelName string
+	modelName   string
+	temperature float64
 
 	tiktokenEncoding            *tiktoken.Tiktoken
 	client                      *openai.Client
 	ent                         *datastore.Ent
 	logger                      *logger.Logger
 	limiter                     ratelimit.Limiter
+	breaker                     *circuitBreaker
 	enableMetricRecordForTokens bool
 }
 
@@ -63,6 +75,90 @@ func parseOpenAIAPIHost(apiHost string) (string, error) {
 	return "", fmt.Errorf("invalid API host: %s", apiHost)
 }
 
+// headersTransport sets the extra headers to every request sent through it.
+type headersTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// newClientConfig creates the client config for the public OpenAI API by
+// default, Azure OpenAI and the other OpenAI compatible endpoints (e.g. vLLM,
+// Ollama) are supported by configuring the base URL, API type, API version and
+// extra headers. Requests fail over between the API keys when more than one
+// key is configured, and fail fast while the breaker (if any) is open.
+func newClientConfig(openAIConfig configs.SectionOpenAI, breaker *circuitBreaker) (openai.ClientConfig, error) {
+	baseURL := strings.TrimSuffix(openAIConfig.BaseURL, "/")
+	if baseURL == "" && openAIConfig.Host != "" {
+		apiHost, err := parseOpenAIAPIHost(openAIConfig.Host)
+		if err != nil {
+			return openai.ClientConfig{}, err
+		}
+
+		baseURL = lo.Ternary(openAIConfig.APIType == configs.OpenAIAPITypeAzure, apiHost, fmt.Sprintf("%s/v1", apiHost))
+	}
+
+	apiKey := openAIConfig.Secret
+	if len(openAIConfig.APIKeys) > 0 {
+		apiKey = openAIConfig.APIKeys[0]
+	}
+
+	var config openai.ClientConfig
+
+	switch openAIConfig.APIType {
+	case configs.OpenAIAPITypeAzure:
+		if baseURL == "" {
+			return openai.ClientConfig{}, errors.New("base URL or host of the Azure OpenAI endpoint is required when the API type is azure")
+		}
+
+		config = openai.DefaultAzureConfig(apiKey, baseURL)
+	default:
+		config = openai.DefaultConfig(apiKey)
+		if baseURL != "" {
+			config.BaseURL = baseURL
+		}
+	}
+
+	if openAIConfig.APIVersion != "" {
+		config.APIVersion = openAIConfig.APIVersion
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+
+	if len(openAIConfig.ExtraHeaders) > 0 {
+		transport = &headersTransport{
+			base:    transport,
+			headers: openAIConfig.ExtraHeaders,
+		}
+	}
+
+	if len(openAIConfig.APIKeys) > 1 {
+		transport = newAPIKeysTransport(transport, openAIConfig.APIKeys, openAIConfig.APIType == configs.OpenAIAPITypeAzure)
+	}
+
+	if breaker != nil {
+		transport = &circuitBreakerTransport{
+			base:    transport,
+			breaker: breaker,
+		}
+	}
+
+	if transport != http.DefaultTransport {
+		config.HTTPClient = &http.Client{Transport: transport}
+	}
+
+	return config, nil
+}
+
 type NewClientParams struct {
 	fx.In
 
@@ -78,17 +174,14 @@ func NewClient(enableMetricRecordForTokens bool) func(NewClientParams) (Client,
 			return nil, err
 		}
 
-		apiHost := params.Config.OpenAI.Host
-
-		config := openai.DefaultConfig(params.Config.OpenAI.Secret)
-
-		if apiHost != "" {
-			apiHost, err = parseOpenAIAPIHost(apiHost)
-			if err != nil {
-				return nil, err
-			}
+		var breaker *circuitBreaker
+		if params.Config.OpenAI.CircuitBreakerFailureThreshold > 0 {
+			breaker = newCircuitBreaker(int(params.Config.OpenAI.CircuitBreakerFailureThreshold), time.Duration(params.Config.OpenAI.CircuitBreakerCooldownSeconds)*time.Second)
+		}
 
-			config.BaseURL = fmt.Sprintf("%s/v1", apiHost)
+		config, err := newClientConfig(params.Config.OpenAI, breaker)
+		if err != nil {
+			return nil, err
 		}
 
 		client := openai.NewClientWithConfig(config)
@@ -98,16 +191,31 @@ func NewClient(enableMetricRecordForTokens bool) func(NewClientParams) (Client,
 
 		return &OpenAIClient{
 			modelName:                   lo.Ternary(params.Config.OpenAI.ModelName == "", openai.GPT3Dot5Turbo, params.Config.OpenAI.ModelName),
+			temperature:                 params.Config.OpenAI.Temperature,
 			client:                      client,
 			tiktokenEncoding:            tokenizer,
 			ent:                         params.Ent,
 			logger:                      params.Logger,
 			limiter:                     ratelimit.New(5),
+			breaker:                     breaker,
 			enableMetricRecordForTokens: enableMetricRecordForTokens,
 		}, nil
 	}
 }
 
+// Check reports an error while the circuit breaker is open.
+func (c *OpenAIClient) Check(ctx context.Context) error {
+	if c.breaker == nil {
+		return nil
+	}
+
+	if c.breaker.State() == CircuitBreakerStateOpen {
+		return ErrCircuitBreakerOpen
+	}
+
+	return nil
+}
+
 func (c *OpenAIClient) GetModelName() string {
 	return c.modelName
 }
@@ -213,6 +321,20 @@ func (c *OpenAIClient) SummarizeWithQuestionsAsSimplifiedChinese(ctx context.Con
 	return &resp, nil
 }
 
+// ModerateContent checks the content with the moderation endpoint of OpenAI.
+func (c *OpenAIClient) ModerateContent(ctx context.Context, content string) (*openai.ModerationResponse, error) {
+	c.limiter.Take()
+
+	resp, err := c.client.Moderations(ctx, openai.ModerationRequest{
+		Input: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func (c *OpenAIClient) SummarizeOneChatHistory(ctx context.Context, llmFriendlyChatHistory string) (*openai.ChatCompletionResponse, error) {
 	c.limiter.Take()
 
@@ -321,36 +443,256 @@ func (c *OpenAIClient) SummarizeAny(ctx context.Context, content string) (*opena
 	return &resp, nil
 }
 
-func (c *OpenAIClient) SummarizeChatHistories(ctx context.Context, llmFriendlyChatHistories string) (*openai.ChatCompletionResponse, error) {
+type SummarizeChatHistoriesCallOptions struct {
+	Persona           string
+	ExtraInstructions []string
+	OnStream          func(content string)
+	Temperature       *float64
+	Language          string
+	MaxTokens         int
+}
+
+// WithSummarizeChatHistoriesPersona sets the persona used to phrase the
+// summarization, either a preset name from ChatHistorySummarizationPersonaPresets
+// or a free-form persona.
+func WithSummarizeChatHistoriesPersona(persona string) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.Persona = persona
+	})
+}
+
+// WithSummarizeChatHistoriesExtraInstructions appends additional instructions
+// to the end of the chat histories summarization prompt.
+func WithSummarizeChatHistoriesExtraInstructions(instructions ...string) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.ExtraInstructions = append(o.ExtraInstructions, instructions...)
+	})
+}
+
+// WithSummarizeChatHistoriesStream makes the summarization streamed, onStream
+// will be called with the content accumulated so far whenever new tokens were
+// received.
+func WithSummarizeChatHistoriesStream(onStream func(content string)) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.OnStream = onStream
+	})
+}
+
+// WithSummarizeChatHistoriesTemperature overrides the sampling temperature
+// configured by OPENAI_API_TEMPERATURE.
+func WithSummarizeChatHistoriesTemperature(temperature float64) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.Temperature = &temperature
+	})
+}
+
+// WithSummarizeChatHistoriesMaxTokens sets the max tokens of the completion,
+// zero leaves it to the API default.
+func WithSummarizeChatHistoriesMaxTokens(maxTokens int) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.MaxTokens = maxTokens
+	})
+}
+
+// WithSummarizeChatHistoriesLanguage sets the language of the outputs,
+// Simplified Chinese is used if not set.
+func WithSummarizeChatHistoriesLanguage(language string) options.CallOptions[SummarizeChatHistoriesCallOptions] {
+	return options.NewCallOptions(func(o *SummarizeChatHistoriesCallOptions) {
+		o.Language = SanitizeChatHistorySummarizationLanguage(language)
+	})
+}
+
+// maxCompletionTokensOfModels are the max output tokens of the known models,
+// the longer prefixes must come first.
+var maxCompletionTokensOfModels = []lo.Tuple2[string, int]{
+	{A: "gpt-4o", B: 16384},
+	{A: "gpt-4.1", B: 32768},
+	{A: "gpt-4-turbo", B: 4096},
+	{A: "gpt-4-1106", B: 4096},
+	{A: "gpt-4-0125", B: 4096},
+	{A: "gpt-4", B: 8192},
+	{A: "gpt-3.5-turbo", B: 4096},
+	{A: "o1", B: 100000},
+	{A: "o3", B: 100000},
+	{A: "o4", B: 100000},
+}
+
+// defaultMaxCompletionTokens is the max output tokens of the unknown models.
+const defaultMaxCompletionTokens = 8192
+
+// MaxCompletionTokensOfModel returns the max output tokens of the model.
+func MaxCompletionTokensOfModel(modelName string) int {
+	for _, model := range maxCompletionTokensOfModels {
+		if strings.HasPrefix(modelName, model.A) {
+			return model.B
+		}
+	}
+
+	return defaultMaxCompletionTokens
+}
+
+// isReasoningModel reports whether the model is one of the o-series models,
+// which only accept max_completion_tokens instead of max_tokens.
+func isReasoningModel(modelName string) bool {
+	return lo.SomeBy([]string{"o1", "o3", "o4"}, func(prefix string) bool {
+		return strings.HasPrefix(modelName, prefix)
+	})
+}
+
+// newSummarizeChatHistoriesRequest builds the chat completion request of the
+// summarization prompt, the temperature of the call options takes precedence
+// over the configured one, negative temperature leaves it to the API default.
+// The max tokens of the call options are bounded by the max output tokens of
+// the model.
+func (c *OpenAIClient) newSummarizeChatHistoriesRequest(prompt string, opts *SummarizeChatHistoriesCallOptions) openai.ChatCompletionRequest {
+	request := openai.ChatCompletionRequest{
+		Model: c.modelName,
+		Messages: []openai.ChatCompletionMessage{{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: prompt,
+		}},
+	}
+
+	if opts.MaxTokens > 0 {
+		maxTokens := min(opts.MaxTokens, MaxCompletionTokensOfModel(c.modelName))
+		if isReasoningModel(c.modelName) {
+			request.MaxCompletionTokens = maxTokens
+		} else {
+			request.MaxTokens = maxTokens
+		}
+	}
+
+	temperature := c.temperature
+	if opts.Temperature != nil {
+		temperature = *opts.Temperature
+	}
+
+	if temperature < 0 {
+		return request
+	}
+
+	// temperature is omitted by go-openai when it is 0
+	request.Temperature = lo.Ternary(temperature == 0, math.SmallestNonzeroFloat32, float32(temperature))
+
+	return request
+}
+
+// warnIfCompletionTruncated logs the completions cut off by the max tokens, so
+// that the operators can tune the max tokens of the chats.
+func (c *OpenAIClient) warnIfCompletionTruncated(operation string, request openai.ChatCompletionRequest, resp openai.ChatCompletionResponse) {
+	if len(resp.Choices) == 0 || resp.Choices[0].FinishReason != openai.FinishReasonLength {
+		return
+	}
+
+	c.logger.Warn("chat completion was truncated by the max tokens, consider increasing the max tokens",
+		zap.String("prompt_operation", operation),
+		zap.Int("max_tokens", max(request.MaxTokens, request.MaxCompletionTokens)),
+		zap.Int("completion_token_usage", resp.Usage.CompletionTokens),
+		zap.String("model_name", c.modelName),
+	)
+}
+
+// createChatCompletionStream creates a streamed chat completion and assembles
+// the streamed chunks into a non-streamed chat completion response.
+func (c *OpenAIClient) createChatCompletionStream(ctx context.Context, request openai.ChatCompletionRequest, onStream func(content string)) (openai.ChatCompletionResponse, error) {
+	request.Stream = true
+	request.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+
+	stream, err := c.client.CreateChatCompletionStream(ctx, request)
+	if err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	var (
+		resp         openai.ChatCompletionResponse
+		content      strings.Builder
+		finishReason openai.FinishReason
+	)
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return openai.ChatCompletionResponse{}, err
+		}
+
+		resp.ID = chunk.ID
+		resp.Model = chunk.Model
+		resp.Created = chunk.Created
+
+		if chunk.Usage != nil {
+			resp.Usage = *chunk.Usage
+		}
+
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		if chunk.Choices[0].FinishReason != "" {
+			finishReason = chunk.Choices[0].FinishReason
+		}
+
+		if chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		content.WriteString(chunk.Choices[0].Delta.Content)
+		onStream(content.String())
+	}
+
+	resp.Object = "chat.completion"
+	resp.Choices = []openai.ChatCompletionChoice{{
+		Index: 0,
+		Message: openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleAssistant,
+			Content: content.String(),
+		},
+		FinishReason: finishReason,
+	}}
+
+	return resp, nil
+}
+
+func (c *OpenAIClient) SummarizeChatHistories(ctx context.Context, llmFriendlyChatHistories string, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (*openai.ChatCompletionResponse, error) {
 	c.limiter.Take()
 
+	opts := options.ApplyCallOptions(callOpts)
 	sb := new(strings.Builder)
 
 	err := ChatHistorySummarizationPrompt.Execute(
 		sb,
 		NewChatHistorySummarizationPromptInputs(
 			llmFriendlyChatHistories,
-			"Simplified Chinese",
-		),
+			opts.Language,
+			opts.ExtraInstructions...,
+		).WithPersona(opts.Persona),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.client.CreateChatCompletion(
-		ctx,
-		openai.ChatCompletionRequest{
-			Model: c.modelName,
-			Messages: []openai.ChatCompletionMessage{{
-				Role:    openai.ChatMessageRoleSystem,
-				Content: sb.String(),
-			}},
-		},
-	)
+	request := c.newSummarizeChatHistoriesRequest(sb.String(), opts)
+
+	var resp openai.ChatCompletionResponse
+	if opts.OnStream != nil {
+		resp, err = c.createChatCompletionStream(ctx, request, opts.OnStream)
+	} else {
+		resp, err = c.client.CreateChatCompletion(ctx, request)
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
+	c.warnIfCompletionTruncated("Summarize Chat Histories", request, resp)
+
 	if c.enableMetricRecordForTokens {
 		err = c.ent.MetricOpenAIChatCompletionTokenUsage.
 			Create().
@@ -374,3 +716,103 @@ func (c *OpenAIClient) SummarizeChatHistories(ctx context.Context, llmFriendlyCh
 
 	return &resp, nil
 }
+
+// MergeChatHistoriesSummarizations merges the topics summarized from
+// consecutive parts of the same chat histories, summarizations should be the
+// JSON encoded ChatHistorySummarizationOutputs.
+func (c *OpenAIClient) MergeChatHistoriesSummarizations(ctx context.Context, summarizations string, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (*openai.ChatCompletionResponse, error) {
+	c.limiter.Take()
+
+	opts := options.ApplyCallOptions(callOpts)
+	sb := new(strings.Builder)
+
+	err := ChatHistorySummarizationMergePrompt.Execute(
+		sb,
+		NewChatHistorySummarizationMergePromptInputs(summarizations, opts.Language).WithPersona(opts.Persona),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	request := c.newSummarizeChatHistoriesRequest(sb.String(), opts)
+
+	resp, err := c.client.CreateChatCompletion(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.warnIfCompletionTruncated("Merge Chat Histories Summarizations", request, resp)
+
+	if c.enableMetricRecordForTokens {
+		err = c.ent.MetricOpenAIChatCompletionTokenUsage.
+			Create().
+			SetPromptOperation("Merge Chat Histories Summarizations").
+			SetPromptTokenUsage(resp.Usage.PromptTokens).
+			SetCompletionTokenUsage(resp.Usage.CompletionTokens).
+			SetTotalTokenUsage(resp.Usage.TotalTokens).
+			SetModelName(c.modelName).
+			Exec(ctx)
+		if err != nil {
+			c.logger.Error("failed to create metric openai chat completion token usage",
+				zap.Error(err),
+				zap.String("prompt_operation", "Merge Chat Histories Summarizations"),
+				zap.Int("prompt_token_usage", resp.Usage.PromptTokens),
+				zap.Int("completion_token_usage", resp.Usage.CompletionTokens),
+				zap.Int("total_token_usage", resp.Usage.TotalTokens),
+				zap.String("model_name", c.modelName),
+			)
+		}
+	}
+
+	return &resp, nil
+}
+
+// TranslateChatHistoriesSummarizations translates the summarized topics into
+// the language of the call options, summarizations should be the JSON encoded
+// ChatHistorySummarizationOutputs.
+func (c *OpenAIClient) TranslateChatHistoriesSummarizations(ctx context.Context, summarizations string, callOpts ...options.CallOptions[SummarizeChatHistoriesCallOptions]) (*openai.ChatCompletionResponse, error) {
+	c.limiter.Take()
+
+	opts := options.ApplyCallOptions(callOpts)
+	sb := new(strings.Builder)
+
+	err := ChatHistorySummarizationTranslatePrompt.Execute(
+		sb,
+		NewChatHistorySummarizationTranslatePromptInputs(summarizations, opts.Language),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	request := c.newSummarizeChatHistoriesRequest(sb.String(), opts)
+
+	resp, err := c.client.CreateChatCompletion(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.warnIfCompletionTruncated("Translate Chat Histories Summarizations", request, resp)
+
+	if c.enableMetricRecordForTokens {
+		err = c.ent.MetricOpenAIChatCompletionTokenUsage.
+			Create().
+			SetPromptOperation("Translate Chat Histories Summarizations").
+			SetPromptTokenUsage(resp.Usage.PromptTokens).
+			SetCompletionTokenUsage(resp.Usage.CompletionTokens).
+			SetTotalTokenUsage(resp.Usage.TotalTokens).
+			SetModelName(c.modelName).
+			Exec(ctx)
+		if err != nil {
+			c.logger.Error("failed to create metric openai chat completion token usage",
+				zap.Error(err),
+				zap.String("prompt_operation", "Translate Chat Histories Summarizations"),
+				zap.Int("prompt_token_usage", resp.Usage.PromptTokens),
+				zap.Int("completion_token_usage", resp.Usage.CompletionTokens),
+				zap.Int("total_token_usage", resp.Usage.TotalTokens),
+				zap.String("model_name", c.modelName),
+			)
+		}
+	}
+
+	return &resp, nil
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/nekomeowww/insights-bot/internal/configs"
 	"github.com/nekomeowww/insights-bot/internal/datastore"
 	"github.com/nekomeowww/insights-bot/internal/lib"
+	"github.com/nekomeowww/insights-bot/pkg/options"
 	"github.com/nekomeowww/insights-bot/pkg/tutils"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -111,3 +113,75 @@ func TestSplitContentBasedOnTokenLimitations(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSummarizeChatHistoriesRequest(t *testing.T) {
+	c := &OpenAIClient{modelName: "gpt-3.5-turbo", temperature: 0.7}
+
+	t.Run("Configured", func(t *testing.T) {
+		request := c.newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions[SummarizeChatHistoriesCallOptions](nil))
+		assert.Equal(t, "gpt-3.5-turbo", request.Model)
+		assert.Equal(t, "prompt", request.Messages[0].Content)
+		assert.InDelta(t, 0.7, request.Temperature, 1e-6)
+	})
+
+	t.Run("Overridden", func(t *testing.T) {
+		request := c.newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions([]options.CallOptions[SummarizeChatHistoriesCallOptions]{
+			WithSummarizeChatHistoriesTemperature(1.2),
+		}))
+		assert.InDelta(t, 1.2, request.Temperature, 1e-6)
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		request := c.newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions([]options.CallOptions[SummarizeChatHistoriesCallOptions]{
+			WithSummarizeChatHistoriesTemperature(0),
+		}))
+		assert.NotZero(t, request.Temperature)
+		assert.InDelta(t, 0, request.Temperature, 1e-6)
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		request := (&OpenAIClient{temperature: -1}).newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions[SummarizeChatHistoriesCallOptions](nil))
+		assert.Zero(t, request.Temperature)
+	})
+
+	t.Run("MaxTokens", func(t *testing.T) {
+		request := c.newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions[SummarizeChatHistoriesCallOptions](nil))
+		assert.Zero(t, request.MaxTokens)
+
+		request = c.newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions([]options.CallOptions[SummarizeChatHistoriesCallOptions]{
+			WithSummarizeChatHistoriesMaxTokens(2048),
+		}))
+		assert.Equal(t, 2048, request.MaxTokens)
+		assert.Zero(t, request.MaxCompletionTokens)
+	})
+
+	t.Run("MaxTokensBoundedByModel", func(t *testing.T) {
+		request := c.newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions([]options.CallOptions[SummarizeChatHistoriesCallOptions]{
+			WithSummarizeChatHistoriesMaxTokens(32768),
+		}))
+		assert.Equal(t, 4096, request.MaxTokens)
+
+		request = (&OpenAIClient{modelName: "gpt-4o-mini", temperature: -1}).newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions([]options.CallOptions[SummarizeChatHistoriesCallOptions]{
+			WithSummarizeChatHistoriesMaxTokens(32768),
+		}))
+		assert.Equal(t, 16384, request.MaxTokens)
+	})
+
+	t.Run("MaxTokensOfReasoningModel", func(t *testing.T) {
+		request := (&OpenAIClient{modelName: "o3-mini", temperature: -1}).newSummarizeChatHistoriesRequest("prompt", options.ApplyCallOptions([]options.CallOptions[SummarizeChatHistoriesCallOptions]{
+			WithSummarizeChatHistoriesMaxTokens(2048),
+		}))
+		assert.Zero(t, request.MaxTokens)
+		assert.Equal(t, 2048, request.MaxCompletionTokens)
+	})
+}
+
+func TestMaxCompletionTokensOfModel(t *testing.T) {
+	assert.Equal(t, 4096, MaxCompletionTokensOfModel("gpt-3.5-turbo"))
+	assert.Equal(t, 4096, MaxCompletionTokensOfModel("gpt-4-turbo-preview"))
+	assert.Equal(t, 8192, MaxCompletionTokensOfModel("gpt-4"))
+	assert.Equal(t, 16384, MaxCompletionTokensOfModel("gpt-4o"))
+	assert.Equal(t, 32768, MaxCompletionTokensOfModel("gpt-4.1-mini"))
+	assert.Equal(t, 100000, MaxCompletionTokensOfModel("o1"))
+	assert.Equal(t, defaultMaxCompletionTokens, MaxCompletionTokensOfModel("qwen-max"))
+}
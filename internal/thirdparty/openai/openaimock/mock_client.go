@@ -6,10 +6,22 @@ import (
 	"sync"
 
 	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/pkg/options"
 	openaia "github.com/sashabaranov/go-openai"
 )
 
 type MockClient struct {
+	CheckStub        func(context.Context) error
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+		arg1 context.Context
+	}
+	checkReturns struct {
+		result1 error
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetModelNameStub        func() string
 	getModelNameMutex       sync.RWMutex
 	getModelNameArgsForCall []struct {
@@ -20,6 +32,35 @@ type MockClient struct {
 	getModelNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MergeChatHistoriesSummarizationsStub        func(context.Context, string, ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error)
+	mergeChatHistoriesSummarizationsMutex       sync.RWMutex
+	mergeChatHistoriesSummarizationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]
+	}
+	mergeChatHistoriesSummarizationsReturns struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}
+	mergeChatHistoriesSummarizationsReturnsOnCall map[int]struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}
+	ModerateContentStub        func(context.Context, string) (*openaia.ModerationResponse, error)
+	moderateContentMutex       sync.RWMutex
+	moderateContentArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	moderateContentReturns struct {
+		result1 *openaia.ModerationResponse
+		result2 error
+	}
+	moderateContentReturnsOnCall map[int]struct {
+		result1 *openaia.ModerationResponse
+		result2 error
+	}
 	SplitContentBasedByTokenLimitationsStub        func(string, int) []string
 	splitContentBasedByTokenLimitationsMutex       sync.RWMutex
 	splitContentBasedByTokenLimitationsArgsForCall []struct {
@@ -46,11 +87,12 @@ type MockClient struct {
 		result1 *openaia.ChatCompletionResponse
 		result2 error
 	}
-	SummarizeChatHistoriesStub        func(context.Context, string) (*openaia.ChatCompletionResponse, error)
+	SummarizeChatHistoriesStub        func(context.Context, string, ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error)
 	summarizeChatHistoriesMutex       sync.RWMutex
 	summarizeChatHistoriesArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
+		arg3 []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]
 	}
 	summarizeChatHistoriesReturns struct {
 		result1 *openaia.ChatCompletionResponse
@@ -90,6 +132,21 @@ type MockClient struct {
 		result1 *openaia.ChatCompletionResponse
 		result2 error
 	}
+	TranslateChatHistoriesSummarizationsStub        func(context.Context, string, ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error)
+	translateChatHistoriesSummarizationsMutex       sync.RWMutex
+	translateChatHistoriesSummarizationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]
+	}
+	translateChatHistoriesSummarizationsReturns struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}
+	translateChatHistoriesSummarizationsReturnsOnCall map[int]struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}
 	TruncateContentBasedOnTokensStub        func(string, int) string
 	truncateContentBasedOnTokensMutex       sync.RWMutex
 	truncateContentBasedOnTokensArgsForCall []struct {
@@ -106,6 +163,67 @@ type MockClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *MockClient) Check(arg1 context.Context) error {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CheckStub
+	fakeReturns := fake.checkReturns
+	fake.recordInvocation("Check", []interface{}{arg1})
+	fake.checkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *MockClient) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *MockClient) CheckCalls(stub func(context.Context) error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = stub
+}
+
+func (fake *MockClient) CheckArgsForCall(i int) context.Context {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	argsForCall := fake.checkArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *MockClient) CheckReturns(result1 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *MockClient) CheckReturnsOnCall(i int, result1 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *MockClient) GetModelName() string {
 	fake.getModelNameMutex.Lock()
 	ret, specificReturn := fake.getModelNameReturnsOnCall[len(fake.getModelNameArgsForCall)]
@@ -159,6 +277,137 @@ func (fake *MockClient) GetModelNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *MockClient) MergeChatHistoriesSummarizations(arg1 context.Context, arg2 string, arg3 ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error) {
+	fake.mergeChatHistoriesSummarizationsMutex.Lock()
+	ret, specificReturn := fake.mergeChatHistoriesSummarizationsReturnsOnCall[len(fake.mergeChatHistoriesSummarizationsArgsForCall)]
+	fake.mergeChatHistoriesSummarizationsArgsForCall = append(fake.mergeChatHistoriesSummarizationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]
+	}{arg1, arg2, arg3})
+	stub := fake.MergeChatHistoriesSummarizationsStub
+	fakeReturns := fake.mergeChatHistoriesSummarizationsReturns
+	fake.recordInvocation("MergeChatHistoriesSummarizations", []interface{}{arg1, arg2, arg3})
+	fake.mergeChatHistoriesSummarizationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *MockClient) MergeChatHistoriesSummarizationsCallCount() int {
+	fake.mergeChatHistoriesSummarizationsMutex.RLock()
+	defer fake.mergeChatHistoriesSummarizationsMutex.RUnlock()
+	return len(fake.mergeChatHistoriesSummarizationsArgsForCall)
+}
+
+func (fake *MockClient) MergeChatHistoriesSummarizationsCalls(stub func(context.Context, string, ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error)) {
+	fake.mergeChatHistoriesSummarizationsMutex.Lock()
+	defer fake.mergeChatHistoriesSummarizationsMutex.Unlock()
+	fake.MergeChatHistoriesSummarizationsStub = stub
+}
+
+func (fake *MockClient) MergeChatHistoriesSummarizationsArgsForCall(i int) (context.Context, string, []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) {
+	fake.mergeChatHistoriesSummarizationsMutex.RLock()
+	defer fake.mergeChatHistoriesSummarizationsMutex.RUnlock()
+	argsForCall := fake.mergeChatHistoriesSummarizationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *MockClient) MergeChatHistoriesSummarizationsReturns(result1 *openaia.ChatCompletionResponse, result2 error) {
+	fake.mergeChatHistoriesSummarizationsMutex.Lock()
+	defer fake.mergeChatHistoriesSummarizationsMutex.Unlock()
+	fake.MergeChatHistoriesSummarizationsStub = nil
+	fake.mergeChatHistoriesSummarizationsReturns = struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *MockClient) MergeChatHistoriesSummarizationsReturnsOnCall(i int, result1 *openaia.ChatCompletionResponse, result2 error) {
+	fake.mergeChatHistoriesSummarizationsMutex.Lock()
+	defer fake.mergeChatHistoriesSummarizationsMutex.Unlock()
+	fake.MergeChatHistoriesSummarizationsStub = nil
+	if fake.mergeChatHistoriesSummarizationsReturnsOnCall == nil {
+		fake.mergeChatHistoriesSummarizationsReturnsOnCall = make(map[int]struct {
+			result1 *openaia.ChatCompletionResponse
+			result2 error
+		})
+	}
+	fake.mergeChatHistoriesSummarizationsReturnsOnCall[i] = struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *MockClient) ModerateContent(arg1 context.Context, arg2 string) (*openaia.ModerationResponse, error) {
+	fake.moderateContentMutex.Lock()
+	ret, specificReturn := fake.moderateContentReturnsOnCall[len(fake.moderateContentArgsForCall)]
+	fake.moderateContentArgsForCall = append(fake.moderateContentArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ModerateContentStub
+	fakeReturns := fake.moderateContentReturns
+	fake.recordInvocation("ModerateContent", []interface{}{arg1, arg2})
+	fake.moderateContentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *MockClient) ModerateContentCallCount() int {
+	fake.moderateContentMutex.RLock()
+	defer fake.moderateContentMutex.RUnlock()
+	return len(fake.moderateContentArgsForCall)
+}
+
+func (fake *MockClient) ModerateContentCalls(stub func(context.Context, string) (*openaia.ModerationResponse, error)) {
+	fake.moderateContentMutex.Lock()
+	defer fake.moderateContentMutex.Unlock()
+	fake.ModerateContentStub = stub
+}
+
+func (fake *MockClient) ModerateContentArgsForCall(i int) (context.Context, string) {
+	fake.moderateContentMutex.RLock()
+	defer fake.moderateContentMutex.RUnlock()
+	argsForCall := fake.moderateContentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *MockClient) ModerateContentReturns(result1 *openaia.ModerationResponse, result2 error) {
+	fake.moderateContentMutex.Lock()
+	defer fake.moderateContentMutex.Unlock()
+	fake.ModerateContentStub = nil
+	fake.moderateContentReturns = struct {
+		result1 *openaia.ModerationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *MockClient) ModerateContentReturnsOnCall(i int, result1 *openaia.ModerationResponse, result2 error) {
+	fake.moderateContentMutex.Lock()
+	defer fake.moderateContentMutex.Unlock()
+	fake.ModerateContentStub = nil
+	if fake.moderateContentReturnsOnCall == nil {
+		fake.moderateContentReturnsOnCall = make(map[int]struct {
+			result1 *openaia.ModerationResponse
+			result2 error
+		})
+	}
+	fake.moderateContentReturnsOnCall[i] = struct {
+		result1 *openaia.ModerationResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *MockClient) SplitContentBasedByTokenLimitations(arg1 string, arg2 int) []string {
 	fake.splitContentBasedByTokenLimitationsMutex.Lock()
 	ret, specificReturn := fake.splitContentBasedByTokenLimitationsReturnsOnCall[len(fake.splitContentBasedByTokenLimitationsArgsForCall)]
@@ -286,19 +535,20 @@ func (fake *MockClient) SummarizeAnyReturnsOnCall(i int, result1 *openaia.ChatCo
 	}{result1, result2}
 }
 
-func (fake *MockClient) SummarizeChatHistories(arg1 context.Context, arg2 string) (*openaia.ChatCompletionResponse, error) {
+func (fake *MockClient) SummarizeChatHistories(arg1 context.Context, arg2 string, arg3 ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error) {
 	fake.summarizeChatHistoriesMutex.Lock()
 	ret, specificReturn := fake.summarizeChatHistoriesReturnsOnCall[len(fake.summarizeChatHistoriesArgsForCall)]
 	fake.summarizeChatHistoriesArgsForCall = append(fake.summarizeChatHistoriesArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
+		arg3 []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]
+	}{arg1, arg2, arg3})
 	stub := fake.SummarizeChatHistoriesStub
 	fakeReturns := fake.summarizeChatHistoriesReturns
-	fake.recordInvocation("SummarizeChatHistories", []interface{}{arg1, arg2})
+	fake.recordInvocation("SummarizeChatHistories", []interface{}{arg1, arg2, arg3})
 	fake.summarizeChatHistoriesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -312,17 +562,17 @@ func (fake *MockClient) SummarizeChatHistoriesCallCount() int {
 	return len(fake.summarizeChatHistoriesArgsForCall)
 }
 
-func (fake *MockClient) SummarizeChatHistoriesCalls(stub func(context.Context, string) (*openaia.ChatCompletionResponse, error)) {
+func (fake *MockClient) SummarizeChatHistoriesCalls(stub func(context.Context, string, ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error)) {
 	fake.summarizeChatHistoriesMutex.Lock()
 	defer fake.summarizeChatHistoriesMutex.Unlock()
 	fake.SummarizeChatHistoriesStub = stub
 }
 
-func (fake *MockClient) SummarizeChatHistoriesArgsForCall(i int) (context.Context, string) {
+func (fake *MockClient) SummarizeChatHistoriesArgsForCall(i int) (context.Context, string, []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) {
 	fake.summarizeChatHistoriesMutex.RLock()
 	defer fake.summarizeChatHistoriesMutex.RUnlock()
 	argsForCall := fake.summarizeChatHistoriesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *MockClient) SummarizeChatHistoriesReturns(result1 *openaia.ChatCompletionResponse, result2 error) {
@@ -483,6 +733,72 @@ func (fake *MockClient) SummarizeWithQuestionsAsSimplifiedChineseReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *MockClient) TranslateChatHistoriesSummarizations(arg1 context.Context, arg2 string, arg3 ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error) {
+	fake.translateChatHistoriesSummarizationsMutex.Lock()
+	ret, specificReturn := fake.translateChatHistoriesSummarizationsReturnsOnCall[len(fake.translateChatHistoriesSummarizationsArgsForCall)]
+	fake.translateChatHistoriesSummarizationsArgsForCall = append(fake.translateChatHistoriesSummarizationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]
+	}{arg1, arg2, arg3})
+	stub := fake.TranslateChatHistoriesSummarizationsStub
+	fakeReturns := fake.translateChatHistoriesSummarizationsReturns
+	fake.recordInvocation("TranslateChatHistoriesSummarizations", []interface{}{arg1, arg2, arg3})
+	fake.translateChatHistoriesSummarizationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *MockClient) TranslateChatHistoriesSummarizationsCallCount() int {
+	fake.translateChatHistoriesSummarizationsMutex.RLock()
+	defer fake.translateChatHistoriesSummarizationsMutex.RUnlock()
+	return len(fake.translateChatHistoriesSummarizationsArgsForCall)
+}
+
+func (fake *MockClient) TranslateChatHistoriesSummarizationsCalls(stub func(context.Context, string, ...options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) (*openaia.ChatCompletionResponse, error)) {
+	fake.translateChatHistoriesSummarizationsMutex.Lock()
+	defer fake.translateChatHistoriesSummarizationsMutex.Unlock()
+	fake.TranslateChatHistoriesSummarizationsStub = stub
+}
+
+func (fake *MockClient) TranslateChatHistoriesSummarizationsArgsForCall(i int) (context.Context, string, []options.CallOptions[openai.SummarizeChatHistoriesCallOptions]) {
+	fake.translateChatHistoriesSummarizationsMutex.RLock()
+	defer fake.translateChatHistoriesSummarizationsMutex.RUnlock()
+	argsForCall := fake.translateChatHistoriesSummarizationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *MockClient) TranslateChatHistoriesSummarizationsReturns(result1 *openaia.ChatCompletionResponse, result2 error) {
+	fake.translateChatHistoriesSummarizationsMutex.Lock()
+	defer fake.translateChatHistoriesSummarizationsMutex.Unlock()
+	fake.TranslateChatHistoriesSummarizationsStub = nil
+	fake.translateChatHistoriesSummarizationsReturns = struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *MockClient) TranslateChatHistoriesSummarizationsReturnsOnCall(i int, result1 *openaia.ChatCompletionResponse, result2 error) {
+	fake.translateChatHistoriesSummarizationsMutex.Lock()
+	defer fake.translateChatHistoriesSummarizationsMutex.Unlock()
+	fake.TranslateChatHistoriesSummarizationsStub = nil
+	if fake.translateChatHistoriesSummarizationsReturnsOnCall == nil {
+		fake.translateChatHistoriesSummarizationsReturnsOnCall = make(map[int]struct {
+			result1 *openaia.ChatCompletionResponse
+			result2 error
+		})
+	}
+	fake.translateChatHistoriesSummarizationsReturnsOnCall[i] = struct {
+		result1 *openaia.ChatCompletionResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *MockClient) TruncateContentBasedOnTokens(arg1 string, arg2 int) string {
 	fake.truncateContentBasedOnTokensMutex.Lock()
 	ret, specificReturn := fake.truncateContentBasedOnTokensReturnsOnCall[len(fake.truncateContentBasedOnTokensArgsForCall)]
@@ -548,8 +864,14 @@ func (fake *MockClient) TruncateContentBasedOnTokensReturnsOnCall(i int, result1
 func (fake *MockClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
 	fake.getModelNameMutex.RLock()
 	defer fake.getModelNameMutex.RUnlock()
+	fake.mergeChatHistoriesSummarizationsMutex.RLock()
+	defer fake.mergeChatHistoriesSummarizationsMutex.RUnlock()
+	fake.moderateContentMutex.RLock()
+	defer fake.moderateContentMutex.RUnlock()
 	fake.splitContentBasedByTokenLimitationsMutex.RLock()
 	defer fake.splitContentBasedByTokenLimitationsMutex.RUnlock()
 	fake.summarizeAnyMutex.RLock()
@@ -560,6 +882,8 @@ func (fake *MockClient) Invocations() map[string][][]interface{} {
 	defer fake.summarizeOneChatHistoryMutex.RUnlock()
 	fake.summarizeWithQuestionsAsSimplifiedChineseMutex.RLock()
 	defer fake.summarizeWithQuestionsAsSimplifiedChineseMutex.RUnlock()
+	fake.translateChatHistoriesSummarizationsMutex.RLock()
+	defer fake.translateChatHistoriesSummarizationsMutex.RUnlock()
 	fake.truncateContentBasedOnTokensMutex.RLock()
 	defer fake.truncateContentBasedOnTokensMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -1,6 +1,8 @@
 package openai
 
 import (
+	"regexp"
+	"strings"
 	"text/template"
 
 	"github.com/samber/lo"
@@ -15,17 +17,68 @@ var AnySummarizationPrompt = lo.Must(template.New("anything summarization prompt
 你是我的总结助手。我将为你提供一段话，我需要你在不丢失原文主旨和情感、不做更多的解释和说明的情况下帮我用不超过100字总结一下这段话说了什么。`))
 
 type ChatHistorySummarizationPromptInputs struct {
-	ChatHistory string
-	Language    string
+	ChatHistory       string
+	Language          string
+	Persona           string
+	ExtraInstructions []string
 }
 
-func NewChatHistorySummarizationPromptInputs(chatHistory string, language string) *ChatHistorySummarizationPromptInputs {
+func NewChatHistorySummarizationPromptInputs(chatHistory string, language string, extraInstructions ...string) *ChatHistorySummarizationPromptInputs {
 	return &ChatHistorySummarizationPromptInputs{
-		ChatHistory: chatHistory,
-		Language:    lo.Ternary(language != "", language, "Simplified Chinese"),
+		ChatHistory:       chatHistory,
+		Language:          lo.Ternary(language != "", language, "Simplified Chinese"),
+		ExtraInstructions: extraInstructions,
 	}
 }
 
+// WithPersona sets the persona used to phrase the summarization, both preset
+// names and free-form personas are accepted.
+func (i *ChatHistorySummarizationPromptInputs) WithPersona(persona string) *ChatHistorySummarizationPromptInputs {
+	i.Persona = ResolveChatHistorySummarizationPersona(persona)
+
+	return i
+}
+
+const ChatHistorySummarizationPersonaMaxLength = 100
+
+// ChatHistorySummarizationPersonaPresets are the built-in personas that can be
+// referred by their names. Personas only change the wording of the outputs, the
+// prompt always asks the model to keep honoring the JSON Schema, otherwise the
+// outputs can not be parsed into ChatHistorySummarizationOutputs.
+var ChatHistorySummarizationPersonaPresets = map[string]string{
+	"formal":    "a formal and objective meeting secretary, using neutral and professional wording",
+	"playful":   "a playful and humorous group member, using lively and light-hearted wording",
+	"technical": "a senior engineer, focusing on technical details, precise terms and concrete conclusions",
+}
+
+var regexpPersonaUnsafeCharacters = regexp.MustCompile("[\\x00-\\x1f\\x7f\"`{}<>]")
+
+// SanitizeChatHistorySummarizationPersona removes the characters that may break
+// the prompt out from the free-form persona, and truncates it to
+// ChatHistorySummarizationPersonaMaxLength characters.
+func SanitizeChatHistorySummarizationPersona(persona string) string {
+	persona = regexpPersonaUnsafeCharacters.ReplaceAllString(persona, " ")
+	persona = strings.Join(strings.Fields(persona), " ")
+
+	runes := []rune(persona)
+	if len(runes) > ChatHistorySummarizationPersonaMaxLength {
+		persona = string(runes[:ChatHistorySummarizationPersonaMaxLength])
+	}
+
+	return persona
+}
+
+// ResolveChatHistorySummarizationPersona resolves the preset name into the
+// persona description, free-form personas will be sanitized.
+func ResolveChatHistorySummarizationPersona(persona string) string {
+	preset, ok := ChatHistorySummarizationPersonaPresets[strings.ToLower(strings.TrimSpace(persona))]
+	if ok {
+		return preset
+	}
+
+	return SanitizeChatHistorySummarizationPersona(persona)
+}
+
 type ChatHistorySummarizationOutputsDiscussion struct {
 	Point  string  `json:"point"`
 	KeyIDs []int64 `json:"keyIds"`
@@ -37,6 +90,9 @@ type ChatHistorySummarizationOutputs struct {
 	Participants []string                                     `json:"participants"`
 	Discussion   []*ChatHistorySummarizationOutputsDiscussion `json:"discussion"`
 	Conclusion   string                                       `json:"conclusion"`
+	// NewDevelopments is what is new since the previous recap, it is only
+	// asked for by the incremental recaps.
+	NewDevelopments string `json:"newDevelopments,omitempty"`
 }
 
 var ChatHistorySummarizationPrompt = lo.Must(template.New("chat histories summarization prompt").Parse("" +
@@ -54,4 +110,79 @@ For example:"""
 [{"topicName":"Most Important Topic 1","sinceId":123456789,"participants":["John","Mary"],"discussion":[{"point":"Most relevant key point","keyIds":[123456789,987654321]}],"conclusion":"Optional brief conclusion"},{"topicName":"Most Important Topic 2","sinceId":987654321,"participants":["Bob","Alice"],"discussion":[{"point":"Most relevant key point","keyIds":[987654321]}],"conclusion":"Optional brief conclusion"}]
 """
 
-Please note the topics may be discussed in parallel, so please consider the relevant keywords that appeared across the chat histories. Summarize the distinct topics from the chat history. For each topic, extract the most relevant 1-5 points and key message IDs. Be very concise and focused on the key essence of each topic.`))
+Please note the topics may be discussed in parallel, so please consider the relevant keywords that appeared across the chat histories. Summarize the distinct topics from the chat history. For each topic, extract the most relevant 1-5 points and key message IDs. Be very concise and focused on the key essence of each topic.{{ if .Persona }}
+Please phrase the topic names, points and conclusions as {{ .Persona }}. The persona only affects the wording, the output must still strictly follow the JSON Schema above.{{ end }}{{ range .ExtraInstructions }}
+{{ . }}{{ end }}`))
+
+type ChatHistorySummarizationMergePromptInputs struct {
+	Summarizations string
+	Language       string
+	Persona        string
+}
+
+func NewChatHistorySummarizationMergePromptInputs(summarizations string, language string) *ChatHistorySummarizationMergePromptInputs {
+	return &ChatHistorySummarizationMergePromptInputs{
+		Summarizations: summarizations,
+		Language:       lo.Ternary(language != "", language, "Simplified Chinese"),
+	}
+}
+
+// WithPersona sets the persona used to phrase the merged summarization, both
+// preset names and free-form personas are accepted.
+func (i *ChatHistorySummarizationMergePromptInputs) WithPersona(persona string) *ChatHistorySummarizationMergePromptInputs {
+	i.Persona = ResolveChatHistorySummarizationPersona(persona)
+
+	return i
+}
+
+var ChatHistorySummarizationMergePrompt = lo.Must(template.New("chat histories summarization merge prompt").Parse("" +
+	`Summarized topics:"""
+{{ .Summarizations }}
+"""
+
+You are an expert in summarizing the refined outlines from documents and dialogues. The summarized topics above were summarized from consecutive parts of the same chat history separately, so the same topic may appear multiple times. Please merge the topics that talked about the same subject into one topic, and keep the 1-10 most important distinct topics.
+
+When merging topics, use the smallest sinceId of the merged topics, combine the participants, keep the most relevant 1-5 points with their keyIds unchanged, and merge the conclusions. Never invent new ids.
+
+Output topics correspond the same JSON Schema as the summarized topics above, and output the result in language {{ .Language }}.{{ if .Persona }}
+Please phrase the topic names, points and conclusions as {{ .Persona }}. The persona only affects the wording, the output must still strictly follow the JSON Schema.{{ end }}`))
+
+const ChatHistorySummarizationLanguageMaxLength = 32
+
+// SanitizeChatHistorySummarizationLanguage removes the characters that may
+// break the prompt out from the language name, and truncates it to
+// ChatHistorySummarizationLanguageMaxLength characters.
+func SanitizeChatHistorySummarizationLanguage(language string) string {
+	language = regexpPersonaUnsafeCharacters.ReplaceAllString(language, " ")
+	language = strings.Join(strings.Fields(language), " ")
+
+	runes := []rune(language)
+	if len(runes) > ChatHistorySummarizationLanguageMaxLength {
+		language = string(runes[:ChatHistorySummarizationLanguageMaxLength])
+	}
+
+	return language
+}
+
+type ChatHistorySummarizationTranslatePromptInputs struct {
+	Summarizations string
+	Language       string
+}
+
+func NewChatHistorySummarizationTranslatePromptInputs(summarizations string, language string) *ChatHistorySummarizationTranslatePromptInputs {
+	return &ChatHistorySummarizationTranslatePromptInputs{
+		Summarizations: summarizations,
+		Language:       lo.Ternary(language != "", language, "English"),
+	}
+}
+
+var ChatHistorySummarizationTranslatePrompt = lo.Must(template.New("chat histories summarization translate prompt").Parse("" +
+	`Summarized topics:"""
+{{ .Summarizations }}
+"""
+
+You are a professional translator. Please translate the topicName, point and conclusion fields of the summarized topics above into language {{ .Language }}.
+
+Keep the number and the order of the topics and the points unchanged, keep sinceId, keyIds and participants exactly as they are, and never add or remove any fields.
+
+Output topics correspond the same JSON Schema as the summarized topics above.`))
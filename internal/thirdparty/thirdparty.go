@@ -4,10 +4,12 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/nekomeowww/insights-bot/internal/thirdparty/openai"
+	"github.com/nekomeowww/insights-bot/internal/thirdparty/webhook"
 )
 
 func NewModules() fx.Option {
 	return fx.Options(
 		fx.Provide(openai.NewClient(true)),
+		fx.Provide(webhook.NewClient()),
 	)
 }
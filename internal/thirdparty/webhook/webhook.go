@@ -0,0 +1,197 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/pkg/logger"
+)
+
+const (
+	SignatureHeader = "X-Insights-Bot-Signature"
+
+	EventRecapPublished = "recap.published"
+)
+
+type RecapPublishedMode string
+
+const (
+	RecapPublishedModeManual RecapPublishedMode = "manual"
+	RecapPublishedModeAuto   RecapPublishedMode = "auto"
+)
+
+// RecapPublishedPayload is the body of the request sent once a recap is
+// published.
+type RecapPublishedPayload struct {
+	Event          string             `json:"event"`
+	ChatID         int64              `json:"chatId"`
+	LogID          string             `json:"logId"`
+	Summarizations []string           `json:"summarizations"`
+	Timestamp      int64              `json:"timestamp"`
+	Mode           RecapPublishedMode `json:"mode"`
+}
+
+// NewRecapPublishedPayload creates the payload of a recap published event.
+func NewRecapPublishedPayload(chatID int64, logID uuid.UUID, summarizations []string, mode RecapPublishedMode) *RecapPublishedPayload {
+	return &RecapPublishedPayload{
+		Event:          EventRecapPublished,
+		ChatID:         chatID,
+		LogID:          logID.String(),
+		Summarizations: summarizations,
+		Timestamp:      time.Now().Unix(),
+		Mode:           mode,
+	}
+}
+
+// Sign computes the hex encoded HMAC-SHA256 signature of the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type NewClientParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+
+	Config *configs.Config
+	Logger *logger.Logger
+}
+
+type Client struct {
+	url    string
+	secret string
+
+	httpClient  *http.Client
+	logger      *logger.Logger
+	maxAttempts int
+	retryDelay  time.Duration
+
+	wg sync.WaitGroup
+}
+
+func NewClient() func(NewClientParams) *Client {
+	return func(params NewClientParams) *Client {
+		client := newClient(params.Config.Recap.WebhookURL, params.Config.Recap.WebhookSecret, params.Logger)
+
+		params.Lifecycle.Append(fx.Hook{
+			OnStop: func(ctx context.Context) error {
+				done := make(chan struct{})
+
+				go func() {
+					client.wg.Wait()
+					close(done)
+				}()
+
+				select {
+				case <-done:
+				case <-ctx.Done():
+				}
+
+				return nil
+			},
+		})
+
+		return client
+	}
+}
+
+func newClient(url string, secret string, logger *logger.Logger) *Client {
+	return &Client{
+		url:         url,
+		secret:      secret,
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		logger:      logger,
+		maxAttempts: 3,
+		retryDelay:  5 * time.Second,
+	}
+}
+
+// Enabled reports whether the webhook URL is configured.
+func (c *Client) Enabled() bool {
+	return c != nil && c.url != ""
+}
+
+// PublishRecap delivers the payload in the background, failures are only
+// logged and never affect the publishing of the recap itself.
+func (c *Client) PublishRecap(payload *RecapPublishedPayload) {
+	if !c.Enabled() {
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		c.logger.Error("failed to marshal recap webhook payload", zap.Int64("chat_id", payload.ChatID), zap.Error(err))
+		return
+	}
+
+	c.wg.Add(1)
+
+	go func() {
+		defer c.wg.Done()
+
+		_, _, err := lo.AttemptWithDelay(c.maxAttempts, c.retryDelay, func(iter int, _ time.Duration) error {
+			err := c.deliver(body)
+			if err != nil {
+				c.logger.Warn("failed to deliver recap webhook",
+					zap.Int64("chat_id", payload.ChatID),
+					zap.String("log_id", payload.LogID),
+					zap.Int("iter", iter),
+					zap.Int("max_iter", c.maxAttempts),
+					zap.Error(err),
+				)
+			}
+
+			return err
+		})
+		if err != nil {
+			c.logger.Error("gave up delivering recap webhook",
+				zap.Int64("chat_id", payload.ChatID),
+				zap.String("log_id", payload.LogID),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+func (c *Client) deliver(body []byte) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(c.secret, body))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nekomeowww/insights-bot/internal/configs"
+	"github.com/nekomeowww/insights-bot/internal/lib"
+)
+
+type receivedRequest struct {
+	body      []byte
+	signature string
+}
+
+func newTestServer(t *testing.T, statusCodes ...int) (*httptest.Server, func() []receivedRequest) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		requests []receivedRequest
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		statusCode := http.StatusOK
+		if len(requests) < len(statusCodes) {
+			statusCode = statusCodes[len(requests)]
+		}
+
+		requests = append(requests, receivedRequest{body: body, signature: r.Header.Get(SignatureHeader)})
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []receivedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return requests
+	}
+}
+
+func newTestClient(t *testing.T, url string, secret string) *Client {
+	t.Helper()
+
+	logger, err := lib.NewLogger()(lib.NewLoggerParams{Configs: configs.NewTestConfig()()})
+	require.NoError(t, err)
+
+	client := newClient(url, secret, logger)
+	client.retryDelay = time.Millisecond
+
+	return client
+}
+
+func TestPublishRecap(t *testing.T) {
+	t.Run("PayloadAndSignature", func(t *testing.T) {
+		server, requests := newTestServer(t)
+		client := newTestClient(t, server.URL, "secret")
+
+		logID := uuid.New()
+		client.PublishRecap(NewRecapPublishedPayload(-100123456789, logID, []string{"## 话题 1", "## 话题 2"}, RecapPublishedModeAuto))
+		client.wg.Wait()
+
+		received := requests()
+		require.Len(t, received, 1)
+
+		var payload map[string]any
+
+		require.NoError(t, json.Unmarshal(received[0].body, &payload))
+		assert.Equal(t, EventRecapPublished, payload["event"])
+		assert.Equal(t, float64(-100123456789), payload["chatId"])
+		assert.Equal(t, logID.String(), payload["logId"])
+		assert.Equal(t, []any{"## 话题 1", "## 话题 2"}, payload["summarizations"])
+		assert.Equal(t, "auto", payload["mode"])
+		assert.NotZero(t, payload["timestamp"])
+
+		assert.Equal(t, "sha256="+Sign("secret", received[0].body), received[0].signature)
+	})
+
+	t.Run("WithoutSecret", func(t *testing.T) {
+		server, requests := newTestServer(t)
+		client := newTestClient(t, server.URL, "")
+
+		client.PublishRecap(NewRecapPublishedPayload(-100123456789, uuid.New(), []string{}, RecapPublishedModeManual))
+		client.wg.Wait()
+
+		received := requests()
+		require.Len(t, received, 1)
+		assert.Empty(t, received[0].signature)
+	})
+
+	t.Run("RetriesOnFailure", func(t *testing.T) {
+		server, requests := newTestServer(t, http.StatusInternalServerError, http.StatusBadGateway)
+		client := newTestClient(t, server.URL, "secret")
+
+		client.PublishRecap(NewRecapPublishedPayload(-100123456789, uuid.New(), []string{}, RecapPublishedModeManual))
+		client.wg.Wait()
+
+		assert.Len(t, requests(), 3)
+	})
+
+	t.Run("GivesUpAfterMaxAttempts", func(t *testing.T) {
+		server, requests := newTestServer(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+		client := newTestClient(t, server.URL, "secret")
+
+		client.PublishRecap(NewRecapPublishedPayload(-100123456789, uuid.New(), []string{}, RecapPublishedModeManual))
+		client.wg.Wait()
+
+		assert.Len(t, requests(), client.maxAttempts)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		client := newTestClient(t, "", "secret")
+		assert.False(t, client.Enabled())
+
+		client.PublishRecap(NewRecapPublishedPayload(-100123456789, uuid.New(), []string{}, RecapPublishedModeManual))
+		client.wg.Wait()
+	})
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '{"chatId":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "853afdb620c31108ae049578323108dbba528de0c8ddb5153b5c8c70c7326f9b", Sign("secret", []byte(`{"chatId":1}`)))
+}
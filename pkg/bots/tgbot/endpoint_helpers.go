@@ -22,16 +22,18 @@ func (config PinChatMessageConfig) params() (tgbotapi.Params, error) {
 	}
 
 	params.AddNonZero("message_id", config.MessageID)
-	params.AddBool("disable_notification", true)
+	params.AddBool("disable_notification", config.DisableNotification)
 
 	return params, err
 }
 
-func NewPinChatMessageConfig(chatID int64, messageID int) PinChatMessageConfig {
+// NewPinChatMessageConfig creates the config to pin the message, members of
+// the chat will not be notified if disableNotification is true.
+func NewPinChatMessageConfig(chatID int64, messageID int, disableNotification bool) PinChatMessageConfig {
 	return PinChatMessageConfig{
 		ChatID:              chatID,
 		MessageID:           messageID,
-		DisableNotification: true,
+		DisableNotification: disableNotification,
 	}
 }
 
@@ -64,3 +66,49 @@ func NewUnpinChatMessageConfig(chatID int64, messageID int) UnpinChatMessageConf
 		MessageID: messageID,
 	}
 }
+
+// ThreadMessageConfig is the message sent into a thread (forum topic) of the
+// chat, which tgbotapi.MessageConfig does not support yet.
+type ThreadMessageConfig struct {
+	tgbotapi.MessageConfig
+	MessageThreadID int
+}
+
+func (config ThreadMessageConfig) method() string {
+	return "sendMessage"
+}
+
+func (config ThreadMessageConfig) params() (tgbotapi.Params, error) {
+	params := make(tgbotapi.Params)
+
+	err := params.AddFirstValid("chat_id", config.ChatID, config.ChannelUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	params.AddNonZero("message_thread_id", config.MessageThreadID)
+	params.AddNonZero("reply_to_message_id", config.ReplyToMessageID)
+	params.AddBool("disable_notification", config.DisableNotification)
+	params.AddBool("allow_sending_without_reply", config.AllowSendingWithoutReply)
+	params.AddNonEmpty("text", config.Text)
+	params.AddBool("disable_web_page_preview", config.DisableWebPagePreview)
+	params.AddNonEmpty("parse_mode", config.ParseMode)
+
+	err = params.AddInterface("reply_markup", config.ReplyMarkup)
+	if err != nil {
+		return nil, err
+	}
+
+	err = params.AddInterface("entities", config.Entities)
+
+	return params, err
+}
+
+// NewThreadMessageConfig creates the config to send the message into the
+// thread of the chat, the message is sent as usual if threadID is zero.
+func NewThreadMessageConfig(message tgbotapi.MessageConfig, threadID int) ThreadMessageConfig {
+	return ThreadMessageConfig{
+		MessageConfig:   message,
+		MessageThreadID: threadID,
+	}
+}
@@ -0,0 +1,73 @@
+package tgbot
+
+import (
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPinChatMessageConfig(t *testing.T) {
+	t.Run("Silently", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		config := NewPinChatMessageConfig(1, 2, true)
+		a.Equal("pinChatMessage", config.method())
+
+		params, err := config.params()
+		r.NoError(err)
+
+		a.Equal("1", params["chat_id"])
+		a.Equal("2", params["message_id"])
+		a.Equal("true", params["disable_notification"])
+	})
+
+	t.Run("WithNotification", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		params, err := NewPinChatMessageConfig(1, 2, false).params()
+		r.NoError(err)
+
+		_, ok := params["disable_notification"]
+		a.False(ok)
+	})
+}
+
+func TestNewThreadMessageConfig(t *testing.T) {
+	t.Run("InThread", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		message := tgbotapi.NewMessage(-100123456789, "<b>recap</b>")
+		message.ParseMode = tgbotapi.ModeHTML
+		message.DisableWebPagePreview = true
+		message.ReplyToMessageID = 42
+
+		config := NewThreadMessageConfig(message, 7)
+		a.Equal("sendMessage", config.method())
+
+		params, err := config.params()
+		r.NoError(err)
+
+		a.Equal("-100123456789", params["chat_id"])
+		a.Equal("7", params["message_thread_id"])
+		a.Equal("42", params["reply_to_message_id"])
+		a.Equal("<b>recap</b>", params["text"])
+		a.Equal(tgbotapi.ModeHTML, params["parse_mode"])
+		a.Equal("true", params["disable_web_page_preview"])
+	})
+
+	t.Run("WithoutThread", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		params, err := NewThreadMessageConfig(tgbotapi.NewMessage(1, "recap"), 0).params()
+		r.NoError(err)
+
+		_, ok := params["message_thread_id"]
+		a.False(ok)
+	})
+}
@@ -0,0 +1,71 @@
+package tgbot
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+)
+
+// floodControlMaxRetries is how many times a message is retried after
+// Telegram asked to slow down before giving up.
+const floodControlMaxRetries = 3
+
+// RetryAfterFromErr returns how long Telegram asks to wait before sending
+// again, false will be returned if the error is not a flood control error.
+func RetryAfterFromErr(err error) (time.Duration, bool) {
+	var tgbotapiErr *tgbotapi.Error
+	if !errors.As(err, &tgbotapiErr) {
+		return 0, false
+	}
+
+	if tgbotapiErr.Code != http.StatusTooManyRequests || tgbotapiErr.RetryAfter <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(tgbotapiErr.RetryAfter) * time.Second, true
+}
+
+func sendWithFloodControl(send func() (tgbotapi.Message, error), sleep func(time.Duration), onRetry func(retryAfter time.Duration, attempt int)) (tgbotapi.Message, error) {
+	for attempt := 0; ; attempt++ {
+		message, err := send()
+		if err == nil {
+			return message, nil
+		}
+
+		retryAfter, ok := RetryAfterFromErr(err)
+		if !ok || attempt >= floodControlMaxRetries {
+			return message, err
+		}
+
+		onRetry(retryAfter, attempt+1)
+		sleep(retryAfter)
+	}
+}
+
+// SendWithFloodControl sends the chattable, and waits for as long as Telegram
+// asks and sends it again when it was rejected by the flood control, so that
+// the message is not lost when sending to many chats in a burst.
+func (b *Bot) SendWithFloodControl(chattable tgbotapi.Chattable) (tgbotapi.Message, error) {
+	return sendWithFloodControl(func() (tgbotapi.Message, error) {
+		return b.Send(chattable)
+	}, time.Sleep, b.logFloodControlRetry)
+}
+
+// SendThreadMessageWithFloodControl is SendWithFloodControl for the message
+// sent into a thread of the chat.
+func (b *Bot) SendThreadMessageWithFloodControl(config ThreadMessageConfig) (tgbotapi.Message, error) {
+	return sendWithFloodControl(func() (tgbotapi.Message, error) {
+		return b.SendThreadMessage(config)
+	}, time.Sleep, b.logFloodControlRetry)
+}
+
+func (b *Bot) logFloodControlRetry(retryAfter time.Duration, attempt int) {
+	b.logger.Warn("rate limited by telegram, retrying later",
+		zap.Duration("retry_after", retryAfter),
+		zap.Int("attempt", attempt),
+		zap.Int("max_attempts", floodControlMaxRetries),
+	)
+}
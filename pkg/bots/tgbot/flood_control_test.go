@@ -0,0 +1,86 @@
+package tgbot
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	errs  []error
+	calls int
+}
+
+func (s *fakeSender) Send() (tgbotapi.Message, error) {
+	s.calls++
+
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+
+		return tgbotapi.Message{}, err
+	}
+
+	return tgbotapi.Message{MessageID: 42}, nil
+}
+
+func newFloodControlErr(retryAfter int) error {
+	return &tgbotapi.Error{
+		Code:               429,
+		Message:            "Too Many Requests: retry after 3",
+		ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: retryAfter},
+	}
+}
+
+func TestRetryAfterFromErr(t *testing.T) {
+	retryAfter, ok := RetryAfterFromErr(newFloodControlErr(3))
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, retryAfter)
+
+	_, ok = RetryAfterFromErr(&tgbotapi.Error{Code: 403, Message: "Forbidden: bot was blocked by the user"})
+	assert.False(t, ok)
+
+	_, ok = RetryAfterFromErr(errors.New("connection reset"))
+	assert.False(t, ok)
+
+	_, ok = RetryAfterFromErr(nil)
+	assert.False(t, ok)
+}
+
+func TestSendWithFloodControl(t *testing.T) {
+	t.Run("RetriesAfterRetryAfter", func(t *testing.T) {
+		s := &fakeSender{errs: []error{newFloodControlErr(3)}}
+		slept := make([]time.Duration, 0)
+
+		message, err := sendWithFloodControl(s.Send, func(d time.Duration) { slept = append(slept, d) }, func(time.Duration, int) {})
+		require.NoError(t, err)
+
+		assert.Equal(t, 42, message.MessageID)
+		assert.Equal(t, 2, s.calls)
+		assert.Equal(t, []time.Duration{3 * time.Second}, slept)
+	})
+
+	t.Run("GivesUpAfterMaxRetries", func(t *testing.T) {
+		s := &fakeSender{errs: []error{newFloodControlErr(1), newFloodControlErr(1), newFloodControlErr(1), newFloodControlErr(1)}}
+		slept := make([]time.Duration, 0)
+
+		_, err := sendWithFloodControl(s.Send, func(d time.Duration) { slept = append(slept, d) }, func(time.Duration, int) {})
+		require.Error(t, err)
+
+		assert.Equal(t, floodControlMaxRetries+1, s.calls)
+		assert.Len(t, slept, floodControlMaxRetries)
+	})
+
+	t.Run("OtherErrorsAreNotRetried", func(t *testing.T) {
+		s := &fakeSender{errs: []error{&tgbotapi.Error{Code: 400, Message: "Bad Request: chat not found"}}}
+
+		_, err := sendWithFloodControl(s.Send, func(time.Duration) { t.Fatal("should not sleep") }, func(time.Duration, int) {})
+		require.Error(t, err)
+
+		assert.Equal(t, 1, s.calls)
+	})
+}
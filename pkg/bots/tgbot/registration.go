@@ -0,0 +1,45 @@
+package tgbot
+
+import (
+	"go.uber.org/fx"
+)
+
+// CallbackQuery is a route of the callback queries and the handler of it.
+type CallbackQuery struct {
+	Route   string
+	Handler Handler
+}
+
+// Registrations are the commands and the callback queries provided by the
+// feature modules with AsCommand and AsCallbackQuery.
+type Registrations struct {
+	fx.In
+
+	Commands        []Command       `group:"tgbot_commands"`
+	CallbackQueries []CallbackQuery `group:"tgbot_callback_queries"`
+}
+
+// AsCommand annotates the constructor which returns a Command, so that the
+// command is collected into Registrations and registered to the dispatcher
+// without touching the handler wiring.
+func AsCommand(constructor any) any {
+	return fx.Annotate(constructor, fx.ResultTags(`group:"tgbot_commands"`))
+}
+
+// AsCallbackQuery annotates the constructor which returns a CallbackQuery, so
+// that the callback query is collected into Registrations and registered to
+// the dispatcher without touching the handler wiring.
+func AsCallbackQuery(constructor any) any {
+	return fx.Annotate(constructor, fx.ResultTags(`group:"tgbot_callback_queries"`))
+}
+
+// Register registers the collected commands and callback queries.
+func (d *Dispatcher) Register(registrations Registrations) {
+	for _, c := range registrations.Commands {
+		d.OnCommand(c.Command, c.HelpMessage, c.Handler)
+	}
+
+	for _, q := range registrations.CallbackQueries {
+		d.OnCallbackQuery(q.Route, q.Handler)
+	}
+}
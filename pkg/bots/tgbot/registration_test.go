@@ -0,0 +1,67 @@
+package tgbot
+
+import (
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/nekomeowww/insights-bot/pkg/i18n"
+	"github.com/nekomeowww/insights-bot/pkg/logger"
+)
+
+func TestDispatcherRegister(t *testing.T) {
+	handled := make(chan string, 1)
+
+	var dispatcher *Dispatcher
+
+	app := fx.New(
+		fx.NopLogger,
+		fx.Provide(func() (*logger.Logger, error) {
+			return logger.NewLogger(zapcore.DebugLevel, "insights-bot", "", nil)
+		}),
+		fx.Provide(func() *i18n.I18n { return nil }),
+		fx.Provide(NewDispatcher()),
+		fx.Provide(AsCommand(func() Command {
+			return Command{
+				Command:     "ping",
+				HelpMessage: func(*Context) string { return "ping" },
+				Handler: defaultHandler{handleFunc: func(c *Context) (Response, error) {
+					handled <- c.Update.Message.Text
+					return nil, nil
+				}},
+			}
+		})),
+		fx.Provide(AsCallbackQuery(func() CallbackQuery {
+			return CallbackQuery{Route: "ping/pong", Handler: defaultHandler{handleFunc: func(*Context) (Response, error) { return nil, nil }}}
+		})),
+		fx.Invoke(func(d *Dispatcher, registrations Registrations) {
+			d.Register(registrations)
+			dispatcher = d
+		}),
+	)
+	require.NoError(t, app.Err())
+
+	assert.Contains(t, lo.Values(dispatcher.callbackQueryHandlersRoute), "ping/pong")
+
+	dispatcher.Dispatch(nil, tgbotapi.Update{
+		Message: &tgbotapi.Message{
+			Text:     "/ping",
+			From:     &tgbotapi.User{ID: 1, FirstName: "Neko"},
+			Chat:     &tgbotapi.Chat{ID: 1, Type: "private"},
+			Entities: []tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: 5}},
+		},
+	}, nil)
+
+	select {
+	case text := <-handled:
+		assert.Equal(t, "/ping", text)
+	case <-time.After(time.Second):
+		assert.Fail(t, "registered command was not dispatched")
+	}
+}
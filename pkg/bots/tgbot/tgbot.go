@@ -301,6 +301,41 @@ func (b *Bot) MaySend(chattable tgbotapi.Chattable) *tgbotapi.Message {
 	return lo.ToPtr(may.Invoke(b.Send(chattable)))
 }
 
+// SendThreadMessage sends the message into the thread of the chat, it is sent
+// as usual if no thread is specified.
+func (b *Bot) SendThreadMessage(config ThreadMessageConfig) (tgbotapi.Message, error) {
+	if config.MessageThreadID == 0 {
+		return b.Send(config.MessageConfig)
+	}
+
+	params, err := config.params()
+	if err != nil {
+		return tgbotapi.Message{}, err
+	}
+
+	resp, err := b.MakeRequest(config.method(), params)
+	if err != nil {
+		return tgbotapi.Message{}, err
+	}
+
+	var message tgbotapi.Message
+
+	err = json.Unmarshal(resp.Result, &message)
+	if err != nil {
+		return tgbotapi.Message{}, err
+	}
+
+	return message, nil
+}
+
+func (b *Bot) MaySendThreadMessage(config ThreadMessageConfig) *tgbotapi.Message {
+	may := fo.NewMay[tgbotapi.Message]().Use(func(err error, messageArgs ...any) {
+		b.logger.Error("failed to send message to telegram", zap.String("message", xo.SprintJSON(config)), zap.Error(err))
+	})
+
+	return lo.ToPtr(may.Invoke(b.SendThreadMessage(config)))
+}
+
 func (b *Bot) MayRequest(chattable tgbotapi.Chattable) *tgbotapi.APIResponse {
 	may := fo.NewMay[*tgbotapi.APIResponse]().Use(func(err error, messageArgs ...any) {
 		b.logger.Error("failed to send request to telegram", zap.String("request", xo.SprintJSON(chattable)), zap.Error(err))
@@ -505,6 +540,37 @@ func (b *Bot) routeHashAndActionHashFromData(callbackQueryData string) (string,
 	return handlerIdentifierPairs[0], handlerIdentifierPairs[1]
 }
 
+// FetchCallbackQueryActionData binds the action data that was assigned to the
+// callback query data of the route into dst, false will be returned if the
+// callback query data doesn't belong to the route or the action data has
+// already expired.
+func (b *Bot) FetchCallbackQueryActionData(route string, callbackQueryData string, dst any) (bool, error) {
+	routeHash, actionHash := b.routeHashAndActionHashFromData(callbackQueryData)
+	if routeHash == "" || actionHash == "" {
+		return false, nil
+	}
+
+	if routeHash != fmt.Sprintf("%x", sha256.Sum256([]byte(route)))[0:16] {
+		return false, nil
+	}
+
+	actionData, err := b.fetchCallbackQueryActionData(route, actionHash)
+	if err != nil {
+		return false, err
+	}
+
+	if actionData == "" {
+		return false, nil
+	}
+
+	err = json.Unmarshal([]byte(actionData), dst)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (b *Bot) fetchCallbackQueryActionData(route string, dataHash string) (string, error) {
 	getCmd := b.rueidisClient.B().
 		Get().
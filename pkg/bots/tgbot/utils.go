@@ -1,8 +1,11 @@
 package tgbot
 
 import (
+	"fmt"
+	"math"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/nekomeowww/insights-bot/pkg/types/telegram"
 	"github.com/nekomeowww/xo"
@@ -162,3 +165,28 @@ func MapMemberStatusToChineseText(memberStatus telegram.MemberStatus) string {
 		return "未知"
 	}
 }
+
+// FormatDurationToChineseText formats the remaining duration into human
+// readable Chinese text, durations under a minute are shown in seconds,
+// others are rounded up to the next minute.
+func FormatDurationToChineseText(d time.Duration) string {
+	if d < time.Minute {
+		seconds := int64(math.Ceil(d.Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+
+		return fmt.Sprintf("%d 秒", seconds)
+	}
+
+	minutes := int64(math.Ceil(d.Minutes()))
+	if minutes < 60 {
+		return fmt.Sprintf("%d 分钟", minutes)
+	}
+
+	if minutes%60 == 0 {
+		return fmt.Sprintf("%d 小时", minutes/60)
+	}
+
+	return fmt.Sprintf("%d 小时 %d 分钟", minutes/60, minutes%60)
+}
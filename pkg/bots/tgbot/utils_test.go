@@ -3,6 +3,7 @@ package tgbot
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -40,3 +41,25 @@ func TestReplaceMarkdownTitlesToBoldTexts(t *testing.T) {
 		a.Equal(expect, actual)
 	})
 }
+
+func TestFormatDurationToChineseText(t *testing.T) {
+	tables := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{duration: 0, expected: "1 秒"},
+		{duration: 500 * time.Millisecond, expected: "1 秒"},
+		{duration: 59 * time.Second, expected: "59 秒"},
+		{duration: time.Minute, expected: "1 分钟"},
+		{duration: 61 * time.Second, expected: "2 分钟"},
+		{duration: 90 * time.Second, expected: "2 分钟"},
+		{duration: time.Hour, expected: "1 小时"},
+		{duration: time.Hour + 30*time.Second, expected: "1 小时 1 分钟"},
+	}
+
+	for _, table := range tables {
+		t.Run(table.duration.String(), func(t *testing.T) {
+			assert.Equal(t, table.expected, FormatDurationToChineseText(table.duration))
+		})
+	}
+}
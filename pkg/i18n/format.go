@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+type timeFormat struct {
+	clock    string
+	dateTime string
+}
+
+var (
+	timeFormatChinese  = timeFormat{clock: "15:04", dateTime: "2006-01-02 15:04"}
+	timeFormatJapanese = timeFormat{clock: "15:04", dateTime: "2006/01/02 15:04"}
+	timeFormatEnglish  = timeFormat{clock: "3:04 PM", dateTime: "Jan 2, 2006 3:04 PM"}
+)
+
+// timeFormatOf returns the time format for the language, Chinese format will
+// be used for empty or unknown languages since the recaps are written in
+// Chinese.
+func timeFormatOf(lang string) timeFormat {
+	if lang == "" {
+		return timeFormatChinese
+	}
+
+	base, _ := language.Make(lang).Base()
+
+	switch base.String() {
+	case "en":
+		return timeFormatEnglish
+	case "ja":
+		return timeFormatJapanese
+	default:
+		return timeFormatChinese
+	}
+}
+
+// FormatClockHour formats the hour of a day into a clock time for the
+// language, e.g. 20:00 for Chinese and 8:00 PM for English.
+func FormatClockHour(lang string, hour int) string {
+	return time.Date(2000, 1, 1, hour, 0, 0, 0, time.UTC).Format(timeFormatOf(lang).clock)
+}
+
+// FormatDateTime formats the date and time for the language, e.g.
+// 2023-09-01 20:30 for Chinese and Sep 1, 2023 8:30 PM for English.
+func FormatDateTime(lang string, t time.Time) string {
+	return t.Format(timeFormatOf(lang).dateTime)
+}
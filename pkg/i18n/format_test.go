@@ -0,0 +1,39 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatClockHour(t *testing.T) {
+	t.Run("Chinese", func(t *testing.T) {
+		assert.Equal(t, "08:00", FormatClockHour("zh-hans", 8))
+		assert.Equal(t, "20:00", FormatClockHour("zh-CN", 20))
+	})
+
+	t.Run("English", func(t *testing.T) {
+		assert.Equal(t, "8:00 AM", FormatClockHour("en", 8))
+		assert.Equal(t, "8:00 PM", FormatClockHour("en-US", 20))
+		assert.Equal(t, "12:00 AM", FormatClockHour("en", 0))
+	})
+
+	t.Run("Japanese", func(t *testing.T) {
+		assert.Equal(t, "20:00", FormatClockHour("ja", 20))
+	})
+
+	t.Run("EmptyOrUnknown", func(t *testing.T) {
+		assert.Equal(t, "02:00", FormatClockHour("", 2))
+		assert.Equal(t, "02:00", FormatClockHour("not-a-language", 2))
+	})
+}
+
+func TestFormatDateTime(t *testing.T) {
+	at := time.Date(2023, 9, 1, 20, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "2023-09-01 20:30", FormatDateTime("zh-hans", at))
+	assert.Equal(t, "Sep 1, 2023 8:30 PM", FormatDateTime("en", at))
+	assert.Equal(t, "2023/09/01 20:30", FormatDateTime("ja-JP", at))
+	assert.Equal(t, "2023-09-01 20:30", FormatDateTime("", at))
+}
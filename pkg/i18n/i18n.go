@@ -1,6 +1,7 @@
 package i18n
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 
@@ -73,6 +74,13 @@ func NewI18n(options ...NewI18nOption) (*I18n, error) {
 			return nil, err
 		}
 
+		// the Simplified Chinese messages are registered for zh-Hans as well,
+		// the other languages such as zh-TW must not override them.
+		script, _ := file.Tag.Script()
+		if script != language.MustParseScript("Hans") {
+			continue
+		}
+
 		err = bundle.AddMessages(language.SimplifiedChinese, file.Messages...)
 		if err != nil {
 			return nil, err
@@ -123,6 +131,13 @@ func (i *I18n) t(lang language.Tag, key string, args ...any) string {
 	}
 
 	str, err := localizer.Localize(config)
+
+	// the messages missing in the language fall back to English, so that the
+	// incomplete locales can still be used
+	var notFoundErr *i18n.MessageNotFoundErr
+	if errors.As(err, &notFoundErr) && lang != language.English {
+		str, err = i18n.NewLocalizer(i.Bundle, language.English.String()).Localize(config)
+	}
 	if err != nil {
 		i.logger.Error("failed to localize message",
 			zap.String("lang", lang.String()),
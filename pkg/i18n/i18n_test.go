@@ -0,0 +1,122 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v3"
+)
+
+func TestTWithLanguage(t *testing.T) {
+	i, err := NewI18n(WithLocalesDir(filepath.Join("..", "..", "locales")))
+	require.NoError(t, err)
+
+	key := "modules.telegram.recap.failedToGenerate"
+
+	t.Run("ResolvesPerLanguage", func(t *testing.T) {
+		assert.Equal(t, "聊天记录回顾生成失败，请稍后再试！", i.TWithLanguage("zh-CN", key))
+		assert.Equal(t, "聊天记录回顾生成失败，请稍后再试！", i.TWithLanguage("zh-Hans", key))
+		assert.Equal(t, "聊天紀錄回顧產生失敗，請稍後再試！", i.TWithLanguage("zh-TW", key))
+		assert.Equal(t, "聊天紀錄回顧產生失敗，請稍後再試！", i.TWithLanguage("zh-Hant", key))
+		assert.Equal(t, "Failed to generate the recap of the chat histories, please try again later!", i.TWithLanguage("en", key))
+	})
+
+	t.Run("TemplateData", func(t *testing.T) {
+		assert.Equal(t, "已整理出 3 个话题...", i.TWithLanguage("zh-CN", "modules.telegram.recap.topicsSummarized", M{"Count": 3}))
+		assert.Equal(t, "3 topics summarized...", i.TWithLanguage("en", "modules.telegram.recap.topicsSummarized", M{"Count": 3}))
+	})
+
+	t.Run("UnknownLanguageFallsBackToEnglish", func(t *testing.T) {
+		assert.Equal(t, "Failed to generate the recap of the chat histories, please try again later!", i.TWithLanguage("xx", key))
+	})
+}
+
+func TestTWithLanguageFallback(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "en.yaml"), []byte("greeting: Hello\nfarewell: Bye\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "zh-CN.yaml"), []byte("greeting: 你好\nfarewell: 再见\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "zh-TW.yaml"), []byte("greeting: 你好呀\n"), 0o600))
+
+	i, err := NewI18n(WithLocalesDir(dir))
+	require.NoError(t, err)
+
+	t.Run("TraditionalChineseDoesNotOverrideSimplifiedChinese", func(t *testing.T) {
+		assert.Equal(t, "你好", i.TWithLanguage("zh-CN", "greeting"))
+		assert.Equal(t, "你好", i.TWithLanguage("zh-Hans", "greeting"))
+		assert.Equal(t, "你好呀", i.TWithLanguage("zh-TW", "greeting"))
+	})
+
+	t.Run("MissingKeyFallsBackToEnglish", func(t *testing.T) {
+		assert.Equal(t, "Bye", i.TWithLanguage("zh-TW", "farewell"))
+	})
+
+	t.Run("MissingKeyInAllLanguages", func(t *testing.T) {
+		assert.Equal(t, "unknown", i.TWithLanguage("zh-CN", "unknown"))
+	})
+}
+
+// flattenLocaleKeys collects the keys of the string values in the locale,
+// the non-string values such as the prompts are skipped.
+func flattenLocaleKeys(prefix string, value any, keys map[string]struct{}) {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, child := range v {
+			flattenLocaleKeys(strings.TrimPrefix(prefix+"."+k, "."), child, keys)
+		}
+	case string:
+		keys[prefix] = struct{}{}
+	}
+}
+
+func TestLocalesHaveTheSameKeys(t *testing.T) {
+	dir := filepath.Join("..", "..", "locales")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	localeKeys := make(map[string]map[string]struct{})
+	allKeys := make(map[string]struct{})
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".yaml" {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+
+		var locale map[string]any
+
+		err = yaml.Unmarshal(content, &locale)
+		require.NoError(t, err, entry.Name())
+
+		keys := make(map[string]struct{})
+		flattenLocaleKeys("", locale, keys)
+
+		localeKeys[entry.Name()] = keys
+
+		for k := range keys {
+			allKeys[k] = struct{}{}
+		}
+	}
+
+	require.NotEmpty(t, localeKeys)
+
+	for name, keys := range localeKeys {
+		missing := make([]string, 0)
+
+		for k := range allKeys {
+			if _, ok := keys[k]; !ok {
+				missing = append(missing, k)
+			}
+		}
+
+		sort.Strings(missing)
+		assert.Empty(t, missing, "%s is missing keys", name)
+	}
+}
@@ -0,0 +1,64 @@
+package i18n
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// ChineseScript is the script of the Chinese text.
+type ChineseScript int
+
+const (
+	// ChineseScriptUnknown means the text has none of the characters that
+	// tell the scripts apart.
+	ChineseScriptUnknown ChineseScript = iota
+	ChineseScriptSimplified
+	ChineseScriptTraditional
+	// ChineseScriptMixed means the text has both the Simplified and the
+	// Traditional characters.
+	ChineseScriptMixed
+)
+
+// The common characters that are written differently in the Simplified and
+// the Traditional Chinese, paired by index. Characters shared by both scripts
+// in some words, such as 范 and 后, are left out to avoid false positives.
+const (
+	simplifiedOnlyCharacters  = "记录顾讯与们这个为说时会设启关开闭调传发选择请应该将对无还给吗预览订阅线错误数据标题话产资种类总结联网页链连处动变体隐显频导读获当单实现区间历让验证围参转语词级点击员过长专书测试计条头门问样举称户号内统组较静钟须议论众节满图档视删"
+	traditionalOnlyCharacters = "記錄顧訊與們這個為說時會設啟關開閉調傳發選擇請應該將對無還給嗎預覽訂閱線錯誤數據標題話產資種類總結聯網頁鏈連處動變體隱顯頻導讀獲當單實現區間歷讓驗證圍參轉語詞級點擊員過長專書測試計條頭門問樣舉稱戶號內統組較靜鐘須議論眾節滿圖檔視刪"
+)
+
+// ChineseScriptOf tells the script of the Chinese text by the characters that
+// are only used in either the Simplified or the Traditional Chinese.
+func ChineseScriptOf(text string) ChineseScript {
+	simplified := strings.ContainsAny(text, simplifiedOnlyCharacters)
+	traditional := strings.ContainsAny(text, traditionalOnlyCharacters)
+
+	switch {
+	case simplified && traditional:
+		return ChineseScriptMixed
+	case simplified:
+		return ChineseScriptSimplified
+	case traditional:
+		return ChineseScriptTraditional
+	default:
+		return ChineseScriptUnknown
+	}
+}
+
+// HasMixedChineseScripts reports whether the text mixes the Simplified and the
+// Traditional Chinese, which should never happen in one message.
+func HasMixedChineseScripts(text string) bool {
+	return ChineseScriptOf(text) == ChineseScriptMixed
+}
+
+// IsTraditionalChinese reports whether the language is Chinese written in the
+// Traditional script, such as zh-TW, zh-HK and zh-Hant.
+func IsTraditionalChinese(lang string) bool {
+	tag := language.Make(lang)
+
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+
+	return base.String() == "zh" && script.String() == "Hant"
+}
@@ -0,0 +1,83 @@
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v3"
+)
+
+func TestChineseScriptOf(t *testing.T) {
+	assert.Equal(t, ChineseScriptUnknown, ChineseScriptOf("Hello"))
+	assert.Equal(t, ChineseScriptUnknown, ChineseScriptOf("你好"))
+	assert.Equal(t, ChineseScriptSimplified, ChineseScriptOf("聊天记录回顾"))
+	assert.Equal(t, ChineseScriptTraditional, ChineseScriptOf("聊天紀錄回顧"))
+	assert.Equal(t, ChineseScriptMixed, ChineseScriptOf("聊天记录回顧"))
+	assert.Equal(t, ChineseScriptTraditional, ChineseScriptOf("過去 6 時間のチャット履歴"))
+}
+
+func TestIsTraditionalChinese(t *testing.T) {
+	assert.True(t, IsTraditionalChinese("zh-TW"))
+	assert.True(t, IsTraditionalChinese("zh-HK"))
+	assert.True(t, IsTraditionalChinese("zh-Hant"))
+	assert.False(t, IsTraditionalChinese("zh-CN"))
+	assert.False(t, IsTraditionalChinese("zh"))
+	assert.False(t, IsTraditionalChinese("ja"))
+	assert.False(t, IsTraditionalChinese(""))
+}
+
+func TestChineseOnlyCharactersArePaired(t *testing.T) {
+	require.Equal(t, utf8.RuneCountInString(simplifiedOnlyCharacters), utf8.RuneCountInString(traditionalOnlyCharacters))
+
+	traditional := []rune(traditionalOnlyCharacters)
+	for i, r := range []rune(simplifiedOnlyCharacters) {
+		assert.NotEqual(t, r, traditional[i])
+	}
+}
+
+func localeMessages(t *testing.T, name string) map[string]string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "locales", name))
+	require.NoError(t, err)
+
+	var root map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &root))
+
+	messages := make(map[string]string)
+
+	var walk func(prefix string, value any)
+	walk = func(prefix string, value any) {
+		switch v := value.(type) {
+		case map[string]any:
+			for key, item := range v {
+				walk(prefix+"."+key, item)
+			}
+		case []any:
+			for i, item := range v {
+				walk(fmt.Sprintf("%s.%d", prefix, i), item)
+			}
+		default:
+			messages[prefix] = fmt.Sprint(v)
+		}
+	}
+
+	walk("", root)
+
+	return messages
+}
+
+func TestLocalesDoNotMixChineseScripts(t *testing.T) {
+	for key, message := range localeMessages(t, "zh-CN.yaml") {
+		assert.NotContains(t, []ChineseScript{ChineseScriptTraditional, ChineseScriptMixed}, ChineseScriptOf(message), key)
+	}
+
+	for key, message := range localeMessages(t, "zh-TW.yaml") {
+		assert.NotContains(t, []ChineseScript{ChineseScriptSimplified, ChineseScriptMixed}, ChineseScriptOf(message), key)
+	}
+}
@@ -45,3 +45,18 @@ type ConfigureRecapPinMessageData struct {
 	Status bool  `json:"status"`
 	ChatID int64 `json:"chatId"`
 }
+
+type ConfigureRecapOptionToggleData struct {
+	Status bool  `json:"status"`
+	ChatID int64 `json:"chatId"`
+}
+
+type ConfigureRecapOutputFormatData struct {
+	Format tgchat.RecapOutputFormat `json:"format"`
+	ChatID int64                    `json:"chatId"`
+}
+
+type ConfigureRecapManualRecapMinRoleData struct {
+	Role   tgchat.ManualRecapMinRole `json:"role"`
+	ChatID int64                     `json:"chatId"`
+}
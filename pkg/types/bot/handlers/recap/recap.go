@@ -8,3 +8,15 @@ type SelectHourCallbackQueryData struct {
 	ChatTitle string                   `json:"chat_title"`
 	RecapMode tgchat.AutoRecapSendMode `json:"recap_mode"`
 }
+
+type PublishRecapPreviewActionData struct {
+	PreviewID string `json:"preview_id"`
+	ChatID    int64  `json:"chat_id"`
+	FromID    int64  `json:"from_id"`
+}
+
+type RecapApprovalActionData struct {
+	ApprovalID string `json:"approval_id"`
+	ChatID     int64  `json:"chat_id"`
+	FromID     int64  `json:"from_id"`
+}
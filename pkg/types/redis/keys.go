@@ -15,6 +15,15 @@ func (k Key) Format(params ...interface{}) string {
 const (
 	// TimeCapsuleAutoRecapSortedSetKey is the key for auto recap used timecapsule queue.
 	TimeCapsuleAutoRecapSortedSetKey Key = "time_capsule/auto_recap_capsules" //  SortedSet
+
+	// TimeCapsulePrivateRecapDigestSortedSetKey is the key for private recap digest used timecapsule queue.
+	TimeCapsulePrivateRecapDigestSortedSetKey Key = "time_capsule/private_recap_digest_capsules" //  SortedSet
+
+	// TimeCapsuleAutoUnpinRecapSortedSetKey is the key for auto unpinning recap messages used timecapsule queue.
+	TimeCapsuleAutoUnpinRecapSortedSetKey Key = "time_capsule/auto_unpin_recap_capsules" //  SortedSet
+
+	// TimeCapsuleApprovedAutoRecapSortedSetKey is the key for publishing the approved auto recaps used timecapsule queue.
+	TimeCapsuleApprovedAutoRecapSortedSetKey Key = "time_capsule/approved_auto_recap_capsules" //  SortedSet
 )
 
 // Recap keys.
@@ -35,6 +44,42 @@ const (
 	// RecapSubscribeRecapStartCommandContext1 is the key for storing the recap subscribe recap start command context.
 	// params: hash key
 	RecapSubscribeRecapStartCommandContext1 Key = "recap/subscribe_recap/start_command_context/%s"
+
+	// RecapPreview1 is the key for storing the generated but not yet published recap preview.
+	// params: preview id
+	RecapPreview1 Key = "recap/preview/%s"
+
+	// RecapApproval1 is the key for storing the auto recap waiting for the approval of the administrators.
+	// params: approval id
+	RecapApproval1 Key = "recap/approval/%s"
+
+	// RecapPrivateDigest1 is the key for storing the auto recaps waiting to be delivered to the user in the
+	// next private recap digest.
+	// params: user id
+	RecapPrivateDigest1 Key = "recap/private_digest/%d" // List
+
+	// RecapPreviousSummary1 is the key for storing the summary of the last incremental recap of the chat.
+	// params: chat id
+	RecapPreviousSummary1 Key = "recap/previous_summary/%d"
+)
+
+// Chat histories keys.
+
+const (
+	// ChatHistoriesEphemeral1 is the key for storing the chat histories of the chat that opted out of
+	// storing message content durably.
+	// params: chat id
+	ChatHistoriesEphemeral1 Key = "chat_histories/ephemeral/%d" // SortedSet
+
+	// ChatHistoriesFirstChattedAt1 is the key for storing the time that the users were first seen chatting in
+	// the chat that opted out of storing message content durably.
+	// params: chat id
+	ChatHistoriesFirstChattedAt1 Key = "chat_histories/first_chatted_at/%d" // Hash
+
+	// ChatHistoriesEphemeralPoll1 is the key for storing the chat id of the poll kept in the ephemeral chat
+	// histories, since the poll updates carry no chat.
+	// params: poll id
+	ChatHistoriesEphemeralPoll1 Key = "chat_histories/ephemeral_poll/%s"
 )
 
 // Common keys.
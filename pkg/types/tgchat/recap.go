@@ -1,10 +1,13 @@
 package tgchat
 
+import "strings"
+
 type AutoRecapSendMode int
 
 const (
 	AutoRecapSendModePublicly                 AutoRecapSendMode = iota
 	AutoRecapSendModeOnlyPrivateSubscriptions                   // Only users who subscribed to the recap will receive it
+	AutoRecapSendModeDigestOnly                                 // Only the topics are posted to the chat, the full recap is sent to the subscribers
 )
 
 func (a AutoRecapSendMode) String() string {
@@ -13,7 +16,116 @@ func (a AutoRecapSendMode) String() string {
 		return "公开"
 	case AutoRecapSendModeOnlyPrivateSubscriptions:
 		return "私聊"
+	case AutoRecapSendModeDigestOnly:
+		return "仅摘要"
+	default:
+		return "其他"
+	}
+}
+
+// PostsToChat reports whether the auto recaps are posted to the chat itself.
+func (a AutoRecapSendMode) PostsToChat() bool {
+	return a == AutoRecapSendModePublicly || a == AutoRecapSendModeDigestOnly
+}
+
+type RecapOutputFormat int
+
+const (
+	RecapOutputFormatProse   RecapOutputFormat = iota
+	RecapOutputFormatBullets                   // Terse bullet points only
+)
+
+func (f RecapOutputFormat) String() string {
+	switch f {
+	case RecapOutputFormatProse:
+		return "段落"
+	case RecapOutputFormatBullets:
+		return "要点"
+	default:
+		return "其他"
+	}
+}
+
+type SubscribeRecapMemberStatusRequirement int
+
+const (
+	SubscribeRecapMemberStatusRequirementMember        SubscribeRecapMemberStatusRequirement = iota
+	SubscribeRecapMemberStatusRequirementUnrestricted                                        // Restricted members are not allowed to subscribe
+	SubscribeRecapMemberStatusRequirementAdministrator                                       // Only administrators and the creator are allowed to subscribe
+)
+
+func (r SubscribeRecapMemberStatusRequirement) String() string {
+	switch r {
+	case SubscribeRecapMemberStatusRequirementMember:
+		return "群组成员"
+	case SubscribeRecapMemberStatusRequirementUnrestricted:
+		return "未被限制的群组成员"
+	case SubscribeRecapMemberStatusRequirementAdministrator:
+		return "群组管理员"
+	default:
+		return "其他"
+	}
+}
+
+// ManualRecapMinRole is the lowest role of the members that are allowed to
+// create the recaps with /recap.
+type ManualRecapMinRole int
+
+const (
+	ManualRecapMinRoleEveryone      ManualRecapMinRole = iota
+	ManualRecapMinRoleAdministrator                    // Only administrators and the creator are allowed to create recaps
+	ManualRecapMinRoleCreator                          // Only the creator is allowed to create recaps
+)
+
+func (r ManualRecapMinRole) String() string {
+	switch r {
+	case ManualRecapMinRoleEveryone:
+		return "所有成员"
+	case ManualRecapMinRoleAdministrator:
+		return "管理员"
+	case ManualRecapMinRoleCreator:
+		return "群组创建者"
 	default:
 		return "其他"
 	}
 }
+
+// MessageTypes is a set of the kinds of the chat histories, stored as bitmask.
+type MessageTypes int
+
+const (
+	MessageTypeService   MessageTypes = 1 << iota // Joins, leaves, pins and other service messages
+	MessageTypeForwarded                          // Messages forwarded from other users or chats
+	MessageTypeMediaOnly                          // Photos, stickers and other media without caption
+)
+
+// DefaultExcludedMessageTypes is the message types excluded from the recaps
+// unless configured otherwise.
+const DefaultExcludedMessageTypes = MessageTypeService
+
+// Has reports whether all of the types in other are in the set.
+func (t MessageTypes) Has(other MessageTypes) bool {
+	return t&other == other
+}
+
+func (t MessageTypes) String() string {
+	names := make([]string, 0, 3)
+
+	if t.Has(MessageTypeService) {
+		names = append(names, "服务消息")
+	}
+
+	if t.Has(MessageTypeForwarded) {
+		names = append(names, "转发消息")
+	}
+
+	if t.Has(MessageTypeMediaOnly) {
+		names = append(names, "无文字的媒体消息")
+	}
+
+	if len(names) == 0 {
+		return "无"
+	}
+
+	return strings.Join(names, "、")
+}
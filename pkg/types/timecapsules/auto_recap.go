@@ -3,3 +3,17 @@ package timecapsules
 type AutoRecapCapsule struct {
 	ChatID int64 `json:"chat_id"`
 }
+
+type PrivateRecapDigestCapsule struct {
+	UserID int64 `json:"user_id"`
+}
+
+type AutoUnpinRecapCapsule struct {
+	ChatID    int64 `json:"chat_id"`
+	MessageID int   `json:"message_id"`
+}
+
+type ApprovedAutoRecapCapsule struct {
+	ChatID     int64  `json:"chat_id"`
+	ApprovalID string `json:"approval_id"`
+}